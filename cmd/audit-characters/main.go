@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/clients/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	characterDraftRepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft"
+	charactersRepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	characterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	rulebookService "github.com/KirkDiggler/dnd-bot-discord/internal/services/rulebook"
+	"github.com/redis/go-redis/v9"
+)
+
+func main() {
+	repair := flag.Bool("repair", false, "Apply the automatic repairs and save")
+	showWarnings := flag.Bool("warnings", false, "Also list characters that only have warnings")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	// Set up Redis
+	redisURL := os.Getenv("REDIS_URL")
+	if redisURL == "" {
+		redisURL = "redis://localhost:6379/0"
+	}
+
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		log.Fatalf("Failed to parse Redis URL: %v", err)
+	}
+
+	client := redis.NewClient(opts)
+
+	// Test connection first
+	if _, pingErr := client.Ping(ctx).Result(); pingErr != nil {
+		log.Fatalf("Failed to connect to Redis: %v", pingErr)
+	}
+	defer func() {
+		clientErr := client.Close()
+		if clientErr != nil {
+			log.Printf("Failed to close Redis connection: %v", clientErr)
+		}
+	}()
+
+	// The audit checks characters against the rulebook
+	dndClient, err := dnd5e.New(&dnd5e.Config{
+		HttpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	})
+	if err != nil {
+		log.Fatalf("Failed to create D&D 5e client: %v", err)
+	}
+	rulebookSvc := rulebookService.NewService(&rulebookService.ServiceConfig{
+		DNDClient: dndClient,
+	})
+
+	service := characterService.NewService(&characterService.ServiceConfig{
+		DNDClient:       dndClient,
+		Repository:      charactersRepo.NewRedis(client, rulebookSvc),
+		DraftRepository: characterDraftRepo.NewInMemoryRepository(),
+	})
+
+	var scanned, illegal, repaired, failed int
+	err = charactersRepo.ScanCharacterIDs(ctx, client, func(id string) error {
+		char, getErr := service.GetCharacter(ctx, id)
+		if getErr != nil {
+			fmt.Printf("FAILED   %s: %v\n", id, getErr)
+			failed++
+			return nil
+		}
+		if char.Status == shared.CharacterStatusDraft {
+			return nil
+		}
+		scanned++
+
+		var report *characterService.AuditReport
+		var auditErr error
+		if *repair {
+			report, auditErr = service.RepairCharacter(ctx, &characterService.RepairCharacterInput{CharacterID: id})
+		} else {
+			report, auditErr = service.AuditCharacter(ctx, id)
+		}
+		if auditErr != nil {
+			fmt.Printf("FAILED   %s: %v\n", id, auditErr)
+			failed++
+			return nil
+		}
+
+		if len(report.Repaired) > 0 {
+			repaired++
+			fmt.Printf("REPAIRED %s (%s, owner %s)\n", report.CharacterName, id, report.OwnerID)
+			for _, v := range report.Repaired {
+				fmt.Printf("         - [%s] %s: %s\n", v.Rule, v.Message, v.Repair)
+			}
+		}
+		switch {
+		case !report.Legal():
+			illegal++
+			fmt.Printf("ILLEGAL  %s (%s, owner %s)\n", report.CharacterName, id, report.OwnerID)
+		case *showWarnings && len(report.Violations) > 0:
+			fmt.Printf("WARNINGS %s (%s, owner %s)\n", report.CharacterName, id, report.OwnerID)
+		default:
+			return nil
+		}
+		for _, v := range report.Violations {
+			marker := ""
+			if v.AutoRepairable() {
+				marker = " (auto)"
+			}
+			fmt.Printf("         %-7s [%s] %s -> %s%s\n", v.Severity, v.Rule, v.Message, v.Repair, marker)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatalf("Audit stopped: %v", err)
+	}
+
+	fmt.Printf("\nAudited %d characters. %d repaired, %d still illegal, %d failed.\n",
+		scanned, repaired, illegal, failed)
+	if illegal > 0 || failed > 0 {
+		os.Exit(1)
+	}
+}
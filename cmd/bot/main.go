@@ -24,6 +24,7 @@ import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/gamesessions"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	rulebookService "github.com/KirkDiggler/dnd-bot-discord/internal/services/rulebook"
 )
 
 func main() {
@@ -62,9 +63,15 @@ func main() {
 		log.Fatalf("Failed to create D&D 5e client: %v", err)
 	}
 
+	// The rulebook cache is shared by the services and the character repository
+	rulebookSvc := rulebookService.NewService(&rulebookService.ServiceConfig{
+		DNDClient: dndClient,
+	})
+
 	// Create service provider config
 	providerConfig := &services.ProviderConfig{
-		DNDClient: dndClient,
+		DNDClient:       dndClient,
+		RulebookService: rulebookSvc,
 	}
 
 	// Keep Redis client for cleanup
@@ -93,7 +100,7 @@ func main() {
 				log.Println("Successfully connected to Redis")
 
 				// Create Redis repositories using bounded context constructors
-				providerConfig.CharacterRepository = characters.NewRedis(redisClient)
+				providerConfig.CharacterRepository = characters.NewRedis(redisClient, rulebookSvc)
 				providerConfig.SessionRepository = gamesessions.NewRedis(redisClient)
 
 				log.Println("Using Redis for persistence")
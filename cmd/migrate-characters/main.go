@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	charactersRepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	"github.com/redis/go-redis/v9"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "Report what would change without saving")
+	list := flag.Bool("list", false, "List the registered migrations and exit")
+	flag.Parse()
+
+	if *list {
+		for _, migration := range charactersRepo.Migrations() {
+			fmt.Printf("%3d  %s\n", migration.Version, migration.Description)
+		}
+		return
+	}
+
+	ctx := context.Background()
+
+	// Set up Redis
+	redisURL := os.Getenv("REDIS_URL")
+	if redisURL == "" {
+		redisURL = "redis://localhost:6379/0"
+	}
+
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		log.Fatalf("Failed to parse Redis URL: %v", err)
+	}
+
+	client := redis.NewClient(opts)
+
+	// Test connection first
+	if _, pingErr := client.Ping(ctx).Result(); pingErr != nil {
+		log.Fatalf("Failed to connect to Redis: %v", pingErr)
+	}
+	defer func() {
+		clientErr := client.Close()
+		if clientErr != nil {
+			log.Printf("Failed to close Redis connection: %v", clientErr)
+		}
+	}()
+
+	report, err := charactersRepo.NewMigrator(client).MigrateAll(ctx, *dryRun)
+	for _, result := range report.Results {
+		if result.Err != nil {
+			fmt.Printf("FAILED   %s (v%d): %v\n", result.CharacterID, result.FromVersion, result.Err)
+			continue
+		}
+		fmt.Printf("MIGRATED %s v%d -> v%d: %s\n", result.CharacterID, result.FromVersion,
+			charactersRepo.CurrentSchemaVersion, strings.Join(result.Applied, "; "))
+	}
+	if err != nil {
+		log.Fatalf("Migration stopped: %v", err)
+	}
+
+	verb := "Migrated"
+	if report.DryRun {
+		verb = "Would migrate"
+	}
+	fmt.Printf("\nScanned %d characters. %s %d to schema v%d, %d failed.\n",
+		report.Scanned, verb, report.Migrated, charactersRepo.CurrentSchemaVersion, report.Failed)
+	if report.Failed > 0 {
+		os.Exit(1)
+	}
+}
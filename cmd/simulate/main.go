@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/clients/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	charactersRepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	rulebookService "github.com/KirkDiggler/dnd-bot-discord/internal/services/rulebook"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/simulation"
+)
+
+func main() {
+	partyFiles := flag.String("party", "", "Comma-separated saved character JSON files")
+	presets := flag.String("preset", "", "Comma-separated class:level presets, e.g. fighter:3,rogue:3")
+	monsters := flag.String("monsters", "", "Comma-separated monster:count list, e.g. goblin:4,orc")
+	fixture := flag.String("monster-fixture", "", "JSON file of monster templates (defaults to the D&D 5e API)")
+	spellFixture := flag.String("spell-fixture", "", "JSON file of spells for casters (defaults to the D&D 5e API)")
+	runs := flag.Int("runs", 50, "Number of encounters to simulate")
+	seed := flag.Int64("seed", 0, "Dice seed (0 picks one from the clock)")
+	maxRounds := flag.Int("max-rounds", simulation.DefaultMaxRounds, "Round limit before a fight counts as a stalemate")
+	rules := flag.String("rules", "", "Comma-separated house rules to enable, e.g. flanking,max_dice_crits")
+	verbose := flag.Bool("v", false, "Show combat logging")
+	flag.Parse()
+
+	if !*verbose {
+		log.SetOutput(io.Discard)
+	}
+
+	party, err := loadParty(context.Background(), *partyFiles, *presets)
+	if err != nil {
+		fatalf("Failed to build party: %v", err)
+	}
+
+	source, err := monsterSource(*fixture)
+	if err != nil {
+		fatalf("Failed to set up monster source: %v", err)
+	}
+
+	monsterInputs, err := loadMonsters(source, *monsters)
+	if err != nil {
+		fatalf("Failed to load monsters: %v", err)
+	}
+
+	spells, err := spellSource(*spellFixture)
+	if err != nil {
+		fatalf("Failed to set up spell source: %v", err)
+	}
+
+	houseRules, err := parseHouseRules(*rules)
+	if err != nil {
+		fatalf("Invalid house rules: %v", err)
+	}
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	sim, err := simulation.NewSimulator(&simulation.Config{
+		Party:      party,
+		Monsters:   monsterInputs,
+		Runs:       *runs,
+		Seed:       *seed,
+		MaxRounds:  *maxRounds,
+		HouseRules: houseRules,
+		Spells:     spells,
+	})
+	if err != nil {
+		fatalf("Invalid simulation: %v", err)
+	}
+
+	report, err := sim.Run(context.Background())
+	if err != nil {
+		fatalf("Simulation failed: %v", err)
+	}
+
+	if err := report.Print(os.Stdout); err != nil {
+		fatalf("Failed to print report: %v", err)
+	}
+}
+
+// fatalf prints to stderr since the default logger may be silenced
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+// loadParty builds the party from saved character files and class presets.
+// Saved characters only store their race, class and background keys, so
+// those are loaded from the D&D 5e API.
+func loadParty(ctx context.Context, files, presets string) ([]*character.Character, error) {
+	var party []*character.Character
+
+	var rulebook charactersRepo.RulebookSource
+	for _, path := range splitList(files) {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if rulebook == nil {
+			client, err := newDNDClient()
+			if err != nil {
+				return nil, err
+			}
+			rulebook = rulebookService.NewService(&rulebookService.ServiceConfig{DNDClient: client})
+		}
+		char, err := charactersRepo.LoadCharacter(ctx, raw, rulebook)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		party = append(party, char)
+	}
+
+	for _, spec := range splitList(presets) {
+		class, level, err := parseKeyCount(spec)
+		if err != nil {
+			return nil, err
+		}
+		char, err := simulation.NewPresetCharacter(class, level)
+		if err != nil {
+			return nil, err
+		}
+		party = append(party, char)
+	}
+
+	if len(party) == 0 {
+		return nil, fmt.Errorf("use -party and/or -preset to add characters (presets: %s)",
+			strings.Join(simulation.PresetClasses(), ", "))
+	}
+	return party, nil
+}
+
+// monsterSource returns the fixture if one is given, otherwise the D&D 5e API
+func monsterSource(fixturePath string) (simulation.MonsterSource, error) {
+	if fixturePath != "" {
+		return simulation.LoadMonsterFixture(fixturePath)
+	}
+
+	return newDNDClient()
+}
+
+// spellSource returns the fixture if one is given, otherwise the D&D 5e API
+func spellSource(fixturePath string) (simulation.SpellSource, error) {
+	if fixturePath != "" {
+		return simulation.LoadSpellFixture(fixturePath)
+	}
+
+	return newDNDClient()
+}
+
+func newDNDClient() (dnd5e.Client, error) {
+	return dnd5e.New(&dnd5e.Config{
+		HttpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	})
+}
+
+func loadMonsters(source simulation.MonsterSource, spec string) ([]*encounter.AddMonsterInput, error) {
+	var inputs []*encounter.AddMonsterInput
+
+	for _, entry := range splitList(spec) {
+		key, count, err := parseKeyCount(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		template, err := source.GetMonster(key)
+		if err != nil {
+			return nil, fmt.Errorf("monster '%s': %w", key, err)
+		}
+
+		input := simulation.MonsterFromTemplate(template)
+		if len(input.Actions) == 0 {
+			return nil, fmt.Errorf("monster '%s' has no attacks to simulate", key)
+		}
+		for i := 0; i < count; i++ {
+			inputs = append(inputs, input)
+		}
+	}
+
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("use -monsters to add monsters, e.g. -monsters goblin:4")
+	}
+	return inputs, nil
+}
+
+func parseHouseRules(spec string) (*gameSession.HouseRules, error) {
+	rules := &gameSession.HouseRules{}
+	for _, name := range splitList(spec) {
+		if !rules.Set(gameSession.HouseRule(name), true) {
+			return nil, fmt.Errorf("unknown house rule '%s'", name)
+		}
+	}
+	return rules, nil
+}
+
+// parseKeyCount parses "key:n", where n defaults to 1
+func parseKeyCount(spec string) (string, int, error) {
+	key, countStr, found := strings.Cut(spec, ":")
+	if !found {
+		return key, 1, nil
+	}
+
+	count, err := strconv.Atoi(countStr)
+	if err != nil || count < 1 {
+		return "", 0, fmt.Errorf("invalid number in '%s'", spec)
+	}
+	return key, count, nil
+}
+
+func splitList(spec string) []string {
+	var items []string
+	for _, item := range strings.Split(spec, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
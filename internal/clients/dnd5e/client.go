@@ -275,6 +275,7 @@ func apiToMonsterTemplate(input *apiEntities.Monster) *combat.MonsterTemplate {
 		ArmorClass:      input.ArmorClass,
 		HitPoints:       input.HitPoints,
 		HitDice:         input.HitDice,
+		Dexterity:       input.Dexterity,
 		ChallengeRating: input.ChallengeRating,
 		Actions:         apisToMonsterActions(input.MonsterActions),
 	}
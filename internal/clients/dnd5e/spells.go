@@ -83,11 +83,23 @@ func (c *client) convertSpell(apiSpell *entities.Spell) *rulebook.Spell {
 		spell.Damage = c.convertSpellDamage(apiSpell.SpellDamage)
 	}
 
+	// The API client drops cantrip scaling, so fill it in from the rulebook
+	if spell.Level == 0 {
+		if scaling := rulebook.CantripDamageAtCharacterLevel(spell.Key); scaling != nil {
+			if spell.Damage == nil {
+				spell.Damage = &rulebook.SpellDamage{}
+			}
+			spell.Damage.DamageAtCharacterLevel = scaling
+		}
+	}
+
 	// Convert DC
 	if apiSpell.DC != nil {
 		spell.DC = c.convertSpellDC(apiSpell.DC)
 	}
 
+	spell.AttackType = spellAttackType(spell)
+
 	// Convert area of effect
 	if apiSpell.AreaOfEffect != nil {
 		spell.AreaOfEffect = &rulebook.SpellAreaOfEffect{
@@ -102,6 +114,23 @@ func (c *client) convertSpell(apiSpell *entities.Spell) *rulebook.Spell {
 	return spell
 }
 
+// autoHitSpells deal damage without an attack roll or saving throw
+var autoHitSpells = map[string]bool{
+	"magic-missile": true,
+}
+
+// spellAttackType infers the attack type, which the API client doesn't expose.
+// Damage spells without a saving throw use a spell attack.
+func spellAttackType(spell *rulebook.Spell) string {
+	if spell.Damage == nil || spell.DC != nil || autoHitSpells[spell.Key] {
+		return ""
+	}
+	if strings.EqualFold(spell.Range, "touch") {
+		return rulebook.SpellAttackMelee
+	}
+	return rulebook.SpellAttackRanged
+}
+
 // convertSpellDamage converts API spell damage to domain model
 func (c *client) convertSpellDamage(apiDamage *entities.SpellDamage) *rulebook.SpellDamage {
 	damage := &rulebook.SpellDamage{
@@ -109,6 +109,45 @@ func RollString(diceString string) (*RollResult, error) {
 	return Roll(diceCount, diceSize, bonus)
 }
 
+// ParseNotation parses dice notation like "8d6", "1d4+1" or "3d4 + 3".
+// Only one kind of die is supported; flat terms are summed into the bonus.
+func ParseNotation(notation string) (count, sides, bonus int, err error) {
+	compact := strings.ReplaceAll(notation, " ", "")
+	if compact == "" {
+		return 0, 0, 0, errors.New("empty dice notation")
+	}
+
+	for _, term := range strings.Split(compact, "+") {
+		countStr, sidesStr, isDice := strings.Cut(strings.ToLower(term), "d")
+		if !isDice {
+			value, convErr := strconv.Atoi(term)
+			if convErr != nil {
+				return 0, 0, 0, fmt.Errorf("invalid dice notation %q", notation)
+			}
+			bonus += value
+			continue
+		}
+
+		termCount := 1
+		if countStr != "" {
+			if termCount, err = strconv.Atoi(countStr); err != nil {
+				return 0, 0, 0, fmt.Errorf("invalid dice notation %q", notation)
+			}
+		}
+		termSides, convErr := strconv.Atoi(sidesStr)
+		if convErr != nil || termSides < 1 || termCount < 1 {
+			return 0, 0, 0, fmt.Errorf("invalid dice notation %q", notation)
+		}
+		if sides != 0 && sides != termSides {
+			return 0, 0, 0, fmt.Errorf("mixed dice in notation %q", notation)
+		}
+		sides = termSides
+		count += termCount
+	}
+
+	return count, sides, bonus, nil
+}
+
 func (r *RollResult) String() string {
 	compact := strings.ReplaceAll(fmt.Sprintf("%v", r.Rolls), " ", "")
 	return fmt.Sprintf("**%d** : %s", r.Total-r.Lowest, compact)
@@ -261,3 +261,63 @@ func TestRandomRoller_BasicFunctionality(t *testing.T) {
 		assert.LessOrEqual(t, roll, 20)
 	}
 }
+
+func TestSeededRoller_IsRepeatable(t *testing.T) {
+	first := dice.NewSeededRoller(42)
+	second := dice.NewSeededRoller(42)
+
+	for i := 0; i < 20; i++ {
+		a, err := first.Roll(3, 6, 1)
+		require.NoError(t, err)
+		b, err := second.Roll(3, 6, 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, a.Rolls, b.Rolls, "same seed should give the same rolls")
+		assert.Equal(t, a.RawTotal+1, a.Total)
+	}
+
+	adv, err := first.RollWithAdvantage(20, 0)
+	require.NoError(t, err)
+	assert.Len(t, adv.Rolls, 2, "advantage should roll twice")
+	assert.Equal(t, max(adv.Rolls[0], adv.Rolls[1]), adv.RawTotal)
+
+	dis, err := first.RollWithDisadvantage(20, 0)
+	require.NoError(t, err)
+	assert.Equal(t, min(dis.Rolls[0], dis.Rolls[1]), dis.RawTotal)
+
+	_, err = first.Roll(0, 6, 0)
+	assert.Error(t, err)
+}
+
+func TestParseNotation(t *testing.T) {
+	tests := []struct {
+		notation  string
+		wantCount int
+		wantSides int
+		wantBonus int
+		wantErr   bool
+	}{
+		{notation: "8d6", wantCount: 8, wantSides: 6},
+		{notation: "1d4+1", wantCount: 1, wantSides: 4, wantBonus: 1},
+		{notation: "3d4 + 3", wantCount: 3, wantSides: 4, wantBonus: 3},
+		{notation: "d20", wantCount: 1, wantSides: 20},
+		{notation: "2d6 + 2d6", wantCount: 4, wantSides: 6},
+		{notation: "1d8 + 1d6", wantErr: true},
+		{notation: "fireball", wantErr: true},
+		{notation: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.notation, func(t *testing.T) {
+			count, sides, bonus, err := dice.ParseNotation(tt.notation)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCount, count)
+			assert.Equal(t, tt.wantSides, sides)
+			assert.Equal(t, tt.wantBonus, bonus)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package dice
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+)
+
+// seededRoller implements Roller with its own random source so a sequence of
+// rolls can be reproduced from a seed
+type seededRoller struct {
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// NewSeededRoller creates a dice roller that produces the same rolls for the same seed
+func NewSeededRoller(seed int64) Roller {
+	return &seededRoller{
+		rng: rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (r *seededRoller) roll(count, sides int) ([]int, error) {
+	if count < 1 {
+		return nil, errors.New("invalid dice count")
+	}
+	if sides < 1 {
+		return nil, errors.New("invalid dice size")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rolls := make([]int, count)
+	for i := range rolls {
+		rolls[i] = r.rng.Intn(sides) + 1
+	}
+	return rolls, nil
+}
+
+// Roll implements Roller.Roll
+func (r *seededRoller) Roll(count, sides, bonus int) (*RollResult, error) {
+	rolls, err := r.roll(count, sides)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RollResult{
+		Rolls: rolls,
+		Bonus: bonus,
+		Count: count,
+		Sides: sides,
+	}
+	for i, roll := range rolls {
+		result.RawTotal += roll
+		if i == 0 || roll > result.Highest {
+			result.Highest = roll
+		}
+		if i == 0 || roll < result.Lowest {
+			result.Lowest = roll
+		}
+	}
+	result.Total = result.RawTotal + bonus
+
+	// Check for crit/fumble on d20
+	if count == 1 && sides == 20 {
+		result.IsCrit = rolls[0] == 20
+		result.IsFumble = rolls[0] == 1
+	}
+
+	return result, nil
+}
+
+// RollWithAdvantage implements Roller.RollWithAdvantage
+func (r *seededRoller) RollWithAdvantage(sides, bonus int) (*RollResult, error) {
+	return r.rollTwice(sides, bonus, func(a, b int) bool { return a > b })
+}
+
+// RollWithDisadvantage implements Roller.RollWithDisadvantage
+func (r *seededRoller) RollWithDisadvantage(sides, bonus int) (*RollResult, error) {
+	return r.rollTwice(sides, bonus, func(a, b int) bool { return a < b })
+}
+
+// rollTwice rolls two dice and keeps the one preferred by keep
+func (r *seededRoller) rollTwice(sides, bonus int, keep func(a, b int) bool) (*RollResult, error) {
+	rolls, err := r.roll(2, sides)
+	if err != nil {
+		return nil, err
+	}
+
+	kept := rolls[0]
+	if keep(rolls[1], rolls[0]) {
+		kept = rolls[1]
+	}
+
+	result := &RollResult{
+		Total:    kept + bonus,
+		Rolls:    rolls, // Show both rolls
+		Bonus:    bonus,
+		Count:    1,
+		Sides:    sides,
+		RawTotal: kept,
+	}
+
+	// Check for crit/fumble on d20
+	if sides == 20 {
+		result.IsCrit = kept == 20
+		result.IsFumble = kept == 1
+	}
+
+	return result, nil
+}
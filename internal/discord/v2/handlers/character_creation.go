@@ -8,6 +8,7 @@ import (
 	domainCharacter "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/quickbuild"
 )
 
 // StepHandlerFunc handles rendering for a specific step type
@@ -15,17 +16,19 @@ type StepHandlerFunc func(char *domainCharacter.Character, step *domainCharacter
 
 // CharacterCreationHandler handles the character creation flow
 type CharacterCreationHandler struct {
-	service         character.Service
-	flowService     domainCharacter.CreationFlowService
-	customIDBuilder *core.CustomIDBuilder
-	stepHandlers    map[domainCharacter.CreationStepType]StepHandlerFunc
+	service           character.Service
+	flowService       domainCharacter.CreationFlowService
+	quickBuildService quickbuild.Service
+	customIDBuilder   *core.CustomIDBuilder
+	stepHandlers      map[domainCharacter.CreationStepType]StepHandlerFunc
 }
 
 // CharacterCreationHandlerConfig holds the configuration
 type CharacterCreationHandlerConfig struct {
-	Service         character.Service
-	FlowService     domainCharacter.CreationFlowService
-	CustomIDBuilder *core.CustomIDBuilder
+	Service           character.Service
+	FlowService       domainCharacter.CreationFlowService
+	QuickBuildService quickbuild.Service // Optional, enables the quick build button
+	CustomIDBuilder   *core.CustomIDBuilder
 }
 
 // NewCharacterCreationHandler creates a new character creation handler
@@ -47,10 +50,11 @@ func NewCharacterCreationHandler(cfg *CharacterCreationHandlerConfig) (*Characte
 	}
 
 	h := &CharacterCreationHandler{
-		service:         cfg.Service,
-		flowService:     cfg.FlowService,
-		customIDBuilder: customIDBuilder,
-		stepHandlers:    make(map[domainCharacter.CreationStepType]StepHandlerFunc),
+		service:           cfg.Service,
+		flowService:       cfg.FlowService,
+		quickBuildService: cfg.QuickBuildService,
+		customIDBuilder:   customIDBuilder,
+		stepHandlers:      make(map[domainCharacter.CreationStepType]StepHandlerFunc),
 	}
 
 	// Register all step handlers explicitly
@@ -74,6 +78,8 @@ func (h *CharacterCreationHandler) registerStepHandlers() {
 	h.stepHandlers[domainCharacter.StepTypeClassSelection] = h.handleClassSelection
 	h.stepHandlers[domainCharacter.StepTypeAbilityScores] = h.handleAbilityScores
 	h.stepHandlers[domainCharacter.StepTypeAbilityAssignment] = h.handleAbilityAssignment
+	h.stepHandlers[domainCharacter.StepTypeBackgroundSelection] = h.handleBackgroundSelection
+	h.stepHandlers[domainCharacter.StepTypePersonalitySelection] = h.handlePersonalitySelection
 	h.stepHandlers[domainCharacter.StepTypeProficiencySelection] = h.handleProficiencySelection
 	h.stepHandlers[domainCharacter.StepTypeEquipmentSelection] = h.handleEquipmentSelection
 	h.stepHandlers[domainCharacter.StepTypeCharacterDetails] = h.handleCharacterDetails
@@ -160,8 +166,15 @@ func (h *CharacterCreationHandler) handleSkillSelection(char *domainCharacter.Ch
 }
 
 func (h *CharacterCreationHandler) handleLanguageSelection(char *domainCharacter.Character, step *domainCharacter.CreationStep) (*core.Response, error) {
-	// TODO: Implement language selection UI
-	return nil, fmt.Errorf("language selection UI not implemented")
+	return h.buildEnhancedStepResponse(char, step)
+}
+
+func (h *CharacterCreationHandler) handleBackgroundSelection(char *domainCharacter.Character, step *domainCharacter.CreationStep) (*core.Response, error) {
+	return h.buildEnhancedStepResponse(char, step)
+}
+
+func (h *CharacterCreationHandler) handlePersonalitySelection(char *domainCharacter.Character, step *domainCharacter.CreationStep) (*core.Response, error) {
+	return h.buildEnhancedStepResponse(char, step)
 }
 
 func (h *CharacterCreationHandler) handleSpellSelection(char *domainCharacter.Character, step *domainCharacter.CreationStep) (*core.Response, error) {
@@ -281,15 +294,21 @@ func (h *CharacterCreationHandler) HandleStepSelection(ctx *core.InteractionCont
 		return nil, core.NewInternalError(err)
 	}
 
+	return h.continueToStep(ctx, char.ID, nextStep)
+}
+
+// continueToStep shows the next step after a step result, or finalizes the
+// character when every step is done
+func (h *CharacterCreationHandler) continueToStep(ctx *core.InteractionContext, characterID string, nextStep *domainCharacter.CreationStep) (*core.HandlerResult, error) {
 	// Check if we're done
-	isComplete, err := h.flowService.IsCreationComplete(ctx.Context, char.ID)
+	isComplete, err := h.flowService.IsCreationComplete(ctx.Context, characterID)
 	if err != nil {
 		return nil, core.NewInternalError(err)
 	}
 
 	if isComplete {
 		// Get the updated character
-		updatedChar, updateErr := h.service.GetCharacter(ctx.Context, char.ID)
+		updatedChar, updateErr := h.service.GetCharacter(ctx.Context, characterID)
 		if updateErr != nil {
 			return nil, core.NewInternalError(updateErr)
 		}
@@ -297,7 +316,7 @@ func (h *CharacterCreationHandler) HandleStepSelection(ctx *core.InteractionCont
 	}
 
 	// Get updated character for display
-	updatedChar, err := h.service.GetCharacter(ctx.Context, char.ID)
+	updatedChar, err := h.service.GetCharacter(ctx.Context, characterID)
 	if err != nil {
 		return nil, core.NewInternalError(err)
 	}
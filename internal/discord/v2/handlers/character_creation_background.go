@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/discord/v2/builders"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/discord/v2/core"
+	domainCharacter "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	characterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+)
+
+// buildBackgroundStep shows the backgrounds in a select menu
+func (h *CharacterCreationHandler) buildBackgroundStep(embed *builders.EmbedBuilder, components *builders.ComponentBuilder, char *domainCharacter.Character, step *domainCharacter.CreationStep) {
+	embed.Description(step.Description)
+
+	options := make([]builders.SelectOption, 0, len(step.Options))
+	for _, opt := range step.Options {
+		options = append(options, builders.SelectOption{
+			Label:       opt.Name,
+			Value:       opt.Key,
+			Description: opt.Description,
+			Emoji:       "📜",
+		})
+	}
+	components.SelectMenuWithTarget("Choose a background...", "select", char.ID, options)
+}
+
+// buildLanguageStep shows the languages the step lets the character learn
+func (h *CharacterCreationHandler) buildLanguageStep(embed *builders.EmbedBuilder, components *builders.ComponentBuilder, char *domainCharacter.Character, step *domainCharacter.CreationStep) {
+	embed.Description(step.Description)
+
+	options := make([]builders.SelectOption, 0, len(step.Options))
+	for _, opt := range step.Options {
+		options = append(options, builders.SelectOption{
+			Label:       opt.Name,
+			Value:       opt.Key,
+			Description: opt.Description,
+		})
+	}
+
+	placeholder := "Choose languages..."
+	if p, ok := step.Context["placeholder"].(string); ok {
+		placeholder = p
+	}
+	components.SelectMenuWithTarget(placeholder, "select", char.ID, options, builders.SelectConfig{
+		MinValues: step.MinChoices,
+		MaxValues: step.MaxChoices,
+	})
+}
+
+// buildPersonalityStep shows one personality table at a time, in book
+// order, with buttons to roll it or everything still open
+func (h *CharacterCreationHandler) buildPersonalityStep(embed *builders.EmbedBuilder, components *builders.ComponentBuilder, char *domainCharacter.Character, step *domainCharacter.CreationStep) {
+	embed.Description(step.Description)
+
+	for _, table := range rulebook.PersonalityTables {
+		if picks := char.Personality.Get(table); len(picks) > 0 {
+			embed.AddField(table.DisplayName(), strings.Join(picks, "\n"), false)
+		}
+	}
+
+	var table rulebook.PersonalityTable
+	for _, t := range rulebook.PersonalityTables {
+		if !char.Personality.IsTableComplete(t) {
+			table = t
+			break
+		}
+	}
+	if table == "" {
+		return
+	}
+
+	var options []builders.SelectOption
+	for _, opt := range step.Options {
+		if opt.Metadata["table"] != string(table) {
+			continue
+		}
+		options = append(options, builders.SelectOption{
+			Label: truncateLabel(opt.Name),
+			Value: opt.Key,
+		})
+	}
+
+	placeholder := fmt.Sprintf("Choose your %s...", strings.ToLower(table.DisplayName()))
+	if table.Picks() > 1 {
+		placeholder = fmt.Sprintf("Choose %d %s...", table.Picks(), strings.ToLower(table.DisplayName()))
+	}
+	components.SelectMenuWithTargetAndArgs(placeholder, "select", char.ID, []string{string(table)}, options, builders.SelectConfig{
+		MinValues: table.Picks(),
+		MaxValues: table.Picks(),
+	})
+	components.NewRow()
+	components.PrimaryButton(fmt.Sprintf("🎲 Roll %s", table.DisplayName()), "personality_roll", char.ID, string(table))
+	components.SecondaryButton("🎲 Roll Everything", "personality_roll", char.ID)
+}
+
+// HandlePersonalityRoll rolls one personality table, or every table that
+// hasn't been picked from yet
+func (h *CharacterCreationHandler) HandlePersonalityRoll(ctx *core.InteractionContext) (*core.HandlerResult, error) {
+	customID, err := core.ParseCustomID(ctx.GetCustomID())
+	if err != nil {
+		return nil, core.NewValidationError("Invalid selection")
+	}
+
+	char, err := h.service.GetCharacter(ctx.Context, customID.Target)
+	if err != nil {
+		return nil, core.NewInternalError(err)
+	}
+	if char.OwnerID != ctx.UserID {
+		return nil, core.NewForbiddenError("You can only edit your own characters")
+	}
+
+	selection := characterService.PersonalityRoll
+	if len(customID.Args) > 0 {
+		selection = characterService.PersonalityRoll + "-" + customID.Args[0]
+	}
+
+	nextStep, err := h.flowService.ProcessStepResult(ctx.Context, char.ID, &domainCharacter.CreationStepResult{
+		StepType:   domainCharacter.StepTypePersonalitySelection,
+		Selections: []string{selection},
+	})
+	if err != nil {
+		return nil, core.NewInternalError(err)
+	}
+
+	return h.continueToStep(ctx, char.ID, nextStep)
+}
+
+// buildBackgroundSummary describes the background for the character summary
+func buildBackgroundSummary(char *domainCharacter.Character) string {
+	if char.Background == nil {
+		return ""
+	}
+
+	lines := []string{fmt.Sprintf("**📜 Background:** %s", char.Background.Name)}
+
+	var skills []string
+	for _, prof := range char.Background.SkillProficiencies {
+		skills = append(skills, strings.TrimPrefix(prof.Name, "Skill: "))
+	}
+	for _, prof := range char.Background.ToolProficiencies {
+		skills = append(skills, prof.Name)
+	}
+	if len(skills) > 0 {
+		lines = append(lines, fmt.Sprintf("• **Proficiencies:** %s", strings.Join(skills, ", ")))
+	}
+	if languages := languageNames(char.GetBackgroundLanguages()); len(languages) > 0 {
+		lines = append(lines, fmt.Sprintf("• **Languages:** %s", strings.Join(languages, ", ")))
+	}
+	if char.Background.Feature != nil {
+		lines = append(lines, fmt.Sprintf("• **Feature:** %s", char.Background.Feature.Name))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// languageNames turns language keys into their names
+func languageNames(keys []string) []string {
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if language, ok := rulebook.GetLanguage(key); ok {
+			names = append(names, language.Name)
+		} else {
+			names = append(names, key)
+		}
+	}
+	return names
+}
+
+// truncateLabel shortens text to the 100 characters a select option label allows
+func truncateLabel(text string) string {
+	if len(text) <= 100 {
+		return text
+	}
+	return text[:97] + "..."
+}
@@ -13,6 +13,7 @@ import (
 	domainCharacter "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
 	characterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
 	"github.com/bwmarrin/discordgo"
 )
@@ -58,6 +59,9 @@ func (h *CharacterCreationHandler) buildEnhancedStepResponse(char *domainCharact
 			// Random race button
 			components.NewRow()
 			components.SecondaryButton("🎲 Random Race", "race_random", char.ID)
+			if h.quickBuildService != nil {
+				components.PrimaryButton("⚡ Quick Build", "quick_start", char.ID)
+			}
 
 			// Add select menu with enhanced descriptions
 			options := make([]builders.SelectOption, 0, len(step.Options))
@@ -114,7 +118,11 @@ func (h *CharacterCreationHandler) buildEnhancedStepResponse(char *domainCharact
 		}
 
 	case domainCharacter.StepTypeAbilityScores:
-		embed.Description("Time to determine your character's abilities! You can roll for random scores or use the standard array.")
+		embed.Description("Time to determine your character's abilities! You can roll for random scores, use the standard array or buy them with points.")
+		if required, ok := step.Context["required_method"].(string); ok {
+			embed.Description(fmt.Sprintf("Time to determine your character's abilities! Your session uses **%s**.",
+				shared.AbilityScoreMethod(required).DisplayName()))
+		}
 
 		// Show current race/class bonuses that will apply
 		if char.Race != nil {
@@ -129,8 +137,11 @@ func (h *CharacterCreationHandler) buildEnhancedStepResponse(char *domainCharact
 			}
 		}
 
-		components.PrimaryButton("🎲 Roll Ability Scores", "roll", char.ID)
-		components.SecondaryButton("📊 Use Standard Array", "standard", char.ID)
+		allowed := make([]shared.AbilityScoreMethod, 0, len(step.Options))
+		for _, opt := range step.Options {
+			allowed = append(allowed, shared.AbilityScoreMethod(opt.Key))
+		}
+		h.addAbilityMethodButtons(components, char.ID, allowed)
 		components.NewRow()
 		components.SecondaryButton("❓ About Ability Scores", "ability_info", char.ID)
 		components.DangerButton("🗑️ Start Fresh", "start_fresh", char.ID)
@@ -158,6 +169,15 @@ func (h *CharacterCreationHandler) buildEnhancedStepResponse(char *domainCharact
 		components.SuccessButton("🤖 Auto-Assign & Continue", "auto_assign_and_continue", char.ID)
 		components.PrimaryButton("📝 Manual Assignment", "start_manual_assignment", char.ID)
 
+	case domainCharacter.StepTypeBackgroundSelection:
+		h.buildBackgroundStep(embed, components, char, step)
+
+	case domainCharacter.StepTypeLanguageSelection:
+		h.buildLanguageStep(embed, components, char, step)
+
+	case domainCharacter.StepTypePersonalitySelection:
+		h.buildPersonalityStep(embed, components, char, step)
+
 	case domainCharacter.StepTypeProficiencySelection:
 		embed.Description("Choose your character's proficiencies. These determine what skills and tools you're trained in.")
 		components.PrimaryButton("🛠️ Choose Proficiencies", "proficiencies", char.ID)
@@ -259,6 +279,11 @@ func (h *CharacterCreationHandler) buildProgressSummary(char *domainCharacter.Ch
 		sections = append(sections, "**⚔️ Class:** *Not selected*")
 	}
 
+	// Background Section
+	if background := buildBackgroundSummary(char); background != "" {
+		sections = append(sections, background)
+	}
+
 	// Ability Scores Section
 	if len(char.Attributes) > 0 {
 		var abilitySection []string
@@ -470,6 +495,8 @@ func (h *CharacterCreationHandler) buildDynamicProgressTracker(char *domainChara
 		{domainCharacter.StepTypeCantripsSelection, "Cantrips", "✨", []string{"wizard", "cleric", "sorcerer", "warlock", "bard", "druid"}},
 		{domainCharacter.StepTypeSpellbookSelection, "Spells", "📖", []string{"wizard"}},
 		{domainCharacter.StepTypeSpellsKnownSelection, "Spells", "🌟", []string{"sorcerer", "bard", "warlock"}},
+		{domainCharacter.StepTypeBackgroundSelection, "Background", "📜", nil},
+		{domainCharacter.StepTypePersonalitySelection, "Personality", "🎭", nil},
 		{domainCharacter.StepTypeProficiencySelection, "Proficiencies", "🛠️", nil},
 		{domainCharacter.StepTypeEquipmentSelection, "Equipment", "🎒", nil},
 		{domainCharacter.StepTypeCharacterDetails, "Name & Finalize", "📝", nil},
@@ -560,6 +587,8 @@ func (h *CharacterCreationHandler) buildDynamicProgressTracker(char *domainChara
 				stepDisplay = "🎲 Rolled"
 			case domainCharacter.StepTypeAbilityAssignment:
 				stepDisplay = "📊 Assigned"
+			case domainCharacter.StepTypeBackgroundSelection:
+				stepDisplay = "📜 " + char.Background.Name
 			case domainCharacter.StepTypeCantripsSelection:
 				if char.Spells != nil && len(char.Spells.Cantrips) > 0 {
 					stepDisplay = fmt.Sprintf("✨ %d Cantrips", len(char.Spells.Cantrips))
@@ -625,6 +654,10 @@ func (h *CharacterCreationHandler) isStepComplete(char *domainCharacter.Characte
 		return char.Class != nil
 	case domainCharacter.StepTypeAbilityScores, domainCharacter.StepTypeAbilityAssignment:
 		return len(char.Attributes) == 6
+	case domainCharacter.StepTypeBackgroundSelection:
+		return char.Background != nil
+	case domainCharacter.StepTypePersonalitySelection:
+		return char.Personality.IsComplete()
 	case domainCharacter.StepTypeCantripsSelection:
 		// Check for confirmation marker
 		for _, feature := range char.Features {
@@ -714,6 +747,8 @@ func (h *CharacterCreationHandler) buildMockAllSteps(char *domainCharacter.Chara
 
 	// Add universal end steps
 	steps = append(steps,
+		domainCharacter.CreationStep{Type: domainCharacter.StepTypeBackgroundSelection},
+		domainCharacter.CreationStep{Type: domainCharacter.StepTypePersonalitySelection},
 		domainCharacter.CreationStep{Type: domainCharacter.StepTypeProficiencySelection},
 		domainCharacter.CreationStep{Type: domainCharacter.StepTypeEquipmentSelection},
 		domainCharacter.CreationStep{Type: domainCharacter.StepTypeCharacterDetails},
@@ -1081,6 +1116,9 @@ func (h *CharacterCreationHandler) HandleRollAbilityScores(ctx *core.Interaction
 
 	_, err = h.service.UpdateDraftCharacter(ctx.Context, char.ID, updateInput)
 	if err != nil {
+		if dnderr.IsInvalidArgument(err) {
+			return nil, core.NewValidationError(err.Error())
+		}
 		return nil, core.NewInternalError(err)
 	}
 
@@ -1397,7 +1435,7 @@ func (h *CharacterCreationHandler) HandleStandardArray(ctx *core.InteractionCont
 	// Build components for assignment
 	components := builders.NewComponentBuilder(h.customIDBuilder)
 	components.PrimaryButton("📝 Assign Scores", "assign_standard_array", char.ID)
-	components.SecondaryButton("🎲 Roll Instead", "roll", char.ID)
+	components.SecondaryButton("⬅️ Other Methods", "back_to_abilities", char.ID)
 	components.NewRow()
 	components.SecondaryButton("❓ About Standard Array", "ability_info", char.ID)
 
@@ -1445,6 +1483,9 @@ func (h *CharacterCreationHandler) HandleAbilityInfo(ctx *core.InteractionContex
 	embed.AddField("📊 Standard Array",
 		"• Use predetermined scores: 15, 14, 13, 12, 10, 8\n• Assign them to abilities as you choose\n• More balanced and predictable\n• Total: 72", false)
 
+	embed.AddField("🧮 Point Buy",
+		"• Every score starts at 8\n• Spend 27 points to raise them, up to 15\n• 9-13 cost 1 point each, 14 and 15 cost 2\n• Build exactly the character you want", false)
+
 	// Explain ability score effects
 	embed.AddField("💪 What Abilities Do",
 		"• **Strength**: Melee attacks, Athletics, carrying capacity\n• **Dexterity**: Ranged attacks, AC, Stealth, Initiative\n• **Constitution**: Hit points, concentration saves\n• **Intelligence**: Spells (Wizard), Investigation, History\n• **Wisdom**: Spells (Cleric/Druid), Perception, Insight\n• **Charisma**: Spells (Bard/Sorcerer), social skills", false)
@@ -1453,10 +1494,21 @@ func (h *CharacterCreationHandler) HandleAbilityInfo(ctx *core.InteractionContex
 	embed.AddField("🔢 Ability Modifiers",
 		"• Score 8-9 = -1 modifier\n• Score 10-11 = +0 modifier\n• Score 12-13 = +1 modifier\n• Score 14-15 = +2 modifier\n• Score 16-17 = +3 modifier\n• Score 18-19 = +4 modifier", false)
 
+	// Only offer the methods the user's session allows
+	required, err := h.service.RequiredAbilityScoreMethod(ctx.Context, ctx.UserID)
+	if err != nil {
+		return nil, core.NewInternalError(err)
+	}
+	var allowed []shared.AbilityScoreMethod
+	for _, method := range shared.AbilityScoreMethods {
+		if required.Allows(method) {
+			allowed = append(allowed, method)
+		}
+	}
+
 	// Build components
 	components := builders.NewComponentBuilder(h.customIDBuilder)
-	components.PrimaryButton("🎲 Roll Ability Scores", "roll", char.ID)
-	components.SecondaryButton("📊 Use Standard Array", "standard", char.ID)
+	h.addAbilityMethodButtons(components, char.ID, allowed)
 	components.NewRow()
 	components.SecondaryButton("⬅️ Back", "back_to_abilities", char.ID)
 
@@ -1522,6 +1574,9 @@ func (h *CharacterCreationHandler) HandleConfirmRolledScores(ctx *core.Interacti
 
 	_, err = h.service.UpdateDraftCharacter(ctx.Context, char.ID, updateInput)
 	if err != nil {
+		if dnderr.IsInvalidArgument(err) {
+			return nil, core.NewValidationError(err.Error())
+		}
 		return nil, core.NewInternalError(err)
 	}
 
@@ -1564,11 +1619,15 @@ func (h *CharacterCreationHandler) HandleAssignStandardArray(ctx *core.Interacti
 
 	// Store the standard array in the character
 	updateInput := &characterService.UpdateDraftInput{
-		AbilityRolls: abilityRolls,
+		AbilityRolls:  abilityRolls,
+		AbilityMethod: shared.AbilityScoreMethodStandardArray,
 	}
 
 	_, err = h.service.UpdateDraftCharacter(ctx.Context, char.ID, updateInput)
 	if err != nil {
+		if dnderr.IsInvalidArgument(err) {
+			return nil, core.NewValidationError(err.Error())
+		}
 		return nil, core.NewInternalError(err)
 	}
 
@@ -1915,6 +1974,9 @@ func (h *CharacterCreationHandler) HandleRollSingleAbility(ctx *core.Interaction
 
 	_, err = h.service.UpdateDraftCharacter(ctx.Context, char.ID, updateInput)
 	if err != nil {
+		if dnderr.IsInvalidArgument(err) {
+			return nil, core.NewValidationError(err.Error())
+		}
 		return nil, core.NewInternalError(err)
 	}
 
@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/discord/v2/builders"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/discord/v2/core"
+	domainCharacter "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	characterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/bwmarrin/discordgo"
+)
+
+// pointBuyScores holds the bought scores in shared.Attributes order. It is
+// carried between clicks in the custom ID as one digit per ability, so no
+// half-finished point buy is ever saved.
+type pointBuyScores [6]int
+
+func newPointBuyScores() pointBuyScores {
+	var scores pointBuyScores
+	for i := range scores {
+		scores[i] = rulebook.PointBuyMin
+	}
+	return scores
+}
+
+// encode stores each score as its distance above the minimum, e.g. "000000"
+func (p pointBuyScores) encode() string {
+	var sb strings.Builder
+	for _, score := range p {
+		sb.WriteString(strconv.Itoa(score - rulebook.PointBuyMin))
+	}
+	return sb.String()
+}
+
+func decodePointBuyScores(state string) (pointBuyScores, error) {
+	var scores pointBuyScores
+	if len(state) != len(scores) {
+		return scores, fmt.Errorf("invalid point buy state %q", state)
+	}
+	for i, c := range state {
+		if c < '0' || c > '0'+rulebook.PointBuyMax-rulebook.PointBuyMin {
+			return scores, fmt.Errorf("invalid point buy state %q", state)
+		}
+		scores[i] = rulebook.PointBuyMin + int(c-'0')
+	}
+	return scores, nil
+}
+
+func (p pointBuyScores) remaining() int {
+	return rulebook.PointBuyBudget - rulebook.PointBuyTotal(p[:])
+}
+
+// HandlePointBuy starts a point buy with every score at 8
+func (h *CharacterCreationHandler) HandlePointBuy(ctx *core.InteractionContext) (*core.HandlerResult, error) {
+	char, err := h.getPointBuyCharacter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return h.buildPointBuyResponse(char, newPointBuyScores())
+}
+
+// HandlePointBuyAdjust raises or lowers one score by a point
+func (h *CharacterCreationHandler) HandlePointBuyAdjust(ctx *core.InteractionContext) (*core.HandlerResult, error) {
+	customID, err := core.ParseCustomID(ctx.GetCustomID())
+	if err != nil || len(customID.Args) < 3 {
+		return nil, core.NewValidationError("Invalid selection")
+	}
+
+	char, err := h.getPointBuyCharacter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := decodePointBuyScores(customID.Args[0])
+	if err != nil {
+		return nil, core.NewValidationError("Invalid point buy")
+	}
+	index, err := strconv.Atoi(customID.Args[1])
+	if err != nil || index < 0 || index >= len(scores) {
+		return nil, core.NewValidationError("Invalid ability")
+	}
+
+	// Buttons are disabled at the limits, so out of range clicks are stale and ignored
+	adjusted := scores
+	if customID.Args[2] == "up" {
+		adjusted[index]++
+	} else {
+		adjusted[index]--
+	}
+	if rulebook.ValidatePointBuy(adjusted[:]) == nil {
+		scores = adjusted
+	}
+
+	return h.buildPointBuyResponse(char, scores)
+}
+
+// HandlePointBuyConfirm saves the point buy and moves on to the next step
+func (h *CharacterCreationHandler) HandlePointBuyConfirm(ctx *core.InteractionContext) (*core.HandlerResult, error) {
+	customID, err := core.ParseCustomID(ctx.GetCustomID())
+	if err != nil || len(customID.Args) < 1 {
+		return nil, core.NewValidationError("Invalid selection")
+	}
+
+	char, err := h.getPointBuyCharacter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := decodePointBuyScores(customID.Args[0])
+	if err != nil {
+		return nil, core.NewValidationError("Invalid point buy")
+	}
+
+	input := &characterService.PointBuyInput{
+		CharacterID: char.ID,
+		Scores:      make(map[shared.Attribute]int, len(scores)),
+	}
+	for i, attr := range shared.Attributes {
+		input.Scores[attr] = scores[i]
+	}
+
+	updated, err := h.service.ApplyPointBuy(ctx.Context, input)
+	if err != nil {
+		if dnderr.IsInvalidArgument(err) {
+			return nil, core.NewValidationError(err.Error())
+		}
+		return nil, core.NewInternalError(err)
+	}
+
+	nextStep, err := h.flowService.GetCurrentStep(ctx.Context, updated.ID)
+	if err != nil {
+		return nil, core.NewInternalError(err)
+	}
+
+	response, err := h.buildEnhancedStepResponse(updated, nextStep)
+	if err != nil {
+		return nil, err
+	}
+	response.AsUpdate()
+
+	return &core.HandlerResult{
+		Response: response,
+	}, nil
+}
+
+// addAbilityMethodButtons adds a button for each allowed ability score method
+func (h *CharacterCreationHandler) addAbilityMethodButtons(components *builders.ComponentBuilder, characterID string, allowed []shared.AbilityScoreMethod) {
+	for _, method := range allowed {
+		switch method {
+		case shared.AbilityScoreMethodRoll:
+			components.PrimaryButton("🎲 Roll Ability Scores", "roll", characterID)
+		case shared.AbilityScoreMethodStandardArray:
+			components.SecondaryButton("📊 Use Standard Array", "standard", characterID)
+		case shared.AbilityScoreMethodPointBuy:
+			components.SecondaryButton("🧮 Point Buy", "point_buy", characterID)
+		}
+	}
+}
+
+// getPointBuyCharacter loads the draft and checks the user may point buy for it
+func (h *CharacterCreationHandler) getPointBuyCharacter(ctx *core.InteractionContext) (*domainCharacter.Character, error) {
+	customID, err := core.ParseCustomID(ctx.GetCustomID())
+	if err != nil {
+		return nil, core.NewValidationError("Invalid selection")
+	}
+
+	char, err := h.service.GetCharacter(ctx.Context, customID.Target)
+	if err != nil {
+		return nil, core.NewInternalError(err)
+	}
+
+	if char.OwnerID != ctx.UserID {
+		return nil, core.NewForbiddenError("You can only edit your own characters")
+	}
+
+	required, err := h.service.RequiredAbilityScoreMethod(ctx.Context, ctx.UserID)
+	if err != nil {
+		return nil, core.NewInternalError(err)
+	}
+	if !required.Allows(shared.AbilityScoreMethodPointBuy) {
+		return nil, core.NewValidationError(fmt.Sprintf("Your session requires %s for ability scores", required.DisplayName()))
+	}
+
+	return char, nil
+}
+
+// buildPointBuyResponse shows each score with its cost and +/- buttons for it
+func (h *CharacterCreationHandler) buildPointBuyResponse(char *domainCharacter.Character, scores pointBuyScores) (*core.HandlerResult, error) {
+	remaining := scores.remaining()
+
+	racial := make(map[shared.Attribute]int)
+	if char.Race != nil {
+		for _, bonus := range char.Race.AbilityBonuses {
+			racial[bonus.Attribute] += bonus.Bonus
+		}
+	}
+
+	var lines []string
+	for i, attr := range shared.Attributes {
+		line := fmt.Sprintf("**%s** %2d • cost %d", getAbilityAbbrev(string(attr)), scores[i], rulebook.PointBuyCost(scores[i]))
+		if bonus := racial[attr]; bonus != 0 {
+			line += fmt.Sprintf(" • %+d racial → **%d**", bonus, scores[i]+bonus)
+		}
+		lines = append(lines, line)
+	}
+
+	embed := builders.NewEmbed().
+		Title("🧮 Point Buy").
+		Color(builders.ColorPrimary).
+		Description(fmt.Sprintf("Spend **%d** points on your abilities. Every score starts at %d and can go up to %d before racial bonuses.",
+			rulebook.PointBuyBudget, rulebook.PointBuyMin, rulebook.PointBuyMax)).
+		AddField("📊 Scores", strings.Join(lines, "\n"), false).
+		AddField("💰 Points Remaining", fmt.Sprintf("**%d** / %d", remaining, rulebook.PointBuyBudget), true).
+		AddField("📈 Costs", "9-13: 1 point each\n14 and 15: 2 points each", true)
+
+	if char.Class != nil {
+		if primary := getClassPrimaryAbilities(char.Class.Key); primary != "" {
+			embed.AddField("💡 Class Recommendations", primary, false)
+		}
+	}
+
+	state := scores.encode()
+	components := builders.NewComponentBuilder(h.customIDBuilder)
+	for i, attr := range shared.Attributes {
+		// Two abilities per row
+		if i%2 == 0 {
+			components.NewRow()
+		}
+		abbrev := getAbilityAbbrev(string(attr))
+		index := strconv.Itoa(i)
+
+		if scores[i] > rulebook.PointBuyMin {
+			components.SecondaryButton("➖ "+abbrev, "point_buy_adjust", char.ID, state, index, "down")
+		} else {
+			components.DisabledButton("➖ "+abbrev, discordgo.SecondaryButton)
+		}
+
+		next := scores[i] + 1
+		if next <= rulebook.PointBuyMax && rulebook.PointBuyCost(next)-rulebook.PointBuyCost(scores[i]) <= remaining {
+			components.PrimaryButton("➕ "+abbrev, "point_buy_adjust", char.ID, state, index, "up")
+		} else {
+			components.DisabledButton("➕ "+abbrev, discordgo.PrimaryButton)
+		}
+	}
+
+	components.NewRow()
+	confirmLabel := "✅ Confirm Scores"
+	if remaining > 0 {
+		confirmLabel = fmt.Sprintf("✅ Confirm (%d unspent)", remaining)
+	}
+	components.SuccessButton(confirmLabel, "point_buy_confirm", char.ID, state)
+	components.SecondaryButton("🔄 Reset", "point_buy", char.ID)
+	components.SecondaryButton("⬅️ Back", "back_to_abilities", char.ID)
+
+	response := core.NewResponse("").
+		WithEmbeds(embed.Build()).
+		WithComponents(components.Build()...).
+		AsUpdate()
+
+	return &core.HandlerResult{
+		Response: response,
+	}, nil
+}
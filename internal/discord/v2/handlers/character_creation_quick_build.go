@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/discord/v2/builders"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/discord/v2/core"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/quickbuild"
+)
+
+// HandleQuickStart shows the classes that have a PHB quick build
+func (h *CharacterCreationHandler) HandleQuickStart(ctx *core.InteractionContext) (*core.HandlerResult, error) {
+	if h.quickBuildService == nil {
+		return nil, core.NewValidationError("Quick build is not available")
+	}
+
+	customID, err := core.ParseCustomID(ctx.GetCustomID())
+	if err != nil {
+		return nil, core.NewValidationError("Invalid selection")
+	}
+
+	char, err := h.service.GetCharacter(ctx.Context, customID.Target)
+	if err != nil {
+		return nil, core.NewInternalError(err)
+	}
+	if char.OwnerID != ctx.UserID {
+		return nil, core.NewForbiddenError("You can only edit your own characters")
+	}
+
+	embed := builders.NewEmbed().
+		Title("⚡ Quick Build").
+		Color(builders.ColorPrimary).
+		Description("Pick a class and get a finished 1st level character built from the Player's Handbook quick build advice: " +
+			"race, ability scores, skills, equipment and spells are all chosen for you.")
+
+	classes := rulebook.QuickBuildClasses()
+	options := make([]builders.SelectOption, 0, len(classes))
+	for _, classKey := range classes {
+		quick, _ := rulebook.GetQuickBuild(classKey)
+		options = append(options, builders.SelectOption{
+			Label:       fmt.Sprintf("%s %s", getClassEmoji(classKey), titleCase(classKey)),
+			Value:       classKey,
+			Description: fmt.Sprintf("%s %s", titleCase(quick.RaceKey), titleCase(quick.BackgroundKey)),
+		})
+	}
+
+	components := builders.NewComponentBuilder(h.customIDBuilder)
+	components.SelectMenuWithTarget("Choose a class...", "quick_start_class", char.ID, options)
+	components.NewRow()
+	components.SecondaryButton("⬅️ Back", "back", char.ID)
+
+	response := core.NewResponse("").
+		WithEmbeds(embed.Build()).
+		WithComponents(components.Build()...).
+		AsUpdate()
+
+	return &core.HandlerResult{
+		Response: response,
+	}, nil
+}
+
+// HandleQuickStartClass builds and finalizes the quick build for the chosen class
+func (h *CharacterCreationHandler) HandleQuickStartClass(ctx *core.InteractionContext) (*core.HandlerResult, error) {
+	if h.quickBuildService == nil {
+		return nil, core.NewValidationError("Quick build is not available")
+	}
+
+	var classKey string
+	if ctx.IsComponent() && ctx.Interaction != nil {
+		if values := ctx.Interaction.MessageComponentData().Values; len(values) > 0 {
+			classKey = values[0]
+		}
+	}
+	if classKey == "" {
+		return nil, core.NewValidationError("No class selected")
+	}
+
+	char, err := h.quickBuildService.QuickBuild(ctx.Context, &quickbuild.BuildInput{
+		UserID:   ctx.UserID,
+		RealmID:  ctx.GuildID,
+		ClassKey: classKey,
+	})
+	if err != nil {
+		if dnderr.IsInvalidArgument(err) {
+			return nil, core.NewValidationError(err.Error())
+		}
+		return nil, core.NewInternalError(err)
+	}
+
+	embed := builders.SuccessEmbed(
+		"Character Created!",
+		fmt.Sprintf("Your quick build character **%s** is ready to adventure!", char.Name),
+	).
+		AddField("Race", char.Race.Name, true).
+		AddField("Class", char.Class.Name, true).
+		AddField("Level", fmt.Sprintf("%d", char.Level), true).
+		AddField("Hit Points", fmt.Sprintf("%d/%d", char.CurrentHitPoints, char.MaxHitPoints), true).
+		AddField("Armor Class", fmt.Sprintf("%d", char.AC), true).
+		Build()
+
+	response := core.NewResponse("").
+		WithEmbeds(embed).
+		WithComponents().
+		AsUpdate()
+
+	return &core.HandlerResult{
+		Response: response,
+	}, nil
+}
+
+// titleCase turns a key like "half-elf" into "Half-Elf"
+func titleCase(key string) string {
+	parts := strings.Split(key, "-")
+	for i, part := range parts {
+		if part != "" {
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	return strings.Join(parts, "-")
+}
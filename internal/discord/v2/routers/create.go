@@ -48,9 +48,10 @@ func NewCreateRouter(cfg *CreateRouterConfig) (*CreateRouter, error) {
 
 	// Create the handler with the creation domain
 	creationConfig := &handlers.CharacterCreationHandlerConfig{
-		Service:         cfg.Provider.CharacterService,
-		FlowService:     cfg.Provider.CreationFlowService,
-		CustomIDBuilder: router.GetCustomIDBuilder(),
+		Service:           cfg.Provider.CharacterService,
+		FlowService:       cfg.Provider.CreationFlowService,
+		QuickBuildService: cfg.Provider.QuickBuildService,
+		CustomIDBuilder:   router.GetCustomIDBuilder(),
 	}
 
 	creationHandler, err := handlers.NewCharacterCreationHandler(creationConfig)
@@ -93,6 +94,8 @@ func (r *CreateRouter) registerRoutes() {
 	r.router.ComponentFunc("race_random", r.creationHandler.HandleRandomRace)
 	r.router.ComponentFunc("preview_race", r.creationHandler.HandleRacePreview)
 	r.router.ComponentFunc("confirm_race", r.creationHandler.HandleConfirmRace)
+	r.router.ComponentFunc("quick_start", r.creationHandler.HandleQuickStart)
+	r.router.ComponentFunc("quick_start_class", r.creationHandler.HandleQuickStartClass)
 
 	// Class selection handlers
 	r.router.ComponentFunc("class_overview", r.creationHandler.HandleClassOverview)
@@ -109,6 +112,12 @@ func (r *CreateRouter) registerRoutes() {
 	r.router.ComponentFunc("start_ability_assignment", r.creationHandler.HandleStartAbilityAssignment)
 	r.router.ComponentFunc("start_fresh", r.creationHandler.HandleStartFresh)
 	r.router.ComponentFunc("back_to_abilities", r.creationHandler.HandleBackToAbilities)
+	r.router.ComponentFunc("point_buy", r.creationHandler.HandlePointBuy)
+	r.router.ComponentFunc("point_buy_adjust", r.creationHandler.HandlePointBuyAdjust)
+	r.router.ComponentFunc("point_buy_confirm", r.creationHandler.HandlePointBuyConfirm)
+
+	// Background handlers
+	r.router.ComponentFunc("personality_roll", r.creationHandler.HandlePersonalityRoll)
 
 	// Manual assignment handlers
 	r.router.ComponentFunc("auto_assign_and_continue", r.creationHandler.HandleAutoAssignAndContinue)
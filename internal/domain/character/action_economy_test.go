@@ -303,3 +303,62 @@ func TestCharacter_NonMonkNoMartialArts(t *testing.T) {
 	// No martial arts bonus action available
 	assert.Empty(t, fighter.Resources.ActionEconomy.AvailableBonusActions)
 }
+
+func TestCharacter_SpellActionEconomy(t *testing.T) {
+	newCaster := func() *Character {
+		char := &Character{
+			ID:    "test-wizard",
+			Level: 5,
+			Class: &rulebook.Class{Key: "wizard", HitDie: 6},
+		}
+		char.InitializeResources()
+		return char
+	}
+
+	t.Run("bonus action spell limits the action to a cantrip", func(t *testing.T) {
+		char := newCaster()
+		char.RecordSpellCast("healing-word", 1, rulebook.CastingTimeBonusAction)
+
+		ok, _ := char.CanCastSpellThisTurn(0, rulebook.CastingTimeAction)
+		assert.True(t, ok, "Action cantrip should still be allowed")
+
+		ok, reason := char.CanCastSpellThisTurn(1, rulebook.CastingTimeAction)
+		assert.False(t, ok, "Leveled action spell should be blocked")
+		assert.Contains(t, reason, "cantrip")
+
+		ok, _ = char.CanCastSpellThisTurn(1, rulebook.CastingTimeBonusAction)
+		assert.False(t, ok, "Bonus action is already used")
+	})
+
+	t.Run("leveled action spell blocks bonus action spells", func(t *testing.T) {
+		char := newCaster()
+		char.RecordSpellCast("magic-missile", 1, rulebook.CastingTimeAction)
+
+		assert.True(t, char.Resources.ActionEconomy.ActionUsed)
+		ok, _ := char.CanCastSpellThisTurn(1, rulebook.CastingTimeBonusAction)
+		assert.False(t, ok)
+	})
+
+	t.Run("action cantrip still allows a bonus action spell", func(t *testing.T) {
+		char := newCaster()
+		char.RecordSpellCast("fire-bolt", 0, rulebook.CastingTimeAction)
+
+		ok, _ := char.CanCastSpellThisTurn(1, rulebook.CastingTimeBonusAction)
+		assert.True(t, ok)
+	})
+
+	t.Run("long casting times can't be used in combat", func(t *testing.T) {
+		char := newCaster()
+		ok, _ := char.CanCastSpellThisTurn(1, "1 minute")
+		assert.False(t, ok)
+	})
+
+	t.Run("new turn clears spell tracking", func(t *testing.T) {
+		char := newCaster()
+		char.RecordSpellCast("healing-word", 1, rulebook.CastingTimeBonusAction)
+		char.StartNewTurn()
+
+		ok, _ := char.CanCastSpellThisTurn(1, rulebook.CastingTimeAction)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,272 @@
+package character
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/effects"
+)
+
+// MaxAttunedItems is how many magic items a character can be attuned to
+const MaxAttunedItems = 3
+
+// itemEffectPrefix prefixes the IDs of effects granted by equipped magic items
+const itemEffectPrefix = "equipped_item:"
+
+// IsAttuned checks if the character is attuned to at least one copy of an item
+func (c *Character) IsAttuned(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Contains(c.Attuned, key)
+}
+
+// AttunedCopies returns how many copies of an item the character is attuned
+// to. Attuned holds one entry per attuned copy.
+func (c *Character) AttunedCopies(key string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.attunedCopies(key)
+}
+
+func (c *Character) attunedCopies(key string) int {
+	count := 0
+	for _, attuned := range c.Attuned {
+		if attuned == key {
+			count++
+		}
+	}
+	return count
+}
+
+// Attune attunes the character to a copy of a magic item in their inventory
+// they aren't attuned to yet
+func (c *Character) Attune(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item := c.getEquipment(key)
+	if item == nil {
+		return fmt.Errorf("%s isn't in the inventory", key)
+	}
+
+	magic := equipment.Magic(item)
+	if magic == nil || !magic.RequiresAttunement {
+		return fmt.Errorf("%s doesn't require attunement", item.GetName())
+	}
+	if c.attunedCopies(key) >= c.countEquipment(key) {
+		return fmt.Errorf("already attuned to %s", item.GetName())
+	}
+	if len(c.Attuned) >= MaxAttunedItems {
+		return errors.New("already attuned to the maximum of 3 items")
+	}
+
+	c.Attuned = append(c.Attuned, key)
+	c.refreshItemEffects()
+	c.calculateAC()
+	return nil
+}
+
+// Unattune ends the character's attunement to one copy of an item
+func (c *Character) Unattune(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	index := slices.Index(c.Attuned, key)
+	if index == -1 {
+		return false
+	}
+
+	c.Attuned = slices.Delete(c.Attuned, index, index+1)
+	c.refreshItemEffects()
+	c.calculateAC()
+	return true
+}
+
+// ItemCharges returns the charges left across every copy of an item the
+// character carries
+func (c *Character) ItemCharges(key string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	charges := 0
+	for _, items := range c.Inventory {
+		for _, item := range items {
+			if magic := equipment.Magic(item); item.GetKey() == key && magic != nil {
+				charges += magic.Charges
+			}
+		}
+	}
+	return charges
+}
+
+// ChargedItems returns the carried magic items that cast a spell by
+// spending a charge, one per key, sorted by name
+func (c *Character) ChargedItems() []equipment.Equipment {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	byKey := make(map[string]equipment.Equipment)
+	for _, items := range c.Inventory {
+		for _, item := range items {
+			magic := equipment.Magic(item)
+			if item == nil || magic == nil || magic.Spell == "" || magic.MaxCharges == 0 {
+				continue
+			}
+			if _, ok := byKey[item.GetKey()]; !ok {
+				byKey[item.GetKey()] = item
+			}
+		}
+	}
+
+	charged := make([]equipment.Equipment, 0, len(byKey))
+	for _, item := range byKey {
+		charged = append(charged, item)
+	}
+	slices.SortFunc(charged, func(a, b equipment.Equipment) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	return charged
+}
+
+// UseItemCharge spends one of a carried item's charges, returning the
+// item's magic so the caller can report what's left
+func (c *Character) UseItemCharge(key string) (*equipment.MagicProperties, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item := c.getEquipment(key)
+	if item == nil {
+		return nil, fmt.Errorf("%s isn't in the inventory", key)
+	}
+	// Spend from a copy that still has charges
+	for _, carried := range c.Inventory[item.GetEquipmentType()] {
+		if magic := equipment.Magic(carried); carried.GetKey() == key && magic != nil && magic.Charges > 0 {
+			item = carried
+			break
+		}
+	}
+
+	magic := equipment.Magic(item)
+	if magic == nil || magic.MaxCharges == 0 {
+		return nil, fmt.Errorf("%s has no charges", item.GetName())
+	}
+	if magic.RequiresAttunement && !slices.Contains(c.Attuned, key) {
+		return nil, fmt.Errorf("attune to %s before using it", item.GetName())
+	}
+	if !magic.UseCharge() {
+		return nil, fmt.Errorf("%s has no charges left", item.GetName())
+	}
+	return magic, nil
+}
+
+// Unequip empties a slot, returning the item that was in it
+func (c *Character) Unequip(slot shared.Slot) equipment.Equipment {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	defer c.calculateAC()
+
+	item := c.EquippedSlots[slot]
+	if item == nil {
+		return nil
+	}
+
+	c.EquippedSlots[slot] = nil
+	c.refreshItemEffects()
+	return item
+}
+
+// RefreshItemEffects rebuilds the effects granted by equipped magic items.
+// Call it after changing EquippedSlots or Attuned directly.
+func (c *Character) RefreshItemEffects() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.refreshItemEffects()
+	c.calculateAC()
+}
+
+// equippedSlotOrder is the order equipped items claim attunements in when
+// the character carries more copies of an item than they're attuned to
+var equippedSlotOrder = []shared.Slot{
+	shared.SlotMainHand, shared.SlotOffHand, shared.SlotTwoHanded, shared.SlotBody,
+	shared.SlotNeck, shared.SlotCloak, shared.SlotRing, shared.SlotSecondRing,
+}
+
+// refreshItemEffects replaces the item effects in the effect manager with
+// ones for the currently equipped magic items (caller must hold lock).
+// An item that needs attunement only works if the character is attuned to
+// that copy; each attunement covers one equipped copy.
+func (c *Character) refreshItemEffects() {
+	manager := c.getEffectManagerInternal()
+	changed := false
+
+	for _, effect := range manager.GetActiveEffects() {
+		if strings.HasPrefix(effect.ID, itemEffectPrefix) {
+			manager.RemoveEffect(effect.ID)
+			changed = true
+		}
+	}
+
+	attunements := make(map[string]int, len(c.Attuned))
+	for _, key := range c.Attuned {
+		attunements[key]++
+	}
+
+	for _, slot := range equippedSlotOrder {
+		item := c.EquippedSlots[slot]
+		magic := equipment.Magic(item)
+		if item == nil || magic == nil {
+			continue
+		}
+		if magic.RequiresAttunement {
+			if attunements[item.GetKey()] == 0 {
+				continue
+			}
+			attunements[item.GetKey()]--
+		}
+
+		effect := equipment.MagicEffect(item)
+		if effect == nil {
+			continue
+		}
+		effect.ID = itemEffectPrefix + string(slot)
+		if err := manager.AddEffect(effect); err != nil {
+			log.Printf("Failed to add effect for %s: %v", item.GetName(), err)
+			continue
+		}
+		changed = true
+	}
+
+	if changed {
+		c.syncEffectManagerToResources()
+	}
+}
+
+// EffectBonus returns the total flat bonus active effects give a target,
+// such as +1 AC from a ring of protection. Dice and advantage modifiers
+// are left to the rolls that use them.
+func (c *Character) EffectBonus(target effects.ModifierTarget, conditions map[string]string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.effectBonus(target, conditions)
+}
+
+// effectBonus returns the flat effect bonus without locking (caller must hold lock)
+func (c *Character) effectBonus(target effects.ModifierTarget, conditions map[string]string) int {
+	total := 0
+	for _, mod := range c.getEffectManagerInternal().GetModifiers(target, conditions) {
+		if value, err := strconv.Atoi(mod.Value); err == nil {
+			total += value
+		}
+	}
+	return total
+}
@@ -0,0 +1,134 @@
+package character
+
+import (
+	"testing"
+
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/damage"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/effects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAdventurer creates a character with Dexterity 10 carrying the given items
+func newAdventurer(items ...equipment.Equipment) *Character {
+	char := &Character{
+		Level: 1,
+		Attributes: map[shared.Attribute]*AbilityScore{
+			shared.AttributeStrength:  {Score: 10},
+			shared.AttributeDexterity: {Score: 10},
+		},
+	}
+	for _, item := range items {
+		char.AddInventory(item)
+	}
+	return char
+}
+
+func TestCharacter_MagicItemNeedsAttunement(t *testing.T) {
+	char := newAdventurer(equipment.NewSRDMagicItem("ring-of-protection"))
+
+	require.True(t, char.Equip("ring-of-protection"))
+	assert.Equal(t, 10, char.AC, "the ring does nothing until attuned")
+	assert.Equal(t, 0, char.GetSavingThrowBonus(shared.AttributeDexterity))
+
+	require.NoError(t, char.Attune("ring-of-protection"))
+	assert.Equal(t, 11, char.AC)
+	assert.Equal(t, 1, char.GetSavingThrowBonus(shared.AttributeDexterity))
+
+	require.NotNil(t, char.Unequip(shared.SlotRing))
+	assert.Equal(t, 10, char.AC)
+	assert.True(t, char.IsAttuned("ring-of-protection"), "attunement survives unequipping")
+}
+
+func TestCharacter_AttunementLimit(t *testing.T) {
+	items := make([]equipment.Equipment, 0, MaxAttunedItems+1)
+	for _, key := range []string{"ring-a", "ring-b", "ring-c", "ring-d"} {
+		ring := equipment.NewSRDMagicItem("ring-of-protection")
+		ring.Base.Key = key
+		items = append(items, ring)
+	}
+	char := newAdventurer(items...)
+
+	require.NoError(t, char.Attune("ring-a"))
+	require.NoError(t, char.Attune("ring-b"))
+	require.NoError(t, char.Attune("ring-c"))
+	assert.Error(t, char.Attune("ring-d"))
+	assert.Error(t, char.Attune("ring-a"), "can't attune twice")
+
+	assert.True(t, char.Unattune("ring-b"))
+	assert.NoError(t, char.Attune("ring-d"))
+	assert.False(t, char.Unattune("ring-b"))
+}
+
+func TestCharacter_TwoCopiesOfARing(t *testing.T) {
+	char := newAdventurer(equipment.NewSRDMagicItem("ring-of-protection"), equipment.NewSRDMagicItem("ring-of-protection"))
+
+	require.True(t, char.Equip("ring-of-protection"))
+	require.True(t, char.Equip("ring-of-protection"))
+	require.NotNil(t, char.EquippedSlots[shared.SlotRing])
+	require.NotNil(t, char.EquippedSlots[shared.SlotSecondRing], "the second ring goes on the other hand")
+	assert.NotSame(t, char.EquippedSlots[shared.SlotRing], char.EquippedSlots[shared.SlotSecondRing])
+
+	require.NoError(t, char.Attune("ring-of-protection"))
+	assert.Equal(t, 11, char.AC, "one attunement covers one ring")
+	require.NoError(t, char.Attune("ring-of-protection"))
+	assert.Equal(t, 12, char.AC)
+	assert.Equal(t, 2, char.AttunedCopies("ring-of-protection"))
+	assert.Error(t, char.Attune("ring-of-protection"), "both copies are attuned")
+
+	assert.True(t, char.Unattune("ring-of-protection"))
+	assert.Equal(t, 11, char.AC)
+	assert.True(t, char.IsAttuned("ring-of-protection"))
+}
+
+func TestCharacter_WandCharges(t *testing.T) {
+	wand := equipment.NewSRDMagicItem("wand-of-magic-missiles")
+	char := newAdventurer(wand)
+
+	for range 6 {
+		_, err := char.UseItemCharge("wand-of-magic-missiles")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, char.ItemCharges("wand-of-magic-missiles"))
+
+	roller := mockdice.NewManualMockRoller()
+	roller.SetRolls([]int{3})
+	char.WithDiceRoller(roller)
+	char.LongRest(false)
+	assert.Equal(t, 5, wand.Magic.Charges, "a long rest restores 1d6+1 charges")
+
+	roller.SetRolls([]int{6})
+	char.LongRest(false)
+	assert.Equal(t, 7, wand.Magic.Charges, "charges stop at the maximum")
+
+	wand.Magic.Charges = 0
+	_, err := char.UseItemCharge("wand-of-magic-missiles")
+	assert.Error(t, err, "no charges left")
+}
+
+func TestCharacter_AttuneRequiresAttunableItem(t *testing.T) {
+	char := newAdventurer(equipment.NewSRDMagicItem("wand-of-magic-missiles"))
+
+	assert.Error(t, char.Attune("wand-of-magic-missiles"))
+	assert.Error(t, char.Attune("missing"))
+}
+
+func TestCharacter_MagicWeaponBonus(t *testing.T) {
+	sword := equipment.NewMagicWeapon(&equipment.Weapon{
+		Base:           equipment.BasicEquipment{Key: "longsword", Name: "Longsword"},
+		Damage:         &damage.Damage{DiceCount: 1, DiceSize: 8, DamageType: damage.TypeSlashing},
+		WeaponCategory: "Martial",
+		WeaponRange:    "Melee",
+	}, 1)
+	char := newAdventurer(sword)
+	require.True(t, char.Equip(sword.GetKey()))
+
+	assert.Equal(t, "+1 Longsword", sword.GetName())
+	assert.Equal(t, 1, char.EffectBonus(effects.TargetAttackRoll, map[string]string{"weapon": sword.GetKey()}))
+	assert.Equal(t, 0, char.EffectBonus(effects.TargetAttackRoll, map[string]string{"weapon": "dagger"}),
+		"the bonus only applies to attacks with the sword")
+	assert.Equal(t, 10, char.AC)
+}
@@ -0,0 +1,75 @@
+package character
+
+import (
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+)
+
+// SetBackground sets the character's background and replaces the feature it
+// grants. Personality picks come from the background's tables, so they're
+// cleared when the background changes.
+func (c *Character) SetBackground(background *rulebook.Background) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Background == nil || c.Background.ID != background.ID {
+		c.Personality = nil
+	}
+	c.Background = background
+
+	features := make([]*rulebook.CharacterFeature, 0, len(c.Features)+1)
+	for _, feature := range c.Features {
+		if feature != nil && feature.Type != rulebook.FeatureTypeBackground {
+			features = append(features, feature)
+		}
+	}
+	c.Features = append(features, newBackgroundFeature(background))
+}
+
+// GetBackgroundFeature returns the feature granted by the character's
+// background, or nil
+func (c *Character) GetBackgroundFeature() *rulebook.CharacterFeature {
+	for _, feature := range c.Features {
+		if feature != nil && feature.Type == rulebook.FeatureTypeBackground {
+			return feature
+		}
+	}
+	return nil
+}
+
+// GetBackgroundLanguages returns the languages chosen for the background
+func (c *Character) GetBackgroundLanguages() []string {
+	feature := c.GetBackgroundFeature()
+	if feature == nil || feature.Metadata == nil {
+		return nil
+	}
+
+	// Check both []string and []interface{} since JSON unmarshaling can produce either
+	switch languages := feature.Metadata["languages"].(type) {
+	case []string:
+		return languages
+	case []any:
+		keys := make([]string, 0, len(languages))
+		for _, language := range languages {
+			if key, ok := language.(string); ok {
+				keys = append(keys, key)
+			}
+		}
+		return keys
+	default:
+		return nil
+	}
+}
+
+func newBackgroundFeature(background *rulebook.Background) *rulebook.CharacterFeature {
+	feature := &rulebook.CharacterFeature{
+		Key:    background.FeatureKey(),
+		Name:   background.Name,
+		Type:   rulebook.FeatureTypeBackground,
+		Source: background.Name,
+	}
+	if background.Feature != nil {
+		feature.Name = background.Feature.Name
+		feature.Description = background.Feature.Description
+	}
+	return feature
+}
@@ -0,0 +1,63 @@
+package character
+
+import (
+	"testing"
+
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetBackground_ReplacesFeature(t *testing.T) {
+	char := &Character{
+		Features: []*rulebook.CharacterFeature{
+			{Key: "second_wind", Type: rulebook.FeatureTypeClass},
+		},
+	}
+
+	char.SetBackground(rulebook.GetSRDBackground("acolyte"))
+	char.SetBackground(rulebook.GetSRDBackground("soldier"))
+
+	require.Len(t, char.Features, 2)
+	feature := char.GetBackgroundFeature()
+	require.NotNil(t, feature)
+	assert.Equal(t, "background_soldier", feature.Key)
+	assert.Equal(t, "Military Rank", feature.Name)
+	assert.Equal(t, "Soldier", feature.Source)
+}
+
+func TestSetBackground_ClearsPersonalityWhenChanged(t *testing.T) {
+	char := &Character{}
+	char.SetBackground(rulebook.GetSRDBackground("sage"))
+	char.Personality = &Personality{Ideal: "Knowledge."}
+
+	char.SetBackground(rulebook.GetSRDBackground("sage"))
+	assert.NotNil(t, char.Personality, "same background keeps the personality")
+
+	char.SetBackground(rulebook.GetSRDBackground("hermit"))
+	assert.Nil(t, char.Personality)
+}
+
+func TestGetBackgroundLanguages_FromJSON(t *testing.T) {
+	char := &Character{}
+	char.SetBackground(rulebook.GetSRDBackground("acolyte"))
+	char.GetBackgroundFeature().Metadata = map[string]any{
+		"languages": []any{"elvish", "dwarvish"},
+	}
+
+	assert.Equal(t, []string{"elvish", "dwarvish"}, char.GetBackgroundLanguages())
+}
+
+func TestPersonality_IsComplete(t *testing.T) {
+	var personality *Personality
+	assert.False(t, personality.IsComplete())
+
+	personality = &Personality{}
+	personality.Set(rulebook.PersonalityTableTraits, []string{"Calm.", "Curious."})
+	personality.Set(rulebook.PersonalityTableIdeals, []string{"Faith."})
+	personality.Set(rulebook.PersonalityTableBonds, []string{"My temple."})
+	assert.False(t, personality.IsComplete())
+
+	personality.Set(rulebook.PersonalityTableFlaws, []string{"Stubborn."})
+	assert.True(t, personality.IsComplete())
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	"log"
+	"maps"
+	"slices"
 	"strings"
 	"sync"
 
@@ -27,6 +29,7 @@ type Character struct {
 	Speed              int
 	Race               *rulebook.Race
 	Class              *rulebook.Class
+	Classes            []*ClassLevel // Level in each class, starting class first; may be empty for a single class
 	Background         *rulebook.Background
 	Attributes         map[shared.Attribute]*AbilityScore
 	Rolls              []*dice.RollResult
@@ -47,6 +50,15 @@ type Character struct {
 
 	EquippedSlots map[shared.Slot]equipment.Equipment
 
+	// Attuned holds the keys of the magic items the character is attuned to
+	Attuned []string `json:"attuned,omitempty"`
+
+	// Wallet holds the character's coins
+	Wallet shared.Wallet `json:"wallet"`
+
+	// Personality holds the traits, ideal, bond and flaw from the background
+	Personality *Personality `json:"personality,omitempty"`
+
 	Status shared.CharacterStatus `json:"status"`
 
 	// Resources tracks HP, abilities, spell slots, etc
@@ -55,6 +67,9 @@ type Character struct {
 	// Spells tracks known/prepared spells
 	Spells *SpellList `json:"spells,omitempty"`
 
+	// LevelUp holds the choices for a level-up in progress
+	LevelUp *LevelUpProgress `json:"level_up,omitempty"`
+
 	// EffectManager tracks all active status effects
 	EffectManager *effects.Manager `json:"-"`
 
@@ -85,6 +100,14 @@ func (c *Character) getDiceRoller() dice.Roller {
 	return c.diceRoller
 }
 
+// GetEquipment finds an item in the inventory by key
+func (c *Character) GetEquipment(key string) equipment.Equipment {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.getEquipment(key)
+}
+
 func (c *Character) getEquipment(key string) equipment.Equipment {
 	for _, v := range c.Inventory {
 		for _, eq := range v {
@@ -97,13 +120,57 @@ func (c *Character) getEquipment(key string) equipment.Equipment {
 	return nil
 }
 
+// countEquipment returns how many copies of an item the character carries
+func (c *Character) countEquipment(key string) int {
+	count := 0
+	for _, items := range c.Inventory {
+		for _, item := range items {
+			if item.GetKey() == key {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// CountEquipment returns how many copies of an item the character carries
+func (c *Character) CountEquipment(key string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.countEquipment(key)
+}
+
+// getUnequipped returns a carried copy of an item that isn't equipped,
+// falling back to any copy when they all are
+func (c *Character) getUnequipped(key string) equipment.Equipment {
+	for _, items := range c.Inventory {
+		for _, item := range items {
+			if item.GetKey() != key {
+				continue
+			}
+			equipped := false
+			for _, worn := range c.EquippedSlots {
+				if worn == item {
+					equipped = true
+					break
+				}
+			}
+			if !equipped {
+				return item
+			}
+		}
+	}
+	return c.getEquipment(key)
+}
+
 // Equip equips the item if it is found in the inventory, otherwise it is a noop
 func (c *Character) Equip(key string) bool {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	defer c.calculateAC()
 
-	equipmentValue := c.getEquipment(key)
+	equipmentValue := c.getUnequipped(key)
 	if equipmentValue == nil {
 		return false
 	}
@@ -114,7 +181,8 @@ func (c *Character) Equip(key string) bool {
 
 	c.EquippedSlots[shared.SlotTwoHanded] = nil
 
-	switch equipmentValue.GetSlot() {
+	slot := equipmentValue.GetSlot()
+	switch slot {
 	case shared.SlotMainHand:
 		if c.EquippedSlots[shared.SlotMainHand] != nil {
 			c.EquippedSlots[shared.SlotOffHand] = c.EquippedSlots[shared.SlotMainHand]
@@ -122,9 +190,15 @@ func (c *Character) Equip(key string) bool {
 	case shared.SlotTwoHanded:
 		c.EquippedSlots[shared.SlotMainHand] = nil
 		c.EquippedSlots[shared.SlotOffHand] = nil
+	case shared.SlotRing:
+		// A second ring goes on the other hand; a third replaces the first
+		if c.EquippedSlots[shared.SlotRing] != nil && c.EquippedSlots[shared.SlotSecondRing] == nil {
+			slot = shared.SlotSecondRing
+		}
 	}
 
-	c.EquippedSlots[equipmentValue.GetSlot()] = equipmentValue
+	c.EquippedSlots[slot] = equipmentValue
+	c.refreshItemEffects()
 
 	return true
 }
@@ -174,6 +248,9 @@ func (c *Character) calculateAC() {
 
 	// Apply fighting style bonuses to AC
 	c.applyFightingStyleAC()
+
+	// Apply AC bonuses from active effects, such as magic items
+	c.AC += c.effectBonus(effects.TargetAC, nil)
 }
 
 // applyFightingStyleAC applies AC bonuses from fighting styles
@@ -234,8 +311,16 @@ func (c *Character) initializeClassAbilities() {
 		c.Resources.Abilities = make(map[string]*shared.ActiveAbility)
 	}
 
+	// Multiclass characters get the abilities of each class at its class level
+	for _, classLevel := range c.ClassLevels() {
+		c.initializeAbilitiesForClass(classLevel.Class.Key, classLevel.Level)
+	}
+}
+
+// initializeAbilitiesForClass adds the abilities of one class at a class level
+func (c *Character) initializeAbilitiesForClass(classKey string, level int) {
 	// Add class-specific abilities based on class key
-	switch c.Class.Key {
+	switch classKey {
 	case "barbarian":
 		c.Resources.Abilities[shared.AbilityKeyRage] = &shared.ActiveAbility{
 			Key:           shared.AbilityKeyRage,
@@ -243,8 +328,8 @@ func (c *Character) initializeClassAbilities() {
 			Description:   "Enter a battle fury gaining damage bonus and resistance",
 			FeatureKey:    "barbarian-rage",
 			ActionType:    shared.AbilityTypeBonusAction,
-			UsesMax:       2, // 2 uses at level 1
-			UsesRemaining: 2,
+			UsesMax:       rageUses(level),
+			UsesRemaining: rageUses(level),
 			RestType:      shared.RestTypeLong,
 			Duration:      10, // 10 rounds (1 minute)
 		}
@@ -281,8 +366,8 @@ func (c *Character) initializeClassAbilities() {
 			Description:   "Heal wounds with a pool of hit points equal to 5 × paladin level",
 			FeatureKey:    "paladin-lay-on-hands",
 			ActionType:    shared.AbilityTypeAction,
-			UsesMax:       5 * c.Level, // 5 HP per level
-			UsesRemaining: 5 * c.Level,
+			UsesMax:       5 * level, // 5 HP per paladin level
+			UsesRemaining: 5 * level,
 			RestType:      shared.RestTypeLong,
 			Duration:      0, // Instant effect
 		}
@@ -325,6 +410,98 @@ func (c *Character) initializeClassAbilities() {
 		// Natural Explorer is also passive but doesn't have mechanical effects yet
 		// TODO: Implement Natural Explorer when terrain tracking is added
 	}
+
+	c.initializeAbilitiesForSubclass(c.SubclassKeyFor(classKey), level)
+}
+
+// initializeAbilitiesForSubclass adds the limited-use abilities a subclass
+// grants from 3rd level in its class
+func (c *Character) initializeAbilitiesForSubclass(subclassKey string, level int) {
+	if level < 3 {
+		return
+	}
+
+	switch subclassKey {
+	case "berserker":
+		c.Resources.Abilities[shared.AbilityKeyFrenzy] = &shared.ActiveAbility{
+			Key:           shared.AbilityKeyFrenzy,
+			Name:          "Frenzy",
+			Description:   "While raging, make a melee weapon attack as a bonus action each turn; suffer a level of exhaustion when the rage ends",
+			FeatureKey:    "frenzy",
+			ActionType:    shared.AbilityTypeFree,
+			UsesMax:       1, // The exhaustion only wears off with a long rest
+			UsesRemaining: 1,
+			RestType:      shared.RestTypeLong,
+			Duration:      10, // Lasts as long as the rage
+		}
+	case "battle-master":
+		dice := superiorityDice(level)
+		c.Resources.Abilities[shared.AbilityKeySuperiorityDice] = &shared.ActiveAbility{
+			Key:           shared.AbilityKeySuperiorityDice,
+			Name:          "Superiority Dice",
+			Description:   "Spend a superiority die to fuel a combat maneuver",
+			FeatureKey:    "combat_superiority",
+			ActionType:    shared.AbilityTypeFree,
+			UsesMax:       dice,
+			UsesRemaining: dice,
+			RestType:      shared.RestTypeShort,
+			Duration:      0, // Instant effect
+		}
+	case "devotion":
+		c.Resources.Abilities[shared.AbilityKeySacredWeapon] = &shared.ActiveAbility{
+			Key:           shared.AbilityKeySacredWeapon,
+			Name:          "Sacred Weapon",
+			Description:   "Channel Divinity: add your Charisma modifier to attack rolls with a weapon for 1 minute",
+			FeatureKey:    "sacred_weapon",
+			ActionType:    shared.AbilityTypeAction,
+			UsesMax:       1, // One Channel Divinity per rest
+			UsesRemaining: 1,
+			RestType:      shared.RestTypeShort,
+			Duration:      10, // 10 rounds (1 minute)
+		}
+	case "vengeance":
+		c.Resources.Abilities[shared.AbilityKeyVowOfEnmity] = &shared.ActiveAbility{
+			Key:           shared.AbilityKeyVowOfEnmity,
+			Name:          "Vow of Enmity",
+			Description:   "Channel Divinity: gain advantage on attack rolls against a creature within 10 feet for 1 minute",
+			FeatureKey:    "vow_of_enmity",
+			ActionType:    shared.AbilityTypeBonusAction,
+			UsesMax:       1, // One Channel Divinity per rest
+			UsesRemaining: 1,
+			RestType:      shared.RestTypeShort,
+			Duration:      10, // 10 rounds (1 minute)
+		}
+	}
+}
+
+// superiorityDice returns how many superiority dice a battle master has:
+// four, plus one at 7th and 15th level
+func superiorityDice(level int) int {
+	switch {
+	case level >= 15:
+		return 6
+	case level >= 7:
+		return 5
+	default:
+		return 4
+	}
+}
+
+// rageUses returns how many times a barbarian can rage per long rest.
+// Unlimited rage at 20th level is treated as the 17th level maximum.
+func rageUses(level int) int {
+	switch {
+	case level >= 17:
+		return 6
+	case level >= 12:
+		return 5
+	case level >= 6:
+		return 4
+	case level >= 3:
+		return 3
+	default:
+		return 2
+	}
 }
 
 func (c *Character) AddAttribute(attr shared.Attribute, score int) {
@@ -360,6 +537,11 @@ func (c *Character) AddInventory(e equipment.Equipment) {
 	}
 
 	c.mu.Lock()
+	if stack, ok := e.(*equipment.Consumable); ok {
+		c.addConsumable(stack)
+		c.mu.Unlock()
+		return
+	}
 	if c.Inventory[e.GetEquipmentType()] == nil {
 		c.Inventory[e.GetEquipmentType()] = make([]equipment.Equipment, 0)
 	}
@@ -386,7 +568,7 @@ func (c *Character) NameString() string {
 
 func (c *Character) StatsString() string {
 	msg := strings.Builder{}
-	msg.WriteString(fmt.Sprintf("  -  Speed: %d\n", c.Speed))
+	msg.WriteString(fmt.Sprintf("  -  Speed: %d\n", c.EffectiveSpeed(false)))
 	msg.WriteString(fmt.Sprintf("  -  Hit Die: %d\n", c.HitDie))
 	msg.WriteString(fmt.Sprintf("  -  AC: %d\n", c.AC))
 	msg.WriteString(fmt.Sprintf("  -  Max Hit Points: %d\n", c.MaxHitPoints))
@@ -432,7 +614,7 @@ func (c *Character) String() string {
 	}
 	msg.WriteString("\n")
 	msg.WriteString("\n**Stats**:\n")
-	msg.WriteString(fmt.Sprintf("  -  Speed: %d\n", c.Speed))
+	msg.WriteString(fmt.Sprintf("  -  Speed: %d\n", c.EffectiveSpeed(false)))
 	msg.WriteString(fmt.Sprintf("  -  Hit Die: %d\n", c.HitDie))
 	msg.WriteString(fmt.Sprintf("  -  AC: %d\n", c.AC))
 	msg.WriteString(fmt.Sprintf("  -  Max Hit Points: %d\n", c.MaxHitPoints))
@@ -518,6 +700,7 @@ func (c *Character) resetClassFeatures() {
 
 func (c *Character) resetBackground() {
 	c.Background = nil
+	c.Personality = nil
 	// Clear all downstream data
 	c.Proficiencies = make(map[rulebook.ProficiencyType][]*rulebook.Proficiency)
 	c.Inventory = make(map[equipment.EquipmentType][]equipment.Equipment)
@@ -551,6 +734,7 @@ func (c *Character) Clone() *Character {
 		// ProficiencyBonus:  c.ProficiencyBonus,
 		Status:     c.Status,
 		Background: c.Background,
+		Wallet:     c.Wallet,
 		// Alignment:         c.Alignment,
 		// Age:               c.Age,
 		// Height:            c.Height,
@@ -560,6 +744,7 @@ func (c *Character) Clone() *Character {
 		// Hair:              c.Hair,
 		// Backstory:         c.Backstory,
 		// Portrait:          c.Portrait,
+		diceRoller: c.diceRoller,
 		// Note: mu sync.Mutex is not copied - new instance gets its own
 	}
 
@@ -575,6 +760,19 @@ func (c *Character) Clone() *Character {
 		clone.Class = &classCopy
 	}
 
+	// Deep copy class levels
+	for _, classLevel := range c.Classes {
+		if classLevel == nil {
+			continue
+		}
+		levelCopy := *classLevel
+		if levelCopy.Class != nil {
+			classCopy := *levelCopy.Class
+			levelCopy.Class = &classCopy
+		}
+		clone.Classes = append(clone.Classes, &levelCopy)
+	}
+
 	// Deep copy Attributes map
 	clone.Attributes = make(map[shared.Attribute]*AbilityScore)
 	for k, v := range c.Attributes {
@@ -584,6 +782,10 @@ func (c *Character) Clone() *Character {
 		}
 	}
 
+	// Copy the ability rolls characters from before attributes still rely on
+	clone.AbilityRolls = slices.Clone(c.AbilityRolls)
+	clone.AbilityAssignments = maps.Clone(c.AbilityAssignments)
+
 	// Deep copy Inventory map
 	clone.Inventory = make(map[equipment.EquipmentType][]equipment.Equipment)
 	for k, v := range c.Inventory {
@@ -632,6 +834,40 @@ func (c *Character) Clone() *Character {
 	// 	}
 	// }
 
+	// Deep copy Spells
+	if c.Spells != nil {
+		clone.Spells = &SpellList{
+			KnownSpells:    append([]string(nil), c.Spells.KnownSpells...),
+			PreparedSpells: append([]string(nil), c.Spells.PreparedSpells...),
+			Cantrips:       append([]string(nil), c.Spells.Cantrips...),
+
+			CanChangePrepared: c.Spells.CanChangePrepared,
+		}
+	}
+
+	// Deep copy LevelUp
+	if c.LevelUp != nil {
+		levelUp := *c.LevelUp
+		levelUp.Cantrips = append([]string(nil), c.LevelUp.Cantrips...)
+		levelUp.Spells = append([]string(nil), c.LevelUp.Spells...)
+		if c.LevelUp.AbilityIncreases != nil {
+			levelUp.AbilityIncreases = make(map[shared.Attribute]int, len(c.LevelUp.AbilityIncreases))
+			for attr, increase := range c.LevelUp.AbilityIncreases {
+				levelUp.AbilityIncreases[attr] = increase
+			}
+		}
+		clone.LevelUp = &levelUp
+	}
+
+	clone.Attuned = append([]string(nil), c.Attuned...)
+
+	// Deep copy Personality
+	if c.Personality != nil {
+		personality := *c.Personality
+		personality.Traits = append([]string(nil), c.Personality.Traits...)
+		clone.Personality = &personality
+	}
+
 	// Deep copy EquippedSlots map
 	clone.EquippedSlots = make(map[shared.Slot]equipment.Equipment)
 	for k, v := range c.EquippedSlots {
@@ -646,8 +882,8 @@ func (c *Character) Clone() *Character {
 	// Deep copy Resources
 	if c.Resources != nil {
 		clone.Resources = &CharacterResources{
-			HP:      c.Resources.HP,      // HPResource is a value type
-			HitDice: c.Resources.HitDice, // Also a value type
+			HP:      c.Resources.HP, // HPResource is a value type
+			HitDice: append(shared.HitDicePools(nil), c.Resources.HitDice...),
 		}
 
 		// Deep copy spell slots
@@ -719,12 +955,12 @@ func (c *Character) GetSkillBonus(skillKey string, attribute shared.Attribute) i
 	return modifier
 }
 
-// RollSkillCheck rolls a skill check
-func (c *Character) RollSkillCheck(skillKey string, attribute shared.Attribute) (*dice.RollResult, int, error) {
+// RollSkillCheck rolls a skill check. Under the variant encumbrance rule a
+// heavy load gives disadvantage on STR, DEX and CON checks.
+func (c *Character) RollSkillCheck(skillKey string, attribute shared.Attribute, variantEncumbrance bool) (*dice.RollResult, int, error) {
 	bonus := c.GetSkillBonus(skillKey, attribute)
 
-	// Roll 1d20
-	result, err := c.getDiceRoller().Roll(1, 20, bonus)
+	result, err := c.rollD20(bonus, c.EncumbranceDisadvantageOn(attribute, variantEncumbrance))
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to roll skill check: %w", err)
 	}
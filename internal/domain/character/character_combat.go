@@ -48,14 +48,30 @@ func (c *Character) calculateWeaponAbilityBonus(weap *equipment.Weapon, hasMarti
 	return 0
 }
 
+// Attack rolls attacks with the character's equipped weapons using standard rules
 func (c *Character) Attack() ([]*attack.Result, error) {
+	return c.AttackWithOptions(nil)
+}
+
+// AttackWithOptions rolls attacks with the character's equipped weapons, applying
+// situational modifiers and house rules such as advantage or max-dice crits
+func (c *Character) AttackWithOptions(opts *attack.RollOptions) ([]*attack.Result, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	if critRange := c.criticalRangeInternal(); critRange < 20 {
+		withRange := attack.RollOptions{}
+		if opts != nil {
+			withRange = *opts
+		}
+		withRange.CritRange = critRange
+		opts = &withRange
+	}
+
 	if c.EquippedSlots == nil {
 		// Improvised weapon range or melee
 		// No equipped slots, using improvised melee
-		a, err := c.improvisedMelee()
+		a, err := c.improvisedMelee(opts)
 		if err != nil {
 			return nil, err
 		}
@@ -72,7 +88,7 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 			attacks := make([]*attack.Result, 0)
 
 			// Check proficiency while we have the mutex
-			directProf := c.hasWeaponProficiencyInternal(weap.GetKey())
+			directProf := c.hasWeaponProficiencyInternal(weap.ProficiencyKey())
 			categoryProf := c.hasWeaponCategoryProficiency(weap.WeaponCategory)
 			isProficient := directProf || categoryProf
 			// Proficiency check completed
@@ -92,7 +108,7 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 			// Calculate proficiency bonus if proficient
 			proficiencyBonus := 0
 			if isProficient {
-				proficiencyBonus = 2 + ((c.Level - 1) / 4)
+				proficiencyBonus = c.GetProficiencyBonus()
 			}
 
 			attackBonus := abilityBonus + proficiencyBonus
@@ -104,8 +120,9 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 			// Apply damage bonuses from active effects (e.g., rage)
 			// Use the weapon's actual range type
 			attackType := strings.ToLower(weap.WeaponRange)
+			attackBonus += c.effectBonus(effects.TargetAttackRoll, weaponConditions(attackType, weap))
 			var err error
-			damageBonus, err = c.applyActiveEffectDamageBonus(damageBonus, attackType)
+			damageBonus, err = c.applyActiveEffectDamageBonus(damageBonus, attackType, weap)
 			if err != nil {
 				log.Printf("ERROR: Failed to apply active effect damage bonus: %v", err)
 				// Continue with base damage bonus
@@ -122,12 +139,12 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 
 			if weap.IsTwoHanded() && weap.TwoHandedDamage != nil {
 				if useGreatWeaponFighting {
-					attak1, err = attack.RollAttackWithFightingStyle(c.getDiceRoller(), attackBonus, damageBonus, weap.TwoHandedDamage, "great_weapon")
+					attak1, err = attack.RollAttackWithFightingStyle(c.getDiceRoller(), attackBonus, damageBonus, weap.TwoHandedDamage, "great_weapon", opts)
 				} else {
-					attak1, err = attack.RollAttack(c.getDiceRoller(), attackBonus, damageBonus, weap.TwoHandedDamage)
+					attak1, err = attack.RollAttackWithFightingStyle(c.getDiceRoller(), attackBonus, damageBonus, weap.TwoHandedDamage, "", opts)
 				}
 			} else {
-				attak1, err = attack.RollAttack(c.getDiceRoller(), attackBonus, damageBonus, weap.Damage)
+				attak1, err = attack.RollAttackWithFightingStyle(c.getDiceRoller(), attackBonus, damageBonus, weap.Damage, "", opts)
 			}
 
 			if err != nil {
@@ -142,7 +159,7 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 			if c.EquippedSlots[shared.SlotOffHand] != nil {
 				if offWeap, offOk := c.EquippedSlots[shared.SlotOffHand].(*equipment.Weapon); offOk {
 					// Same process for off-hand weapon
-					offHandProficient := c.hasWeaponProficiencyInternal(offWeap.GetKey()) ||
+					offHandProficient := c.hasWeaponProficiencyInternal(offWeap.ProficiencyKey()) ||
 						c.hasWeaponCategoryProficiency(offWeap.WeaponCategory)
 
 					// Calculate off-hand ability bonus
@@ -150,7 +167,7 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 
 					offHandProficiencyBonus := 0
 					if offHandProficient {
-						offHandProficiencyBonus = 2 + ((c.Level - 1) / 4)
+						offHandProficiencyBonus = c.GetProficiencyBonus()
 					}
 
 					offHandAttackBonus := offHandAbilityBonus + offHandProficiencyBonus
@@ -160,13 +177,14 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 					offHandAttackBonus, offHandDamageBonus = c.applyFightingStyleBonusesWithHand(offWeap, offHandAttackBonus, offHandDamageBonus, shared.SlotOffHand)
 
 					// Apply damage bonuses from active effects (e.g., rage) to off-hand
-					offHandDamageBonus, err = c.applyActiveEffectDamageBonus(offHandDamageBonus, "melee")
+					offHandAttackBonus += c.effectBonus(effects.TargetAttackRoll, weaponConditions("melee", offWeap))
+					offHandDamageBonus, err = c.applyActiveEffectDamageBonus(offHandDamageBonus, "melee", offWeap)
 					if err != nil {
 						log.Printf("ERROR: Failed to apply active effect damage bonus to off-hand: %v", err)
 						// Continue with current bonus
 					}
 
-					attak2, err := attack.RollAttack(c.getDiceRoller(), offHandAttackBonus, offHandDamageBonus, offWeap.Damage)
+					attak2, err := attack.RollAttackWithFightingStyle(c.getDiceRoller(), offHandAttackBonus, offHandDamageBonus, offWeap.Damage, "", opts)
 					if err != nil {
 						return nil, err
 					}
@@ -191,7 +209,7 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 		if weap, ok := c.EquippedSlots[shared.SlotTwoHanded].(*equipment.Weapon); ok {
 			log.Printf("Two-handed weapon found: %s", weap.GetName())
 			// Check proficiency while we have the mutex
-			directProf := c.hasWeaponProficiencyInternal(weap.GetKey())
+			directProf := c.hasWeaponProficiencyInternal(weap.ProficiencyKey())
 			categoryProf := c.hasWeaponCategoryProficiency(weap.WeaponCategory)
 			isProficient := directProf || categoryProf
 			// Proficiency check completed
@@ -211,7 +229,7 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 			// Calculate proficiency bonus if proficient
 			proficiencyBonus := 0
 			if isProficient {
-				proficiencyBonus = 2 + ((c.Level - 1) / 4)
+				proficiencyBonus = c.GetProficiencyBonus()
 			}
 
 			attackBonus := abilityBonus + proficiencyBonus
@@ -223,8 +241,9 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 			// Apply damage bonuses from active effects (e.g., rage)
 			// Use the weapon's actual range type
 			attackType := strings.ToLower(weap.WeaponRange)
+			attackBonus += c.effectBonus(effects.TargetAttackRoll, weaponConditions(attackType, weap))
 			var err error
-			damageBonus, err = c.applyActiveEffectDamageBonus(damageBonus, attackType)
+			damageBonus, err = c.applyActiveEffectDamageBonus(damageBonus, attackType, weap)
 			if err != nil {
 				log.Printf("ERROR: Failed to apply active effect damage bonus: %v", err)
 				// Continue with base damage bonus
@@ -245,9 +264,9 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 			fightingStyle := c.getFightingStyle()
 			var a *attack.Result
 			if fightingStyle == "great_weapon" && weap.IsMelee() {
-				a, err = attack.RollAttackWithFightingStyle(c.getDiceRoller(), attackBonus, damageBonus, dmg, "great_weapon")
+				a, err = attack.RollAttackWithFightingStyle(c.getDiceRoller(), attackBonus, damageBonus, dmg, "great_weapon", opts)
 			} else {
-				a, err = attack.RollAttack(c.getDiceRoller(), attackBonus, damageBonus, dmg)
+				a, err = attack.RollAttackWithFightingStyle(c.getDiceRoller(), attackBonus, damageBonus, dmg, "", opts)
 			}
 			if err != nil {
 				return nil, err
@@ -262,7 +281,7 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 		}
 	}
 
-	a, err := c.improvisedMelee()
+	a, err := c.improvisedMelee(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -272,6 +291,30 @@ func (c *Character) Attack() ([]*attack.Result, error) {
 	}, nil
 }
 
+// CriticalRange returns the lowest natural roll that scores a critical hit
+// with the character's weapon attacks
+func (c *Character) CriticalRange() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.criticalRangeInternal()
+}
+
+func (c *Character) criticalRangeInternal() int {
+	critRange := 20
+	for _, feature := range c.Features {
+		if feature == nil {
+			continue
+		}
+		switch feature.Key {
+		case "superior_critical":
+			critRange = min(critRange, 18)
+		case "improved_critical":
+			critRange = min(critRange, 19)
+		}
+	}
+	return critRange
+}
+
 // HasWeaponProficiency checks if the character is proficient with a weapon (thread-safe)
 func (c *Character) HasWeaponProficiency(weaponKey string) bool {
 	c.mu.Lock()
@@ -356,7 +399,7 @@ func (c *Character) hasWeaponCategoryProficiency(weaponCategory string) bool {
 	return false
 }
 
-func (c *Character) improvisedMelee() (*attack.Result, error) {
+func (c *Character) improvisedMelee(opts *attack.RollOptions) (*attack.Result, error) {
 	// Check if character has Martial Arts feature (monks)
 	hasMartialArts := false
 	for _, feature := range c.Features {
@@ -388,7 +431,7 @@ func (c *Character) improvisedMelee() (*attack.Result, error) {
 	}
 
 	// Apply damage bonuses from active effects (e.g., rage) to improvised attacks
-	damageBonus, err := c.applyActiveEffectDamageBonus(bonus, "melee")
+	damageBonus, err := c.applyActiveEffectDamageBonus(bonus, "melee", nil)
 	if err != nil {
 		log.Printf("ERROR: Failed to apply active effect damage bonus to improvised: %v", err)
 		damageBonus = bonus // Fall back to base
@@ -397,17 +440,18 @@ func (c *Character) improvisedMelee() (*attack.Result, error) {
 	// Determine damage dice size based on monk level
 	diceSize := 4 // Default for non-monks and level 1-4 monks
 	if hasMartialArts {
+		monkLevel := c.ClassFeatureLevel("monk")
 		switch {
-		case c.Level >= 17:
+		case monkLevel >= 17:
 			diceSize = 10
-		case c.Level >= 11:
+		case monkLevel >= 11:
 			diceSize = 8
-		case c.Level >= 5:
+		case monkLevel >= 5:
 			diceSize = 6
 		}
 	}
 
-	attackResult, err := c.getDiceRoller().Roll(1, 20, 0)
+	attackResult, err := attack.RollToHit(c.getDiceRoller(), opts)
 	if err != nil {
 		return nil, err
 	}
@@ -417,7 +461,7 @@ func (c *Character) improvisedMelee() (*attack.Result, error) {
 	}
 
 	return &attack.Result{
-		AttackRoll:   attackResult.Total + bonus,
+		AttackRoll:   attack.NaturalRoll(attackResult) + bonus,
 		DamageRoll:   damageResult.Total + damageBonus,
 		AttackType:   damage.TypeBludgeoning,
 		AttackResult: attackResult,
@@ -431,13 +475,23 @@ func (c *Character) improvisedMelee() (*attack.Result, error) {
 	}, nil
 }
 
-// applyActiveEffectDamageBonus applies damage bonuses from active effects like rage
-// Returns the modified damage and any error encountered
-func (c *Character) applyActiveEffectDamageBonus(baseDamage int, damageType string) (int, error) {
-	// Get damage modifiers from the new status effect system
+// weaponConditions builds the effect conditions for an attack with a weapon.
+// A nil weapon is an improvised or unarmed attack.
+func weaponConditions(attackType string, weap *equipment.Weapon) map[string]string {
 	conditions := map[string]string{
-		"attack_type": damageType,
+		"attack_type": attackType,
 	}
+	if weap != nil {
+		conditions["weapon"] = weap.GetKey()
+	}
+	return conditions
+}
+
+// applyActiveEffectDamageBonus applies damage bonuses from active effects like rage
+// and magic weapons. Returns the modified damage and any error encountered
+func (c *Character) applyActiveEffectDamageBonus(baseDamage int, damageType string, weap *equipment.Weapon) (int, error) {
+	// Get damage modifiers from the new status effect system
+	conditions := weaponConditions(damageType, weap)
 
 	modifiers := c.getDamageModifiersInternal(conditions)
 	effectBonus := 0
@@ -3,6 +3,7 @@ package character
 import (
 	"log"
 
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/effects"
 )
@@ -114,6 +115,8 @@ func (c *Character) getEffectManagerInternal() *effects.Manager {
 		c.EffectManager = effects.NewManager()
 		// Restore effects from persisted data
 		c.syncResourcestoEffectManager()
+		// Item effects aren't persisted with their modifiers, so rebuild them
+		c.refreshItemEffects()
 	}
 	return c.EffectManager
 }
@@ -160,7 +163,7 @@ func (c *Character) syncResourcestoEffectManager() {
 		// For well-known effects, rebuild them properly
 		if oldEffect.Name == "Rage" && oldEffect.Source == string(effects.SourceAbility) {
 			// Rebuild rage effect with proper modifiers
-			rageEffect := effects.BuildRageEffect(c.Level)
+			rageEffect := effects.BuildRageEffect(c.ClassFeatureLevel("barbarian"))
 			rageEffect.ID = oldEffect.ID // Keep the same ID
 			if err := c.EffectManager.AddEffect(rageEffect); err != nil {
 				log.Printf("Failed to add rage effect: %v", err)
@@ -181,6 +184,17 @@ func (c *Character) syncResourcestoEffectManager() {
 			if err := c.EffectManager.AddEffect(favoredEnemyEffect); err != nil {
 				log.Printf("Failed to add favored enemy effect: %v", err)
 			}
+		} else if potion := equipment.NewSRDConsumable(oldEffect.SourceID, 1); potion != nil && oldEffect.Source == string(effects.SourceItem) {
+			// Rebuild a potion's buff with its modifiers and the rounds left
+			buff := potion.BuffEffect()
+			if buff == nil {
+				continue
+			}
+			buff.ID = oldEffect.ID
+			buff.Duration.Rounds = oldEffect.Duration
+			if err := c.EffectManager.AddEffect(buff); err != nil {
+				log.Printf("Failed to add %s effect: %v", buff.Name, err)
+			}
 		} else {
 			// Add generic effect
 			if err := c.EffectManager.AddEffect(newEffect); err != nil {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/effects"
 )
 
 func (c *Character) AddProficiency(p *rulebook.Proficiency) {
@@ -43,12 +44,10 @@ func (c *Character) SetProficiencies(profType rulebook.ProficiencyType, proficie
 	c.Proficiencies[profType] = proficiencies
 }
 
-// GetProficiencyBonus returns the character's proficiency bonus based on level
+// GetProficiencyBonus returns the character's proficiency bonus based on level.
+// Multiclass characters use their total level, not any one class level.
 func (c *Character) GetProficiencyBonus() int {
-	if c.Level == 0 {
-		return 2 // Default for level 1
-	}
-	return 2 + ((c.Level - 1) / 4)
+	return rulebook.ProficiencyBonusForLevel(c.Level)
 }
 
 // HasSavingThrowProficiency checks if the character is proficient in a saving throw
@@ -100,15 +99,21 @@ func (c *Character) GetSavingThrowBonus(attribute shared.Attribute) int {
 		modifier += c.GetProficiencyBonus()
 	}
 
+	// Add bonuses from active effects, such as magic items
+	modifier += c.effectBonus(effects.TargetSavingThrow, map[string]string{
+		"ability": strings.ToLower(string(attribute)),
+	})
+
 	return modifier
 }
 
-// RollSavingThrow rolls a saving throw for the given attribute
-func (c *Character) RollSavingThrow(attribute shared.Attribute) (*dice.RollResult, int, error) {
+// RollSavingThrow rolls a saving throw for the given attribute. Under the
+// variant encumbrance rule a heavy load gives disadvantage on STR, DEX and
+// CON saves.
+func (c *Character) RollSavingThrow(attribute shared.Attribute, variantEncumbrance bool) (*dice.RollResult, int, error) {
 	bonus := c.GetSavingThrowBonus(attribute)
 
-	// Roll 1d20
-	result, err := c.getDiceRoller().Roll(1, 20, bonus)
+	result, err := c.rollD20(bonus, c.EncumbranceDisadvantageOn(attribute, variantEncumbrance))
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to roll saving throw: %w", err)
 	}
@@ -1,6 +1,10 @@
 package character
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 )
@@ -11,7 +15,7 @@ type CharacterResources struct {
 	SpellSlots    map[int]shared.SpellSlotInfo     `json:"spell_slots"` // level -> slot info
 	Abilities     map[string]*shared.ActiveAbility `json:"abilities"`   // key -> ability
 	ActiveEffects []*shared.ActiveEffect           `json:"active_effects"`
-	HitDice       shared.HitDiceResource           `json:"hit_dice"`
+	HitDice       shared.HitDicePools              `json:"hit_dice"`
 
 	// Combat state tracking
 	SneakAttackUsedThisTurn bool `json:"sneak_attack_used_this_turn"`
@@ -28,11 +32,10 @@ func (r *CharacterResources) Initialize(class *rulebook.Class, level int) {
 		Max:     class.HitDie,
 	}
 
-	// Initialize hit dice
-	r.HitDice = shared.HitDiceResource{
-		DiceType:  class.HitDie,
-		Max:       level,
-		Remaining: level,
+	// Initialize hit dice; a class without its rulebook data has none
+	r.HitDice = nil
+	if class.HitDie > 0 {
+		r.HitDice = shared.NewHitDicePools(map[int]int{class.HitDie: level})
 	}
 
 	// Initialize abilities map
@@ -40,37 +43,125 @@ func (r *CharacterResources) Initialize(class *rulebook.Class, level int) {
 		r.Abilities = make(map[string]*shared.ActiveAbility)
 	}
 
-	// Initialize spell slots if caster
-	r.initializeSpellSlots(class, level)
+	// Initialize spell slots if caster. Third casters depend on the subclass,
+	// which Character.InitializeResources passes through.
+	r.initializeSpellSlots(rulebook.GetCasterProgression(class.Key, ""), level)
 }
 
-// initializeSpellSlots sets up spell slots for caster classes
-func (r *CharacterResources) initializeSpellSlots(class *rulebook.Class, level int) {
+// initializeSpellSlots sets up spell slots from the class's caster progression.
+// Slots start full.
+func (r *CharacterResources) initializeSpellSlots(progression rulebook.CasterProgression, level int) {
 	r.SpellSlots = make(map[int]shared.SpellSlotInfo)
 
-	// Level 1 full casters (Cleric, Druid, Sorcerer, Wizard, Bard)
-	switch class.Key {
-	case "cleric", "druid", "sorcerer", "wizard", "bard":
-		r.SpellSlots[1] = shared.SpellSlotInfo{
-			Max:       2,
-			Remaining: 2,
-			Source:    "spellcasting",
-		}
-	case "ranger", "paladin": // Half casters don't get slots until level 2
-		if level >= 2 {
-			r.SpellSlots[1] = shared.SpellSlotInfo{
-				Max:       2,
-				Remaining: 2,
-				Source:    "spellcasting",
+	if progression == rulebook.CasterProgressionPact {
+		slots, slotLevel := rulebook.PactMagicSlots(level)
+		if slots > 0 {
+			r.SpellSlots[slotLevel] = shared.SpellSlotInfo{
+				Max:       slots,
+				Remaining: slots,
+				Source:    rulebook.SpellSlotSourcePactMagic,
 			}
 		}
-	case "warlock": // Pact magic - different progression
-		r.SpellSlots[1] = shared.SpellSlotInfo{
-			Max:       1,
-			Remaining: 1,
-			Source:    "pact_magic",
+		return
+	}
+
+	for spellLevel, slots := range rulebook.SpellSlots(progression, level) {
+		r.SpellSlots[spellLevel] = shared.SpellSlotInfo{
+			Max:       slots,
+			Remaining: slots,
+			Source:    rulebook.SpellSlotSourceSpellcasting,
+		}
+	}
+}
+
+// classCasting is one class's spell slot progression at its class level
+type classCasting struct {
+	progression rulebook.CasterProgression
+	level       int
+}
+
+// initializeMulticlassSpellSlots sets up spell slots for a multiclass
+// character. Spellcasting classes pool their levels on the multiclass
+// spellcaster table, unless only one of them casts, which keeps its own
+// table. Pact magic slots are added on top. Slots start full.
+func (r *CharacterResources) initializeMulticlassSpellSlots(casting []classCasting) {
+	r.SpellSlots = make(map[int]shared.SpellSlotInfo)
+
+	var spellcasting []classCasting
+	pactLevel := 0
+	for _, class := range casting {
+		switch class.progression {
+		case rulebook.CasterProgressionNone:
+		case rulebook.CasterProgressionPact:
+			pactLevel += class.level
+		default:
+			spellcasting = append(spellcasting, class)
+		}
+	}
+
+	var slots map[int]int
+	switch len(spellcasting) {
+	case 0:
+	case 1:
+		slots = rulebook.SpellSlots(spellcasting[0].progression, spellcasting[0].level)
+	default:
+		casterLevel := 0
+		for _, class := range spellcasting {
+			casterLevel += rulebook.MulticlassCasterLevel(class.progression, class.level)
+		}
+		slots = rulebook.SpellSlotsForCasterLevel(casterLevel)
+	}
+	for spellLevel, count := range slots {
+		r.SpellSlots[spellLevel] = shared.SpellSlotInfo{
+			Max:       count,
+			Remaining: count,
+			Source:    rulebook.SpellSlotSourceSpellcasting,
+		}
+	}
+
+	pactSlots, slotLevel := rulebook.PactMagicSlots(pactLevel)
+	if pactSlots == 0 {
+		return
+	}
+	slot, ok := r.SpellSlots[slotLevel]
+	if !ok {
+		r.SpellSlots[slotLevel] = shared.SpellSlotInfo{
+			Max:       pactSlots,
+			Remaining: pactSlots,
+			Source:    rulebook.SpellSlotSourcePactMagic,
+		}
+		return
+	}
+	// Either kind of slot can cast either class's spells, so pact slots
+	// sharing a level with spellcasting slots join them
+	slot.Max += pactSlots
+	slot.Remaining += pactSlots
+	slot.PactMax = pactSlots
+	r.SpellSlots[slotLevel] = slot
+}
+
+// PactSlotLevel returns the level pact magic slots are cast at, or 0 without pact magic
+func (r *CharacterResources) PactSlotLevel() int {
+	for level, slot := range r.SpellSlots {
+		if slot.Source == rulebook.SpellSlotSourcePactMagic || slot.PactMax > 0 {
+			return level
+		}
+	}
+	return 0
+}
+
+// PactMagicOnly reports whether every spell slot is a pact magic slot, as for
+// a single-class warlock. Those slots are always cast at the pact slot level.
+func (r *CharacterResources) PactMagicOnly() bool {
+	if len(r.SpellSlots) == 0 {
+		return false
+	}
+	for _, slot := range r.SpellSlots {
+		if slot.Source != rulebook.SpellSlotSourcePactMagic {
+			return false
 		}
 	}
+	return true
 }
 
 // UseSpellSlot consumes a spell slot of the given level
@@ -80,11 +171,8 @@ func (r *CharacterResources) UseSpellSlot(level int) bool {
 		return false
 	}
 
-	r.SpellSlots[level] = shared.SpellSlotInfo{
-		Max:       slot.Max,
-		Remaining: slot.Remaining - 1,
-		Source:    slot.Source,
-	}
+	slot.Remaining--
+	r.SpellSlots[level] = slot
 	return true
 }
 
@@ -95,22 +183,38 @@ func (r *CharacterResources) ShortRest() {
 		ability.RestoreUses(shared.RestTypeShort)
 	}
 
-	// Only warlock (pact magic) spell slots restore on short rest
+	// Only warlock (pact magic) spell slots restore on short rest. Pact
+	// slots sharing a level with spellcasting slots are spent first.
 	for level, slot := range r.SpellSlots {
-		if slot.Source == "pact_magic" {
-			r.SpellSlots[level] = shared.SpellSlotInfo{
-				Max:       slot.Max,
-				Remaining: slot.Max,
-				Source:    slot.Source,
-			}
+		switch {
+		case slot.Source == rulebook.SpellSlotSourcePactMagic:
+			slot.Remaining = slot.Max
+		case slot.PactMax > 0:
+			slot.Remaining = min(slot.Remaining+slot.PactMax, slot.Max)
+		default:
+			continue
 		}
+		r.SpellSlots[level] = slot
 	}
 }
 
 // LongRest restores all resources
 func (r *CharacterResources) LongRest() {
+	r.longRest(true)
+}
+
+// LongRestSlowHealing performs a long rest under the slow natural healing
+// variant: everything recovers except hit points, which must be regained
+// by spending hit dice
+func (r *CharacterResources) LongRestSlowHealing() {
+	r.longRest(false)
+}
+
+func (r *CharacterResources) longRest(restoreHP bool) {
 	// Restore HP to max
-	r.HP.Current = r.HP.Max
+	if restoreHP {
+		r.HP.Current = r.HP.Max
+	}
 	r.HP.Temporary = 0
 
 	// Restore all abilities (RestoreUses also deactivates active abilities)
@@ -120,22 +224,12 @@ func (r *CharacterResources) LongRest() {
 
 	// Restore all spell slots
 	for level, slot := range r.SpellSlots {
-		r.SpellSlots[level] = shared.SpellSlotInfo{
-			Max:       slot.Max,
-			Remaining: slot.Max,
-			Source:    slot.Source,
-		}
+		slot.Remaining = slot.Max
+		r.SpellSlots[level] = slot
 	}
 
 	// Restore half hit dice (minimum 1)
-	restored := r.HitDice.Max / 2
-	if restored < 1 {
-		restored = 1
-	}
-	r.HitDice.Remaining += restored
-	if r.HitDice.Remaining > r.HitDice.Max {
-		r.HitDice.Remaining = r.HitDice.Max
-	}
+	r.HitDice.Restore(max(r.HitDice.Max()/2, 1))
 
 	// Clear temporary effects but keep permanent ones
 	var permanentEffects []*shared.ActiveEffect
@@ -210,3 +304,43 @@ func (r *CharacterResources) HasResistance(damageType string) bool {
 	}
 	return false
 }
+
+// SpellSlotSummary returns remaining slots per level for display,
+// e.g. "1st: 3/4 | 2nd: 2/2". Pact magic slots are labelled as such.
+func (r *CharacterResources) SpellSlotSummary() string {
+	if len(r.SpellSlots) == 0 {
+		return ""
+	}
+
+	levels := make([]int, 0, len(r.SpellSlots))
+	for level := range r.SpellSlots {
+		levels = append(levels, level)
+	}
+	sort.Ints(levels)
+
+	parts := make([]string, 0, len(levels))
+	for _, level := range levels {
+		slot := r.SpellSlots[level]
+		label := ordinal(level)
+		if slot.Source == rulebook.SpellSlotSourcePactMagic {
+			label = fmt.Sprintf("Pact (%s)", label)
+		} else if slot.PactMax > 0 {
+			label = fmt.Sprintf("%s (%d pact)", label, slot.PactMax)
+		}
+		parts = append(parts, fmt.Sprintf("%s: %d/%d", label, slot.Remaining, slot.Max))
+	}
+	return strings.Join(parts, " | ")
+}
+
+// ordinal formats a spell level as 1st, 2nd, 3rd, ...
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	}
+	return fmt.Sprintf("%dth", n)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCharacterResources_LongRest_ClearsActiveAbilitiesAndEffects(t *testing.T) {
@@ -50,11 +51,11 @@ func TestCharacterResources_LongRest_ClearsActiveAbilitiesAndEffects(t *testing.
 				RequiresConcentration: true,
 			},
 		},
-		HitDice: shared.HitDiceResource{
+		HitDice: shared.HitDicePools{{
 			DiceType:  10,
 			Max:       3,
 			Remaining: 1,
-		},
+		}},
 	}
 
 	// Perform long rest
@@ -77,7 +78,7 @@ func TestCharacterResources_LongRest_ClearsActiveAbilitiesAndEffects(t *testing.
 	assert.Empty(t, resources.ActiveEffects, "All active effects should be cleared after long rest")
 
 	// Assert hit dice are restored (half of max, minimum 1)
-	assert.Equal(t, 2, resources.HitDice.Remaining, "Should restore half hit dice (1 + 1 = 2)")
+	assert.Equal(t, 2, resources.HitDice.Remaining(), "Should restore half hit dice (1 + 1 = 2)")
 }
 
 func TestCharacterResources_LongRest_RestoresSpellSlots(t *testing.T) {
@@ -112,9 +113,9 @@ func TestCharacterResources_Initialize(t *testing.T) {
 
 	assert.Equal(t, 12, resources.HP.Current)
 	assert.Equal(t, 12, resources.HP.Max)
-	assert.Equal(t, 12, resources.HitDice.DiceType)
-	assert.Equal(t, 3, resources.HitDice.Max)
-	assert.Equal(t, 3, resources.HitDice.Remaining)
+	assert.Equal(t, 12, resources.HitDice[0].DiceType)
+	assert.Equal(t, 3, resources.HitDice.Max())
+	assert.Equal(t, 3, resources.HitDice.Remaining())
 	assert.NotNil(t, resources.Abilities)
 	assert.Empty(t, resources.SpellSlots) // Barbarians don't have spell slots
 }
@@ -151,3 +152,160 @@ func TestCharacterResources_ShortRest(t *testing.T) {
 	assert.Equal(t, 2, resources.SpellSlots[1].Remaining, "Pact magic slots should be restored")
 	assert.Equal(t, 1, resources.SpellSlots[2].Remaining, "Regular spell slots should not be restored")
 }
+
+func TestCharacterResources_InitializeSpellSlots(t *testing.T) {
+	tests := []struct {
+		name     string
+		classKey string
+		level    int
+		expected map[int]int
+	}{
+		{name: "wizard level 1", classKey: "wizard", level: 1, expected: map[int]int{1: 2}},
+		{name: "wizard level 5", classKey: "wizard", level: 5, expected: map[int]int{1: 4, 2: 3, 3: 2}},
+		{name: "cleric level 20", classKey: "cleric", level: 20, expected: map[int]int{1: 4, 2: 3, 3: 3, 4: 3, 5: 3, 6: 2, 7: 2, 8: 1, 9: 1}},
+		{name: "paladin level 1", classKey: "paladin", level: 1, expected: map[int]int{}},
+		{name: "paladin level 2", classKey: "paladin", level: 2, expected: map[int]int{1: 2}},
+		{name: "ranger level 9", classKey: "ranger", level: 9, expected: map[int]int{1: 4, 2: 3, 3: 2}},
+		{name: "fighter has no slots", classKey: "fighter", level: 10, expected: map[int]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources := &CharacterResources{}
+			resources.Initialize(&rulebook.Class{Key: tt.classKey, HitDie: 8}, tt.level)
+
+			actual := make(map[int]int)
+			for level, slot := range resources.SpellSlots {
+				assert.Equal(t, slot.Max, slot.Remaining)
+				assert.Equal(t, rulebook.SpellSlotSourceSpellcasting, slot.Source)
+				actual[level] = slot.Max
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCharacterResources_PactMagicSlots(t *testing.T) {
+	resources := &CharacterResources{}
+	resources.Initialize(&rulebook.Class{Key: "warlock", HitDie: 8}, 5)
+
+	require.Len(t, resources.SpellSlots, 1)
+	slot := resources.SpellSlots[3]
+	assert.Equal(t, 2, slot.Max)
+	assert.Equal(t, rulebook.SpellSlotSourcePactMagic, slot.Source)
+	assert.Equal(t, 3, resources.PactSlotLevel())
+
+	require.True(t, resources.UseSpellSlot(3))
+	require.True(t, resources.UseSpellSlot(3))
+	assert.False(t, resources.UseSpellSlot(3))
+
+	resources.ShortRest()
+	assert.Equal(t, 2, resources.SpellSlots[3].Remaining)
+	assert.Equal(t, "Pact (3rd): 2/2", resources.SpellSlotSummary())
+}
+
+func TestCharacter_ThirdCasterSpellSlots(t *testing.T) {
+	char := &Character{
+		Level: 7,
+		Class: &rulebook.Class{Key: "fighter", HitDie: 10},
+	}
+	char.InitializeResources()
+	assert.Empty(t, char.Resources.SpellSlots)
+
+	char.Features = append(char.Features, &rulebook.CharacterFeature{
+		Key:      "subclass",
+		Metadata: map[string]any{"subclass": "eldritch-knight"},
+	})
+	char.InitializeResources()
+	assert.Equal(t, "1st: 4/4 | 2nd: 2/2", char.Resources.SpellSlotSummary())
+}
+
+func TestCharacter_LongRest_OpensSpellPreparation(t *testing.T) {
+	cleric := &Character{
+		Level: 3,
+		Class: &rulebook.Class{Key: "cleric"},
+		Spells: &SpellList{
+			KnownSpells:    []string{"bless", "cure-wounds"},
+			PreparedSpells: []string{"bless"},
+		},
+	}
+	cleric.AddAttribute(shared.AttributeWisdom, 14)
+
+	assert.Equal(t, 5, cleric.MaxPreparedSpells())
+	assert.True(t, cleric.CanCastSpell(&rulebook.Spell{Key: "bless"}))
+	assert.False(t, cleric.CanCastSpell(&rulebook.Spell{Key: "cure-wounds"}), "prepared casters only cast prepared spells")
+	assert.False(t, cleric.CanPrepareSpells())
+
+	cleric.LongRest(false)
+
+	assert.True(t, cleric.CanPrepareSpells())
+}
+
+func TestCharacter_SpendHitDie(t *testing.T) {
+	fighter := &Character{
+		Level:            4,
+		Class:            &rulebook.Class{Key: "fighter", HitDie: 10},
+		MaxHitPoints:     36,
+		CurrentHitPoints: 10,
+	}
+	fighter.AddAttribute(shared.AttributeConstitution, 14)
+	fighter.InitializeResources()
+
+	healed, ok := fighter.SpendHitDie(6)
+	require.True(t, ok)
+	assert.Equal(t, 8, healed, "roll plus Constitution modifier")
+	assert.Equal(t, 18, fighter.CurrentHitPoints)
+	assert.Equal(t, 3, fighter.Resources.HitDice.Remaining())
+
+	// Healing stops at max hit points
+	fighter.CurrentHitPoints = 34
+	healed, ok = fighter.SpendHitDie(10)
+	require.True(t, ok)
+	assert.Equal(t, 2, healed)
+	assert.Equal(t, 36, fighter.CurrentHitPoints)
+
+	fighter.Resources.HitDice[0].Remaining = 0
+	_, ok = fighter.SpendHitDie(5)
+	assert.False(t, ok, "no hit dice left")
+}
+
+func TestCharacter_LongRest_RestoresHalfHitDice(t *testing.T) {
+	fighter := &Character{
+		Level:            4,
+		Class:            &rulebook.Class{Key: "fighter", HitDie: 10},
+		MaxHitPoints:     36,
+		CurrentHitPoints: 5,
+	}
+	fighter.InitializeResources()
+	fighter.Resources.HitDice[0].Remaining = 0
+
+	fighter.LongRest(false)
+
+	assert.Equal(t, 36, fighter.CurrentHitPoints)
+	assert.Equal(t, 2, fighter.Resources.HitDice.Remaining())
+}
+
+func TestRefreshResources_KeepsSpentUses(t *testing.T) {
+	char := &Character{
+		Level:            3,
+		Class:            &rulebook.Class{Key: "barbarian", HitDie: 12},
+		MaxHitPoints:     30,
+		CurrentHitPoints: 20,
+	}
+	char.InitializeResources()
+	rage := char.Resources.Abilities[shared.AbilityKeyRage]
+	require.NotNil(t, rage)
+	require.True(t, rage.Use())
+	rage.IsActive = true
+	rage.Duration = 4
+
+	char.Level = 6
+	char.RefreshResources()
+
+	rage = char.Resources.Abilities[shared.AbilityKeyRage]
+	assert.Equal(t, 4, rage.UsesMax)
+	assert.Equal(t, 3, rage.UsesRemaining, "the rage already used stays used")
+	assert.True(t, rage.IsActive)
+	assert.Equal(t, 4, rage.Duration)
+	assert.Equal(t, 20, char.Resources.HP.Current)
+}
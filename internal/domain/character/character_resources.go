@@ -1,6 +1,10 @@
 package character
 
 import (
+	"log"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 )
 
@@ -22,6 +26,58 @@ func (c *Character) InitializeResources() {
 	c.initializeResourcesInternal()
 }
 
+// RefreshResources recalculates resource maximums after the character's level
+// or classes change. Spell slots, hit dice and ability uses already spent stay
+// spent, and abilities in use stay active.
+func (c *Character) RefreshResources() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Resources == nil {
+		c.initializeResourcesInternal()
+		return
+	}
+
+	slotsUsed := make(map[int]int, len(c.Resources.SpellSlots))
+	for level, slot := range c.Resources.SpellSlots {
+		slotsUsed[level] = slot.Max - slot.Remaining
+	}
+	diceSpent := make(map[int]int, len(c.Resources.HitDice))
+	for _, pool := range c.Resources.HitDice {
+		diceSpent[pool.DiceType] = pool.Max - pool.Remaining
+	}
+	abilities := make(map[string]shared.ActiveAbility, len(c.Resources.Abilities))
+	for key, ability := range c.Resources.Abilities {
+		if ability != nil {
+			abilities[key] = *ability
+		}
+	}
+
+	c.initializeResourcesInternal()
+
+	for level, slot := range c.Resources.SpellSlots {
+		slot.Remaining = max(slot.Max-slotsUsed[level], 0)
+		c.Resources.SpellSlots[level] = slot
+	}
+	for i := range c.Resources.HitDice {
+		pool := &c.Resources.HitDice[i]
+		pool.Remaining = max(pool.Max-diceSpent[pool.DiceType], 0)
+	}
+	for key, ability := range c.Resources.Abilities {
+		previous, ok := abilities[key]
+		if !ok || ability == nil {
+			continue
+		}
+		if ability.UsesMax >= 0 && previous.UsesMax >= 0 {
+			ability.UsesRemaining = max(ability.UsesMax-(previous.UsesMax-previous.UsesRemaining), 0)
+		}
+		ability.IsActive = previous.IsActive
+		if previous.IsActive {
+			ability.Duration = previous.Duration
+		}
+	}
+}
+
 // initializeResourcesInternal is the internal resource initialization (caller must hold lock)
 func (c *Character) initializeResourcesInternal() {
 	if c.Resources == nil {
@@ -31,6 +87,24 @@ func (c *Character) initializeResourcesInternal() {
 	// Initialize basic resources
 	if c.Class != nil {
 		c.Resources.Initialize(c.Class, c.Level)
+		if subclassKey := c.GetSubclassKey(); subclassKey != "" {
+			c.Resources.initializeSpellSlots(rulebook.GetCasterProgression(c.Class.Key, subclassKey), c.Level)
+		}
+	}
+
+	// Multiclass characters have a hit dice pool per die size and combine
+	// the spell slots of their classes
+	if c.IsMulticlass() {
+		c.Resources.HitDice = shared.NewHitDicePools(c.hitDiceByType())
+
+		var casting []classCasting
+		for _, classLevel := range c.ClassLevels() {
+			casting = append(casting, classCasting{
+				progression: rulebook.GetCasterProgression(classLevel.Class.Key, c.SubclassKeyFor(classLevel.Class.Key)),
+				level:       classLevel.Level,
+			})
+		}
+		c.Resources.initializeMulticlassSpellSlots(casting)
 	}
 
 	// Set HP based on character's max HP (includes CON bonus)
@@ -42,3 +116,78 @@ func (c *Character) initializeResourcesInternal() {
 	// Initialize class-specific abilities at level 1
 	c.initializeClassAbilities()
 }
+
+// LongRest restores resources after a long rest. Under the slow natural
+// healing house rule hit points aren't restored. Prepared casters may change
+// their prepared spells afterwards.
+func (c *Character) LongRest(slowNaturalHealing bool) {
+	resources := c.syncHitPoints()
+	if slowNaturalHealing {
+		resources.LongRestSlowHealing()
+	} else {
+		resources.LongRest()
+	}
+
+	c.CurrentHitPoints = resources.HP.Current
+
+	c.OpenSpellPreparation()
+
+	// Charged magic items regain 1d6+1 charges at dawn
+	for _, items := range c.Inventory {
+		for _, item := range items {
+			if magic := equipment.Magic(item); magic != nil && magic.MaxCharges > 0 {
+				if _, err := magic.Recharge(c.getDiceRoller()); err != nil {
+					log.Printf("Failed to recharge %s: %v", item.GetName(), err)
+				}
+			}
+		}
+	}
+}
+
+// ShortRest restores short rest abilities and pact magic slots.
+// Hit dice are spent separately with SpendHitDie.
+func (c *Character) ShortRest() {
+	c.GetResources().ShortRest()
+}
+
+// HitDieType returns the size of the next hit die to spend. Multiclass
+// characters spend their largest hit dice first.
+func (c *Character) HitDieType() int {
+	hitDice := c.GetResources().HitDice
+	if dieType := hitDice.Largest(); dieType > 0 {
+		return dieType
+	}
+	if len(hitDice) > 0 && hitDice[0].DiceType > 0 {
+		return hitDice[0].DiceType
+	}
+	return c.HitDie
+}
+
+// SpendHitDie spends one hit die of the size HitDieType returns, regaining
+// the rolled amount plus the Constitution modifier. Returns the hit points
+// regained, or false if no hit dice are left.
+func (c *Character) SpendHitDie(roll int) (int, bool) {
+	resources := c.syncHitPoints()
+	if !resources.HitDice.Spend(resources.HitDice.Largest()) {
+		return 0, false
+	}
+
+	modifier := 0
+	if con := c.Attributes[shared.AttributeConstitution]; con != nil {
+		modifier = con.Bonus
+	}
+	healed := resources.HP.Heal(max(roll+modifier, 0))
+	c.CurrentHitPoints = resources.HP.Current
+	return healed, true
+}
+
+// syncHitPoints copies the character's hit points into its resources, which
+// can fall behind after combat or a level-up
+func (c *Character) syncHitPoints() *CharacterResources {
+	resources := c.GetResources()
+	if c.MaxHitPoints > 0 {
+		resources.HP.Max = c.MaxHitPoints
+		resources.HP.Current = min(c.CurrentHitPoints, c.MaxHitPoints)
+	}
+	return resources
+}
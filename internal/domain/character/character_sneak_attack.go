@@ -8,8 +8,8 @@ import (
 
 // CanSneakAttack checks if the character can use sneak attack with given conditions
 func (c *Character) CanSneakAttack(weapon *equipment.Weapon, hasAdvantage, allyAdjacent, hasDisadvantage bool) bool {
-	// Must be a rogue
-	if c.Class == nil || c.Class.Key != "rogue" {
+	// Must have a level in rogue
+	if c.LevelInClass("rogue") == 0 {
 		return false
 	}
 
@@ -44,16 +44,12 @@ func (c *Character) CanSneakAttack(weapon *equipment.Weapon, hasAdvantage, allyA
 
 // GetSneakAttackDice returns the number of d6 dice for sneak attack based on rogue level
 func (c *Character) GetSneakAttackDice() int {
-	if c.Class == nil || c.Class.Key != "rogue" {
-		return 0
-	}
-
 	// Sneak attack damage: 1d6 per 2 rogue levels (rounded up)
 	// Level 1-2: 1d6
 	// Level 3-4: 2d6
 	// Level 5-6: 3d6
 	// etc...
-	return (c.Level + 1) / 2
+	return (c.LevelInClass("rogue") + 1) / 2
 }
 
 // ApplySneakAttack applies sneak attack damage if eligible
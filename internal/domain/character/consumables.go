@@ -0,0 +1,195 @@
+package character
+
+import (
+	"sort"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+)
+
+// addConsumable merges a stack of consumables into the inventory (caller must hold lock)
+func (c *Character) addConsumable(stack *equipment.Consumable) {
+	quantity := max(stack.Quantity, 1)
+	for _, item := range c.Inventory[equipment.EquipmentTypeConsumable] {
+		if existing, ok := item.(*equipment.Consumable); ok && existing.GetKey() == stack.GetKey() {
+			existing.Quantity += quantity
+			return
+		}
+	}
+
+	// Copy so stacks never share a quantity with the item they came from
+	added := *stack
+	added.Quantity = quantity
+	c.Inventory[equipment.EquipmentTypeConsumable] = append(c.Inventory[equipment.EquipmentTypeConsumable], &added)
+}
+
+// ConsumableCount returns how many of a consumable the character carries
+func (c *Character) ConsumableCount(key string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	count := 0
+	for _, items := range c.Inventory {
+		for _, item := range items {
+			if item == nil || item.GetKey() != key {
+				continue
+			}
+			if consumable := equipment.AsConsumable(item); consumable != nil {
+				count += consumable.Quantity
+			}
+		}
+	}
+	return count
+}
+
+// UsableConsumables returns the potions and scrolls the character carries,
+// one per key with the total quantity, sorted by name
+func (c *Character) UsableConsumables() []*equipment.Consumable {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	byKey := make(map[string]*equipment.Consumable)
+	for _, items := range c.Inventory {
+		for _, item := range items {
+			if item == nil {
+				continue
+			}
+			consumable := equipment.AsConsumable(item)
+			if consumable == nil || !consumable.IsUsable() || consumable.Quantity <= 0 {
+				continue
+			}
+			if existing, ok := byKey[consumable.GetKey()]; ok {
+				existing.Quantity += consumable.Quantity
+				continue
+			}
+			usable := *consumable
+			byKey[consumable.GetKey()] = &usable
+		}
+	}
+
+	usable := make([]*equipment.Consumable, 0, len(byKey))
+	for _, consumable := range byKey {
+		usable = append(usable, consumable)
+	}
+	sort.Slice(usable, func(i, j int) bool {
+		return usable[i].GetName() < usable[j].GetName()
+	})
+	return usable
+}
+
+// ConsumeItem uses up one of a consumable, returning it, or nil if the
+// character has none
+func (c *Character) ConsumeItem(key string) *equipment.Consumable {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.consumeItem(key)
+}
+
+// consumeItem uses up one of a consumable (caller must hold lock)
+func (c *Character) consumeItem(key string) *equipment.Consumable {
+	// Take from stacks before plain items
+	equipTypes := []equipment.EquipmentType{equipment.EquipmentTypeConsumable}
+	for equipType := range c.Inventory {
+		if equipType != equipment.EquipmentTypeConsumable {
+			equipTypes = append(equipTypes, equipType)
+		}
+	}
+
+	for _, equipType := range equipTypes {
+		items := c.Inventory[equipType]
+		for index, item := range items {
+			if item == nil || item.GetKey() != key {
+				continue
+			}
+			consumable := equipment.AsConsumable(item)
+			if consumable == nil || consumable.Quantity <= 0 {
+				continue
+			}
+
+			used := *consumable
+			used.Quantity = 1
+			consumable.Quantity--
+			if consumable.Quantity <= 0 || consumable != item {
+				c.Inventory[equipType] = append(items[:index:index], items[index+1:]...)
+				if len(c.Inventory[equipType]) == 0 {
+					delete(c.Inventory, equipType)
+				}
+			}
+			return &used
+		}
+	}
+	return nil
+}
+
+// SpendAmmunition uses up a piece of the ammunition a weapon fires. It
+// returns the ammunition's key, or "" for weapons that don't use any, and
+// false if the character has run out.
+func (c *Character) SpendAmmunition(weapon *equipment.Weapon) (string, bool) {
+	key := equipment.AmmunitionFor(weapon)
+	if key == "" {
+		return "", true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return key, c.consumeItem(key) != nil
+}
+
+// RecoverAmmunition puts ammunition back in the inventory
+func (c *Character) RecoverAmmunition(key string, count int) {
+	if count <= 0 {
+		return
+	}
+	if ammo := equipment.NewSRDConsumable(key, count); ammo != nil {
+		c.AddInventory(ammo)
+	}
+}
+
+// Heal restores hit points, returning how many were regained
+func (c *Character) Heal(amount int) int {
+	resources := c.syncHitPoints()
+	healed := resources.HP.Heal(max(amount, 0))
+	c.CurrentHitPoints = resources.HP.Current
+	return healed
+}
+
+// CanUseItemThisTurn checks if the character can use an item with their
+// action, or with their bonus action when bonusAction is set
+func (c *Character) CanUseItemThisTurn(bonusAction bool) (bool, string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Resources == nil {
+		return true, ""
+	}
+	if bonusAction {
+		if c.Resources.ActionEconomy.BonusActionUsed {
+			return false, "You've already used your bonus action this turn"
+		}
+		return true, ""
+	}
+	if c.Resources.ActionEconomy.ActionUsed {
+		return false, "You've already used your action this turn"
+	}
+	return true, ""
+}
+
+// RecordItemUse spends the action or bonus action for using an item
+func (c *Character) RecordItemUse(itemKey string, bonusAction bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Resources == nil {
+		return
+	}
+	economy := &c.Resources.ActionEconomy
+	if bonusAction {
+		economy.BonusActionUsed = true
+		economy.RecordAction("bonus_action", "use_item", itemKey)
+	} else {
+		economy.RecordAction("use_item", "item", itemKey)
+	}
+
+	c.updateAvailableBonusActionsInternal()
+}
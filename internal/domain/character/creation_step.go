@@ -8,6 +8,8 @@ const (
 	StepTypeClassSelection           CreationStepType = "class_selection"
 	StepTypeAbilityScores            CreationStepType = "ability_scores"
 	StepTypeAbilityAssignment        CreationStepType = "ability_assignment"
+	StepTypeBackgroundSelection      CreationStepType = "background_selection"
+	StepTypePersonalitySelection     CreationStepType = "personality_selection"
 	StepTypeSkillSelection           CreationStepType = "skill_selection"
 	StepTypeLanguageSelection        CreationStepType = "language_selection"
 	StepTypeFightingStyleSelection   CreationStepType = "fighting_style_selection"
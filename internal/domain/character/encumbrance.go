@@ -0,0 +1,184 @@
+package character
+
+import (
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+)
+
+// Size is a creature's size category
+type Size string
+
+const (
+	SizeTiny       Size = "tiny"
+	SizeSmall      Size = "small"
+	SizeMedium     Size = "medium"
+	SizeLarge      Size = "large"
+	SizeHuge       Size = "huge"
+	SizeGargantuan Size = "gargantuan"
+)
+
+// CarryingMultiplier scales carrying capacity by size. Small and Medium
+// creatures carry the same amount.
+func (s Size) CarryingMultiplier() float64 {
+	switch s {
+	case SizeTiny:
+		return 0.5
+	case SizeLarge:
+		return 2
+	case SizeHuge:
+		return 4
+	case SizeGargantuan:
+		return 8
+	default:
+		return 1
+	}
+}
+
+// EncumbranceLevel describes how weighed down a character is
+type EncumbranceLevel string
+
+const (
+	EncumbranceNone         EncumbranceLevel = "unencumbered"
+	EncumbranceLight        EncumbranceLevel = "encumbered"         // Variant rule: over 5x Strength
+	EncumbranceHeavy        EncumbranceLevel = "heavily_encumbered" // Variant rule: over 10x Strength
+	EncumbranceOverCapacity EncumbranceLevel = "over_capacity"      // Over carrying capacity
+)
+
+// Name returns the level for display
+func (l EncumbranceLevel) Name() string {
+	switch l {
+	case EncumbranceLight:
+		return "Encumbered"
+	case EncumbranceHeavy:
+		return "Heavily Encumbered"
+	case EncumbranceOverCapacity:
+		return "Over Capacity"
+	default:
+		return "Unencumbered"
+	}
+}
+
+// SpeedPenalty returns how many feet of speed the level costs. Going over
+// capacity drops speed to 5 ft instead.
+func (l EncumbranceLevel) SpeedPenalty() int {
+	switch l {
+	case EncumbranceLight:
+		return 10
+	case EncumbranceHeavy:
+		return 20
+	default:
+		return 0
+	}
+}
+
+// defaultSpeed is used for characters created before speed was tracked
+const defaultSpeed = 30
+
+// Size returns the character's size. Every SRD race is Medium except
+// halflings and gnomes.
+func (c *Character) Size() Size {
+	if c.Race != nil {
+		key := strings.ToLower(c.Race.Key)
+		if strings.Contains(key, "halfling") || strings.Contains(key, "gnome") {
+			return SizeSmall
+		}
+	}
+	return SizeMedium
+}
+
+// strengthScore returns the character's Strength score, 10 if unknown
+func (c *Character) strengthScore() int {
+	if score, ok := c.Attributes[shared.AttributeStrength]; ok && score != nil {
+		return score.Score
+	}
+	return 10
+}
+
+// CarryingCapacity returns how many pounds the character can carry:
+// Strength x 15, scaled by size
+func (c *Character) CarryingCapacity() float64 {
+	return float64(c.strengthScore()*15) * c.Size().CarryingMultiplier()
+}
+
+// Load returns the total weight of the character's inventory in pounds.
+// Equipped items are part of the inventory.
+func (c *Character) Load() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var load float64
+	for _, items := range c.Inventory {
+		for _, item := range items {
+			if item != nil {
+				load += equipment.Weight(item)
+			}
+		}
+	}
+	return load
+}
+
+// Encumbrance returns how weighed down the character is. The variant
+// encumbrance rule adds the encumbered and heavily encumbered levels.
+func (c *Character) Encumbrance(variant bool) EncumbranceLevel {
+	load := c.Load()
+	perStrength := float64(c.strengthScore()) * c.Size().CarryingMultiplier()
+
+	switch {
+	case load > c.CarryingCapacity():
+		return EncumbranceOverCapacity
+	case variant && load > perStrength*10:
+		return EncumbranceHeavy
+	case variant && load > perStrength*5:
+		return EncumbranceLight
+	default:
+		return EncumbranceNone
+	}
+}
+
+// EffectiveSpeed returns the character's walking speed after encumbrance
+func (c *Character) EffectiveSpeed(variant bool) int {
+	speed := c.Speed
+	if speed == 0 {
+		speed = defaultSpeed
+	}
+
+	level := c.Encumbrance(variant)
+	if level == EncumbranceOverCapacity {
+		return min(speed, 5)
+	}
+	return max(speed-level.SpeedPenalty(), 0)
+}
+
+// HasEncumbranceDisadvantage checks if the variant encumbrance rule gives the
+// character disadvantage on Strength, Dexterity and Constitution based
+// attacks, ability checks and saving throws
+func (c *Character) HasEncumbranceDisadvantage(variant bool) bool {
+	if !variant {
+		return false
+	}
+	level := c.Encumbrance(true)
+	return level == EncumbranceHeavy || level == EncumbranceOverCapacity
+}
+
+// EncumbranceDisadvantageOn checks if the variant encumbrance rule gives the
+// character disadvantage on attacks, checks and saves using the attribute
+func (c *Character) EncumbranceDisadvantageOn(attribute shared.Attribute, variant bool) bool {
+	switch attribute {
+	case shared.AttributeStrength, shared.AttributeDexterity, shared.AttributeConstitution:
+		return c.HasEncumbranceDisadvantage(variant)
+	default:
+		return false
+	}
+}
+
+// rollD20 rolls a d20 plus the bonus, taking the lower of two rolls with
+// disadvantage
+func (c *Character) rollD20(bonus int, disadvantage bool) (*dice.RollResult, error) {
+	if disadvantage {
+		return c.getDiceRoller().RollWithDisadvantage(20, bonus)
+	}
+	return c.getDiceRoller().Roll(1, 20, bonus)
+}
@@ -0,0 +1,104 @@
+package character
+
+import (
+	"testing"
+
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCarrier creates a character with the given Strength carrying the given weights
+func newCarrier(strength int, weights ...float32) *Character {
+	char := &Character{
+		Speed: 30,
+		Attributes: map[shared.Attribute]*AbilityScore{
+			shared.AttributeStrength: {Score: strength},
+		},
+	}
+	for _, weight := range weights {
+		char.AddInventory(&equipment.BasicEquipment{Key: "sack", Name: "Sack", Weight: weight})
+	}
+	return char
+}
+
+func TestCharacter_CarryingCapacity(t *testing.T) {
+	char := newCarrier(14)
+	assert.Equal(t, 210.0, char.CarryingCapacity())
+
+	char.Race = &rulebook.Race{Key: "halfling"}
+	assert.Equal(t, SizeSmall, char.Size())
+	assert.Equal(t, 210.0, char.CarryingCapacity(), "small creatures carry as much as medium ones")
+
+	assert.Equal(t, 0.5, SizeTiny.CarryingMultiplier())
+	assert.Equal(t, 2.0, SizeLarge.CarryingMultiplier())
+}
+
+func TestCharacter_Load(t *testing.T) {
+	char := newCarrier(10, 20, 0.5)
+	char.AddInventory(&equipment.Weapon{Base: equipment.BasicEquipment{Key: "longsword", Weight: 3}})
+	char.AddInventory(&equipment.Armor{Base: equipment.BasicEquipment{Key: "chain-mail", Weight: 55}})
+
+	assert.Equal(t, 78.5, char.Load())
+}
+
+func TestCharacter_Encumbrance(t *testing.T) {
+	tests := []struct {
+		name         string
+		load         float32
+		variant      bool
+		level        EncumbranceLevel
+		speed        int
+		disadvantage bool
+	}{
+		{name: "light load", load: 50, variant: true, level: EncumbranceNone, speed: 30},
+		{name: "over 5x strength", load: 51, variant: true, level: EncumbranceLight, speed: 20},
+		{name: "over 10x strength", load: 101, variant: true, level: EncumbranceHeavy, speed: 10, disadvantage: true},
+		{name: "heavy load without the variant rule", load: 101, variant: false, level: EncumbranceNone, speed: 30},
+		{name: "over capacity", load: 151, variant: false, level: EncumbranceOverCapacity, speed: 5},
+		{name: "over capacity with the variant rule", load: 151, variant: true, level: EncumbranceOverCapacity, speed: 5, disadvantage: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			char := newCarrier(10, tt.load)
+
+			assert.Equal(t, tt.level, char.Encumbrance(tt.variant))
+			assert.Equal(t, tt.speed, char.EffectiveSpeed(tt.variant))
+			assert.Equal(t, tt.disadvantage, char.HasEncumbranceDisadvantage(tt.variant))
+		})
+	}
+}
+
+func TestCharacter_EncumbranceRolls(t *testing.T) {
+	char := newCarrier(10, 101)
+	char.Attributes[shared.AttributeWisdom] = &AbilityScore{Score: 10}
+
+	assert.True(t, char.EncumbranceDisadvantageOn(shared.AttributeStrength, true))
+	assert.True(t, char.EncumbranceDisadvantageOn(shared.AttributeConstitution, true))
+	assert.False(t, char.EncumbranceDisadvantageOn(shared.AttributeWisdom, true), "only STR, DEX and CON rolls suffer")
+	assert.False(t, char.EncumbranceDisadvantageOn(shared.AttributeStrength, false))
+
+	roller := mockdice.NewManualMockRoller()
+	char.WithDiceRoller(roller)
+
+	// Heavily loaded: the lower of two rolls counts
+	roller.SetRolls([]int{15, 4})
+	_, total, err := char.RollSavingThrow(shared.AttributeStrength, true)
+	require.NoError(t, err)
+	assert.Equal(t, 4, total)
+
+	roller.SetRolls([]int{15, 4})
+	_, total, err = char.RollSkillCheck("skill-athletics", shared.AttributeStrength, true)
+	require.NoError(t, err)
+	assert.Equal(t, 4, total)
+
+	// Wisdom saves are rolled normally
+	roller.SetRolls([]int{15})
+	_, total, err = char.RollSavingThrow(shared.AttributeWisdom, true)
+	require.NoError(t, err)
+	assert.Equal(t, 15, total)
+}
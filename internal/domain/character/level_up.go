@@ -0,0 +1,128 @@
+package character
+
+import (
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+)
+
+// Level-up steps reuse the creation step model. Subclass, fighting style,
+// cantrip and spell steps share their creation step types.
+const (
+	StepTypeLevelUpHitPoints        CreationStepType = "level_up_hit_points"
+	StepTypeLevelUpFeatures         CreationStepType = "level_up_features"
+	StepTypeAbilityScoreImprovement CreationStepType = "ability_score_improvement"
+)
+
+// Hit point methods for the level-up hit point step
+const (
+	HitPointMethodAverage = "average"
+	HitPointMethodRoll    = "roll"
+)
+
+// LevelUpProgress holds the choices for a level-up that hasn't been applied yet.
+// Nothing touches the character until every step is done and the level-up is
+// confirmed, so an abandoned level-up leaves the character unchanged.
+type LevelUpProgress struct {
+	TargetLevel int    `json:"target_level"`
+	ClassKey    string `json:"class_key,omitempty"` // Class the level is taken in; empty means the starting class
+
+	HitPointMethod string `json:"hit_point_method,omitempty"`
+	HitPointRoll   int    `json:"hit_point_roll,omitempty"` // Raw die result or average, before CON
+	HitPoints      int    `json:"hit_points,omitempty"`     // Total max HP gained
+
+	FeaturesConfirmed bool   `json:"features_confirmed,omitempty"`
+	FightingStyle     string `json:"fighting_style,omitempty"`
+	Subclass          string `json:"subclass,omitempty"`
+
+	AbilityIncreases map[shared.Attribute]int `json:"ability_increases,omitempty"`
+	Feat             string                   `json:"feat,omitempty"`
+
+	Cantrips []string `json:"cantrips,omitempty"`
+	Spells   []string `json:"spells,omitempty"`
+
+	// Proficiency choices granted by taking a new class
+	Skills      []string `json:"skills,omitempty"`
+	Instruments []string `json:"instruments,omitempty"`
+}
+
+// HasImprovement reports whether the ASI/feat choice has been made
+func (p *LevelUpProgress) HasImprovement() bool {
+	return p.Feat != "" || len(p.AbilityIncreases) > 0
+}
+
+// CanLevelUp reports whether the character can gain another level
+func (c *Character) CanLevelUp() bool {
+	return c.Class != nil && c.Level >= 1 && c.Level < rulebook.MaxLevel
+}
+
+// GetSubclassKey returns the subclass of the character's starting class,
+// or "" if none has been chosen
+func (c *Character) GetSubclassKey() string {
+	if c.Class == nil {
+		return c.SubclassKeyFor("")
+	}
+	return c.SubclassKeyFor(c.Class.Key)
+}
+
+// SubclassKeyFor returns the subclass chosen for one of the character's
+// classes, or "" if none has been chosen. Clerics store their subclass as
+// the divine domain choice. Subclass features without a class belong to the
+// starting class, as they were saved before multiclassing.
+func (c *Character) SubclassKeyFor(classKey string) string {
+	startingClass := c.Class == nil || c.Class.Key == classKey
+	for _, feature := range c.Features {
+		if feature == nil || feature.Metadata == nil {
+			continue
+		}
+		switch feature.Key {
+		case "subclass":
+			key, ok := feature.Metadata["subclass"].(string)
+			if !ok {
+				continue
+			}
+			if owner, _ := feature.Metadata["class"].(string); owner == classKey || (owner == "" && startingClass) {
+				return key
+			}
+		case "divine_domain":
+			if key, ok := feature.Metadata["domain"].(string); ok && (classKey == "cleric" || c.Class == nil) {
+				return key
+			}
+		}
+	}
+	return ""
+}
+
+// GetSubclass returns the subclass of the character's starting class, if one has been chosen
+func (c *Character) GetSubclass() (rulebook.Subclass, bool) {
+	if c.Class == nil {
+		return rulebook.Subclass{}, false
+	}
+	return c.GetSubclassFor(c.Class.Key)
+}
+
+// GetSubclassFor returns the subclass chosen for one of the character's classes
+func (c *Character) GetSubclassFor(classKey string) (rulebook.Subclass, bool) {
+	return rulebook.GetSubclass(classKey, c.SubclassKeyFor(classKey))
+}
+
+// HasFightingStyle reports whether a fighting style has been chosen
+func (c *Character) HasFightingStyle() bool {
+	for _, feature := range c.Features {
+		if feature != nil && feature.Key == "fighting_style" && feature.Metadata != nil {
+			if _, ok := feature.Metadata["style"].(string); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasFeature reports whether the character has a feature with the given key
+func (c *Character) HasFeature(key string) bool {
+	for _, feature := range c.Features {
+		if feature != nil && feature.Key == key {
+			return true
+		}
+	}
+	return false
+}
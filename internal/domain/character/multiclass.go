@@ -0,0 +1,170 @@
+package character
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+)
+
+// ClassLevel is a class a character has levels in
+type ClassLevel struct {
+	Class *rulebook.Class
+	Level int
+}
+
+// ClassLevels returns the level in each class, starting class first.
+// Characters that never multiclassed report their class at their level.
+func (c *Character) ClassLevels() []ClassLevel {
+	if len(c.Classes) == 0 {
+		if c.Class == nil {
+			return nil
+		}
+		return []ClassLevel{{Class: c.Class, Level: c.Level}}
+	}
+
+	levels := make([]ClassLevel, 0, len(c.Classes))
+	for _, classLevel := range c.Classes {
+		if classLevel != nil && classLevel.Class != nil {
+			levels = append(levels, *classLevel)
+		}
+	}
+	return levels
+}
+
+// LevelInClass returns the character's level in a class, or 0
+func (c *Character) LevelInClass(classKey string) int {
+	for _, classLevel := range c.ClassLevels() {
+		if classLevel.Class.Key == classKey {
+			return classLevel.Level
+		}
+	}
+	return 0
+}
+
+// ClassFeatureLevel returns the level a class feature like Rage scales with:
+// the level in its class, or the character level if no class is recorded
+func (c *Character) ClassFeatureLevel(classKey string) int {
+	if c.Class == nil {
+		return c.Level
+	}
+	return c.LevelInClass(classKey)
+}
+
+// IsMulticlass reports whether the character has levels in more than one class
+func (c *Character) IsMulticlass() bool {
+	return len(c.ClassLevels()) > 1
+}
+
+// AddClassLevel gives the character a level in a class, taking up the class
+// if it's new. The character level becomes the total of the class levels.
+func (c *Character) AddClassLevel(class *rulebook.Class) {
+	if class == nil {
+		return
+	}
+
+	// Single-class characters only record their level
+	if len(c.Classes) == 0 && (c.Class == nil || c.Class.Key == class.Key) {
+		c.Class = class
+		c.Level++
+		return
+	}
+
+	if len(c.Classes) == 0 {
+		for _, classLevel := range c.ClassLevels() {
+			c.Classes = append(c.Classes, &ClassLevel{Class: classLevel.Class, Level: classLevel.Level})
+		}
+	}
+
+	found := false
+	for _, classLevel := range c.Classes {
+		if classLevel != nil && classLevel.Class != nil && classLevel.Class.Key == class.Key {
+			classLevel.Level++
+			found = true
+			break
+		}
+	}
+	if !found {
+		c.Classes = append(c.Classes, &ClassLevel{Class: class, Level: 1})
+	}
+
+	total := 0
+	for _, classLevel := range c.Classes {
+		if classLevel != nil {
+			total += classLevel.Level
+		}
+	}
+	c.Level = total
+}
+
+// CanMulticlassInto checks the multiclass prerequisites for taking a level
+// in a new class. The character must meet the prerequisites of the new class
+// and of every class they already have.
+func (c *Character) CanMulticlassInto(classKey string) error {
+	if c.LevelInClass(classKey) > 0 {
+		return nil
+	}
+
+	scores := make(map[shared.Attribute]int, len(c.Attributes))
+	for attr, ability := range c.Attributes {
+		if ability != nil {
+			scores[attr] = ability.Score
+		}
+	}
+
+	keys := []string{classKey}
+	for _, classLevel := range c.ClassLevels() {
+		keys = append(keys, classLevel.Class.Key)
+	}
+	for _, key := range keys {
+		prerequisite, ok := rulebook.GetMulticlassPrerequisite(key)
+		if !ok {
+			return fmt.Errorf("%s can't be multiclassed", key)
+		}
+		if !prerequisite.Met(scores) {
+			return fmt.Errorf("multiclassing with %s requires %s", key, prerequisite)
+		}
+	}
+	return nil
+}
+
+// ClassSummary describes the character's classes, e.g. "Fighter 3 / Wizard 2".
+// Single-class characters just get the class name.
+func (c *Character) ClassSummary() string {
+	levels := c.ClassLevels()
+	if len(levels) == 1 {
+		return levels[0].Class.Name
+	}
+
+	parts := make([]string, 0, len(levels))
+	for _, classLevel := range levels {
+		parts = append(parts, fmt.Sprintf("%s %d", classLevel.Class.Name, classLevel.Level))
+	}
+	return strings.Join(parts, " / ")
+}
+
+// HitDieFor returns the hit die size of one of the character's classes. It
+// fails if the class wasn't loaded from the rulebook.
+func (c *Character) HitDieFor(class *rulebook.Class) (int, error) {
+	if class == nil {
+		return 0, errors.New("no class to get the hit die of")
+	}
+	if class.HitDie == 0 {
+		return 0, fmt.Errorf("class %s has no hit die loaded", class.Key)
+	}
+	return class.HitDie, nil
+}
+
+// hitDiceByType counts the character's hit dice by die size, skipping
+// classes without their rulebook data
+func (c *Character) hitDiceByType() map[int]int {
+	dice := make(map[int]int)
+	for _, classLevel := range c.ClassLevels() {
+		if hitDie, err := c.HitDieFor(classLevel.Class); err == nil {
+			dice[hitDie] += classLevel.Level
+		}
+	}
+	return dice
+}
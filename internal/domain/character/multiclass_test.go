@@ -0,0 +1,169 @@
+package character
+
+import (
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMulticlassCharacter(levels ...ClassLevel) *Character {
+	char := &Character{MaxHitPoints: 30, CurrentHitPoints: 30}
+	for _, classLevel := range levels {
+		class := classLevel.Class
+		if char.Class == nil {
+			char.Class = class
+		}
+		char.Classes = append(char.Classes, &ClassLevel{Class: class, Level: classLevel.Level})
+		char.Level += classLevel.Level
+	}
+	return char
+}
+
+// testHitDice are the PHB hit dice of the classes the tests use
+var testHitDice = map[string]int{
+	"fighter": 10, "paladin": 10, "cleric": 8, "rogue": 8, "warlock": 8, "sorcerer": 6, "wizard": 6,
+}
+
+func testClass(key string) *rulebook.Class {
+	return &rulebook.Class{Key: key, Name: key, HitDie: testHitDice[key]}
+}
+
+func TestHitDieFor(t *testing.T) {
+	char := &Character{Class: testClass("fighter"), Level: 1}
+
+	hitDie, err := char.HitDieFor(testClass("wizard"))
+	require.NoError(t, err)
+	assert.Equal(t, 6, hitDie)
+
+	_, err = char.HitDieFor(&rulebook.Class{Key: "wizard"})
+	assert.Error(t, err, "a class without rulebook data has no hit die")
+	_, err = char.HitDieFor(nil)
+	assert.Error(t, err)
+}
+
+func TestAddClassLevel(t *testing.T) {
+	fighter := testClass("fighter")
+	char := &Character{Class: fighter, Level: 2}
+
+	char.AddClassLevel(fighter)
+	assert.Equal(t, 3, char.Level)
+	assert.Empty(t, char.Classes, "single-class characters only record their level")
+	assert.False(t, char.IsMulticlass())
+
+	char.AddClassLevel(testClass("wizard"))
+	char.AddClassLevel(fighter)
+	assert.Equal(t, 5, char.Level)
+	assert.Equal(t, 4, char.LevelInClass("fighter"))
+	assert.Equal(t, 1, char.LevelInClass("wizard"))
+	assert.True(t, char.IsMulticlass())
+	assert.Equal(t, "fighter 4 / wizard 1", char.ClassSummary())
+	assert.Equal(t, 3, char.GetProficiencyBonus(), "proficiency uses the total level")
+}
+
+func TestCanMulticlassInto(t *testing.T) {
+	char := &Character{Class: testClass("wizard"), Level: 3}
+	char.AddAttribute(shared.AttributeStrength, 10)
+	char.AddAttribute(shared.AttributeDexterity, 14)
+	char.AddAttribute(shared.AttributeIntelligence, 15)
+	char.AddAttribute(shared.AttributeCharisma, 8)
+
+	assert.NoError(t, char.CanMulticlassInto("fighter"), "DEX 14 qualifies")
+	assert.NoError(t, char.CanMulticlassInto("wizard"), "their own class")
+
+	err := char.CanMulticlassInto("sorcerer")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Charisma 13")
+
+	// The current class's prerequisite applies too
+	char.Attributes[shared.AttributeIntelligence].Score = 12
+	assert.Error(t, char.CanMulticlassInto("fighter"))
+}
+
+func TestInitializeResources_Multiclass(t *testing.T) {
+	t.Run("spellcasters combine on the multiclass table", func(t *testing.T) {
+		char := newMulticlassCharacter(ClassLevel{testClass("wizard"), 3}, ClassLevel{testClass("cleric"), 2})
+		char.InitializeResources()
+
+		assert.Equal(t, 4, char.Resources.SpellSlots[1].Max)
+		assert.Equal(t, 3, char.Resources.SpellSlots[2].Max)
+		assert.Equal(t, 2, char.Resources.SpellSlots[3].Max, "caster level 5")
+	})
+
+	t.Run("a single spellcasting class keeps its own table", func(t *testing.T) {
+		char := newMulticlassCharacter(ClassLevel{testClass("paladin"), 4}, ClassLevel{testClass("fighter"), 2})
+		char.InitializeResources()
+
+		assert.Equal(t, 3, char.Resources.SpellSlots[1].Max)
+		assert.NotContains(t, char.Resources.SpellSlots, 2)
+	})
+
+	t.Run("pact slots join spellcasting slots of the same level", func(t *testing.T) {
+		char := newMulticlassCharacter(ClassLevel{testClass("sorcerer"), 1}, ClassLevel{testClass("warlock"), 1})
+		char.InitializeResources()
+
+		slot := char.Resources.SpellSlots[1]
+		assert.Equal(t, 3, slot.Max)
+		assert.Equal(t, 1, slot.PactMax)
+		assert.Equal(t, 1, char.Resources.PactSlotLevel())
+
+		char.Resources.UseSpellSlot(1)
+		char.Resources.UseSpellSlot(1)
+		char.Resources.ShortRest()
+		assert.Equal(t, 2, char.Resources.SpellSlots[1].Remaining, "only the pact slot comes back")
+	})
+
+	t.Run("hit dice are pooled by die size", func(t *testing.T) {
+		char := newMulticlassCharacter(ClassLevel{testClass("fighter"), 3}, ClassLevel{testClass("paladin"), 1}, ClassLevel{testClass("wizard"), 2})
+		char.InitializeResources()
+
+		assert.Equal(t, shared.HitDicePools{
+			{DiceType: 10, Max: 4, Remaining: 4},
+			{DiceType: 6, Max: 2, Remaining: 2},
+		}, char.Resources.HitDice)
+		assert.Equal(t, 10, char.HitDieType())
+	})
+}
+
+func TestSpellcasting_Multiclass(t *testing.T) {
+	char := newMulticlassCharacter(
+		ClassLevel{Class: testClass("cleric"), Level: 3},
+		ClassLevel{Class: testClass("sorcerer"), Level: 2},
+	)
+	char.AddAttribute(shared.AttributeWisdom, 16)
+	char.AddAttribute(shared.AttributeCharisma, 12)
+	char.Spells = &SpellList{
+		KnownSpells:    []string{"magic-missile", "cure-wounds"},
+		PreparedSpells: []string{"bless"},
+	}
+	magicMissile := &rulebook.Spell{Key: "magic-missile", Level: 1, Classes: []string{"sorcerer", "wizard"}}
+	cureWounds := &rulebook.Spell{Key: "cure-wounds", Level: 1, Classes: []string{"cleric"}}
+	bless := &rulebook.Spell{Key: "bless", Level: 1, Classes: []string{"cleric"}}
+
+	assert.True(t, char.CanCastSpell(magicMissile), "sorcerers cast the spells they know")
+	assert.False(t, char.CanCastSpell(cureWounds), "clerics cast the spells they prepared")
+	assert.True(t, char.CanCastSpell(bless))
+
+	assert.Equal(t, shared.AttributeCharisma, char.SpellcastingAbilityFor(magicMissile))
+	assert.Equal(t, shared.AttributeWisdom, char.SpellcastingAbilityFor(bless))
+	assert.Equal(t, char.GetProficiencyBonus()+1, char.GetSpellAttackBonus(magicMissile))
+	assert.Equal(t, 6, char.MaxPreparedSpells(), "WIS +3 plus cleric level 3")
+}
+
+func TestSneakAttack_Multiclass(t *testing.T) {
+	rapier := &equipment.Weapon{
+		Base:        equipment.BasicEquipment{Key: "rapier", Name: "Rapier"},
+		WeaponRange: "Melee",
+		Properties:  []*shared.ReferenceItem{{Key: "finesse"}},
+	}
+
+	char := newMulticlassCharacter(ClassLevel{Class: testClass("fighter"), Level: 3}, ClassLevel{Class: testClass("rogue"), Level: 1})
+	assert.True(t, char.CanSneakAttack(rapier, true, false, false), "a rogue level grants sneak attack")
+	assert.Equal(t, 1, char.GetSneakAttackDice(), "dice follow the rogue level, not the total level")
+
+	fighter := newMulticlassCharacter(ClassLevel{Class: testClass("fighter"), Level: 4})
+	assert.False(t, fighter.CanSneakAttack(rapier, true, false, false))
+}
@@ -0,0 +1,79 @@
+package character
+
+import (
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+)
+
+// Personality holds the entries a character picked or rolled from their
+// background's personality tables
+type Personality struct {
+	Traits []string `json:"traits,omitempty"`
+	Ideal  string   `json:"ideal,omitempty"`
+	Bond   string   `json:"bond,omitempty"`
+	Flaw   string   `json:"flaw,omitempty"`
+}
+
+// Get returns the entries picked from a table
+func (p *Personality) Get(table rulebook.PersonalityTable) []string {
+	if p == nil {
+		return nil
+	}
+
+	switch table {
+	case rulebook.PersonalityTableTraits:
+		return p.Traits
+	case rulebook.PersonalityTableIdeals:
+		return nonEmpty(p.Ideal)
+	case rulebook.PersonalityTableBonds:
+		return nonEmpty(p.Bond)
+	case rulebook.PersonalityTableFlaws:
+		return nonEmpty(p.Flaw)
+	default:
+		return nil
+	}
+}
+
+// Set replaces the entries picked from a table
+func (p *Personality) Set(table rulebook.PersonalityTable, entries []string) {
+	first := ""
+	if len(entries) > 0 {
+		first = entries[0]
+	}
+
+	switch table {
+	case rulebook.PersonalityTableTraits:
+		p.Traits = append([]string(nil), entries...)
+	case rulebook.PersonalityTableIdeals:
+		p.Ideal = first
+	case rulebook.PersonalityTableBonds:
+		p.Bond = first
+	case rulebook.PersonalityTableFlaws:
+		p.Flaw = first
+	}
+}
+
+// IsTableComplete checks if enough entries have been picked from a table
+func (p *Personality) IsTableComplete(table rulebook.PersonalityTable) bool {
+	return len(p.Get(table)) >= table.Picks()
+}
+
+// IsComplete checks if every personality table has been picked from
+func (p *Personality) IsComplete() bool {
+	if p == nil {
+		return false
+	}
+
+	for _, table := range rulebook.PersonalityTables {
+		if !p.IsTableComplete(table) {
+			return false
+		}
+	}
+	return true
+}
+
+func nonEmpty(entry string) []string {
+	if entry == "" {
+		return nil
+	}
+	return []string{entry}
+}
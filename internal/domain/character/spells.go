@@ -1,12 +1,24 @@
 package character
 
-import "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+import (
+	"fmt"
+	"slices"
+	"strings"
 
-// SpellList represents a character's known/prepared spells
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+)
+
+// SpellList represents a character's known/prepared spells.
+// For wizards KnownSpells is the spellbook they prepare from.
 type SpellList struct {
 	KnownSpells    []string `json:"known_spells"`    // Spell keys for known spells
 	PreparedSpells []string `json:"prepared_spells"` // Spell keys for prepared spells (wizards, clerics, etc.)
 	Cantrips       []string `json:"cantrips"`        // Cantrip keys
+
+	// CanChangePrepared is set by a long rest and cleared once the
+	// character has finished preparing spells
+	CanChangePrepared bool `json:"can_change_prepared,omitempty"`
 }
 
 // AddKnownSpell adds a spell to the known spells list
@@ -41,48 +53,255 @@ func (c *Character) AddCantrip(cantripKey string) {
 	c.Spells.Cantrips = append(c.Spells.Cantrips, cantripKey)
 }
 
-// GetSpellSlotsForLevel returns how many spells a character knows at a given level
+// GetSpellSlotsForLevel returns how many cantrips and spells a character knows at a given level
 func GetSpellSlotsForLevel(class *rulebook.Class, level int) (cantrips, spellsKnown int) {
-	// This would be populated from class data
-	// For now, hardcode some basics
-	switch class.Key {
-	case "bard":
-		// Bards know spells
-		cantrips = 2 // 2 cantrips at level 1
-		if level >= 4 {
-			cantrips = 3
+	if class == nil {
+		return 0, 0
+	}
+	return rulebook.CantripsKnown(class.Key, level), rulebook.SpellsKnown(class.Key, level)
+}
+
+// CanCastSpell reports whether the spell is one of the character's cantrips,
+// known spells or prepared spells. A known spell casts through the class it
+// belongs to: classes like the sorcerer cast what they know, while prepared
+// casters only cast prepared spells, with known spells standing in until
+// they first prepare.
+func (c *Character) CanCastSpell(spell *rulebook.Spell) bool {
+	if c.Spells == nil || spell == nil {
+		return false
+	}
+	if slices.Contains(c.Spells.Cantrips, spell.Key) || slices.Contains(c.Spells.PreparedSpells, spell.Key) {
+		return true
+	}
+	if !slices.Contains(c.Spells.KnownSpells, spell.Key) {
+		return false
+	}
+	for _, class := range c.spellClasses(spell) {
+		if !rulebook.PreparesSpells(class.Key) || len(c.Spells.PreparedSpells) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasSpellOnClassList reports whether the spell is on the spell list of one
+// of the character's classes
+func (c *Character) HasSpellOnClassList(spell *rulebook.Spell) bool {
+	if spell == nil {
+		return false
+	}
+	for _, classLevel := range c.ClassLevels() {
+		if onSpellList(spell, rulebook.SpellListClass(classLevel.Class.Key, c.SubclassKeyFor(classLevel.Class.Key))) {
+			return true
+		}
+	}
+	return false
+}
+
+// spellClasses returns the character's classes with the spell on their list.
+// Single-class characters cast everything through their class.
+func (c *Character) spellClasses(spell *rulebook.Spell) []*rulebook.Class {
+	levels := c.ClassLevels()
+	if len(levels) == 1 {
+		return []*rulebook.Class{levels[0].Class}
+	}
+
+	var classes []*rulebook.Class
+	for _, classLevel := range levels {
+		if onSpellList(spell, rulebook.SpellListClass(classLevel.Class.Key, c.SubclassKeyFor(classLevel.Class.Key))) {
+			classes = append(classes, classLevel.Class)
+		}
+	}
+	return classes
+}
+
+func onSpellList(spell *rulebook.Spell, classKey string) bool {
+	return slices.ContainsFunc(spell.Classes, func(key string) bool {
+		return strings.EqualFold(key, classKey)
+	})
+}
+
+// PreparesSpells reports whether any of the character's classes prepares spells daily
+func (c *Character) PreparesSpells() bool {
+	for _, classLevel := range c.ClassLevels() {
+		if rulebook.PreparesSpells(classLevel.Class.Key) {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxPreparedSpells returns how many spells the character can have prepared,
+// adding up the limit of each class that prepares spells
+func (c *Character) MaxPreparedSpells() int {
+	total := 0
+	for _, classLevel := range c.ClassLevels() {
+		classKey := classLevel.Class.Key
+		if !rulebook.PreparesSpells(classKey) {
+			continue
+		}
+		modifier := 0
+		if ability := c.Attributes[rulebook.SpellcastingAbility(classKey, c.SubclassKeyFor(classKey))]; ability != nil {
+			modifier = ability.Bonus
+		}
+		total += rulebook.PreparedSpellLimit(classKey, classLevel.Level, modifier)
+	}
+	return total
+}
+
+// CanPrepareSpells reports whether the character may change their prepared
+// spells now: after a long rest, until they finish preparing
+func (c *Character) CanPrepareSpells() bool {
+	if !c.PreparesSpells() || c.MaxPreparedSpells() == 0 {
+		return false
+	}
+	return c.Spells != nil && c.Spells.CanChangePrepared
+}
+
+// OpenSpellPreparation lets a prepared caster change their prepared spells,
+// as they can after a long rest
+func (c *Character) OpenSpellPreparation() {
+	if !c.PreparesSpells() {
+		return
+	}
+	if c.Spells == nil {
+		c.Spells = &SpellList{}
+	}
+	c.Spells.CanChangePrepared = true
+}
+
+// InSpellbook reports whether a wizard has the spell in their spellbook
+func (c *Character) InSpellbook(spellKey string) bool {
+	return c.Spells != nil && slices.Contains(c.Spells.KnownSpells, spellKey)
+}
+
+// CanCastAsRitual reports whether the character can cast the spell as a
+// ritual. Wizards cast rituals from their spellbook without preparing them;
+// bards, clerics and druids need the spell known or prepared.
+func (c *Character) CanCastAsRitual(spell *rulebook.Spell) bool {
+	if spell == nil || !spell.Ritual {
+		return false
+	}
+	for _, class := range c.spellClasses(spell) {
+		if !rulebook.CanCastRituals(class.Key) {
+			continue
 		}
-		if level >= 10 {
-			cantrips = 4
+		if class.Key == "wizard" {
+			if c.InSpellbook(spell.Key) {
+				return true
+			}
+		} else if c.CanCastSpell(spell) {
+			return true
 		}
+	}
+	return false
+}
 
-		// Spells known starts at 4 and increases
-		spellsKnown = 4
-		if level >= 2 {
-			spellsKnown = 5
+// GetSpellcastingAbility returns the ability the character casts with, or "" if
+// they can't cast. Multiclass characters use their first spellcasting class;
+// see SpellcastingAbilityFor for a particular spell.
+func (c *Character) GetSpellcastingAbility() shared.Attribute {
+	for _, classLevel := range c.ClassLevels() {
+		if ability := rulebook.SpellcastingAbility(classLevel.Class.Key, c.SubclassKeyFor(classLevel.Class.Key)); ability != "" {
+			return ability
 		}
-		if level >= 3 {
-			spellsKnown = 6
+	}
+	return ""
+}
+
+// SpellcastingAbilityFor returns the ability the character casts a spell
+// with: that of the class the spell belongs to
+func (c *Character) SpellcastingAbilityFor(spell *rulebook.Spell) shared.Attribute {
+	if spell != nil {
+		for _, class := range c.spellClasses(spell) {
+			if ability := rulebook.SpellcastingAbility(class.Key, c.SubclassKeyFor(class.Key)); ability != "" {
+				return ability
+			}
 		}
-		// ... etc
+	}
+	return c.GetSpellcastingAbility()
+}
 
-	case "wizard":
-		// Wizards prepare spells from spellbook
-		cantrips = 3 // 3 cantrips at level 1
-		if level >= 4 {
-			cantrips = 4
+// GetSpellSaveDC returns 8 + proficiency bonus + spellcasting ability modifier
+// for the spell, or for the character's main spellcasting ability if nil
+func (c *Character) GetSpellSaveDC(spell *rulebook.Spell) int {
+	return 8 + c.GetSpellAttackBonus(spell)
+}
+
+// GetSpellAttackBonus returns proficiency bonus + spellcasting ability modifier
+// for the spell, or for the character's main spellcasting ability if nil
+func (c *Character) GetSpellAttackBonus(spell *rulebook.Spell) int {
+	bonus := c.GetProficiencyBonus()
+	if ability := c.Attributes[c.SpellcastingAbilityFor(spell)]; ability != nil {
+		bonus += ability.Bonus
+	}
+	return bonus
+}
+
+// CanCastSpellThisTurn checks the action economy for a spell of the given
+// level and casting time. It returns false with a reason when the spell
+// can't be cast right now.
+func (c *Character) CanCastSpellThisTurn(level int, castingTime string) (bool, string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Resources == nil {
+		return true, ""
+	}
+	economy := &c.Resources.ActionEconomy
+
+	switch castingTime {
+	case rulebook.CastingTimeAction, "":
+		if economy.ActionUsed {
+			return false, "You've already used your action this turn"
+		}
+		if economy.BonusActionSpellCast && level > 0 {
+			return false, "After a bonus action spell you can only cast a cantrip with your action"
 		}
-		if level >= 10 {
-			cantrips = 5
+	case rulebook.CastingTimeBonusAction:
+		if economy.BonusActionUsed {
+			return false, "You've already used your bonus action this turn"
 		}
+		if economy.LeveledSpellCast {
+			return false, "You can't cast a bonus action spell after casting a leveled spell this turn"
+		}
+	case rulebook.CastingTimeReaction:
+		if economy.ReactionUsed {
+			return false, "You've already used your reaction"
+		}
+		if economy.BonusActionSpellCast {
+			return false, "After a bonus action spell you can only cast a cantrip with your action"
+		}
+	default:
+		return false, fmt.Sprintf("Takes %s to cast", castingTime)
+	}
+	return true, ""
+}
+
+// RecordSpellCast spends the action, bonus action or reaction for a spell
+func (c *Character) RecordSpellCast(spellKey string, level int, castingTime string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-		// Wizards start with 6 spells in spellbook
-		spellsKnown = 6
-		// +2 per level
-		spellsKnown += (level - 1) * 2
+	if c.Resources == nil {
+		return
+	}
+	economy := &c.Resources.ActionEconomy
 
-		// ... other classes
+	switch castingTime {
+	case rulebook.CastingTimeBonusAction:
+		economy.BonusActionUsed = true
+		economy.BonusActionSpellCast = true
+		economy.RecordAction("bonus_action", "spell", spellKey)
+	case rulebook.CastingTimeReaction:
+		economy.ReactionUsed = true
+		economy.RecordAction("reaction", "spell", spellKey)
+	default:
+		economy.RecordAction("spell", "action", spellKey)
+	}
+	if level > 0 {
+		economy.LeveledSpellCast = true
 	}
 
-	return cantrips, spellsKnown
+	c.updateAvailableBonusActionsInternal()
 }
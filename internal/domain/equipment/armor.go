@@ -21,11 +21,12 @@ type ArmorClass struct {
 }
 
 type Armor struct {
-	Base                BasicEquipment `json:"base"`
-	ArmorCategory       ArmorCategory  `json:"armor_category"`
-	ArmorClass          *ArmorClass    `json:"armor_class"`
-	StrMin              int            `json:"str_minimum"`
-	StealthDisadvantage bool           `json:"stealth_disadvantage"`
+	Base                BasicEquipment   `json:"base"`
+	ArmorCategory       ArmorCategory    `json:"armor_category"`
+	ArmorClass          *ArmorClass      `json:"armor_class"`
+	StrMin              int              `json:"str_minimum"`
+	StealthDisadvantage bool             `json:"stealth_disadvantage"`
+	Magic               *MagicProperties `json:"magic,omitempty"`
 }
 
 func (e *Armor) GetEquipmentType() EquipmentType {
@@ -48,6 +49,14 @@ func (e *Armor) GetSlot() shared.Slot {
 	return shared.SlotBody
 }
 
+func (e *Armor) GetCost() *shared.Cost {
+	return e.Base.Cost
+}
+
+func (e *Armor) GetWeight() float32 {
+	return e.Base.Weight
+}
+
 // GetACBase returns the base AC for this armor
 func (e *Armor) GetACBase() int {
 	if e.ArmorClass != nil && e.ArmorClass.Base > 0 {
@@ -26,3 +26,11 @@ func (e *BasicEquipment) GetKey() string {
 func (e *BasicEquipment) GetSlot() shared.Slot {
 	return shared.SlotNone
 }
+
+func (e *BasicEquipment) GetCost() *shared.Cost {
+	return e.Cost
+}
+
+func (e *BasicEquipment) GetWeight() float32 {
+	return e.Weight
+}
@@ -0,0 +1,69 @@
+package equipment
+
+import (
+	"slices"
+)
+
+// Clone returns a copy of an item that shares no mutable state with the
+// original, so spending one copy's charges or stack doesn't touch the other.
+// Unknown equipment types are returned as is.
+func Clone(e Equipment) Equipment {
+	switch item := e.(type) {
+	case *BasicEquipment:
+		clone := item.clone()
+		return &clone
+	case *Weapon:
+		clone := *item
+		clone.Base = item.Base.clone()
+		if item.Damage != nil {
+			damage := *item.Damage
+			clone.Damage = &damage
+		}
+		if item.TwoHandedDamage != nil {
+			damage := *item.TwoHandedDamage
+			clone.TwoHandedDamage = &damage
+		}
+		clone.Properties = slices.Clone(item.Properties)
+		clone.Magic = item.Magic.clone()
+		return &clone
+	case *Armor:
+		clone := *item
+		clone.Base = item.Base.clone()
+		if item.ArmorClass != nil {
+			armorClass := *item.ArmorClass
+			clone.ArmorClass = &armorClass
+		}
+		clone.Magic = item.Magic.clone()
+		return &clone
+	case *MagicItem:
+		clone := *item
+		clone.Base = item.Base.clone()
+		clone.Magic = *item.Magic.clone()
+		return &clone
+	case *Consumable:
+		clone := *item
+		clone.Base = item.Base.clone()
+		clone.Effect.Buffs = slices.Clone(item.Effect.Buffs)
+		return &clone
+	default:
+		return e
+	}
+}
+
+func (e *BasicEquipment) clone() BasicEquipment {
+	clone := *e
+	if e.Cost != nil {
+		cost := *e.Cost
+		clone.Cost = &cost
+	}
+	return clone
+}
+
+func (m *MagicProperties) clone() *MagicProperties {
+	if m == nil {
+		return nil
+	}
+	clone := *m
+	clone.Bonuses = slices.Clone(m.Bonuses)
+	return &clone
+}
@@ -0,0 +1,268 @@
+package equipment
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/effects"
+)
+
+const (
+	// EquipmentTypeConsumable is the inventory type for potions, scrolls and ammunition
+	EquipmentTypeConsumable EquipmentType = "consumable"
+
+	// scrollKeyPrefix starts the keys of spell scrolls, e.g. spell-scroll-shield
+	scrollKeyPrefix = "spell-scroll-"
+)
+
+// ConsumableKind is what sort of consumable an item is
+type ConsumableKind string
+
+const (
+	ConsumablePotion     ConsumableKind = "potion"
+	ConsumableScroll     ConsumableKind = "scroll"
+	ConsumableAmmunition ConsumableKind = "ammunition"
+)
+
+// BuffModifier is a modifier a consumable grants for a while, such as
+// resistance to fire
+type BuffModifier struct {
+	Target     effects.ModifierTarget `json:"target"`
+	Value      string                 `json:"value"`
+	DamageType string                 `json:"damage_type,omitempty"`
+}
+
+// ConsumableEffect is what happens when a consumable is used
+type ConsumableEffect struct {
+	// Healing rolled as HealingDice d HealingDieSize + HealingBonus
+	HealingDice    int `json:"healing_dice,omitempty"`
+	HealingDieSize int `json:"healing_die_size,omitempty"`
+	HealingBonus   int `json:"healing_bonus,omitempty"`
+
+	// Spell cast from a scroll at SpellLevel
+	Spell      string `json:"spell,omitempty"`
+	SpellLevel int    `json:"spell_level,omitempty"`
+
+	// Buffs last BuffRounds rounds
+	Buffs      []BuffModifier `json:"buffs,omitempty"`
+	BuffRounds int            `json:"buff_rounds,omitempty"`
+}
+
+// Heals checks if the effect restores hit points
+func (e *ConsumableEffect) Heals() bool {
+	return e.HealingDice > 0 || e.HealingBonus > 0
+}
+
+// HealingString returns the healing as dice notation, e.g. "2d4+2"
+func (e *ConsumableEffect) HealingString() string {
+	if e.HealingBonus == 0 {
+		return fmt.Sprintf("%dd%d", e.HealingDice, e.HealingDieSize)
+	}
+	return fmt.Sprintf("%dd%d%+d", e.HealingDice, e.HealingDieSize, e.HealingBonus)
+}
+
+// Consumable is a stack of items that are used up, like potions, spell
+// scrolls and arrows
+type Consumable struct {
+	Base     BasicEquipment   `json:"base"`
+	Kind     ConsumableKind   `json:"kind"`
+	Quantity int              `json:"quantity"`
+	Rarity   Rarity           `json:"rarity,omitempty"`
+	Effect   ConsumableEffect `json:"effect"`
+}
+
+func (c *Consumable) GetEquipmentType() EquipmentType {
+	return EquipmentTypeConsumable
+}
+
+func (c *Consumable) GetName() string {
+	return c.Base.Name
+}
+
+func (c *Consumable) GetKey() string {
+	return c.Base.Key
+}
+
+func (c *Consumable) GetSlot() shared.Slot {
+	return shared.SlotNone
+}
+
+func (c *Consumable) GetCost() *shared.Cost {
+	return c.Base.Cost
+}
+
+// GetWeight returns the weight of the whole stack
+func (c *Consumable) GetWeight() float32 {
+	return c.Base.Weight * float32(max(c.Quantity, 0))
+}
+
+// IsUsable checks if the consumable can be used on its own, unlike
+// ammunition which is spent by attacking
+func (c *Consumable) IsUsable() bool {
+	return c.Kind == ConsumablePotion || c.Kind == ConsumableScroll
+}
+
+// BuffEffect returns the status effect a consumable's buffs grant, or nil
+// if it grants none
+func (c *Consumable) BuffEffect() *effects.StatusEffect {
+	if len(c.Effect.Buffs) == 0 {
+		return nil
+	}
+
+	builder := effects.NewBuilder(c.Base.Name).
+		WithSource(effects.SourceItem, c.Base.Key).
+		WithDescription(fmt.Sprintf("Granted by %s.", c.Base.Name)).
+		WithDuration(effects.DurationRounds, c.Effect.BuffRounds)
+	for _, buff := range c.Effect.Buffs {
+		builder.AddModifierWithDetails(buff.Target, buff.Value, "", "", buff.DamageType, "")
+	}
+	return builder.Build()
+}
+
+// resistancePotion makes a potion of resistance to a damage type
+func resistancePotion(damageType string) func() *Consumable {
+	return func() *Consumable {
+		return &Consumable{
+			Base: BasicEquipment{
+				Key:    fmt.Sprintf("potion-of-%s-resistance", damageType),
+				Name:   fmt.Sprintf("Potion of %s Resistance", strings.ToUpper(damageType[:1])+damageType[1:]),
+				Cost:   &shared.Cost{Quantity: 300, Unit: "gp"},
+				Weight: 0.5,
+			},
+			Kind:   ConsumablePotion,
+			Rarity: RarityUncommon,
+			Effect: ConsumableEffect{
+				Buffs:      []BuffModifier{{Target: effects.TargetResistance, Value: "resistance", DamageType: damageType}},
+				BuffRounds: 600, // 1 hour
+			},
+		}
+	}
+}
+
+// healingPotion makes a potion of healing
+func healingPotion(key, name string, dice, bonus, price int, rarity Rarity) func() *Consumable {
+	return func() *Consumable {
+		return &Consumable{
+			Base: BasicEquipment{
+				Key:    key,
+				Name:   name,
+				Cost:   &shared.Cost{Quantity: price, Unit: "gp"},
+				Weight: 0.5,
+			},
+			Kind:   ConsumablePotion,
+			Rarity: rarity,
+			Effect: ConsumableEffect{HealingDice: dice, HealingDieSize: 4, HealingBonus: bonus},
+		}
+	}
+}
+
+// ammunition makes a single piece of ammunition
+func ammunition(key, name string, copper int, weight float32) func() *Consumable {
+	return func() *Consumable {
+		return &Consumable{
+			Base: BasicEquipment{
+				Key:    key,
+				Name:   name,
+				Cost:   &shared.Cost{Quantity: copper, Unit: "cp"},
+				Weight: weight,
+			},
+			Kind: ConsumableAmmunition,
+		}
+	}
+}
+
+// srdConsumables are the SRD consumables the bot knows about besides spell scrolls
+var srdConsumables = map[string]func() *Consumable{
+	"potion-of-healing":          healingPotion("potion-of-healing", "Potion of Healing", 2, 2, 50, RarityCommon),
+	"potion-of-greater-healing":  healingPotion("potion-of-greater-healing", "Potion of Greater Healing", 4, 4, 150, RarityUncommon),
+	"potion-of-superior-healing": healingPotion("potion-of-superior-healing", "Potion of Superior Healing", 8, 8, 450, RarityRare),
+	"potion-of-supreme-healing":  healingPotion("potion-of-supreme-healing", "Potion of Supreme Healing", 10, 20, 1350, RarityVeryRare),
+	"potion-of-fire-resistance":  resistancePotion("fire"),
+	"potion-of-cold-resistance":  resistancePotion("cold"),
+	"arrow":                      ammunition("arrow", "Arrow", 5, 0.05),
+	"crossbow-bolt":              ammunition("crossbow-bolt", "Crossbow Bolt", 5, 0.075),
+	"sling-bullet":               ammunition("sling-bullet", "Sling Bullet", 1, 0.075),
+	"blowgun-needle":             ammunition("blowgun-needle", "Blowgun Needle", 2, 0.02),
+}
+
+// scrollRarities is the rarity of a spell scroll by spell level
+var scrollRarities = []Rarity{
+	RarityCommon, RarityCommon, RarityUncommon, RarityUncommon, RarityRare,
+	RarityRare, RarityVeryRare, RarityVeryRare, RarityVeryRare, RarityLegendary,
+}
+
+// NewSpellScroll makes a scroll of a spell at the given level
+func NewSpellScroll(spellKey, spellName string, level int) *Consumable {
+	level = min(max(level, 0), 9)
+	return &Consumable{
+		Base: BasicEquipment{
+			Key:  scrollKeyPrefix + spellKey,
+			Name: fmt.Sprintf("Spell Scroll (%s)", spellName),
+		},
+		Kind:     ConsumableScroll,
+		Quantity: 1,
+		Rarity:   scrollRarities[level],
+		Effect:   ConsumableEffect{Spell: spellKey, SpellLevel: level},
+	}
+}
+
+// ScrollSpell returns the spell a spell scroll key holds, or "" if the key
+// isn't a spell scroll
+func ScrollSpell(key string) string {
+	if spellKey, ok := strings.CutPrefix(key, scrollKeyPrefix); ok {
+		return spellKey
+	}
+	return ""
+}
+
+// NewSRDConsumable returns one of an SRD potion or piece of ammunition, or
+// nil if the key is unknown. Spell scrolls are made with NewSpellScroll.
+func NewSRDConsumable(key string, quantity int) *Consumable {
+	create, ok := srdConsumables[key]
+	if !ok {
+		return nil
+	}
+	item := create()
+	item.Quantity = quantity
+	return item
+}
+
+// AsConsumable returns the consumable an inventory item is. Plain items
+// with the key of an SRD consumable, like the arrows in starting equipment,
+// count as one each.
+func AsConsumable(e Equipment) *Consumable {
+	switch item := e.(type) {
+	case *Consumable:
+		return item
+	case *BasicEquipment:
+		return NewSRDConsumable(item.Key, 1)
+	default:
+		return nil
+	}
+}
+
+// AmmunitionFor returns the ammunition a weapon fires, or "" if it doesn't
+// use any
+func AmmunitionFor(weapon *Weapon) string {
+	if weapon == nil || !weapon.HasProperty("ammunition") {
+		return ""
+	}
+
+	key := weapon.Base.Key
+	if weapon.Magic != nil && weapon.Magic.BaseItem != "" {
+		key = weapon.Magic.BaseItem
+	}
+	switch {
+	case strings.HasSuffix(key, "bow") && !strings.HasPrefix(key, "crossbow"):
+		return "arrow"
+	case strings.HasPrefix(key, "crossbow"):
+		return "crossbow-bolt"
+	case key == "sling":
+		return "sling-bullet"
+	case key == "blowgun":
+		return "blowgun-needle"
+	default:
+		return ""
+	}
+}
@@ -7,10 +7,11 @@ import (
 type EquipmentType string
 
 const (
-	EquipmentTypeArmor   EquipmentType = "armor"
-	EquipmentTypeWeapon  EquipmentType = "weapon"
-	EquipmentTypeOther   EquipmentType = "other"
-	EquipmentTypeUnknown EquipmentType = ""
+	EquipmentTypeArmor     EquipmentType = "armor"
+	EquipmentTypeWeapon    EquipmentType = "weapon"
+	EquipmentTypeMagicItem EquipmentType = "magic_item"
+	EquipmentTypeOther     EquipmentType = "other"
+	EquipmentTypeUnknown   EquipmentType = ""
 )
 
 type Equipment interface {
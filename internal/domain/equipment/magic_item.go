@@ -0,0 +1,264 @@
+package equipment
+
+import (
+	"fmt"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/effects"
+)
+
+// Rarity is how rare a magic item is
+type Rarity string
+
+const (
+	RarityCommon    Rarity = "common"
+	RarityUncommon  Rarity = "uncommon"
+	RarityRare      Rarity = "rare"
+	RarityVeryRare  Rarity = "very_rare"
+	RarityLegendary Rarity = "legendary"
+	RarityArtifact  Rarity = "artifact"
+)
+
+// Name returns the rarity for display
+func (r Rarity) Name() string {
+	switch r {
+	case RarityUncommon:
+		return "Uncommon"
+	case RarityRare:
+		return "Rare"
+	case RarityVeryRare:
+		return "Very Rare"
+	case RarityLegendary:
+		return "Legendary"
+	case RarityArtifact:
+		return "Artifact"
+	default:
+		return "Common"
+	}
+}
+
+// MagicBonus is a flat bonus a magic item grants, such as +1 AC
+type MagicBonus struct {
+	Target effects.ModifierTarget `json:"target"`
+	Value  int                    `json:"value"`
+}
+
+// MagicProperties describes the magic on an item
+type MagicProperties struct {
+	Rarity             Rarity       `json:"rarity"`
+	RequiresAttunement bool         `json:"requires_attunement"`
+	Charges            int          `json:"charges,omitempty"`
+	MaxCharges         int          `json:"max_charges,omitempty"`
+	Bonuses            []MagicBonus `json:"bonuses,omitempty"`
+	// BaseItem is the mundane item this was made from, used for proficiency
+	BaseItem string `json:"base_item,omitempty"`
+	// Spell is cast by spending a charge, like a wand's magic missile
+	Spell string `json:"spell,omitempty"`
+}
+
+// UseCharge spends a charge, returning false if none are left
+func (m *MagicProperties) UseCharge() bool {
+	if m.Charges <= 0 {
+		return false
+	}
+	m.Charges--
+	return true
+}
+
+// Recharge restores 1d6+1 of the item's charges at dawn, up to its maximum,
+// returning how many were regained
+func (m *MagicProperties) Recharge(roller dice.Roller) (int, error) {
+	if m.Charges >= m.MaxCharges {
+		return 0, nil
+	}
+	result, err := roller.Roll(1, 6, 1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to roll recharge: %w", err)
+	}
+	regained := min(result.Total, m.MaxCharges-m.Charges)
+	m.Charges += regained
+	return regained, nil
+}
+
+// Enchanted is equipment that can carry magic
+type Enchanted interface {
+	Equipment
+	GetMagic() *MagicProperties
+}
+
+// Magic returns an item's magic properties, or nil if it is mundane
+func Magic(e Equipment) *MagicProperties {
+	if enchanted, ok := e.(Enchanted); ok {
+		return enchanted.GetMagic()
+	}
+	return nil
+}
+
+// MagicEffect returns the status effect granting an item's bonuses while it
+// is equipped, or nil if it grants none. A weapon's attack and damage
+// bonuses only apply to attacks made with that weapon.
+func MagicEffect(e Equipment) *effects.StatusEffect {
+	magic := Magic(e)
+	if magic == nil || len(magic.Bonuses) == 0 {
+		return nil
+	}
+
+	_, isWeapon := e.(*Weapon)
+	builder := effects.NewBuilder(e.GetName()).
+		WithSource(effects.SourceItem, e.GetKey()).
+		WithDescription(fmt.Sprintf("Granted by %s.", e.GetName())).
+		WithDuration(effects.DurationWhileEquipped, 0).
+		WithStackingRule(effects.StackingStack)
+
+	for _, bonus := range magic.Bonuses {
+		value := fmt.Sprintf("%+d", bonus.Value)
+		if isWeapon && (bonus.Target == effects.TargetAttackRoll || bonus.Target == effects.TargetDamage) {
+			builder.AddModifierWithCondition(bonus.Target, value, fmt.Sprintf("with_weapon:%s", e.GetKey()))
+			continue
+		}
+		builder.AddModifier(bonus.Target, value)
+	}
+
+	return builder.Build()
+}
+
+// MagicItem is a wondrous item, ring, wand or other magic item that isn't a
+// weapon or armor
+type MagicItem struct {
+	Base  BasicEquipment  `json:"base"`
+	Slot  shared.Slot     `json:"slot"`
+	Magic MagicProperties `json:"magic"`
+}
+
+func (m *MagicItem) GetEquipmentType() EquipmentType {
+	return EquipmentTypeMagicItem
+}
+
+func (m *MagicItem) GetName() string {
+	return m.Base.Name
+}
+
+func (m *MagicItem) GetKey() string {
+	return m.Base.Key
+}
+
+func (m *MagicItem) GetSlot() shared.Slot {
+	if m.Slot == "" {
+		return shared.SlotNone
+	}
+	return m.Slot
+}
+
+func (m *MagicItem) GetCost() *shared.Cost {
+	return m.Base.Cost
+}
+
+func (m *MagicItem) GetWeight() float32 {
+	return m.Base.Weight
+}
+
+func (m *MagicItem) GetMagic() *MagicProperties {
+	return &m.Magic
+}
+
+// GetMagic returns the weapon's magic, or nil for a mundane weapon
+func (w *Weapon) GetMagic() *MagicProperties {
+	return w.Magic
+}
+
+// ProficiencyKey returns the key proficiency is checked against. Magic
+// weapons use the mundane weapon they were made from.
+func (w *Weapon) ProficiencyKey() string {
+	if w.Magic != nil && w.Magic.BaseItem != "" {
+		return w.Magic.BaseItem
+	}
+	return w.Base.Key
+}
+
+// GetMagic returns the armor's magic, or nil for mundane armor
+func (e *Armor) GetMagic() *MagicProperties {
+	return e.Magic
+}
+
+// NewMagicWeapon makes a +1, +2 or +3 copy of a mundane weapon
+func NewMagicWeapon(base *Weapon, bonus int) *Weapon {
+	weapon := *base
+	weapon.Base.Key = fmt.Sprintf("%s-plus-%d", base.Base.Key, bonus)
+	weapon.Base.Name = fmt.Sprintf("%+d %s", bonus, base.Base.Name)
+	weapon.Magic = &MagicProperties{
+		Rarity:   []Rarity{RarityUncommon, RarityRare, RarityVeryRare}[min(max(bonus, 1), 3)-1],
+		BaseItem: base.Base.Key,
+		Bonuses: []MagicBonus{
+			{Target: effects.TargetAttackRoll, Value: bonus},
+			{Target: effects.TargetDamage, Value: bonus},
+		},
+	}
+	return &weapon
+}
+
+// NewMagicArmor makes a +1, +2 or +3 copy of mundane armor
+func NewMagicArmor(base *Armor, bonus int) *Armor {
+	armor := *base
+	armor.Base.Key = fmt.Sprintf("%s-plus-%d", base.Base.Key, bonus)
+	armor.Base.Name = fmt.Sprintf("%+d %s", bonus, base.Base.Name)
+	armor.Magic = &MagicProperties{
+		Rarity:   []Rarity{RarityRare, RarityVeryRare, RarityLegendary}[min(max(bonus, 1), 3)-1],
+		BaseItem: base.Base.Key,
+		Bonuses:  []MagicBonus{{Target: effects.TargetAC, Value: bonus}},
+	}
+	return &armor
+}
+
+// srdMagicItems are the SRD magic items the bot knows about beyond +X
+// weapons and armor
+var srdMagicItems = map[string]func() *MagicItem{
+	"cloak-of-protection": func() *MagicItem {
+		return &MagicItem{
+			Base: BasicEquipment{Key: "cloak-of-protection", Name: "Cloak of Protection", Weight: 1},
+			Slot: shared.SlotCloak,
+			Magic: MagicProperties{
+				Rarity:             RarityUncommon,
+				RequiresAttunement: true,
+				Bonuses: []MagicBonus{
+					{Target: effects.TargetAC, Value: 1},
+					{Target: effects.TargetSavingThrow, Value: 1},
+				},
+			},
+		}
+	},
+	"ring-of-protection": func() *MagicItem {
+		return &MagicItem{
+			Base: BasicEquipment{Key: "ring-of-protection", Name: "Ring of Protection"},
+			Slot: shared.SlotRing,
+			Magic: MagicProperties{
+				Rarity:             RarityRare,
+				RequiresAttunement: true,
+				Bonuses: []MagicBonus{
+					{Target: effects.TargetAC, Value: 1},
+					{Target: effects.TargetSavingThrow, Value: 1},
+				},
+			},
+		}
+	},
+	"wand-of-magic-missiles": func() *MagicItem {
+		return &MagicItem{
+			Base: BasicEquipment{Key: "wand-of-magic-missiles", Name: "Wand of Magic Missiles", Weight: 1},
+			Magic: MagicProperties{
+				Rarity:     RarityUncommon,
+				Charges:    7,
+				MaxCharges: 7,
+				Spell:      "magic-missile",
+			},
+		}
+	},
+}
+
+// NewSRDMagicItem returns a fresh copy of an SRD magic item, or nil if the
+// key is unknown
+func NewSRDMagicItem(key string) *MagicItem {
+	if create, ok := srdMagicItems[key]; ok {
+		return create()
+	}
+	return nil
+}
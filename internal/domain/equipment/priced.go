@@ -0,0 +1,17 @@
+package equipment
+
+import "github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+
+// Priced is equipment with a market price
+type Priced interface {
+	Equipment
+	GetCost() *shared.Cost
+}
+
+// Price returns what an item costs in copper pieces, or 0 if it has no price
+func Price(e Equipment) int {
+	if priced, ok := e.(Priced); ok {
+		return priced.GetCost().Copper()
+	}
+	return 0
+}
@@ -19,6 +19,7 @@ type Weapon struct {
 	CategoryRange   string                  `json:"category_range"`
 	Properties      []*shared.ReferenceItem `json:"properties"`
 	TwoHandedDamage *damage.Damage          `json:"two_handed_damage"`
+	Magic           *MagicProperties        `json:"magic,omitempty"`
 }
 
 func (w *Weapon) IsRanged() bool {
@@ -95,3 +96,11 @@ func (w *Weapon) GetSlot() shared.Slot {
 
 	return shared.SlotMainHand
 }
+
+func (w *Weapon) GetCost() *shared.Cost {
+	return w.Base.Cost
+}
+
+func (w *Weapon) GetWeight() float32 {
+	return w.Base.Weight
+}
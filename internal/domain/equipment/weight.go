@@ -0,0 +1,15 @@
+package equipment
+
+// Weighted is equipment with a carrying weight
+type Weighted interface {
+	Equipment
+	GetWeight() float32
+}
+
+// Weight returns what an item weighs in pounds, or 0 if it has no weight
+func Weight(e Equipment) float64 {
+	if weighted, ok := e.(Weighted); ok {
+		return float64(weighted.GetWeight())
+	}
+	return 0
+}
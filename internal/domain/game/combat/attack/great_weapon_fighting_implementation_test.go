@@ -88,7 +88,7 @@ func TestRollAttackWithFightingStyle_GreatWeaponFighting(t *testing.T) {
 				DamageType: damage.TypeSlashing,
 			}
 
-			result, err := RollAttackWithFightingStyle(mockRoller, 5, 4, dmg, "great_weapon")
+			result, err := RollAttackWithFightingStyle(mockRoller, 5, 4, dmg, "great_weapon", nil)
 			require.NoError(t, err)
 
 			assert.Equal(t, tt.expectedDamage, result.DamageRoll, tt.description)
@@ -126,7 +126,7 @@ func TestRollAttackWithFightingStyle_CriticalHitWithGreatWeapon(t *testing.T) {
 		DamageType: damage.TypeSlashing,
 	}
 
-	result, err := RollAttackWithFightingStyle(mockRoller, 5, 4, dmg, "great_weapon")
+	result, err := RollAttackWithFightingStyle(mockRoller, 5, 4, dmg, "great_weapon", nil)
 	require.NoError(t, err)
 
 	// Should be: 4 + 5 + 3 + 6 + 4 bonus = 22
@@ -151,7 +151,7 @@ func TestRollAttackWithFightingStyle_NoFightingStyle(t *testing.T) {
 		DamageType: damage.TypeSlashing,
 	}
 
-	result, err := RollAttackWithFightingStyle(mockRoller, 5, 4, dmg, "")
+	result, err := RollAttackWithFightingStyle(mockRoller, 5, 4, dmg, "", nil)
 	require.NoError(t, err)
 
 	// Should be: 1 + 2 + 4 bonus = 7 (no rerolls)
@@ -173,7 +173,7 @@ func TestRollAttackWithFightingStyle_DifferentFightingStyle(t *testing.T) {
 		DamageType: damage.TypeSlashing,
 	}
 
-	result, err := RollAttackWithFightingStyle(mockRoller, 5, 4, dmg, "defense")
+	result, err := RollAttackWithFightingStyle(mockRoller, 5, 4, dmg, "defense", nil)
 	require.NoError(t, err)
 
 	// Should be: 1 + 2 + 4 bonus = 7 (no rerolls)
@@ -195,7 +195,7 @@ func TestRollAttackWithFightingStyle_SingleDieWeapon(t *testing.T) {
 		DamageType: damage.TypeSlashing,
 	}
 
-	result, err := RollAttackWithFightingStyle(mockRoller, 5, 4, dmg, "great_weapon")
+	result, err := RollAttackWithFightingStyle(mockRoller, 5, 4, dmg, "great_weapon", nil)
 	require.NoError(t, err)
 
 	// Should be: 8 + 4 bonus = 12
@@ -223,7 +223,7 @@ func TestGreatWeaponFightingDisplay(t *testing.T) {
 		DamageType: damage.TypeSlashing,
 	}
 
-	result, err := RollAttackWithFightingStyle(mockRoller, 5, 4, dmg, "great_weapon")
+	result, err := RollAttackWithFightingStyle(mockRoller, 5, 4, dmg, "great_weapon", nil)
 	require.NoError(t, err)
 
 	// Verify the data needed for display formatting
@@ -30,19 +30,93 @@ type DieReroll struct {
 	Position     int // Position in the damage roll sequence (0-based)
 }
 
+// RollOptions carries situational modifiers and house rules that change how an
+// attack is resolved. A nil *RollOptions means a standard 5e attack.
+type RollOptions struct {
+	Advantage    bool // Roll two d20s and keep the higher
+	Disadvantage bool // Roll two d20s and keep the lower
+	MaxDiceCrits bool // House rule: the extra critical dice deal maximum damage
+	CritRange    int  // Lowest natural roll that scores a critical hit; 0 means only a 20
+}
+
+func (o *RollOptions) hasAdvantage() bool {
+	return o != nil && o.Advantage && !o.Disadvantage
+}
+
+func (o *RollOptions) hasDisadvantage() bool {
+	return o != nil && o.Disadvantage && !o.Advantage
+}
+
+func (o *RollOptions) maxDiceCrits() bool {
+	return o != nil && o.MaxDiceCrits
+}
+
+// isCritical reports whether an attack roll scores a critical hit, counting
+// an expanded critical range such as the Champion's Improved Critical
+func (o *RollOptions) isCritical(roll *dice.RollResult) bool {
+	if roll.IsCrit {
+		return true
+	}
+	return o != nil && o.CritRange > 0 && NaturalRoll(roll) >= o.CritRange
+}
+
+// RollToHit rolls the attack d20, applying advantage or disadvantage.
+// Advantage and disadvantage cancel each other out.
+func RollToHit(roller dice.Roller, opts *RollOptions) (*dice.RollResult, error) {
+	switch {
+	case opts.hasAdvantage():
+		return roller.RollWithAdvantage(20, 0)
+	case opts.hasDisadvantage():
+		return roller.RollWithDisadvantage(20, 0)
+	default:
+		return roller.Roll(1, 20, 0)
+	}
+}
+
+// NaturalRoll returns the d20 result that was kept for an attack roll,
+// accounting for advantage/disadvantage rolls that record both dice
+func NaturalRoll(roll *dice.RollResult) int {
+	if roll == nil || len(roll.Rolls) == 0 {
+		return 0
+	}
+	if len(roll.Rolls) > 1 && roll.Count == 1 {
+		return roll.RawTotal
+	}
+	return roll.Rolls[0]
+}
+
+// rollCritDamage rolls the extra damage dice for a critical hit.
+// With the max-dice house rule the extra set deals maximum damage instead.
+func rollCritDamage(roller dice.Roller, dmg *damage.Damage, opts *RollOptions) (int, []int, error) {
+	if opts.maxDiceCrits() {
+		rolls := make([]int, dmg.DiceCount)
+		for i := range rolls {
+			rolls[i] = dmg.DiceSize
+		}
+		return dmg.DiceCount * dmg.DiceSize, rolls, nil
+	}
+
+	critResult, err := roller.Roll(dmg.DiceCount, dmg.DiceSize, 0)
+	if err != nil {
+		return 0, nil, err
+	}
+	return critResult.Total, critResult.Rolls, nil
+}
+
 func (r *Result) String() string {
 	return fmt.Sprintf("attack: %d, type: %s, damage: %d", r.AttackRoll, r.AttackType, r.DamageRoll)
 }
 
 // RollAttackWithFightingStyle rolls an attack with fighting style modifications
-func RollAttackWithFightingStyle(roller dice.Roller, attackBonus, damageBonus int, dmg *damage.Damage, fightingStyle string) (*Result, error) {
-	attackResult, err := roller.Roll(1, 20, 0)
+// and any situational or house-rule options
+func RollAttackWithFightingStyle(roller dice.Roller, attackBonus, damageBonus int, dmg *damage.Damage, fightingStyle string, opts *RollOptions) (*Result, error) {
+	attackResult, err := RollToHit(roller, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	dmgValue := 0
-	attackRoll := attackResult.Total
+	attackRoll := NaturalRoll(attackResult)
 	allRolls := make([]int, 0, dmg.DiceCount*2) // Extra space for potential crits
 	var rerollInfo []DieReroll
 
@@ -62,9 +136,9 @@ func RollAttackWithFightingStyle(roller dice.Roller, attackBonus, damageBonus in
 	// Always add attack bonus to the roll
 	attackRoll += attackBonus
 
-	// Handle critical hit (natural 20)
-	if attackResult.IsCrit {
-		if fightingStyle == "great_weapon" {
+	// Handle critical hit (natural 20, or within an expanded critical range)
+	if opts.isCritical(attackResult) {
+		if fightingStyle == "great_weapon" && !opts.maxDiceCrits() {
 			critValue, critRolls, critRerolls := rollDamageWithGreatWeaponFighting(roller, dmg.DiceCount, dmg.DiceSize)
 			dmgValue += critValue
 			allRolls = append(allRolls, critRolls...)
@@ -74,12 +148,12 @@ func RollAttackWithFightingStyle(roller dice.Roller, attackBonus, damageBonus in
 				rerollInfo = append(rerollInfo, reroll)
 			}
 		} else {
-			critResult, err := roller.Roll(dmg.DiceCount, dmg.DiceSize, 0)
+			critValue, critRolls, err := rollCritDamage(roller, dmg, opts)
 			if err != nil {
 				return nil, err
 			}
-			dmgValue += critResult.Total
-			allRolls = append(allRolls, critResult.Rolls...)
+			dmgValue += critValue
+			allRolls = append(allRolls, critRolls...)
 		}
 	}
 
@@ -144,5 +218,5 @@ func rollDamageWithGreatWeaponFighting(roller dice.Roller, diceCount, diceSize i
 
 func RollAttack(roller dice.Roller, attackBonus, damageBonus int, dmg *damage.Damage) (*Result, error) {
 	// Call the new function with no fighting style
-	return RollAttackWithFightingStyle(roller, attackBonus, damageBonus, dmg, "")
+	return RollAttackWithFightingStyle(roller, attackBonus, damageBonus, dmg, "", nil)
 }
@@ -0,0 +1,107 @@
+package attack
+
+import (
+	"testing"
+
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/damage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollAttackWithFightingStyle_RollOptions(t *testing.T) {
+	longsword := &damage.Damage{
+		DiceCount:  1,
+		DiceSize:   8,
+		DamageType: damage.TypeSlashing,
+	}
+
+	tests := []struct {
+		name           string
+		rolls          []int
+		opts           *RollOptions
+		fightingStyle  string
+		expectedAttack int
+		expectedDamage int
+		expectedDice   []int
+	}{
+		{
+			name:           "advantage keeps the higher d20",
+			rolls:          []int{7, 15, 4},
+			opts:           &RollOptions{Advantage: true},
+			expectedAttack: 15 + 5,
+			expectedDamage: 4 + 3,
+			expectedDice:   []int{4},
+		},
+		{
+			name:           "disadvantage keeps the lower d20",
+			rolls:          []int{7, 15, 4},
+			opts:           &RollOptions{Disadvantage: true},
+			expectedAttack: 7 + 5,
+			expectedDamage: 4 + 3,
+			expectedDice:   []int{4},
+		},
+		{
+			name:           "advantage and disadvantage cancel out",
+			rolls:          []int{9, 4},
+			opts:           &RollOptions{Advantage: true, Disadvantage: true},
+			expectedAttack: 9 + 5,
+			expectedDamage: 4 + 3,
+			expectedDice:   []int{4},
+		},
+		{
+			name:           "standard crit rolls extra dice",
+			rolls:          []int{20, 4, 2},
+			expectedAttack: 20 + 5,
+			expectedDamage: 4 + 2 + 3,
+			expectedDice:   []int{4, 2},
+		},
+		{
+			name:           "expanded crit range crits on a 19",
+			rolls:          []int{19, 4, 2},
+			opts:           &RollOptions{CritRange: 19},
+			expectedAttack: 19 + 5,
+			expectedDamage: 4 + 2 + 3,
+			expectedDice:   []int{4, 2},
+		},
+		{
+			name:           "expanded crit range doesn't crit below it",
+			rolls:          []int{18, 4},
+			opts:           &RollOptions{CritRange: 19},
+			expectedAttack: 18 + 5,
+			expectedDamage: 4 + 3,
+			expectedDice:   []int{4},
+		},
+		{
+			name:           "max-dice crit deals maximum on the extra dice",
+			rolls:          []int{20, 4},
+			opts:           &RollOptions{MaxDiceCrits: true},
+			expectedAttack: 20 + 5,
+			expectedDamage: 4 + 8 + 3,
+			expectedDice:   []int{4, 8},
+		},
+		{
+			name:           "max-dice crit skips great weapon fighting rerolls on the extra dice",
+			rolls:          []int{20, 1, 5},
+			opts:           &RollOptions{MaxDiceCrits: true},
+			fightingStyle:  "great_weapon",
+			expectedAttack: 20 + 5,
+			expectedDamage: 5 + 8 + 3,
+			expectedDice:   []int{5, 8},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roller := mockdice.NewManualMockRoller()
+			roller.SetRolls(tt.rolls)
+
+			result, err := RollAttackWithFightingStyle(roller, 5, 3, longsword, tt.fightingStyle, tt.opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedAttack, result.AttackRoll)
+			assert.Equal(t, tt.expectedDamage, result.DamageRoll)
+			assert.Equal(t, tt.expectedDice, result.AllDamageRolls)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package combat
+
+import (
+	"fmt"
+)
+
+// Concentration is the spell a combatant is concentrating on. Effects the
+// spell applied carry the caster's combatant ID as their SourceID and end
+// with it.
+type Concentration struct {
+	SpellKey  string `json:"spell_key"`
+	SpellName string `json:"spell_name"`
+	Round     int    `json:"round"` // Round the spell was cast
+}
+
+// GetConcentration returns the spell a combatant is concentrating on, if any
+func (e *Encounter) GetConcentration(casterID string) *Concentration {
+	if e.Concentration == nil {
+		return nil
+	}
+	return e.Concentration[casterID]
+}
+
+// StartConcentration starts a caster concentrating on a spell. A caster can
+// only concentrate on one spell, so any previous spell ends first.
+// Returns the concentration that was dropped, if any.
+func (e *Encounter) StartConcentration(casterID, spellKey, spellName string) *Concentration {
+	dropped := e.EndConcentration(casterID, fmt.Sprintf("casting %s", spellName))
+
+	if e.Concentration == nil {
+		e.Concentration = make(map[string]*Concentration)
+	}
+	e.Concentration[casterID] = &Concentration{
+		SpellKey:  spellKey,
+		SpellName: spellName,
+		Round:     e.Round,
+	}
+	return dropped
+}
+
+// EndConcentration ends a caster's concentration and removes the effects
+// linked to it from every combatant. Each ended effect is logged.
+// Returns the concentration that ended, or nil if there was none.
+func (e *Encounter) EndConcentration(casterID, reason string) *Concentration {
+	concentration := e.GetConcentration(casterID)
+	if concentration == nil {
+		return nil
+	}
+	delete(e.Concentration, casterID)
+
+	casterName := casterID
+	if caster, exists := e.Combatants[casterID]; exists {
+		casterName = caster.Name
+	}
+	e.AddCombatLogEntry(fmt.Sprintf("💫 %s loses concentration on %s (%s)", casterName, concentration.SpellName, reason))
+
+	for _, combatant := range e.Combatants {
+		remaining := combatant.ActiveEffects[:0]
+		for _, effect := range combatant.ActiveEffects {
+			if effect.RequiresConcentration && effect.SourceID == casterID {
+				e.AddCombatLogEntry(fmt.Sprintf("%s ends on %s", effect.Name, combatant.Name))
+				continue
+			}
+			remaining = append(remaining, effect)
+		}
+		combatant.ActiveEffects = remaining
+	}
+
+	return concentration
+}
+
+// ConcentrationSaveDC returns the Constitution save DC to keep concentrating
+// after taking damage: 10 or half the damage, whichever is higher
+func ConcentrationSaveDC(damage int) int {
+	return max(10, damage/2)
+}
@@ -0,0 +1,64 @@
+package combat_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createConcentrationEncounter() *combat.Encounter {
+	encounter := combat.NewEncounter("enc-1", "session-1", "channel-1", "Test Combat", "dm-1")
+	encounter.AddCombatant(&combat.Combatant{ID: "wizard", Name: "Wizard", Type: combat.CombatantTypePlayer, CurrentHP: 20, MaxHP: 20, IsActive: true})
+	encounter.AddCombatant(&combat.Combatant{ID: "goblin", Name: "Goblin", Type: combat.CombatantTypeMonster, CurrentHP: 7, MaxHP: 7, IsActive: true})
+	return encounter
+}
+
+func TestEncounter_StartConcentration_DropsPreviousSpell(t *testing.T) {
+	encounter := createConcentrationEncounter()
+	goblin := encounter.Combatants["goblin"]
+
+	assert.Nil(t, encounter.StartConcentration("wizard", "hold-person", "Hold Person"))
+	goblin.ActiveEffects = []*shared.ActiveEffect{
+		{Name: "Held", SourceID: "wizard", RequiresConcentration: true},
+		{Name: "Held by someone else", SourceID: "cleric", RequiresConcentration: true},
+		{Name: "Vicious Mockery Disadvantage", SourceID: "wizard"},
+	}
+
+	dropped := encounter.StartConcentration("wizard", "web", "Web")
+
+	require.NotNil(t, dropped)
+	assert.Equal(t, "Hold Person", dropped.SpellName)
+	assert.Equal(t, "Web", encounter.GetConcentration("wizard").SpellName)
+
+	require.Len(t, goblin.ActiveEffects, 2, "only the dropped spell's effects end")
+	assert.Equal(t, "Held by someone else", goblin.ActiveEffects[0].Name)
+	assert.Equal(t, "Vicious Mockery Disadvantage", goblin.ActiveEffects[1].Name)
+
+	logText := strings.Join(encounter.CombatLog, "\n")
+	assert.Contains(t, logText, "Wizard loses concentration on Hold Person (casting Web)")
+	assert.Contains(t, logText, "Held ends on Goblin")
+}
+
+func TestEncounter_EndConcentration(t *testing.T) {
+	encounter := createConcentrationEncounter()
+
+	assert.Nil(t, encounter.EndConcentration("wizard", "no spell"))
+
+	encounter.StartConcentration("wizard", "bless", "Bless")
+	ended := encounter.EndConcentration("wizard", "incapacitated")
+
+	require.NotNil(t, ended)
+	assert.Equal(t, "bless", ended.SpellKey)
+	assert.Nil(t, encounter.GetConcentration("wizard"))
+}
+
+func TestConcentrationSaveDC(t *testing.T) {
+	assert.Equal(t, 10, combat.ConcentrationSaveDC(5))
+	assert.Equal(t, 10, combat.ConcentrationSaveDC(21))
+	assert.Equal(t, 11, combat.ConcentrationSaveDC(22))
+	assert.Equal(t, 25, combat.ConcentrationSaveDC(50))
+}
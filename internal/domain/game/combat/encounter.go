@@ -45,6 +45,9 @@ type Encounter struct {
 	EndedAt     *time.Time            `json:"ended_at"`
 	CreatedBy   string                `json:"created_by"` // User ID who created the encounter
 	CombatLog   []string              `json:"combat_log"` // History of combat actions
+
+	// Concentration tracks the spell each caster is concentrating on, by combatant ID
+	Concentration map[string]*Concentration `json:"concentration,omitempty"`
 }
 
 // Combatant represents a participant in combat
@@ -69,15 +72,23 @@ type Combatant struct {
 	Class       string `json:"class,omitempty"` // Character class (Fighter, Wizard, etc.)
 	Race        string `json:"race,omitempty"`  // Character race
 
+	// AmmoSpent counts the ammunition fired this encounter by key; half is
+	// recovered when the fight ends
+	AmmoSpent map[string]int `json:"ammo_spent,omitempty"`
+
 	// For monsters
 	MonsterRef string           `json:"monster_ref,omitempty"` // D&D API reference
 	CR         float64          `json:"cr,omitempty"`          // Challenge Rating
 	XP         int              `json:"xp,omitempty"`          // Experience Points
 	Abilities  map[string]int   `json:"abilities,omitempty"`   // STR, DEX, etc.
 	Actions    []*MonsterAction `json:"actions,omitempty"`     // Available actions
+	GroupKey   string           `json:"group_key,omitempty"`   // Base name shared by identical monsters (e.g. "Goblin")
 
 	// Temporary effects (for both players and monsters)
 	ActiveEffects []*shared.ActiveEffect `json:"active_effects,omitempty"` // Temporary combat effects
+
+	// EngagedBy holds IDs of opponents that made a melee attack against this combatant this round
+	EngagedBy []string `json:"engaged_by,omitempty"`
 }
 
 // IsAlive returns true if the combatant has more than 0 HP
@@ -90,6 +101,21 @@ func (c *Combatant) CanAct() bool {
 	return c.IsAlive() && len(c.Actions) > 0
 }
 
+// IsPlayerSide returns true if the combatant fights on the players' side
+func (c *Combatant) IsPlayerSide() bool {
+	return c.Type != CombatantTypeMonster
+}
+
+// RecordMeleeAttacker notes that an opponent engaged this combatant in melee this round
+func (c *Combatant) RecordMeleeAttacker(attackerID string) {
+	for _, id := range c.EngagedBy {
+		if id == attackerID {
+			return
+		}
+	}
+	c.EngagedBy = append(c.EngagedBy, attackerID)
+}
+
 // NewEncounter creates a new encounter
 func NewEncounter(id, sessionID, channelID, name, createdBy string) *Encounter {
 	return &Encounter{
@@ -195,9 +221,10 @@ func (e *Encounter) NextTurn() {
 		e.Round++
 		e.Turn = 0
 
-		// Reset all combatants' HasActed flag
+		// Reset all combatants' HasActed flag and melee engagement
 		for _, combatant := range e.Combatants {
 			combatant.HasActed = false
+			combatant.EngagedBy = nil
 		}
 
 		// Check if combat should end after round reset
@@ -229,6 +256,20 @@ func (e *Encounter) NextRound() {
 	e.NextTurn()
 }
 
+// ResetTurnOrder replaces the turn order at the top of a round, e.g. after
+// initiative is re-rolled, and moves to the first active combatant
+func (e *Encounter) ResetTurnOrder(order []string) {
+	e.TurnOrder = order
+	e.Turn = 0
+
+	for e.Turn < len(e.TurnOrder) {
+		if combatant, exists := e.Combatants[e.TurnOrder[e.Turn]]; exists && combatant.IsActive && combatant.CurrentHP > 0 {
+			break
+		}
+		e.Turn++
+	}
+}
+
 // GetCurrentCombatant returns the combatant whose turn it is
 func (e *Encounter) GetCurrentCombatant() *Combatant {
 	if e.Turn < len(e.TurnOrder) {
@@ -301,7 +342,7 @@ func (c *Combatant) ApplyDamage(damage int) {
 
 	// Then reduce current HP
 	c.CurrentHP -= damage
-	if c.CurrentHP < 0 {
+	if c.CurrentHP <= 0 {
 		c.CurrentHP = 0
 		c.IsActive = false
 	}
@@ -328,6 +369,30 @@ func (c *Combatant) AddTempHP(amount int) {
 	}
 }
 
+// IsFlanked checks if the target is flanked from the attacker's point of view:
+// another active ally of the attacker has already engaged it in melee this round
+func (e *Encounter) IsFlanked(targetID, attackerID string) bool {
+	target, exists := e.Combatants[targetID]
+	if !exists {
+		return false
+	}
+	attacker, exists := e.Combatants[attackerID]
+	if !exists {
+		return false
+	}
+
+	for _, id := range target.EngagedBy {
+		if id == attackerID {
+			continue
+		}
+		ally, exists := e.Combatants[id]
+		if exists && ally.IsActive && ally.IsPlayerSide() == attacker.IsPlayerSide() {
+			return true
+		}
+	}
+	return false
+}
+
 // AddCombatLogEntry adds an entry to the combat log
 func (e *Encounter) AddCombatLogEntry(entry string) {
 	if e.CombatLog == nil {
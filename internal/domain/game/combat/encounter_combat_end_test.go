@@ -142,6 +142,12 @@ func TestApplyDamage_Defeat(t *testing.T) {
 	combatant.ApplyDamage(10)
 	assert.Equal(t, 0, combatant.CurrentHP)
 	assert.False(t, combatant.IsActive)
+
+	// Damage that leaves exactly 0 HP is also a defeat
+	exact := &combat.Combatant{ID: "exact", CurrentHP: 7, MaxHP: 7, IsActive: true}
+	exact.ApplyDamage(7)
+	assert.Equal(t, 0, exact.CurrentHP)
+	assert.False(t, exact.IsActive)
 }
 
 func TestApplyDamage_TempHP(t *testing.T) {
@@ -299,3 +299,66 @@ func TestEncounter_AllCombatantsDead(t *testing.T) {
 	// Should have advanced to next round
 	assert.Equal(t, 2, enc.Round)
 }
+
+func TestEncounter_IsFlanked(t *testing.T) {
+	newEncounter := func() *combat.Encounter {
+		encounter := combat.NewEncounter("enc-1", "session-1", "channel-1", "Test Combat", "dm-1")
+		encounter.AddCombatant(&combat.Combatant{ID: "fighter", Name: "Fighter", Type: combat.CombatantTypePlayer, CurrentHP: 10, MaxHP: 10, IsActive: true})
+		encounter.AddCombatant(&combat.Combatant{ID: "rogue", Name: "Rogue", Type: combat.CombatantTypePlayer, CurrentHP: 8, MaxHP: 8, IsActive: true})
+		encounter.AddCombatant(&combat.Combatant{ID: "goblin", Name: "Goblin", Type: combat.CombatantTypeMonster, CurrentHP: 7, MaxHP: 7, IsActive: true})
+		encounter.AddCombatant(&combat.Combatant{ID: "orc", Name: "Orc", Type: combat.CombatantTypeMonster, CurrentHP: 15, MaxHP: 15, IsActive: true})
+		return encounter
+	}
+
+	t.Run("Not flanked without an engaged ally", func(t *testing.T) {
+		encounter := newEncounter()
+		assert.False(t, encounter.IsFlanked("goblin", "rogue"))
+	})
+
+	t.Run("Own attacks don't count toward flanking", func(t *testing.T) {
+		encounter := newEncounter()
+		encounter.Combatants["goblin"].RecordMeleeAttacker("rogue")
+		encounter.Combatants["goblin"].RecordMeleeAttacker("rogue")
+		assert.Len(t, encounter.Combatants["goblin"].EngagedBy, 1)
+		assert.False(t, encounter.IsFlanked("goblin", "rogue"))
+	})
+
+	t.Run("Flanked when an ally is engaged", func(t *testing.T) {
+		encounter := newEncounter()
+		encounter.Combatants["goblin"].RecordMeleeAttacker("fighter")
+		assert.True(t, encounter.IsFlanked("goblin", "rogue"))
+	})
+
+	t.Run("Enemies engaging the target don't help", func(t *testing.T) {
+		encounter := newEncounter()
+		encounter.Combatants["fighter"].RecordMeleeAttacker("orc")
+		assert.True(t, encounter.IsFlanked("fighter", "goblin"))
+		assert.False(t, encounter.IsFlanked("fighter", "rogue"))
+	})
+
+	t.Run("Engagement clears at the start of a new round", func(t *testing.T) {
+		encounter := newEncounter()
+		encounter.TurnOrder = []string{"fighter", "rogue", "goblin", "orc"}
+		encounter.Status = combat.EncounterStatusRolling
+		assert.True(t, encounter.Start())
+		encounter.Combatants["goblin"].RecordMeleeAttacker("fighter")
+
+		for i := 0; i < 4; i++ {
+			encounter.NextTurn()
+		}
+
+		assert.Equal(t, 2, encounter.Round)
+		assert.False(t, encounter.IsFlanked("goblin", "rogue"))
+	})
+}
+
+func TestEncounter_ResetTurnOrder_SkipsDeadCombatants(t *testing.T) {
+	encounter := combat.NewEncounter("enc-1", "session-1", "channel-1", "Test Combat", "dm-1")
+	encounter.AddCombatant(&combat.Combatant{ID: "goblin", Name: "Goblin", Type: combat.CombatantTypeMonster, CurrentHP: 0, MaxHP: 7, IsActive: false})
+	encounter.AddCombatant(&combat.Combatant{ID: "fighter", Name: "Fighter", Type: combat.CombatantTypePlayer, CurrentHP: 10, MaxHP: 10, IsActive: true})
+
+	encounter.ResetTurnOrder([]string{"goblin", "fighter"})
+
+	assert.Equal(t, 1, encounter.Turn)
+	assert.Equal(t, "fighter", encounter.GetCurrentCombatant().ID)
+}
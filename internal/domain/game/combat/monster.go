@@ -1,6 +1,8 @@
 package combat
 
 import (
+	"strings"
+
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/damage"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 )
@@ -20,6 +22,7 @@ type MonsterTemplate struct {
 	ArmorClass      int              `json:"armor_class"`
 	HitPoints       int              `json:"hit_points"`
 	HitDice         string           `json:"hit_dice"`
+	Dexterity       int              `json:"dexterity,omitempty"`
 	Actions         []*MonsterAction `json:"actions"`
 	XP              int              `json:"xp"`
 	ChallengeRating float32          `json:"challenge_rating"`
@@ -35,3 +38,8 @@ type MonsterAction struct {
 	SaveDC        int              `json:"save_dc,omitempty"`        // DC for the saving throw
 	SaveAttribute shared.Attribute `json:"save_attribute,omitempty"` // Which attribute to save against (STR, DEX, etc.)
 }
+
+// IsMelee returns true unless the action is described as a ranged attack
+func (a *MonsterAction) IsMelee() bool {
+	return !strings.Contains(strings.ToLower(a.Description), "ranged weapon attack")
+}
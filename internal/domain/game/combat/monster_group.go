@@ -0,0 +1,100 @@
+package combat
+
+import (
+	"fmt"
+	"sort"
+)
+
+// MonsterGroup is a set of identical monsters, e.g. Goblin A, B and C
+type MonsterGroup struct {
+	Key     string
+	Members []*Combatant
+}
+
+// AddMonster adds a monster to the encounter, lettering duplicates so each
+// one can be targeted by name. The first goblin stays "Goblin" until a second
+// one arrives, at which point they become "Goblin A" and "Goblin B".
+func (e *Encounter) AddMonster(monster *Combatant) {
+	if monster.GroupKey == "" {
+		monster.GroupKey = monster.Name
+	}
+
+	members := e.groupMembers(monster.GroupKey)
+	if len(members) > 0 {
+		used := make(map[string]bool, len(members))
+		for _, member := range members {
+			if member.Name == member.GroupKey {
+				// The original is still unlabelled - it becomes "A"
+				member.Name = groupMemberName(member.GroupKey, 0)
+			}
+			used[member.Name] = true
+		}
+
+		for i := 0; ; i++ {
+			name := groupMemberName(monster.GroupKey, i)
+			if !used[name] {
+				monster.Name = name
+				break
+			}
+		}
+	}
+
+	e.AddCombatant(monster)
+}
+
+// MonsterGroups returns groups of identical monsters with more than one member
+func (e *Encounter) MonsterGroups() []*MonsterGroup {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, combatant := range e.Combatants {
+		if combatant.Type == CombatantTypeMonster && combatant.GroupKey != "" && !seen[combatant.GroupKey] {
+			seen[combatant.GroupKey] = true
+			keys = append(keys, combatant.GroupKey)
+		}
+	}
+	sort.Strings(keys)
+
+	var groups []*MonsterGroup
+	for _, key := range keys {
+		if members := e.groupMembers(key); len(members) > 1 {
+			groups = append(groups, &MonsterGroup{Key: key, Members: members})
+		}
+	}
+
+	return groups
+}
+
+// GroupSize returns how many monsters share the combatant's group
+func (e *Encounter) GroupSize(combatant *Combatant) int {
+	if combatant.GroupKey == "" {
+		return 1
+	}
+	return len(e.groupMembers(combatant.GroupKey))
+}
+
+// groupMembers returns the monsters in a group ordered by name
+func (e *Encounter) groupMembers(groupKey string) []*Combatant {
+	var members []*Combatant
+	for _, combatant := range e.Combatants {
+		if combatant.Type == CombatantTypeMonster && combatant.GroupKey == groupKey {
+			members = append(members, combatant)
+		}
+	}
+
+	// Order by name so "Goblin A" comes before "Goblin B"
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+
+	return members
+}
+
+// groupMemberName builds the lettered name for the nth member of a group:
+// A-Z, then AA, AB and so on
+func groupMemberName(groupKey string, index int) string {
+	label := ""
+	for n := index; n >= 0; n = n/26 - 1 {
+		label = string(rune('A'+n%26)) + label
+	}
+	return fmt.Sprintf("%s %s", groupKey, label)
+}
@@ -0,0 +1,85 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMonster(id, name string) *combat.Combatant {
+	return &combat.Combatant{
+		ID:        id,
+		Name:      name,
+		Type:      combat.CombatantTypeMonster,
+		CurrentHP: 7,
+		MaxHP:     7,
+		IsActive:  true,
+	}
+}
+
+func TestEncounter_AddMonster_LettersDuplicates(t *testing.T) {
+	encounter := combat.NewEncounter("enc-1", "session-1", "channel-1", "Ambush", "dm-1")
+
+	encounter.AddMonster(newMonster("m1", "Goblin"))
+	assert.Equal(t, "Goblin", encounter.Combatants["m1"].Name, "a lone monster keeps its name")
+
+	encounter.AddMonster(newMonster("m2", "Goblin"))
+	encounter.AddMonster(newMonster("m3", "Goblin"))
+	encounter.AddMonster(newMonster("m4", "Orc"))
+
+	assert.Equal(t, "Goblin A", encounter.Combatants["m1"].Name)
+	assert.Equal(t, "Goblin B", encounter.Combatants["m2"].Name)
+	assert.Equal(t, "Goblin C", encounter.Combatants["m3"].Name)
+	assert.Equal(t, "Orc", encounter.Combatants["m4"].Name)
+
+	for _, id := range []string{"m1", "m2", "m3"} {
+		assert.Equal(t, "Goblin", encounter.Combatants[id].GroupKey)
+		assert.Equal(t, 3, encounter.GroupSize(encounter.Combatants[id]))
+	}
+	assert.Equal(t, 1, encounter.GroupSize(encounter.Combatants["m4"]))
+}
+
+func TestEncounter_AddMonster_ReusesFreedLetters(t *testing.T) {
+	encounter := combat.NewEncounter("enc-1", "session-1", "channel-1", "Ambush", "dm-1")
+
+	encounter.AddMonster(newMonster("m1", "Goblin"))
+	encounter.AddMonster(newMonster("m2", "Goblin"))
+	encounter.AddMonster(newMonster("m3", "Goblin"))
+	encounter.RemoveCombatant("m2")
+
+	encounter.AddMonster(newMonster("m4", "Goblin"))
+	assert.Equal(t, "Goblin B", encounter.Combatants["m4"].Name)
+}
+
+func TestEncounter_AddMonster_LettersPastZ(t *testing.T) {
+	encounter := combat.NewEncounter("enc-1", "session-1", "channel-1", "Swarm", "dm-1")
+
+	for i := 0; i < 28; i++ {
+		encounter.AddMonster(newMonster(string(rune('a'+i%26))+string(rune('0'+i/26)), "Rat"))
+	}
+
+	names := make(map[string]bool)
+	for _, c := range encounter.Combatants {
+		names[c.Name] = true
+	}
+	assert.Len(t, names, 28, "every rat should have a unique name")
+	assert.True(t, names["Rat Z"])
+	assert.True(t, names["Rat AA"])
+	assert.True(t, names["Rat AB"])
+}
+
+func TestEncounter_MonsterGroups(t *testing.T) {
+	encounter := combat.NewEncounter("enc-1", "session-1", "channel-1", "Ambush", "dm-1")
+	encounter.AddMonster(newMonster("m1", "Goblin"))
+	encounter.AddMonster(newMonster("m2", "Goblin"))
+	encounter.AddMonster(newMonster("m3", "Orc"))
+
+	groups := encounter.MonsterGroups()
+	require.Len(t, groups, 1)
+	assert.Equal(t, "Goblin", groups[0].Key)
+	require.Len(t, groups[0].Members, 2)
+	assert.Equal(t, "Goblin A", groups[0].Members[0].Name)
+	assert.Equal(t, "Goblin B", groups[0].Members[1].Name)
+}
@@ -42,6 +42,7 @@ type DungeonRoom struct {
 	Completed   bool     `json:"completed"`
 	Monsters    []string `json:"monsters,omitempty"`
 	Treasure    []string `json:"treasure,omitempty"`
+	Gold        int      `json:"gold,omitempty"` // Gold pieces in a treasure room's hoard
 	Challenge   string   `json:"challenge"`
 }
 
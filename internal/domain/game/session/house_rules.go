@@ -0,0 +1,177 @@
+package session
+
+// HouseRule identifies a single optional rule variant a table can enable
+type HouseRule string
+
+const (
+	HouseRuleMaxDiceCrits              HouseRule = "max_dice_crits"               // Crits deal max damage on one set of dice
+	HouseRuleFlanking                  HouseRule = "flanking"                     // Flanked targets grant advantage to melee attackers
+	HouseRuleSideInitiative            HouseRule = "side_initiative"              // One initiative roll per side
+	HouseRuleGroupInitiative           HouseRule = "group_initiative"             // One initiative roll per group of identical monsters
+	HouseRuleRerollInitiativeEachRound HouseRule = "reroll_initiative_each_round" // Initiative is rolled again every round
+	HouseRulePotionsAsBonusAction      HouseRule = "potions_as_bonus_action"      // Drinking a potion costs a bonus action
+	HouseRuleSlowNaturalHealing        HouseRule = "slow_natural_healing"         // Long rests don't restore hit points
+	HouseRuleVariantEncumbrance        HouseRule = "variant_encumbrance"          // Heavy loads cost speed and impose disadvantage
+	HouseRuleMulticlassing             HouseRule = "multiclassing"                // Characters can take levels in other classes
+)
+
+// HouseRuleInfo describes a house rule for display in the rules editor
+type HouseRuleInfo struct {
+	Rule        HouseRule
+	Name        string
+	Emoji       string
+	Description string
+}
+
+// AllHouseRules lists every supported house rule in display order
+var AllHouseRules = []HouseRuleInfo{
+	{
+		Rule:        HouseRuleMaxDiceCrits,
+		Name:        "Max-Dice Crits",
+		Emoji:       "💥",
+		Description: "Critical hits deal maximum damage on one set of dice, then roll the extra set",
+	},
+	{
+		Rule:        HouseRuleFlanking,
+		Name:        "Flanking",
+		Emoji:       "🗡️",
+		Description: "Melee attacks have advantage against a target an ally is already engaging",
+	},
+	{
+		Rule:        HouseRuleSideInitiative,
+		Name:        "Side Initiative",
+		Emoji:       "🛡️",
+		Description: "Players and monsters each roll a single initiative for their whole side",
+	},
+	{
+		Rule:        HouseRuleGroupInitiative,
+		Name:        "Group Initiative",
+		Emoji:       "👥",
+		Description: "Identical monsters share one initiative roll and act together",
+	},
+	{
+		Rule:        HouseRuleRerollInitiativeEachRound,
+		Name:        "Reroll Initiative",
+		Emoji:       "🔄",
+		Description: "Initiative is rolled again at the start of every round",
+	},
+	{
+		Rule:        HouseRulePotionsAsBonusAction,
+		Name:        "Potions as Bonus Action",
+		Emoji:       "🧪",
+		Description: "Drinking a potion costs a bonus action instead of an action",
+	},
+	{
+		Rule:        HouseRuleSlowNaturalHealing,
+		Name:        "Slow Natural Healing",
+		Emoji:       "🩹",
+		Description: "Long rests don't restore hit points; spend hit dice to heal instead",
+	},
+	{
+		Rule:        HouseRuleVariantEncumbrance,
+		Name:        "Variant Encumbrance",
+		Emoji:       "🎒",
+		Description: "Carrying over 5x Strength costs 10 ft of speed; over 10x costs 20 ft and gives disadvantage on STR, DEX and CON rolls",
+	},
+	{
+		Rule:        HouseRuleMulticlassing,
+		Name:        "Multiclassing",
+		Emoji:       "🔀",
+		Description: "Characters who meet the ability prerequisites can take their next level in a new class",
+	},
+}
+
+// HouseRules holds the optional rule variants enabled for a session.
+// The zero value means standard 5e rules.
+type HouseRules struct {
+	MaxDiceCrits              bool `json:"max_dice_crits"`
+	Flanking                  bool `json:"flanking"`
+	SideInitiative            bool `json:"side_initiative"`
+	GroupInitiative           bool `json:"group_initiative"`
+	RerollInitiativeEachRound bool `json:"reroll_initiative_each_round"`
+	PotionsAsBonusAction      bool `json:"potions_as_bonus_action"`
+	SlowNaturalHealing        bool `json:"slow_natural_healing"`
+	VariantEncumbrance        bool `json:"variant_encumbrance"`
+	Multiclassing             bool `json:"multiclassing"`
+}
+
+// field returns a pointer to the flag backing the given rule, or nil if unknown
+func (h *HouseRules) field(rule HouseRule) *bool {
+	switch rule {
+	case HouseRuleMaxDiceCrits:
+		return &h.MaxDiceCrits
+	case HouseRuleFlanking:
+		return &h.Flanking
+	case HouseRuleSideInitiative:
+		return &h.SideInitiative
+	case HouseRuleGroupInitiative:
+		return &h.GroupInitiative
+	case HouseRuleRerollInitiativeEachRound:
+		return &h.RerollInitiativeEachRound
+	case HouseRulePotionsAsBonusAction:
+		return &h.PotionsAsBonusAction
+	case HouseRuleSlowNaturalHealing:
+		return &h.SlowNaturalHealing
+	case HouseRuleVariantEncumbrance:
+		return &h.VariantEncumbrance
+	case HouseRuleMulticlassing:
+		return &h.Multiclassing
+	default:
+		return nil
+	}
+}
+
+// IsEnabled checks if a house rule is turned on
+func (h *HouseRules) IsEnabled(rule HouseRule) bool {
+	if h == nil {
+		return false
+	}
+	if f := h.field(rule); f != nil {
+		return *f
+	}
+	return false
+}
+
+// Set turns a house rule on or off. Returns false if the rule is unknown.
+func (h *HouseRules) Set(rule HouseRule, enabled bool) bool {
+	f := h.field(rule)
+	if f == nil {
+		return false
+	}
+	*f = enabled
+	return true
+}
+
+// Toggle flips a house rule and returns its new state
+func (h *HouseRules) Toggle(rule HouseRule) bool {
+	enabled := !h.IsEnabled(rule)
+	h.Set(rule, enabled)
+	return enabled
+}
+
+// EnabledRules returns info for every enabled rule in display order
+func (h *HouseRules) EnabledRules() []HouseRuleInfo {
+	var enabled []HouseRuleInfo
+	for _, info := range AllHouseRules {
+		if h.IsEnabled(info.Rule) {
+			enabled = append(enabled, info)
+		}
+	}
+	return enabled
+}
+
+// GetHouseRules returns the session's house rules, defaulting to standard rules
+func (s *SessionSettings) GetHouseRules() *HouseRules {
+	if s == nil || s.HouseRules == nil {
+		return &HouseRules{}
+	}
+	return s.HouseRules
+}
+
+// GetHouseRules returns the house rules configured for this session
+func (s *Session) GetHouseRules() *HouseRules {
+	if s == nil {
+		return &HouseRules{}
+	}
+	return s.Settings.GetHouseRules()
+}
@@ -0,0 +1,52 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHouseRules_Toggle(t *testing.T) {
+	rules := &HouseRules{}
+
+	assert.False(t, rules.IsEnabled(HouseRuleFlanking))
+	assert.True(t, rules.Toggle(HouseRuleFlanking))
+	assert.True(t, rules.Flanking)
+	assert.False(t, rules.Toggle(HouseRuleFlanking))
+	assert.False(t, rules.Flanking)
+}
+
+func TestHouseRules_SetUnknownRule(t *testing.T) {
+	rules := &HouseRules{}
+
+	assert.False(t, rules.Set(HouseRule("critical_fumbles"), true))
+	assert.Empty(t, rules.EnabledRules())
+}
+
+func TestHouseRules_EnabledRulesInDisplayOrder(t *testing.T) {
+	rules := &HouseRules{
+		SlowNaturalHealing: true,
+		MaxDiceCrits:       true,
+	}
+
+	enabled := rules.EnabledRules()
+	if assert.Len(t, enabled, 2) {
+		assert.Equal(t, HouseRuleMaxDiceCrits, enabled[0].Rule)
+		assert.Equal(t, HouseRuleSlowNaturalHealing, enabled[1].Rule)
+	}
+}
+
+func TestHouseRules_AllRulesAreToggleable(t *testing.T) {
+	for _, info := range AllHouseRules {
+		rules := &HouseRules{}
+		assert.True(t, rules.Set(info.Rule, true), "rule %s should be settable", info.Rule)
+		assert.True(t, rules.IsEnabled(info.Rule))
+	}
+}
+
+func TestSession_GetHouseRules_Defaults(t *testing.T) {
+	var nilSession *Session
+	assert.NotNil(t, nilSession.GetHouseRules())
+	assert.NotNil(t, (&Session{}).GetHouseRules())
+	assert.False(t, (&Session{Settings: &SessionSettings{}}).GetHouseRules().IsEnabled(HouseRuleFlanking))
+}
@@ -59,12 +59,17 @@ type SessionMember struct {
 
 // SessionSettings holds configuration for a session
 type SessionSettings struct {
-	MaxPlayers        int      `json:"max_players"`
-	AllowSpectators   bool     `json:"allow_spectators"`
-	RequireInvite     bool     `json:"require_invite"`       // If false, anyone can join with code
-	AutoEndAfterHours int      `json:"auto_end_after_hours"` // Auto-end session after inactivity
-	AllowLateJoin     bool     `json:"allow_late_join"`      // Can players join after session starts
-	RestrictedContent []string `json:"restricted_content"`   // Restricted sourcebooks/content
+	MaxPlayers        int         `json:"max_players"`
+	AllowSpectators   bool        `json:"allow_spectators"`
+	RequireInvite     bool        `json:"require_invite"`        // If false, anyone can join with code
+	AutoEndAfterHours int         `json:"auto_end_after_hours"`  // Auto-end session after inactivity
+	AllowLateJoin     bool        `json:"allow_late_join"`       // Can players join after session starts
+	RestrictedContent []string    `json:"restricted_content"`    // Restricted sourcebooks/content
+	HouseRules        *HouseRules `json:"house_rules,omitempty"` // Optional rule variants for combat
+	Shop              *Shop       `json:"shop,omitempty"`        // Merchant stock and whether it's open
+
+	// AbilityScoreMethod forces how new characters generate ability scores
+	AbilityScoreMethod shared.AbilityScoreMethod `json:"ability_score_method,omitempty"`
 }
 
 // NewSession creates a new session with default settings
@@ -94,9 +99,19 @@ func DefaultSessionSettings() *SessionSettings {
 		AutoEndAfterHours: 24,
 		AllowLateJoin:     true,
 		RestrictedContent: []string{},
+		HouseRules:        &HouseRules{},
 	}
 }
 
+// GetAbilityScoreMethod returns the ability score method new characters
+// must use, or any method if the DM hasn't set one
+func (s *Session) GetAbilityScoreMethod() shared.AbilityScoreMethod {
+	if s == nil || s.Settings == nil {
+		return shared.AbilityScoreMethodAny
+	}
+	return s.Settings.AbilityScoreMethod
+}
+
 // AddMember adds a new member to the session
 func (s *Session) AddMember(userID string, role SessionMemberRole) *SessionMember {
 	member := &SessionMember{
@@ -42,6 +42,7 @@ const (
 	MetadataKeyRoomNumber   MetadataKey = "roomNumber"
 	MetadataKeyLobbyMessage MetadataKey = "lobbyMessageID"
 	MetadataKeyLobbyChannel MetadataKey = "lobbyChannelID"
+	MetadataKeyLootedRoom   MetadataKey = "lootedRoom"
 
 	// Character metadata keys
 	MetadataKeyLevel      MetadataKey = "level"
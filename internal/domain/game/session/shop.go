@@ -0,0 +1,116 @@
+package session
+
+import "slices"
+
+// ShopCategoryInfo describes an SRD equipment category a merchant can stock
+type ShopCategoryInfo struct {
+	Key   string // Equipment category key in the SRD API
+	Name  string
+	Emoji string
+}
+
+// AllShopCategories lists every category a merchant can stock in display order
+var AllShopCategories = []ShopCategoryInfo{
+	{Key: "simple-weapons", Name: "Simple Weapons", Emoji: "🗡️"},
+	{Key: "martial-weapons", Name: "Martial Weapons", Emoji: "⚔️"},
+	{Key: "light-armor", Name: "Light Armor", Emoji: "🧥"},
+	{Key: "medium-armor", Name: "Medium Armor", Emoji: "🦺"},
+	{Key: "heavy-armor", Name: "Heavy Armor", Emoji: "🛡️"},
+	{Key: "shields", Name: "Shields", Emoji: "🔰"},
+	{Key: "adventuring-gear", Name: "Adventuring Gear", Emoji: "🎒"},
+	{Key: "tools", Name: "Tools", Emoji: "🔨"},
+	{Key: "mounts-and-vehicles", Name: "Mounts and Vehicles", Emoji: "🐎"},
+}
+
+// DefaultShopCategories is what a merchant stocks until the DM changes it
+var DefaultShopCategories = []string{
+	"simple-weapons",
+	"martial-weapons",
+	"light-armor",
+	"medium-armor",
+	"shields",
+	"adventuring-gear",
+}
+
+// GetShopCategory returns info for a category key
+func GetShopCategory(key string) (ShopCategoryInfo, bool) {
+	for _, info := range AllShopCategories {
+		if info.Key == key {
+			return info, true
+		}
+	}
+	return ShopCategoryInfo{}, false
+}
+
+// Shop is the session's merchant
+type Shop struct {
+	Open       bool     `json:"open"`
+	Categories []string `json:"categories,omitempty"` // Empty means DefaultShopCategories
+}
+
+// Stocks checks if the merchant sells a category
+func (s *Shop) Stocks(category string) bool {
+	return slices.Contains(s.StockedCategories(), category)
+}
+
+// StockedCategories returns the keys of the categories the merchant sells
+func (s *Shop) StockedCategories() []string {
+	if s == nil || len(s.Categories) == 0 {
+		return DefaultShopCategories
+	}
+	return s.Categories
+}
+
+// SetStocked adds or removes a category. Returns false if the category is
+// unknown or removing it would leave the merchant with nothing to sell.
+func (s *Shop) SetStocked(category string, stocked bool) bool {
+	if _, ok := GetShopCategory(category); !ok {
+		return false
+	}
+
+	var categories []string
+	for _, info := range AllShopCategories {
+		if info.Key == category {
+			if stocked {
+				categories = append(categories, info.Key)
+			}
+			continue
+		}
+		if s.Stocks(info.Key) {
+			categories = append(categories, info.Key)
+		}
+	}
+	if len(categories) == 0 {
+		return false
+	}
+
+	s.Categories = categories
+	return true
+}
+
+// GetShop returns the session's merchant settings
+func (s *SessionSettings) GetShop() *Shop {
+	if s == nil || s.Shop == nil {
+		return &Shop{}
+	}
+	return s.Shop
+}
+
+// GetShop returns the merchant configured for this session
+func (s *Session) GetShop() *Shop {
+	if s == nil {
+		return &Shop{}
+	}
+	return s.Settings.GetShop()
+}
+
+// OpenShop opens the session's merchant, creating default settings if needed
+func (s *Session) OpenShop() {
+	if s.Settings == nil {
+		s.Settings = DefaultSessionSettings()
+	}
+	if s.Settings.Shop == nil {
+		s.Settings.Shop = &Shop{}
+	}
+	s.Settings.Shop.Open = true
+}
@@ -0,0 +1,43 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShop_DefaultStock(t *testing.T) {
+	var shop *Shop
+
+	assert.Equal(t, DefaultShopCategories, shop.StockedCategories())
+	assert.True(t, shop.Stocks("simple-weapons"))
+	assert.False(t, shop.Stocks("heavy-armor"))
+}
+
+func TestShop_SetStocked(t *testing.T) {
+	shop := &Shop{}
+
+	assert.True(t, shop.SetStocked("heavy-armor", true))
+	assert.True(t, shop.Stocks("heavy-armor"))
+	assert.True(t, shop.Stocks("simple-weapons"), "the defaults are kept")
+
+	assert.True(t, shop.SetStocked("simple-weapons", false))
+	assert.False(t, shop.Stocks("simple-weapons"))
+
+	assert.False(t, shop.SetStocked("spaceships", true))
+}
+
+func TestShop_CantRemoveLastCategory(t *testing.T) {
+	shop := &Shop{Categories: []string{"tools"}}
+
+	assert.False(t, shop.SetStocked("tools", false))
+	assert.Equal(t, []string{"tools"}, shop.Categories)
+}
+
+func TestSession_OpenShop(t *testing.T) {
+	sess := &Session{}
+
+	assert.False(t, sess.GetShop().Open)
+	sess.OpenShop()
+	assert.True(t, sess.GetShop().Open)
+}
@@ -50,7 +50,7 @@ func (h *RageHandler) Execute(ctx context.Context, char *character.Character, ab
 		h.removeRageEffects(char)
 
 		// Save character state
-		if err := h.characterService.UpdateEquipment(char); err != nil {
+		if err := h.characterService.UpdateEquipment(ctx, char); err != nil {
 			log.Printf("Failed to save character after deactivating rage: %v", err)
 		}
 
@@ -88,7 +88,7 @@ func (h *RageHandler) Execute(ctx context.Context, char *character.Character, ab
 	ability.Duration = 10
 
 	// Add rage effects
-	rageEffect := effects.BuildRageEffect(char.Level)
+	rageEffect := effects.BuildRageEffect(char.ClassFeatureLevel("barbarian"))
 	if rageEffect != nil {
 		// Convert StatusEffect to ActiveEffect
 		activeEffect := &shared.ActiveEffect{
@@ -109,7 +109,7 @@ func (h *RageHandler) Execute(ctx context.Context, char *character.Character, ab
 			if mod.Target == effects.TargetDamage {
 				activeEffect.Modifiers = append(activeEffect.Modifiers, shared.Modifier{
 					Type:  shared.ModifierTypeDamageBonus,
-					Value: h.getRageDamageBonus(char.Level),
+					Value: h.getRageDamageBonus(char.ClassFeatureLevel("barbarian")),
 				})
 			}
 			// Map resistances
@@ -126,7 +126,7 @@ func (h *RageHandler) Execute(ctx context.Context, char *character.Character, ab
 	}
 
 	// Save character state
-	if err := h.characterService.UpdateEquipment(char); err != nil {
+	if err := h.characterService.UpdateEquipment(ctx, char); err != nil {
 		log.Printf("Failed to save character after activating rage: %v", err)
 	}
 
@@ -141,7 +141,7 @@ func (h *RageHandler) Execute(ctx context.Context, char *character.Character, ab
 			nil,
 		)
 		activateEvent.Context().Set("duration", 10) // 10 rounds
-		activateEvent.Context().Set("damage_bonus", h.getRageDamageBonus(char.Level))
+		activateEvent.Context().Set("damage_bonus", h.getRageDamageBonus(char.ClassFeatureLevel("barbarian")))
 
 		if err := h.eventBus.Publish(ctx, activateEvent); err != nil {
 			log.Printf("Failed to publish rage activated event: %v", err)
@@ -150,12 +150,12 @@ func (h *RageHandler) Execute(ctx context.Context, char *character.Character, ab
 
 	return &Result{
 		Success:       true,
-		Message:       fmt.Sprintf("%s enters a rage! (+%d melee damage, resistance to physical damage)", char.Name, h.getRageDamageBonus(char.Level)),
+		Message:       fmt.Sprintf("%s enters a rage! (+%d melee damage, resistance to physical damage)", char.Name, h.getRageDamageBonus(char.ClassFeatureLevel("barbarian"))),
 		UsesRemaining: ability.UsesRemaining,
 		EffectApplied: true,
 		EffectName:    "Rage",
 		Duration:      10, // 10 rounds
-		DamageBonus:   h.getRageDamageBonus(char.Level),
+		DamageBonus:   h.getRageDamageBonus(char.ClassFeatureLevel("barbarian")),
 	}, nil
 }
 
@@ -212,7 +212,7 @@ func (h *RageHandler) registerRageHandlers(char *character.Character) {
 		if weaponType == "melee" || attackType == "melee" {
 			// Add rage damage bonus
 			currentDamage, _ := rpgtoolkit.GetIntContext(event, rpgtoolkit.ContextDamage)
-			rageBonus := h.getRageDamageBonus(char.Level)
+			rageBonus := h.getRageDamageBonus(char.ClassFeatureLevel("barbarian"))
 			event.Context().Set(rpgtoolkit.ContextDamage, currentDamage+rageBonus)
 			event.Context().Set("rage_damage_applied", true)
 
@@ -54,7 +54,7 @@ func TestRageHandler_Execute(t *testing.T) {
 				AbilityKey:  shared.AbilityKeyRage,
 			},
 			mockSetup: func(m *mockcharacter.MockService) {
-				m.EXPECT().UpdateEquipment(gomock.Any()).Return(nil)
+				m.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			expectedResult: func(t *testing.T, r *Result) {
 				assert.True(t, r.Success)
@@ -114,7 +114,7 @@ func TestRageHandler_Execute(t *testing.T) {
 				AbilityKey:  shared.AbilityKeyRage,
 			},
 			mockSetup: func(m *mockcharacter.MockService) {
-				m.EXPECT().UpdateEquipment(gomock.Any()).Return(nil)
+				m.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			expectedResult: func(t *testing.T, r *Result) {
 				assert.True(t, r.Success)
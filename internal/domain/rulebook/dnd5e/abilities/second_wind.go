@@ -34,7 +34,7 @@ func (s *SecondWindHandler) Execute(ctx context.Context, char *character.Charact
 	}
 
 	// Roll 1d10 + fighter level
-	rollResult, err := s.diceRoller.Roll(1, 10, char.Level)
+	rollResult, err := s.diceRoller.Roll(1, 10, char.ClassFeatureLevel("fighter"))
 	if err != nil {
 		ability.UsesRemaining++ // Restore the use
 		result.Success = false
@@ -0,0 +1,65 @@
+package rulebook
+
+import (
+	"fmt"
+	"slices"
+)
+
+// StandardArray is the fixed set of scores players assign with the standard array
+var StandardArray = []int{15, 14, 13, 12, 10, 8}
+
+// Point buy limits from the PHB
+const (
+	PointBuyBudget = 27 // Points to spend across all six scores
+	PointBuyMin    = 8  // Every score starts here
+	PointBuyMax    = 15 // Highest score points can buy, before racial bonuses
+)
+
+// pointBuyCosts is the total cost of each score from PointBuyMin to PointBuyMax
+var pointBuyCosts = [PointBuyMax - PointBuyMin + 1]int{0, 1, 2, 3, 4, 5, 7, 9}
+
+// PointBuyCost returns the points needed to buy a score. Scores outside
+// 8-15 can't be bought and return -1.
+func PointBuyCost(score int) int {
+	if score < PointBuyMin || score > PointBuyMax {
+		return -1
+	}
+	return pointBuyCosts[score-PointBuyMin]
+}
+
+// PointBuyTotal returns the points spent on a set of scores
+func PointBuyTotal(scores []int) int {
+	total := 0
+	for _, score := range scores {
+		total += max(PointBuyCost(score), 0)
+	}
+	return total
+}
+
+// ValidatePointBuy checks six scores are each 8-15 and cost no more than the budget
+func ValidatePointBuy(scores []int) error {
+	if len(scores) != 6 {
+		return fmt.Errorf("point buy needs 6 scores, got %d", len(scores))
+	}
+	for _, score := range scores {
+		if PointBuyCost(score) < 0 {
+			return fmt.Errorf("point buy scores must be %d-%d, got %d", PointBuyMin, PointBuyMax, score)
+		}
+	}
+	if total := PointBuyTotal(scores); total > PointBuyBudget {
+		return fmt.Errorf("point buy spends %d points, only %d are allowed", total, PointBuyBudget)
+	}
+	return nil
+}
+
+// ValidateStandardArray checks the scores are the standard array in any order
+func ValidateStandardArray(scores []int) error {
+	sorted := slices.Clone(scores)
+	slices.Sort(sorted)
+	expected := slices.Clone(StandardArray)
+	slices.Sort(expected)
+	if !slices.Equal(sorted, expected) {
+		return fmt.Errorf("standard array scores must be %v, got %v", StandardArray, scores)
+	}
+	return nil
+}
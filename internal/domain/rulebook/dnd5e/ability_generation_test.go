@@ -0,0 +1,29 @@
+package rulebook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPointBuyCost(t *testing.T) {
+	expected := map[int]int{7: -1, 8: 0, 9: 1, 12: 4, 13: 5, 14: 7, 15: 9, 16: -1}
+	for score, cost := range expected {
+		assert.Equal(t, cost, PointBuyCost(score), "score %d", score)
+	}
+}
+
+func TestValidatePointBuy(t *testing.T) {
+	assert.NoError(t, ValidatePointBuy([]int{15, 15, 15, 8, 8, 8}))
+	assert.NoError(t, ValidatePointBuy([]int{13, 13, 13, 12, 12, 12}))
+	assert.NoError(t, ValidatePointBuy([]int{8, 8, 8, 8, 8, 8}), "spending less is allowed")
+	assert.Error(t, ValidatePointBuy([]int{15, 15, 15, 9, 8, 8}), "28 points")
+	assert.Error(t, ValidatePointBuy([]int{16, 8, 8, 8, 8, 8}), "above 15")
+	assert.Error(t, ValidatePointBuy([]int{7, 8, 8, 8, 8, 8}), "below 8")
+	assert.Error(t, ValidatePointBuy([]int{15, 15}), "too few scores")
+}
+
+func TestValidateStandardArray(t *testing.T) {
+	assert.NoError(t, ValidateStandardArray([]int{8, 10, 12, 13, 14, 15}))
+	assert.Error(t, ValidateStandardArray([]int{15, 15, 13, 12, 10, 8}))
+}
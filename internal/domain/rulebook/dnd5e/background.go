@@ -1,9 +1,6 @@
 package rulebook
 
-import (
-	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
-	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
-)
+import "sort"
 
 // Background represents a D&D 5e character background
 type Background struct {
@@ -11,27 +8,27 @@ type Background struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 
-	// Starting proficiencies and choices
-	SkillProficiencies    []*Proficiency `json:"skill_proficiencies"`
-	ToolProficiencies     []*Proficiency `json:"tool_proficiencies"`
-	LanguageOptions       *shared.Choice `json:"language_options"`
-	StartingProficiencies []*Proficiency `json:"starting_proficiencies"`
+	// Proficiencies every character with the background gets. Where the book
+	// lets the player pick a gaming set, instrument or artisan's tools, the
+	// background lists a common one.
+	SkillProficiencies []*Proficiency `json:"skill_proficiencies"`
+	ToolProficiencies  []*Proficiency `json:"tool_proficiencies"`
+
+	// Languages is how many languages of their choice the character learns
+	Languages int `json:"languages"`
 
-	// Starting equipment
-	StartingEquipment        []equipment.Equipment `json:"starting_equipment"`
-	StartingEquipmentOptions []*shared.Choice      `json:"starting_equipment_options"`
+	// Starting equipment and the gold in the character's pouch
+	StartingEquipment []BackgroundItem `json:"starting_equipment"`
+	StartingGold      int              `json:"starting_gold"`
 
 	// Feature that comes with the background
 	Feature *Feature `json:"feature"`
 
-	// Personality customization options
+	// Personality tables the player picks or rolls from
 	PersonalityTraits []string `json:"personality_traits"`
 	Ideals            []string `json:"ideals"`
 	Bonds             []string `json:"bonds"`
 	Flaws             []string `json:"flaws"`
-
-	// Suggested characteristics
-	SuggestedCharacteristics []string `json:"suggested_characteristics"`
 }
 
 // Feature represents a special feature granted by a background
@@ -39,3 +36,128 @@ type Feature struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 }
+
+// BackgroundItem is a piece of starting equipment. Key is the SRD equipment
+// key when there is one; items the SRD doesn't list are kept by name.
+type BackgroundItem struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// PersonalityTable names one of a background's personality tables
+type PersonalityTable string
+
+const (
+	PersonalityTableTraits PersonalityTable = "trait"
+	PersonalityTableIdeals PersonalityTable = "ideal"
+	PersonalityTableBonds  PersonalityTable = "bond"
+	PersonalityTableFlaws  PersonalityTable = "flaw"
+)
+
+// PersonalityTables lists the tables in the order the book presents them
+var PersonalityTables = []PersonalityTable{
+	PersonalityTableTraits,
+	PersonalityTableIdeals,
+	PersonalityTableBonds,
+	PersonalityTableFlaws,
+}
+
+// Picks returns how many entries a character takes from the table: two
+// personality traits and one of everything else
+func (t PersonalityTable) Picks() int {
+	if t == PersonalityTableTraits {
+		return 2
+	}
+	return 1
+}
+
+// DisplayName returns the table's name for display
+func (t PersonalityTable) DisplayName() string {
+	switch t {
+	case PersonalityTableTraits:
+		return "Personality Traits"
+	case PersonalityTableIdeals:
+		return "Ideal"
+	case PersonalityTableBonds:
+		return "Bond"
+	case PersonalityTableFlaws:
+		return "Flaw"
+	default:
+		return string(t)
+	}
+}
+
+// Table returns the entries of one of the background's personality tables
+func (b *Background) Table(table PersonalityTable) []string {
+	switch table {
+	case PersonalityTableTraits:
+		return b.PersonalityTraits
+	case PersonalityTableIdeals:
+		return b.Ideals
+	case PersonalityTableBonds:
+		return b.Bonds
+	case PersonalityTableFlaws:
+		return b.Flaws
+	default:
+		return nil
+	}
+}
+
+// FeatureKey returns the key of the character feature the background grants
+func (b *Background) FeatureKey() string {
+	return "background_" + b.ID
+}
+
+// GetSRDBackground returns the background with the given key, or nil
+func GetSRDBackground(key string) *Background {
+	return srdBackgrounds[key]
+}
+
+// SRDBackgrounds returns every background, sorted by name
+func SRDBackgrounds() []*Background {
+	backgrounds := make([]*Background, 0, len(srdBackgrounds))
+	for _, background := range srdBackgrounds {
+		backgrounds = append(backgrounds, background)
+	}
+	sort.Slice(backgrounds, func(i, j int) bool {
+		return backgrounds[i].Name < backgrounds[j].Name
+	})
+	return backgrounds
+}
+
+// Language is a language a character can learn
+type Language struct {
+	Key      string
+	Name     string
+	Speakers string
+}
+
+// Languages lists the standard and exotic languages, leaving out Common,
+// which every character already speaks
+var Languages = []Language{
+	{Key: "dwarvish", Name: "Dwarvish", Speakers: "Dwarves"},
+	{Key: "elvish", Name: "Elvish", Speakers: "Elves"},
+	{Key: "giant", Name: "Giant", Speakers: "Ogres, giants"},
+	{Key: "gnomish", Name: "Gnomish", Speakers: "Gnomes"},
+	{Key: "goblin", Name: "Goblin", Speakers: "Goblinoids"},
+	{Key: "halfling", Name: "Halfling", Speakers: "Halflings"},
+	{Key: "orc", Name: "Orc", Speakers: "Orcs"},
+	{Key: "abyssal", Name: "Abyssal", Speakers: "Demons"},
+	{Key: "celestial", Name: "Celestial", Speakers: "Celestials"},
+	{Key: "draconic", Name: "Draconic", Speakers: "Dragons, dragonborn"},
+	{Key: "deep-speech", Name: "Deep Speech", Speakers: "Aboleths, cloakers"},
+	{Key: "infernal", Name: "Infernal", Speakers: "Devils"},
+	{Key: "primordial", Name: "Primordial", Speakers: "Elementals"},
+	{Key: "sylvan", Name: "Sylvan", Speakers: "Fey creatures"},
+	{Key: "undercommon", Name: "Undercommon", Speakers: "Underdark traders"},
+}
+
+// GetLanguage returns the language with the given key
+func GetLanguage(key string) (Language, bool) {
+	for _, language := range Languages {
+		if language.Key == key {
+			return language, true
+		}
+	}
+	return Language{}, false
+}
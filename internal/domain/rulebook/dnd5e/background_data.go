@@ -0,0 +1,504 @@
+package rulebook
+
+import "strings"
+
+// srdBackgrounds are the backgrounds characters can take. The D&D 5e API
+// only serves the SRD's acolyte, so they are kept here in full.
+var srdBackgrounds = map[string]*Background{
+	"acolyte": {
+		ID:                 "acolyte",
+		Name:               "Acolyte",
+		Description:        "You have spent your life in the service of a temple, acting as an intermediary between the realm of the holy and the mortal world.",
+		SkillProficiencies: backgroundSkills("Insight", "Religion"),
+		Languages:          2,
+		StartingEquipment: []BackgroundItem{
+			{Key: "amulet", Name: "Holy Symbol"},
+			{Key: "book", Name: "Prayer Book"},
+			{Name: "Incense (5 sticks)"},
+			{Key: "vestments", Name: "Vestments"},
+			{Key: "clothes-common", Name: "Common Clothes"},
+			{Key: "pouch", Name: "Pouch"},
+		},
+		StartingGold: 15,
+		Feature: &Feature{
+			Name:        "Shelter of the Faithful",
+			Description: "You and your companions can expect free healing and care at a temple, shrine or other established presence of your faith.",
+		},
+		PersonalityTraits: []string{
+			"I idolize a particular hero of my faith, and constantly refer to that person's deeds and example.",
+			"I can find common ground between the fiercest enemies, empathizing with them and always working toward peace.",
+			"I see omens in every event and action. The gods try to speak to us, we just need to listen.",
+			"Nothing can shake my optimistic attitude.",
+			"I quote (or misquote) sacred texts and proverbs in almost every situation.",
+			"I am tolerant (or intolerant) of other faiths and respect (or condemn) the worship of other gods.",
+			"I've enjoyed fine food, drink, and high society among my temple's elite. Rough living grates on me.",
+			"I've spent so long in the temple that I have little practical experience dealing with people in the outside world.",
+		},
+		Ideals: []string{
+			"Tradition. The ancient traditions of worship and sacrifice must be preserved and upheld. (Lawful)",
+			"Charity. I always try to help those in need, no matter what the personal cost. (Good)",
+			"Change. We must help bring about the changes the gods are constantly working in the world. (Chaotic)",
+			"Power. I hope to one day rise to the top of my faith's religious hierarchy. (Lawful)",
+			"Faith. I trust that my deity will guide my actions. I have faith that if I work hard, things will go well. (Lawful)",
+			"Aspiration. I seek to prove myself worthy of my god's favor by matching my actions against their teachings. (Any)",
+		},
+		Bonds: []string{
+			"I would die to recover an ancient relic of my faith that was lost long ago.",
+			"I will someday get revenge on the corrupt temple hierarchy who branded me a heretic.",
+			"I owe my life to the priest who took me in when my parents died.",
+			"Everything I do is for the common people.",
+			"I will do anything to protect the temple where I served.",
+			"I seek to preserve a sacred text that my enemies consider heretical and seek to destroy.",
+		},
+		Flaws: []string{
+			"I judge others harshly, and myself even more severely.",
+			"I put too much trust in those who wield power within my temple's hierarchy.",
+			"My piety sometimes leads me to blindly trust those that profess faith in my god.",
+			"I am inflexible in my thinking.",
+			"I am suspicious of strangers and expect the worst of them.",
+			"Once I pick a goal, I become obsessed with it to the detriment of everything else in my life.",
+		},
+	},
+	"charlatan": {
+		ID:                 "charlatan",
+		Name:               "Charlatan",
+		Description:        "You have always had a way with people, and you know what makes them tick well enough to sell them whatever they want.",
+		SkillProficiencies: backgroundSkills("Deception", "Sleight of Hand"),
+		ToolProficiencies: []*Proficiency{
+			backgroundTool("disguise-kit", "Disguise Kit"),
+			backgroundTool("forgery-kit", "Forgery Kit"),
+		},
+		StartingEquipment: []BackgroundItem{
+			{Key: "clothes-fine", Name: "Fine Clothes"},
+			{Key: "disguise-kit", Name: "Disguise Kit"},
+			{Name: "Con Tools (weighted dice)"},
+			{Key: "pouch", Name: "Pouch"},
+		},
+		StartingGold: 15,
+		Feature: &Feature{
+			Name:        "False Identity",
+			Description: "You keep a second identity with documents, acquaintances and disguises, and can forge papers you have seen.",
+		},
+		PersonalityTraits: []string{
+			"I fall in and out of love easily, and am always chasing someone.",
+			"I have a joke for every occasion, especially the ones that call for silence.",
+			"Flattery is my favorite tool for getting what I want.",
+			"I'm a born gambler who can't resist a risk with a big payoff.",
+			"I lie about almost everything, even when there's no reason to.",
+			"Sarcasm and insults are my weapons of choice.",
+		},
+		Ideals: []string{
+			"Independence. I am a free spirit and no one tells me what to do. (Chaotic)",
+			"Fairness. I never target people who can't afford to lose a few coins. (Lawful)",
+			"Charity. I share what I take with the people who need it most. (Good)",
+			"Creativity. I never run the same con twice. (Chaotic)",
+		},
+		Bonds: []string{
+			"I fleeced the wrong person and must keep them from ever finding me.",
+			"I owe everything to a mentor who taught me the trade, and who ended up in prison.",
+			"A child of mine doesn't know I exist, and I'm trying to make their world better anyway.",
+			"I come from a wealthy family and one day I'll win back what I lost.",
+		},
+		Flaws: []string{
+			"I can't resist a pretty face.",
+			"I'm always in debt, and spend my gains as fast as I make them.",
+			"I'm convinced no one could ever fool me the way I fool others.",
+			"I can't pass up a chance to swindle someone richer than me.",
+		},
+	},
+	"criminal": {
+		ID:                 "criminal",
+		Name:               "Criminal",
+		Description:        "You are an experienced criminal with a history of breaking the law and contacts in the criminal underworld.",
+		SkillProficiencies: backgroundSkills("Deception", "Stealth"),
+		ToolProficiencies: []*Proficiency{
+			backgroundTool("thieves-tools", "Thieves' Tools"),
+			backgroundTool("dice-set", "Dice Set"),
+		},
+		StartingEquipment: []BackgroundItem{
+			{Key: "crowbar", Name: "Crowbar"},
+			{Key: "clothes-common", Name: "Dark Common Clothes with a Hood"},
+			{Key: "pouch", Name: "Pouch"},
+		},
+		StartingGold: 15,
+		Feature: &Feature{
+			Name:        "Criminal Contact",
+			Description: "You have a reliable contact who acts as your liaison to a network of other criminals and can carry messages for you.",
+		},
+		PersonalityTraits: []string{
+			"I always have a plan for what to do when things go wrong.",
+			"I stay calm no matter the situation, and never raise my voice.",
+			"The first thing I do in a new place is note where the valuables are.",
+			"I'd rather make a new friend than a new enemy.",
+			"I don't trust anyone who doesn't have something to lose.",
+			"I blow up at the slightest insult.",
+		},
+		Ideals: []string{
+			"Honor. I don't steal from others in the trade. (Lawful)",
+			"Freedom. Chains are meant to be broken, as are those who forge them. (Chaotic)",
+			"Greed. I'll do whatever it takes to become wealthy. (Evil)",
+			"People. I'm loyal to my friends, not to any ideals. (Neutral)",
+		},
+		Bonds: []string{
+			"I'm trying to pay off an old debt I owe to a generous benefactor.",
+			"My ill-gotten gains go to support my family.",
+			"Something important was taken from me, and I aim to steal it back.",
+			"Someone I loved died because of a mistake I made. It will never happen again.",
+		},
+		Flaws: []string{
+			"When I see something valuable, I can't think about anything but how to steal it.",
+			"When faced with a choice between money and my friends, I usually choose the money.",
+			"If there's a plan, I'll forget it. If I don't forget it, I'll ignore it.",
+			"An innocent person is in prison for a crime that I committed, and I'm fine with that.",
+		},
+	},
+	"entertainer": {
+		ID:                 "entertainer",
+		Name:               "Entertainer",
+		Description:        "You thrive in front of an audience, and know how to entrance, entertain and inspire them.",
+		SkillProficiencies: backgroundSkills("Acrobatics", "Performance"),
+		ToolProficiencies: []*Proficiency{
+			backgroundTool("disguise-kit", "Disguise Kit"),
+			{Key: "lute", Name: "Lute", Type: ProficiencyTypeInstrument},
+		},
+		StartingEquipment: []BackgroundItem{
+			{Key: "lute", Name: "Lute"},
+			{Name: "Favor of an Admirer"},
+			{Key: "clothes-costume", Name: "Costume"},
+			{Key: "pouch", Name: "Pouch"},
+		},
+		StartingGold: 15,
+		Feature: &Feature{
+			Name:        "By Popular Demand",
+			Description: "You can always find a place to perform, where you receive free lodging and food in exchange for performing each night.",
+		},
+		PersonalityTraits: []string{
+			"I know a story relevant to almost every situation.",
+			"Whenever I come to a new place, I collect local rumors and spread gossip.",
+			"I'm a hopeless romantic, always searching for that special someone.",
+			"Nobody stays angry at me for long, since I can defuse any amount of tension.",
+			"I love a good insult, even one directed at me.",
+			"I get bitter if I'm not the center of attention.",
+		},
+		Ideals: []string{
+			"Beauty. When I perform, I make the world better than it was. (Good)",
+			"Tradition. The stories of old must never be forgotten. (Lawful)",
+			"Creativity. The world is in need of new ideas and bold action. (Chaotic)",
+			"Fame. I'm going to be famous, whatever it takes. (Any)",
+		},
+		Bonds: []string{
+			"My instrument is my most treasured possession, and it reminds me of someone I love.",
+			"Someone stole my precious instrument, and someday I'll get it back.",
+			"I want to be famous, whatever it takes.",
+			"I would do anything to prove myself superior to my hated rival.",
+		},
+		Flaws: []string{
+			"I'll do anything to win fame and renown.",
+			"I'm a sucker for a pretty face.",
+			"A scandal prevents me from ever going home again.",
+			"I have trouble keeping my true feelings hidden. My sharp tongue lands me in trouble.",
+		},
+	},
+	"folk-hero": {
+		ID:                 "folk-hero",
+		Name:               "Folk Hero",
+		Description:        "You come from a humble social rank, but you are destined for so much more. The people of your home village see you as their champion.",
+		SkillProficiencies: backgroundSkills("Animal Handling", "Survival"),
+		ToolProficiencies: []*Proficiency{
+			backgroundTool("carpenters-tools", "Carpenter's Tools"),
+			backgroundTool("land-vehicles", "Land Vehicles"),
+		},
+		StartingEquipment: []BackgroundItem{
+			{Key: "carpenters-tools", Name: "Carpenter's Tools"},
+			{Key: "shovel", Name: "Shovel"},
+			{Key: "pot-iron", Name: "Iron Pot"},
+			{Key: "clothes-common", Name: "Common Clothes"},
+			{Key: "pouch", Name: "Pouch"},
+		},
+		StartingGold: 10,
+		Feature: &Feature{
+			Name:        "Rustic Hospitality",
+			Description: "Common folk will shelter you from the law or anyone searching for you, though they won't risk their lives for you.",
+		},
+		PersonalityTraits: []string{
+			"I judge people by their actions, not their words.",
+			"If someone is in trouble, I'm always ready to lend help.",
+			"When I set my mind to something, I follow through no matter what gets in my way.",
+			"I have a strong sense of fair play and always try to find the most equitable solution.",
+			"I'm confident in my own abilities and do what I can to instill confidence in others.",
+			"I misuse long words in an attempt to sound smarter.",
+		},
+		Ideals: []string{
+			"Respect. People deserve to be treated with dignity and respect. (Good)",
+			"Fairness. No one should get preferential treatment before the law. (Lawful)",
+			"Freedom. Tyrants must not be allowed to oppress the people. (Chaotic)",
+			"Destiny. Nothing and no one can steer me away from my higher calling. (Any)",
+		},
+		Bonds: []string{
+			"I have a family, but I have no idea where they are. One day I hope to see them again.",
+			"I worked the land, I love the land, and I will protect the land.",
+			"A proud noble once gave me a horrible beating, and I will take my revenge.",
+			"I protect those who cannot protect themselves.",
+		},
+		Flaws: []string{
+			"The tyrant who rules my land will stop at nothing to see me killed.",
+			"I'm convinced of the significance of my destiny, and blind to my shortcomings.",
+			"The people who knew me when I was young know my shameful secret.",
+			"I have a weakness for the vices of the city, especially hard drink.",
+		},
+	},
+	"hermit": {
+		ID:                 "hermit",
+		Name:               "Hermit",
+		Description:        "You lived in seclusion for a formative part of your life, seeking quiet, solitude and perhaps some of the answers you were looking for.",
+		SkillProficiencies: backgroundSkills("Medicine", "Religion"),
+		ToolProficiencies: []*Proficiency{
+			backgroundTool("herbalism-kit", "Herbalism Kit"),
+		},
+		Languages: 1,
+		StartingEquipment: []BackgroundItem{
+			{Name: "Scroll Case of Notes"},
+			{Key: "blanket", Name: "Winter Blanket"},
+			{Key: "clothes-common", Name: "Common Clothes"},
+			{Key: "herbalism-kit", Name: "Herbalism Kit"},
+		},
+		StartingGold: 5,
+		Feature: &Feature{
+			Name:        "Discovery",
+			Description: "Your seclusion gave you access to a unique and powerful discovery, a truth about the cosmos, the gods or the forces of nature.",
+		},
+		PersonalityTraits: []string{
+			"I've been isolated for so long that I rarely speak, preferring gestures and the occasional grunt.",
+			"I am utterly serene, even in the face of disaster.",
+			"I feel tremendous empathy for all who suffer.",
+			"I connect everything that happens to me to a grand, cosmic plan.",
+			"I often get lost in my own thoughts, becoming oblivious to my surroundings.",
+			"I am working on a grand philosophical theory and love sharing my ideas.",
+		},
+		Ideals: []string{
+			"Greater Good. My gifts are meant to be shared with all, not used for my own benefit. (Good)",
+			"Free Thinking. Inquiry and curiosity are the pillars of progress. (Chaotic)",
+			"Self-Knowledge. If you know yourself, there's nothing left to know. (Any)",
+			"Logic. Emotions must not cloud our sense of what is right and true. (Lawful)",
+		},
+		Bonds: []string{
+			"Nothing is more important than the other members of my hermitage, order, or association.",
+			"I entered seclusion to hide from the ones who might still be hunting me.",
+			"I'm still seeking the enlightenment I pursued in my seclusion.",
+			"I entered seclusion because I loved someone I could not have.",
+		},
+		Flaws: []string{
+			"Now that I've returned to the world, I enjoy its delights a little too much.",
+			"I harbor dark, bloodthirsty thoughts that my isolation failed to quell.",
+			"I am dogmatic in my thoughts and philosophy.",
+			"I'd risk too much to uncover a lost bit of knowledge.",
+		},
+	},
+	"noble": {
+		ID:                 "noble",
+		Name:               "Noble",
+		Description:        "You understand wealth, power and privilege. You carry a noble title, and your family owns land, collects taxes and wields political influence.",
+		SkillProficiencies: backgroundSkills("History", "Persuasion"),
+		ToolProficiencies: []*Proficiency{
+			backgroundTool("playing-card-set", "Playing Card Set"),
+		},
+		Languages: 1,
+		StartingEquipment: []BackgroundItem{
+			{Key: "clothes-fine", Name: "Fine Clothes"},
+			{Key: "signet-ring", Name: "Signet Ring"},
+			{Name: "Scroll of Pedigree"},
+			{Key: "pouch", Name: "Purse"},
+		},
+		StartingGold: 25,
+		Feature: &Feature{
+			Name:        "Position of Privilege",
+			Description: "People are inclined to think the best of you. You are welcome in high society, and common folk try to accommodate you.",
+		},
+		PersonalityTraits: []string{
+			"My eloquent flattery makes everyone I talk to feel like the most important person in the world.",
+			"The common folk love me for my kindness and generosity.",
+			"No one could doubt by looking at my regal bearing that I am a cut above the unwashed masses.",
+			"I take great pains to always look my best and follow the latest fashions.",
+			"I don't like to get my hands dirty, and I won't be caught dead in unsuitable accommodations.",
+			"Despite my noble birth, I do not place myself above other folk.",
+		},
+		Ideals: []string{
+			"Respect. Respect is due to me because of my position, but all people deserve dignity. (Good)",
+			"Responsibility. It is my duty to respect the authority of those above me, just as those below me must respect mine. (Lawful)",
+			"Independence. I must prove that I can handle myself without the coddling of my family. (Chaotic)",
+			"Power. If I can attain more power, no one will tell me what to do. (Evil)",
+		},
+		Bonds: []string{
+			"I will face any challenge to win the approval of my family.",
+			"My house's alliance with another noble family must be sustained at all costs.",
+			"Nothing is more important than the other members of my family.",
+			"I am in love with the heir of a family that my family despises.",
+		},
+		Flaws: []string{
+			"I secretly believe that everyone is beneath me.",
+			"I hide a truly scandalous secret that could ruin my family forever.",
+			"I too often hear veiled insults and threats in every word addressed to me.",
+			"I have an insatiable desire for carnal pleasures.",
+		},
+	},
+	"outlander": {
+		ID:                 "outlander",
+		Name:               "Outlander",
+		Description:        "You grew up in the wilds, far from civilization and the comforts of town and technology.",
+		SkillProficiencies: backgroundSkills("Athletics", "Survival"),
+		ToolProficiencies: []*Proficiency{
+			{Key: "flute", Name: "Flute", Type: ProficiencyTypeInstrument},
+		},
+		Languages: 1,
+		StartingEquipment: []BackgroundItem{
+			{Key: "quarterstaff", Name: "Staff"},
+			{Key: "hunting-trap", Name: "Hunting Trap"},
+			{Name: "Trophy from an Animal You Killed"},
+			{Key: "clothes-travelers", Name: "Traveler's Clothes"},
+			{Key: "pouch", Name: "Pouch"},
+		},
+		StartingGold: 10,
+		Feature: &Feature{
+			Name:        "Wanderer",
+			Description: "You have an excellent memory for maps and geography, and can find food and fresh water for yourself and up to five others each day.",
+		},
+		PersonalityTraits: []string{
+			"I'm driven by a wanderlust that led me away from home.",
+			"I watch over my friends as if they were a litter of newborn pups.",
+			"I once ran twenty-five miles without stopping to warn my clan of an approaching horde.",
+			"I have a lesson for every situation, drawn from observing nature.",
+			"I place no stock in wealthy or well-mannered folk. Money and manners won't save you from a hungry owlbear.",
+			"I feel far more comfortable around animals than people.",
+		},
+		Ideals: []string{
+			"Change. Life is like the seasons, in constant change, and we must change with it. (Chaotic)",
+			"Greater Good. It is each person's responsibility to make the most happiness for the whole tribe. (Good)",
+			"Honor. If I dishonor myself, I dishonor my whole clan. (Lawful)",
+			"Nature. The natural world is more important than all the constructs of civilization. (Neutral)",
+		},
+		Bonds: []string{
+			"My family, clan, or tribe is the most important thing in my life, even when they are far from me.",
+			"An injury to the unspoiled wilderness of my home is an injury to me.",
+			"I will bring terrible wrath down on the evildoers who destroyed my homeland.",
+			"I suffer awful visions of a coming disaster and will do anything to prevent it.",
+		},
+		Flaws: []string{
+			"I am too enamored of ale, wine, and other intoxicants.",
+			"There's no room for caution in a life lived to the fullest.",
+			"I remember every insult I've received and nurse a silent resentment.",
+			"I am slow to trust members of other races, tribes, and societies.",
+		},
+	},
+	"sage": {
+		ID:                 "sage",
+		Name:               "Sage",
+		Description:        "You spent years learning the lore of the multiverse, scouring manuscripts, studying scrolls and listening to the greatest experts.",
+		SkillProficiencies: backgroundSkills("Arcana", "History"),
+		Languages:          2,
+		StartingEquipment: []BackgroundItem{
+			{Key: "ink-1-ounce-bottle", Name: "Bottle of Black Ink"},
+			{Key: "ink-pen", Name: "Quill"},
+			{Name: "Small Knife"},
+			{Name: "Letter from a Dead Colleague"},
+			{Key: "clothes-common", Name: "Common Clothes"},
+			{Key: "pouch", Name: "Pouch"},
+		},
+		StartingGold: 10,
+		Feature: &Feature{
+			Name:        "Researcher",
+			Description: "When you don't know a piece of lore, you often know where and from whom you can learn it.",
+		},
+		PersonalityTraits: []string{
+			"I use polysyllabic words that convey the impression of great erudition.",
+			"I've read every book in the world's greatest libraries, or I like to boast that I have.",
+			"I'm used to helping out those who aren't as smart as I am, and I patiently explain anything.",
+			"There's nothing I like more than a good mystery.",
+			"I'm willing to listen to every side of an argument before I make my own judgment.",
+			"I am horribly, horribly awkward in social situations.",
+		},
+		Ideals: []string{
+			"Knowledge. The path to power and self-improvement is through knowledge. (Neutral)",
+			"Beauty. What is beautiful points us beyond itself toward what is true. (Good)",
+			"Logic. Emotions must not cloud our logical thinking. (Lawful)",
+			"No Limits. Nothing should fetter the infinite possibility inherent in all existence. (Chaotic)",
+		},
+		Bonds: []string{
+			"It is my duty to protect my students.",
+			"I have an ancient text that holds terrible secrets that must not fall into the wrong hands.",
+			"I work to preserve a library, university, scriptorium, or monastery.",
+			"I've been searching my whole life for the answer to a certain question.",
+		},
+		Flaws: []string{
+			"I am easily distracted by the promise of information.",
+			"Most people scream and run when they see a demon. I stop and take notes on its anatomy.",
+			"Unlocking an ancient mystery is worth the price of a civilization.",
+			"I speak without really thinking through my words, invariably insulting others.",
+		},
+	},
+	"soldier": {
+		ID:                 "soldier",
+		Name:               "Soldier",
+		Description:        "War has been your life for as long as you care to remember. You trained as a youth, studied the use of weapons and armor, and learned basic survival techniques.",
+		SkillProficiencies: backgroundSkills("Athletics", "Intimidation"),
+		ToolProficiencies: []*Proficiency{
+			backgroundTool("dice-set", "Dice Set"),
+			backgroundTool("land-vehicles", "Land Vehicles"),
+		},
+		StartingEquipment: []BackgroundItem{
+			{Name: "Insignia of Rank"},
+			{Name: "Trophy from a Fallen Enemy"},
+			{Key: "dice-set", Name: "Dice Set"},
+			{Key: "clothes-common", Name: "Common Clothes"},
+			{Key: "pouch", Name: "Pouch"},
+		},
+		StartingGold: 10,
+		Feature: &Feature{
+			Name:        "Military Rank",
+			Description: "Soldiers loyal to your former military organization still recognize your authority and influence, and you can requisition simple equipment.",
+		},
+		PersonalityTraits: []string{
+			"I'm always polite and respectful.",
+			"I'm haunted by memories of war. I can't get the images of violence out of my mind.",
+			"I've lost too many friends, and I'm slow to make new ones.",
+			"I'm full of inspiring and cautionary tales from my military experience.",
+			"I can stare down a hell hound without flinching.",
+			"I enjoy being strong and like breaking things.",
+		},
+		Ideals: []string{
+			"Greater Good. Our lot is to lay down our lives in defense of others. (Good)",
+			"Responsibility. I do what I must and obey just authority. (Lawful)",
+			"Independence. When people follow orders blindly, they embrace a kind of tyranny. (Chaotic)",
+			"Might. In life as in war, the stronger force wins. (Evil)",
+		},
+		Bonds: []string{
+			"I would still lay down my life for the people I served with.",
+			"Someone saved my life on the battlefield. To this day, I will never leave a friend behind.",
+			"My honor is my life.",
+			"I fight for those who cannot fight for themselves.",
+		},
+		Flaws: []string{
+			"The monstrous enemy we faced in battle still leaves me quivering with fear.",
+			"I have little respect for anyone who is not a proven warrior.",
+			"I made a terrible mistake in battle that cost many lives, and I would do anything to keep it secret.",
+			"I obey the law, even if the law causes misery.",
+		},
+	},
+}
+
+// backgroundSkills builds skill proficiencies from their names
+func backgroundSkills(names ...string) []*Proficiency {
+	skills := make([]*Proficiency, 0, len(names))
+	for _, name := range names {
+		skills = append(skills, &Proficiency{
+			Key:  "skill-" + strings.ReplaceAll(strings.ToLower(name), " ", "-"),
+			Name: "Skill: " + name,
+			Type: ProficiencyTypeSkill,
+		})
+	}
+	return skills
+}
+
+// backgroundTool builds a tool proficiency
+func backgroundTool(key, name string) *Proficiency {
+	return &Proficiency{Key: key, Name: name, Type: ProficiencyTypeTool}
+}
@@ -0,0 +1,37 @@
+package rulebook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSRDBackgrounds_AreComplete(t *testing.T) {
+	backgrounds := SRDBackgrounds()
+	require.Len(t, backgrounds, 10)
+
+	for _, background := range backgrounds {
+		assert.Equal(t, background, GetSRDBackground(background.ID))
+		assert.Len(t, background.SkillProficiencies, 2, background.ID)
+		assert.NotNil(t, background.Feature, background.ID)
+		assert.NotEmpty(t, background.StartingEquipment, background.ID)
+		assert.Positive(t, background.StartingGold, background.ID)
+		for _, table := range PersonalityTables {
+			assert.GreaterOrEqual(t, len(background.Table(table)), table.Picks(), "%s %s", background.ID, table)
+		}
+	}
+}
+
+func TestGetSRDBackground_Unknown(t *testing.T) {
+	assert.Nil(t, GetSRDBackground("pirate"))
+}
+
+func TestGetLanguage(t *testing.T) {
+	language, ok := GetLanguage("elvish")
+	require.True(t, ok)
+	assert.Equal(t, "Elvish", language.Name)
+
+	_, ok = GetLanguage("klingon")
+	assert.False(t, ok)
+}
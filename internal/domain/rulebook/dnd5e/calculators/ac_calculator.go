@@ -3,9 +3,9 @@ package calculators
 import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
-	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/features"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/effects"
 )
 
 // DnD5eACCalculator implements AC calculation following D&D 5e rules
@@ -87,10 +87,7 @@ func (c *DnD5eACCalculator) Calculate(char *character.Character) int {
 	}
 
 	// Get class features
-	classFeatures := []rulebook.CharacterFeature{}
-	if char.Class != nil {
-		classFeatures = features.GetClassFeatures(char.Class.Key, char.Level)
-	}
+	classFeatures := features.GetCharacterClassFeatures(char)
 
 	// Calculate AC based on armor or unarmored defense
 	var ac int
@@ -106,6 +103,10 @@ func (c *DnD5eACCalculator) Calculate(char *character.Character) int {
 			// No armor and no unarmored defense
 			ac = 10 + dexMod
 		}
+		if features.HasFeature(classFeatures, "draconic_resilience") {
+			// Draconic Resilience doesn't stack with Unarmored Defense
+			ac = max(ac, 13+dexMod)
+		}
 	} else {
 		// Standard AC with armor
 		ac = baseAC + dexMod
@@ -128,6 +129,7 @@ func (c *DnD5eACCalculator) Calculate(char *character.Character) int {
 			ac += effect.GetACBonus()
 		}
 	}
+	ac += char.EffectBonus(effects.TargetAC, nil)
 
 	return ac
 }
@@ -224,6 +224,33 @@ func TestDnD5eACCalculator_Calculate(t *testing.T) {
 			},
 			expected: 15, // 10 + 2 DEX + 3 CON
 		},
+		{
+			name: "unarmored defense from a class taken later",
+			setup: func() *character.Character {
+				fighter := &rulebook.Class{Key: "fighter", Name: "Fighter"}
+				barbarian := &rulebook.Class{Key: "barbarian", Name: "Barbarian"}
+				char := &character.Character{
+					ID:         "test-8b",
+					Level:      6,
+					Attributes: map[shared.Attribute]*character.AbilityScore{},
+					Class:      fighter,
+					Classes: []*character.ClassLevel{
+						{Class: fighter, Level: 5},
+						{Class: barbarian, Level: 1},
+					},
+				}
+				char.Attributes[shared.AttributeDexterity] = &character.AbilityScore{
+					Score: 14,
+					Bonus: 2,
+				}
+				char.Attributes[shared.AttributeConstitution] = &character.AbilityScore{
+					Score: 16,
+					Bonus: 3,
+				}
+				return char
+			},
+			expected: 15, // 10 + 2 DEX + 3 CON
+		},
 		{
 			name: "defense fighting style with armor",
 			setup: func() *character.Character {
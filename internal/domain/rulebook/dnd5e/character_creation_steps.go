@@ -9,6 +9,8 @@ var CharacterCreationSteps = []string{
 	"class_selection",
 	"ability_scores",
 	"ability_assignment",
+	"background_selection",
+	"personality_selection",
 	"skill_selection",
 	"language_selection",
 	"proficiency_selection",
@@ -12,6 +12,7 @@ type DivineDomain struct {
 // DomainFeature represents a feature granted by a divine domain
 type DomainFeature struct {
 	Level       int    `json:"level"`
+	Key         string `json:"key"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
 }
@@ -30,6 +31,13 @@ func GetDivineDomains() []DivineDomain {
 				7: {"arcane-eye", "confusion"},
 				9: {"legend-lore", "scrying"},
 			},
+			Features: []DomainFeature{
+				{Level: 1, Key: "blessings_of_knowledge", Name: "Blessings of Knowledge", Description: "You learn two languages and gain proficiency with two of Arcana, History, Nature or Religion. Your proficiency bonus is doubled for them."},
+				{Level: 2, Key: "knowledge_of_the_ages", Name: "Channel Divinity: Knowledge of the Ages", Description: "You can use your Channel Divinity to gain proficiency with a skill or tool for 10 minutes."},
+				{Level: 6, Key: "read_thoughts", Name: "Channel Divinity: Read Thoughts", Description: "You can use your Channel Divinity to read a creature's surface thoughts and cast suggestion on it without a spell slot."},
+				{Level: 8, Key: "knowledge_potent_spellcasting", Name: "Potent Spellcasting", Description: "You add your Wisdom modifier to the damage you deal with any cleric cantrip."},
+				{Level: 17, Key: "visions_of_the_past", Name: "Visions of the Past", Description: "You can meditate to receive visions of an object's owners or of events that took place around you."},
+			},
 		},
 		{
 			Key:         "life",
@@ -42,6 +50,14 @@ func GetDivineDomains() []DivineDomain {
 				7: {"death-ward", "guardian-of-faith"},
 				9: {"mass-cure-wounds", "raise-dead"},
 			},
+			Features: []DomainFeature{
+				{Level: 1, Key: "life_bonus_proficiency", Name: "Bonus Proficiency", Description: "You gain proficiency with heavy armor."},
+				{Level: 1, Key: "disciple_of_life", Name: "Disciple of Life", Description: "Your healing spells of 1st level or higher restore an additional 2 + the spell's level hit points."},
+				{Level: 2, Key: "preserve_life", Name: "Channel Divinity: Preserve Life", Description: "You can use your Channel Divinity to restore hit points equal to five times your cleric level, divided among creatures within 30 feet."},
+				{Level: 6, Key: "blessed_healer", Name: "Blessed Healer", Description: "When you cast a healing spell of 1st level or higher on another creature, you regain 2 + the spell's level hit points."},
+				{Level: 8, Key: "life_divine_strike", Name: "Divine Strike", Description: "Once on each of your turns, your weapon attacks deal an extra 1d8 radiant damage, increasing to 2d8 at 14th level."},
+				{Level: 17, Key: "supreme_healing", Name: "Supreme Healing", Description: "When you would roll dice to restore hit points with a spell, you use the highest number possible for each die instead."},
+			},
 		},
 		{
 			Key:         "light",
@@ -54,6 +70,14 @@ func GetDivineDomains() []DivineDomain {
 				7: {"guardian-of-faith", "wall-of-fire"},
 				9: {"flame-strike", "scrying"},
 			},
+			Features: []DomainFeature{
+				{Level: 1, Key: "light_bonus_cantrip", Name: "Bonus Cantrip", Description: "You learn the light cantrip if you don't already know it."},
+				{Level: 1, Key: "warding_flare", Name: "Warding Flare", Description: "When a creature you can see within 30 feet attacks you, you can use your reaction to impose disadvantage on the attack roll."},
+				{Level: 2, Key: "radiance_of_the_dawn", Name: "Channel Divinity: Radiance of the Dawn", Description: "You can use your Channel Divinity to dispel magical darkness and deal 2d10 + cleric level radiant damage to hostile creatures within 30 feet."},
+				{Level: 6, Key: "improved_flare", Name: "Improved Flare", Description: "You can use Warding Flare when a creature attacks another creature within 30 feet of you."},
+				{Level: 8, Key: "light_potent_spellcasting", Name: "Potent Spellcasting", Description: "You add your Wisdom modifier to the damage you deal with any cleric cantrip."},
+				{Level: 17, Key: "corona_of_light", Name: "Corona of Light", Description: "You can emanate an aura of sunlight that gives enemies disadvantage on saves against fire and radiant spells."},
+			},
 		},
 		{
 			Key:         "nature",
@@ -66,6 +90,14 @@ func GetDivineDomains() []DivineDomain {
 				7: {"dominate-beast", "grasping-vine"},
 				9: {"insect-plague", "tree-stride"},
 			},
+			Features: []DomainFeature{
+				{Level: 1, Key: "acolyte_of_nature", Name: "Acolyte of Nature", Description: "You learn one druid cantrip and gain proficiency with Animal Handling, Nature or Survival."},
+				{Level: 1, Key: "nature_bonus_proficiency", Name: "Bonus Proficiency", Description: "You gain proficiency with heavy armor."},
+				{Level: 2, Key: "charm_animals_and_plants", Name: "Channel Divinity: Charm Animals and Plants", Description: "You can use your Channel Divinity to charm beasts and plant creatures within 30 feet for 1 minute."},
+				{Level: 6, Key: "dampen_elements", Name: "Dampen Elements", Description: "When you or a creature within 30 feet takes acid, cold, fire, lightning or thunder damage, you can use your reaction to grant resistance to it."},
+				{Level: 8, Key: "nature_divine_strike", Name: "Divine Strike", Description: "Once on each of your turns, your weapon attacks deal an extra 1d8 cold, fire or lightning damage, increasing to 2d8 at 14th level."},
+				{Level: 17, Key: "master_of_nature", Name: "Master of Nature", Description: "You can command animals and plants you have charmed as a bonus action."},
+			},
 		},
 		{
 			Key:         "tempest",
@@ -78,6 +110,14 @@ func GetDivineDomains() []DivineDomain {
 				7: {"control-water", "ice-storm"},
 				9: {"destructive-wave", "insect-plague"},
 			},
+			Features: []DomainFeature{
+				{Level: 1, Key: "tempest_bonus_proficiencies", Name: "Bonus Proficiencies", Description: "You gain proficiency with martial weapons and heavy armor."},
+				{Level: 1, Key: "wrath_of_the_storm", Name: "Wrath of the Storm", Description: "When a creature within 5 feet hits you, you can use your reaction to deal 2d8 lightning or thunder damage to it (Dexterity save for half)."},
+				{Level: 2, Key: "destructive_wrath", Name: "Channel Divinity: Destructive Wrath", Description: "When you roll lightning or thunder damage, you can use your Channel Divinity to deal maximum damage instead of rolling."},
+				{Level: 6, Key: "thunderbolt_strike", Name: "Thunderbolt Strike", Description: "When you deal lightning damage to a Large or smaller creature, you can push it up to 10 feet away."},
+				{Level: 8, Key: "tempest_divine_strike", Name: "Divine Strike", Description: "Once on each of your turns, your weapon attacks deal an extra 1d8 thunder damage, increasing to 2d8 at 14th level."},
+				{Level: 17, Key: "stormborn", Name: "Stormborn", Description: "You have a flying speed equal to your walking speed when you are outdoors and not underground."},
+			},
 		},
 		{
 			Key:         "trickery",
@@ -90,6 +130,13 @@ func GetDivineDomains() []DivineDomain {
 				7: {"dimension-door", "polymorph"},
 				9: {"dominate-person", "modify-memory"},
 			},
+			Features: []DomainFeature{
+				{Level: 1, Key: "blessing_of_the_trickster", Name: "Blessing of the Trickster", Description: "You can use your action to give a willing creature advantage on Dexterity (Stealth) checks for 1 hour."},
+				{Level: 2, Key: "invoke_duplicity", Name: "Channel Divinity: Invoke Duplicity", Description: "You can use your Channel Divinity to create an illusory duplicate of yourself. You can cast spells from its space and gain advantage on attacks against creatures near it."},
+				{Level: 6, Key: "cloak_of_shadows_trickery", Name: "Channel Divinity: Cloak of Shadows", Description: "You can use your Channel Divinity to become invisible until the end of your next turn."},
+				{Level: 8, Key: "trickery_divine_strike", Name: "Divine Strike", Description: "Once on each of your turns, your weapon attacks deal an extra 1d8 poison damage, increasing to 2d8 at 14th level."},
+				{Level: 17, Key: "improved_duplicity", Name: "Improved Duplicity", Description: "You can create up to four duplicates of yourself when you use Invoke Duplicity."},
+			},
 		},
 		{
 			Key:         "war",
@@ -102,6 +149,14 @@ func GetDivineDomains() []DivineDomain {
 				7: {"freedom-of-movement", "stoneskin"},
 				9: {"flame-strike", "hold-monster"},
 			},
+			Features: []DomainFeature{
+				{Level: 1, Key: "war_bonus_proficiencies", Name: "Bonus Proficiencies", Description: "You gain proficiency with martial weapons and heavy armor."},
+				{Level: 1, Key: "war_priest", Name: "War Priest", Description: "When you use the Attack action, you can make one weapon attack as a bonus action a number of times equal to your Wisdom modifier per long rest."},
+				{Level: 2, Key: "guided_strike", Name: "Channel Divinity: Guided Strike", Description: "When you make an attack roll, you can use your Channel Divinity to gain a +10 bonus to the roll."},
+				{Level: 6, Key: "war_gods_blessing", Name: "Channel Divinity: War God's Blessing", Description: "When a creature within 30 feet makes an attack roll, you can use your reaction and Channel Divinity to grant it a +10 bonus."},
+				{Level: 8, Key: "war_divine_strike", Name: "Divine Strike", Description: "Once on each of your turns, your weapon attacks deal an extra 1d8 damage of the weapon's type, increasing to 2d8 at 14th level."},
+				{Level: 17, Key: "avatar_of_battle", Name: "Avatar of Battle", Description: "You have resistance to bludgeoning, piercing and slashing damage from nonmagical weapons."},
+			},
 		},
 	}
 }
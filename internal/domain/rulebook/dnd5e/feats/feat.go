@@ -30,6 +30,12 @@ type Feat interface {
 	RegisterHandlers(bus *rpgevents.Bus, char *character.Character)
 }
 
+// LongRestRecoverer is implemented by feats whose uses recover on a long rest
+type LongRestRecoverer interface {
+	// RecoverOnLongRest restores the feat's uses for the character
+	RecoverOnLongRest(char *character.Character)
+}
+
 // Prerequisite represents a requirement for taking a feat
 type Prerequisite struct {
 	Type        string // "ability_score", "proficiency", "spellcasting", "level"
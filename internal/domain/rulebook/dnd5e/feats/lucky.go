@@ -183,23 +183,26 @@ func (f *LuckyFeat) RegisterHandlers(bus *rpgevents.Bus, char *character.Charact
 			return nil
 		}
 
-		// Restore luck points
-		f.luckPoints[actor.ID] = 3
+		f.RecoverOnLongRest(actor)
+		return nil
+	})
+}
 
-		// Update character feature metadata
-		for i, feature := range actor.Features {
-			if feature.Key == "lucky" && feature.Type == "feat" {
-				if feature.Metadata == nil {
-					feature.Metadata = make(map[string]any)
-				}
-				feature.Metadata["luck_points"] = 3
-				actor.Features[i] = feature
-				break
+// RecoverOnLongRest restores all of the character's luck points
+func (f *LuckyFeat) RecoverOnLongRest(char *character.Character) {
+	f.luckPoints[char.ID] = 3
+
+	// Update character feature metadata
+	for i, feature := range char.Features {
+		if feature.Key == "lucky" && feature.Type == "feat" {
+			if feature.Metadata == nil {
+				feature.Metadata = make(map[string]any)
 			}
+			feature.Metadata["luck_points"] = 3
+			char.Features[i] = feature
+			break
 		}
+	}
 
-		log.Printf("[LUCKY] %s restores all luck points on long rest", actor.Name)
-
-		return nil
-	})
+	log.Printf("[LUCKY] %s restores all luck points on long rest", char.Name)
 }
@@ -137,6 +137,23 @@ func (r *Registry) RegisterAllHandlers(char *character.Character, eventBus *rpge
 	}
 }
 
+// LongRest restores the uses of a character's feats that recover on a long
+// rest. Unlike the feats' event handlers, it doesn't need the character to
+// have joined an encounter first.
+func (r *Registry) LongRest(char *character.Character) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, feature := range char.Features {
+		if feature.Type != "feat" {
+			continue
+		}
+		if recoverer, ok := r.feats[feature.Key].(LongRestRecoverer); ok {
+			recoverer.RecoverOnLongRest(char)
+		}
+	}
+}
+
 // RegisterAll registers all standard D&D 5e feats
 func RegisterAll() {
 	// Combat feats
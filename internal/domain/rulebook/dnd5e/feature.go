@@ -4,10 +4,12 @@ package rulebook
 type FeatureType string
 
 const (
-	FeatureTypeRacial  FeatureType = "racial"
-	FeatureTypeClass   FeatureType = "class"
-	FeatureTypeSubrace FeatureType = "subrace"
-	FeatureTypeFeat    FeatureType = "feat"
+	FeatureTypeRacial     FeatureType = "racial"
+	FeatureTypeClass      FeatureType = "class"
+	FeatureTypeSubrace    FeatureType = "subrace"
+	FeatureTypeFeat       FeatureType = "feat"
+	FeatureTypeBackground FeatureType = "background"
+	FeatureTypeSubclass   FeatureType = "subclass"
 )
 
 // CharacterFeature represents a character feature (trait, ability, etc)
@@ -5,8 +5,20 @@ import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/effects"
 )
 
+// GetCharacterClassFeatures returns the class and subclass features of each
+// of the character's classes at its level in that class
+func GetCharacterClassFeatures(char *character.Character) []rulebook.CharacterFeature {
+	classFeatures := []rulebook.CharacterFeature{}
+	for _, classLevel := range char.ClassLevels() {
+		classFeatures = append(classFeatures, GetClassFeatures(classLevel.Class.Key, classLevel.Level)...)
+		classFeatures = append(classFeatures, GetSubclassFeatures(classLevel.Class.Key, char.SubclassKeyFor(classLevel.Class.Key), classLevel.Level)...)
+	}
+	return classFeatures
+}
+
 // CalculateAC calculates AC based on class features, armor, and abilities
 func CalculateAC(char *character.Character) int {
 	if char == nil {
@@ -101,10 +113,7 @@ func CalculateAC(char *character.Character) int {
 	}
 
 	// Get class features
-	classFeatures := []rulebook.CharacterFeature{}
-	if char.Class != nil {
-		classFeatures = GetClassFeatures(char.Class.Key, char.Level)
-	}
+	classFeatures := GetCharacterClassFeatures(char)
 
 	// Calculate AC based on armor or unarmored defense
 	var ac int
@@ -120,6 +129,10 @@ func CalculateAC(char *character.Character) int {
 			// No armor and no unarmored defense
 			ac = 10 + dexMod
 		}
+		if HasFeature(classFeatures, "draconic_resilience") {
+			// Draconic Resilience doesn't stack with Unarmored Defense
+			ac = max(ac, 13+dexMod)
+		}
 	} else {
 		// Standard AC with armor
 		ac = baseAC + dexMod
@@ -140,6 +153,9 @@ func CalculateAC(char *character.Character) int {
 		ac += 2
 	}
 
+	// Apply AC bonuses from active effects, such as magic items
+	ac += char.EffectBonus(effects.TargetAC, nil)
+
 	return ac
 }
 
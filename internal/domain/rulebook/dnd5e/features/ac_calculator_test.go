@@ -3,6 +3,7 @@ package features_test
 import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/features"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	"testing"
@@ -135,3 +136,26 @@ func TestCalculateAC_ChainMailIgnoresDex(t *testing.T) {
 	ac := features.CalculateAC(char)
 	assert.Equal(t, 16, ac, "AC with chain mail should be 16 (ignoring DEX)")
 }
+
+func TestCalculateAC_DraconicResilience(t *testing.T) {
+	char := &character.Character{
+		Level: 1,
+		Class: &rulebook.Class{Key: "sorcerer", Name: "Sorcerer", HitDie: 6},
+		Attributes: map[shared.Attribute]*character.AbilityScore{
+			shared.AttributeDexterity: {Score: 14, Bonus: 2},
+		},
+		Features: []*rulebook.CharacterFeature{{
+			Key:      "subclass",
+			Metadata: map[string]any{"subclass": "draconic", "class": "sorcerer"},
+		}},
+		EquippedSlots: make(map[shared.Slot]equipment.Equipment),
+	}
+	assert.Equal(t, 15, features.CalculateAC(char), "13 + 2 DEX without armor")
+
+	char.EquippedSlots[shared.SlotBody] = &equipment.Armor{
+		Base:          equipment.BasicEquipment{Key: "leather-armor", Name: "Leather Armor"},
+		ArmorCategory: equipment.ArmorCategoryLight,
+		ArmorClass:    &equipment.ArmorClass{Base: 11, DexBonus: true},
+	}
+	assert.Equal(t, 13, features.CalculateAC(char), "armor replaces Draconic Resilience")
+}
@@ -0,0 +1,674 @@
+package features
+
+import (
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+)
+
+// ClassProgression holds the features each class gains from level 2 to 20.
+// Ability Score Improvements and subclass features are handled separately.
+var ClassProgression = map[string][]rulebook.CharacterFeature{
+	"barbarian": {
+		{
+			Key:         "reckless_attack",
+			Name:        "Reckless Attack",
+			Description: "When you make your first attack on your turn, you can attack recklessly: you have advantage on Strength melee attacks this turn, but attacks against you have advantage until your next turn.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Barbarian",
+		},
+		{
+			Key:         "danger_sense",
+			Name:        "Danger Sense",
+			Description: "You have advantage on Dexterity saving throws against effects you can see, such as traps and spells, while not blinded, deafened, or incapacitated.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Barbarian",
+		},
+		{
+			Key:         "extra_attack",
+			Name:        "Extra Attack",
+			Description: "You can attack twice, instead of once, whenever you take the Attack action on your turn.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       5,
+			Source:      "Barbarian",
+		},
+		{
+			Key:         "fast_movement",
+			Name:        "Fast Movement",
+			Description: "Your speed increases by 10 feet while you aren't wearing heavy armor.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       5,
+			Source:      "Barbarian",
+		},
+		{
+			Key:         "feral_instinct",
+			Name:        "Feral Instinct",
+			Description: "You have advantage on initiative rolls, and you can act normally on a surprise round if you enter your rage first.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       7,
+			Source:      "Barbarian",
+		},
+		{
+			Key:         "brutal_critical",
+			Name:        "Brutal Critical",
+			Description: "You roll one additional weapon damage die when determining the extra damage for a critical hit with a melee attack. This increases to two dice at 13th level and three dice at 17th level.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       9,
+			Source:      "Barbarian",
+		},
+		{
+			Key:         "relentless_rage",
+			Name:        "Relentless Rage",
+			Description: "If you drop to 0 hit points while raging and don't die outright, you can make a DC 10 Constitution saving throw to drop to 1 hit point instead. The DC increases by 5 each time you use it until you finish a rest.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       11,
+			Source:      "Barbarian",
+		},
+		{
+			Key:         "persistent_rage",
+			Name:        "Persistent Rage",
+			Description: "Your rage is so fierce that it ends early only if you fall unconscious or if you choose to end it.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       15,
+			Source:      "Barbarian",
+		},
+		{
+			Key:         "indomitable_might",
+			Name:        "Indomitable Might",
+			Description: "If your total for a Strength check is less than your Strength score, you can use that score in place of the total.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       18,
+			Source:      "Barbarian",
+		},
+		{
+			Key:         "primal_champion",
+			Name:        "Primal Champion",
+			Description: "Your Strength and Constitution scores increase by 4. Your maximum for those scores is now 24.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       20,
+			Source:      "Barbarian",
+		},
+	},
+	"bard": {
+		{
+			Key:         "jack_of_all_trades",
+			Name:        "Jack of All Trades",
+			Description: "You can add half your proficiency bonus, rounded down, to any ability check you make that doesn't already include your proficiency bonus.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Bard",
+		},
+		{
+			Key:         "song_of_rest",
+			Name:        "Song of Rest",
+			Description: "If you or friendly creatures who can hear your performance regain hit points by spending Hit Dice during a short rest, each of those creatures regains an extra 1d6 hit points. The die grows at higher levels.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Bard",
+		},
+		{
+			Key:         "bard_expertise",
+			Name:        "Expertise",
+			Description: "Choose two of your skill proficiencies. Your proficiency bonus is doubled for any ability check you make that uses either of them. You choose two more at 10th level.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       3,
+			Source:      "Bard",
+		},
+		{
+			Key:         "font_of_inspiration",
+			Name:        "Font of Inspiration",
+			Description: "You regain all of your expended uses of Bardic Inspiration when you finish a short or long rest. Your Bardic Inspiration die becomes a d8.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       5,
+			Source:      "Bard",
+		},
+		{
+			Key:         "countercharm",
+			Name:        "Countercharm",
+			Description: "As an action, you can start a performance that lasts until the end of your next turn. Friendly creatures within 30 feet have advantage on saving throws against being frightened or charmed.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       6,
+			Source:      "Bard",
+		},
+		{
+			Key:         "magical_secrets",
+			Name:        "Magical Secrets",
+			Description: "Choose two spells from any class. They count as bard spells for you. You learn two more at 14th and 18th level.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       10,
+			Source:      "Bard",
+		},
+		{
+			Key:         "superior_inspiration",
+			Name:        "Superior Inspiration",
+			Description: "When you roll initiative and have no uses of Bardic Inspiration left, you regain one use.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       20,
+			Source:      "Bard",
+		},
+	},
+	"cleric": {
+		{
+			Key:         "channel_divinity",
+			Name:        "Channel Divinity",
+			Description: "You gain the ability to channel divine energy directly from your deity. You can use it once between rests, twice from 6th level and three times from 18th level.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Cleric",
+		},
+		{
+			Key:         "turn_undead",
+			Name:        "Turn Undead",
+			Description: "As an action, you present your holy symbol. Each undead that can see or hear you within 30 feet must make a Wisdom saving throw or be turned for 1 minute.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Cleric",
+		},
+		{
+			Key:         "destroy_undead",
+			Name:        "Destroy Undead",
+			Description: "When an undead fails its saving throw against your Turn Undead, it is instantly destroyed if its challenge rating is low enough. The threshold rises as you gain levels.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       5,
+			Source:      "Cleric",
+		},
+		{
+			Key:         "divine_intervention",
+			Name:        "Divine Intervention",
+			Description: "You can call on your deity to intervene on your behalf. If you roll a d100 equal to or lower than your cleric level, your deity intervenes. At 20th level it succeeds automatically.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       10,
+			Source:      "Cleric",
+		},
+	},
+	"druid": {
+		{
+			Key:         "wild_shape",
+			Name:        "Wild Shape",
+			Description: "As an action, you can magically assume the shape of a beast that you have seen before. You can use this feature twice between short or long rests.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Druid",
+		},
+		{
+			Key:         "timeless_body_druid",
+			Name:        "Timeless Body",
+			Description: "The primal magic that you wield causes you to age more slowly. For every 10 years that pass, your body ages only 1 year.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       18,
+			Source:      "Druid",
+		},
+		{
+			Key:         "beast_spells",
+			Name:        "Beast Spells",
+			Description: "You can cast many of your druid spells in any shape you assume using Wild Shape.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       18,
+			Source:      "Druid",
+		},
+		{
+			Key:         "archdruid",
+			Name:        "Archdruid",
+			Description: "You can use your Wild Shape an unlimited number of times, and you ignore the verbal and somatic components of your druid spells.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       20,
+			Source:      "Druid",
+		},
+	},
+	"fighter": {
+		{
+			Key:         "action_surge",
+			Name:        "Action Surge",
+			Description: "On your turn, you can take one additional action. Once you use this feature, you must finish a short or long rest before you can use it again. You can use it twice between rests from 17th level.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Fighter",
+		},
+		{
+			Key:         "extra_attack",
+			Name:        "Extra Attack",
+			Description: "You can attack twice, instead of once, whenever you take the Attack action on your turn.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       5,
+			Source:      "Fighter",
+		},
+		{
+			Key:         "indomitable",
+			Name:        "Indomitable",
+			Description: "You can reroll a saving throw that you fail. You must use the new roll. You can use this feature once between long rests, twice from 13th level and three times from 17th level.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       9,
+			Source:      "Fighter",
+		},
+		{
+			Key:         "extra_attack_2",
+			Name:        "Extra Attack (2)",
+			Description: "You can attack three times whenever you take the Attack action on your turn.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       11,
+			Source:      "Fighter",
+		},
+		{
+			Key:         "extra_attack_3",
+			Name:        "Extra Attack (3)",
+			Description: "You can attack four times whenever you take the Attack action on your turn.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       20,
+			Source:      "Fighter",
+		},
+	},
+	"monk": {
+		{
+			Key:         "ki",
+			Name:        "Ki",
+			Description: "You can harness the mystic energy of ki. You have a number of ki points equal to your monk level, spent on Flurry of Blows, Patient Defense, and Step of the Wind. Ki returns on a short or long rest.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Monk",
+		},
+		{
+			Key:         "unarmored_movement",
+			Name:        "Unarmored Movement",
+			Description: "Your speed increases by 10 feet while you are not wearing armor or wielding a shield. This bonus increases as you gain monk levels.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Monk",
+		},
+		{
+			Key:         "deflect_missiles",
+			Name:        "Deflect Missiles",
+			Description: "You can use your reaction to deflect or catch a missile when you are hit by a ranged weapon attack, reducing the damage by 1d10 + your Dexterity modifier + your monk level.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       3,
+			Source:      "Monk",
+		},
+		{
+			Key:         "slow_fall",
+			Name:        "Slow Fall",
+			Description: "You can use your reaction when you fall to reduce any falling damage you take by an amount equal to five times your monk level.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       4,
+			Source:      "Monk",
+		},
+		{
+			Key:         "extra_attack",
+			Name:        "Extra Attack",
+			Description: "You can attack twice, instead of once, whenever you take the Attack action on your turn.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       5,
+			Source:      "Monk",
+		},
+		{
+			Key:         "stunning_strike",
+			Name:        "Stunning Strike",
+			Description: "When you hit another creature with a melee weapon attack, you can spend 1 ki point to attempt a stunning strike. The target must succeed on a Constitution saving throw or be stunned until the end of your next turn.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       5,
+			Source:      "Monk",
+		},
+		{
+			Key:         "ki_empowered_strikes",
+			Name:        "Ki-Empowered Strikes",
+			Description: "Your unarmed strikes count as magical for the purpose of overcoming resistance and immunity to nonmagical attacks and damage.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       6,
+			Source:      "Monk",
+		},
+		{
+			Key:         "evasion",
+			Name:        "Evasion",
+			Description: "When you are subjected to an effect that allows a Dexterity saving throw to take only half damage, you take no damage on a success and half damage on a failure.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       7,
+			Source:      "Monk",
+		},
+		{
+			Key:         "stillness_of_mind",
+			Name:        "Stillness of Mind",
+			Description: "You can use your action to end one effect on yourself that is causing you to be charmed or frightened.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       7,
+			Source:      "Monk",
+		},
+		{
+			Key:         "purity_of_body",
+			Name:        "Purity of Body",
+			Description: "Your mastery of the ki flowing through you makes you immune to disease and poison.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       10,
+			Source:      "Monk",
+		},
+		{
+			Key:         "tongue_of_the_sun_and_moon",
+			Name:        "Tongue of the Sun and Moon",
+			Description: "You understand all spoken languages, and any creature that can understand a language can understand what you say.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       13,
+			Source:      "Monk",
+		},
+		{
+			Key:         "diamond_soul",
+			Name:        "Diamond Soul",
+			Description: "You gain proficiency in all saving throws. You can spend 1 ki point to reroll a saving throw that you fail.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       14,
+			Source:      "Monk",
+		},
+		{
+			Key:         "timeless_body",
+			Name:        "Timeless Body",
+			Description: "You suffer none of the frailty of old age, and you can't be aged magically. You no longer need food or water.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       15,
+			Source:      "Monk",
+		},
+		{
+			Key:         "empty_body",
+			Name:        "Empty Body",
+			Description: "You can spend 4 ki points to become invisible for 1 minute and gain resistance to all damage but force damage.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       18,
+			Source:      "Monk",
+		},
+		{
+			Key:         "perfect_self",
+			Name:        "Perfect Self",
+			Description: "When you roll for initiative and have no ki points remaining, you regain 4 ki points.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       20,
+			Source:      "Monk",
+		},
+	},
+	"paladin": {
+		{
+			Key:         "fighting_style",
+			Name:        "Fighting Style",
+			Description: "You adopt a particular style of fighting as your specialty.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Paladin",
+		},
+		{
+			Key:         "spellcasting_paladin",
+			Name:        "Spellcasting",
+			Description: "You have learned to draw on divine magic through meditation and prayer to cast spells as a cleric does.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Paladin",
+		},
+		{
+			Key:         "divine_smite",
+			Name:        "Divine Smite",
+			Description: "When you hit a creature with a melee weapon attack, you can expend one spell slot to deal radiant damage to the target, in addition to the weapon's damage: 2d8 for a 1st-level slot, plus 1d8 for each slot level higher.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Paladin",
+		},
+		{
+			Key:         "divine_health",
+			Name:        "Divine Health",
+			Description: "The divine magic flowing through you makes you immune to disease.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       3,
+			Source:      "Paladin",
+		},
+		{
+			Key:         "extra_attack",
+			Name:        "Extra Attack",
+			Description: "You can attack twice, instead of once, whenever you take the Attack action on your turn.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       5,
+			Source:      "Paladin",
+		},
+		{
+			Key:         "aura_of_protection",
+			Name:        "Aura of Protection",
+			Description: "Whenever you or a friendly creature within 10 feet of you must make a saving throw, the creature gains a bonus to the saving throw equal to your Charisma modifier (minimum +1).",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       6,
+			Source:      "Paladin",
+		},
+		{
+			Key:         "aura_of_courage",
+			Name:        "Aura of Courage",
+			Description: "You and friendly creatures within 10 feet of you can't be frightened while you are conscious.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       10,
+			Source:      "Paladin",
+		},
+		{
+			Key:         "improved_divine_smite",
+			Name:        "Improved Divine Smite",
+			Description: "Whenever you hit a creature with a melee weapon, the creature takes an extra 1d8 radiant damage.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       11,
+			Source:      "Paladin",
+		},
+		{
+			Key:         "cleansing_touch",
+			Name:        "Cleansing Touch",
+			Description: "You can use your action to end one spell on yourself or on one willing creature that you touch. You can use this a number of times equal to your Charisma modifier between long rests.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       14,
+			Source:      "Paladin",
+		},
+	},
+	"ranger": {
+		{
+			Key:         "fighting_style",
+			Name:        "Fighting Style",
+			Description: "You adopt a particular style of fighting as your specialty.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Ranger",
+		},
+		{
+			Key:         "spellcasting_ranger",
+			Name:        "Spellcasting",
+			Description: "You have learned to use the magical essence of nature to cast spells, much as a druid does.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Ranger",
+		},
+		{
+			Key:         "primeval_awareness",
+			Name:        "Primeval Awareness",
+			Description: "You can expend one ranger spell slot to sense whether certain creature types are present within 1 mile of you (or 6 miles in your favored terrain).",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       3,
+			Source:      "Ranger",
+		},
+		{
+			Key:         "extra_attack",
+			Name:        "Extra Attack",
+			Description: "You can attack twice, instead of once, whenever you take the Attack action on your turn.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       5,
+			Source:      "Ranger",
+		},
+		{
+			Key:         "lands_stride",
+			Name:        "Land's Stride",
+			Description: "Moving through nonmagical difficult terrain costs you no extra movement, and you have advantage on saving throws against magically created plants.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       8,
+			Source:      "Ranger",
+		},
+		{
+			Key:         "hide_in_plain_sight",
+			Name:        "Hide in Plain Sight",
+			Description: "You can spend 1 minute creating camouflage for yourself, gaining a +10 bonus to Stealth checks while you remain still.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       10,
+			Source:      "Ranger",
+		},
+		{
+			Key:         "vanish",
+			Name:        "Vanish",
+			Description: "You can use the Hide action as a bonus action, and you can't be tracked by nonmagical means unless you choose to leave a trail.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       14,
+			Source:      "Ranger",
+		},
+		{
+			Key:         "feral_senses",
+			Name:        "Feral Senses",
+			Description: "You gain preternatural senses. You don't have disadvantage when attacking creatures you can't see, and you are aware of invisible creatures within 30 feet.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       18,
+			Source:      "Ranger",
+		},
+		{
+			Key:         "foe_slayer",
+			Name:        "Foe Slayer",
+			Description: "Once on each of your turns, you can add your Wisdom modifier to the attack roll or the damage roll of an attack you make against one of your favored enemies.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       20,
+			Source:      "Ranger",
+		},
+	},
+	"rogue": {
+		{
+			Key:         "cunning_action",
+			Name:        "Cunning Action",
+			Description: "You can take a bonus action on each of your turns to take the Dash, Disengage, or Hide action.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Rogue",
+		},
+		{
+			Key:         "uncanny_dodge",
+			Name:        "Uncanny Dodge",
+			Description: "When an attacker that you can see hits you with an attack, you can use your reaction to halve the attack's damage against you.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       5,
+			Source:      "Rogue",
+		},
+		{
+			Key:         "evasion",
+			Name:        "Evasion",
+			Description: "When you are subjected to an effect that allows a Dexterity saving throw to take only half damage, you take no damage on a success and half damage on a failure.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       7,
+			Source:      "Rogue",
+		},
+		{
+			Key:         "reliable_talent",
+			Name:        "Reliable Talent",
+			Description: "Whenever you make an ability check that lets you add your proficiency bonus, you can treat a d20 roll of 9 or lower as a 10.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       11,
+			Source:      "Rogue",
+		},
+		{
+			Key:         "blindsense",
+			Name:        "Blindsense",
+			Description: "If you are able to hear, you are aware of the location of any hidden or invisible creature within 10 feet of you.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       14,
+			Source:      "Rogue",
+		},
+		{
+			Key:         "slippery_mind",
+			Name:        "Slippery Mind",
+			Description: "You gain proficiency in Wisdom saving throws.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       15,
+			Source:      "Rogue",
+		},
+		{
+			Key:         "elusive",
+			Name:        "Elusive",
+			Description: "No attack roll has advantage against you while you aren't incapacitated.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       18,
+			Source:      "Rogue",
+		},
+		{
+			Key:         "stroke_of_luck",
+			Name:        "Stroke of Luck",
+			Description: "If your attack misses a target within range, you can turn the miss into a hit, or treat a failed ability check as a 20. Once used, you must finish a rest to use it again.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       20,
+			Source:      "Rogue",
+		},
+	},
+	"sorcerer": {
+		{
+			Key:         "font_of_magic",
+			Name:        "Font of Magic",
+			Description: "You tap into a deep wellspring of magic, represented by sorcery points equal to your sorcerer level. You can convert sorcery points into spell slots and back.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Sorcerer",
+		},
+		{
+			Key:         "metamagic",
+			Name:        "Metamagic",
+			Description: "You gain the ability to twist your spells to suit your needs. Choose two Metamagic options, and one more at 10th and 17th level.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       3,
+			Source:      "Sorcerer",
+		},
+		{
+			Key:         "sorcerous_restoration",
+			Name:        "Sorcerous Restoration",
+			Description: "You regain 4 expended sorcery points whenever you finish a short rest.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       20,
+			Source:      "Sorcerer",
+		},
+	},
+	"warlock": {
+		{
+			Key:         "eldritch_invocations",
+			Name:        "Eldritch Invocations",
+			Description: "In your study of occult lore, you have unearthed fragments of forbidden knowledge that imbue you with an abiding magical ability. You learn more invocations as you gain levels.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       2,
+			Source:      "Warlock",
+		},
+		{
+			Key:         "pact_boon",
+			Name:        "Pact Boon",
+			Description: "Your otherworldly patron bestows a gift upon you for your loyal service: Pact of the Chain, Pact of the Blade, or Pact of the Tome.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       3,
+			Source:      "Warlock",
+		},
+		{
+			Key:         "mystic_arcanum",
+			Name:        "Mystic Arcanum",
+			Description: "Your patron bestows upon you a magical secret called an arcanum. Choose one 6th-level warlock spell that you can cast once without expending a spell slot. You gain higher-level arcana at 13th, 15th, and 17th level.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       11,
+			Source:      "Warlock",
+		},
+		{
+			Key:         "eldritch_master",
+			Name:        "Eldritch Master",
+			Description: "You can spend 1 minute entreating your patron to regain all your expended spell slots from Pact Magic. Once used, you must finish a long rest to use it again.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       20,
+			Source:      "Warlock",
+		},
+	},
+	"wizard": {
+		{
+			Key:         "spell_mastery",
+			Name:        "Spell Mastery",
+			Description: "Choose a 1st-level and a 2nd-level wizard spell in your spellbook. You can cast those spells at their lowest level without expending a spell slot.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       18,
+			Source:      "Wizard",
+		},
+		{
+			Key:         "signature_spells",
+			Name:        "Signature Spells",
+			Description: "Choose two 3rd-level wizard spells in your spellbook as your signature spells. You can cast each once at 3rd level without expending a spell slot between short rests.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       20,
+			Source:      "Wizard",
+		},
+	},
+}
@@ -158,6 +158,78 @@ var ClassFeatures = map[string][]rulebook.CharacterFeature{
 			Source:      "Paladin",
 		},
 	},
+	"bard": {
+		{
+			Key:         "spellcasting_bard",
+			Name:        "Spellcasting",
+			Description: "You have learned to untangle and reshape the fabric of reality in harmony with your wishes and music. Charisma is your spellcasting ability for bard spells.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       1,
+			Source:      "Bard",
+		},
+		{
+			Key:         "bardic_inspiration",
+			Name:        "Bardic Inspiration",
+			Description: "You can inspire others through stirring words or music. As a bonus action, a creature within 60 feet gains a d6 to add to one ability check, attack roll, or saving throw. You can use this a number of times equal to your Charisma modifier.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       1,
+			Source:      "Bard",
+		},
+	},
+	"druid": {
+		{
+			Key:         "druidic",
+			Name:        "Druidic",
+			Description: "You know Druidic, the secret language of druids. You can speak the language and use it to leave hidden messages.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       1,
+			Source:      "Druid",
+		},
+		{
+			Key:         "spellcasting_druid",
+			Name:        "Spellcasting",
+			Description: "Drawing on the divine essence of nature itself, you can cast spells to shape that essence to your will. Wisdom is your spellcasting ability for druid spells.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       1,
+			Source:      "Druid",
+		},
+	},
+	"sorcerer": {
+		{
+			Key:         "spellcasting_sorcerer",
+			Name:        "Spellcasting",
+			Description: "An event in your past, or in the life of a parent or ancestor, left an indelible mark on you, infusing you with arcane magic. Charisma is your spellcasting ability for sorcerer spells.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       1,
+			Source:      "Sorcerer",
+		},
+		{
+			Key:         "sorcerous_origin",
+			Name:        "Sorcerous Origin",
+			Description: "Choose a sorcerous origin, which describes the source of your innate magical power.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       1,
+			Source:      "Sorcerer",
+		},
+	},
+	"warlock": {
+		{
+			Key:         "otherworldly_patron",
+			Name:        "Otherworldly Patron",
+			Description: "You have struck a bargain with an otherworldly being of your choice. Your choice grants you features at 1st level and again at 6th, 10th, and 14th level.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       1,
+			Source:      "Warlock",
+		},
+		{
+			Key:         "pact_magic",
+			Name:        "Pact Magic",
+			Description: "Your arcane research and the magic bestowed on you by your patron have given you facility with spells. Your spell slots recover when you finish a short or long rest.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       1,
+			Source:      "Warlock",
+		},
+	},
 }
 
 // RacialFeatures defines features for each race
@@ -322,6 +394,22 @@ func GetClassFeatures(classKey string, level int) []rulebook.CharacterFeature {
 			}
 		}
 	}
+	for _, feat := range ClassProgression[classKey] {
+		if feat.Level <= level {
+			features = append(features, feat)
+		}
+	}
+	return features
+}
+
+// GetClassFeaturesAtLevel returns only the features a class gains at exactly the given level
+func GetClassFeaturesAtLevel(classKey string, level int) []rulebook.CharacterFeature {
+	features := []rulebook.CharacterFeature{}
+	for _, feat := range GetClassFeatures(classKey, level) {
+		if feat.Level == level {
+			features = append(features, feat)
+		}
+	}
 	return features
 }
 
@@ -0,0 +1,305 @@
+package features
+
+import (
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+)
+
+// SubclassProgression holds the features each PHB subclass grants, keyed by
+// subclass key. Type and Source are filled in by GetSubclassFeatures.
+var SubclassProgression = map[string][]rulebook.CharacterFeature{
+	// Barbarian primal paths
+	"berserker": {
+		subclassFeature(3, "frenzy", "Frenzy", "While raging you can go into a frenzy, making a single melee weapon attack as a bonus action on each of your turns. You suffer a level of exhaustion when the rage ends."),
+		subclassFeature(6, "mindless_rage", "Mindless Rage", "You can't be charmed or frightened while raging. If you are charmed or frightened when you enter your rage, the effect is suspended for the rage's duration."),
+		subclassFeature(10, "intimidating_presence", "Intimidating Presence", "You can use your action to frighten a creature within 30 feet that can see or hear you (Wisdom save against 8 + proficiency bonus + Charisma modifier)."),
+		subclassFeature(14, "retaliation", "Retaliation", "When a creature within 5 feet of you damages you, you can use your reaction to make a melee weapon attack against it."),
+	},
+	"totem-warrior": {
+		subclassFeature(3, "spirit_seeker", "Spirit Seeker", "You can cast beast sense and speak with animals as rituals."),
+		subclassFeature(3, "totem_spirit", "Totem Spirit", "Choose a bear, eagle or wolf totem. While raging, the bear grants resistance to all damage but psychic, the eagle lets you Dash as a bonus action, and the wolf gives allies advantage against enemies near you."),
+		subclassFeature(6, "aspect_of_the_beast", "Aspect of the Beast", "Your totem animal grants a benefit outside of rage: a bear's strength, an eagle's eyesight or a wolf's tracking."),
+		subclassFeature(10, "spirit_walker", "Spirit Walker", "You can cast commune with nature as a ritual."),
+		subclassFeature(14, "totemic_attunement", "Totemic Attunement", "Your totem grants a powerful benefit while raging: a bear imposes disadvantage on foes attacking others, an eagle lets you fly, and a wolf can knock foes prone."),
+	},
+
+	// Bard colleges
+	"lore": {
+		subclassFeature(3, "lore_bonus_proficiencies", "Bonus Proficiencies", "You gain proficiency with three skills of your choice."),
+		subclassFeature(3, "cutting_words", "Cutting Words", "When a creature you can see within 60 feet makes an attack roll, ability check or damage roll, you can use your reaction and a Bardic Inspiration die to subtract the roll from its result."),
+		subclassFeature(6, "additional_magical_secrets", "Additional Magical Secrets", "You learn two spells of your choice from any class. They count as bard spells for you."),
+		subclassFeature(14, "peerless_skill", "Peerless Skill", "When you make an ability check, you can expend a use of Bardic Inspiration and add the die to your roll."),
+	},
+	"valor": {
+		subclassFeature(3, "valor_bonus_proficiencies", "Bonus Proficiencies", "You gain proficiency with medium armor, shields and martial weapons."),
+		subclassFeature(3, "combat_inspiration", "Combat Inspiration", "A creature with your Bardic Inspiration die can add it to a weapon damage roll, or to its AC against one attack as a reaction."),
+		subclassFeature(6, "extra_attack", "Extra Attack", "You can attack twice, instead of once, whenever you take the Attack action on your turn."),
+		subclassFeature(14, "battle_magic", "Battle Magic", "When you use your action to cast a bard spell, you can make one weapon attack as a bonus action."),
+	},
+
+	// Cleric divine domains come from rulebook.GetDivineDomains, see init
+
+	// Druid circles
+	"land": {
+		subclassFeature(2, "land_bonus_cantrip", "Bonus Cantrip", "You learn one additional druid cantrip of your choice."),
+		subclassFeature(2, "natural_recovery", "Natural Recovery", "Once per day during a short rest, you can recover expended spell slots with a combined level up to half your druid level, rounded up."),
+		subclassFeature(3, "circle_spells", "Circle Spells", "Your connection to the land you chose grants you circle spells that are always prepared."),
+		subclassFeature(6, "lands_stride", "Land's Stride", "Nonmagical difficult terrain costs no extra movement, and you have advantage on saves against magically created plants."),
+		subclassFeature(10, "natures_ward", "Nature's Ward", "You can't be charmed or frightened by elementals or fey, and you are immune to poison and disease."),
+		subclassFeature(14, "natures_sanctuary", "Nature's Sanctuary", "Beasts and plant creatures must make a Wisdom save to attack you."),
+	},
+	"moon": {
+		subclassFeature(2, "combat_wild_shape", "Combat Wild Shape", "You can use Wild Shape as a bonus action, and spend spell slots while transformed to regain 1d8 hit points per slot level."),
+		subclassFeature(2, "circle_forms", "Circle Forms", "You can transform into beasts with a challenge rating as high as 1, and as high as a third of your druid level from 6th level."),
+		subclassFeature(6, "primal_strike", "Primal Strike", "Your attacks in beast form count as magical for overcoming resistance and immunity."),
+		subclassFeature(10, "elemental_wild_shape", "Elemental Wild Shape", "You can expend two uses of Wild Shape to transform into an air, earth, fire or water elemental."),
+		subclassFeature(14, "thousand_forms", "Thousand Forms", "You can cast alter self at will."),
+	},
+
+	// Fighter martial archetypes
+	"champion": {
+		subclassFeature(3, "improved_critical", "Improved Critical", "Your weapon attacks score a critical hit on a roll of 19 or 20."),
+		subclassFeature(7, "remarkable_athlete", "Remarkable Athlete", "You add half your proficiency bonus to Strength, Dexterity and Constitution checks that don't already use it, and your running long jump improves."),
+		subclassFeature(10, "additional_fighting_style", "Additional Fighting Style", "You can choose a second option from the Fighting Style class feature."),
+		subclassFeature(15, "superior_critical", "Superior Critical", "Your weapon attacks score a critical hit on a roll of 18-20."),
+		subclassFeature(18, "survivor", "Survivor", "At the start of each of your turns, you regain 5 + Constitution modifier hit points if you have no more than half your hit points left."),
+	},
+	"battle-master": {
+		subclassFeature(3, "combat_superiority", "Combat Superiority", "You learn three maneuvers fueled by four d8 superiority dice, which you regain on a short or long rest."),
+		subclassFeature(3, "student_of_war", "Student of War", "You gain proficiency with one type of artisan's tools."),
+		subclassFeature(7, "know_your_enemy", "Know Your Enemy", "By observing a creature for 1 minute, you learn how its capabilities compare to yours."),
+		subclassFeature(10, "improved_combat_superiority", "Improved Combat Superiority", "Your superiority dice turn into d10s, and into d12s at 18th level."),
+		subclassFeature(15, "relentless", "Relentless", "When you roll initiative and have no superiority dice remaining, you regain one."),
+	},
+	"eldritch-knight": {
+		subclassFeature(3, "eldritch_knight_spellcasting", "Spellcasting", "You learn wizard cantrips and abjuration and evocation spells, using Intelligence as your spellcasting ability."),
+		subclassFeature(3, "weapon_bond", "Weapon Bond", "You can bond with up to two weapons. A bonded weapon can't be disarmed, and you can summon it to your hand as a bonus action."),
+		subclassFeature(7, "war_magic", "War Magic", "When you use your action to cast a cantrip, you can make one weapon attack as a bonus action."),
+		subclassFeature(10, "eldritch_strike", "Eldritch Strike", "When you hit a creature with a weapon attack, it has disadvantage on its next save against a spell you cast before the end of your next turn."),
+		subclassFeature(15, "arcane_charge", "Arcane Charge", "When you use Action Surge, you can teleport up to 30 feet."),
+		subclassFeature(18, "improved_war_magic", "Improved War Magic", "When you use your action to cast a spell, you can make one weapon attack as a bonus action."),
+	},
+
+	// Monk monastic traditions
+	"open-hand": {
+		subclassFeature(3, "open_hand_technique", "Open Hand Technique", "When you hit with a Flurry of Blows attack, you can knock the target prone, push it 15 feet, or stop it from taking reactions."),
+		subclassFeature(6, "wholeness_of_body", "Wholeness of Body", "As an action, you can regain hit points equal to three times your monk level once per long rest."),
+		subclassFeature(11, "tranquility", "Tranquility", "At the end of a long rest you gain the effect of a sanctuary spell until your next long rest."),
+		subclassFeature(17, "quivering_palm", "Quivering Palm", "You can spend 3 ki points to set up lethal vibrations in a creature you hit, which you can end to reduce it to 0 hit points or deal 10d10 necrotic damage."),
+	},
+	"shadow": {
+		subclassFeature(3, "shadow_arts", "Shadow Arts", "You can spend 2 ki points to cast darkness, darkvision, pass without trace or silence, and you learn the minor illusion cantrip."),
+		subclassFeature(6, "shadow_step", "Shadow Step", "While in dim light or darkness, you can use a bonus action to teleport up to 60 feet to another shadow and gain advantage on your next melee attack."),
+		subclassFeature(11, "cloak_of_shadows", "Cloak of Shadows", "While in dim light or darkness, you can use your action to become invisible."),
+		subclassFeature(17, "opportunist", "Opportunist", "When a creature within 5 feet is hit by an attack from someone else, you can use your reaction to make a melee attack against it."),
+	},
+	"four-elements": {
+		subclassFeature(3, "disciple_of_the_elements", "Disciple of the Elements", "You learn Elemental Attunement and one elemental discipline, spending ki points to cast elemental spells."),
+		subclassFeature(6, "elemental_discipline_6", "Elemental Discipline", "You learn one additional elemental discipline and can replace one you already know."),
+		subclassFeature(11, "elemental_discipline_11", "Elemental Discipline", "You learn one additional elemental discipline and can replace one you already know."),
+		subclassFeature(17, "elemental_discipline_17", "Elemental Discipline", "You learn one additional elemental discipline and can replace one you already know."),
+	},
+
+	// Paladin sacred oaths
+	"devotion": {
+		subclassFeature(3, "sacred_weapon", "Channel Divinity: Sacred Weapon", "You can use your Channel Divinity to add your Charisma modifier to attack rolls with a weapon for 1 minute. The weapon sheds bright light and counts as magical."),
+		subclassFeature(3, "turn_the_unholy", "Channel Divinity: Turn the Unholy", "You can use your Channel Divinity to turn fiends and undead within 30 feet for 1 minute."),
+		subclassFeature(7, "aura_of_devotion", "Aura of Devotion", "You and friendly creatures within 10 feet can't be charmed while you are conscious. The aura grows to 30 feet at 18th level."),
+		subclassFeature(15, "purity_of_spirit", "Purity of Spirit", "You are always under the effects of a protection from evil and good spell."),
+		subclassFeature(20, "holy_nimbus", "Holy Nimbus", "As an action, you can emanate an aura of sunlight for 1 minute that deals 10 radiant damage to enemies starting their turn in it."),
+	},
+	"ancients": {
+		subclassFeature(3, "natures_wrath", "Channel Divinity: Nature's Wrath", "You can use your Channel Divinity to restrain a creature within 10 feet with spectral vines."),
+		subclassFeature(3, "turn_the_faithless", "Channel Divinity: Turn the Faithless", "You can use your Channel Divinity to turn fey and fiends within 30 feet for 1 minute."),
+		subclassFeature(7, "aura_of_warding", "Aura of Warding", "You and friendly creatures within 10 feet have resistance to damage from spells. The aura grows to 30 feet at 18th level."),
+		subclassFeature(15, "undying_sentinel", "Undying Sentinel", "When you are reduced to 0 hit points and not killed outright, you can drop to 1 hit point instead once per long rest. You no longer age."),
+		subclassFeature(20, "elder_champion", "Elder Champion", "As an action, you can take the form of an ancient force of nature for 1 minute, regaining hit points and casting spells faster."),
+	},
+	"vengeance": {
+		subclassFeature(3, "abjure_enemy", "Channel Divinity: Abjure Enemy", "You can use your Channel Divinity to frighten a creature within 60 feet and reduce its speed to 0."),
+		subclassFeature(3, "vow_of_enmity", "Channel Divinity: Vow of Enmity", "As a bonus action, you can use your Channel Divinity to gain advantage on attack rolls against a creature within 10 feet for 1 minute."),
+		subclassFeature(7, "relentless_avenger", "Relentless Avenger", "When you hit a creature with an opportunity attack, you can move up to half your speed without provoking opportunity attacks."),
+		subclassFeature(15, "soul_of_vengeance", "Soul of Vengeance", "When a creature under your Vow of Enmity attacks, you can use your reaction to make a melee weapon attack against it."),
+		subclassFeature(20, "avenging_angel", "Avenging Angel", "As an action, you can sprout wings and radiate an aura of menace for 1 hour."),
+	},
+
+	// Ranger archetypes
+	"hunter": {
+		subclassFeature(3, "hunters_prey", "Hunter's Prey", "Choose Colossus Slayer, Giant Killer or Horde Breaker."),
+		subclassFeature(7, "defensive_tactics", "Defensive Tactics", "Choose Escape the Horde, Multiattack Defense or Steel Will."),
+		subclassFeature(11, "multiattack", "Multiattack", "Choose Volley or Whirlwind Attack."),
+		subclassFeature(15, "superior_hunters_defense", "Superior Hunter's Defense", "Choose Evasion, Stand Against the Tide or Uncanny Dodge."),
+	},
+	"beast-master": {
+		subclassFeature(3, "rangers_companion", "Ranger's Companion", "You gain a beast companion of challenge rating 1/4 or lower that obeys your commands and acts on your turn."),
+		subclassFeature(7, "exceptional_training", "Exceptional Training", "Your companion can Dash, Disengage, Dodge or Help as a bonus action, and its attacks count as magical."),
+		subclassFeature(11, "bestial_fury", "Bestial Fury", "Your companion can make two attacks when you command it to Attack."),
+		subclassFeature(15, "share_spells", "Share Spells", "When you cast a spell targeting yourself, you can also affect your companion if it is within 30 feet."),
+	},
+
+	// Rogue archetypes
+	"thief": {
+		subclassFeature(3, "fast_hands", "Fast Hands", "You can use your Cunning Action to make a Sleight of Hand check, use thieves' tools or take the Use an Object action."),
+		subclassFeature(3, "second_story_work", "Second-Story Work", "Climbing costs you no extra movement, and your running jump distance increases by your Dexterity modifier."),
+		subclassFeature(9, "supreme_sneak", "Supreme Sneak", "You have advantage on Dexterity (Stealth) checks if you move no more than half your speed on the same turn."),
+		subclassFeature(13, "use_magic_device", "Use Magic Device", "You ignore all class, race and level requirements on the use of magic items."),
+		subclassFeature(17, "thiefs_reflexes", "Thief's Reflexes", "You take two turns during the first round of any combat."),
+	},
+	"assassin": {
+		subclassFeature(3, "assassin_bonus_proficiencies", "Bonus Proficiencies", "You gain proficiency with the disguise kit and the poisoner's kit."),
+		subclassFeature(3, "assassinate", "Assassinate", "You have advantage on attacks against creatures that haven't taken a turn yet, and any hit against a surprised creature is a critical hit."),
+		subclassFeature(9, "infiltration_expertise", "Infiltration Expertise", "You can unfailingly create false identities for yourself."),
+		subclassFeature(13, "impostor", "Impostor", "You can unerringly mimic another person's speech, writing and behavior."),
+		subclassFeature(17, "death_strike", "Death Strike", "When you hit a surprised creature, it must make a Constitution save or take double damage."),
+	},
+	"arcane-trickster": {
+		subclassFeature(3, "arcane_trickster_spellcasting", "Spellcasting", "You learn wizard cantrips, including mage hand, and enchantment and illusion spells, using Intelligence as your spellcasting ability."),
+		subclassFeature(3, "mage_hand_legerdemain", "Mage Hand Legerdemain", "Your spectral mage hand is invisible and can stow, retrieve and steal objects, pick locks and disarm traps."),
+		subclassFeature(9, "magical_ambush", "Magical Ambush", "If you are hidden from a creature when you cast a spell on it, it has disadvantage on any save against the spell this turn."),
+		subclassFeature(13, "versatile_trickster", "Versatile Trickster", "As a bonus action, you can use your mage hand to distract a creature within 5 feet of it, gaining advantage on attacks against it."),
+		subclassFeature(17, "spell_thief", "Spell Thief", "When a creature casts a spell targeting you, you can use your reaction to negate it and steal the knowledge of the spell for 8 hours."),
+	},
+
+	// Sorcerous origins
+	"draconic": {
+		subclassFeature(1, "dragon_ancestor", "Dragon Ancestor", "You choose a dragon ancestor and can speak Draconic. You double your proficiency bonus for Charisma checks with dragons."),
+		subclassFeature(1, "draconic_resilience", "Draconic Resilience", "Your hit point maximum increases by 1 per sorcerer level, and your AC is 13 + Dexterity modifier when you aren't wearing armor."),
+		subclassFeature(6, "elemental_affinity", "Elemental Affinity", "When you cast a spell that deals your ancestry's damage type, you add your Charisma modifier to one damage roll and can spend a sorcery point for resistance to that type."),
+		subclassFeature(14, "dragon_wings", "Dragon Wings", "You can sprout dragon wings as a bonus action, gaining a flying speed equal to your speed."),
+		subclassFeature(18, "draconic_presence", "Draconic Presence", "You can spend 5 sorcery points to exude an aura of awe or fear for 1 minute."),
+	},
+	"wild-magic": {
+		subclassFeature(1, "wild_magic_surge", "Wild Magic Surge", "When you cast a sorcerer spell of 1st level or higher, the DM can have you roll a d20. On a 1, you roll on the Wild Magic Surge table."),
+		subclassFeature(1, "tides_of_chaos", "Tides of Chaos", "You can gain advantage on one attack roll, ability check or saving throw once per long rest."),
+		subclassFeature(6, "bend_luck", "Bend Luck", "When a creature you can see makes an attack roll, ability check or saving throw, you can spend 2 sorcery points to add or subtract 1d4."),
+		subclassFeature(14, "controlled_chaos", "Controlled Chaos", "Whenever you roll on the Wild Magic Surge table, you can roll twice and use either number."),
+		subclassFeature(18, "spell_bombardment", "Spell Bombardment", "When you roll the highest number possible on a damage die for a spell, you can roll that die again and add it to the damage."),
+	},
+
+	// Warlock otherworldly patrons
+	"archfey": {
+		subclassFeature(1, "archfey_expanded_spells", "Expanded Spell List", "Your patron adds faerie fire, sleep and other fey magic to the warlock spell list for you."),
+		subclassFeature(1, "fey_presence", "Fey Presence", "As an action, you can charm or frighten creatures in a 10-foot cube until the end of your next turn. Recharges on a short or long rest."),
+		subclassFeature(6, "misty_escape", "Misty Escape", "When you take damage, you can use your reaction to turn invisible and teleport up to 60 feet."),
+		subclassFeature(10, "beguiling_defenses", "Beguiling Defenses", "You are immune to being charmed and can turn charm attempts back on their caster."),
+		subclassFeature(14, "dark_delirium", "Dark Delirium", "As an action, you can plunge a creature within 60 feet into an illusory realm for 1 minute."),
+	},
+	"fiend": {
+		subclassFeature(1, "fiend_expanded_spells", "Expanded Spell List", "Your patron adds burning hands, command and other fiendish magic to the warlock spell list for you."),
+		subclassFeature(1, "dark_ones_blessing", "Dark One's Blessing", "When you reduce a hostile creature to 0 hit points, you gain temporary hit points equal to your Charisma modifier + warlock level."),
+		subclassFeature(6, "dark_ones_own_luck", "Dark One's Own Luck", "When you make an ability check or saving throw, you can add a d10 to the roll once per short or long rest."),
+		subclassFeature(10, "fiendish_resilience", "Fiendish Resilience", "After a short or long rest, you choose a damage type to gain resistance to until you choose another."),
+		subclassFeature(14, "hurl_through_hell", "Hurl Through Hell", "When you hit a creature with an attack, you can send it through the lower planes, dealing 10d10 psychic damage to non-fiends."),
+	},
+	"great-old-one": {
+		subclassFeature(1, "great_old_one_expanded_spells", "Expanded Spell List", "Your patron adds dissonant whispers, Tasha's hideous laughter and other alien magic to the warlock spell list for you."),
+		subclassFeature(1, "awakened_mind", "Awakened Mind", "You can speak telepathically to any creature within 30 feet that understands a language."),
+		subclassFeature(6, "entropic_ward", "Entropic Ward", "When a creature attacks you, you can use your reaction to impose disadvantage. If it misses, you gain advantage on your next attack against it."),
+		subclassFeature(10, "thought_shield", "Thought Shield", "Your thoughts can't be read, you resist psychic damage, and creatures that deal psychic damage to you take the same amount."),
+		subclassFeature(14, "create_thrall", "Create Thrall", "You can touch an incapacitated humanoid to charm it permanently and communicate with it telepathically."),
+	},
+
+	// Wizard arcane traditions
+	"abjuration": {
+		subclassFeature(2, "abjuration_savant", "Abjuration Savant", "Copying abjuration spells into your spellbook takes half the gold and time."),
+		subclassFeature(2, "arcane_ward", "Arcane Ward", "When you cast an abjuration spell of 1st level or higher, you create a ward with twice your wizard level + Intelligence modifier hit points that absorbs damage."),
+		subclassFeature(6, "projected_ward", "Projected Ward", "When a creature within 30 feet takes damage, you can use your reaction to have your Arcane Ward absorb it."),
+		subclassFeature(10, "improved_abjuration", "Improved Abjuration", "You add your proficiency bonus to ability checks made as part of abjuration spells."),
+		subclassFeature(14, "spell_resistance", "Spell Resistance", "You have advantage on saves against spells and resistance to the damage of spells."),
+	},
+	"conjuration": {
+		subclassFeature(2, "conjuration_savant", "Conjuration Savant", "Copying conjuration spells into your spellbook takes half the gold and time."),
+		subclassFeature(2, "minor_conjuration", "Minor Conjuration", "As an action, you can conjure a nonmagical object up to 3 feet on a side that lasts for 1 hour."),
+		subclassFeature(6, "benign_transposition", "Benign Transposition", "As an action, you can teleport up to 30 feet or swap places with a willing Small or Medium creature."),
+		subclassFeature(10, "focused_conjuration", "Focused Conjuration", "Taking damage can't break your concentration on a conjuration spell."),
+		subclassFeature(14, "durable_summons", "Durable Summons", "Creatures you summon with conjuration spells have 30 temporary hit points."),
+	},
+	"divination": {
+		subclassFeature(2, "divination_savant", "Divination Savant", "Copying divination spells into your spellbook takes half the gold and time."),
+		subclassFeature(2, "portent", "Portent", "After a long rest, roll two d20s. You can replace any attack roll, saving throw or ability check you can see with one of these rolls."),
+		subclassFeature(6, "expert_divination", "Expert Divination", "When you cast a divination spell of 2nd level or higher, you regain a lower-level spell slot."),
+		subclassFeature(10, "the_third_eye", "The Third Eye", "As an action, you gain darkvision, ethereal sight, the ability to read any language, or see invisibility until your next rest."),
+		subclassFeature(14, "greater_portent", "Greater Portent", "You roll three d20s for Portent instead of two."),
+	},
+	"enchantment": {
+		subclassFeature(2, "enchantment_savant", "Enchantment Savant", "Copying enchantment spells into your spellbook takes half the gold and time."),
+		subclassFeature(2, "hypnotic_gaze", "Hypnotic Gaze", "As an action, you can charm a creature within 5 feet, leaving it incapacitated while you maintain the gaze."),
+		subclassFeature(6, "instinctive_charm", "Instinctive Charm", "When a creature within 30 feet attacks you, you can use your reaction to divert the attack to another creature."),
+		subclassFeature(10, "split_enchantment", "Split Enchantment", "When you cast an enchantment spell that targets only one creature, you can target a second creature."),
+		subclassFeature(14, "alter_memories", "Alter Memories", "When you charm a creature, you can make it unaware that it was charmed and erase some of its memories."),
+	},
+	"evocation": {
+		subclassFeature(2, "evocation_savant", "Evocation Savant", "Copying evocation spells into your spellbook takes half the gold and time."),
+		subclassFeature(2, "sculpt_spells", "Sculpt Spells", "When you cast an evocation spell, you can protect 1 + the spell's level creatures from its effects."),
+		subclassFeature(6, "potent_cantrip", "Potent Cantrip", "Creatures that succeed on a save against your damaging cantrips still take half damage."),
+		subclassFeature(10, "empowered_evocation", "Empowered Evocation", "You add your Intelligence modifier to one damage roll of any wizard evocation spell you cast."),
+		subclassFeature(14, "overchannel", "Overchannel", "When you cast a wizard spell of 5th level or lower that deals damage, you can deal maximum damage, taking necrotic damage if you use it again before a long rest."),
+	},
+	"illusion": {
+		subclassFeature(2, "illusion_savant", "Illusion Savant", "Copying illusion spells into your spellbook takes half the gold and time."),
+		subclassFeature(2, "improved_minor_illusion", "Improved Minor Illusion", "You learn minor illusion, and can create both a sound and an image with a single casting."),
+		subclassFeature(6, "malleable_illusions", "Malleable Illusions", "You can use your action to change the nature of an illusion spell you cast that lasts 1 minute or longer."),
+		subclassFeature(10, "illusory_self", "Illusory Self", "When a creature attacks you, you can use your reaction to make it miss by interposing an illusory duplicate."),
+		subclassFeature(14, "illusory_reality", "Illusory Reality", "You can make one inanimate, nonmagical object from your illusion spell real for 1 minute."),
+	},
+	"necromancy": {
+		subclassFeature(2, "necromancy_savant", "Necromancy Savant", "Copying necromancy spells into your spellbook takes half the gold and time."),
+		subclassFeature(2, "grim_harvest", "Grim Harvest", "When you kill a creature with a spell of 1st level or higher, you regain hit points equal to twice the spell's level, or three times for necromancy spells."),
+		subclassFeature(6, "undead_thralls", "Undead Thralls", "You learn animate dead. Undead you create with necromancy are tougher and hit harder."),
+		subclassFeature(10, "inured_to_undeath", "Inured to Undeath", "You resist necrotic damage, and your hit point maximum can't be reduced."),
+		subclassFeature(14, "command_undead", "Command Undead", "As an action, you can bring an undead creature within 60 feet under your control."),
+	},
+	"transmutation": {
+		subclassFeature(2, "transmutation_savant", "Transmutation Savant", "Copying transmutation spells into your spellbook takes half the gold and time."),
+		subclassFeature(2, "minor_alchemy", "Minor Alchemy", "You can temporarily change an object made of wood, stone, iron, copper or silver into another of those materials."),
+		subclassFeature(6, "transmuters_stone", "Transmuter's Stone", "You can create a stone that grants darkvision, extra speed, a saving throw proficiency or a damage resistance to its bearer."),
+		subclassFeature(10, "shapechanger", "Shapechanger", "You can cast polymorph on yourself without expending a spell slot once per short rest."),
+		subclassFeature(14, "master_transmuter", "Master Transmuter", "You can destroy your transmuter's stone to transform an object, remove curses and diseases, restore youth or cast raise dead."),
+	},
+}
+
+func init() {
+	for _, domain := range rulebook.GetDivineDomains() {
+		for _, feature := range domain.Features {
+			SubclassProgression[domain.Key] = append(SubclassProgression[domain.Key],
+				subclassFeature(feature.Level, feature.Key, feature.Name, feature.Description))
+		}
+	}
+}
+
+func subclassFeature(level int, key, name, description string) rulebook.CharacterFeature {
+	return rulebook.CharacterFeature{
+		Key:         key,
+		Name:        name,
+		Description: description,
+		Type:        rulebook.FeatureTypeSubclass,
+		Level:       level,
+	}
+}
+
+// GetSubclassFeatures returns the features a subclass grants up to a class level
+func GetSubclassFeatures(classKey, subclassKey string, level int) []rulebook.CharacterFeature {
+	subclass, ok := rulebook.GetSubclass(classKey, subclassKey)
+	if !ok {
+		return []rulebook.CharacterFeature{}
+	}
+
+	features := []rulebook.CharacterFeature{}
+	for _, feat := range SubclassProgression[subclassKey] {
+		if feat.Level <= level {
+			feat.Source = subclass.Name
+			features = append(features, feat)
+		}
+	}
+	return features
+}
+
+// GetSubclassFeaturesAtLevel returns only the features a subclass grants at exactly the given level
+func GetSubclassFeaturesAtLevel(classKey, subclassKey string, level int) []rulebook.CharacterFeature {
+	features := []rulebook.CharacterFeature{}
+	for _, feat := range GetSubclassFeatures(classKey, subclassKey, level) {
+		if feat.Level == level {
+			features = append(features, feat)
+		}
+	}
+	return features
+}
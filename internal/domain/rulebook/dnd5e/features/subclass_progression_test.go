@@ -0,0 +1,57 @@
+package features
+
+import (
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var phbClasses = []string{
+	"barbarian", "bard", "cleric", "druid", "fighter", "monk",
+	"paladin", "ranger", "rogue", "sorcerer", "warlock", "wizard",
+}
+
+func TestSubclassProgression_CoversEverySubclass(t *testing.T) {
+	for _, classKey := range phbClasses {
+		subclasses := rulebook.GetSubclasses(classKey)
+		require.NotEmpty(t, subclasses, classKey)
+
+		for _, subclass := range subclasses {
+			feats := GetSubclassFeatures(classKey, subclass.Key, rulebook.MaxLevel)
+			require.NotEmpty(t, feats, subclass.Key)
+			assert.Equal(t, rulebook.SubclassLevel(classKey), feats[0].Level,
+				"%s features should start at the level it's chosen", subclass.Key)
+
+			for _, feat := range feats {
+				assert.Equal(t, rulebook.FeatureTypeSubclass, feat.Type)
+				assert.Equal(t, subclass.Name, feat.Source)
+			}
+		}
+	}
+}
+
+func TestSubclassProgression_KeysDontCollideWithClassFeatures(t *testing.T) {
+	for _, classKey := range phbClasses {
+		classFeatures := GetClassFeatures(classKey, rulebook.MaxLevel)
+		for _, subclass := range rulebook.GetSubclasses(classKey) {
+			for _, feat := range SubclassProgression[subclass.Key] {
+				assert.False(t, HasFeature(classFeatures, feat.Key), "%s %s", subclass.Key, feat.Key)
+			}
+		}
+	}
+}
+
+func TestGetSubclassFeatures_ByLevel(t *testing.T) {
+	feats := GetSubclassFeatures("fighter", "champion", 10)
+	assert.True(t, HasFeature(feats, "improved_critical"))
+	assert.True(t, HasFeature(feats, "additional_fighting_style"))
+	assert.False(t, HasFeature(feats, "superior_critical"))
+
+	atLevel := GetSubclassFeaturesAtLevel("fighter", "champion", 15)
+	require.Len(t, atLevel, 1)
+	assert.Equal(t, "superior_critical", atLevel[0].Key)
+
+	assert.Empty(t, GetSubclassFeatures("wizard", "champion", 20), "subclass belongs to another class")
+}
@@ -0,0 +1,179 @@
+package rulebook
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+)
+
+// MulticlassMinimumScore is the ability score a multiclass prerequisite requires
+const MulticlassMinimumScore = 13
+
+// MulticlassPrerequisite lists the ability scores a class requires before a
+// character can multiclass into it, or out of it into another class
+type MulticlassPrerequisite struct {
+	Abilities []shared.Attribute
+	AnyOf     bool // Only one of the abilities has to qualify (fighter)
+}
+
+// MulticlassProficiencies are the proficiencies a class grants when it's
+// taken as a second class, which are fewer than it grants at 1st level
+type MulticlassProficiencies struct {
+	Proficiencies []*Proficiency // Granted outright
+	Skills        int            // Skills to choose
+	SkillOptions  []string       // Skill names to choose from; empty means any skill
+	Instruments   int            // Musical instruments to choose
+}
+
+var multiclassPrerequisites = map[string]MulticlassPrerequisite{
+	"barbarian": {Abilities: []shared.Attribute{shared.AttributeStrength}},
+	"bard":      {Abilities: []shared.Attribute{shared.AttributeCharisma}},
+	"cleric":    {Abilities: []shared.Attribute{shared.AttributeWisdom}},
+	"druid":     {Abilities: []shared.Attribute{shared.AttributeWisdom}},
+	"fighter":   {Abilities: []shared.Attribute{shared.AttributeStrength, shared.AttributeDexterity}, AnyOf: true},
+	"monk":      {Abilities: []shared.Attribute{shared.AttributeDexterity, shared.AttributeWisdom}},
+	"paladin":   {Abilities: []shared.Attribute{shared.AttributeStrength, shared.AttributeCharisma}},
+	"ranger":    {Abilities: []shared.Attribute{shared.AttributeDexterity, shared.AttributeWisdom}},
+	"rogue":     {Abilities: []shared.Attribute{shared.AttributeDexterity}},
+	"sorcerer":  {Abilities: []shared.Attribute{shared.AttributeCharisma}},
+	"warlock":   {Abilities: []shared.Attribute{shared.AttributeCharisma}},
+	"wizard":    {Abilities: []shared.Attribute{shared.AttributeIntelligence}},
+}
+
+var (
+	lightArmor     = &Proficiency{Key: "light-armor", Name: "Light Armor", Type: ProficiencyTypeArmor}
+	mediumArmor    = &Proficiency{Key: "medium-armor", Name: "Medium Armor", Type: ProficiencyTypeArmor}
+	shields        = &Proficiency{Key: "shields", Name: "Shields", Type: ProficiencyTypeArmor}
+	simpleWeapons  = &Proficiency{Key: "simple-weapons", Name: "Simple Weapons", Type: ProficiencyTypeWeapon}
+	martialWeapons = &Proficiency{Key: "martial-weapons", Name: "Martial Weapons", Type: ProficiencyTypeWeapon}
+)
+
+var multiclassProficiencies = map[string]MulticlassProficiencies{
+	"barbarian": {Proficiencies: []*Proficiency{shields, simpleWeapons, martialWeapons}},
+	"bard":      {Proficiencies: []*Proficiency{lightArmor}, Skills: 1, Instruments: 1},
+	"cleric":    {Proficiencies: []*Proficiency{lightArmor, mediumArmor, shields}},
+	"druid":     {Proficiencies: []*Proficiency{lightArmor, mediumArmor, shields}},
+	"fighter":   {Proficiencies: []*Proficiency{lightArmor, mediumArmor, shields, simpleWeapons, martialWeapons}},
+	"monk": {Proficiencies: []*Proficiency{
+		simpleWeapons,
+		{Key: "shortswords", Name: "Shortswords", Type: ProficiencyTypeWeapon},
+	}},
+	"paladin": {Proficiencies: []*Proficiency{lightArmor, mediumArmor, shields, simpleWeapons, martialWeapons}},
+	"ranger": {
+		Proficiencies: []*Proficiency{lightArmor, mediumArmor, shields, simpleWeapons, martialWeapons},
+		Skills:        1,
+		SkillOptions:  []string{"Animal Handling", "Athletics", "Insight", "Investigation", "Nature", "Perception", "Stealth", "Survival"},
+	},
+	"rogue": {
+		Proficiencies: []*Proficiency{lightArmor, backgroundTool("thieves-tools", "Thieves' Tools")},
+		Skills:        1,
+		SkillOptions: []string{"Acrobatics", "Athletics", "Deception", "Insight", "Intimidation", "Investigation",
+			"Perception", "Performance", "Persuasion", "Sleight of Hand", "Stealth"},
+	},
+	"sorcerer": {},
+	"warlock":  {Proficiencies: []*Proficiency{lightArmor, simpleWeapons}},
+	"wizard":   {},
+}
+
+// SkillNames lists every skill, for choices that allow any skill
+var SkillNames = []string{
+	"Acrobatics", "Animal Handling", "Arcana", "Athletics", "Deception", "History",
+	"Insight", "Intimidation", "Investigation", "Medicine", "Nature", "Perception",
+	"Performance", "Persuasion", "Religion", "Sleight of Hand", "Stealth", "Survival",
+}
+
+// MusicalInstruments lists the PHB musical instruments
+var MusicalInstruments = []*Proficiency{
+	{Key: "bagpipes", Name: "Bagpipes", Type: ProficiencyTypeInstrument},
+	{Key: "drum", Name: "Drum", Type: ProficiencyTypeInstrument},
+	{Key: "dulcimer", Name: "Dulcimer", Type: ProficiencyTypeInstrument},
+	{Key: "flute", Name: "Flute", Type: ProficiencyTypeInstrument},
+	{Key: "lute", Name: "Lute", Type: ProficiencyTypeInstrument},
+	{Key: "lyre", Name: "Lyre", Type: ProficiencyTypeInstrument},
+	{Key: "horn", Name: "Horn", Type: ProficiencyTypeInstrument},
+	{Key: "pan-flute", Name: "Pan Flute", Type: ProficiencyTypeInstrument},
+	{Key: "shawm", Name: "Shawm", Type: ProficiencyTypeInstrument},
+	{Key: "viol", Name: "Viol", Type: ProficiencyTypeInstrument},
+}
+
+// GetMulticlassPrerequisite returns the multiclass prerequisite for a class
+func GetMulticlassPrerequisite(classKey string) (MulticlassPrerequisite, bool) {
+	prerequisite, ok := multiclassPrerequisites[classKey]
+	return prerequisite, ok
+}
+
+// GetMulticlassProficiencies returns what a class grants as a second class
+func GetMulticlassProficiencies(classKey string) MulticlassProficiencies {
+	return multiclassProficiencies[classKey]
+}
+
+// MulticlassClasses returns the keys of the classes that can be multiclassed into
+func MulticlassClasses() []string {
+	return []string{"barbarian", "bard", "cleric", "druid", "fighter", "monk",
+		"paladin", "ranger", "rogue", "sorcerer", "warlock", "wizard"}
+}
+
+// Met reports whether ability scores meet the prerequisite
+func (p MulticlassPrerequisite) Met(scores map[shared.Attribute]int) bool {
+	for _, attr := range p.Abilities {
+		qualifies := scores[attr] >= MulticlassMinimumScore
+		if p.AnyOf && qualifies {
+			return true
+		}
+		if !p.AnyOf && !qualifies {
+			return false
+		}
+	}
+	return !p.AnyOf
+}
+
+// String describes the prerequisite, e.g. "Strength 13 or Dexterity 13"
+func (p MulticlassPrerequisite) String() string {
+	parts := make([]string, 0, len(p.Abilities))
+	for _, attr := range p.Abilities {
+		parts = append(parts, fmt.Sprintf("%s %d", attributeName(attr), MulticlassMinimumScore))
+	}
+	if p.AnyOf {
+		return strings.Join(parts, " or ")
+	}
+	return strings.Join(parts, " and ")
+}
+
+// SkillProficiency builds the proficiency for a skill name
+func SkillProficiency(name string) *Proficiency {
+	return backgroundSkills(name)[0]
+}
+
+// MulticlassCasterLevel returns a class's contribution to the multiclass
+// spellcaster table: all levels of full casters, half of half casters and a
+// third of third casters, rounded down. Pact magic doesn't contribute.
+func MulticlassCasterLevel(progression CasterProgression, level int) int {
+	switch progression {
+	case CasterProgressionFull:
+		return level
+	case CasterProgressionHalf:
+		return level / 2
+	case CasterProgressionThird:
+		return level / 3
+	}
+	return 0
+}
+
+func attributeName(attr shared.Attribute) string {
+	switch attr {
+	case shared.AttributeStrength:
+		return "Strength"
+	case shared.AttributeDexterity:
+		return "Dexterity"
+	case shared.AttributeConstitution:
+		return "Constitution"
+	case shared.AttributeIntelligence:
+		return "Intelligence"
+	case shared.AttributeWisdom:
+		return "Wisdom"
+	case shared.AttributeCharisma:
+		return "Charisma"
+	}
+	return string(attr)
+}
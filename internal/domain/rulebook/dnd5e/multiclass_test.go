@@ -0,0 +1,46 @@
+package rulebook
+
+import (
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMulticlassPrerequisite(t *testing.T) {
+	fighter, ok := GetMulticlassPrerequisite("fighter")
+	require.True(t, ok)
+	assert.True(t, fighter.Met(map[shared.Attribute]int{shared.AttributeDexterity: 13}))
+	assert.False(t, fighter.Met(map[shared.Attribute]int{shared.AttributeStrength: 12}))
+	assert.Equal(t, "Strength 13 or Dexterity 13", fighter.String())
+
+	paladin, ok := GetMulticlassPrerequisite("paladin")
+	require.True(t, ok)
+	assert.False(t, paladin.Met(map[shared.Attribute]int{shared.AttributeStrength: 15}))
+	assert.True(t, paladin.Met(map[shared.Attribute]int{shared.AttributeStrength: 15, shared.AttributeCharisma: 13}))
+	assert.Equal(t, "Strength 13 and Charisma 13", paladin.String())
+
+	_, ok = GetMulticlassPrerequisite("artificer")
+	assert.False(t, ok)
+}
+
+func TestMulticlassCasterLevel(t *testing.T) {
+	assert.Equal(t, 5, MulticlassCasterLevel(CasterProgressionFull, 5))
+	assert.Equal(t, 2, MulticlassCasterLevel(CasterProgressionHalf, 5))
+	assert.Equal(t, 1, MulticlassCasterLevel(CasterProgressionThird, 5))
+	assert.Equal(t, 0, MulticlassCasterLevel(CasterProgressionPact, 5))
+	assert.Equal(t, 0, MulticlassCasterLevel(CasterProgressionNone, 5))
+}
+
+func TestGetMulticlassProficiencies(t *testing.T) {
+	rogue := GetMulticlassProficiencies("rogue")
+	assert.Equal(t, 1, rogue.Skills)
+	assert.Contains(t, rogue.SkillOptions, "Stealth")
+
+	bard := GetMulticlassProficiencies("bard")
+	assert.Equal(t, 1, bard.Instruments)
+	assert.Empty(t, bard.SkillOptions, "bards pick any skill")
+
+	assert.Empty(t, GetMulticlassProficiencies("wizard").Proficiencies)
+}
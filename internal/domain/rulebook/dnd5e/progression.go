@@ -0,0 +1,127 @@
+package rulebook
+
+// MaxLevel is the highest character level in D&D 5e
+const MaxLevel = 20
+
+// experienceThresholds is the total XP needed to reach each level (index 0 is level 1)
+var experienceThresholds = [MaxLevel]int{
+	0, 300, 900, 2700, 6500, 14000, 23000, 34000, 48000, 64000,
+	85000, 100000, 120000, 140000, 165000, 195000, 225000, 265000, 305000, 355000,
+}
+
+// ExperienceForLevel returns the total XP needed to reach a level
+func ExperienceForLevel(level int) int {
+	if level <= 1 {
+		return 0
+	}
+	if level > MaxLevel {
+		level = MaxLevel
+	}
+	return experienceThresholds[level-1]
+}
+
+// ProficiencyBonusForLevel returns the proficiency bonus at a character level
+func ProficiencyBonusForLevel(level int) int {
+	if level < 1 {
+		level = 1
+	}
+	return 2 + ((level - 1) / 4)
+}
+
+// SubclassLevel returns the class level at which a subclass is chosen
+func SubclassLevel(classKey string) int {
+	switch classKey {
+	case "cleric", "sorcerer", "warlock":
+		return 1
+	case "druid", "wizard":
+		return 2
+	default:
+		return 3
+	}
+}
+
+// IsAbilityScoreImprovementLevel reports whether a class gains an Ability
+// Score Improvement at the given level. Fighters and rogues get extra ones.
+func IsAbilityScoreImprovementLevel(classKey string, level int) bool {
+	switch level {
+	case 4, 8, 12, 16, 19:
+		return true
+	case 6, 14:
+		return classKey == "fighter"
+	case 10:
+		return classKey == "rogue"
+	}
+	return false
+}
+
+// MaxSpellLevel returns the highest spell level a class can learn at a level,
+// or 0 if the class has no spellcasting yet. The subclass matters for third
+// casters like the eldritch knight.
+func MaxSpellLevel(classKey, subclassKey string, level int) int {
+	progression := GetCasterProgression(classKey, subclassKey)
+	if progression == CasterProgressionPact {
+		// Pact slots cap at 5th level; Mystic Arcanum covers the rest
+		_, slotLevel := PactMagicSlots(level)
+		return slotLevel
+	}
+
+	highest := 0
+	for spellLevel := range SpellSlots(progression, level) {
+		highest = max(highest, spellLevel)
+	}
+	return highest
+}
+
+// CantripsKnown returns how many cantrips a class knows at a level
+func CantripsKnown(classKey string, level int) int {
+	if level < 1 {
+		return 0
+	}
+
+	switch classKey {
+	case "cleric", "wizard":
+		return stepUp(level, 3, 4, 10)
+	case "bard", "druid", "warlock":
+		return stepUp(level, 2, 4, 10)
+	case "sorcerer":
+		return stepUp(level, 4, 4, 10)
+	}
+	return 0
+}
+
+// stepUp returns base plus one for each threshold the level has reached
+func stepUp(level, base int, thresholds ...int) int {
+	for _, threshold := range thresholds {
+		if level >= threshold {
+			base++
+		}
+	}
+	return base
+}
+
+// spellsKnownTables lists spells known by level for classes that learn a
+// fixed number of spells rather than preparing them
+var spellsKnownTables = map[string][MaxLevel]int{
+	"bard":     {4, 5, 6, 7, 8, 9, 10, 11, 12, 14, 15, 15, 16, 18, 19, 19, 20, 22, 22, 22},
+	"ranger":   {0, 2, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11},
+	"sorcerer": {2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 12, 13, 13, 14, 14, 15, 15, 15, 15},
+	"warlock":  {2, 3, 4, 5, 6, 7, 8, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15},
+}
+
+// SpellsKnown returns how many leveled spells a class knows at a level.
+// For wizards this is the minimum spellbook size (six plus two per level).
+// Prepared casters (cleric, druid, paladin) return 0.
+func SpellsKnown(classKey string, level int) int {
+	if level < 1 {
+		return 0
+	}
+	level = min(level, MaxLevel)
+
+	if classKey == "wizard" {
+		return 6 + (level-1)*2
+	}
+	if table, ok := spellsKnownTables[classKey]; ok {
+		return table[level-1]
+	}
+	return 0
+}
@@ -0,0 +1,86 @@
+package rulebook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProficiencyBonusForLevel(t *testing.T) {
+	expected := map[int]int{1: 2, 4: 2, 5: 3, 8: 3, 9: 4, 13: 5, 17: 6, 20: 6}
+	for level, bonus := range expected {
+		assert.Equal(t, bonus, ProficiencyBonusForLevel(level), "level %d", level)
+	}
+}
+
+func TestExperienceForLevel(t *testing.T) {
+	assert.Equal(t, 0, ExperienceForLevel(1))
+	assert.Equal(t, 300, ExperienceForLevel(2))
+	assert.Equal(t, 6500, ExperienceForLevel(5))
+	assert.Equal(t, 355000, ExperienceForLevel(20))
+}
+
+func TestIsAbilityScoreImprovementLevel(t *testing.T) {
+	assert.True(t, IsAbilityScoreImprovementLevel("wizard", 4))
+	assert.False(t, IsAbilityScoreImprovementLevel("wizard", 6))
+	assert.True(t, IsAbilityScoreImprovementLevel("fighter", 6))
+	assert.True(t, IsAbilityScoreImprovementLevel("fighter", 14))
+	assert.True(t, IsAbilityScoreImprovementLevel("rogue", 10))
+	assert.True(t, IsAbilityScoreImprovementLevel("rogue", 19))
+}
+
+func TestSpellcastingProgression(t *testing.T) {
+	assert.Equal(t, 0, MaxSpellLevel("fighter", "", 10))
+	assert.Equal(t, 0, MaxSpellLevel("paladin", "", 1))
+	assert.Equal(t, 1, MaxSpellLevel("paladin", "", 2))
+	assert.Equal(t, 2, MaxSpellLevel("wizard", "", 3))
+	assert.Equal(t, 9, MaxSpellLevel("wizard", "", 17))
+	assert.Equal(t, 5, MaxSpellLevel("warlock", "", 20))
+	assert.Equal(t, 0, MaxSpellLevel("fighter", "eldritch-knight", 2))
+	assert.Equal(t, 1, MaxSpellLevel("fighter", "eldritch-knight", 3))
+	assert.Equal(t, 2, MaxSpellLevel("rogue", "arcane-trickster", 7))
+	assert.Equal(t, 4, MaxSpellLevel("rogue", "arcane-trickster", 19))
+
+	assert.Equal(t, 3, CantripsKnown("wizard", 1))
+	assert.Equal(t, 4, CantripsKnown("wizard", 4))
+	assert.Equal(t, 0, CantripsKnown("paladin", 5))
+
+	assert.Equal(t, 6, SpellsKnown("wizard", 1))
+	assert.Equal(t, 8, SpellsKnown("wizard", 2))
+	assert.Equal(t, 4, SpellsKnown("bard", 1))
+	assert.Equal(t, 0, SpellsKnown("cleric", 5))
+}
+
+func TestSubclassLevel(t *testing.T) {
+	assert.Equal(t, 1, SubclassLevel("cleric"))
+	assert.Equal(t, 2, SubclassLevel("wizard"))
+	assert.Equal(t, 3, SubclassLevel("fighter"))
+}
+
+func TestSpellDamage_DiceAt(t *testing.T) {
+	fireball := &SpellDamage{DamageAtLevel: map[int]string{3: "8d6", 4: "9d6", 5: "10d6"}}
+	assert.Equal(t, "8d6", fireball.DiceAt(3, 5))
+	assert.Equal(t, "10d6", fireball.DiceAt(5, 9))
+	assert.Equal(t, "10d6", fireball.DiceAt(9, 17), "missing levels use the closest lower entry")
+
+	fireBolt := &SpellDamage{DamageAtCharacterLevel: CantripDamageAtCharacterLevel("fire-bolt")}
+	assert.Equal(t, "1d10", fireBolt.DiceAt(0, 4))
+	assert.Equal(t, "2d10", fireBolt.DiceAt(0, 5))
+	assert.Equal(t, "4d10", fireBolt.DiceAt(0, 20))
+
+	assert.Nil(t, CantripDamageAtCharacterLevel("light"))
+	var none *SpellDamage
+	assert.Empty(t, none.DiceAt(1, 1))
+}
+
+func TestPreparedSpellLimit(t *testing.T) {
+	assert.Equal(t, 5, PreparedSpellLimit("cleric", 3, 2))
+	assert.Equal(t, 1, PreparedSpellLimit("wizard", 1, -1), "always at least one")
+	assert.Equal(t, 0, PreparedSpellLimit("paladin", 1, 3), "paladins cast from level 2")
+	assert.Equal(t, 5, PreparedSpellLimit("paladin", 5, 3), "half paladin level")
+	assert.Equal(t, 0, PreparedSpellLimit("sorcerer", 5, 3), "known casters don't prepare")
+
+	gold, hours := SpellbookCopyCost(3)
+	assert.Equal(t, 150, gold)
+	assert.Equal(t, 6, hours)
+}
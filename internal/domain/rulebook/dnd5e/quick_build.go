@@ -0,0 +1,172 @@
+package rulebook
+
+import "github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+
+// QuickBuild is the PHB's quick build advice for a class, filled out with
+// the choices the book leaves open so a character can be built in one step
+type QuickBuild struct {
+	ClassKey      string
+	RaceKey       string
+	BackgroundKey string
+
+	// AbilityPriority lists all six abilities, the one to get the highest score first
+	AbilityPriority []shared.Attribute
+
+	// Preferred picks for each kind of choice. A pick the flow doesn't offer
+	// is skipped and the first offered option is used instead.
+	Skills        []string // Proficiency keys, e.g. "skill-athletics"
+	Expertise     []string // Proficiency keys to double, for rogues and bards
+	Equipment     []string // Equipment keys
+	FightingStyle string
+	DivineDomain  string
+	Subclass      string
+	FavoredEnemy  string
+	Terrain       string
+	Cantrips      []string
+	Spells        []string
+}
+
+var quickBuilds = map[string]*QuickBuild{
+	"barbarian": {
+		RaceKey:         "half-orc",
+		BackgroundKey:   "outlander",
+		AbilityPriority: []shared.Attribute{shared.AttributeStrength, shared.AttributeConstitution, shared.AttributeDexterity, shared.AttributeWisdom, shared.AttributeCharisma, shared.AttributeIntelligence},
+		Skills:          []string{"skill-athletics", "skill-perception"},
+		Equipment:       []string{"greataxe", "handaxe", "explorers-pack", "javelin"},
+	},
+	"bard": {
+		RaceKey:         "half-elf",
+		BackgroundKey:   "entertainer",
+		AbilityPriority: []shared.Attribute{shared.AttributeCharisma, shared.AttributeDexterity, shared.AttributeConstitution, shared.AttributeWisdom, shared.AttributeIntelligence, shared.AttributeStrength},
+		Skills:          []string{"skill-performance", "skill-persuasion", "skill-deception"},
+		Expertise:       []string{"skill-performance", "skill-persuasion"},
+		Equipment:       []string{"rapier", "diplomats-pack", "lute", "leather-armor", "dagger"},
+		Cantrips:        []string{"dancing-lights", "vicious-mockery"},
+		Spells:          []string{"charm-person", "detect-magic", "healing-word", "thunderwave"},
+	},
+	"cleric": {
+		RaceKey:         "dwarf",
+		BackgroundKey:   "acolyte",
+		AbilityPriority: []shared.Attribute{shared.AttributeWisdom, shared.AttributeConstitution, shared.AttributeStrength, shared.AttributeCharisma, shared.AttributeDexterity, shared.AttributeIntelligence},
+		Skills:          []string{"skill-medicine", "skill-insight"},
+		Equipment:       []string{"mace", "scale-mail", "light-crossbow", "priests-pack", "shield"},
+		DivineDomain:    "life",
+	},
+	"druid": {
+		RaceKey:         "human",
+		BackgroundKey:   "hermit",
+		AbilityPriority: []shared.Attribute{shared.AttributeWisdom, shared.AttributeConstitution, shared.AttributeDexterity, shared.AttributeIntelligence, shared.AttributeCharisma, shared.AttributeStrength},
+		Skills:          []string{"skill-perception", "skill-animal-handling"},
+		Equipment:       []string{"shield", "scimitar", "leather-armor", "explorers-pack"},
+		Cantrips:        []string{"druidcraft", "produce-flame"},
+	},
+	"fighter": {
+		RaceKey:         "human",
+		BackgroundKey:   "soldier",
+		AbilityPriority: []shared.Attribute{shared.AttributeStrength, shared.AttributeConstitution, shared.AttributeDexterity, shared.AttributeWisdom, shared.AttributeCharisma, shared.AttributeIntelligence},
+		Skills:          []string{"skill-athletics", "skill-perception"},
+		Equipment:       []string{"chain-mail", "longsword", "shield", "light-crossbow", "dungeoneers-pack"},
+		FightingStyle:   "defense",
+	},
+	"monk": {
+		RaceKey:         "elf",
+		BackgroundKey:   "hermit",
+		AbilityPriority: []shared.Attribute{shared.AttributeDexterity, shared.AttributeWisdom, shared.AttributeConstitution, shared.AttributeStrength, shared.AttributeIntelligence, shared.AttributeCharisma},
+		Skills:          []string{"skill-acrobatics", "skill-stealth"},
+		Equipment:       []string{"shortsword", "dungeoneers-pack"},
+	},
+	"paladin": {
+		RaceKey:         "dragonborn",
+		BackgroundKey:   "noble",
+		AbilityPriority: []shared.Attribute{shared.AttributeStrength, shared.AttributeCharisma, shared.AttributeConstitution, shared.AttributeWisdom, shared.AttributeDexterity, shared.AttributeIntelligence},
+		Skills:          []string{"skill-athletics", "skill-persuasion"},
+		Equipment:       []string{"longsword", "shield", "javelin", "priests-pack"},
+	},
+	"ranger": {
+		RaceKey:         "elf",
+		BackgroundKey:   "outlander",
+		AbilityPriority: []shared.Attribute{shared.AttributeDexterity, shared.AttributeWisdom, shared.AttributeConstitution, shared.AttributeStrength, shared.AttributeIntelligence, shared.AttributeCharisma},
+		Skills:          []string{"skill-perception", "skill-stealth", "skill-survival"},
+		Equipment:       []string{"scale-mail", "shortsword", "explorers-pack", "longbow"},
+		FavoredEnemy:    "beasts",
+		Terrain:         "forest",
+	},
+	"rogue": {
+		RaceKey:         "halfling",
+		BackgroundKey:   "charlatan",
+		AbilityPriority: []shared.Attribute{shared.AttributeDexterity, shared.AttributeIntelligence, shared.AttributeConstitution, shared.AttributeCharisma, shared.AttributeWisdom, shared.AttributeStrength},
+		Skills:          []string{"skill-stealth", "skill-perception", "skill-acrobatics", "skill-sleight-of-hand"},
+		Expertise:       []string{"skill-stealth", "skill-perception"},
+		Equipment:       []string{"rapier", "shortbow", "burglars-pack"},
+	},
+	"sorcerer": {
+		RaceKey:         "tiefling",
+		BackgroundKey:   "hermit",
+		AbilityPriority: []shared.Attribute{shared.AttributeCharisma, shared.AttributeConstitution, shared.AttributeDexterity, shared.AttributeWisdom, shared.AttributeIntelligence, shared.AttributeStrength},
+		Skills:          []string{"skill-arcana", "skill-persuasion"},
+		Equipment:       []string{"light-crossbow", "component-pouch", "dungeoneers-pack"},
+		Subclass:        "draconic",
+		Cantrips:        []string{"light", "prestidigitation", "ray-of-frost", "shocking-grasp"},
+		Spells:          []string{"shield", "magic-missile"},
+	},
+	"warlock": {
+		RaceKey:         "tiefling",
+		BackgroundKey:   "charlatan",
+		AbilityPriority: []shared.Attribute{shared.AttributeCharisma, shared.AttributeConstitution, shared.AttributeDexterity, shared.AttributeWisdom, shared.AttributeIntelligence, shared.AttributeStrength},
+		Skills:          []string{"skill-arcana", "skill-deception"},
+		Equipment:       []string{"light-crossbow", "arcane-focus", "scholars-pack"},
+		Subclass:        "fiend",
+		Cantrips:        []string{"eldritch-blast", "chill-touch"},
+		Spells:          []string{"ray-of-sickness", "witch-bolt"},
+	},
+	"wizard": {
+		RaceKey:         "gnome",
+		BackgroundKey:   "sage",
+		AbilityPriority: []shared.Attribute{shared.AttributeIntelligence, shared.AttributeConstitution, shared.AttributeDexterity, shared.AttributeWisdom, shared.AttributeCharisma, shared.AttributeStrength},
+		Skills:          []string{"skill-arcana", "skill-investigation"},
+		Equipment:       []string{"quarterstaff", "component-pouch", "scholars-pack"},
+		Subclass:        "evocation",
+		Cantrips:        []string{"mage-hand", "light", "ray-of-frost"},
+		Spells:          []string{"burning-hands", "charm-person", "feather-fall", "mage-armor", "magic-missile", "sleep"},
+	},
+}
+
+// GetQuickBuild returns the quick build for a class
+func GetQuickBuild(classKey string) (*QuickBuild, bool) {
+	build, ok := quickBuilds[classKey]
+	if !ok {
+		return nil, false
+	}
+	result := *build
+	result.ClassKey = classKey
+	return &result, true
+}
+
+// QuickBuildClasses returns the keys of every class with a quick build
+func QuickBuildClasses() []string {
+	return []string{
+		"barbarian", "bard", "cleric", "druid", "fighter", "monk",
+		"paladin", "ranger", "rogue", "sorcerer", "warlock", "wizard",
+	}
+}
+
+// characterNames are suggested names for generated characters, by race
+var characterNames = map[string][]string{
+	"dragonborn": {"Arjhan", "Balasar", "Kava", "Medrash", "Sora", "Thava"},
+	"dwarf":      {"Bruenor", "Dagnal", "Eberk", "Gunnloda", "Helja", "Vistra"},
+	"elf":        {"Adran", "Aelar", "Birel", "Keyleth", "Naivara", "Thia"},
+	"gnome":      {"Alston", "Boddynock", "Carlin", "Ellyjobell", "Nissa", "Orla"},
+	"half-elf":   {"Aramil", "Corrin", "Enna", "Lia", "Peren", "Tessa"},
+	"half-orc":   {"Dench", "Feng", "Holg", "Baggi", "Ovak", "Volen"},
+	"halfling":   {"Alton", "Cade", "Lavinia", "Merric", "Seraphina", "Wellby"},
+	"human":      {"Bram", "Darvin", "Helm", "Jhessail", "Kethra", "Rowan"},
+	"tiefling":   {"Akmenos", "Damakos", "Kallista", "Lerissa", "Mordai", "Orianna"},
+}
+
+// CharacterNames returns suggested names for a race, falling back to human names
+func CharacterNames(raceKey string) []string {
+	if names, ok := characterNames[raceKey]; ok {
+		return names
+	}
+	return characterNames["human"]
+}
@@ -0,0 +1,30 @@
+package rulebook
+
+import (
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuickBuilds_RankEveryAbilityOnce(t *testing.T) {
+	for _, classKey := range QuickBuildClasses() {
+		quick, ok := GetQuickBuild(classKey)
+		require.True(t, ok, classKey)
+		assert.Equal(t, classKey, quick.ClassKey)
+		assert.NotEmpty(t, quick.RaceKey, classKey)
+		assert.NotEmpty(t, quick.BackgroundKey, classKey)
+		assert.ElementsMatch(t, shared.Attributes, quick.AbilityPriority, classKey)
+	}
+}
+
+func TestGetQuickBuild_Unknown(t *testing.T) {
+	_, ok := GetQuickBuild("artificer")
+	assert.False(t, ok)
+}
+
+func TestCharacterNames_FallsBackToHuman(t *testing.T) {
+	assert.Equal(t, CharacterNames("human"), CharacterNames("aarakocra"))
+	assert.NotEmpty(t, CharacterNames("tiefling"))
+}
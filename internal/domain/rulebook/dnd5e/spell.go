@@ -24,6 +24,7 @@ type Spell struct {
 	Description   string                   `json:"description"`
 	HigherLevel   string                   `json:"higher_level,omitempty"`
 	Classes       []string                 `json:"classes"`
+	AttackType    string                   `json:"attack_type,omitempty"` // SpellAttackRanged, SpellAttackMelee or "" for none
 	Damage        *SpellDamage             `json:"damage,omitempty"`
 	DC            *SpellDC                 `json:"dc,omitempty"`
 	AreaOfEffect  *SpellAreaOfEffect       `json:"area_of_effect,omitempty"`
@@ -32,8 +33,53 @@ type Spell struct {
 
 // SpellDamage represents spell damage information
 type SpellDamage struct {
-	DamageType    string         `json:"damage_type"`
-	DamageAtLevel map[int]string `json:"damage_at_level"` // Key: spell slot level, Value: damage dice
+	DamageType             string         `json:"damage_type"`
+	DamageAtLevel          map[int]string `json:"damage_at_level"`                     // Key: spell slot level, Value: damage dice
+	DamageAtCharacterLevel map[int]string `json:"damage_at_character_level,omitempty"` // Cantrips: key is the character level the dice apply from
+}
+
+// Spell attack types
+const (
+	SpellAttackRanged = "ranged"
+	SpellAttackMelee  = "melee"
+)
+
+// Casting times that fit in a combat turn
+const (
+	CastingTimeAction      = "1 action"
+	CastingTimeBonusAction = "1 bonus action"
+	CastingTimeReaction    = "1 reaction"
+)
+
+// DiceAt returns the damage dice for a cast. Leveled spells use the slot level
+// and cantrips use the caster's character level. Falls back to the closest
+// lower entry when the exact level isn't listed.
+func (d *SpellDamage) DiceAt(slotLevel, characterLevel int) string {
+	if d == nil {
+		return ""
+	}
+	if len(d.DamageAtCharacterLevel) > 0 {
+		return closestAtOrBelow(d.DamageAtCharacterLevel, characterLevel)
+	}
+	return closestAtOrBelow(d.DamageAtLevel, slotLevel)
+}
+
+func closestAtOrBelow(values map[int]string, level int) string {
+	best, result := 0, ""
+	for key, value := range values {
+		if key <= level && key > best {
+			best, result = key, value
+		}
+	}
+	if result == "" {
+		// Nothing at or below: use the lowest listed entry
+		for key, value := range values {
+			if result == "" || key < best {
+				best, result = key, value
+			}
+		}
+	}
+	return result
 }
 
 // SpellDC represents spell save DC information
@@ -0,0 +1,134 @@
+package rulebook
+
+// CasterProgression describes how a class gains spell slots
+type CasterProgression string
+
+const (
+	CasterProgressionNone  CasterProgression = ""
+	CasterProgressionFull  CasterProgression = "full"
+	CasterProgressionHalf  CasterProgression = "half"
+	CasterProgressionThird CasterProgression = "third"
+	CasterProgressionPact  CasterProgression = "pact"
+)
+
+// Spell slot sources stored on shared.SpellSlotInfo
+const (
+	SpellSlotSourceSpellcasting = "spellcasting"
+	SpellSlotSourcePactMagic    = "pact_magic"
+)
+
+// fullCasterSlots is the PHB spellcasting table, indexed by caster level.
+// Each row lists slots for spell levels 1-9. Half and third casters use
+// the same table at a reduced caster level.
+var fullCasterSlots = [MaxLevel + 1][9]int{
+	{},
+	{2},
+	{3},
+	{4, 2},
+	{4, 3},
+	{4, 3, 2},
+	{4, 3, 3},
+	{4, 3, 3, 1},
+	{4, 3, 3, 2},
+	{4, 3, 3, 3, 1},
+	{4, 3, 3, 3, 2},
+	{4, 3, 3, 3, 2, 1},
+	{4, 3, 3, 3, 2, 1},
+	{4, 3, 3, 3, 2, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1, 1},
+	{4, 3, 3, 3, 3, 1, 1, 1, 1},
+	{4, 3, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 3, 2, 2, 1, 1},
+}
+
+// thirdCasterSubclasses are the subclasses that grant third-caster spellcasting
+var thirdCasterSubclasses = map[string]string{
+	"eldritch-knight":  "fighter",
+	"arcane-trickster": "rogue",
+}
+
+// SpellListClass returns the class whose spell list a class and subclass cast
+// from. Eldritch knights and arcane tricksters learn wizard spells.
+func SpellListClass(classKey, subclassKey string) string {
+	if thirdCasterSubclasses[subclassKey] == classKey {
+		return "wizard"
+	}
+	return classKey
+}
+
+// GetCasterProgression returns the spell slot progression for a class and subclass
+func GetCasterProgression(classKey, subclassKey string) CasterProgression {
+	switch classKey {
+	case "bard", "cleric", "druid", "sorcerer", "wizard":
+		return CasterProgressionFull
+	case "paladin", "ranger":
+		return CasterProgressionHalf
+	case "warlock":
+		return CasterProgressionPact
+	}
+	if thirdCasterSubclasses[subclassKey] == classKey {
+		return CasterProgressionThird
+	}
+	return CasterProgressionNone
+}
+
+// CasterLevel converts a class level into a level on the full caster table.
+// Half casters start at 2nd level and third casters at 3rd, rounding up.
+func CasterLevel(progression CasterProgression, level int) int {
+	switch progression {
+	case CasterProgressionFull:
+		return level
+	case CasterProgressionHalf:
+		if level < 2 {
+			return 0
+		}
+		return (level + 1) / 2
+	case CasterProgressionThird:
+		if level < 3 {
+			return 0
+		}
+		return (level + 2) / 3
+	}
+	return 0
+}
+
+// SpellSlotsForCasterLevel returns slots per spell level from the full caster table
+func SpellSlotsForCasterLevel(casterLevel int) map[int]int {
+	casterLevel = min(casterLevel, MaxLevel)
+	slots := make(map[int]int)
+	if casterLevel < 1 {
+		return slots
+	}
+	for i, count := range fullCasterSlots[casterLevel] {
+		if count > 0 {
+			slots[i+1] = count
+		}
+	}
+	return slots
+}
+
+// SpellSlots returns slots per spell level for a class progression at a class level.
+// Pact magic is not included; see PactMagicSlots.
+func SpellSlots(progression CasterProgression, level int) map[int]int {
+	return SpellSlotsForCasterLevel(CasterLevel(progression, level))
+}
+
+// PactMagicSlots returns a warlock's slot count and the level they are cast at
+func PactMagicSlots(level int) (slots, slotLevel int) {
+	switch {
+	case level < 1:
+		return 0, 0
+	case level == 1:
+		return 1, 1
+	case level >= 17:
+		slots = 4
+	case level >= 11:
+		slots = 3
+	default:
+		slots = 2
+	}
+	return slots, min((level+1)/2, 5)
+}
@@ -0,0 +1,120 @@
+package rulebook
+
+import (
+	"fmt"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+)
+
+// SpellcastingAbility returns the ability a class casts with, or "" for non-casters
+func SpellcastingAbility(classKey, subclassKey string) shared.Attribute {
+	switch classKey {
+	case "wizard":
+		return shared.AttributeIntelligence
+	case "cleric", "druid", "ranger":
+		return shared.AttributeWisdom
+	case "bard", "paladin", "sorcerer", "warlock":
+		return shared.AttributeCharisma
+	}
+	if GetCasterProgression(classKey, subclassKey) == CasterProgressionThird {
+		return shared.AttributeIntelligence
+	}
+	return ""
+}
+
+// cantripDamageDice is the single-die damage of the PHB damage cantrips. The API
+// only lists slot-level damage, so cantrip scaling is filled in from here.
+var cantripDamageDice = map[string]string{
+	"acid-splash":     "1d6",
+	"chill-touch":     "1d8",
+	"eldritch-blast":  "1d10",
+	"fire-bolt":       "1d10",
+	"poison-spray":    "1d12",
+	"produce-flame":   "1d8",
+	"ray-of-frost":    "1d8",
+	"sacred-flame":    "1d8",
+	"shocking-grasp":  "1d8",
+	"vicious-mockery": "1d4",
+}
+
+// healingSpellDice is the die the PHB healing spells roll per slot level.
+// The API doesn't list healing, so it comes from here.
+var healingSpellDice = map[string]int{
+	"cure-wounds":  8,
+	"healing-word": 4,
+}
+
+// HealingDie returns the sides of the die a healing spell rolls per slot
+// level, or 0 if the spell doesn't heal
+func HealingDie(spellKey string) int {
+	return healingSpellDice[spellKey]
+}
+
+// cantripScalingLevels are the character levels where cantrips gain a die
+var cantripScalingLevels = []int{1, 5, 11, 17}
+
+// CantripDamageAtCharacterLevel returns the damage progression for a damage
+// cantrip, keyed by character level, or nil if the cantrip deals no damage
+func CantripDamageAtCharacterLevel(spellKey string) map[int]string {
+	base, ok := cantripDamageDice[spellKey]
+	if !ok {
+		return nil
+	}
+
+	var sides int
+	if _, err := fmt.Sscanf(base, "1d%d", &sides); err != nil {
+		return nil
+	}
+
+	result := make(map[int]string, len(cantripScalingLevels))
+	for i, level := range cantripScalingLevels {
+		result[level] = fmt.Sprintf("%dd%d", i+1, sides)
+	}
+	return result
+}
+
+// PreparesSpells reports whether a class prepares its spells each day
+// rather than knowing a fixed list
+func PreparesSpells(classKey string) bool {
+	switch classKey {
+	case "cleric", "druid", "paladin", "wizard":
+		return true
+	}
+	return false
+}
+
+// PreparedSpellLimit returns how many spells a class can prepare: the
+// spellcasting ability modifier plus the class level (half the level for
+// paladins), minimum one. Returns 0 before the class can cast spells.
+func PreparedSpellLimit(classKey string, level, abilityModifier int) int {
+	if !PreparesSpells(classKey) || MaxSpellLevel(classKey, "", level) == 0 {
+		return 0
+	}
+	if classKey == "paladin" {
+		level /= 2
+	}
+	return max(abilityModifier+level, 1)
+}
+
+// CanCastRituals reports whether a class has the Ritual Casting feature
+func CanCastRituals(classKey string) bool {
+	switch classKey {
+	case "bard", "cleric", "druid", "wizard":
+		return true
+	}
+	return false
+}
+
+// Copying a spell into a spellbook costs 2 hours and 50 gp per spell level
+const (
+	SpellbookCopyHoursPerLevel = 2
+	SpellbookCopyGoldPerLevel  = 50
+)
+
+// SpellbookCopyCost returns the gold and hours it takes a wizard to copy a spell
+func SpellbookCopyCost(spellLevel int) (gold, hours int) {
+	return spellLevel * SpellbookCopyGoldPerLevel, spellLevel * SpellbookCopyHoursPerLevel
+}
+
+// RitualCastingMinutes is the extra time a spell takes when cast as a ritual
+const RitualCastingMinutes = 10
@@ -0,0 +1,59 @@
+package spells
+
+import (
+	"context"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	spellService "github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
+)
+
+// HealingHandler restores hit points to one creature. It rolls the spell's
+// healing die once per slot level, so upcasting adds a die per level, and
+// adds the caster's spellcasting modifier.
+type HealingHandler struct {
+	key string
+}
+
+// NewHealingHandler creates a handler for a spell with a rulebook healing die
+func NewHealingHandler(spellKey string) *HealingHandler {
+	return &HealingHandler{key: spellKey}
+}
+
+// Key returns the spell key
+func (h *HealingHandler) Key() string {
+	return h.key
+}
+
+// Heals lets the spell target creatures at 0 hit points
+func (h *HealingHandler) Heals() bool {
+	return true
+}
+
+// Resolve rolls the healing for the target
+func (h *HealingHandler) Resolve(ctx context.Context, cast *spellService.Cast) (*spellService.CastSpellResult, error) {
+	if len(cast.Targets) != 1 {
+		return nil, dnderr.InvalidArgumentf("%s heals one creature", cast.Spell.Name)
+	}
+
+	modifier := 0
+	if ability := cast.Caster.Attributes[cast.Caster.SpellcastingAbilityFor(cast.Spell)]; ability != nil {
+		modifier = ability.Bonus
+	}
+
+	roll, err := cast.Roller.Roll(max(cast.SlotLevel, 1), rulebook.HealingDie(h.key), modifier)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to roll %s healing", cast.Spell.Name)
+	}
+
+	target := cast.Targets[0]
+	return &spellService.CastSpellResult{
+		SpellKey:  cast.Spell.Key,
+		SpellName: cast.Spell.Name,
+		Targets: []*spellService.TargetResult{{
+			CombatantID: target.ID,
+			Name:        target.Name,
+			Healing:     max(roll.Total, 1),
+		}},
+	}, nil
+}
@@ -0,0 +1,73 @@
+package spells
+
+import (
+	"context"
+	"testing"
+
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	spellService "github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealingHandler_Resolve(t *testing.T) {
+	tests := []struct {
+		name        string
+		handler     *HealingHandler
+		slotLevel   int
+		targets     int
+		rolls       []int
+		wantHealing int
+		wantErr     bool
+	}{
+		{
+			name:        "cure wounds adds the spellcasting modifier",
+			handler:     NewHealingHandler(shared.SpellKeyCureWounds),
+			slotLevel:   1,
+			targets:     1,
+			rolls:       []int{5},
+			wantHealing: 8,
+		},
+		{
+			name:        "upcast healing word rolls a die per slot level",
+			handler:     NewHealingHandler(shared.SpellKeyHealingWord),
+			slotLevel:   3,
+			targets:     1,
+			rolls:       []int{1, 2, 4},
+			wantHealing: 10,
+		},
+		{
+			name:      "heals only one creature",
+			handler:   NewHealingHandler(shared.SpellKeyCureWounds),
+			slotLevel: 1,
+			targets:   2,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roller := mockdice.NewManualMockRoller()
+			roller.SetRolls(tt.rolls)
+
+			result, err := tt.handler.Resolve(context.Background(), &spellService.Cast{
+				Caster:    createCaster("cleric", shared.AttributeWisdom, 16),
+				Spell:     &rulebook.Spell{Key: tt.handler.Key(), Name: "Healing", Level: 1, Classes: []string{"cleric"}},
+				SlotLevel: tt.slotLevel,
+				Targets:   createTargets(tt.targets),
+				Roller:    roller,
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, result.Targets, 1)
+			assert.Equal(t, tt.wantHealing, result.Targets[0].Healing)
+			assert.Zero(t, result.Targets[0].Damage)
+		})
+	}
+}
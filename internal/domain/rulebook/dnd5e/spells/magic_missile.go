@@ -0,0 +1,63 @@
+package spells
+
+import (
+	"context"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	spellService "github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
+)
+
+// MagicMissileHandler fires glowing darts that hit automatically.
+// Each dart deals 1d4+1 force damage; casting above 1st level adds a dart per level.
+type MagicMissileHandler struct{}
+
+// NewMagicMissileHandler creates a new magic missile handler
+func NewMagicMissileHandler() *MagicMissileHandler {
+	return &MagicMissileHandler{}
+}
+
+// Key returns the spell key
+func (m *MagicMissileHandler) Key() string {
+	return shared.SpellKeyMagicMissile
+}
+
+// Resolve splits the darts across the targets, extra darts going to the first targets
+func (m *MagicMissileHandler) Resolve(ctx context.Context, cast *spellService.Cast) (*spellService.CastSpellResult, error) {
+	darts := 2 + max(cast.SlotLevel, 1)
+	if len(cast.Targets) == 0 {
+		return nil, dnderr.InvalidArgument("Magic Missile needs a target")
+	}
+	if len(cast.Targets) > darts {
+		return nil, dnderr.InvalidArgumentf("Magic Missile only has %d darts", darts)
+	}
+
+	result := &spellService.CastSpellResult{
+		SpellKey:   cast.Spell.Key,
+		SpellName:  cast.Spell.Name,
+		DamageType: "Force",
+	}
+
+	perTarget := darts / len(cast.Targets)
+	extra := darts % len(cast.Targets)
+	for i, target := range cast.Targets {
+		count := perTarget
+		if i < extra {
+			count++
+		}
+
+		roll, err := cast.Roller.Roll(count, 4, count)
+		if err != nil {
+			return nil, dnderr.Wrap(err, "failed to roll magic missile damage")
+		}
+
+		result.Targets = append(result.Targets, &spellService.TargetResult{
+			CombatantID: target.ID,
+			Name:        target.Name,
+			Hit:         true,
+			Damage:      roll.Total,
+		})
+	}
+
+	return result, nil
+}
@@ -1,177 +1,147 @@
-//go:build ignore
-// +build ignore
-
 package spells
 
 import (
 	"context"
 	"testing"
 
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
-	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/events"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	spellService "github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-// mockDiceRoller for testing
-type mockDiceRoller struct {
-	result int
-}
-
-func (m *mockDiceRoller) Roll(numDice, sides, modifier int) (struct{ Total int }, error) {
-	// For 1d4+1, return consistent value
-	return struct{ Total int }{Total: m.result}, nil
-}
-
-// mockCharacterService for testing
-type mockCharacterService struct {
-	characters map[string]*character.Character
-}
-
-func (m *mockCharacterService) UpdateEquipment(char *character.Character) error {
-	return nil
+func createCaster(classKey string, attribute shared.Attribute, score int) *character.Character {
+	caster := &character.Character{
+		Name:  "Caster",
+		Level: 1,
+		Class: &rulebook.Class{Key: classKey},
+	}
+	caster.AddAttribute(attribute, score)
+	return caster
 }
 
-func (m *mockCharacterService) GetByID(id string) (*character.Character, error) {
-	if char, ok := m.characters[id]; ok {
-		return char, nil
+func createTargets(count int) []*combat.Combatant {
+	targets := make([]*combat.Combatant, count)
+	for i := range targets {
+		targets[i] = &combat.Combatant{
+			ID:        string(rune('a' + i)),
+			Name:      "Goblin",
+			CurrentHP: 7,
+			AC:        15,
+			Abilities: map[string]int{"WIS": 8},
+		}
 	}
-	return nil, nil
+	return targets
 }
 
-func TestMagicMissileHandler_Execute(t *testing.T) {
+func TestMagicMissileHandler_Resolve(t *testing.T) {
 	tests := []struct {
-		name           string
-		caster         *character.Character
-		input          *SpellInput
-		diceResult     int
-		expectSuccess  bool
-		expectDamage   int
-		expectMissiles int
+		name        string
+		slotLevel   int
+		targets     int
+		rolls       []int
+		wantDamages []int
+		wantErr     bool
 	}{
 		{
-			name: "level 1 magic missile single target",
-			caster: &character.Character{
-				ID:    "wizard-123",
-				Name:  "Gandalf",
-				Level: 5,
-			},
-			input: &SpellInput{
-				SpellLevel: 1,
-				TargetIDs:  []string{"goblin-1"},
-			},
-			diceResult:     3, // 1d4+1 = 3
-			expectSuccess:  true,
-			expectDamage:   9, // 3 missiles * 3 damage
-			expectMissiles: 3,
-		},
-		{
-			name: "level 3 magic missile two targets",
-			caster: &character.Character{
-				ID:    "wizard-123",
-				Name:  "Gandalf",
-				Level: 5,
-			},
-			input: &SpellInput{
-				SpellLevel: 3,
-				TargetIDs:  []string{"goblin-1", "goblin-2"},
-			},
-			diceResult:     4, // 1d4+1 = 4
-			expectSuccess:  true,
-			expectDamage:   20, // 5 missiles * 4 damage (3 + 2 split)
-			expectMissiles: 5,  // 3 + 2 extra for level 3
+			name:        "three darts at one target",
+			slotLevel:   1,
+			targets:     1,
+			rolls:       []int{1, 2, 3},
+			wantDamages: []int{9},
 		},
 		{
-			name: "invalid spell level",
-			caster: &character.Character{
-				ID:   "wizard-123",
-				Name: "Gandalf",
-			},
-			input: &SpellInput{
-				SpellLevel: 10,
-				TargetIDs:  []string{"goblin-1"},
-			},
-			expectSuccess: false,
+			name:        "upcast darts split across targets",
+			slotLevel:   3,
+			targets:     2,
+			rolls:       []int{4, 4, 4, 1, 1},
+			wantDamages: []int{15, 4},
 		},
 		{
-			name: "no targets",
-			caster: &character.Character{
-				ID:   "wizard-123",
-				Name: "Gandalf",
-			},
-			input: &SpellInput{
-				SpellLevel: 1,
-				TargetIDs:  []string{},
-			},
-			expectSuccess: false,
+			name:      "more targets than darts",
+			slotLevel: 1,
+			targets:   4,
+			wantErr:   true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Create event bus
-			eventBus := events.NewEventBus()
-
-			// Track emitted events
-			var spellCastEvent *events.GameEvent
-			var spellDamageEvents []*events.GameEvent
-
-			// Subscribe to events
-			eventBus.Subscribe(events.OnSpellCast, &testEventListener{
-				handleFunc: func(event *events.GameEvent) error {
-					spellCastEvent = event
-					return nil
-				},
-			})
-			eventBus.Subscribe(events.OnSpellDamage, &testEventListener{
-				handleFunc: func(event *events.GameEvent) error {
-					spellDamageEvents = append(spellDamageEvents, event)
-					return nil
-				},
+			roller := mockdice.NewManualMockRoller()
+			roller.SetRolls(tt.rolls)
+
+			result, err := NewMagicMissileHandler().Resolve(context.Background(), &spellService.Cast{
+				Caster:    createCaster("wizard", shared.AttributeIntelligence, 16),
+				Spell:     &rulebook.Spell{Key: shared.SpellKeyMagicMissile, Name: "Magic Missile", Level: 1},
+				SlotLevel: tt.slotLevel,
+				Targets:   createTargets(tt.targets),
+				Roller:    roller,
 			})
-
-			// Create handler
-			handler := NewMagicMissileHandler(eventBus)
-			handler.SetDiceRoller(&mockDiceRoller{result: tt.diceResult})
-			handler.SetCharacterService(&mockCharacterService{
-				characters: map[string]*character.Character{
-					"goblin-1": {ID: "goblin-1", Name: "Goblin 1"},
-					"goblin-2": {ID: "goblin-2", Name: "Goblin 2"},
-				},
-			})
-
-			// Execute
-			result, err := handler.Execute(context.Background(), tt.caster, tt.input)
-
-			// Verify
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
 			require.NoError(t, err)
-			assert.Equal(t, tt.expectSuccess, result.Success)
 
-			if tt.expectSuccess {
-				assert.Equal(t, tt.expectDamage, result.TotalDamage)
-				assert.Equal(t, tt.input.SpellLevel, result.SpellSlotUsed)
-
-				// Check spell cast event
-				assert.NotNil(t, spellCastEvent)
-				level, _ := spellCastEvent.GetIntContext(events.ContextSpellLevel)
-				assert.Equal(t, tt.input.SpellLevel, level)
-
-				// Check damage events
-				assert.Len(t, spellDamageEvents, len(tt.input.TargetIDs))
+			require.Len(t, result.Targets, len(tt.wantDamages))
+			for i, damage := range tt.wantDamages {
+				assert.True(t, result.Targets[i].Hit)
+				assert.Equal(t, damage, result.Targets[i].Damage)
 			}
 		})
 	}
 }
 
-// testEventListener is a simple event listener for testing
-type testEventListener struct {
-	handleFunc func(event *events.GameEvent) error
-}
+func TestViciousMockeryHandler_Resolve(t *testing.T) {
+	spell := &rulebook.Spell{
+		Key:  shared.SpellKeyViciousMockery,
+		Name: "Vicious Mockery",
+		Damage: &rulebook.SpellDamage{
+			DamageType:             "Psychic",
+			DamageAtCharacterLevel: rulebook.CantripDamageAtCharacterLevel(shared.SpellKeyViciousMockery),
+		},
+	}
 
-func (t *testEventListener) HandleEvent(event *events.GameEvent) error {
-	return t.handleFunc(event)
-}
+	t.Run("failed save deals damage and imposes disadvantage", func(t *testing.T) {
+		roller := mockdice.NewManualMockRoller()
+		roller.SetRolls([]int{3, 5}) // 1d4 damage, then the save: 5 - 1 vs DC 13
+
+		result, err := NewViciousMockeryHandler().Resolve(context.Background(), &spellService.Cast{
+			Caster:  createCaster("bard", shared.AttributeCharisma, 16),
+			Spell:   spell,
+			Targets: createTargets(1),
+			Roller:  roller,
+		})
+		require.NoError(t, err)
+
+		target := result.Targets[0]
+		assert.False(t, target.Saved)
+		assert.Equal(t, 13, target.SaveDC)
+		assert.Equal(t, 3, target.Damage)
+		require.NotNil(t, target.Effect)
+		assert.Equal(t, ViciousMockeryEffectName, target.Effect.Name)
+		assert.Equal(t, shared.ModifierTypeDisadvantage, target.Effect.Modifiers[0].Type)
+	})
+
+	t.Run("successful save negates everything", func(t *testing.T) {
+		roller := mockdice.NewManualMockRoller()
+		roller.SetRolls([]int{3, 18})
+
+		result, err := NewViciousMockeryHandler().Resolve(context.Background(), &spellService.Cast{
+			Caster:  createCaster("bard", shared.AttributeCharisma, 16),
+			Spell:   spell,
+			Targets: createTargets(1),
+			Roller:  roller,
+		})
+		require.NoError(t, err)
 
-func (t *testEventListener) Priority() int {
-	return 0
+		target := result.Targets[0]
+		assert.True(t, target.Saved)
+		assert.Zero(t, target.Damage)
+		assert.Nil(t, target.Effect)
+	})
 }
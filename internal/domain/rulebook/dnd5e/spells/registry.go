@@ -0,0 +1,16 @@
+package spells
+
+import (
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	spellService "github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
+)
+
+// RegisterAll registers the D&D 5e spells that need more than the generic resolver
+func RegisterAll(registry interface {
+	RegisterHandler(handler spellService.Handler)
+}) {
+	registry.RegisterHandler(NewMagicMissileHandler())
+	registry.RegisterHandler(NewViciousMockeryHandler())
+	registry.RegisterHandler(NewHealingHandler(shared.SpellKeyCureWounds))
+	registry.RegisterHandler(NewHealingHandler(shared.SpellKeyHealingWord))
+}
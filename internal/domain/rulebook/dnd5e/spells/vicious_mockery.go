@@ -0,0 +1,59 @@
+package spells
+
+import (
+	"context"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	spellService "github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
+)
+
+// ViciousMockeryEffectName is the effect the encounter service checks for
+// when rolling a monster's attack
+const ViciousMockeryEffectName = "Vicious Mockery Disadvantage"
+
+// ViciousMockeryHandler deals psychic damage on a failed Wisdom save and gives
+// the target disadvantage on its next attack roll
+type ViciousMockeryHandler struct{}
+
+// NewViciousMockeryHandler creates a new vicious mockery handler
+func NewViciousMockeryHandler() *ViciousMockeryHandler {
+	return &ViciousMockeryHandler{}
+}
+
+// Key returns the spell key
+func (v *ViciousMockeryHandler) Key() string {
+	return shared.SpellKeyViciousMockery
+}
+
+// Resolve uses the generic save resolution, then curses targets that failed
+func (v *ViciousMockeryHandler) Resolve(ctx context.Context, cast *spellService.Cast) (*spellService.CastSpellResult, error) {
+	if cast.Spell.DC == nil {
+		spell := *cast.Spell
+		spell.DC = &rulebook.SpellDC{Type: shared.AttributeWisdom, Success: "none"}
+		cast.Spell = &spell
+	}
+
+	result, err := spellService.Resolve(ctx, cast)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, target := range result.Targets {
+		if target.Saved {
+			continue
+		}
+		target.Effect = &shared.ActiveEffect{
+			Name:         ViciousMockeryEffectName,
+			Description:  "Disadvantage on next attack roll",
+			Source:       "Vicious Mockery",
+			Duration:     1,
+			DurationType: shared.DurationTypeRounds,
+			Modifiers: []shared.Modifier{
+				{Type: shared.ModifierTypeDisadvantage},
+			},
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,136 @@
+package rulebook
+
+// Subclass represents a class specialization (archetype, domain, patron, ...)
+type Subclass struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	ClassKey    string `json:"class_key"`
+	Description string `json:"description"`
+}
+
+// subclasses lists the PHB subclasses for each class. Cleric domains live in
+// divine_domains.go and are added by GetSubclasses.
+var subclasses = map[string][]Subclass{
+	"barbarian": {
+		{Key: "berserker", Name: "Path of the Berserker", Description: "Rage becomes a frenzy that grants an extra attack as a bonus action."},
+		{Key: "totem-warrior", Name: "Path of the Totem Warrior", Description: "Draw on a spirit animal for guidance, protection and inspiration."},
+	},
+	"bard": {
+		{Key: "lore", Name: "College of Lore", Description: "Gain extra proficiencies and Cutting Words to hinder foes."},
+		{Key: "valor", Name: "College of Valor", Description: "Gain medium armor, shields and martial weapons, and inspire in combat."},
+	},
+	"druid": {
+		{Key: "land", Name: "Circle of the Land", Description: "Gain bonus cantrips, circle spells and Natural Recovery."},
+		{Key: "moon", Name: "Circle of the Moon", Description: "Wild Shape into stronger beasts and use it as a bonus action."},
+	},
+	"fighter": {
+		{Key: "champion", Name: "Champion", Description: "Raw physical power: score critical hits on a 19 or 20."},
+		{Key: "battle-master", Name: "Battle Master", Description: "Learn combat maneuvers fueled by superiority dice."},
+		{Key: "eldritch-knight", Name: "Eldritch Knight", Description: "Combine martial mastery with abjuration and evocation magic."},
+	},
+	"monk": {
+		{Key: "open-hand", Name: "Way of the Open Hand", Description: "Flurry of Blows can knock foes prone, push them or stop reactions."},
+		{Key: "shadow", Name: "Way of Shadow", Description: "Spend ki to cast darkness and step between shadows."},
+		{Key: "four-elements", Name: "Way of the Four Elements", Description: "Spend ki to harness elemental disciplines."},
+	},
+	"paladin": {
+		{Key: "devotion", Name: "Oath of Devotion", Description: "Uphold justice, virtue and order. Sacred Weapon and Turn the Unholy."},
+		{Key: "ancients", Name: "Oath of the Ancients", Description: "Preserve light and life. Nature's Wrath and Turn the Faithless."},
+		{Key: "vengeance", Name: "Oath of Vengeance", Description: "Punish grievous wrongs. Abjure Enemy and Vow of Enmity."},
+	},
+	"ranger": {
+		{Key: "hunter", Name: "Hunter", Description: "Specialize in bringing down specific kinds of prey."},
+		{Key: "beast-master", Name: "Beast Master", Description: "Bond with an animal companion that fights alongside you."},
+	},
+	"rogue": {
+		{Key: "thief", Name: "Thief", Description: "Fast Hands and Second-Story Work for burglary and agility."},
+		{Key: "assassin", Name: "Assassin", Description: "Deadly ambushes: advantage and automatic crits on surprised foes."},
+		{Key: "arcane-trickster", Name: "Arcane Trickster", Description: "Enhance stealth and agility with enchantment and illusion spells."},
+	},
+	"sorcerer": {
+		{Key: "draconic", Name: "Draconic Bloodline", Description: "Draconic resilience and elemental affinity from a dragon ancestor."},
+		{Key: "wild-magic", Name: "Wild Magic", Description: "Chaotic magic that can surge unpredictably when you cast."},
+	},
+	"warlock": {
+		{Key: "archfey", Name: "The Archfey", Description: "A lord or lady of the fey. Fey Presence to charm or frighten."},
+		{Key: "fiend", Name: "The Fiend", Description: "A being of the lower planes. Gain temporary hit points when you drop foes."},
+		{Key: "great-old-one", Name: "The Great Old One", Description: "An unknowable entity. Gain telepathy with nearby creatures."},
+	},
+	"wizard": {
+		{Key: "abjuration", Name: "School of Abjuration", Description: "Protective magic and an Arcane Ward that absorbs damage."},
+		{Key: "conjuration", Name: "School of Conjuration", Description: "Summon objects and creatures and teleport short distances."},
+		{Key: "divination", Name: "School of Divination", Description: "Portent lets you replace rolls with dice rolled at dawn."},
+		{Key: "enchantment", Name: "School of Enchantment", Description: "Beguile and charm others with a Hypnotic Gaze."},
+		{Key: "evocation", Name: "School of Evocation", Description: "Sculpt spells around allies and empower evocation damage."},
+		{Key: "illusion", Name: "School of Illusion", Description: "Improved minor illusions that grow more real over time."},
+		{Key: "necromancy", Name: "School of Necromancy", Description: "Grim Harvest heals you when your spells kill."},
+		{Key: "transmutation", Name: "School of Transmutation", Description: "Alter matter and carry a Transmuter's Stone."},
+	},
+}
+
+// GetSubclasses returns the subclasses available to a class
+func GetSubclasses(classKey string) []Subclass {
+	if classKey == "cleric" {
+		domains := GetDivineDomains()
+		result := make([]Subclass, len(domains))
+		for i, domain := range domains {
+			result[i] = Subclass{
+				Key:         domain.Key,
+				Name:        domain.Name,
+				ClassKey:    classKey,
+				Description: domain.Description,
+			}
+		}
+		return result
+	}
+
+	list := subclasses[classKey]
+	result := make([]Subclass, len(list))
+	for i, subclass := range list {
+		subclass.ClassKey = classKey
+		result[i] = subclass
+	}
+	return result
+}
+
+// GetSubclass returns a class's subclass by key
+func GetSubclass(classKey, subclassKey string) (Subclass, bool) {
+	for _, subclass := range GetSubclasses(classKey) {
+		if subclass.Key == subclassKey {
+			return subclass, true
+		}
+	}
+	return Subclass{}, false
+}
+
+var heavyArmor = &Proficiency{Key: "heavy-armor", Name: "Heavy Armor", Type: ProficiencyTypeArmor}
+
+// subclassProficiencies lists the bonus proficiencies a subclass grants when chosen
+var subclassProficiencies = map[string][]*Proficiency{
+	"life":     {heavyArmor},
+	"nature":   {heavyArmor},
+	"tempest":  {martialWeapons, heavyArmor},
+	"war":      {martialWeapons, heavyArmor},
+	"valor":    {mediumArmor, shields, martialWeapons},
+	"assassin": {backgroundTool("disguise-kit", "Disguise Kit"), backgroundTool("poisoners-kit", "Poisoner's Kit")},
+}
+
+// GetSubclassProficiencies returns copies of the bonus proficiencies a
+// subclass grants
+func GetSubclassProficiencies(subclassKey string) []*Proficiency {
+	profs := make([]*Proficiency, 0, len(subclassProficiencies[subclassKey]))
+	for _, prof := range subclassProficiencies[subclassKey] {
+		granted := *prof
+		profs = append(profs, &granted)
+	}
+	return profs
+}
+
+// SubclassHitPointsPerLevel returns the extra max HP a subclass grants for
+// each level in its class, like the draconic sorcerer's Draconic Resilience
+func SubclassHitPointsPerLevel(subclassKey string) int {
+	if subclassKey == "draconic" {
+		return 1
+	}
+	return 0
+}
@@ -29,6 +29,12 @@ const (
 
 	// Wizard abilities
 	AbilityKeyArcaneRecovery = "arcane-recovery"
+
+	// Subclass abilities
+	AbilityKeyFrenzy          = "frenzy"
+	AbilityKeySuperiorityDice = "superiority-dice"
+	AbilityKeySacredWeapon    = "sacred-weapon"
+	AbilityKeyVowOfEnmity     = "vow-of-enmity"
 )
 
 // Spell key constants
@@ -42,6 +48,7 @@ const (
 	// 1st Level spells
 	SpellKeyMagicMissile = "magic-missile"
 	SpellKeyHealingWord  = "healing-word"
+	SpellKeyCureWounds   = "cure-wounds"
 	SpellKeyCharmPerson  = "charm-person"
 	SpellKeyThunderwave  = "thunderwave"
 	SpellKeyShield       = "shield"
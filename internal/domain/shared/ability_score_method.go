@@ -0,0 +1,47 @@
+package shared
+
+// AbilityScoreMethod is how ability scores are generated during character creation
+type AbilityScoreMethod string
+
+const (
+	AbilityScoreMethodAny           AbilityScoreMethod = ""               // Player's choice
+	AbilityScoreMethodRoll          AbilityScoreMethod = "roll"           // 4d6, drop the lowest
+	AbilityScoreMethodStandardArray AbilityScoreMethod = "standard_array" // 15, 14, 13, 12, 10, 8
+	AbilityScoreMethodPointBuy      AbilityScoreMethod = "point_buy"      // 27 points, scores 8-15
+)
+
+// AbilityScoreMethods lists the methods a player can pick from, in display order
+var AbilityScoreMethods = []AbilityScoreMethod{
+	AbilityScoreMethodRoll,
+	AbilityScoreMethodStandardArray,
+	AbilityScoreMethodPointBuy,
+}
+
+// IsValid checks the method is known. Any is valid.
+func (m AbilityScoreMethod) IsValid() bool {
+	switch m {
+	case AbilityScoreMethodAny, AbilityScoreMethodRoll, AbilityScoreMethodStandardArray, AbilityScoreMethodPointBuy:
+		return true
+	default:
+		return false
+	}
+}
+
+// Allows checks a required method lets players use the given method
+func (m AbilityScoreMethod) Allows(method AbilityScoreMethod) bool {
+	return m == AbilityScoreMethodAny || m == method
+}
+
+// DisplayName returns a human-readable name for the method
+func (m AbilityScoreMethod) DisplayName() string {
+	switch m {
+	case AbilityScoreMethodRoll:
+		return "Roll 4d6"
+	case AbilityScoreMethodStandardArray:
+		return "Standard Array"
+	case AbilityScoreMethodPointBuy:
+		return "Point Buy"
+	default:
+		return "Player's Choice"
+	}
+}
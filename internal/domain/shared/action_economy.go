@@ -10,6 +10,11 @@ type ActionEconomy struct {
 	ReactionUsed    bool `json:"reaction_used"`
 	MovementUsed    int  `json:"movement_used"`
 
+	// Spells cast this turn. Casting a bonus action spell limits any other
+	// spell that turn to a cantrip cast with the action.
+	BonusActionSpellCast bool `json:"bonus_action_spell_cast"`
+	LeveledSpellCast     bool `json:"leveled_spell_cast"`
+
 	// What was done this turn (for triggering bonus actions)
 	ActionsThisTurn []ActionRecord `json:"actions_this_turn"`
 
@@ -39,6 +44,8 @@ func (ae *ActionEconomy) Reset() {
 	ae.ActionUsed = false
 	ae.BonusActionUsed = false
 	ae.MovementUsed = 0
+	ae.BonusActionSpellCast = false
+	ae.LeveledSpellCast = false
 	ae.ActionsThisTurn = []ActionRecord{}
 	ae.AvailableBonusActions = []BonusActionOption{}
 	// Note: ReactionUsed is NOT reset here - reactions reset at start of YOUR turn
@@ -56,7 +63,8 @@ func (ae *ActionEconomy) RecordAction(actionType, subtype, weaponKey string) {
 
 	// Mark action as used if it's a main action
 	if actionType == "attack" || actionType == "spell" || actionType == "dash" ||
-		actionType == "dodge" || actionType == "help" || actionType == "ready" {
+		actionType == "dodge" || actionType == "help" || actionType == "ready" ||
+		actionType == "use_item" {
 		ae.ActionUsed = true
 	}
 }
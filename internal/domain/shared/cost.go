@@ -4,3 +4,16 @@ type Cost struct {
 	Quantity int    `json:"quantity"`
 	Unit     string `json:"unit"`
 }
+
+// Copper returns the cost in copper pieces. Unknown units count as gold,
+// the unit most equipment is priced in.
+func (c *Cost) Copper() int {
+	if c == nil {
+		return 0
+	}
+	coin, ok := ParseCoin(c.Unit)
+	if !ok {
+		coin = CoinGold
+	}
+	return c.Quantity * coin.Value()
+}
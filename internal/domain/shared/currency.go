@@ -0,0 +1,172 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Coin is a denomination of currency
+type Coin string
+
+const (
+	CoinCopper   Coin = "cp"
+	CoinSilver   Coin = "sp"
+	CoinElectrum Coin = "ep"
+	CoinGold     Coin = "gp"
+	CoinPlatinum Coin = "pp"
+)
+
+// Coins lists the denominations from most to least valuable
+var Coins = []Coin{CoinPlatinum, CoinGold, CoinElectrum, CoinSilver, CoinCopper}
+
+// Value returns what one coin is worth in copper pieces
+func (c Coin) Value() int {
+	switch c {
+	case CoinSilver:
+		return 10
+	case CoinElectrum:
+		return 50
+	case CoinGold:
+		return 100
+	case CoinPlatinum:
+		return 1000
+	default:
+		return 1
+	}
+}
+
+// Name returns the coin's full name
+func (c Coin) Name() string {
+	switch c {
+	case CoinSilver:
+		return "silver"
+	case CoinElectrum:
+		return "electrum"
+	case CoinGold:
+		return "gold"
+	case CoinPlatinum:
+		return "platinum"
+	default:
+		return "copper"
+	}
+}
+
+// ParseCoin parses a coin abbreviation ("gp") or name ("gold")
+func ParseCoin(s string) (Coin, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for _, coin := range Coins {
+		if s == string(coin) || s == coin.Name() {
+			return coin, true
+		}
+	}
+	return "", false
+}
+
+// Wallet holds a character's coins
+type Wallet struct {
+	Copper   int `json:"cp,omitempty"`
+	Silver   int `json:"sp,omitempty"`
+	Electrum int `json:"ep,omitempty"`
+	Gold     int `json:"gp,omitempty"`
+	Platinum int `json:"pp,omitempty"`
+}
+
+// coins returns a pointer to the wallet's count of a coin
+func (w *Wallet) coins(coin Coin) *int {
+	switch coin {
+	case CoinSilver:
+		return &w.Silver
+	case CoinElectrum:
+		return &w.Electrum
+	case CoinGold:
+		return &w.Gold
+	case CoinPlatinum:
+		return &w.Platinum
+	default:
+		return &w.Copper
+	}
+}
+
+// Get returns how many of a coin the wallet holds
+func (w *Wallet) Get(coin Coin) int {
+	return *w.coins(coin)
+}
+
+// Add puts coins in the wallet
+func (w *Wallet) Add(amount int, coin Coin) {
+	if amount <= 0 {
+		return
+	}
+	*w.coins(coin) += amount
+}
+
+// AddCopper adds a value in copper pieces as the fewest gold, silver and
+// copper coins
+func (w *Wallet) AddCopper(value int) {
+	for _, coin := range []Coin{CoinGold, CoinSilver, CoinCopper} {
+		w.Add(value/coin.Value(), coin)
+		value %= coin.Value()
+	}
+}
+
+// Total returns the wallet's value in copper pieces
+func (w *Wallet) Total() int {
+	total := 0
+	for _, coin := range Coins {
+		total += w.Get(coin) * coin.Value()
+	}
+	return total
+}
+
+// CanAfford returns whether the wallet holds at least a value in copper pieces
+func (w *Wallet) CanAfford(value int) bool {
+	return w.Total() >= value
+}
+
+// Spend pays a value in copper pieces. The smallest coins are used first and
+// larger coins are broken into change as needed. Returns false, leaving the
+// wallet untouched, if there isn't enough money.
+func (w *Wallet) Spend(value int) bool {
+	if value <= 0 {
+		return true
+	}
+	if !w.CanAfford(value) {
+		return false
+	}
+
+	for i := len(Coins) - 1; i >= 0 && value > 0; i-- {
+		coin := Coins[i]
+		held := w.coins(coin)
+		// Round up so a larger coin covers what the smaller ones couldn't
+		used := min(*held, (value+coin.Value()-1)/coin.Value())
+		*held -= used
+		value -= used * coin.Value()
+	}
+
+	// Overpaid with a larger coin, take the change
+	if value < 0 {
+		w.AddCopper(-value)
+	}
+	return true
+}
+
+// String lists the wallet's coins, most valuable first
+func (w Wallet) String() string {
+	var parts []string
+	for _, coin := range Coins {
+		if amount := w.Get(coin); amount > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", amount, coin))
+		}
+	}
+	if len(parts) == 0 {
+		return "no coins"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FormatCopper formats a value in copper pieces using the fewest coins
+func FormatCopper(value int) string {
+	var w Wallet
+	w.AddCopper(value)
+	return w.String()
+}
@@ -0,0 +1,95 @@
+package shared_test
+
+import (
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCoin(t *testing.T) {
+	coin, ok := shared.ParseCoin("GP")
+	assert.True(t, ok)
+	assert.Equal(t, shared.CoinGold, coin)
+
+	coin, ok = shared.ParseCoin("electrum")
+	assert.True(t, ok)
+	assert.Equal(t, shared.CoinElectrum, coin)
+
+	_, ok = shared.ParseCoin("doubloons")
+	assert.False(t, ok)
+}
+
+func TestWallet_Total(t *testing.T) {
+	wallet := shared.Wallet{Copper: 3, Silver: 2, Electrum: 1, Gold: 4, Platinum: 1}
+	assert.Equal(t, 3+20+50+400+1000, wallet.Total())
+	assert.Equal(t, "1 pp, 4 gp, 1 ep, 2 sp, 3 cp", wallet.String())
+	assert.Equal(t, "no coins", shared.Wallet{}.String())
+}
+
+func TestWallet_Spend(t *testing.T) {
+	tests := []struct {
+		name     string
+		wallet   shared.Wallet
+		cost     int
+		expected shared.Wallet
+		ok       bool
+	}{
+		{
+			name:     "exact coins",
+			wallet:   shared.Wallet{Gold: 5},
+			cost:     200,
+			expected: shared.Wallet{Gold: 3},
+			ok:       true,
+		},
+		{
+			name:     "small coins first",
+			wallet:   shared.Wallet{Silver: 10, Gold: 5},
+			cost:     100,
+			expected: shared.Wallet{Gold: 5},
+			ok:       true,
+		},
+		{
+			name:     "breaks larger coins into change",
+			wallet:   shared.Wallet{Copper: 5, Gold: 2},
+			cost:     150,
+			expected: shared.Wallet{Copper: 5, Silver: 5},
+			ok:       true,
+		},
+		{
+			name:     "breaks platinum",
+			wallet:   shared.Wallet{Platinum: 1},
+			cost:     5,
+			expected: shared.Wallet{Copper: 5, Silver: 9, Gold: 9},
+			ok:       true,
+		},
+		{
+			name:     "not enough money",
+			wallet:   shared.Wallet{Silver: 9},
+			cost:     100,
+			expected: shared.Wallet{Silver: 9},
+			ok:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wallet := tt.wallet
+			assert.Equal(t, tt.ok, wallet.Spend(tt.cost))
+			assert.Equal(t, tt.expected, wallet)
+		})
+	}
+}
+
+func TestWallet_AddCopper(t *testing.T) {
+	var wallet shared.Wallet
+	wallet.AddCopper(1234)
+	assert.Equal(t, shared.Wallet{Copper: 4, Silver: 3, Gold: 12}, wallet)
+	assert.Equal(t, "2 gp, 5 sp", shared.FormatCopper(250))
+}
+
+func TestCost_Copper(t *testing.T) {
+	assert.Equal(t, 1500, (&shared.Cost{Quantity: 15, Unit: "gp"}).Copper())
+	assert.Equal(t, 20, (&shared.Cost{Quantity: 2, Unit: "sp"}).Copper())
+	assert.Equal(t, 0, (*shared.Cost)(nil).Copper())
+}
@@ -1,5 +1,13 @@
 package shared
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
 // HPResource tracks hit points and temporary HP
 type HPResource struct {
 	Current   int `json:"current"`
@@ -61,6 +69,11 @@ type SpellSlotInfo struct {
 	Max       int    `json:"max"`
 	Remaining int    `json:"remaining"`
 	Source    string `json:"source"` // "spellcasting" or "pact_magic"
+
+	// PactMax counts the slots of a multiclass warlock's pact magic that
+	// share a level with their spellcasting slots. They're spent first and
+	// come back on a short rest.
+	PactMax int `json:"pact_max,omitempty"`
 }
 
 // HitDiceResource tracks hit dice for healing
@@ -69,3 +82,112 @@ type HitDiceResource struct {
 	Max       int `json:"max"`       // Usually equals level
 	Remaining int `json:"remaining"`
 }
+
+// HitDicePools tracks hit dice by die size. Multiclass characters have a
+// pool for each size their classes use, largest die first.
+type HitDicePools []HitDiceResource
+
+// NewHitDicePools builds full pools from the number of dice of each size
+func NewHitDicePools(dice map[int]int) HitDicePools {
+	pools := make(HitDicePools, 0, len(dice))
+	for diceType, count := range dice {
+		if count > 0 {
+			pools = append(pools, HitDiceResource{DiceType: diceType, Max: count, Remaining: count})
+		}
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].DiceType > pools[j].DiceType
+	})
+	return pools
+}
+
+// Remaining returns the hit dice left across all pools
+func (p HitDicePools) Remaining() int {
+	total := 0
+	for _, pool := range p {
+		total += pool.Remaining
+	}
+	return total
+}
+
+// Max returns the total hit dice across all pools
+func (p HitDicePools) Max() int {
+	total := 0
+	for _, pool := range p {
+		total += pool.Max
+	}
+	return total
+}
+
+// String describes each pool, e.g. "3/5 d10, 2/2 d6"
+func (p HitDicePools) String() string {
+	parts := make([]string, 0, len(p))
+	for _, pool := range p {
+		parts = append(parts, fmt.Sprintf("%d/%d d%d", pool.Remaining, pool.Max, pool.DiceType))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Pool returns the pool for a die size, or nil
+func (p HitDicePools) Pool(diceType int) *HitDiceResource {
+	for i := range p {
+		if p[i].DiceType == diceType {
+			return &p[i]
+		}
+	}
+	return nil
+}
+
+// Largest returns the largest die size with dice left, or 0 if none are left
+func (p HitDicePools) Largest() int {
+	for _, pool := range p {
+		if pool.Remaining > 0 {
+			return pool.DiceType
+		}
+	}
+	return 0
+}
+
+// Spend uses one hit die of the given size
+func (p HitDicePools) Spend(diceType int) bool {
+	pool := p.Pool(diceType)
+	if pool == nil || pool.Remaining <= 0 {
+		return false
+	}
+	pool.Remaining--
+	return true
+}
+
+// Restore regains up to count spent hit dice, largest dice first
+func (p HitDicePools) Restore(count int) {
+	for i := range p {
+		if count <= 0 {
+			return
+		}
+		regained := min(count, p[i].Max-p[i].Remaining)
+		p[i].Remaining += regained
+		count -= regained
+	}
+}
+
+// UnmarshalJSON also reads the single pool saved before multiclassing
+func (p *HitDicePools) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var pool HitDiceResource
+		if err := json.Unmarshal(trimmed, &pool); err != nil {
+			return err
+		}
+		*p = nil
+		if pool.DiceType > 0 || pool.Max > 0 {
+			*p = HitDicePools{pool}
+		}
+		return nil
+	}
+
+	var pools []HitDiceResource
+	if err := json.Unmarshal(data, &pools); err != nil {
+		return err
+	}
+	*p = pools
+	return nil
+}
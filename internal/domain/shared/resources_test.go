@@ -145,9 +145,9 @@ func TestCharacterResources_Initialize(t *testing.T) {
 		assert.Equal(t, 8, resources.HP.Current)
 
 		// Check hit dice
-		assert.Equal(t, 8, resources.HitDice.DiceType)
-		assert.Equal(t, 1, resources.HitDice.Max)
-		assert.Equal(t, 1, resources.HitDice.Remaining)
+		assert.Equal(t, 8, resources.HitDice[0].DiceType)
+		assert.Equal(t, 1, resources.HitDice.Max())
+		assert.Equal(t, 1, resources.HitDice.Remaining())
 
 		// Check spell slots
 		require.NotNil(t, resources.SpellSlots)
@@ -291,11 +291,11 @@ func TestCharacterResources_Rest(t *testing.T) {
 				Max:       10,
 				Temporary: 3,
 			},
-			HitDice: shared.HitDiceResource{
+			HitDice: shared.HitDicePools{{
 				DiceType:  8,
 				Max:       4,
 				Remaining: 1,
-			},
+			}},
 			SpellSlots: map[int]shared.SpellSlotInfo{
 				1: {Max: 2, Remaining: 0, Source: "spellcasting"},
 				2: {Max: 1, Remaining: 0, Source: "pact_magic"},
@@ -328,7 +328,7 @@ func TestCharacterResources_Rest(t *testing.T) {
 		assert.Equal(t, 0, resources.HP.Temporary)
 
 		// Half hit dice should restore (minimum 1)
-		assert.Equal(t, 3, resources.HitDice.Remaining) // 1 + (4/2)
+		assert.Equal(t, 3, resources.HitDice.Remaining()) // 1 + (4/2)
 
 		// All spell slots should restore on long rest
 		assert.Equal(t, 2, resources.SpellSlots[1].Remaining)
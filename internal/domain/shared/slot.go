@@ -3,9 +3,13 @@ package shared
 type Slot string
 
 const (
-	SlotMainHand  Slot = "main-hand"
-	SlotOffHand   Slot = "off-hand"
-	SlotTwoHanded Slot = "two-handed"
-	SlotBody      Slot = "body"
-	SlotNone      Slot = "none"
+	SlotMainHand   Slot = "main-hand"
+	SlotOffHand    Slot = "off-hand"
+	SlotTwoHanded  Slot = "two-handed"
+	SlotBody       Slot = "body"
+	SlotNeck       Slot = "neck"
+	SlotCloak      Slot = "cloak"
+	SlotRing       Slot = "ring"
+	SlotSecondRing Slot = "ring-2" // A character can wear a ring on each hand
+	SlotNone       Slot = "none"
 )
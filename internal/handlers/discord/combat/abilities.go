@@ -6,7 +6,9 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/ability"
 	"github.com/bwmarrin/discordgo"
@@ -80,6 +82,15 @@ func (h *Handler) handleShowAbilities(s *discordgo.Session, i *discordgo.Interac
 		embed.Description = "You have no special abilities available."
 	}
 
+	// Subclass features are mostly passive, so list them alongside the abilities
+	if playerCombatant.CharacterID != "" && h.characterService != nil {
+		if char, err := h.characterService.GetByID(playerCombatant.CharacterID); err == nil {
+			if field := buildSubclassField(char); field != nil {
+				embed.Fields = append(embed.Fields, field)
+			}
+		}
+	}
+
 	// Add footer
 	embed.Footer = &discordgo.MessageEmbedFooter{
 		Text: "Select an ability to use it",
@@ -696,6 +707,34 @@ func (h *Handler) handleUseAbilityWithTarget(s *discordgo.Session, i *discordgo.
 
 // Helper functions
 
+// buildSubclassField lists the character's subclass features, or returns nil
+// if no subclass has been chosen
+func buildSubclassField(char *character.Character) *discordgo.MessageEmbedField {
+	subclass, ok := char.GetSubclass()
+	if !ok {
+		return nil
+	}
+
+	var lines []string
+	for _, feature := range char.Features {
+		if feature != nil && feature.Type == rulebook.FeatureTypeSubclass {
+			lines = append(lines, fmt.Sprintf("• **%s** - %s", feature.Name, feature.Description))
+		}
+	}
+	if len(lines) == 0 {
+		lines = append(lines, subclass.Description)
+	}
+
+	value := strings.Join(lines, "\n")
+	if len(value) > 1024 {
+		value = value[:1021] + "..."
+	}
+	return &discordgo.MessageEmbedField{
+		Name:  fmt.Sprintf("🏅 %s", subclass.Name),
+		Value: value,
+	}
+}
+
 func formatActionType(actionType shared.AbilityType) string {
 	switch actionType {
 	case shared.AbilityTypeAction:
@@ -52,20 +52,8 @@ func BuildCombatStatusEmbedForPlayer(enc *combat.Encounter, monsterActions []*en
 			})
 		}
 	} else if len(monsterActions) > 0 {
-		// Fallback to old style for non-player-specific views
-		for _, ma := range monsterActions {
-			var value string
-			if ma.Hit {
-				value = fmt.Sprintf("Attacked %s for **%d** damage!", ma.TargetName, ma.Damage)
-			} else {
-				value = fmt.Sprintf("Missed %s!", ma.TargetName)
-			}
-			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-				Name:   fmt.Sprintf("🐉 %s", ma.AttackerName),
-				Value:  value,
-				Inline: false,
-			})
-		}
+		// Non-player-specific views show each monster (or monster group) turn
+		embed.Fields = append(embed.Fields, BuildMonsterTurnFields(monsterActions)...)
 	}
 
 	// Add initiative order using the new field format
@@ -13,6 +13,8 @@ import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/ability"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/item"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -21,6 +23,8 @@ type Handler struct {
 	encounterService encounter.Service
 	abilityService   ability.Service
 	characterService character.Service
+	spellService     spell.Service
+	itemService      item.Service
 }
 
 // appendCombatEndMessage adds combat end information to an embed
@@ -53,11 +57,13 @@ func getCombatEndMessage(combatEnded, playersWon bool) string {
 }
 
 // NewHandler creates a new combat handler
-func NewHandler(encounterService encounter.Service, abilityService ability.Service, characterService character.Service) *Handler {
+func NewHandler(encounterService encounter.Service, abilityService ability.Service, characterService character.Service, spellService spell.Service, itemService item.Service) *Handler {
 	return &Handler{
 		encounterService: encounterService,
 		abilityService:   abilityService,
 		characterService: characterService,
+		spellService:     spellService,
+		itemService:      itemService,
 	}
 }
 
@@ -92,6 +98,20 @@ func (h *Handler) HandleButton(s *discordgo.Session, i *discordgo.InteractionCre
 		return h.handleUseAbilityWithTarget(s, i, encounterID)
 	case "lay_on_hands_amount":
 		return h.handleLayOnHandsAmount(s, i, encounterID)
+	case "spells":
+		return h.handleShowSpells(s, i, encounterID)
+	case "cast_spell":
+		return h.handleCastSpell(s, i, encounterID)
+	case "cast_slot":
+		return h.handleCastSlot(s, i, encounterID)
+	case "items":
+		return h.handleShowItems(s, i, encounterID)
+	case "use_item":
+		return h.handleUseItem(s, i, encounterID)
+	case "item_target":
+		return h.handleItemTarget(s, i, encounterID)
+	case "cast_target":
+		return h.handleCastTarget(s, i, encounterID)
 	case "bonus_action":
 		return h.handleBonusAction(s, i, encounterID)
 	case "bonus_target":
@@ -415,17 +435,7 @@ func (h *Handler) handleNextTurn(s *discordgo.Session, i *discordgo.InteractionC
 	if len(monsterResults) > 0 {
 		var roundActions strings.Builder
 		roundActions.WriteString("🔄 **Monster Actions This Turn:**\n")
-		for _, ma := range monsterResults {
-			if ma.Hit {
-				if ma.TargetDefeated {
-					roundActions.WriteString(fmt.Sprintf("• ⚔️ **%s** → **%s** | HIT 🩸 **%d** 💀\n", ma.AttackerName, ma.TargetName, ma.Damage))
-				} else {
-					roundActions.WriteString(fmt.Sprintf("• ⚔️ **%s** → **%s** | HIT 🩸 **%d**\n", ma.AttackerName, ma.TargetName, ma.Damage))
-				}
-			} else {
-				roundActions.WriteString(fmt.Sprintf("• ❌ **%s** → **%s** | MISS\n", ma.AttackerName, ma.TargetName))
-			}
-		}
+		roundActions.WriteString(BuildMonsterTurnSummary(monsterResults))
 		embed.Description = roundActions.String() + "\n" + embed.Description
 	}
 
@@ -595,13 +605,7 @@ func (h *Handler) handleContinueRound(s *discordgo.Session, i *discordgo.Interac
 	// Add round start and monster actions if any
 	roundSummary := fmt.Sprintf("🔄 **Round %d Begins!**\n\n", enc.Round)
 	if len(monsterResults) > 0 {
-		for _, ma := range monsterResults {
-			if ma.Hit {
-				roundSummary += fmt.Sprintf("👹 **%s** attacked %s for %d damage!\n", ma.AttackerName, ma.TargetName, ma.Damage)
-			} else {
-				roundSummary += fmt.Sprintf("👹 **%s** missed %s!\n", ma.AttackerName, ma.TargetName)
-			}
-		}
+		roundSummary += BuildMonsterTurnSummary(monsterResults)
 	}
 	embed.Description = roundSummary + "\n" + embed.Description
 
@@ -854,6 +858,12 @@ func (h *Handler) handleMyActions(s *discordgo.Session, i *discordgo.Interaction
 
 			actionEconomyInfo = fmt.Sprintf("\n**Action:** %s | **Bonus Action:** %s", actionStatus, bonusActionStatus)
 
+			if char.Resources != nil {
+				if slots := char.Resources.SpellSlotSummary(); slots != "" {
+					actionEconomyInfo += fmt.Sprintf("\n**Spell Slots:** %s", slots)
+				}
+			}
+
 			// Get available bonus actions
 			if char.Resources != nil {
 				availableBonusActions = char.GetAvailableBonusActions()
@@ -889,6 +899,8 @@ func (h *Handler) handleMyActions(s *discordgo.Session, i *discordgo.Interaction
 		if char != nil && char.Resources != nil && char.Resources.ActionEconomy.ActionUsed {
 			attackDisabled = true
 		}
+		spellsDisabled := enc.Status != combat.EncounterStatusActive || !canCastSpells(char)
+		itemsDisabled := enc.Status != combat.EncounterStatusActive || !hasUsableItems(char)
 
 		components = []discordgo.MessageComponent{
 			discordgo.ActionsRow{
@@ -900,6 +912,13 @@ func (h *Handler) handleMyActions(s *discordgo.Session, i *discordgo.Interaction
 						Emoji:    &discordgo.ComponentEmoji{Name: "⚔️"},
 						Disabled: attackDisabled,
 					},
+					discordgo.Button{
+						Label:    "Cast a Spell",
+						Style:    discordgo.PrimaryButton,
+						CustomID: fmt.Sprintf("combat:spells:%s", encounterID),
+						Emoji:    &discordgo.ComponentEmoji{Name: "🔮"},
+						Disabled: spellsDisabled,
+					},
 					discordgo.Button{
 						Label:    "Abilities",
 						Style:    discordgo.PrimaryButton,
@@ -907,6 +926,13 @@ func (h *Handler) handleMyActions(s *discordgo.Session, i *discordgo.Interaction
 						Emoji:    &discordgo.ComponentEmoji{Name: "✨"},
 						Disabled: enc.Status != combat.EncounterStatusActive,
 					},
+					discordgo.Button{
+						Label:    "Use Item",
+						Style:    discordgo.PrimaryButton,
+						CustomID: fmt.Sprintf("combat:items:%s", encounterID),
+						Emoji:    &discordgo.ComponentEmoji{Name: "🧪"},
+						Disabled: itemsDisabled,
+					},
 					discordgo.Button{
 						Label:    "End Turn",
 						Style:    discordgo.SecondaryButton,
@@ -959,7 +985,6 @@ func (h *Handler) handleMyActions(s *discordgo.Session, i *discordgo.Interaction
 	}
 
 	// TODO: Add more action types in the future:
-	// - Use Item (potions, scrolls)
 	// - Cast Spell
 	// - Special Abilities
 	// - Defensive Actions (dodge, dash, disengage)
@@ -1437,7 +1462,7 @@ func (h *Handler) executeBonusAction(s *discordgo.Session, i *discordgo.Interact
 		}
 
 		// Save character to persist the bonus action used state
-		if errUpdate := h.characterService.UpdateEquipment(char); errUpdate != nil {
+		if errUpdate := h.characterService.UpdateEquipment(character.WithChange(context.Background(), i.Member.User.ID, "used a bonus action"), char); errUpdate != nil {
 			log.Printf("Failed to update character after bonus action: %v", errUpdate)
 		}
 	}
@@ -1668,6 +1693,12 @@ func (h *Handler) buildActionController(enc *combat.Encounter, encounterID, user
 
 			actionEconomyInfo = fmt.Sprintf("\n**Action:** %s | **Bonus Action:** %s", actionStatus, bonusActionStatus)
 
+			if char.Resources != nil {
+				if slots := char.Resources.SpellSlotSummary(); slots != "" {
+					actionEconomyInfo += fmt.Sprintf("\n**Spell Slots:** %s", slots)
+				}
+			}
+
 			// Get available bonus actions
 			if char.Resources != nil {
 				availableBonusActions = char.GetAvailableBonusActions()
@@ -1703,6 +1734,8 @@ func (h *Handler) buildActionController(enc *combat.Encounter, encounterID, user
 		if char != nil && char.Resources != nil && char.Resources.ActionEconomy.ActionUsed {
 			attackDisabled = true
 		}
+		spellsDisabled := enc.Status != combat.EncounterStatusActive || !isMyTurn || !canCastSpells(char)
+		itemsDisabled := enc.Status != combat.EncounterStatusActive || !isMyTurn || !hasUsableItems(char)
 
 		components = []discordgo.MessageComponent{
 			discordgo.ActionsRow{
@@ -1714,6 +1747,13 @@ func (h *Handler) buildActionController(enc *combat.Encounter, encounterID, user
 						Emoji:    &discordgo.ComponentEmoji{Name: "⚔️"},
 						Disabled: attackDisabled,
 					},
+					discordgo.Button{
+						Label:    "Cast a Spell",
+						Style:    discordgo.PrimaryButton,
+						CustomID: fmt.Sprintf("combat:spells:%s", encounterID),
+						Emoji:    &discordgo.ComponentEmoji{Name: "🔮"},
+						Disabled: spellsDisabled,
+					},
 					discordgo.Button{
 						Label:    "Abilities",
 						Style:    discordgo.PrimaryButton,
@@ -1721,6 +1761,13 @@ func (h *Handler) buildActionController(enc *combat.Encounter, encounterID, user
 						Emoji:    &discordgo.ComponentEmoji{Name: "✨"},
 						Disabled: enc.Status != combat.EncounterStatusActive || !isMyTurn,
 					},
+					discordgo.Button{
+						Label:    "Use Item",
+						Style:    discordgo.PrimaryButton,
+						CustomID: fmt.Sprintf("combat:items:%s", encounterID),
+						Emoji:    &discordgo.ComponentEmoji{Name: "🧪"},
+						Disabled: itemsDisabled,
+					},
 					discordgo.Button{
 						Label:    "End Turn",
 						Style:    discordgo.SecondaryButton,
@@ -1812,7 +1859,7 @@ func (h *Handler) executeUnarmedStrike(enc *combat.Encounter, attacker, target *
 	// Calculate proficiency bonus (everyone is proficient with unarmed strikes)
 	proficiencyBonus := 0
 	if char != nil {
-		proficiencyBonus = 2 + ((char.Level - 1) / 4)
+		proficiencyBonus = char.GetProficiencyBonus()
 	}
 
 	// Roll attack
@@ -1930,7 +1977,7 @@ func (h *Handler) executeOffHandAttack(enc *combat.Encounter, attacker, target *
 	// Calculate proficiency bonus
 	proficiencyBonus := 0
 	if char != nil && char.HasWeaponProficiency(offHandWeapon.GetKey()) {
-		proficiencyBonus = 2 + ((char.Level - 1) / 4)
+		proficiencyBonus = char.GetProficiencyBonus()
 	}
 
 	// Roll attack
@@ -0,0 +1,371 @@
+package combat
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	character2 "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/item"
+	"github.com/bwmarrin/discordgo"
+)
+
+// hasUsableItems reports whether the character carries any potions, scrolls
+// or charged magic items
+func hasUsableItems(char *character2.Character) bool {
+	return char != nil && (len(char.UsableConsumables()) > 0 || len(char.ChargedItems()) > 0)
+}
+
+// describeCharges returns a short summary of a charged item, e.g.
+// "magic-missile, 5/7 charges"
+func describeCharges(char *character2.Character, item equipment.Equipment) string {
+	magic := equipment.Magic(item)
+	return fmt.Sprintf("%s, %d/%d charges", magic.Spell, char.ItemCharges(item.GetKey()), magic.MaxCharges)
+}
+
+// describeConsumable returns a short summary of what an item does, e.g.
+// "Heals 2d4+2" or "Level 3 spell"
+func describeConsumable(consumable *equipment.Consumable) string {
+	effect := consumable.Effect
+	switch {
+	case consumable.Kind == equipment.ConsumableScroll:
+		return formatSpellLevel(effect.SpellLevel) + " spell"
+	case effect.Heals():
+		return "Heals " + effect.HealingString()
+	case len(effect.Buffs) > 0:
+		return fmt.Sprintf("Lasts %d minutes", max(effect.BuffRounds/10, 1))
+	default:
+		return "Consumable"
+	}
+}
+
+// consumableEmoji returns the emoji for a kind of consumable
+func consumableEmoji(consumable *equipment.Consumable) string {
+	if consumable.Kind == equipment.ConsumableScroll {
+		return "📜"
+	}
+	return "🧪"
+}
+
+// handleShowItems lists the potions, scrolls and charged items the player can use
+func (h *Handler) handleShowItems(s *discordgo.Session, i *discordgo.InteractionCreate, encounterID string) error {
+	caster, err := h.loadCaster(s, i, encounterID)
+	if caster == nil {
+		return err
+	}
+
+	var itemList []string
+	var menuOptions []discordgo.SelectMenuOption
+	for _, consumable := range caster.Character.UsableConsumables() {
+		itemList = append(itemList, fmt.Sprintf("%s **%s** ×%d - %s",
+			consumableEmoji(consumable), consumable.GetName(), consumable.Quantity, describeConsumable(consumable)))
+
+		if len(menuOptions) < maxSelectOptions {
+			menuOptions = append(menuOptions, discordgo.SelectMenuOption{
+				Label:       consumable.GetName(),
+				Value:       consumable.GetKey(),
+				Description: describeConsumable(consumable),
+				Emoji:       &discordgo.ComponentEmoji{Name: consumableEmoji(consumable)},
+			})
+		}
+	}
+	for _, charged := range caster.Character.ChargedItems() {
+		itemList = append(itemList, fmt.Sprintf("🪄 **%s** - %s", charged.GetName(), describeCharges(caster.Character, charged)))
+
+		if len(menuOptions) < maxSelectOptions {
+			menuOptions = append(menuOptions, discordgo.SelectMenuOption{
+				Label:       charged.GetName(),
+				Value:       charged.GetKey(),
+				Description: describeCharges(caster.Character, charged),
+				Emoji:       &discordgo.ComponentEmoji{Name: "🪄"},
+			})
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🎒 %s's Items", caster.Combatant.Name),
+		Color:       0x1abc9c, // Teal
+		Description: strings.Join(itemList, "\n"),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Using an item takes your action; a scroll or wand takes its spell's casting time",
+		},
+	}
+	if len(itemList) == 0 {
+		embed.Description = "You don't have any potions, scrolls or wands."
+	}
+
+	var components []discordgo.MessageComponent
+	if len(menuOptions) > 0 {
+		components = append(components, discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    fmt.Sprintf("combat:use_item:%s", encounterID),
+					Placeholder: "Choose an item to use",
+					Options:     menuOptions,
+				},
+			},
+		})
+	}
+	components = append(components, backToActionsRow(encounterID))
+
+	if isEphemeralInteraction(i) {
+		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Embeds:     []*discordgo.MessageEmbed{embed},
+				Components: components,
+			},
+		})
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+			Flags:      discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
+
+// handleUseItem handles an item picked from the menu. Scrolls of spells
+// aimed at creatures ask for targets first.
+func (h *Handler) handleUseItem(s *discordgo.Session, i *discordgo.InteractionCreate, encounterID string) error {
+	values := i.MessageComponentData().Values
+	if len(values) == 0 {
+		return respondError(s, i, "No item selected", nil)
+	}
+
+	caster, err := h.loadCaster(s, i, encounterID)
+	if caster == nil {
+		return err
+	}
+
+	for _, charged := range caster.Character.ChargedItems() {
+		if charged.GetKey() != values[0] {
+			continue
+		}
+		spellData, err := h.characterService.GetSpell(context.Background(), equipment.Magic(charged).Spell)
+		if err != nil {
+			return respondError(s, i, "Failed to get the item's spell", err)
+		}
+		if spellNeedsTargets(spellData) {
+			customID := fmt.Sprintf("combat:item_target:%s:%s", encounterID, charged.GetKey())
+			return h.showSpellTargetSelection(s, i, caster, spellData, spellData.Level, customID, itemCancelRow(encounterID))
+		}
+		return h.executeItem(s, i, encounterID, charged.GetKey(), nil)
+	}
+
+	var chosen *equipment.Consumable
+	for _, consumable := range caster.Character.UsableConsumables() {
+		if consumable.GetKey() == values[0] {
+			chosen = consumable
+			break
+		}
+	}
+	if chosen == nil {
+		return respondError(s, i, "You don't have that item anymore", nil)
+	}
+
+	if chosen.Kind == equipment.ConsumableScroll {
+		spellData, err := h.characterService.GetSpell(context.Background(), chosen.Effect.Spell)
+		if err != nil {
+			return respondError(s, i, "Failed to get the scroll's spell", err)
+		}
+		if spellNeedsTargets(spellData) {
+			customID := fmt.Sprintf("combat:item_target:%s:%s", encounterID, chosen.GetKey())
+			return h.showSpellTargetSelection(s, i, caster, spellData, chosen.Effect.SpellLevel, customID, itemCancelRow(encounterID))
+		}
+	}
+
+	return h.executeItem(s, i, encounterID, chosen.GetKey(), nil)
+}
+
+// handleItemTarget reads a scroll at the chosen targets: combat:item_target:encounterID:itemKey
+func (h *Handler) handleItemTarget(s *discordgo.Session, i *discordgo.InteractionCreate, encounterID string) error {
+	parts := parseCustomID(i.MessageComponentData().CustomID)
+	if len(parts) < 4 {
+		return respondError(s, i, "Invalid target selection", nil)
+	}
+
+	targetIDs := i.MessageComponentData().Values
+	if len(targetIDs) == 0 {
+		return respondError(s, i, "No targets selected", nil)
+	}
+
+	return h.executeItem(s, i, encounterID, parts[3], targetIDs)
+}
+
+// itemCancelRow returns a row with a button back to the item menu
+func itemCancelRow(encounterID string) discordgo.ActionsRow {
+	return discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    "Cancel",
+				Style:    discordgo.SecondaryButton,
+				CustomID: fmt.Sprintf("combat:items:%s", encounterID),
+				Emoji:    &discordgo.ComponentEmoji{Name: "❌"},
+			},
+		},
+	}
+}
+
+// executeItem uses the item, then shows the result on the action controller
+// and the shared combat message
+func (h *Handler) executeItem(s *discordgo.Session, i *discordgo.InteractionCreate, encounterID, itemKey string, targetIDs []string) error {
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	}); err != nil {
+		log.Printf("Failed to defer item use: %v", err)
+		return fmt.Errorf("failed to defer: %w", err)
+	}
+
+	enc, err := h.encounterService.GetEncounter(context.Background(), encounterID)
+	if err != nil {
+		return respondEditError(s, i, "Failed to get encounter", err)
+	}
+	user := findPlayerCombatant(enc, i.Member.User.ID)
+	if user == nil {
+		return respondEditError(s, i, "You are not in this combat!", nil)
+	}
+
+	input := &item.UseItemInput{
+		CharacterID: user.CharacterID,
+		UserID:      i.Member.User.ID,
+		ItemKey:     itemKey,
+		EncounterID: encounterID,
+		TargetIDs:   targetIDs,
+	}
+	var resultText, sharedText string
+	if h.isChargedItem(user.CharacterID, itemKey) {
+		result, err := h.itemService.UseCharge(context.Background(), input)
+		if err != nil {
+			return respondEditError(s, i, "Failed to use item", err)
+		}
+		resultText = formatChargeResult(user.Name, result)
+		sharedText = "🪄 " + result.Message
+	} else {
+		result, err := h.itemService.UseItem(context.Background(), input)
+		if err != nil {
+			return respondEditError(s, i, "Failed to use item", err)
+		}
+		resultText = formatItemResult(user.Name, result)
+		sharedText = fmt.Sprintf("%s %s", consumableEmoji(result.Item), result.Message)
+	}
+
+	// Reload to pick up healing, damage and a possible end of combat
+	enc, err = h.encounterService.GetEncounter(context.Background(), encounterID)
+	if err != nil {
+		return respondEditError(s, i, "Failed to get updated encounter", err)
+	}
+
+	combatEnded := enc.Status == combat.EncounterStatusCompleted
+	playersWon := false
+	if combatEnded {
+		for _, c := range enc.Combatants {
+			if c.Type == combat.CombatantTypePlayer && c.IsActive {
+				playersWon = true
+				break
+			}
+		}
+	}
+
+	resultText += getCombatEndMessage(combatEnded, playersWon)
+
+	actionEmbed, actionComponents, err := h.buildActionController(enc, encounterID, i.Member.User.ID)
+	if err != nil {
+		return respondEditError(s, i, "Failed to build action controller", err)
+	}
+	resultField := &discordgo.MessageEmbedField{
+		Name:  "🎒 Item Result",
+		Value: resultText,
+	}
+	if len(actionEmbed.Fields) > 0 {
+		actionEmbed.Fields = append([]*discordgo.MessageEmbedField{actionEmbed.Fields[0], resultField}, actionEmbed.Fields[1:]...)
+	} else {
+		actionEmbed.Fields = append(actionEmbed.Fields, resultField)
+	}
+
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{actionEmbed},
+		Components: &actionComponents,
+	}); err != nil {
+		log.Printf("Failed to update action controller with item result: %v", err)
+		return fmt.Errorf("failed to edit response: %w", err)
+	}
+
+	// Everyone sees the item used on the shared combat message
+	sharedEmbed := BuildCombatStatusEmbed(enc, nil)
+	sharedEmbed.Description = fmt.Sprintf("%s\n\n%s", sharedText, sharedEmbed.Description)
+	appendCombatEndMessage(sharedEmbed, combatEnded, playersWon)
+	sharedComponents := BuildCombatComponents(encounterID, &encounter.ExecuteAttackResult{
+		CombatEnded: combatEnded,
+		PlayersWon:  playersWon,
+	})
+	if updateErr := updateSharedCombatMessage(s, encounterID, enc.MessageID, enc.ChannelID, sharedEmbed, sharedComponents); updateErr != nil {
+		log.Printf("Failed to update shared combat message: %v", updateErr)
+	}
+
+	return nil
+}
+
+// isChargedItem reports whether the item is a wand or other charged item
+// rather than a consumable
+func (h *Handler) isChargedItem(characterID, itemKey string) bool {
+	char, err := h.characterService.GetByID(characterID)
+	if err != nil {
+		return false
+	}
+	magic := equipment.Magic(char.GetEquipment(itemKey))
+	return magic != nil && magic.Spell != ""
+}
+
+// formatChargeResult describes what spending a charge did for the player
+func formatChargeResult(name string, result *item.UseChargeResult) string {
+	lines := []string{fmt.Sprintf("**%s** used a charge of the **%s**", name, result.Item.GetName())}
+	roundSummary := NewRoundSummary(0)
+	for _, target := range result.Spell.Targets {
+		roundSummary.RecordSpell(name, result.Spell.SpellName, target)
+	}
+	if summary := roundSummary.GetPlayerSummary(name); summary != "" {
+		lines = append(lines, summary)
+	}
+	lines = append(lines, fmt.Sprintf("%d charges left", result.ChargesLeft))
+	return strings.Join(lines, "\n")
+}
+
+// formatItemResult describes what using an item did for the player
+func formatItemResult(name string, result *item.UseItemResult) string {
+	var lines []string
+	switch {
+	case result.ScrollFailed:
+		lines = append(lines, fmt.Sprintf("**%s** failed to read the **%s** and it crumbles to dust", name, result.Item.GetName()))
+	case result.Spell != nil:
+		lines = append(lines, fmt.Sprintf("**%s** read a **%s**", name, result.Item.GetName()))
+		roundSummary := NewRoundSummary(0)
+		for _, target := range result.Spell.Targets {
+			roundSummary.RecordSpell(name, result.Spell.SpellName, target)
+		}
+		if summary := roundSummary.GetPlayerSummary(name); summary != "" {
+			lines = append(lines, summary)
+		}
+	default:
+		lines = append(lines, fmt.Sprintf("**%s** drank a **%s**", name, result.Item.GetName()))
+	}
+
+	if result.HealingRolls != nil {
+		lines = append(lines, fmt.Sprintf("💚 Healed %d HP (%s: %v)", result.Healed, result.Item.Effect.HealingString(), result.HealingRolls))
+	}
+	if result.Effect != "" {
+		lines = append(lines, fmt.Sprintf("✨ %s is active", result.Effect))
+	}
+	if result.BonusAction {
+		lines = append(lines, "Used your bonus action")
+	}
+	lines = append(lines, fmt.Sprintf("%d left", result.Remaining))
+	return strings.Join(lines, "\n")
+}
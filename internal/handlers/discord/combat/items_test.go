@@ -0,0 +1,36 @@
+package combat
+
+import (
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/item"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeConsumable(t *testing.T) {
+	assert.Equal(t, "Heals 2d4+2", describeConsumable(equipment.NewSRDConsumable("potion-of-healing", 1)))
+	assert.Equal(t, "Lasts 60 minutes", describeConsumable(equipment.NewSRDConsumable("potion-of-fire-resistance", 1)))
+	assert.Equal(t, "Level 3 spell", describeConsumable(equipment.NewSpellScroll("fireball", "Fireball", 3)))
+	assert.Equal(t, "Cantrip spell", describeConsumable(equipment.NewSpellScroll("fire-bolt", "Fire Bolt", 0)))
+}
+
+func TestFormatItemResult(t *testing.T) {
+	potion := equipment.NewSRDConsumable("potion-of-healing", 1)
+
+	text := formatItemResult("Elminster", &item.UseItemResult{
+		Item:         potion,
+		HealingRolls: []int{1, 2},
+		Healed:       5,
+		BonusAction:  true,
+		Remaining:    1,
+	})
+	assert.Contains(t, text, "**Elminster** drank a **Potion of Healing**")
+	assert.Contains(t, text, "Healed 5 HP (2d4+2: [1 2])")
+	assert.Contains(t, text, "bonus action")
+	assert.Contains(t, text, "1 left")
+
+	scroll := equipment.NewSpellScroll("fireball", "Fireball", 3)
+	text = formatItemResult("Elminster", &item.UseItemResult{Item: scroll, ScrollFailed: true})
+	assert.Contains(t, text, "failed to read the **Spell Scroll (Fireball)**")
+}
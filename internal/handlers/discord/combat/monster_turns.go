@@ -0,0 +1,160 @@
+package combat
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	"github.com/bwmarrin/discordgo"
+)
+
+// MonsterTurnGroup collects consecutive attacks made by one monster or by a
+// group of identical monsters acting together
+type MonsterTurnGroup struct {
+	Name    string // Group key for grouped monsters, otherwise the monster's name
+	Members []string
+	Results []*encounter.AttackResult
+}
+
+// IsGroup returns true if several monsters acted together
+func (g *MonsterTurnGroup) IsGroup() bool {
+	return len(g.Members) > 1
+}
+
+// Title returns the display name, e.g. "Goblin ×3 (A, B, C)". Counting
+// the group avoids pluralizing names like "Wolf" by hand.
+func (g *MonsterTurnGroup) Title() string {
+	if !g.IsGroup() {
+		return g.Name
+	}
+
+	labels := make([]string, 0, len(g.Members))
+	for _, member := range g.Members {
+		labels = append(labels, strings.TrimSpace(strings.TrimPrefix(member, g.Name)))
+	}
+	return fmt.Sprintf("%s ×%d (%s)", g.Name, len(g.Members), strings.Join(labels, ", "))
+}
+
+// TotalDamage returns the damage dealt by the whole group
+func (g *MonsterTurnGroup) TotalDamage() int {
+	total := 0
+	for _, result := range g.Results {
+		if result.Hit {
+			total += result.Damage
+		}
+	}
+	return total
+}
+
+// Hits returns how many of the group's attacks landed
+func (g *MonsterTurnGroup) Hits() int {
+	hits := 0
+	for _, result := range g.Results {
+		if result.Hit {
+			hits++
+		}
+	}
+	return hits
+}
+
+// GroupMonsterResults merges consecutive attacks from the same monster group
+// so a pack of goblins reads as one consolidated turn
+func GroupMonsterResults(results []*encounter.AttackResult) []*MonsterTurnGroup {
+	var groups []*MonsterTurnGroup
+
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+
+		key := result.AttackerGroup
+		if key == "" {
+			key = result.AttackerName
+		}
+
+		var current *MonsterTurnGroup
+		if len(groups) > 0 && result.AttackerGroup != "" && groups[len(groups)-1].Name == key {
+			current = groups[len(groups)-1]
+		} else {
+			current = &MonsterTurnGroup{Name: key}
+			groups = append(groups, current)
+		}
+
+		current.Results = append(current.Results, result)
+		if !slices.Contains(current.Members, result.AttackerName) {
+			current.Members = append(current.Members, result.AttackerName)
+		}
+	}
+
+	return groups
+}
+
+// BuildMonsterTurnSummary renders monster attacks as compact lines, with one
+// summary line per monster group
+func BuildMonsterTurnSummary(results []*encounter.AttackResult) string {
+	var sb strings.Builder
+
+	for _, group := range GroupMonsterResults(results) {
+		if group.IsGroup() {
+			sb.WriteString(fmt.Sprintf("👹 **%s** - %d/%d hits, 🩸 **%d** total\n",
+				group.Title(), group.Hits(), len(group.Results), group.TotalDamage()))
+		}
+		for _, result := range group.Results {
+			prefix := "• "
+			if group.IsGroup() {
+				prefix = "  ↳ "
+			}
+			sb.WriteString(prefix + formatMonsterAttackLine(result) + "\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// BuildMonsterTurnFields renders monster attacks as embed fields, one per
+// monster or monster group
+func BuildMonsterTurnFields(results []*encounter.AttackResult) []*discordgo.MessageEmbedField {
+	var fields []*discordgo.MessageEmbedField
+
+	for _, group := range GroupMonsterResults(results) {
+		var value strings.Builder
+		for _, result := range group.Results {
+			value.WriteString(formatMonsterAttackLine(result) + "\n")
+		}
+
+		name := fmt.Sprintf("🐉 %s's Turn", group.Title())
+		if group.IsGroup() {
+			name = fmt.Sprintf("👹 %s", group.Title())
+			value.WriteString(fmt.Sprintf("**Total:** 🩸 %d damage (%d/%d hits)", group.TotalDamage(), group.Hits(), len(group.Results)))
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   name,
+			Value:  strings.TrimSpace(value.String()),
+			Inline: false,
+		})
+	}
+
+	return fields
+}
+
+func formatMonsterAttackLine(result *encounter.AttackResult) string {
+	weapon := ""
+	if result.WeaponName != "" {
+		weapon = fmt.Sprintf(" (%s)", result.WeaponName)
+	}
+
+	if !result.Hit {
+		return fmt.Sprintf("❌ **%s** → **%s**%s | MISS", result.AttackerName, result.TargetName, weapon)
+	}
+
+	line := fmt.Sprintf("⚔️ **%s** → **%s**%s | HIT 🩸 **%d**", result.AttackerName, result.TargetName, weapon, result.Damage)
+	if result.Critical {
+		line += " 💥"
+	}
+	if result.TargetDefeated {
+		line += " 💀"
+	}
+	return line
+}
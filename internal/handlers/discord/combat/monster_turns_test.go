@@ -0,0 +1,60 @@
+package combat
+
+import (
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupMonsterResults(t *testing.T) {
+	results := []*encounter.AttackResult{
+		{AttackerName: "Goblin A", AttackerGroup: "Goblin", TargetName: "Grunk", Hit: true, Damage: 5},
+		{AttackerName: "Goblin B", AttackerGroup: "Goblin", TargetName: "Grunk", Hit: false},
+		{AttackerName: "Goblin C", AttackerGroup: "Goblin", TargetName: "Grunk", Hit: true, Damage: 4},
+		{AttackerName: "Orc", TargetName: "Grunk", Hit: true, Damage: 9},
+	}
+
+	groups := GroupMonsterResults(results)
+	require.Len(t, groups, 2)
+
+	goblins := groups[0]
+	assert.True(t, goblins.IsGroup())
+	assert.Equal(t, "Goblin ×3 (A, B, C)", goblins.Title())
+	assert.Equal(t, 9, goblins.TotalDamage())
+	assert.Equal(t, 2, goblins.Hits())
+
+	orc := groups[1]
+	assert.False(t, orc.IsGroup())
+	assert.Equal(t, "Orc", orc.Title())
+}
+
+func TestGroupMonsterResults_SeparatesUngroupedMonstersWithSameName(t *testing.T) {
+	results := []*encounter.AttackResult{
+		{AttackerName: "Orc", TargetName: "Grunk"},
+		{AttackerName: "Orc", TargetName: "Grunk"},
+	}
+
+	groups := GroupMonsterResults(results)
+	assert.Len(t, groups, 2, "multiattacks without a group shouldn't be merged")
+}
+
+func TestBuildMonsterTurnFields(t *testing.T) {
+	results := []*encounter.AttackResult{
+		{AttackerName: "Goblin A", AttackerGroup: "Goblin", TargetName: "Grunk", WeaponName: "Scimitar", Hit: true, Damage: 5},
+		{AttackerName: "Goblin B", AttackerGroup: "Goblin", TargetName: "Grunk", WeaponName: "Scimitar", Hit: false},
+		{AttackerName: "Skeleton", TargetName: "Grunk", WeaponName: "Shortsword", Hit: true, Damage: 6, TargetDefeated: true},
+	}
+
+	fields := BuildMonsterTurnFields(results)
+	require.Len(t, fields, 2)
+
+	assert.Equal(t, "👹 Goblin ×2 (A, B)", fields[0].Name)
+	assert.Contains(t, fields[0].Value, "**Goblin A** → **Grunk** (Scimitar) | HIT 🩸 **5**")
+	assert.Contains(t, fields[0].Value, "**Goblin B** → **Grunk** (Scimitar) | MISS")
+	assert.Contains(t, fields[0].Value, "**Total:** 🩸 5 damage (1/2 hits)")
+
+	assert.Equal(t, "🐉 Skeleton's Turn", fields[1].Name)
+	assert.Contains(t, fields[1].Value, "💀")
+}
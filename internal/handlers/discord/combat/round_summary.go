@@ -3,6 +3,8 @@ package combat
 import (
 	"fmt"
 	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
 )
 
 // RoundSummary tracks combat actions for a single round
@@ -27,6 +29,7 @@ type AttackInfo struct {
 	Damage       int
 	Hit          bool
 	Critical     bool
+	Saved        bool // Target made its saving throw against a spell
 	WeaponName   string
 }
 
@@ -75,6 +78,20 @@ func (rs *RoundSummary) RecordAttack(attack AttackInfo) {
 	}
 }
 
+// RecordSpell records a spell's outcome against one target in the round summary
+func (rs *RoundSummary) RecordSpell(casterName, spellName string, target *spell.TargetResult) {
+	autoHit := target.AttackRoll == 0 && target.SaveDC == 0
+	rs.RecordAttack(AttackInfo{
+		AttackerName: casterName,
+		TargetName:   target.Name,
+		Damage:       target.Damage,
+		Hit:          target.Hit || autoHit || target.Damage > 0 || (target.SaveDC > 0 && !target.Saved),
+		Critical:     target.Critical,
+		Saved:        target.Saved,
+		WeaponName:   spellName,
+	})
+}
+
 // GetPlayerSummary returns a formatted summary for a specific player
 func (rs *RoundSummary) GetPlayerSummary(playerName string) string {
 	info, exists := rs.PlayerActions[playerName]
@@ -96,10 +113,19 @@ func (rs *RoundSummary) GetPlayerSummary(playerName string) string {
 					icon = "⚔️"
 				}
 			}
+			if atk.Saved {
+				icon = "🛡️"
+			}
 			sb.WriteString(fmt.Sprintf("%s %s → **%s** ", icon, atk.WeaponName, atk.TargetName))
-			if atk.Hit {
+			switch {
+			case atk.Hit:
 				sb.WriteString(fmt.Sprintf("🩸 **%d** damage", atk.Damage))
-			} else {
+				if atk.Saved {
+					sb.WriteString(" (saved)")
+				}
+			case atk.Saved:
+				sb.WriteString("**SAVED**")
+			default:
 				sb.WriteString("**MISS**")
 			}
 			sb.WriteString("\n")
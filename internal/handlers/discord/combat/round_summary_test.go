@@ -3,6 +3,7 @@ package combat
 import (
 	"testing"
 
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,6 +46,22 @@ func TestRoundSummary(t *testing.T) {
 		assert.Len(t, grunkInfo.AttacksRecvd, 2)
 	})
 
+	t.Run("RecordSpell tracks saves and damage", func(t *testing.T) {
+		rs := NewRoundSummary(1)
+
+		rs.RecordSpell("Elminster", "Fireball", &spell.TargetResult{Name: "Goblin", SaveDC: 14, Saved: true, Damage: 12})
+		rs.RecordSpell("Elminster", "Fireball", &spell.TargetResult{Name: "Orc", SaveDC: 14, Damage: 24})
+		rs.RecordSpell("Elminster", "Fire Bolt", &spell.TargetResult{Name: "Wolf", AttackRoll: 3, AttackTotal: 9})
+
+		info := rs.PlayerActions["Elminster"]
+		assert.Equal(t, 36, info.DamageDealt)
+		assert.Len(t, info.AttacksMade, 3)
+
+		summary := rs.GetPlayerSummary("Elminster")
+		assert.Contains(t, summary, "Fireball → **Goblin** 🩸 **12** damage (saved)")
+		assert.Contains(t, summary, "Fire Bolt → **Wolf** **MISS**")
+	})
+
 	t.Run("GetPlayerSummary formats correctly", func(t *testing.T) {
 		rs := NewRoundSummary(2)
 
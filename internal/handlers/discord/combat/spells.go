@@ -0,0 +1,681 @@
+package combat
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	character2 "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
+	"github.com/bwmarrin/discordgo"
+)
+
+// Discord allows at most 25 options in a select menu
+const maxSelectOptions = 25
+
+// spellOption is a spell in the caster's menu along with whether it can be cast right now
+type spellOption struct {
+	Spell      *rulebook.Spell
+	Available  bool
+	Reason     string
+	SlotLevels []int // Slot levels the spell can be cast with; empty for cantrips
+}
+
+// canCastSpells reports whether the character has any spells to show
+func canCastSpells(char *character2.Character) bool {
+	if char == nil || char.Spells == nil {
+		return false
+	}
+	return len(char.Spells.Cantrips) > 0 || len(char.Spells.KnownSpells) > 0 || len(char.Spells.PreparedSpells) > 0
+}
+
+// spellKeys returns the character's cantrips, known and prepared spells
+// without duplicates
+func spellKeys(char *character2.Character) []string {
+	if char.Spells == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	var keys []string
+	for _, list := range [][]string{char.Spells.Cantrips, char.Spells.KnownSpells, char.Spells.PreparedSpells} {
+		for _, key := range list {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+// availableSlotLevels returns the slot levels with slots left that can cast the spell.
+// Casters with only pact magic always cast at the pact slot level.
+func availableSlotLevels(char *character2.Character, spellData *rulebook.Spell) []int {
+	if spellData.Level == 0 {
+		return nil
+	}
+
+	resources := char.GetResources()
+	if resources.PactMagicOnly() {
+		pactLevel := resources.PactSlotLevel()
+		if pactLevel >= spellData.Level && resources.SpellSlots[pactLevel].Remaining > 0 {
+			return []int{pactLevel}
+		}
+		return nil
+	}
+
+	var levels []int
+	for level := spellData.Level; level <= 9; level++ {
+		if slot, exists := resources.SpellSlots[level]; exists && slot.Remaining > 0 {
+			levels = append(levels, level)
+		}
+	}
+	return levels
+}
+
+// buildSpellOption checks slots and the action economy for a spell
+func buildSpellOption(char *character2.Character, spellData *rulebook.Spell) *spellOption {
+	option := &spellOption{
+		Spell:      spellData,
+		SlotLevels: availableSlotLevels(char, spellData),
+	}
+
+	option.Available, option.Reason = char.CanCastSpellThisTurn(spellData.Level, spellData.CastingTime)
+	if option.Available && spellData.Level > 0 && len(option.SlotLevels) == 0 {
+		option.Available = false
+		option.Reason = "No spell slots left"
+	}
+	return option
+}
+
+// getSpellOptions loads the spells the character can cast, sorted by level
+// then name. Unprepared spells are left out.
+func (h *Handler) getSpellOptions(char *character2.Character) []*spellOption {
+	var options []*spellOption
+	for _, key := range spellKeys(char) {
+		spellData, err := h.characterService.GetSpell(context.Background(), key)
+		if err != nil || spellData == nil {
+			log.Printf("Failed to load spell %s for %s: %v", key, char.Name, err)
+			continue
+		}
+		if !char.CanCastSpell(spellData) {
+			continue
+		}
+		options = append(options, buildSpellOption(char, spellData))
+	}
+
+	sort.SliceStable(options, func(a, b int) bool {
+		if options[a].Spell.Level != options[b].Spell.Level {
+			return options[a].Spell.Level < options[b].Spell.Level
+		}
+		return options[a].Spell.Name < options[b].Spell.Name
+	})
+	return options
+}
+
+// spellNeedsTargets reports whether a spell is aimed at creatures
+func spellNeedsTargets(spellData *rulebook.Spell) bool {
+	return spellData.Damage != nil || spellData.DC != nil || rulebook.HealingDie(spellData.Key) > 0
+}
+
+// canTargetWithSpell reports whether a combatant is offered as a target.
+// Healing spells are aimed at allies, including the caster and anyone at 0
+// hit points; other spells at everyone else still in the fight.
+func canTargetWithSpell(spellData *rulebook.Spell, caster, target *combat.Combatant) bool {
+	if rulebook.HealingDie(spellData.Key) > 0 {
+		return target.Type == caster.Type && (target.IsActive || !target.IsAlive())
+	}
+	return target.IsActive && target.IsAlive() && target.ID != caster.ID
+}
+
+// maxSpellTargets returns how many creatures a spell can be aimed at
+func maxSpellTargets(spellData *rulebook.Spell, slotLevel int) int {
+	switch {
+	case spellData.AreaOfEffect != nil:
+		return maxSelectOptions
+	case spellData.Key == shared.SpellKeyMagicMissile:
+		return 2 + max(slotLevel, 1)
+	default:
+		return 1
+	}
+}
+
+// formatSpellLevel returns "Cantrip" or "Level N"
+func formatSpellLevel(level int) string {
+	if level == 0 {
+		return "Cantrip"
+	}
+	return fmt.Sprintf("Level %d", level)
+}
+
+// findPlayerCombatant returns the active combatant controlled by the user
+func findPlayerCombatant(enc *combat.Encounter, userID string) *combat.Combatant {
+	for _, c := range enc.Combatants {
+		if c.PlayerID == userID && c.IsActive {
+			return c
+		}
+	}
+	return nil
+}
+
+// spellCaster is the player casting a spell on their turn
+type spellCaster struct {
+	Encounter *combat.Encounter
+	Combatant *combat.Combatant
+	Character *character2.Character
+}
+
+// loadCaster loads the encounter, the player's combatant and character. When
+// the player can't cast right now it responds to the interaction and returns nil.
+func (h *Handler) loadCaster(s *discordgo.Session, i *discordgo.InteractionCreate, encounterID string) (*spellCaster, error) {
+	enc, err := h.encounterService.GetEncounter(context.Background(), encounterID)
+	if err != nil {
+		return nil, respondError(s, i, "Failed to get encounter", err)
+	}
+
+	playerCombatant := findPlayerCombatant(enc, i.Member.User.ID)
+	if playerCombatant == nil {
+		return nil, respondError(s, i, "You are not in this combat!", nil)
+	}
+
+	current := enc.GetCurrentCombatant()
+	if current == nil || current.ID != playerCombatant.ID {
+		currentName := "Unknown"
+		if current != nil {
+			currentName = current.Name
+		}
+		return nil, respondSpellNotYourTurn(s, i, encounterID, currentName)
+	}
+
+	char, err := h.characterService.GetByID(playerCombatant.CharacterID)
+	if err != nil {
+		return nil, respondError(s, i, "Failed to get character", err)
+	}
+
+	return &spellCaster{Encounter: enc, Combatant: playerCombatant, Character: char}, nil
+}
+
+// respondSpellNotYourTurn shows a friendly message when casting out of turn
+func respondSpellNotYourTurn(s *discordgo.Session, i *discordgo.InteractionCreate, encounterID, currentName string) error {
+	if !isEphemeralInteraction(i) {
+		return respondError(s, i, fmt.Sprintf("It's currently %s's turn", currentName), nil)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "⏳ Not Your Turn",
+		Description: fmt.Sprintf("It's currently **%s's** turn.", currentName),
+		Color:       0xf39c12, // Orange
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Wait for your turn to cast spells",
+		},
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: []discordgo.MessageComponent{backToActionsRow(encounterID)},
+		},
+	})
+}
+
+// backToActionsRow returns a row with the "Back to Actions" button
+func backToActionsRow(encounterID string) discordgo.ActionsRow {
+	return discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    "Back to Actions",
+				Style:    discordgo.SecondaryButton,
+				CustomID: fmt.Sprintf("combat:my_actions:%s", encounterID),
+				Emoji:    &discordgo.ComponentEmoji{Name: "🎯"},
+			},
+		},
+	}
+}
+
+// handleShowSpells displays the player's spells and which can be cast this turn
+func (h *Handler) handleShowSpells(s *discordgo.Session, i *discordgo.InteractionCreate, encounterID string) error {
+	caster, err := h.loadCaster(s, i, encounterID)
+	if caster == nil {
+		return err
+	}
+	char := caster.Character
+
+	options := h.getSpellOptions(char)
+
+	var spellList []string
+	var menuOptions []discordgo.SelectMenuOption
+	for _, option := range options {
+		status := "✅"
+		detail := option.Spell.CastingTime
+		if !option.Available {
+			status = "❌"
+			detail += " | " + option.Reason
+		}
+		spellList = append(spellList, fmt.Sprintf("%s **%s** (%s) - %s",
+			status, option.Spell.Name, formatSpellLevel(option.Spell.Level), detail))
+
+		if option.Available && len(menuOptions) < maxSelectOptions {
+			menuOptions = append(menuOptions, discordgo.SelectMenuOption{
+				Label:       option.Spell.Name,
+				Value:       option.Spell.Key,
+				Description: fmt.Sprintf("%s • %s", formatSpellLevel(option.Spell.Level), option.Spell.CastingTime),
+				Emoji:       &discordgo.ComponentEmoji{Name: "🔮"},
+			})
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🔮 %s's Spells", caster.Combatant.Name),
+		Color:       0x9b59b6, // Purple
+		Description: strings.Join(spellList, "\n"),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "A bonus action spell leaves only cantrips for your action",
+		},
+	}
+	if len(spellList) == 0 {
+		embed.Description = "You don't know any spells."
+	}
+	if slots := char.GetResources().SpellSlotSummary(); slots != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Spell Slots",
+			Value: slots,
+		})
+	}
+
+	var components []discordgo.MessageComponent
+	if len(menuOptions) > 0 {
+		components = append(components, discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    fmt.Sprintf("combat:cast_spell:%s", encounterID),
+					Placeholder: "Choose a spell to cast",
+					Options:     menuOptions,
+				},
+			},
+		})
+	}
+	components = append(components, backToActionsRow(encounterID))
+
+	if isEphemeralInteraction(i) {
+		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Embeds:     []*discordgo.MessageEmbed{embed},
+				Components: components,
+			},
+		})
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+			Flags:      discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
+
+// handleCastSpell handles a spell picked from the menu
+func (h *Handler) handleCastSpell(s *discordgo.Session, i *discordgo.InteractionCreate, encounterID string) error {
+	values := i.MessageComponentData().Values
+	if len(values) == 0 {
+		return respondError(s, i, "No spell selected", nil)
+	}
+
+	caster, err := h.loadCaster(s, i, encounterID)
+	if caster == nil {
+		return err
+	}
+
+	spellData, err := h.characterService.GetSpell(context.Background(), values[0])
+	if err != nil {
+		return respondError(s, i, "Failed to get spell", err)
+	}
+
+	option := buildSpellOption(caster.Character, spellData)
+	if !option.Available {
+		return respondError(s, i, fmt.Sprintf("You can't cast %s: %s", spellData.Name, option.Reason), nil)
+	}
+
+	// Offer upcasting when more than one slot level is available
+	if len(option.SlotLevels) > 1 {
+		return h.showSpellSlotSelection(s, i, encounterID, option)
+	}
+
+	slotLevel := 0
+	if len(option.SlotLevels) == 1 {
+		slotLevel = option.SlotLevels[0]
+	}
+	return h.continueCast(s, i, caster, spellData, slotLevel)
+}
+
+// showSpellSlotSelection lets the player choose which slot level to cast with
+func (h *Handler) showSpellSlotSelection(s *discordgo.Session, i *discordgo.InteractionCreate, encounterID string, option *spellOption) error {
+	var buttons []discordgo.MessageComponent
+	for _, level := range option.SlotLevels {
+		label := fmt.Sprintf("Level %d", level)
+		if level > option.Spell.Level {
+			label += " (upcast)"
+		}
+		buttons = append(buttons, discordgo.Button{
+			Label:    label,
+			Style:    discordgo.PrimaryButton,
+			CustomID: fmt.Sprintf("combat:cast_slot:%s:%s:%d", encounterID, option.Spell.Key, level),
+		})
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🔮 Cast %s", option.Spell.Name),
+		Description: "Choose a spell slot. Higher slots make many spells more powerful.",
+		Color:       0x9b59b6, // Purple
+	}
+	if option.Spell.HigherLevel != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "At Higher Levels",
+			Value: option.Spell.HigherLevel,
+		})
+	}
+
+	components := []discordgo.MessageComponent{}
+	for start := 0; start < len(buttons); start += 5 {
+		end := min(start+5, len(buttons))
+		components = append(components, discordgo.ActionsRow{Components: buttons[start:end]})
+	}
+	components = append(components, spellCancelRow(encounterID))
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	})
+}
+
+// handleCastSlot handles the slot level choice: combat:cast_slot:encounterID:spellKey:level
+func (h *Handler) handleCastSlot(s *discordgo.Session, i *discordgo.InteractionCreate, encounterID string) error {
+	parts := parseCustomID(i.MessageComponentData().CustomID)
+	if len(parts) < 5 {
+		return respondError(s, i, "Invalid spell slot selection", nil)
+	}
+	slotLevel, err := strconv.Atoi(parts[4])
+	if err != nil {
+		return respondError(s, i, "Invalid spell slot level", err)
+	}
+
+	caster, err := h.loadCaster(s, i, encounterID)
+	if caster == nil {
+		return err
+	}
+
+	spellData, err := h.characterService.GetSpell(context.Background(), parts[3])
+	if err != nil {
+		return respondError(s, i, "Failed to get spell", err)
+	}
+
+	return h.continueCast(s, i, caster, spellData, slotLevel)
+}
+
+// continueCast asks for targets when the spell needs them, otherwise casts it
+func (h *Handler) continueCast(s *discordgo.Session, i *discordgo.InteractionCreate, caster *spellCaster, spellData *rulebook.Spell, slotLevel int) error {
+	if spellNeedsTargets(spellData) {
+		customID := fmt.Sprintf("combat:cast_target:%s:%s:%d", caster.Encounter.ID, spellData.Key, slotLevel)
+		return h.showSpellTargetSelection(s, i, caster, spellData, slotLevel, customID, spellCancelRow(caster.Encounter.ID))
+	}
+	return h.executeSpell(s, i, caster.Encounter.ID, spellData.Key, slotLevel, nil)
+}
+
+// showSpellTargetSelection lists the creatures a spell can be aimed at. The
+// chosen targets are sent to customID.
+func (h *Handler) showSpellTargetSelection(s *discordgo.Session, i *discordgo.InteractionCreate, spellCaster *spellCaster, spellData *rulebook.Spell, slotLevel int, customID string, cancelRow discordgo.ActionsRow) error {
+	enc, caster := spellCaster.Encounter, spellCaster.Combatant
+	var enemies, allies []discordgo.SelectMenuOption
+	for _, id := range enc.TurnOrder {
+		target, exists := enc.Combatants[id]
+		if !exists || !canTargetWithSpell(spellData, caster, target) {
+			continue
+		}
+
+		option := discordgo.SelectMenuOption{
+			Label: target.Name,
+			Value: target.ID,
+			Description: fmt.Sprintf("HP: %d/%d | AC: %d",
+				target.CurrentHP, target.MaxHP, target.AC),
+		}
+		if target.Type == caster.Type {
+			option.Emoji = &discordgo.ComponentEmoji{Name: "👤"}
+			allies = append(allies, option)
+		} else {
+			option.Emoji = &discordgo.ComponentEmoji{Name: "👹"}
+			enemies = append(enemies, option)
+		}
+	}
+
+	// Enemies first; allies stay selectable for area spells that catch them
+	options := append(enemies, allies...)
+	if len(options) > maxSelectOptions {
+		options = options[:maxSelectOptions]
+	}
+	if len(options) == 0 {
+		return respondError(s, i, "There are no targets for this spell", nil)
+	}
+
+	maxTargets := min(maxSpellTargets(spellData, slotLevel), len(options))
+	minValues := 1
+
+	description := fmt.Sprintf("Choose a target for **%s**.", spellData.Name)
+	if maxTargets > 1 {
+		description = fmt.Sprintf("Choose up to %d targets for **%s**.", maxTargets, spellData.Name)
+	}
+	if spellData.AreaOfEffect != nil {
+		description += fmt.Sprintf("\nPick everyone caught in the %d-foot %s.",
+			spellData.AreaOfEffect.Size, spellData.AreaOfEffect.Type)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🎯 Select Targets for %s", spellData.Name),
+		Description: description,
+		Color:       0x3498db, // Blue
+	}
+	if spellData.Range != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Range",
+			Value:  spellData.Range,
+			Inline: true,
+		})
+	}
+	if slotLevel > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Slot",
+			Value:  fmt.Sprintf("Level %d", slotLevel),
+			Inline: true,
+		})
+	}
+
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    customID,
+					Placeholder: "Choose targets",
+					MinValues:   &minValues,
+					MaxValues:   maxTargets,
+					Options:     options,
+				},
+			},
+		},
+		cancelRow,
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	})
+}
+
+// spellCancelRow returns a row with a button back to the spell menu
+func spellCancelRow(encounterID string) discordgo.ActionsRow {
+	return discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    "Cancel",
+				Style:    discordgo.SecondaryButton,
+				CustomID: fmt.Sprintf("combat:spells:%s", encounterID),
+				Emoji:    &discordgo.ComponentEmoji{Name: "❌"},
+			},
+		},
+	}
+}
+
+// handleCastTarget casts the spell at the chosen targets: combat:cast_target:encounterID:spellKey:level
+func (h *Handler) handleCastTarget(s *discordgo.Session, i *discordgo.InteractionCreate, encounterID string) error {
+	parts := parseCustomID(i.MessageComponentData().CustomID)
+	if len(parts) < 5 {
+		return respondError(s, i, "Invalid target selection", nil)
+	}
+	slotLevel, err := strconv.Atoi(parts[4])
+	if err != nil {
+		return respondError(s, i, "Invalid spell slot level", err)
+	}
+
+	targetIDs := i.MessageComponentData().Values
+	if len(targetIDs) == 0 {
+		return respondError(s, i, "No targets selected", nil)
+	}
+
+	return h.executeSpell(s, i, encounterID, parts[3], slotLevel, targetIDs)
+}
+
+// executeSpell casts the spell, then shows the result on the action controller
+// and the shared combat message. The turn is checked again because the turn
+// can pass while the player picks targets.
+func (h *Handler) executeSpell(s *discordgo.Session, i *discordgo.InteractionCreate, encounterID, spellKey string, slotLevel int, targetIDs []string) error {
+	enc, err := h.encounterService.GetEncounter(context.Background(), encounterID)
+	if err != nil {
+		return respondError(s, i, "Failed to get encounter", err)
+	}
+	caster := findPlayerCombatant(enc, i.Member.User.ID)
+	if caster == nil {
+		return respondError(s, i, "You are not in this combat!", nil)
+	}
+	if current := enc.GetCurrentCombatant(); current == nil || current.ID != caster.ID {
+		currentName := "Unknown"
+		if current != nil {
+			currentName = current.Name
+		}
+		return respondSpellNotYourTurn(s, i, encounterID, currentName)
+	}
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	}); err != nil {
+		log.Printf("Failed to defer spell cast: %v", err)
+		return fmt.Errorf("failed to defer: %w", err)
+	}
+
+	result, err := h.spellService.CastSpell(context.Background(), &spell.CastSpellInput{
+		CharacterID: caster.CharacterID,
+		UserID:      i.Member.User.ID,
+		SpellKey:    spellKey,
+		SlotLevel:   slotLevel,
+		EncounterID: encounterID,
+		TargetIDs:   targetIDs,
+	})
+	if err != nil {
+		return respondEditError(s, i, "Failed to cast spell", err)
+	}
+
+	// Reload to pick up damage and a possible end of combat
+	enc, err = h.encounterService.GetEncounter(context.Background(), encounterID)
+	if err != nil {
+		return respondEditError(s, i, "Failed to get updated encounter", err)
+	}
+
+	combatEnded := enc.Status == combat.EncounterStatusCompleted
+	playersWon := false
+	if combatEnded {
+		for _, c := range enc.Combatants {
+			if c.Type == combat.CombatantTypePlayer && c.IsActive {
+				playersWon = true
+				break
+			}
+		}
+	}
+
+	roundSummary := NewRoundSummary(enc.Round)
+	for _, target := range result.Targets {
+		if target.Healing > 0 {
+			continue
+		}
+		roundSummary.RecordSpell(caster.Name, result.SpellName, target)
+	}
+
+	resultText := fmt.Sprintf("**%s** cast **%s**", caster.Name, result.SpellName)
+	if result.SlotLevel > 0 {
+		resultText += fmt.Sprintf(" (level %d, %d slots left)", result.SlotLevel, result.SlotsLeft)
+	}
+	if result.Concentration {
+		resultText += "\n🧠 Concentrating"
+		if result.DroppedConcentration != "" {
+			resultText += fmt.Sprintf(" (%s ends)", result.DroppedConcentration)
+		}
+	}
+	if summary := roundSummary.GetPlayerSummary(caster.Name); summary != "" {
+		resultText += "\n" + summary
+	}
+	for _, target := range result.Targets {
+		if target.Healing > 0 {
+			resultText += fmt.Sprintf("\n💚 **%s** regains %d HP", target.Name, target.Healing)
+		}
+	}
+	resultText += getCombatEndMessage(combatEnded, playersWon)
+
+	actionEmbed, actionComponents, err := h.buildActionController(enc, encounterID, i.Member.User.ID)
+	if err != nil {
+		return respondEditError(s, i, "Failed to build action controller", err)
+	}
+	resultField := &discordgo.MessageEmbedField{
+		Name:  "🔮 Spell Result",
+		Value: resultText,
+	}
+	if len(actionEmbed.Fields) > 0 {
+		actionEmbed.Fields = append([]*discordgo.MessageEmbedField{actionEmbed.Fields[0], resultField}, actionEmbed.Fields[1:]...)
+	} else {
+		actionEmbed.Fields = append(actionEmbed.Fields, resultField)
+	}
+
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{actionEmbed},
+		Components: &actionComponents,
+	}); err != nil {
+		log.Printf("Failed to update action controller with spell result: %v", err)
+		return fmt.Errorf("failed to edit response: %w", err)
+	}
+
+	// Everyone sees the cast on the shared combat message
+	sharedEmbed := BuildCombatStatusEmbed(enc, nil)
+	sharedEmbed.Description = fmt.Sprintf("🔮 %s\n\n%s", result.Message, sharedEmbed.Description)
+	appendCombatEndMessage(sharedEmbed, combatEnded, playersWon)
+	sharedComponents := BuildCombatComponents(encounterID, &encounter.ExecuteAttackResult{
+		CombatEnded: combatEnded,
+		PlayersWon:  playersWon,
+	})
+	if updateErr := updateSharedCombatMessage(s, encounterID, enc.MessageID, enc.ChannelID, sharedEmbed, sharedComponents); updateErr != nil {
+		log.Printf("Failed to update shared combat message: %v", updateErr)
+	}
+
+	return nil
+}
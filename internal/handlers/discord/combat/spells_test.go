@@ -0,0 +1,119 @@
+package combat
+
+import (
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func createSpellcaster(classKey string, level int) *character.Character {
+	char := &character.Character{
+		ID:    "char_1",
+		Name:  "Caster",
+		Level: level,
+		Class: &rulebook.Class{Key: classKey, HitDie: 6},
+		Spells: &character.SpellList{
+			Cantrips:       []string{"fire-bolt"},
+			KnownSpells:    []string{"magic-missile", "fireball"},
+			PreparedSpells: []string{"magic-missile"},
+		},
+	}
+	char.InitializeResources()
+	return char
+}
+
+func TestSpellKeys(t *testing.T) {
+	char := createSpellcaster("wizard", 5)
+	assert.Equal(t, []string{"fire-bolt", "magic-missile", "fireball"}, spellKeys(char))
+	assert.True(t, canCastSpells(char))
+	assert.False(t, canCastSpells(&character.Character{}))
+}
+
+func TestAvailableSlotLevels(t *testing.T) {
+	magicMissile := &rulebook.Spell{Key: "magic-missile", Level: 1, CastingTime: rulebook.CastingTimeAction}
+
+	t.Run("upcast levels with slots remaining", func(t *testing.T) {
+		wizard := createSpellcaster("wizard", 5)
+		wizard.Resources.SpellSlots[2] = shared.SpellSlotInfo{Max: 3, Remaining: 0}
+
+		assert.Equal(t, []int{1, 3}, availableSlotLevels(wizard, magicMissile))
+		assert.Nil(t, availableSlotLevels(wizard, &rulebook.Spell{Level: 0}))
+	})
+
+	t.Run("pact magic only offers the pact level", func(t *testing.T) {
+		warlock := createSpellcaster("warlock", 5)
+		assert.Equal(t, []int{3}, availableSlotLevels(warlock, magicMissile))
+	})
+
+	t.Run("multiclass warlocks use every slot level", func(t *testing.T) {
+		caster := createSpellcaster("wizard", 7)
+		caster.Classes = []*character.ClassLevel{
+			{Class: caster.Class, Level: 5},
+			{Class: &rulebook.Class{Key: "warlock", HitDie: 8}, Level: 2},
+		}
+		caster.InitializeResources()
+
+		assert.Equal(t, []int{1, 2, 3}, availableSlotLevels(caster, magicMissile))
+	})
+}
+
+func TestBuildSpellOption(t *testing.T) {
+	fireBolt := &rulebook.Spell{Key: "fire-bolt", Name: "Fire Bolt", CastingTime: rulebook.CastingTimeAction}
+	fireball := &rulebook.Spell{Key: "fireball", Name: "Fireball", Level: 3, CastingTime: rulebook.CastingTimeAction}
+	rope := &rulebook.Spell{Key: "rope-trick", Name: "Rope Trick", Level: 2, CastingTime: "1 minute"}
+
+	t.Run("bonus action spell leaves only action cantrips", func(t *testing.T) {
+		wizard := createSpellcaster("wizard", 5)
+		wizard.RecordSpellCast("misty-step", 2, rulebook.CastingTimeBonusAction)
+
+		assert.True(t, buildSpellOption(wizard, fireBolt).Available)
+
+		option := buildSpellOption(wizard, fireball)
+		assert.False(t, option.Available)
+		assert.Contains(t, option.Reason, "cantrip")
+	})
+
+	t.Run("no slots left", func(t *testing.T) {
+		wizard := createSpellcaster("wizard", 5)
+		wizard.Resources.SpellSlots[3] = shared.SpellSlotInfo{Max: 2, Remaining: 0}
+
+		option := buildSpellOption(wizard, fireball)
+		assert.False(t, option.Available)
+		assert.Equal(t, "No spell slots left", option.Reason)
+	})
+
+	t.Run("long casting times are unavailable in combat", func(t *testing.T) {
+		wizard := createSpellcaster("wizard", 5)
+		assert.False(t, buildSpellOption(wizard, rope).Available)
+	})
+}
+
+func TestMaxSpellTargets(t *testing.T) {
+	assert.Equal(t, 1, maxSpellTargets(&rulebook.Spell{Key: "fire-bolt"}, 0))
+	assert.Equal(t, 4, maxSpellTargets(&rulebook.Spell{Key: shared.SpellKeyMagicMissile}, 2))
+	assert.Equal(t, maxSelectOptions, maxSpellTargets(&rulebook.Spell{
+		Key:          "fireball",
+		AreaOfEffect: &rulebook.SpellAreaOfEffect{Type: "sphere", Size: 20},
+	}, 3))
+}
+
+func TestCanTargetWithSpell(t *testing.T) {
+	caster := &combat.Combatant{ID: "wizard", Type: combat.CombatantTypePlayer, CurrentHP: 10, IsActive: true}
+	downed := &combat.Combatant{ID: "fighter", Type: combat.CombatantTypePlayer, CurrentHP: 0}
+	goblin := &combat.Combatant{ID: "goblin", Type: combat.CombatantTypeMonster, CurrentHP: 7, IsActive: true}
+	cureWounds := &rulebook.Spell{Key: shared.SpellKeyCureWounds}
+	fireBolt := &rulebook.Spell{Key: shared.SpellKeyFireBolt}
+
+	assert.True(t, spellNeedsTargets(cureWounds))
+	assert.True(t, canTargetWithSpell(cureWounds, caster, caster))
+	assert.True(t, canTargetWithSpell(cureWounds, caster, downed))
+	assert.False(t, canTargetWithSpell(cureWounds, caster, goblin))
+
+	assert.False(t, canTargetWithSpell(fireBolt, caster, caster))
+	assert.False(t, canTargetWithSpell(fireBolt, caster, downed))
+	assert.True(t, canTargetWithSpell(fireBolt, caster, goblin))
+}
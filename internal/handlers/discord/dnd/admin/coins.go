@@ -0,0 +1,145 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/bwmarrin/discordgo"
+
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	sessionService "github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+	walletService "github.com/KirkDiggler/dnd-bot-discord/internal/services/wallet"
+)
+
+// CoinsHandler handles the DM's give and take coin commands
+type CoinsHandler struct {
+	sessionService sessionService.Service
+	walletService  walletService.Service
+}
+
+// NewCoinsHandler creates a new coins handler
+func NewCoinsHandler(serviceProvider *services.Provider) *CoinsHandler {
+	return &CoinsHandler{
+		sessionService: serviceProvider.SessionService,
+		walletService:  serviceProvider.WalletService,
+	}
+}
+
+// HandleGive gives coins to a character in the session the user runs
+func (h *CoinsHandler) HandleGive(s *discordgo.Session, i *discordgo.InteractionCreate, characterName string, amount int64, coin string) error {
+	return h.handleAdjust(s, i, characterName, amount, coin, true)
+}
+
+// HandleTake takes coins from a character in the session the user runs
+func (h *CoinsHandler) HandleTake(s *discordgo.Session, i *discordgo.InteractionCreate, characterName string, amount int64, coin string) error {
+	return h.handleAdjust(s, i, characterName, amount, coin, false)
+}
+
+func (h *CoinsHandler) handleAdjust(s *discordgo.Session, i *discordgo.InteractionCreate, characterName string, amount int64, coin string, give bool) error {
+	// Coin changes are announced to the table
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to defer response: %w", err)
+	}
+
+	ctx := context.Background()
+	userID := i.Member.User.ID
+
+	parsedCoin, ok := shared.ParseCoin(coin)
+	if !ok {
+		return h.respondError(s, i, fmt.Sprintf("Unknown coin '%s'", coin), nil)
+	}
+
+	sess, err := h.findDMSession(ctx, userID)
+	if err != nil {
+		return h.respondError(s, i, "Failed to retrieve your active sessions", err)
+	}
+	if sess == nil {
+		return h.respondError(s, i, "You're not the DM of any active session", nil)
+	}
+
+	input := &walletService.AdjustInput{
+		SessionID:     sess.ID,
+		UserID:        userID,
+		CharacterName: characterName,
+		Amount:        int(amount),
+		Coin:          parsedCoin,
+	}
+
+	title := "💰 Coins Given"
+	description := fmt.Sprintf("The DM gives **%d %s** to %s", amount, parsedCoin, characterName)
+	adjust := h.walletService.Give
+	if !give {
+		title = "💸 Coins Taken"
+		description = fmt.Sprintf("The DM takes **%d %s** from %s", amount, parsedCoin, characterName)
+		adjust = h.walletService.Take
+	}
+
+	char, err := adjust(ctx, input)
+	if err != nil {
+		return h.respondError(s, i, err.Error(), err)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: description,
+		Color:       0xf1c40f, // Gold
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   fmt.Sprintf("%s's Coins", char.Name),
+				Value:  char.Wallet.String(),
+				Inline: false,
+			},
+		},
+	}
+
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+	})
+	return err
+}
+
+// findDMSession returns the most recently active session the user is the DM of
+func (h *CoinsHandler) findDMSession(ctx context.Context, userID string) (*gameSession.Session, error) {
+	sessions, err := h.sessionService.ListActiveUserSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var session *gameSession.Session
+	for _, s := range sessions {
+		member, exists := s.Members[userID]
+		if !exists || member.Role != gameSession.SessionRoleDM {
+			continue
+		}
+		if session == nil || s.LastActive.After(session.LastActive) {
+			session = s
+		}
+	}
+	return session, nil
+}
+
+func (h *CoinsHandler) respondError(s *discordgo.Session, i *discordgo.InteractionCreate, message string, err error) error {
+	if err != nil {
+		log.Printf("Admin coins error: %s: %v", message, err)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "❌ Error",
+		Description: message,
+		Color:       0xff0000,
+	}
+
+	_, editErr := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+	})
+	if editErr != nil {
+		log.Printf("Failed to edit interaction response: %v", editErr)
+	}
+	return nil
+}
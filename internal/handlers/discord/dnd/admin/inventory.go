@@ -8,6 +8,7 @@ import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
@@ -45,6 +46,112 @@ func (h *InventoryHandler) HandleGive(s *discordgo.Session, i *discordgo.Interac
 		return h.respondError(s, i, fmt.Sprintf("Character '%s' not found", characterName), nil)
 	}
 
+	// Consumables stack instead of taking a slot each
+	var equipmentValue equipment.Equipment
+	if consumable := h.buildConsumable(itemKey, int(quantity)); consumable != nil {
+		equipmentValue = consumable
+		targetChar.AddInventory(consumable)
+		quantity = 0
+	} else {
+		equipmentValue = buildItem(itemKey)
+	}
+
+	// Initialize inventory map if needed
+	if targetChar.Inventory == nil {
+		targetChar.Inventory = make(map[equipment.EquipmentType][]equipment.Equipment)
+	}
+
+	// Determine equipment type
+	equipType := equipmentValue.GetEquipmentType()
+	if equipType == "BasicEquipment" {
+		// Try to determine type from key
+		if itemKey == "shield" {
+			equipType = equipment.EquipmentTypeArmor
+		} else {
+			equipType = equipment.EquipmentTypeOther
+		}
+	}
+
+	// Add the item to the character's inventory
+	// Magic items get their own copy so charges are tracked per item
+	given := quantity
+	if consumable, ok := equipmentValue.(*equipment.Consumable); ok {
+		given = int64(consumable.Quantity)
+	}
+	for j := int64(0); j < quantity; j++ {
+		item := equipmentValue
+		if j > 0 && equipment.Magic(equipmentValue) != nil {
+			item = buildItem(itemKey)
+		}
+		targetChar.Inventory[equipType] = append(targetChar.Inventory[equipType], item)
+	}
+
+	// Save the character - use UpdateEquipment method
+	ctx := characterService.WithChange(context.Background(), i.Member.User.ID, fmt.Sprintf("admin gave %d %s", given, itemKey))
+	if updateErr := h.characterService.UpdateEquipment(ctx, targetChar); updateErr != nil {
+		return h.respondError(s, i, "Failed to save character", updateErr)
+	}
+
+	// Count total items
+	totalItems := 0
+	for _, items := range targetChar.Inventory {
+		totalItems += len(items)
+	}
+
+	// Send success response
+	embed := &discordgo.MessageEmbed{
+		Title:       "✅ Item Added",
+		Description: fmt.Sprintf("Added %d x **%s** to %s's inventory", given, equipmentValue.GetName(), targetChar.Name),
+		Color:       0x00ff00,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Current Inventory Count",
+				Value:  fmt.Sprintf("%d items", totalItems),
+				Inline: true,
+			},
+		},
+	}
+
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+	})
+	return err
+}
+
+// buildConsumable creates a stack of an SRD potion or ammunition, or a spell
+// scroll for keys like spell-scroll-fireball. It returns nil for other items.
+func (h *InventoryHandler) buildConsumable(itemKey string, quantity int) *equipment.Consumable {
+	if spellKey := equipment.ScrollSpell(itemKey); spellKey != "" {
+		spell, err := h.characterService.GetSpell(context.Background(), spellKey)
+		if err != nil || spell == nil {
+			return nil
+		}
+		scroll := equipment.NewSpellScroll(spell.Key, spell.Name, spell.Level)
+		scroll.Quantity = quantity
+		return scroll
+	}
+	return equipment.NewSRDConsumable(itemKey, quantity)
+}
+
+// buildItem creates the item for a key. SRD magic items are known by key and
+// +X weapons and armor use the mundane key with a "-plus-X" suffix, such as
+// longsword-plus-1.
+func buildItem(itemKey string) equipment.Equipment {
+	if item := equipment.NewSRDMagicItem(itemKey); item != nil {
+		return item
+	}
+
+	if idx := strings.LastIndex(itemKey, "-plus-"); idx != -1 {
+		if bonus, err := strconv.Atoi(itemKey[idx+len("-plus-"):]); err == nil && bonus >= 1 && bonus <= 3 {
+			switch base := buildItem(itemKey[:idx]).(type) {
+			case *equipment.Weapon:
+				return equipment.NewMagicWeapon(base, bonus)
+			case *equipment.Armor:
+				return equipment.NewMagicArmor(base, bonus)
+			}
+		}
+	}
+
 	// For testing purposes, create a simple weapon based on the item key
 	// In a real implementation, this would fetch from the D&D API
 	var equipmentValue equipment.Equipment
@@ -96,56 +203,19 @@ func (h *InventoryHandler) HandleGive(s *discordgo.Session, i *discordgo.Interac
 		}
 	}
 
-	// Initialize inventory map if needed
-	if targetChar.Inventory == nil {
-		targetChar.Inventory = make(map[equipment.EquipmentType][]equipment.Equipment)
-	}
+	return equipmentValue
+}
 
-	// Determine equipment type
-	equipType := equipmentValue.GetEquipmentType()
-	if equipType == "BasicEquipment" {
-		// Try to determine type from key
-		if itemKey == "shield" {
-			equipType = equipment.EquipmentTypeArmor
-		} else {
-			equipType = equipment.EquipmentTypeOther
+// hasItem checks if the character still has an item in their inventory
+func hasItem(char *character.Character, itemKey string) bool {
+	for _, items := range char.Inventory {
+		for _, item := range items {
+			if item.GetKey() == itemKey {
+				return true
+			}
 		}
 	}
-
-	// Add the item to the character's inventory
-	for j := int64(0); j < quantity; j++ {
-		targetChar.Inventory[equipType] = append(targetChar.Inventory[equipType], equipmentValue)
-	}
-
-	// Save the character - use UpdateEquipment method
-	if updateErr := h.characterService.UpdateEquipment(targetChar); updateErr != nil {
-		return h.respondError(s, i, "Failed to save character", updateErr)
-	}
-
-	// Count total items
-	totalItems := 0
-	for _, items := range targetChar.Inventory {
-		totalItems += len(items)
-	}
-
-	// Send success response
-	embed := &discordgo.MessageEmbed{
-		Title:       "✅ Item Added",
-		Description: fmt.Sprintf("Added %d x **%s** to %s's inventory", quantity, equipmentValue.GetName(), targetChar.Name),
-		Color:       0x00ff00,
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "Current Inventory Count",
-				Value:  fmt.Sprintf("%d items", totalItems),
-				Inline: true,
-			},
-		},
-	}
-
-	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
-		Embeds: &[]*discordgo.MessageEmbed{embed},
-	})
-	return err
+	return false
 }
 
 // HandleTake handles the admin take command
@@ -170,7 +240,11 @@ func (h *InventoryHandler) HandleTake(s *discordgo.Session, i *discordgo.Interac
 	for _, items := range targetChar.Inventory {
 		for _, item := range items {
 			if item.GetKey() == itemKey {
-				itemCount++
+				if stack, ok := item.(*equipment.Consumable); ok {
+					itemCount += stack.Quantity
+				} else {
+					itemCount++
+				}
 				if itemName == "" {
 					itemName = item.GetName()
 				}
@@ -188,8 +262,11 @@ func (h *InventoryHandler) HandleTake(s *discordgo.Session, i *discordgo.Interac
 		removeCount = int(quantity)
 	}
 
-	// Remove the items
+	// Remove the items, taking consumables out of their stacks
 	removed := 0
+	for removed < removeCount && targetChar.ConsumableCount(itemKey) > 0 && targetChar.ConsumeItem(itemKey) != nil {
+		removed++
+	}
 	for equipType, items := range targetChar.Inventory {
 		newItems := []equipment.Equipment{}
 		for _, item := range items {
@@ -211,13 +288,17 @@ func (h *InventoryHandler) HandleTake(s *discordgo.Session, i *discordgo.Interac
 	if targetChar.EquippedSlots != nil {
 		for slot, equipped := range targetChar.EquippedSlots {
 			if equipped != nil && equipped.GetKey() == itemKey {
-				delete(targetChar.EquippedSlots, slot)
+				targetChar.Unequip(slot)
 			}
 		}
 	}
+	if !hasItem(targetChar, itemKey) {
+		targetChar.Unattune(itemKey)
+	}
 
 	// Save the character
-	if updateErr := h.characterService.UpdateEquipment(targetChar); updateErr != nil {
+	ctx := characterService.WithChange(context.Background(), i.Member.User.ID, fmt.Sprintf("admin took %d %s", quantity, itemKey))
+	if updateErr := h.characterService.UpdateEquipment(ctx, targetChar); updateErr != nil {
 		return h.respondError(s, i, "Failed to save character", updateErr)
 	}
 
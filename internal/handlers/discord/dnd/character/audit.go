@@ -0,0 +1,187 @@
+package character
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/bwmarrin/discordgo"
+)
+
+// AuditRequest is the /dnd character audit command
+type AuditRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	Name        string // Name of the character
+}
+
+// AuditRepairRequest is the repair button on an audit report
+type AuditRepairRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	CharacterID string
+}
+
+// AuditHandler checks characters against the rules and repairs them
+type AuditHandler struct {
+	services *services.Provider
+}
+
+// NewAuditHandler creates a new audit handler
+func NewAuditHandler(serviceProvider *services.Provider) *AuditHandler {
+	return &AuditHandler{
+		services: serviceProvider,
+	}
+}
+
+// Handle audits one of the user's characters, or a character in a session
+// the user is the DM of
+func (h *AuditHandler) Handle(req *AuditRequest) error {
+	err := req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge interaction: %w", err)
+	}
+
+	ctx := context.Background()
+	char, err := findManagedCharacter(ctx, h.services, req.Interaction.Member.User.ID, req.Name)
+	if err != nil {
+		return h.editResponse(req, fmt.Sprintf("❌ %v", err))
+	}
+
+	report, err := h.services.CharacterService.AuditCharacter(ctx, char.ID)
+	if err != nil {
+		return h.editResponse(req, fmt.Sprintf("❌ Failed to audit %s: %v", char.Name, err))
+	}
+
+	var components []discordgo.MessageComponent
+	if repairable := len(report.AutoRepairable()); repairable > 0 {
+		components = []discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.Button{
+						Label:    fmt.Sprintf("Apply %d automatic repair(s)", repairable),
+						Style:    discordgo.PrimaryButton,
+						CustomID: fmt.Sprintf("character:audit_repair:%s", char.ID),
+						Emoji:    &discordgo.ComponentEmoji{Name: "🔧"},
+					},
+				},
+			},
+		}
+	}
+
+	_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{BuildAuditEmbed(report)},
+		Components: &components,
+	})
+	return err
+}
+
+// HandleRepair applies the automatic repairs after checking the user owns
+// the character or is the DM of a session it's in
+func (h *AuditHandler) HandleRepair(req *AuditRepairRequest) error {
+	ctx := context.Background()
+	userID := req.Interaction.Member.User.ID
+
+	char, err := h.services.CharacterService.GetCharacter(ctx, req.CharacterID)
+	if err != nil {
+		return h.updateMessage(req, fmt.Sprintf("❌ %v", err), nil)
+	}
+	if !managesCharacter(ctx, h.services, userID, char) {
+		return h.updateMessage(req, "❌ Only the character's owner or their DM can repair it", nil)
+	}
+
+	report, err := h.services.CharacterService.RepairCharacter(ctx, &charService.RepairCharacterInput{
+		CharacterID: req.CharacterID,
+		Actor:       userID,
+	})
+	if err != nil {
+		return h.updateMessage(req, fmt.Sprintf("❌ Failed to repair: %v", err), nil)
+	}
+
+	content := fmt.Sprintf("🔧 Repaired %d problem(s) on **%s**. Use `/dnd character history` to undo.",
+		len(report.Repaired), report.CharacterName)
+	return h.updateMessage(req, content, BuildAuditEmbed(report))
+}
+
+func (h *AuditHandler) editResponse(req *AuditRequest, content string) error {
+	_, err := req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Content: &content,
+	})
+	return err
+}
+
+func (h *AuditHandler) updateMessage(req *AuditRepairRequest, content string, embed *discordgo.MessageEmbed) error {
+	embeds := []*discordgo.MessageEmbed{}
+	if embed != nil {
+		embeds = append(embeds, embed)
+	}
+	return req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Embeds:     embeds,
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+}
+
+// BuildAuditEmbed shows an audit report, one field per rule
+func BuildAuditEmbed(report *charService.AuditReport) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("🔍 Audit: %s", report.CharacterName),
+		Color: 0x2ecc71,
+	}
+
+	switch {
+	case len(report.Violations) == 0:
+		embed.Description = "✅ Everything checks out against the rules."
+		return embed
+	case report.Legal():
+		embed.Description = "✅ Legal, with a few things worth a look."
+		embed.Color = 0xf1c40f
+	default:
+		embed.Description = "❌ Some things break the rules. 🔧 marks problems that can be repaired automatically."
+		embed.Color = 0xe74c3c
+	}
+
+	var rules []string
+	lines := make(map[string][]string)
+	for _, v := range report.Violations {
+		if _, seen := lines[v.Rule]; !seen {
+			rules = append(rules, v.Rule)
+		}
+		icon := "❌"
+		if v.Severity == charService.AuditSeverityWarning {
+			icon = "⚠️"
+		}
+		line := fmt.Sprintf("%s %s\n→ %s", icon, v.Message, v.Repair)
+		if v.AutoRepairable() {
+			line += " 🔧"
+		}
+		lines[v.Rule] = append(lines[v.Rule], line)
+	}
+
+	for _, rule := range rules {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  auditRuleNames[rule],
+			Value: truncateField(strings.Join(lines[rule], "\n")),
+		})
+	}
+	return embed
+}
+
+var auditRuleNames = map[string]string{
+	charService.AuditRuleAbilityScores: "Ability Scores",
+	charService.AuditRuleProficiencies: "Proficiencies",
+	charService.AuditRuleEquipment:     "Equipment",
+	charService.AuditRuleArmorClass:    "Armor Class",
+	charService.AuditRuleHitPoints:     "Hit Points",
+	charService.AuditRuleSpells:        "Spells",
+}
@@ -61,11 +61,11 @@ func (h *ShowHandler) Handle(req *ShowRequest) error {
 		Fields:      make([]*discordgo.MessageEmbedField, 0),
 	}
 
-	// Basic info
+	// Basic info; speed drops when the character carries more than they can
 	basicInfo := fmt.Sprintf("**Level:** %d\n**Experience:** %d XP\n**Speed:** %d ft",
 		char.Level,
 		char.Experience,
-		char.Speed,
+		char.EffectiveSpeed(false),
 	)
 
 	// Add race and class info
@@ -164,6 +164,14 @@ func (h *ShowHandler) Handle(req *ShowRequest) error {
 			})
 		}
 
+		if slots := char.Resources.SpellSlotSummary(); slots != "" {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:   "🔮 Spell Slots",
+				Value:  slots,
+				Inline: false,
+			})
+		}
+
 		// Abilities with uses
 		if len(char.Resources.Abilities) > 0 {
 			abilitiesValue := ""
@@ -194,7 +202,7 @@ func (h *ShowHandler) Handle(req *ShowRequest) error {
 		}
 		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
 			Name:   "🎒 Inventory",
-			Value:  fmt.Sprintf("Total items: %d", invCount),
+			Value:  fmt.Sprintf("Total items: %d\n%s", invCount, FormatLoad(char)),
 			Inline: true,
 		})
 	}
@@ -6,6 +6,7 @@ import (
 	character2 "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	"log"
+	"strings"
 
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
 	"github.com/bwmarrin/discordgo"
@@ -69,7 +70,8 @@ func (h *ClassFeaturesHandler) Handle(req *ClassFeaturesRequest) error {
 			log.Printf("DEBUG: Fighting style feature metadata: %v", feature.Metadata)
 		}
 	}
-	if err := h.characterService.UpdateEquipment(char); err != nil {
+	ctx := character.WithChange(context.Background(), char.OwnerID, fmt.Sprintf("chose %s", strings.ReplaceAll(req.FeatureType, "_", " ")))
+	if err := h.characterService.UpdateEquipment(ctx, char); err != nil {
 		log.Printf("DEBUG: Error saving character: %v", err)
 		return fmt.Errorf("failed to update character: %w", err)
 	}
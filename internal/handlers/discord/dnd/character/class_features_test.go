@@ -39,7 +39,7 @@ func TestClassFeaturesHandler_HandleFavoredEnemy(t *testing.T) {
 
 	// Mock the service calls
 	mockService.EXPECT().GetByID("test-ranger").Return(char, nil)
-	mockService.EXPECT().UpdateEquipment(char).Return(nil)
+	mockService.EXPECT().UpdateEquipment(gomock.Any(), char).Return(nil)
 
 	// Create a test request
 	req := &ClassFeaturesRequest{
@@ -87,7 +87,7 @@ func TestClassFeaturesHandler_HandleNaturalExplorer(t *testing.T) {
 
 	// Mock the service calls
 	mockService.EXPECT().GetByID("test-ranger").Return(char, nil)
-	mockService.EXPECT().UpdateEquipment(char).Return(nil)
+	mockService.EXPECT().UpdateEquipment(gomock.Any(), char).Return(nil)
 
 	// Create a test request
 	req := &ClassFeaturesRequest{
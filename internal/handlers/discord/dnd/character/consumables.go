@@ -0,0 +1,54 @@
+package character
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/bwmarrin/discordgo"
+)
+
+// FormatConsumable describes a potion, scroll or ammunition for the
+// inventory, or returns "" for other items
+func FormatConsumable(char *character.Character, item equipment.Equipment) string {
+	consumable := equipment.AsConsumable(item)
+	if consumable == nil {
+		return ""
+	}
+
+	lines := []string{fmt.Sprintf("**Carried:** %d", char.ConsumableCount(consumable.GetKey()))}
+	effect := consumable.Effect
+	switch {
+	case consumable.Kind == equipment.ConsumableScroll:
+		lines = append(lines, fmt.Sprintf("**Spell:** %s (level %d)", effect.Spell, effect.SpellLevel))
+	case effect.Heals():
+		lines = append(lines, fmt.Sprintf("**Heals:** %s", effect.HealingString()))
+	case consumable.Kind == equipment.ConsumableAmmunition:
+		lines = append(lines, "Spent when attacking; half is recovered after a fight")
+	}
+	for _, buff := range effect.Buffs {
+		lines = append(lines, fmt.Sprintf("**%s** to %s for %d minutes", buff.Value, buff.DamageType, max(effect.BuffRounds/10, 1)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// UseItemButton returns the button to use a potion or scroll outside of
+// combat, or nil if the item can't be used on its own
+func UseItemButton(char *character.Character, item equipment.Equipment) *discordgo.Button {
+	consumable := equipment.AsConsumable(item)
+	if consumable == nil || !consumable.IsUsable() {
+		return nil
+	}
+
+	label, emoji := "Drink", "🧪"
+	if consumable.Kind == equipment.ConsumableScroll {
+		label, emoji = "Read", "📜"
+	}
+	return &discordgo.Button{
+		Label:    label,
+		Style:    discordgo.SuccessButton,
+		CustomID: fmt.Sprintf("character:use_item:%s:%s", char.ID, item.GetKey()),
+		Emoji:    &discordgo.ComponentEmoji{Name: emoji},
+	}
+}
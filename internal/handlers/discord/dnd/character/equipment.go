@@ -1,6 +1,7 @@
 package character
 
 import (
+	"context"
 	"fmt"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -112,7 +114,8 @@ func (h *EquipmentHandler) HandleEquip(s *discordgo.Session, i *discordgo.Intera
 	}
 
 	// Save the equipment changes
-	if err := h.ServiceProvider.CharacterService.UpdateEquipment(char); err != nil {
+	ctx := character.WithChange(context.Background(), i.Member.User.ID, fmt.Sprintf("equipped %s", itemKey))
+	if err := h.ServiceProvider.CharacterService.UpdateEquipment(ctx, char); err != nil {
 		log.Printf("Failed to save equipment changes for character %s: %v", characterID, err)
 		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
@@ -244,10 +247,11 @@ func (h *EquipmentHandler) HandleUnequip(s *discordgo.Session, i *discordgo.Inte
 	itemName := equippedItem.GetName()
 
 	// Unequip the item
-	char.EquippedSlots[slot] = nil
+	char.Unequip(slot)
 
 	// Save the equipment changes
-	if err := h.ServiceProvider.CharacterService.UpdateEquipment(char); err != nil {
+	ctx := character.WithChange(context.Background(), i.Member.User.ID, fmt.Sprintf("unequipped %s", itemName))
+	if err := h.ServiceProvider.CharacterService.UpdateEquipment(ctx, char); err != nil {
 		log.Printf("Failed to save equipment changes for character %s: %v", characterID, err)
 		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
@@ -323,7 +327,7 @@ func (h *EquipmentHandler) HandleInventory(s *discordgo.Session, i *discordgo.In
 	// Build inventory display
 	embed := &discordgo.MessageEmbed{
 		Title:       fmt.Sprintf("🎒 %s's Inventory", char.Name),
-		Description: "Weapons and equipped items",
+		Description: fmt.Sprintf("Weapons and equipped items\n%s", FormatLoad(char)),
 		Color:       0x3498db, // Blue
 		Fields:      []*discordgo.MessageEmbedField{},
 	}
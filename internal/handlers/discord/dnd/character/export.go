@@ -0,0 +1,93 @@
+package character
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	"github.com/bwmarrin/discordgo"
+)
+
+// ExportRequest is the /dnd character export command
+type ExportRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	Name        string // Name of the character to export
+}
+
+// ExportHandler sends a player one of their characters as a JSON file
+type ExportHandler struct {
+	services *services.Provider
+}
+
+// NewExportHandler creates a new export handler
+func NewExportHandler(serviceProvider *services.Provider) *ExportHandler {
+	return &ExportHandler{
+		services: serviceProvider,
+	}
+}
+
+// unsafeFileChars are replaced in export file names
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// Handle exports the user's character with the requested name
+func (h *ExportHandler) Handle(req *ExportRequest) error {
+	err := req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge interaction: %w", err)
+	}
+
+	ctx := context.Background()
+	userID := req.Interaction.Member.User.ID
+
+	chars, err := h.services.CharacterService.ListCharacters(ctx, userID)
+	if err != nil {
+		return h.editResponse(req, fmt.Sprintf("❌ Failed to retrieve your characters: %v", err))
+	}
+
+	var characterID, name string
+	for _, char := range chars {
+		if char.Status != shared.CharacterStatusDraft && strings.EqualFold(char.Name, req.Name) {
+			characterID, name = char.ID, char.Name
+			break
+		}
+	}
+	if characterID == "" {
+		return h.editResponse(req, fmt.Sprintf("❌ You don't have a character named %s", req.Name))
+	}
+
+	data, err := h.services.CharacterService.ExportCharacter(ctx, characterID, userID)
+	if err != nil {
+		return h.editResponse(req, fmt.Sprintf("❌ %v", err))
+	}
+
+	content := fmt.Sprintf("📦 Here's **%s**. Use `/dnd character import` to bring them to another server.", name)
+	fileName := strings.Trim(unsafeFileChars.ReplaceAllString(strings.ToLower(name), "-"), "-") + ".json"
+	_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Content: &content,
+		Files: []*discordgo.File{
+			{
+				Name:        fileName,
+				ContentType: "application/json",
+				Reader:      bytes.NewReader(data),
+			},
+		},
+	})
+	return err
+}
+
+func (h *ExportHandler) editResponse(req *ExportRequest, content string) error {
+	_, err := req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Content: &content,
+	})
+	return err
+}
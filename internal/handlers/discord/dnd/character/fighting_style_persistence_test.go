@@ -1,6 +1,7 @@
 package character
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
@@ -48,8 +49,8 @@ func TestFightingStylePersistence(t *testing.T) {
 
 		// Mock saving the character - capture what gets saved
 		mockService.EXPECT().
-			UpdateEquipment(gomock.Any()).
-			Do(func(char *character.Character) {
+			UpdateEquipment(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, char *character.Character) {
 				savedCharacter = char
 			}).
 			Return(nil)
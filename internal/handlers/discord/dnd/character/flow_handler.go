@@ -8,6 +8,7 @@ import (
 
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	characterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -84,6 +85,15 @@ func (h *FlowHandler) HandleSelection(s *discordgo.Session, i *discordgo.Interac
 			Selections: selections,
 		}
 
+		// A fourth part is either a button's selection or the step's source
+		if len(parts) > 3 {
+			if len(selections) == 0 {
+				result.Selections = []string{parts[3]}
+			} else {
+				result.Metadata = map[string]any{"source": parts[3]}
+			}
+		}
+
 		// Process the result and get next step
 		nextStep, err := h.services.CreationFlowService.ProcessStepResult(ctx, characterID, result)
 		if err != nil {
@@ -91,11 +101,73 @@ func (h *FlowHandler) HandleSelection(s *discordgo.Session, i *discordgo.Interac
 			return respondWithError(s, i, "Failed to process selection")
 		}
 
+		// Background steps come before the proficiencies in the legacy flow,
+		// so go back there rather than wherever the flow is up to
+		if isBackgroundStep(stepType, result.Metadata) {
+			return h.ContinueToProficiencies(s, i, characterID)
+		}
+
 		// Route to next step handler
 		return h.routeToStepHandler(s, i, characterID, nextStep)
 	}
 }
 
+// ContinueToProficiencies shows the background steps the character hasn't
+// finished yet, then the proficiency choices
+func (h *FlowHandler) ContinueToProficiencies(s *discordgo.Session, i *discordgo.InteractionCreate, characterID string) error {
+	ctx := context.Background()
+
+	progressSteps, err := h.services.CreationFlowService.GetProgressSteps(ctx, characterID)
+	if err != nil {
+		log.Printf("Error getting progress steps for character %s: %v", characterID, err)
+		return respondWithError(s, i, "Failed to determine next creation step")
+	}
+
+	for _, stepInfo := range progressSteps {
+		step := stepInfo.Step
+		if !stepInfo.Completed && isBackgroundStep(step.Type, step.Context) {
+			return h.routeToStepHandler(s, i, characterID, &step)
+		}
+	}
+
+	char, err := h.services.CharacterService.GetByID(characterID)
+	if err != nil || char.Race == nil || char.Class == nil {
+		return respondWithError(s, i, "Failed to get character for proficiency selection")
+	}
+	return h.showProficiencies(s, i, char)
+}
+
+// showProficiencies shows the proficiency choices, leaving out what the
+// background already grants
+func (h *FlowHandler) showProficiencies(s *discordgo.Session, i *discordgo.InteractionCreate, char *character.Character) error {
+	handler := NewProficiencyChoicesHandler(&ProficiencyChoicesHandlerConfig{
+		CharacterService: h.services.CharacterService,
+	})
+	req := &ProficiencyChoicesRequest{
+		Session:     s,
+		Interaction: i,
+		RaceKey:     char.Race.Key,
+		ClassKey:    char.Class.Key,
+	}
+	if char.Background != nil {
+		req.BackgroundKey = char.Background.ID
+	}
+	return handler.Handle(req)
+}
+
+// isBackgroundStep checks if a step belongs to the background
+func isBackgroundStep(stepType character.CreationStepType, meta map[string]any) bool {
+	switch stepType {
+	case character.StepTypeBackgroundSelection, character.StepTypePersonalitySelection:
+		return true
+	case character.StepTypeLanguageSelection:
+		source, ok := meta["source"].(string)
+		return ok && source == characterService.BackgroundLanguageSource
+	default:
+		return false
+	}
+}
+
 // routeToStepHandler routes to the appropriate handler for a step type
 func (h *FlowHandler) routeToStepHandler(s *discordgo.Session, i *discordgo.InteractionCreate,
 	characterID string, step *character.CreationStep) error {
@@ -156,16 +228,7 @@ func (h *FlowHandler) routeToStepHandler(s *discordgo.Session, i *discordgo.Inte
 		}
 
 		// Use existing proficiency handler
-		handler := NewProficiencyChoicesHandler(&ProficiencyChoicesHandlerConfig{
-			CharacterService: h.services.CharacterService,
-		})
-		req := &ProficiencyChoicesRequest{
-			Session:     s,
-			Interaction: i,
-			RaceKey:     char.Race.Key,
-			ClassKey:    char.Class.Key,
-		}
-		return handler.Handle(req)
+		return h.showProficiencies(s, i, char)
 
 	case character.StepTypeEquipmentSelection:
 		// Get character to extract race and class keys
@@ -246,9 +309,13 @@ func (h *FlowHandler) routeToStepHandler(s *discordgo.Session, i *discordgo.Inte
 		}
 		return handler.ShowNaturalExplorerSelection(req)
 
+	case character.StepTypePersonalitySelection:
+		return h.renderPersonalityStep(s, i, step, characterID)
+
 	// New step types that need generic rendering
 	case character.StepTypeSkillSelection,
-		character.StepTypeLanguageSelection:
+		character.StepTypeLanguageSelection,
+		character.StepTypeBackgroundSelection:
 		return h.renderGenericStep(s, i, step, characterID)
 
 	default:
@@ -330,7 +397,7 @@ func (h *FlowHandler) renderGenericStep(s *discordgo.Session, i *discordgo.Inter
 			desc = desc[:97] + "..."
 		}
 		selectOptions = append(selectOptions, discordgo.SelectMenuOption{
-			Label:       option.Name,
+			Label:       truncateLabel(option.Name),
 			Value:       option.Key,
 			Description: desc,
 		})
@@ -347,6 +414,9 @@ func (h *FlowHandler) renderGenericStep(s *discordgo.Session, i *discordgo.Inter
 	}
 
 	customID := fmt.Sprintf("creation_flow:%s:%s", characterID, step.Type)
+	if source, ok := step.Context["source"].(string); ok {
+		customID += ":" + source
+	}
 
 	// Get placeholder from step context or use default
 	placeholder := "Make your selection..."
@@ -0,0 +1,111 @@
+package character
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/bwmarrin/discordgo"
+)
+
+// renderPersonalityStep shows one personality table at a time, in book order,
+// with buttons to roll it or everything still open
+func (h *FlowHandler) renderPersonalityStep(s *discordgo.Session, i *discordgo.InteractionCreate,
+	step *character.CreationStep, characterID string) error {
+
+	char, err := h.services.CharacterService.GetByID(characterID)
+	if err != nil {
+		return respondWithError(s, i, "Failed to get character for personality selection")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       step.Title,
+		Description: step.Description,
+		Color:       0x1abc9c, // Teal
+	}
+	if c, ok := step.Context["color"].(int); ok {
+		embed.Color = c
+	}
+
+	var table rulebook.PersonalityTable
+	for _, t := range rulebook.PersonalityTables {
+		if picks := char.Personality.Get(t); len(picks) > 0 {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:  t.DisplayName(),
+				Value: strings.Join(picks, "\n"),
+			})
+		}
+		if table == "" && !char.Personality.IsTableComplete(t) {
+			table = t
+		}
+	}
+	if table == "" {
+		return h.ContinueToProficiencies(s, i, characterID)
+	}
+
+	var selectOptions []discordgo.SelectMenuOption
+	for _, option := range step.Options {
+		if option.Metadata["table"] != string(table) {
+			continue
+		}
+		selectOptions = append(selectOptions, discordgo.SelectMenuOption{
+			Label: truncateLabel(option.Name),
+			Value: option.Key,
+		})
+	}
+
+	picks := table.Picks()
+	placeholder := fmt.Sprintf("Choose your %s...", strings.ToLower(table.DisplayName()))
+	if picks > 1 {
+		placeholder = fmt.Sprintf("Choose %d %s...", picks, strings.ToLower(table.DisplayName()))
+	}
+
+	customID := fmt.Sprintf("creation_flow:%s:%s", characterID, step.Type)
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    customID,
+					Placeholder: placeholder,
+					Options:     selectOptions,
+					MinValues:   &picks,
+					MaxValues:   picks,
+				},
+			},
+		},
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Roll " + table.DisplayName(),
+					Style:    discordgo.PrimaryButton,
+					CustomID: fmt.Sprintf("%s:roll-%s", customID, table),
+					Emoji:    &discordgo.ComponentEmoji{Name: "🎲"},
+				},
+				discordgo.Button{
+					Label:    "Roll Everything",
+					Style:    discordgo.SecondaryButton,
+					CustomID: customID + ":roll",
+					Emoji:    &discordgo.ComponentEmoji{Name: "🎲"},
+				},
+			},
+		},
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+			Flags:      discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
+
+// truncateLabel shortens text to the 100 characters a select option label allows
+func truncateLabel(text string) string {
+	if len(text) <= 100 {
+		return text
+	}
+	return text[:97] + "..."
+}
@@ -0,0 +1,240 @@
+package character
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	historyVersionsShown = 10 // Versions listed in the history overview
+	historyChangesShown  = 3  // Changes listed per version in the overview
+	historyValueLength   = 40 // Longest old or new value shown in a change
+)
+
+// HistoryRequest is the /dnd character history command
+type HistoryRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	Name        string // Name of the character
+	Version     int    // Version to show in full; 0 lists recent versions
+}
+
+// HistoryRestoreRequest is the restore button on a version
+type HistoryRestoreRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	CharacterID string
+	Version     int
+}
+
+// HistoryHandler shows what changed on a character and restores earlier versions
+type HistoryHandler struct {
+	services *services.Provider
+}
+
+// NewHistoryHandler creates a new history handler
+func NewHistoryHandler(serviceProvider *services.Provider) *HistoryHandler {
+	return &HistoryHandler{
+		services: serviceProvider,
+	}
+}
+
+// Handle shows the history of one of the user's characters, or of a
+// character in a session the user is the DM of
+func (h *HistoryHandler) Handle(req *HistoryRequest) error {
+	err := req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge interaction: %w", err)
+	}
+
+	ctx := context.Background()
+	char, err := findManagedCharacter(ctx, h.services, req.Interaction.Member.User.ID, req.Name)
+	if err != nil {
+		return h.editResponse(req.Session, req.Interaction, fmt.Sprintf("❌ %v", err))
+	}
+
+	history, err := h.services.CharacterService.GetCharacterHistory(ctx, char.ID)
+	if err != nil {
+		return h.editResponse(req.Session, req.Interaction, fmt.Sprintf("❌ Failed to load the history: %v", err))
+	}
+	if len(history) == 0 {
+		return h.editResponse(req.Session, req.Interaction, fmt.Sprintf("📜 %s has no saved versions yet", char.Name))
+	}
+
+	var embed *discordgo.MessageEmbed
+	var components []discordgo.MessageComponent
+	if req.Version == 0 {
+		embed = buildHistoryEmbed(char, history)
+	} else {
+		var version *charService.CharacterVersion
+		for _, v := range history {
+			if v.Version == req.Version {
+				version = v
+			}
+		}
+		if version == nil {
+			return h.editResponse(req.Session, req.Interaction,
+				fmt.Sprintf("❌ Version %d isn't in the history (versions %d-%d are kept)",
+					req.Version, history[len(history)-1].Version, history[0].Version))
+		}
+		embed = buildVersionEmbed(char, version)
+
+		// The latest version is the character as it is now
+		if version.Version != history[0].Version {
+			components = []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.Button{
+							Label:    fmt.Sprintf("Restore to version %d", version.Version),
+							Style:    discordgo.DangerButton,
+							CustomID: fmt.Sprintf("character:history_restore:%s:%d", char.ID, version.Version),
+							Emoji:    &discordgo.ComponentEmoji{Name: "⏪"},
+						},
+					},
+				},
+			}
+		}
+	}
+
+	_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{embed},
+		Components: &components,
+	})
+	return err
+}
+
+// HandleRestore restores a character to a version after checking the user
+// owns it or is the DM of a session it's in. The service refuses owners while
+// the character is in an active session.
+func (h *HistoryHandler) HandleRestore(req *HistoryRestoreRequest) error {
+	ctx := context.Background()
+	userID := req.Interaction.Member.User.ID
+
+	char, err := h.services.CharacterService.GetCharacter(ctx, req.CharacterID)
+	if err != nil {
+		return h.updateMessage(req, fmt.Sprintf("❌ %v", err))
+	}
+	if !managesCharacter(ctx, h.services, userID, char) {
+		return h.updateMessage(req, "❌ Only the character's owner or their DM can restore it")
+	}
+
+	restored, err := h.services.CharacterService.RestoreCharacterVersion(ctx, &charService.RestoreVersionInput{
+		CharacterID: req.CharacterID,
+		Version:     req.Version,
+		Actor:       userID,
+	})
+	if err != nil {
+		return h.updateMessage(req, fmt.Sprintf("❌ Failed to restore: %v", err))
+	}
+
+	return h.updateMessage(req, fmt.Sprintf("⏪ Restored **%s** to version %d. The restore is saved as a new version, so it can be undone the same way.",
+		restored.Name, req.Version))
+}
+
+func (h *HistoryHandler) editResponse(s *discordgo.Session, i *discordgo.InteractionCreate, content string) error {
+	_, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: &content,
+	})
+	return err
+}
+
+func (h *HistoryHandler) updateMessage(req *HistoryRestoreRequest, content string) error {
+	return req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Embeds:     []*discordgo.MessageEmbed{},
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+}
+
+// buildHistoryEmbed lists a character's recent versions
+func buildHistoryEmbed(char *character.Character, history []*charService.CharacterVersion) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📜 History: %s", char.Name),
+		Description: "Newest first. Add `version:` to see every change in a version and restore it.",
+		Color:       0x95a5a6,
+	}
+
+	for _, version := range history[:min(len(history), historyVersionsShown)] {
+		lines := []string{describeVersion(version)}
+		for _, change := range version.Changes[:min(len(version.Changes), historyChangesShown)] {
+			lines = append(lines, "• "+FormatFieldChange(change))
+		}
+		if hidden := len(version.Changes) - historyChangesShown; hidden > 0 {
+			lines = append(lines, fmt.Sprintf("…and %d more", hidden))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("Version %d", version.Version),
+			Value: truncateField(strings.Join(lines, "\n")),
+		})
+	}
+	return embed
+}
+
+// buildVersionEmbed shows every change in one version
+func buildVersionEmbed(char *character.Character, version *charService.CharacterVersion) *discordgo.MessageEmbed {
+	lines := []string{describeVersion(version), ""}
+	if len(version.Changes) == 0 {
+		lines = append(lines, "No earlier version is kept to compare with.")
+	}
+	for _, change := range version.Changes {
+		lines = append(lines, "• "+FormatFieldChange(change))
+	}
+
+	description := strings.Join(lines, "\n")
+	if len(description) > 4000 {
+		description = description[:4000] + "\n…"
+	}
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📜 %s: version %d", char.Name, version.Version),
+		Description: description,
+		Color:       0x95a5a6,
+	}
+}
+
+// describeVersion says when a version was saved, by whom and why
+func describeVersion(version *charService.CharacterVersion) string {
+	who := "automatic"
+	if version.Actor != "" {
+		who = fmt.Sprintf("<@%s>", version.Actor)
+	}
+	reason := version.Reason
+	if reason == "" {
+		reason = "saved"
+	}
+	return fmt.Sprintf("<t:%d:R> • %s • %s", version.SavedAt.Unix(), who, reason)
+}
+
+// FormatFieldChange shows a change as "field: old → new"
+func FormatFieldChange(change *charService.FieldChange) string {
+	value := func(v string) string {
+		if v == "" {
+			return "∅"
+		}
+		if len(v) > historyValueLength {
+			return v[:historyValueLength] + "…"
+		}
+		return v
+	}
+	return fmt.Sprintf("`%s`: %s → %s", change.Field, value(change.Old), value(change.New))
+}
+
+func truncateField(value string) string {
+	if len(value) > 1024 {
+		return value[:1020] + "…"
+	}
+	return value
+}
@@ -0,0 +1,108 @@
+package character
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxImportSize is the largest export file accepted, well above any real character
+const maxImportSize = 512 * 1024
+
+// ImportRequest is the /dnd character import command
+type ImportRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	Attachment  *discordgo.MessageAttachment // The export file
+}
+
+// ImportHandler creates a character from an exported JSON file
+type ImportHandler struct {
+	services   *services.Provider
+	httpClient *http.Client
+}
+
+// NewImportHandler creates a new import handler
+func NewImportHandler(serviceProvider *services.Provider) *ImportHandler {
+	return &ImportHandler{
+		services:   serviceProvider,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Handle downloads the attached export and imports the character
+func (h *ImportHandler) Handle(req *ImportRequest) error {
+	err := req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge interaction: %w", err)
+	}
+
+	if req.Attachment == nil {
+		return h.editResponse(req, "❌ Attach a character file made with `/dnd character export`")
+	}
+	if req.Attachment.Size > maxImportSize {
+		return h.editResponse(req, "❌ That file is too large to be a character export")
+	}
+
+	data, err := h.download(req.Attachment.URL)
+	if err != nil {
+		return h.editResponse(req, fmt.Sprintf("❌ Failed to download the file: %v", err))
+	}
+
+	char, err := h.services.CharacterService.ImportCharacter(context.Background(), &charService.ImportCharacterInput{
+		UserID:  req.Interaction.Member.User.ID,
+		RealmID: req.Interaction.GuildID,
+		Data:    data,
+	})
+	if err != nil {
+		return h.editResponse(req, fmt.Sprintf("❌ Can't import this character: %v", err))
+	}
+
+	embed := BuildCharacterSheetEmbed(char)
+	content := fmt.Sprintf("✅ Imported **%s**", char.Name)
+	_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Content: &content,
+		Embeds:  &[]*discordgo.MessageEmbed{embed},
+	})
+	return err
+}
+
+// download fetches an attachment, refusing anything over maxImportSize
+func (h *ImportHandler) download(url string) ([]byte, error) {
+	resp, err := h.httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImportSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxImportSize {
+		return nil, fmt.Errorf("file is larger than %d KB", maxImportSize/1024)
+	}
+	return data, nil
+}
+
+func (h *ImportHandler) editResponse(req *ImportRequest, content string) error {
+	_, err := req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Content: &content,
+	})
+	return err
+}
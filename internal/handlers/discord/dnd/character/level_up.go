@@ -0,0 +1,392 @@
+package character
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/levelup"
+	"github.com/bwmarrin/discordgo"
+)
+
+// LevelUpRequest is the /dnd character levelup command
+type LevelUpRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+}
+
+// LevelUpHandler walks a player through leveling up a character
+type LevelUpHandler struct {
+	services *services.Provider
+}
+
+// NewLevelUpHandler creates a new level-up handler
+func NewLevelUpHandler(serviceProvider *services.Provider) *LevelUpHandler {
+	return &LevelUpHandler{
+		services: serviceProvider,
+	}
+}
+
+// Handle shows the characters the user can level up
+func (h *LevelUpHandler) Handle(req *LevelUpRequest) error {
+	err := req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge interaction: %w", err)
+	}
+
+	chars, err := h.services.CharacterService.ListByOwner(req.Interaction.Member.User.ID)
+	if err != nil {
+		content := fmt.Sprintf("❌ Failed to retrieve your characters: %v", err)
+		_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+			Content: &content,
+		})
+		return err
+	}
+
+	var options []discordgo.SelectMenuOption
+	for _, char := range chars {
+		if char.Status != shared.CharacterStatusActive || (char.LevelUp == nil && !char.CanLevelUp()) {
+			continue
+		}
+		description := fmt.Sprintf("Level %d → %d", char.Level, char.Level+1)
+		if char.LevelUp != nil {
+			description += " (in progress)"
+		}
+		options = append(options, discordgo.SelectMenuOption{
+			Label:       char.Name,
+			Value:       char.ID,
+			Description: description,
+			Emoji:       &discordgo.ComponentEmoji{Name: "⬆️"},
+		})
+		if len(options) == 25 {
+			break
+		}
+	}
+
+	if len(options) == 0 {
+		content := "📝 You don't have any active characters that can level up."
+		_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+			Content: &content,
+		})
+		return err
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "⬆️ Level Up",
+		Description: "Choose a character to advance. Nothing changes until you confirm the final step.",
+		Color:       0xF1C40F,
+	}
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    "level_up:choose",
+					Placeholder: "Select a character...",
+					Options:     options,
+				},
+			},
+		},
+	}
+
+	_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{embed},
+		Components: &components,
+	})
+	return err
+}
+
+// HandleComponent handles level_up:* buttons and select menus
+//
+//	level_up:choose                          - character select menu
+//	level_up:step:{charID}:{stepType}[:key] - step selection (menu values or button key)
+//	level_up:confirm:{charID}                - apply the level-up
+//	level_up:cancel:{charID}                 - discard the level-up
+func (h *LevelUpHandler) HandleComponent(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	ctx := context.Background()
+	parts := strings.Split(i.MessageComponentData().CustomID, ":")
+	if len(parts) < 2 {
+		return respondWithError(s, i, "Invalid level-up action")
+	}
+	userID := i.Member.User.ID
+
+	switch parts[1] {
+	case "choose":
+		values := i.MessageComponentData().Values
+		if len(values) == 0 {
+			return respondWithError(s, i, "No character selected")
+		}
+		step, err := h.services.LevelUpService.StartLevelUp(ctx, values[0], userID)
+		if err != nil {
+			return respondWithError(s, i, err.Error())
+		}
+		return h.renderStep(s, i, values[0], step)
+
+	case "step":
+		if len(parts) < 4 {
+			return respondWithError(s, i, "Invalid level-up step")
+		}
+		selections := i.MessageComponentData().Values
+		if len(parts) >= 5 {
+			selections = []string{strings.Join(parts[4:], ":")}
+		}
+		step, err := h.services.LevelUpService.ProcessStepResult(ctx, parts[2], userID, &character.CreationStepResult{
+			StepType:   character.CreationStepType(parts[3]),
+			Selections: selections,
+		})
+		if err != nil {
+			return respondWithError(s, i, err.Error())
+		}
+		return h.renderStep(s, i, parts[2], step)
+
+	case "confirm":
+		if len(parts) < 3 {
+			return respondWithError(s, i, "Invalid level-up action")
+		}
+		result, err := h.services.LevelUpService.CompleteLevelUp(ctx, parts[2], userID)
+		if err != nil {
+			return respondWithError(s, i, err.Error())
+		}
+		return h.renderResult(s, i, result)
+
+	case "cancel":
+		if len(parts) < 3 {
+			return respondWithError(s, i, "Invalid level-up action")
+		}
+		if err := h.services.LevelUpService.CancelLevelUp(ctx, parts[2], userID); err != nil {
+			return respondWithError(s, i, err.Error())
+		}
+		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    "Level-up cancelled. Your character is unchanged.",
+				Embeds:     []*discordgo.MessageEmbed{},
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+	}
+
+	return respondWithError(s, i, "Unknown level-up action")
+}
+
+// renderStep shows a level-up step with its progress
+func (h *LevelUpHandler) renderStep(s *discordgo.Session, i *discordgo.InteractionCreate, characterID string, step *character.CreationStep) error {
+	ctx := context.Background()
+	char, err := h.services.CharacterService.GetByID(characterID)
+	if err != nil {
+		return respondWithError(s, i, "Failed to load character")
+	}
+
+	color := 0xF1C40F
+	if step.UIHints != nil && step.UIHints.Color != 0 {
+		color = step.UIHints.Color
+	}
+
+	targetLevel := char.Level + 1
+	if char.LevelUp != nil {
+		targetLevel = char.LevelUp.TargetLevel
+	}
+
+	description := step.Description
+	if len(description) > 4000 {
+		description = description[:3997] + "..."
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("⬆️ %s: Level %d → %d", char.Name, char.Level, targetLevel),
+		Description: fmt.Sprintf("**%s**\n%s", step.Title, description),
+		Color:       color,
+	}
+
+	if step.Type != character.StepTypeComplete {
+		if progressSteps, err := h.services.LevelUpService.GetProgressSteps(ctx, characterID); err == nil {
+			var lines []string
+			for _, info := range progressSteps {
+				icon := "⏳"
+				if info.Completed {
+					icon = "✅"
+				} else if info.Current {
+					icon = "🔄"
+				}
+				lines = append(lines, fmt.Sprintf("%s %s", icon, info.Step.Title))
+			}
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:  "Progress",
+				Value: strings.Join(lines, "\n"),
+			})
+		}
+	}
+
+	components := buildLevelUpComponents(characterID, step)
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	})
+}
+
+// buildLevelUpComponents uses buttons for short single-choice steps and a
+// select menu otherwise. Every step gets a cancel button.
+func buildLevelUpComponents(characterID string, step *character.CreationStep) []discordgo.MessageComponent {
+	cancel := discordgo.Button{
+		Label:    "Cancel",
+		Style:    discordgo.SecondaryButton,
+		CustomID: fmt.Sprintf("level_up:cancel:%s", characterID),
+	}
+
+	if step.Type == character.StepTypeComplete {
+		return []discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.Button{
+						Label:    "Level Up!",
+						Style:    discordgo.SuccessButton,
+						CustomID: fmt.Sprintf("level_up:confirm:%s", characterID),
+						Emoji:    &discordgo.ComponentEmoji{Name: "⬆️"},
+					},
+					cancel,
+				},
+			},
+		}
+	}
+
+	if step.MaxChoices <= 1 && len(step.Options) <= 4 {
+		buttons := make([]discordgo.MessageComponent, 0, len(step.Options)+1)
+		for _, option := range step.Options {
+			buttons = append(buttons, discordgo.Button{
+				Label:    option.Name,
+				Style:    discordgo.PrimaryButton,
+				CustomID: fmt.Sprintf("level_up:step:%s:%s:%s", characterID, step.Type, option.Key),
+			})
+		}
+		buttons = append(buttons, cancel)
+		return []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}}
+	}
+
+	selectOptions := make([]discordgo.SelectMenuOption, 0, len(step.Options))
+	for _, option := range step.Options {
+		description := option.Description
+		if len(description) > 100 {
+			description = description[:97] + "..."
+		}
+		selectOptions = append(selectOptions, discordgo.SelectMenuOption{
+			Label:       option.Name,
+			Value:       option.Key,
+			Description: description,
+		})
+	}
+
+	minValues := max(step.MinChoices, 1)
+	maxValues := max(step.MaxChoices, minValues)
+	placeholder := "Make your selection..."
+	if p, ok := step.Context["placeholder"].(string); ok {
+		placeholder = p
+	}
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    fmt.Sprintf("level_up:step:%s:%s", characterID, step.Type),
+					Placeholder: placeholder,
+					Options:     selectOptions,
+					MinValues:   &minValues,
+					MaxValues:   maxValues,
+				},
+			},
+		},
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{cancel}},
+	}
+}
+
+// renderResult shows what the level-up changed
+func (h *LevelUpHandler) renderResult(s *discordgo.Session, i *discordgo.InteractionCreate, result *levelup.Result) error {
+	char := result.Character
+
+	fields := []*discordgo.MessageEmbedField{
+		{
+			Name:   "Hit Points",
+			Value:  fmt.Sprintf("+%d (max %d)", result.HitPointsGained, char.MaxHitPoints),
+			Inline: true,
+		},
+		{
+			Name:   "Proficiency Bonus",
+			Value:  fmt.Sprintf("+%d", result.ProficiencyBonus),
+			Inline: true,
+		},
+		{
+			Name:   "Hit Dice",
+			Value:  hitDiceSummary(char),
+			Inline: true,
+		},
+	}
+
+	if char.IsMulticlass() {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Classes",
+			Value: char.ClassSummary(),
+		})
+	}
+
+	if len(result.NewFeatures) > 0 {
+		names := make([]string, 0, len(result.NewFeatures))
+		for _, feature := range result.NewFeatures {
+			names = append(names, "• "+feature.Name)
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "New Features",
+			Value: strings.Join(names, "\n"),
+		})
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🎉 %s reached level %d!", char.Name, result.NewLevel),
+		Description: "Your resources have been refreshed for your new level.",
+		Color:       0x2ECC71,
+		Fields:      fields,
+	}
+
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "View Character",
+					Style:    discordgo.PrimaryButton,
+					CustomID: fmt.Sprintf("character:sheet_show:%s", char.ID),
+					Emoji:    &discordgo.ComponentEmoji{Name: "📄"},
+				},
+			},
+		},
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	})
+}
+
+// hitDiceSummary lists hit dice by die size, e.g. "3d10 + 2d6"
+func hitDiceSummary(char *character.Character) string {
+	if char.Resources == nil || len(char.Resources.HitDice) == 0 {
+		return fmt.Sprintf("%dd%d", char.Level, char.HitDie)
+	}
+
+	parts := make([]string, 0, len(char.Resources.HitDice))
+	for _, pool := range char.Resources.HitDice {
+		parts = append(parts, fmt.Sprintf("%dd%d", pool.Max, pool.DiceType))
+	}
+	return strings.Join(parts, " + ")
+}
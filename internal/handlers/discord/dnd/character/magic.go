@@ -0,0 +1,102 @@
+package character
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/effects"
+	"github.com/bwmarrin/discordgo"
+)
+
+// bonusNames are how magic bonuses read on an item
+var bonusNames = map[effects.ModifierTarget]string{
+	effects.TargetAC:          "AC",
+	effects.TargetAttackRoll:  "attack rolls",
+	effects.TargetDamage:      "damage rolls",
+	effects.TargetSavingThrow: "saving throws",
+}
+
+// FormatMagic describes an item's magic for the inventory, or returns ""
+// for a mundane item
+func FormatMagic(char *character.Character, item equipment.Equipment) string {
+	magic := equipment.Magic(item)
+	if magic == nil {
+		return ""
+	}
+
+	lines := []string{fmt.Sprintf("**Rarity:** %s", magic.Rarity.Name())}
+	for _, bonus := range magic.Bonuses {
+		name, ok := bonusNames[bonus.Target]
+		if !ok {
+			name = string(bonus.Target)
+		}
+		lines = append(lines, fmt.Sprintf("**%+d** to %s", bonus.Value, name))
+	}
+	if magic.MaxCharges > 0 {
+		lines = append(lines, fmt.Sprintf("**Charges:** %d/%d", magic.Charges, magic.MaxCharges))
+	}
+	if magic.Spell != "" {
+		lines = append(lines, fmt.Sprintf("Spend a charge to cast **%s**", magic.Spell))
+	}
+	if magic.RequiresAttunement {
+		status := "Not attuned"
+		if attuned := char.AttunedCopies(item.GetKey()); attuned > 0 {
+			status = "Attuned"
+			if copies := char.CountEquipment(item.GetKey()); copies > 1 {
+				status = fmt.Sprintf("Attuned to %d of %d", attuned, copies)
+			}
+		}
+		lines = append(lines, fmt.Sprintf("**Requires attunement** (%s, %d/%d used)",
+			status, len(char.Attuned), character.MaxAttunedItems))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// AttuneButtons returns the buttons to attune to another copy of an item
+// and to end attunement with one, or nil if the item doesn't need attunement
+func AttuneButtons(char *character.Character, item equipment.Equipment) []discordgo.MessageComponent {
+	magic := equipment.Magic(item)
+	if magic == nil || !magic.RequiresAttunement {
+		return nil
+	}
+
+	var buttons []discordgo.MessageComponent
+	attuned := char.AttunedCopies(item.GetKey())
+	if attuned < char.CountEquipment(item.GetKey()) {
+		buttons = append(buttons, discordgo.Button{
+			Label:    "Attune",
+			Style:    discordgo.PrimaryButton,
+			CustomID: fmt.Sprintf("character:attune:%s:%s", char.ID, item.GetKey()),
+			Emoji:    &discordgo.ComponentEmoji{Name: "✨"},
+			Disabled: len(char.Attuned) >= character.MaxAttunedItems,
+		})
+	}
+	if attuned > 0 {
+		buttons = append(buttons, discordgo.Button{
+			Label:    "End Attunement",
+			Style:    discordgo.SecondaryButton,
+			CustomID: fmt.Sprintf("character:unattune:%s:%s", char.ID, item.GetKey()),
+			Emoji:    &discordgo.ComponentEmoji{Name: "💤"},
+		})
+	}
+	return buttons
+}
+
+// UseChargeButton returns the button to spend a charge of a wand or other
+// charged item, or nil if the item has no spell to cast
+func UseChargeButton(char *character.Character, item equipment.Equipment) *discordgo.Button {
+	magic := equipment.Magic(item)
+	if magic == nil || magic.Spell == "" || magic.MaxCharges == 0 {
+		return nil
+	}
+
+	return &discordgo.Button{
+		Label:    "Use a Charge",
+		Style:    discordgo.SuccessButton,
+		CustomID: fmt.Sprintf("character:use_charge:%s:%s", char.ID, item.GetKey()),
+		Emoji:    &discordgo.ComponentEmoji{Name: "🪄"},
+		Disabled: char.ItemCharges(item.GetKey()) == 0,
+	}
+}
@@ -0,0 +1,70 @@
+package character
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+)
+
+// findManagedCharacter finds a character by name among the user's own
+// characters, then among the players of sessions the user is the DM of
+func findManagedCharacter(ctx context.Context, provider *services.Provider, userID, name string) (*character.Character, error) {
+	chars, err := provider.CharacterService.ListCharacters(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve your characters: %w", err)
+	}
+	for _, char := range chars {
+		if char.Status != shared.CharacterStatusDraft && strings.EqualFold(char.Name, name) {
+			return char, nil
+		}
+	}
+
+	sessions, err := provider.SessionService.ListActiveUserSessions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve your sessions: %w", err)
+	}
+	for _, sess := range sessions {
+		if sess.DMID != userID {
+			continue
+		}
+		for _, member := range sess.Members {
+			if member.CharacterID == "" {
+				continue
+			}
+			char, err := provider.CharacterService.GetCharacter(ctx, member.CharacterID)
+			if err == nil && strings.EqualFold(char.Name, name) {
+				return char, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no character named %s among yours or your players'", name)
+}
+
+// managesCharacter reports whether the user owns the character or is the DM
+// of an active session it's in
+func managesCharacter(ctx context.Context, provider *services.Provider, userID string, char *character.Character) bool {
+	if char.OwnerID == userID {
+		return true
+	}
+
+	sessions, err := provider.SessionService.ListActiveUserSessions(ctx, userID)
+	if err != nil {
+		return false
+	}
+	for _, sess := range sessions {
+		if sess.DMID != userID {
+			continue
+		}
+		for _, member := range sess.Members {
+			if member.CharacterID == char.ID {
+				return true
+			}
+		}
+	}
+	return false
+}
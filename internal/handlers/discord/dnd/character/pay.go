@@ -0,0 +1,112 @@
+package character
+
+import (
+	"context"
+	"fmt"
+
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/wallet"
+	"github.com/bwmarrin/discordgo"
+)
+
+// PayRequest is the /dnd character pay command
+type PayRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	To          string // Name of the character being paid
+	Amount      int
+	Coin        string
+}
+
+// PayHandler lets a player hand coins to another character in their party
+type PayHandler struct {
+	services *services.Provider
+}
+
+// NewPayHandler creates a new pay handler
+func NewPayHandler(serviceProvider *services.Provider) *PayHandler {
+	return &PayHandler{
+		services: serviceProvider,
+	}
+}
+
+// Handle pays coins from the user's character in their active session
+func (h *PayHandler) Handle(req *PayRequest) error {
+	// Payments are announced to the table
+	err := req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge interaction: %w", err)
+	}
+
+	ctx := context.Background()
+	userID := req.Interaction.Member.User.ID
+
+	coin, ok := shared.ParseCoin(req.Coin)
+	if !ok {
+		return h.editResponse(req, fmt.Sprintf("❌ Unknown coin '%s'", req.Coin))
+	}
+
+	sessions, err := h.services.SessionService.ListActiveUserSessions(ctx, userID)
+	if err != nil {
+		return h.editResponse(req, fmt.Sprintf("❌ Failed to retrieve your active sessions: %v", err))
+	}
+
+	// Pay from the character in the most recently active session
+	var session *gameSession.Session
+	for _, s := range sessions {
+		if member, exists := s.Members[userID]; !exists || member.CharacterID == "" {
+			continue
+		}
+		if session == nil || s.LastActive.After(session.LastActive) {
+			session = s
+		}
+	}
+	if session == nil {
+		return h.editResponse(req, "❌ You need a character in an active session to pay someone")
+	}
+
+	result, err := h.services.WalletService.Transfer(ctx, &wallet.TransferInput{
+		SessionID:       session.ID,
+		UserID:          userID,
+		ToCharacterName: req.To,
+		Amount:          req.Amount,
+		Coin:            coin,
+	})
+	if err != nil {
+		return h.editResponse(req, fmt.Sprintf("❌ %v", err))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "💰 Payment",
+		Description: fmt.Sprintf("%s pays **%d %s** to %s", result.From.Name, req.Amount, coin, result.To.Name),
+		Color:       0xf1c40f, // Gold
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   result.From.Name,
+				Value:  result.From.Wallet.String(),
+				Inline: true,
+			},
+			{
+				Name:   result.To.Name,
+				Value:  result.To.Wallet.String(),
+				Inline: true,
+			},
+		},
+	}
+
+	_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+	})
+	return err
+}
+
+func (h *PayHandler) editResponse(req *PayRequest, content string) error {
+	_, err := req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Content: &content,
+	})
+	return err
+}
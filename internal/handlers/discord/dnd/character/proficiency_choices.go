@@ -6,6 +6,8 @@ import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	"strings"
 
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+
 	characterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
 	"github.com/bwmarrin/discordgo"
 )
@@ -33,6 +35,9 @@ type ProficiencyChoicesRequest struct {
 	Interaction *discordgo.InteractionCreate
 	RaceKey     string
 	ClassKey    string
+	// BackgroundKey is optional; the background's proficiencies are
+	// shown as granted and left out of the choices
+	BackgroundKey string
 }
 
 // Handle processes proficiency choices
@@ -50,8 +55,9 @@ func (h *ProficiencyChoicesHandler) Handle(req *ProficiencyChoicesRequest) error
 
 	// Use character service to resolve choices
 	choices, err := h.characterService.ResolveChoices(context.Background(), &characterService.ResolveChoicesInput{
-		RaceKey:  req.RaceKey,
-		ClassKey: req.ClassKey,
+		RaceKey:       req.RaceKey,
+		ClassKey:      req.ClassKey,
+		BackgroundKey: req.BackgroundKey,
 	})
 	if err != nil {
 		return h.respondWithError(req, fmt.Sprintf("Failed to load proficiency choices: %v", err))
@@ -136,6 +142,22 @@ func (h *ProficiencyChoicesHandler) Handle(req *ProficiencyChoicesRequest) error
 		})
 	}
 
+	// Show background proficiencies (automatic)
+	if background := rulebook.GetSRDBackground(req.BackgroundKey); background != nil {
+		profStrings := []string{}
+		for _, prof := range background.SkillProficiencies {
+			profStrings = append(profStrings, prof.Name)
+		}
+		for _, prof := range background.ToolProficiencies {
+			profStrings = append(profStrings, prof.Name)
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("✅ %s Proficiencies", background.Name),
+			Value:  strings.Join(profStrings, "\n"),
+			Inline: true,
+		})
+	}
+
 	// Show proficiency choices from service
 	hasChoices := len(choices.ProficiencyChoices) > 0
 
@@ -0,0 +1,64 @@
+package character
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/quickbuild"
+	"github.com/bwmarrin/discordgo"
+)
+
+// RandomRequest is the /dnd character random command
+type RandomRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	Name        string // Optional, a name suited to the race is picked if empty
+}
+
+// RandomHandler creates fully random characters
+type RandomHandler struct {
+	services *services.Provider
+}
+
+// NewRandomHandler creates a new random character handler
+func NewRandomHandler(serviceProvider *services.Provider) *RandomHandler {
+	return &RandomHandler{
+		services: serviceProvider,
+	}
+}
+
+// Handle builds a random legal character for the user
+func (h *RandomHandler) Handle(req *RandomRequest) error {
+	err := req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge interaction: %w", err)
+	}
+
+	char, err := h.services.QuickBuildService.RandomCharacter(context.Background(), &quickbuild.BuildInput{
+		UserID:  req.Interaction.Member.User.ID,
+		RealmID: req.Interaction.GuildID,
+		Name:    req.Name,
+	})
+	if err != nil {
+		content := fmt.Sprintf("❌ Failed to create a random character: %v", err)
+		_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+			Content: &content,
+		})
+		return err
+	}
+
+	embed, components := BuildCreationSuccessResponse(char)
+	content := fmt.Sprintf("🎲 Fate has chosen: **%s**, a %s %s", char.Name, char.Race.Name, char.Class.Name)
+	_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Content:    &content,
+		Embeds:     &[]*discordgo.MessageEmbed{embed},
+		Components: &components,
+	})
+	return err
+}
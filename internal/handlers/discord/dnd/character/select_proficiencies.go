@@ -188,12 +188,13 @@ func (h *SelectProficienciesHandler) Handle(req *SelectProficienciesRequest) err
 			Text: "Select which type of proficiency you want",
 		}
 	} else {
-		// Regular dropdown for direct options
+		// Regular dropdown for direct options, leaving out what the background already grants
+		granted := h.backgroundProficiencies(req)
 		selectOptions := []discordgo.SelectMenuOption{}
 		for _, option := range currentChoice.Options {
 			optionName := h.getOptionName(option)
 			optionKey := h.getOptionKey(option)
-			if optionName != "" && optionKey != "" {
+			if optionName != "" && optionKey != "" && !granted[optionKey] {
 				selectOptions = append(selectOptions, discordgo.SelectMenuOption{
 					Label: optionName,
 					Value: optionKey,
@@ -327,6 +328,28 @@ func (h *SelectProficienciesHandler) getOptionKey(option shared.Option) string {
 }
 
 // moveToNextStep transitions to the next part of character creation
+// backgroundProficiencies returns the keys of the proficiencies the draft
+// character's background grants
+func (h *SelectProficienciesHandler) backgroundProficiencies(req *SelectProficienciesRequest) map[string]bool {
+	granted := make(map[string]bool)
+	draftChar, err := h.characterService.GetOrCreateDraftCharacter(
+		context.Background(),
+		req.Interaction.Member.User.ID,
+		req.Interaction.GuildID,
+	)
+	if err != nil || draftChar.Background == nil {
+		return granted
+	}
+
+	for _, prof := range draftChar.Background.SkillProficiencies {
+		granted[prof.Key] = true
+	}
+	for _, prof := range draftChar.Background.ToolProficiencies {
+		granted[prof.Key] = true
+	}
+	return granted
+}
+
 func (h *SelectProficienciesHandler) moveToNextStep(req *SelectProficienciesRequest, race *rulebook.Race, class *rulebook.Class, message string) error {
 	embed := &discordgo.MessageEmbed{
 		Title:       "Proficiencies Complete",
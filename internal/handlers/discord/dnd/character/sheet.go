@@ -3,6 +3,7 @@ package character
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
@@ -64,10 +65,30 @@ func (h *SheetHandler) Handle(s *discordgo.Session, i *discordgo.InteractionCrea
 	})
 }
 
+// multiclassTitle lists each class with its level and subclass,
+// e.g. "Fighter 3 (Champion) / Wizard 2"
+func multiclassTitle(char *character.Character) string {
+	var parts []string
+	for _, classLevel := range char.ClassLevels() {
+		part := fmt.Sprintf("%s %d", classLevel.Class.Name, classLevel.Level)
+		if subclass, ok := char.GetSubclassFor(classLevel.Class.Key); ok {
+			part = fmt.Sprintf("%s (%s)", part, subclass.Name)
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " / ")
+}
+
 // BuildCharacterSheetEmbed creates the main character sheet embed
 func BuildCharacterSheetEmbed(char *character.Character) *discordgo.MessageEmbed {
 	// Build title
 	title := fmt.Sprintf("%s - Level %d %s", char.Name, char.Level, char.Class.Name)
+	if subclass, ok := char.GetSubclass(); ok {
+		title = fmt.Sprintf("%s (%s)", title, subclass.Name)
+	}
+	if char.IsMulticlass() {
+		title = fmt.Sprintf("%s - Level %d %s", char.Name, char.Level, multiclassTitle(char))
+	}
 	if char.Race != nil {
 		title = fmt.Sprintf("%s %s", char.Race.Name, title)
 	}
@@ -82,6 +103,11 @@ func BuildCharacterSheetEmbed(char *character.Character) *discordgo.MessageEmbed
 		initiativeBonus = dex.Bonus
 	}
 	hpAcLine += fmt.Sprintf(" | **Initiative:** %+d", initiativeBonus)
+	if char.Resources != nil {
+		if slots := char.Resources.SpellSlotSummary(); slots != "" {
+			hpAcLine += fmt.Sprintf("\n**Spell Slots:** %s", slots)
+		}
+	}
 
 	// Build ability scores
 	abilityLines := []string{
@@ -130,7 +156,7 @@ func BuildCharacterSheetEmbed(char *character.Character) *discordgo.MessageEmbed
 			},
 			{
 				Name:   "✨ Features",
-				Value:  strings.Join(featureLines, "\n"),
+				Value:  truncateField(strings.Join(featureLines, "\n")),
 				Inline: false,
 			},
 			{
@@ -144,9 +170,55 @@ func BuildCharacterSheetEmbed(char *character.Character) *discordgo.MessageEmbed
 		},
 	}
 
+	// Add background and personality
+	if backgroundLines := buildBackgroundDisplay(char); len(backgroundLines) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("📜 Background: %s", char.Background.Name),
+			Value:  truncateField(strings.Join(backgroundLines, "\n")),
+			Inline: false,
+		})
+	}
+
 	return embed
 }
 
+// buildBackgroundDisplay builds the background section: its feature,
+// languages and the character's personality
+func buildBackgroundDisplay(char *character.Character) []string {
+	if char.Background == nil {
+		return nil
+	}
+
+	lines := []string{}
+	if feature := char.GetBackgroundFeature(); feature != nil {
+		lines = append(lines, fmt.Sprintf("**Feature:** %s", feature.Name))
+	}
+	if keys := char.GetBackgroundLanguages(); len(keys) > 0 {
+		names := make([]string, 0, len(keys))
+		for _, key := range keys {
+			if language, ok := rulebook.GetLanguage(key); ok {
+				names = append(names, language.Name)
+			} else {
+				names = append(names, key)
+			}
+		}
+		lines = append(lines, fmt.Sprintf("**Languages:** %s", strings.Join(names, ", ")))
+	}
+
+	for _, table := range rulebook.PersonalityTables {
+		picks := char.Personality.Get(table)
+		if len(picks) == 0 {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("**%s:** %s", table.DisplayName(), strings.Join(picks, " ")))
+	}
+
+	if len(lines) == 0 {
+		lines = append(lines, fmt.Sprintf("*%s*", char.Background.Description))
+	}
+	return lines
+}
+
 // buildAbilityLine formats a single ability score line
 func buildAbilityLine(name string, score *character.AbilityScore) string {
 	if score == nil {
@@ -187,9 +259,50 @@ func buildEquipmentDisplay(char *character.Character) []string {
 		lines = append(lines, "**Armor:** Empty")
 	}
 
+	// Worn magic items (only show filled slots)
+	for _, worn := range []struct {
+		slot  shared.Slot
+		label string
+	}{
+		{shared.SlotNeck, "Neck"},
+		{shared.SlotCloak, "Cloak"},
+		{shared.SlotRing, "Ring"},
+		{shared.SlotSecondRing, "Ring"},
+	} {
+		if item := char.EquippedSlots[worn.slot]; item != nil {
+			lines = append(lines, fmt.Sprintf("**%s:** %s", worn.label, item.GetName()))
+		}
+	}
+
+	if len(char.Attuned) > 0 {
+		lines = append(lines, fmt.Sprintf("**Attuned:** %d/%d", len(char.Attuned), character.MaxAttunedItems))
+	}
+
+	lines = append(lines, fmt.Sprintf("**Coins:** %s", char.Wallet))
+	lines = append(lines, FormatLoad(char))
+
 	return lines
 }
 
+// FormatLoad shows how much the character carries against their capacity,
+// noting what the variant encumbrance rule would do to them
+func FormatLoad(char *character.Character) string {
+	line := fmt.Sprintf("**Load:** %s/%s lb", formatPounds(char.Load()), formatPounds(char.CarryingCapacity()))
+
+	switch level := char.Encumbrance(true); level {
+	case character.EncumbranceOverCapacity:
+		line += fmt.Sprintf(" ⚠️ %s (speed 5 ft)", level.Name())
+	case character.EncumbranceLight, character.EncumbranceHeavy:
+		line += fmt.Sprintf(" (%s under variant encumbrance, -%d ft)", level.Name(), level.SpeedPenalty())
+	}
+	return line
+}
+
+// formatPounds drops the decimals from whole-pound weights
+func formatPounds(pounds float64) string {
+	return strconv.FormatFloat(pounds, 'f', -1, 64)
+}
+
 // buildProficiencySummary builds a summary of proficiencies
 func buildProficiencySummary(char *character.Character) []string {
 	lines := []string{}
@@ -267,6 +380,7 @@ func buildFeatureSummary(char *character.Character) []string {
 
 	// Group features by type
 	classFeatures := []*rulebook.CharacterFeature{}
+	subclassFeatures := []*rulebook.CharacterFeature{}
 	racialFeatures := []*rulebook.CharacterFeature{}
 	otherFeatures := []*rulebook.CharacterFeature{}
 
@@ -277,8 +391,13 @@ func buildFeatureSummary(char *character.Character) []string {
 		switch feature.Type {
 		case rulebook.FeatureTypeClass:
 			classFeatures = append(classFeatures, feature)
+		case rulebook.FeatureTypeSubclass:
+			subclassFeatures = append(subclassFeatures, feature)
 		case rulebook.FeatureTypeRacial:
 			racialFeatures = append(racialFeatures, feature)
+		case rulebook.FeatureTypeBackground:
+			// Shown with the background
+			continue
 		default:
 			otherFeatures = append(otherFeatures, feature)
 		}
@@ -299,6 +418,17 @@ func buildFeatureSummary(char *character.Character) []string {
 		}
 	}
 
+	// Add subclass features under the subclass name
+	if len(subclassFeatures) > 0 {
+		if len(lines) > 0 {
+			lines = append(lines, "") // Add spacing
+		}
+		lines = append(lines, fmt.Sprintf("**%s Features:**", subclassFeatures[0].Source))
+		for _, feat := range subclassFeatures {
+			lines = append(lines, fmt.Sprintf("• %s", feat.Name))
+		}
+	}
+
 	// Add racial features
 	if len(racialFeatures) > 0 {
 		if len(lines) > 0 {
@@ -321,6 +451,10 @@ func buildFeatureSummary(char *character.Character) []string {
 		}
 	}
 
+	if len(lines) == 0 {
+		lines = append(lines, "*No features*")
+	}
+
 	return lines
 }
 
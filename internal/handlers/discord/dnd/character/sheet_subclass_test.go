@@ -0,0 +1,59 @@
+package character
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/features"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildCharacterSheetEmbed_ShowsSubclass(t *testing.T) {
+	char := &character.Character{
+		Name:  "Brom",
+		Level: 3,
+		Class: &rulebook.Class{Key: "fighter", Name: "Fighter"},
+		Race:  &rulebook.Race{Name: "Dwarf"},
+		Features: []*rulebook.CharacterFeature{
+			{
+				Key:      "subclass",
+				Name:     "Martial Archetype",
+				Type:     rulebook.FeatureTypeClass,
+				Metadata: map[string]any{"subclass": "champion"},
+			},
+		},
+	}
+	for _, feature := range features.GetSubclassFeatures("fighter", "champion", 3) {
+		f := feature
+		char.Features = append(char.Features, &f)
+	}
+
+	embed := BuildCharacterSheetEmbed(char)
+	assert.Equal(t, "Dwarf Brom - Level 3 Fighter (Champion)", embed.Title)
+
+	summary := strings.Join(buildFeatureSummary(char), "\n")
+	assert.Contains(t, summary, "**Champion Features:**")
+	assert.Contains(t, summary, "Improved Critical")
+}
+
+func TestBuildCharacterSheetEmbed_ShowsMulticlass(t *testing.T) {
+	fighter := &rulebook.Class{Key: "fighter", Name: "Fighter"}
+	char := &character.Character{
+		Name:  "Brom",
+		Level: 5,
+		Class: fighter,
+		Classes: []*character.ClassLevel{
+			{Class: fighter, Level: 3},
+			{Class: &rulebook.Class{Key: "wizard", Name: "Wizard"}, Level: 2},
+		},
+		Race: &rulebook.Race{Name: "Dwarf"},
+		Features: []*rulebook.CharacterFeature{
+			{Key: "subclass", Metadata: map[string]any{"subclass": "champion", "class": "fighter"}},
+		},
+	}
+
+	embed := BuildCharacterSheetEmbed(char)
+	assert.Equal(t, "Dwarf Brom - Level 5 Fighter 3 (Champion) / Wizard 2", embed.Title)
+}
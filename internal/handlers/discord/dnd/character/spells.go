@@ -0,0 +1,387 @@
+package character
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
+	"github.com/bwmarrin/discordgo"
+)
+
+// Spell management actions
+const (
+	SpellsActionPrepare = "prepare"
+	SpellsActionCopy    = "copy"
+	SpellsActionRitual  = "ritual"
+)
+
+// SpellsRequest is a /dnd spells command
+type SpellsRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	Action      string
+	SpellName   string // For copy and ritual
+}
+
+// SpellsHandler handles spell preparation, spellbook copying and rituals
+type SpellsHandler struct {
+	services *services.Provider
+}
+
+// NewSpellsHandler creates a new spells handler
+func NewSpellsHandler(serviceProvider *services.Provider) *SpellsHandler {
+	return &SpellsHandler{
+		services: serviceProvider,
+	}
+}
+
+// Handle shows the characters that can take the requested action
+func (h *SpellsHandler) Handle(req *SpellsRequest) error {
+	err := req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge interaction: %w", err)
+	}
+
+	chars, err := h.services.CharacterService.ListByOwner(req.Interaction.Member.User.ID)
+	if err != nil {
+		content := fmt.Sprintf("❌ Failed to retrieve your characters: %v", err)
+		_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+			Content: &content,
+		})
+		return err
+	}
+
+	var options []discordgo.SelectMenuOption
+	for _, char := range chars {
+		if char.Status != shared.CharacterStatusActive || !canTakeSpellsAction(char, req.Action) {
+			continue
+		}
+		options = append(options, discordgo.SelectMenuOption{
+			Label:       char.Name,
+			Value:       char.ID,
+			Description: fmt.Sprintf("Level %d %s", char.Level, char.Class.Name),
+		})
+		if len(options) == 25 {
+			break
+		}
+	}
+
+	if len(options) == 0 {
+		content := "📝 You don't have any active characters that can do that."
+		_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+			Content: &content,
+		})
+		return err
+	}
+
+	customID := fmt.Sprintf("spells:choose:%s", req.Action)
+	var title, description string
+	switch req.Action {
+	case SpellsActionCopy:
+		spellKey := spellNameToKey(req.SpellName)
+		customID += ":" + spellKey
+		title = "📜 Copy into Spellbook"
+		description = fmt.Sprintf("Choose the wizard copying **%s**. Copying costs 50 gp and 2 hours per spell level.", req.SpellName)
+	case SpellsActionRitual:
+		spellKey := spellNameToKey(req.SpellName)
+		customID += ":" + spellKey
+		title = "🕯️ Ritual Casting"
+		description = fmt.Sprintf("Choose who casts **%s** as a ritual. It takes %d minutes longer and uses no spell slot.", req.SpellName, rulebook.RitualCastingMinutes)
+	default:
+		title = "📖 Prepare Spells"
+		description = "Choose a character. Prepared spells can be changed after a long rest."
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: description,
+		Color:       0x9B59B6,
+	}
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    customID,
+					Placeholder: "Select a character...",
+					Options:     options,
+				},
+			},
+		},
+	}
+
+	_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{embed},
+		Components: &components,
+	})
+	return err
+}
+
+// HandleComponent handles spells:* buttons and select menus
+//
+//	spells:choose:{action}[:spellKey]  - character select menu
+//	spells:level:{charID}:{level}      - show a spell level to prepare
+//	spells:prepare:{charID}:{level}    - prepared spells select menu
+//	spells:done:{charID}               - finish preparing
+func (h *SpellsHandler) HandleComponent(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	ctx := context.Background()
+	parts := strings.Split(i.MessageComponentData().CustomID, ":")
+	if len(parts) < 3 {
+		return respondWithError(s, i, "Invalid spells action")
+	}
+	userID := i.Member.User.ID
+
+	switch parts[1] {
+	case "choose":
+		values := i.MessageComponentData().Values
+		if len(values) == 0 {
+			return respondWithError(s, i, "No character selected")
+		}
+		characterID := values[0]
+
+		switch parts[2] {
+		case SpellsActionPrepare:
+			options, err := h.services.SpellService.StartPreparation(ctx, characterID, userID)
+			if err != nil {
+				return respondWithError(s, i, err.Error())
+			}
+			levels := options.Levels()
+			if len(levels) == 0 {
+				return respondWithError(s, i, "There are no spells to prepare")
+			}
+			return h.renderPreparation(s, i, options, levels[0])
+
+		case SpellsActionCopy:
+			if len(parts) < 4 {
+				return respondWithError(s, i, "No spell chosen")
+			}
+			result, err := h.services.SpellService.CopyToSpellbook(ctx, &spell.CopySpellInput{
+				CharacterID: characterID,
+				UserID:      userID,
+				SpellKey:    parts[3],
+			})
+			if err != nil {
+				return respondWithError(s, i, err.Error())
+			}
+			return updateSpellsMessage(s, i, &discordgo.MessageEmbed{
+				Title: "📜 Spell Copied",
+				Description: fmt.Sprintf("**%s** has been copied into the spellbook.\n\nPaid **%d gp**; the copying takes **%d hours** of work.",
+					result.SpellName, result.GoldCost, result.Hours),
+				Color: 0x2ECC71,
+			})
+
+		case SpellsActionRitual:
+			if len(parts) < 4 {
+				return respondWithError(s, i, "No spell chosen")
+			}
+			result, err := h.services.SpellService.CastSpell(ctx, &spell.CastSpellInput{
+				CharacterID: characterID,
+				UserID:      userID,
+				SpellKey:    parts[3],
+				Ritual:      true,
+			})
+			if err != nil {
+				return respondWithError(s, i, err.Error())
+			}
+			return updateSpellsMessage(s, i, &discordgo.MessageEmbed{
+				Title:       fmt.Sprintf("🕯️ %s", result.SpellName),
+				Description: fmt.Sprintf("%s\n\nThe ritual took %d extra minutes. No spell slot was used.", result.Message, rulebook.RitualCastingMinutes),
+				Color:       0x9B59B6,
+			})
+		}
+
+	case "level":
+		if len(parts) < 4 {
+			return respondWithError(s, i, "Invalid spell level")
+		}
+		level, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return respondWithError(s, i, "Invalid spell level")
+		}
+		options, err := h.services.SpellService.StartPreparation(ctx, parts[2], userID)
+		if err != nil {
+			return respondWithError(s, i, err.Error())
+		}
+		return h.renderPreparation(s, i, options, level)
+
+	case "prepare":
+		if len(parts) < 4 {
+			return respondWithError(s, i, "Invalid spell level")
+		}
+		level, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return respondWithError(s, i, "Invalid spell level")
+		}
+		options, err := h.services.SpellService.PrepareSpells(ctx, &spell.PrepareSpellsInput{
+			CharacterID: parts[2],
+			UserID:      userID,
+			SpellLevel:  level,
+			SpellKeys:   i.MessageComponentData().Values,
+		})
+		if err != nil {
+			return respondWithError(s, i, err.Error())
+		}
+		return h.renderPreparation(s, i, options, level)
+
+	case "done":
+		if err := h.services.SpellService.FinishPreparation(ctx, parts[2], userID); err != nil {
+			return respondWithError(s, i, err.Error())
+		}
+		return updateSpellsMessage(s, i, &discordgo.MessageEmbed{
+			Title:       "📖 Spells Prepared",
+			Description: "Your prepared spells are set until your next long rest.",
+			Color:       0x2ECC71,
+		})
+	}
+
+	return respondWithError(s, i, "Unknown spells action")
+}
+
+// renderPreparation shows the prepared spells and a menu for one spell level
+func (h *SpellsHandler) renderPreparation(s *discordgo.Session, i *discordgo.InteractionCreate, options *spell.PreparationOptions, level int) error {
+	char, err := h.services.CharacterService.GetByID(options.CharacterID)
+	if err != nil {
+		return respondWithError(s, i, "Failed to load character")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📖 %s: Prepare Spells", char.Name),
+		Description: fmt.Sprintf("Prepared **%d/%d** spells. Choose the level %d spells to prepare.", len(options.Prepared), options.MaxPrepared, level),
+		Color:       0x9B59B6,
+	}
+	for _, spellLevel := range options.Levels() {
+		var names []string
+		for _, ref := range options.Spells[spellLevel] {
+			if slices.Contains(options.Prepared, ref.Key) {
+				names = append(names, ref.Name)
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("Level %d", spellLevel),
+			Value:  strings.Join(names, "\n"),
+			Inline: true,
+		})
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: buildPreparationComponents(options, level),
+		},
+	})
+}
+
+// buildPreparationComponents shows a select menu for the chosen level,
+// buttons to switch levels and a done button
+func buildPreparationComponents(options *spell.PreparationOptions, level int) []discordgo.MessageComponent {
+	var components []discordgo.MessageComponent
+
+	refs := options.Spells[level]
+	if len(refs) > 25 {
+		refs = refs[:25]
+	}
+	if len(refs) > 0 {
+		selectOptions := make([]discordgo.SelectMenuOption, 0, len(refs))
+		for _, ref := range refs {
+			selectOptions = append(selectOptions, discordgo.SelectMenuOption{
+				Label:   ref.Name,
+				Value:   ref.Key,
+				Default: slices.Contains(options.Prepared, ref.Key),
+			})
+		}
+		minValues := 0
+		components = append(components, discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    fmt.Sprintf("spells:prepare:%s:%d", options.CharacterID, level),
+					Placeholder: fmt.Sprintf("Prepare level %d spells...", level),
+					Options:     selectOptions,
+					MinValues:   &minValues,
+					MaxValues:   len(selectOptions),
+				},
+			},
+		})
+	}
+
+	var buttons []discordgo.MessageComponent
+	for _, spellLevel := range options.Levels() {
+		style := discordgo.SecondaryButton
+		if spellLevel == level {
+			style = discordgo.PrimaryButton
+		}
+		buttons = append(buttons, discordgo.Button{
+			Label:    fmt.Sprintf("Level %d", spellLevel),
+			Style:    style,
+			CustomID: fmt.Sprintf("spells:level:%s:%d", options.CharacterID, spellLevel),
+			Disabled: spellLevel == level,
+		})
+		if len(buttons) == 5 {
+			components = append(components, discordgo.ActionsRow{Components: buttons})
+			buttons = nil
+		}
+	}
+	if len(buttons) > 0 {
+		components = append(components, discordgo.ActionsRow{Components: buttons})
+	}
+
+	components = append(components, discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    "Done",
+				Style:    discordgo.SuccessButton,
+				CustomID: fmt.Sprintf("spells:done:%s", options.CharacterID),
+				Emoji:    &discordgo.ComponentEmoji{Name: "✅"},
+			},
+		},
+	})
+	return components
+}
+
+func updateSpellsMessage(s *discordgo.Session, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed) error {
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+}
+
+// canTakeSpellsAction filters the characters offered for a spells command
+func canTakeSpellsAction(char *character.Character, action string) bool {
+	switch action {
+	case SpellsActionCopy:
+		return char.LevelInClass("wizard") > 0
+	case SpellsActionRitual:
+		for _, classLevel := range char.ClassLevels() {
+			if rulebook.CanCastRituals(classLevel.Class.Key) {
+				return true
+			}
+		}
+		return false
+	default:
+		return char.PreparesSpells()
+	}
+}
+
+// spellNameToKey converts a spell name such as "Detect Magic" to its key
+func spellNameToKey(name string) string {
+	key := strings.ToLower(strings.TrimSpace(name))
+	key = strings.ReplaceAll(key, "'", "")
+	return strings.Join(strings.Fields(key), "-")
+}
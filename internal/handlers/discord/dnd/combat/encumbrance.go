@@ -0,0 +1,40 @@
+package combat
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+)
+
+// variantEncumbrance checks whether one of the player's active sessions plays
+// with the variant encumbrance rule
+func variantEncumbrance(sessionService session.Service, userID string) bool {
+	if sessionService == nil || userID == "" {
+		return false
+	}
+
+	sessions, err := sessionService.ListActiveUserSessions(context.Background(), userID)
+	if err != nil {
+		log.Printf("Failed to load sessions for %s, ignoring variant encumbrance: %v", userID, err)
+		return false
+	}
+	for _, sess := range sessions {
+		if sess.GetHouseRules().VariantEncumbrance {
+			return true
+		}
+	}
+	return false
+}
+
+// formatD20 shows the d20 that counted, and both dice for a roll made with
+// disadvantage
+func formatD20(roll *dice.RollResult) string {
+	kept := roll.Total - roll.Bonus
+	if len(roll.Rolls) > 1 {
+		return fmt.Sprintf("🎲 %d (disadvantage: %d, %d)", kept, roll.Rolls[0], roll.Rolls[1])
+	}
+	return fmt.Sprintf("🎲 %d", kept)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -16,12 +17,14 @@ import (
 type SavingThrowHandler struct {
 	characterService character.Service
 	encounterService encounter.Service
+	sessionService   session.Service
 }
 
 // SavingThrowHandlerConfig holds configuration for the saving throw handler
 type SavingThrowHandlerConfig struct {
 	CharacterService character.Service
 	EncounterService encounter.Service
+	SessionService   session.Service // Optional, for the variant encumbrance rule
 }
 
 // NewSavingThrowHandler creates a new saving throw handler
@@ -29,6 +32,7 @@ func NewSavingThrowHandler(cfg *SavingThrowHandlerConfig) *SavingThrowHandler {
 	return &SavingThrowHandler{
 		characterService: cfg.CharacterService,
 		encounterService: cfg.EncounterService,
+		sessionService:   cfg.SessionService,
 	}
 }
 
@@ -109,7 +113,7 @@ func (h *SavingThrowHandler) HandleSavingThrowRoll(s *discordgo.Session, i *disc
 	}
 
 	// Roll the saving throw
-	roll, total, err := char.RollSavingThrow(attribute)
+	roll, total, err := char.RollSavingThrow(attribute, variantEncumbrance(h.sessionService, char.OwnerID))
 	if err != nil {
 		log.Printf("Failed to roll saving throw: %v", err)
 		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -143,7 +147,7 @@ func (h *SavingThrowHandler) HandleSavingThrowRoll(s *discordgo.Session, i *disc
 		Fields: []*discordgo.MessageEmbedField{
 			{
 				Name:   "Roll",
-				Value:  fmt.Sprintf("🎲 %d", roll.Rolls[0]),
+				Value:  formatD20(roll),
 				Inline: true,
 			},
 			{
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
 	"github.com/bwmarrin/discordgo"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -38,17 +39,20 @@ var SkillToAttribute = map[string]shared.Attribute{
 // SkillCheckHandler handles skill check interactions
 type SkillCheckHandler struct {
 	characterService character.Service
+	sessionService   session.Service
 }
 
 // SkillCheckHandlerConfig holds configuration for the skill check handler
 type SkillCheckHandlerConfig struct {
 	CharacterService character.Service
+	SessionService   session.Service // Optional, for the variant encumbrance rule
 }
 
 // NewSkillCheckHandler creates a new skill check handler
 func NewSkillCheckHandler(cfg *SkillCheckHandlerConfig) *SkillCheckHandler {
 	return &SkillCheckHandler{
 		characterService: cfg.CharacterService,
+		sessionService:   cfg.SessionService,
 	}
 }
 
@@ -150,7 +154,7 @@ func (h *SkillCheckHandler) HandleSkillCheckRoll(s *discordgo.Session, i *discor
 	}
 
 	// Roll the skill check
-	roll, total, err := char.RollSkillCheck(skillKey, attribute)
+	roll, total, err := char.RollSkillCheck(skillKey, attribute, variantEncumbrance(h.sessionService, char.OwnerID))
 	if err != nil {
 		log.Printf("Failed to roll skill check: %v", err)
 		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -187,7 +191,7 @@ func (h *SkillCheckHandler) HandleSkillCheckRoll(s *discordgo.Session, i *discor
 		Fields: []*discordgo.MessageEmbedField{
 			{
 				Name:   "Roll",
-				Value:  fmt.Sprintf("🎲 %d", roll.Rolls[0]),
+				Value:  formatD20(roll),
 				Inline: true,
 			},
 			{
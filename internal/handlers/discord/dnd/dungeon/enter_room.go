@@ -6,12 +6,16 @@ import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/damage"
 	combat2 "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	"log"
 	"math/rand"
 	"strings"
 
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/handlers/discord/combat"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/handlers/discord/dnd/shop"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	dungeonService "github.com/KirkDiggler/dnd-bot-discord/internal/services/dungeon"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
 	"github.com/bwmarrin/discordgo"
 )
@@ -393,28 +397,65 @@ func (h *EnterRoomHandler) handleTrapRoom(s *discordgo.Session, i *discordgo.Int
 }
 
 func (h *EnterRoomHandler) handleTreasureRoom(s *discordgo.Session, i *discordgo.InteractionCreate, sess *session.Session) error {
-	// Placeholder for treasure room
 	embed := &discordgo.MessageEmbed{
 		Title:       "💰 Treasure Room",
 		Description: "Riches await!",
 		Color:       0xf1c40f, // Gold
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "✨ Loot",
-				Value:  "Treasure mechanics coming soon!",
-				Inline: false,
-			},
-		},
+		Fields:      []*discordgo.MessageEmbedField{},
 	}
 
-	_, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+	claim, err := h.services.DungeonService.ClaimTreasure(context.Background(), sess.ID)
+	switch {
+	case dnderr.IsAlreadyExists(err):
+		embed.Description = "The party has already emptied this room."
+	case err != nil:
+		log.Printf("Failed to claim treasure in session %s: %v", sess.ID, err)
+		content := fmt.Sprintf("❌ Failed to claim the treasure: %v", err)
+		_, editErr := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: &content,
+		})
+		return editErr
+	default:
+		embed.Fields = append(embed.Fields, treasureFields(claim)...)
+	}
+
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
 		Embeds: &[]*discordgo.MessageEmbed{embed},
 	})
 	return err
 }
 
+// treasureFields lists each character's share of the gold and the loot
+func treasureFields(claim *dungeonService.TreasureClaim) []*discordgo.MessageEmbedField {
+	var fields []*discordgo.MessageEmbedField
+	if len(claim.Shares) > 0 {
+		lines := make([]string, len(claim.Shares))
+		for j, share := range claim.Shares {
+			lines[j] = fmt.Sprintf("**%s** +%s", share.Character.Name, shared.FormatCopper(share.Value))
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("🪙 %d gold pieces, split between the party", claim.Gold),
+			Value: strings.Join(lines, "\n"),
+		})
+	}
+
+	if len(claim.Items) > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "✨ Loot",
+			Value: strings.Join(claim.Items, "\n"),
+		})
+	}
+
+	return fields
+}
+
 func (h *EnterRoomHandler) handleRestRoom(s *discordgo.Session, i *discordgo.InteractionCreate, sess *session.Session) error {
-	// Placeholder for rest room
+	// A traveling merchant waits in every rest area
+	sess.OpenShop()
+	if err := h.services.SessionService.SaveSession(context.Background(), sess); err != nil {
+		log.Printf("EnterRoom - Failed to open shop: %v", err)
+	}
+
 	embed := &discordgo.MessageEmbed{
 		Title:       "💤 Rest Area",
 		Description: "A safe place to recover.",
@@ -422,14 +463,21 @@ func (h *EnterRoomHandler) handleRestRoom(s *discordgo.Session, i *discordgo.Int
 		Fields: []*discordgo.MessageEmbedField{
 			{
 				Name:   "🏕️ Rest",
-				Value:  "Rest mechanics coming soon!",
+				Value:  "Use `/dnd rest short` or `/dnd rest long` to recover.",
+				Inline: false,
+			},
+			{
+				Name:   "🛒 Merchant",
+				Value:  "A traveling merchant has set up shop. Buy with `/dnd shop browse` and sell with `/dnd shop sell`.",
 				Inline: false,
 			},
 		},
 	}
 
+	components := []discordgo.MessageComponent{shop.BrowseButton(sess.ID)}
 	_, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
-		Embeds: &[]*discordgo.MessageEmbed{embed},
+		Embeds:     &[]*discordgo.MessageEmbed{embed},
+		Components: &components,
 	})
 	return err
 }
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -165,10 +166,10 @@ func (h *JoinPartyHandler) HandleButton(s *discordgo.Session, i *discordgo.Inter
 	// This ensures fresh resources for each dungeon run
 	if playerChar.Resources != nil {
 		log.Printf("Performing long rest for %s before dungeon entry", playerChar.Name)
-		playerChar.Resources.LongRest()
+		playerChar.LongRest(sess.GetHouseRules().SlowNaturalHealing)
 
 		// Save the rested character
-		if updateErr := h.services.CharacterService.UpdateEquipment(playerChar); updateErr != nil {
+		if updateErr := h.services.CharacterService.UpdateEquipment(charService.WithChange(context.Background(), i.Member.User.ID, "long rest on entering the dungeon"), playerChar); updateErr != nil {
 			log.Printf("Warning: Failed to save character after rest: %v", updateErr)
 		} else {
 			log.Printf("Character %s has been rested and saved", playerChar.Name)
@@ -100,7 +100,12 @@ func (h *HelpHandler) getCharacterHelp() *discordgo.MessageEmbed {
 			},
 			{
 				Name:   "Managing Characters",
-				Value:  "`/dnd character list` - See all your characters\n`/dnd character show <id>` - View full character sheet\n`/dnd character delete <id>` - Delete a character (⚠️ permanent!)",
+				Value:  "`/dnd character list` - See all your characters\n`/dnd character show <id>` - View full character sheet\n`/dnd character delete <id>` - Delete a character (⚠️ permanent!)\n`/dnd character levelup` - Advance a character to the next level\n`/dnd character pay <to> <amount> <coin>` - Give coins to a party member",
+				Inline: false,
+			},
+			{
+				Name:   "Spells",
+				Value:  "`/dnd spells prepare` - Choose prepared spells after a long rest\n`/dnd spells copy <spell>` - Copy a spell into a wizard's spellbook\n`/dnd spells ritual <spell>` - Cast a ritual spell without a slot",
 				Inline: false,
 			},
 			{
@@ -130,7 +135,17 @@ func (h *HelpHandler) getSessionHelp() *discordgo.MessageEmbed {
 			},
 			{
 				Name:   "Session Commands",
-				Value:  "**DM Commands:**\n`/dnd session start` - Begin the session\n`/dnd session end` - Conclude the session\n`/dnd session info` - View session details\n\n**Player Commands:**\n`/dnd session list` - View your sessions\n`/dnd session info` - Current session info\n`Leave Session` button - Exit a session",
+				Value:  "**DM Commands:**\n`/dnd session start` - Begin the session\n`/dnd session end` - Conclude the session\n`/dnd session info` - View session details\n`/dnd session rules` - Toggle house rules like flanking and max-dice crits\n`/dnd admin givecoins` / `takecoins` - Give or take a character's coins\n\n**Player Commands:**\n`/dnd session list` - View your sessions\n`/dnd session info` - Current session info\n`Leave Session` button - Exit a session",
+				Inline: false,
+			},
+			{
+				Name:   "Resting",
+				Value:  "`/dnd rest short` - Short rest; each player chooses how many hit dice to spend\n`/dnd rest long` - Long rest once the whole party agrees or the DM calls it; restores HP, spell slots and half your hit dice",
+				Inline: false,
+			},
+			{
+				Name:   "Shopping",
+				Value:  "`/dnd shop browse` - Buy SRD equipment from the session's merchant\n`/dnd shop sell` - Sell unequipped items for half price\n`/dnd shop open` / `close` - Open or close the merchant (DM only)\n`/dnd shop stock` - Choose which categories the merchant sells (DM only)",
 				Inline: false,
 			},
 			{
@@ -0,0 +1,359 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	restService "github.com/KirkDiggler/dnd-bot-discord/internal/services/rest"
+)
+
+type RestRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	RestType    shared.RestType
+}
+
+type RestHandler struct {
+	services *services.Provider
+}
+
+func NewRestHandler(serviceProvider *services.Provider) *RestHandler {
+	return &RestHandler{
+		services: serviceProvider,
+	}
+}
+
+// Handle starts a short or long rest for the party in the channel's session
+func (h *RestHandler) Handle(req *RestRequest) error {
+	// The rest is announced to the whole channel
+	err := req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge interaction: %w", err)
+	}
+
+	ctx := context.Background()
+	userID := req.Interaction.Member.User.ID
+
+	sessions, err := h.services.SessionService.ListActiveUserSessions(ctx, userID)
+	if err != nil {
+		return h.editResponse(req, fmt.Sprintf("❌ Failed to retrieve your active sessions: %v", err))
+	}
+
+	// Rest in the session played in this channel
+	var session *gameSession.Session
+	for _, s := range sessions {
+		if s.ChannelID == req.Interaction.ChannelID {
+			session = s
+			break
+		}
+	}
+	if session == nil {
+		return h.editResponse(req, "🏕️ You're not in an active session in this channel. Join one with `/dnd session join` first.")
+	}
+
+	var result *restService.Result
+	if req.RestType == shared.RestTypeLong {
+		result, err = h.services.RestService.LongRest(ctx, session.ID, userID)
+	} else {
+		result, err = h.services.RestService.ShortRest(ctx, session.ID, userID)
+	}
+	if err != nil {
+		return h.editResponse(req, fmt.Sprintf("❌ %v", err))
+	}
+
+	embed := buildRestEmbed(session, result)
+	edit := &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+	}
+	if len(result.AwaitingApproval) > 0 {
+		components := longRestApproval(session.ID)
+		edit.Components = &components
+	} else if result.RestType == shared.RestTypeShort {
+		components := []discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.Button{
+						Label:    "Spend Hit Dice",
+						Style:    discordgo.PrimaryButton,
+						CustomID: fmt.Sprintf("rest:hit_dice:%s", session.ID),
+						Emoji:    &discordgo.ComponentEmoji{Name: "🎲"},
+					},
+					discordgo.Button{
+						Label:    "End Short Rest",
+						Style:    discordgo.SecondaryButton,
+						CustomID: fmt.Sprintf("rest:end:%s", session.ID),
+					},
+				},
+			},
+		}
+		edit.Components = &components
+	}
+
+	_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, edit)
+	return err
+}
+
+// HandleComponent handles the hit dice and end rest buttons
+func (h *RestHandler) HandleComponent(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	ctx := context.Background()
+	parts := strings.Split(i.MessageComponentData().CustomID, ":")
+	if len(parts) < 3 {
+		return respondEphemeral(s, i, "❌ Invalid rest action")
+	}
+	sessionID := parts[2]
+	userID := i.Member.User.ID
+
+	switch parts[1] {
+	case "hit_dice":
+		return h.showHitDiceChoice(s, i, sessionID)
+
+	case "spend":
+		values := i.MessageComponentData().Values
+		if len(values) == 0 {
+			return respondEphemeral(s, i, "❌ Choose how many hit dice to spend")
+		}
+		count, err := strconv.Atoi(values[0])
+		if err != nil {
+			return respondEphemeral(s, i, "❌ Invalid number of hit dice")
+		}
+
+		result, err := h.services.RestService.SpendHitDice(ctx, &restService.SpendHitDiceInput{
+			SessionID: sessionID,
+			UserID:    userID,
+			Count:     count,
+		})
+		if err != nil {
+			return respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+		}
+
+		content := formatHitDiceResult(result)
+		err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    content,
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		// Let the rest of the party know
+		_, err = s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+			Content: content,
+		})
+		return err
+
+	case "long":
+		result, err := h.services.RestService.LongRest(ctx, sessionID, userID)
+		if err != nil {
+			return respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+		}
+		session, err := h.services.SessionService.GetSession(ctx, sessionID)
+		if err != nil {
+			return respondEphemeral(s, i, fmt.Sprintf("❌ Failed to get session: %v", err))
+		}
+
+		components := []discordgo.MessageComponent{}
+		if len(result.AwaitingApproval) > 0 {
+			components = longRestApproval(sessionID)
+		}
+		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Embeds:     []*discordgo.MessageEmbed{buildRestEmbed(session, result)},
+				Components: components,
+			},
+		})
+
+	case "end":
+		if err := h.services.RestService.EndShortRest(ctx, sessionID, userID); err != nil {
+			return respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+		}
+
+		embeds := i.Message.Embeds
+		if len(embeds) > 0 {
+			embeds[0].Footer = &discordgo.MessageEmbedFooter{
+				Text: fmt.Sprintf("Short rest ended by %s", i.Member.User.Username),
+			}
+		}
+		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Embeds:     embeds,
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+	}
+
+	return respondEphemeral(s, i, "❌ Unknown rest action")
+}
+
+// showHitDiceChoice asks the player how many of their hit dice to spend
+func (h *RestHandler) showHitDiceChoice(s *discordgo.Session, i *discordgo.InteractionCreate, sessionID string) error {
+	session, err := h.services.SessionService.GetSession(context.Background(), sessionID)
+	if err != nil {
+		return respondEphemeral(s, i, fmt.Sprintf("❌ Failed to get session: %v", err))
+	}
+
+	member, exists := session.Members[i.Member.User.ID]
+	if !exists || member.CharacterID == "" {
+		return respondEphemeral(s, i, "❌ You don't have a character in this session")
+	}
+
+	char, err := h.services.CharacterService.GetByID(member.CharacterID)
+	if err != nil {
+		return respondEphemeral(s, i, fmt.Sprintf("❌ Failed to get character: %v", err))
+	}
+
+	hitDice := char.GetResources().HitDice
+	remaining := hitDice.Remaining()
+	if remaining == 0 {
+		return respondEphemeral(s, i, fmt.Sprintf("🎲 %s has no hit dice left. They come back with a long rest.", char.Name))
+	}
+
+	// Discord select menus hold at most 25 options
+	var options []discordgo.SelectMenuOption
+	for count := 1; count <= min(remaining, 25); count++ {
+		label := fmt.Sprintf("%d × d%d", count, char.HitDieType())
+		if len(hitDice) > 1 {
+			label = fmt.Sprintf("%d hit dice (largest first)", count)
+		}
+		options = append(options, discordgo.SelectMenuOption{
+			Label: label,
+			Value: strconv.Itoa(count),
+		})
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("🎲 **%s** - HP %d/%d, %d hit dice left. How many do you spend?",
+				char.Name, char.CurrentHitPoints, char.MaxHitPoints, remaining),
+			Flags: discordgo.MessageFlagsEphemeral,
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.SelectMenu{
+							CustomID:    fmt.Sprintf("rest:spend:%s", sessionID),
+							Placeholder: "Hit dice to spend",
+							Options:     options,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func (h *RestHandler) editResponse(req *RestRequest, content string) error {
+	_, err := req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Content: &content,
+	})
+	return err
+}
+
+// longRestApproval is the button the rest of the party agrees to a long rest with
+func longRestApproval(sessionID string) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Agree to Rest",
+					Style:    discordgo.PrimaryButton,
+					CustomID: fmt.Sprintf("rest:long:%s", sessionID),
+					Emoji:    &discordgo.ComponentEmoji{Name: "🌙"},
+				},
+			},
+		},
+	}
+}
+
+func buildRestEmbed(session *gameSession.Session, result *restService.Result) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Fields: []*discordgo.MessageEmbedField{},
+	}
+
+	if len(result.AwaitingApproval) > 0 {
+		waiting := make([]string, len(result.AwaitingApproval))
+		for i, userID := range result.AwaitingApproval {
+			waiting[i] = fmt.Sprintf("<@%s>", userID)
+		}
+		embed.Title = fmt.Sprintf("🌙 Long Rest? - %s", session.Name)
+		embed.Description = "A long rest has been proposed. The party rests once every player agrees, or the DM calls it."
+		embed.Color = 0x2c3e50 // Midnight blue
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Waiting on",
+			Value: strings.Join(waiting, ", "),
+		})
+		return embed
+	}
+
+	if result.RestType == shared.RestTypeLong {
+		embed.Title = fmt.Sprintf("🌙 Long Rest - %s", session.Name)
+		embed.Description = "The party rests for the night. Hit points, spell slots and abilities are restored, along with half of each character's hit dice."
+		embed.Color = 0x2c3e50 // Midnight blue
+	} else {
+		embed.Title = fmt.Sprintf("🏕️ Short Rest - %s", session.Name)
+		embed.Description = "The party takes a breather. Short rest abilities are restored. Spend hit dice to heal, then end the rest."
+		embed.Color = 0xe67e22 // Orange
+	}
+
+	for _, char := range result.Characters {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   char.Name,
+			Value:  formatRestStatus(char),
+			Inline: true,
+		})
+	}
+
+	return embed
+}
+
+func formatRestStatus(char *character.Character) string {
+	return fmt.Sprintf("❤️ %d/%d HP\n🎲 %s", char.CurrentHitPoints, char.MaxHitPoints,
+		char.GetResources().HitDice)
+}
+
+func formatHitDiceResult(result *restService.HitDiceResult) string {
+	rolls := make([]string, len(result.Rolls))
+	for i, roll := range result.Rolls {
+		rolls[i] = strconv.Itoa(roll)
+	}
+
+	modifier := ""
+	if result.Modifier != 0 {
+		modifier = fmt.Sprintf(" %+d CON each", result.Modifier)
+	}
+
+	return fmt.Sprintf("🎲 **%s** spends %d hit %s [%s]%s and regains **%d HP** (%d/%d HP, %d hit dice left)",
+		result.Character.Name, len(result.Rolls), pluralDie(len(result.Rolls)), strings.Join(rolls, ", "), modifier,
+		result.Healed, result.Character.CurrentHitPoints, result.Character.MaxHitPoints, result.HitDiceLeft)
+}
+
+func pluralDie(count int) string {
+	if count == 1 {
+		return "die"
+	}
+	return "dice"
+}
+
+func respondEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, content string) error {
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
@@ -152,6 +152,13 @@ func (h *InfoHandler) Handle(req *InfoRequest) error {
 	if session.Settings.AutoEndAfterHours > 0 {
 		settingsInfo = append(settingsInfo, fmt.Sprintf("⏰ Auto-end after %d hours", session.Settings.AutoEndAfterHours))
 	}
+	if houseRules := session.GetHouseRules().EnabledRules(); len(houseRules) > 0 {
+		names := make([]string, 0, len(houseRules))
+		for _, rule := range houseRules {
+			names = append(names, rule.Name)
+		}
+		settingsInfo = append(settingsInfo, fmt.Sprintf("📜 House rules: %s", strings.Join(names, ", ")))
+	}
 
 	if len(settingsInfo) > 0 {
 		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
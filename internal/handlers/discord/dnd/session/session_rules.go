@@ -0,0 +1,258 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+)
+
+type RulesRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+}
+
+type RulesToggleRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	SessionID   string
+	Rule        gameSession.HouseRule
+}
+
+type RulesAbilityMethodRequest struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	SessionID   string
+	Method      shared.AbilityScoreMethod
+}
+
+// abilityMethodAny is the select value for letting players choose, since
+// select options can't have an empty value
+const abilityMethodAny = "any"
+
+type RulesHandler struct {
+	services *services.Provider
+}
+
+func NewRulesHandler(serviceProvider *services.Provider) *RulesHandler {
+	return &RulesHandler{
+		services: serviceProvider,
+	}
+}
+
+// Handle shows the house rules editor for the session the user is running
+func (h *RulesHandler) Handle(req *RulesRequest) error {
+	// Defer acknowledge the interaction
+	err := req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge interaction: %w", err)
+	}
+
+	userID := req.Interaction.Member.User.ID
+
+	sessions, err := h.services.SessionService.ListActiveUserSessions(context.Background(), userID)
+	if err != nil {
+		content := fmt.Sprintf("❌ Failed to retrieve your active sessions: %v", err)
+		_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+			Content: &content,
+		})
+		return err
+	}
+
+	// Find the most recently active session this user is running
+	var session *gameSession.Session
+	for _, s := range sessions {
+		member, exists := s.Members[userID]
+		if !exists || member.Role != gameSession.SessionRoleDM {
+			continue
+		}
+		if session == nil || s.LastActive.After(session.LastActive) {
+			session = s
+		}
+	}
+
+	if session == nil {
+		content := "📝 You're not the DM of any active session. Only the DM can change house rules."
+		_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+			Content: &content,
+		})
+		return err
+	}
+
+	embed := h.buildEmbed(session)
+	components := h.buildComponents(session)
+
+	_, err = req.Session.InteractionResponseEdit(req.Interaction.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{embed},
+		Components: &components,
+	})
+	return err
+}
+
+// HandleToggle flips a single house rule and refreshes the editor
+func (h *RulesHandler) HandleToggle(req *RulesToggleRequest) error {
+	ctx := context.Background()
+
+	session, err := h.services.SessionService.GetSession(ctx, req.SessionID)
+	if err != nil {
+		return h.respondError(req.Session, req.Interaction, fmt.Sprintf("❌ Failed to get session: %v", err))
+	}
+
+	enabled := !session.GetHouseRules().IsEnabled(req.Rule)
+	session, err = h.services.SessionService.SetHouseRule(ctx, req.SessionID, req.Interaction.Member.User.ID, req.Rule, enabled)
+	if err != nil {
+		return h.respondError(req.Session, req.Interaction, fmt.Sprintf("❌ Failed to update house rules: %v", err))
+	}
+
+	embed := h.buildEmbed(session)
+	components := h.buildComponents(session)
+
+	return req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	})
+}
+
+// HandleAbilityMethod sets the ability score method new characters must use
+func (h *RulesHandler) HandleAbilityMethod(req *RulesAbilityMethodRequest) error {
+	session, err := h.services.SessionService.SetAbilityScoreMethod(context.Background(), req.SessionID, req.Interaction.Member.User.ID, req.Method)
+	if err != nil {
+		return h.respondError(req.Session, req.Interaction, fmt.Sprintf("❌ Failed to update the ability score method: %v", err))
+	}
+
+	embed := h.buildEmbed(session)
+	components := h.buildComponents(session)
+
+	return req.Session.InteractionRespond(req.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	})
+}
+
+// ParseAbilityMethod converts an ability method select value to a method
+func ParseAbilityMethod(value string) shared.AbilityScoreMethod {
+	if value == abilityMethodAny {
+		return shared.AbilityScoreMethodAny
+	}
+	return shared.AbilityScoreMethod(value)
+}
+
+func (h *RulesHandler) respondError(s *discordgo.Session, i *discordgo.InteractionCreate, content string) error {
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
+
+func (h *RulesHandler) buildEmbed(session *gameSession.Session) *discordgo.MessageEmbed {
+	rules := session.GetHouseRules()
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📜 House Rules - %s", session.Name),
+		Description: "Toggle optional rules for this session. Changes apply to the next roll.",
+		Color:       0x9b59b6, // Purple
+		Fields:      []*discordgo.MessageEmbedField{},
+	}
+
+	for _, info := range gameSession.AllHouseRules {
+		status := "❌ Off"
+		if rules.IsEnabled(info.Rule) {
+			status = "✅ On"
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("%s %s - %s", info.Emoji, info.Name, status),
+			Value:  info.Description,
+			Inline: false,
+		})
+	}
+
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name:   fmt.Sprintf("🎲 Ability Scores - %s", session.GetAbilityScoreMethod().DisplayName()),
+		Value:  "How new characters generate their ability scores",
+		Inline: false,
+	})
+
+	embed.Footer = &discordgo.MessageEmbedFooter{
+		Text: fmt.Sprintf("Session ID: %s", session.ID),
+	}
+
+	return embed
+}
+
+func (h *RulesHandler) buildComponents(session *gameSession.Session) []discordgo.MessageComponent {
+	rules := session.GetHouseRules()
+
+	// Discord allows at most 5 buttons per row
+	var rows []discordgo.MessageComponent
+	var buttons []discordgo.MessageComponent
+	for _, info := range gameSession.AllHouseRules {
+		style := discordgo.SecondaryButton
+		if rules.IsEnabled(info.Rule) {
+			style = discordgo.SuccessButton
+		}
+		buttons = append(buttons, discordgo.Button{
+			Label:    info.Name,
+			Style:    style,
+			CustomID: fmt.Sprintf("session_rules:toggle:%s:%s", session.ID, info.Rule),
+			Emoji:    &discordgo.ComponentEmoji{Name: info.Emoji},
+		})
+		if len(buttons) == 5 {
+			rows = append(rows, discordgo.ActionsRow{Components: buttons})
+			buttons = nil
+		}
+	}
+	if len(buttons) > 0 {
+		rows = append(rows, discordgo.ActionsRow{Components: buttons})
+	}
+
+	current := session.GetAbilityScoreMethod()
+	options := []discordgo.SelectMenuOption{{
+		Label:       shared.AbilityScoreMethodAny.DisplayName(),
+		Value:       abilityMethodAny,
+		Description: "Players roll, use the standard array or point buy",
+		Default:     current == shared.AbilityScoreMethodAny,
+	}}
+	for _, method := range shared.AbilityScoreMethods {
+		options = append(options, discordgo.SelectMenuOption{
+			Label:       fmt.Sprintf("Require %s", method.DisplayName()),
+			Value:       string(method),
+			Description: abilityMethodDescriptions[method],
+			Default:     current == method,
+		})
+	}
+	rows = append(rows, discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.SelectMenu{
+				CustomID:    fmt.Sprintf("session_rules:ability_method:%s", session.ID),
+				Placeholder: "Ability score method",
+				Options:     options,
+			},
+		},
+	})
+
+	return rows
+}
+
+var abilityMethodDescriptions = map[shared.AbilityScoreMethod]string{
+	shared.AbilityScoreMethodRoll:          "Everyone rolls 4d6 and drops the lowest",
+	shared.AbilityScoreMethodStandardArray: "Everyone assigns 15, 14, 13, 12, 10 and 8",
+	shared.AbilityScoreMethodPointBuy:      "Everyone spends 27 points on scores from 8 to 15",
+}
@@ -0,0 +1,628 @@
+package shop
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
+	shopService "github.com/KirkDiggler/dnd-bot-discord/internal/services/shop"
+)
+
+// itemsPerPage keeps each page of stock readable in a single embed
+const itemsPerPage = 10
+
+// ShopHandler handles the /dnd shop commands and the merchant's buttons
+type ShopHandler struct {
+	services *services.Provider
+}
+
+// NewShopHandler creates a new shop handler
+func NewShopHandler(serviceProvider *services.Provider) *ShopHandler {
+	return &ShopHandler{
+		services: serviceProvider,
+	}
+}
+
+// HandleBrowse shows the categories the merchant in the user's session sells
+func (h *ShopHandler) HandleBrowse(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	if err := deferEphemeral(s, i); err != nil {
+		return err
+	}
+
+	session, err := h.findPlayerSession(i.Member.User.ID)
+	if err != nil {
+		return editResponse(s, i, fmt.Sprintf("❌ Failed to retrieve your active sessions: %v", err))
+	}
+	if session == nil {
+		return editResponse(s, i, "🛒 You need a character in an active session to go shopping")
+	}
+
+	data, err := h.buildCategoryMenu(session.ID, i.Member.User.ID)
+	if err != nil {
+		return editResponse(s, i, fmt.Sprintf("❌ %v", err))
+	}
+
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &data.Embeds,
+		Components: &data.Components,
+	})
+	return err
+}
+
+// HandleSell shows the items the user's character can sell
+func (h *ShopHandler) HandleSell(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	if err := deferEphemeral(s, i); err != nil {
+		return err
+	}
+
+	session, err := h.findPlayerSession(i.Member.User.ID)
+	if err != nil {
+		return editResponse(s, i, fmt.Sprintf("❌ Failed to retrieve your active sessions: %v", err))
+	}
+	if session == nil {
+		return editResponse(s, i, "🛒 You need a character in an active session to sell anything")
+	}
+
+	data, err := h.buildSellMenu(session.ID, i.Member.User.ID, "")
+	if err != nil {
+		return editResponse(s, i, fmt.Sprintf("❌ %v", err))
+	}
+
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &data.Embeds,
+		Components: &data.Components,
+	})
+	return err
+}
+
+// HandleOpen opens or closes the merchant in the session the user runs
+func (h *ShopHandler) HandleOpen(s *discordgo.Session, i *discordgo.InteractionCreate, open bool) error {
+	// Opening the shop is announced to the table
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge interaction: %w", err)
+	}
+
+	ctx := context.Background()
+	userID := i.Member.User.ID
+
+	session, err := h.findDMSession(userID)
+	if err != nil {
+		return editResponse(s, i, fmt.Sprintf("❌ Failed to retrieve your active sessions: %v", err))
+	}
+	if session == nil {
+		return editResponse(s, i, "🛒 You're not the DM of any active session. Only the DM can open the shop.")
+	}
+
+	session, err = h.services.SessionService.SetShopOpen(ctx, session.ID, userID, open)
+	if err != nil {
+		return editResponse(s, i, fmt.Sprintf("❌ Failed to update the shop: %v", err))
+	}
+
+	if !open {
+		return editResponse(s, i, "🔒 The merchant packs up their wares. The shop is closed.")
+	}
+
+	content := "🛒 A merchant sets up shop! Use `/dnd shop browse` to buy and `/dnd shop sell` to sell."
+	components := []discordgo.MessageComponent{BrowseButton(session.ID)}
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content:    &content,
+		Components: &components,
+	})
+	return err
+}
+
+// HandleStock shows the DM which categories the merchant sells
+func (h *ShopHandler) HandleStock(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	if err := deferEphemeral(s, i); err != nil {
+		return err
+	}
+
+	session, err := h.findDMSession(i.Member.User.ID)
+	if err != nil {
+		return editResponse(s, i, fmt.Sprintf("❌ Failed to retrieve your active sessions: %v", err))
+	}
+	if session == nil {
+		return editResponse(s, i, "🛒 You're not the DM of any active session. Only the DM can stock the shop.")
+	}
+
+	embed := buildStockEmbed(session)
+	components := buildStockComponents(session)
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{embed},
+		Components: &components,
+	})
+	return err
+}
+
+// HandleComponent handles the shop's menus and buttons
+func (h *ShopHandler) HandleComponent(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	ctx := context.Background()
+	parts := strings.Split(i.MessageComponentData().CustomID, ":")
+	if len(parts) < 3 {
+		return respondEphemeral(s, i, "❌ Invalid shop action")
+	}
+	sessionID := parts[2]
+	userID := i.Member.User.ID
+
+	switch parts[1] {
+	case "browse":
+		// Shop buttons are posted to the channel, so each player gets their own menu
+		data, err := h.buildCategoryMenu(sessionID, userID)
+		if err != nil {
+			return respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+		}
+		data.Flags = discordgo.MessageFlagsEphemeral
+		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: data,
+		})
+
+	case "category":
+		values := i.MessageComponentData().Values
+		if len(values) == 0 {
+			return respondEphemeral(s, i, "❌ Choose a category")
+		}
+		return h.updatePage(s, i, sessionID, values[0], 0, "")
+
+	case "page":
+		if len(parts) < 5 {
+			return respondEphemeral(s, i, "❌ Invalid shop page")
+		}
+		page, err := strconv.Atoi(parts[4])
+		if err != nil {
+			return respondEphemeral(s, i, "❌ Invalid shop page")
+		}
+		return h.updatePage(s, i, sessionID, parts[3], page, "")
+
+	case "categories":
+		data, err := h.buildCategoryMenu(sessionID, userID)
+		if err != nil {
+			return respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+		}
+		return updateMessage(s, i, data)
+
+	case "buy":
+		if len(parts) < 5 {
+			return respondEphemeral(s, i, "❌ Invalid purchase")
+		}
+		values := i.MessageComponentData().Values
+		if len(values) == 0 {
+			return respondEphemeral(s, i, "❌ Choose something to buy")
+		}
+		page, _ := strconv.Atoi(parts[4])
+
+		result, err := h.services.ShopService.Buy(ctx, &shopService.BuyInput{
+			SessionID: sessionID,
+			UserID:    userID,
+			Category:  parts[3],
+			ItemKey:   values[0],
+		})
+		if err != nil {
+			return h.updatePage(s, i, sessionID, parts[3], page, fmt.Sprintf("❌ %v", err))
+		}
+
+		notice := fmt.Sprintf("✅ %s buys **%s** for %s", result.Character.Name, result.Item.GetName(),
+			shared.FormatCopper(result.Price))
+		return h.updatePage(s, i, sessionID, parts[3], page, notice)
+
+	case "sell":
+		values := i.MessageComponentData().Values
+		if len(values) == 0 {
+			return respondEphemeral(s, i, "❌ Choose something to sell")
+		}
+
+		notice := ""
+		result, err := h.services.ShopService.Sell(ctx, &shopService.SellInput{
+			SessionID: sessionID,
+			UserID:    userID,
+			ItemKey:   values[0],
+		})
+		if err != nil {
+			notice = fmt.Sprintf("❌ %v", err)
+		} else {
+			notice = fmt.Sprintf("✅ %s sells **%s** for %s", result.Character.Name, result.Item.GetName(),
+				shared.FormatCopper(result.Price))
+		}
+
+		data, err := h.buildSellMenu(sessionID, userID, notice)
+		if err != nil {
+			return respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+		}
+		return updateMessage(s, i, data)
+
+	case "stock":
+		if len(parts) < 4 {
+			return respondEphemeral(s, i, "❌ Invalid shop category")
+		}
+
+		session, err := h.services.SessionService.GetSession(ctx, sessionID)
+		if err != nil {
+			return respondEphemeral(s, i, fmt.Sprintf("❌ Failed to get session: %v", err))
+		}
+
+		stocked := !session.GetShop().Stocks(parts[3])
+		session, err = h.services.SessionService.SetShopCategory(ctx, sessionID, userID, parts[3], stocked)
+		if err != nil {
+			return respondEphemeral(s, i, fmt.Sprintf("❌ Failed to update the shop: %v", err))
+		}
+
+		return updateMessage(s, i, &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{buildStockEmbed(session)},
+			Components: buildStockComponents(session),
+		})
+	}
+
+	return respondEphemeral(s, i, "❌ Unknown shop action")
+}
+
+// updatePage replaces the shop message with a page of a category's stock
+func (h *ShopHandler) updatePage(s *discordgo.Session, i *discordgo.InteractionCreate, sessionID, category string, page int, notice string) error {
+	data, err := h.buildPage(sessionID, i.Member.User.ID, category, page, notice)
+	if err != nil {
+		return respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+	}
+	return updateMessage(s, i, data)
+}
+
+// buildCategoryMenu lists the categories the merchant sells
+func (h *ShopHandler) buildCategoryMenu(sessionID, userID string) (*discordgo.InteractionResponseData, error) {
+	ctx := context.Background()
+
+	char, err := h.services.ShopService.GetCustomer(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := h.services.SessionService.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var options []discordgo.SelectMenuOption
+	for _, key := range session.GetShop().StockedCategories() {
+		info, ok := gameSession.GetShopCategory(key)
+		if !ok {
+			continue
+		}
+		options = append(options, discordgo.SelectMenuOption{
+			Label: info.Name,
+			Value: info.Key,
+			Emoji: &discordgo.ComponentEmoji{Name: info.Emoji},
+		})
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🛒 Merchant - %s", session.Name),
+		Description: "Choose a category to see what's for sale.",
+		Color:       0xe67e22, // Orange
+		Fields: []*discordgo.MessageEmbedField{
+			walletField(char),
+		},
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{embed},
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.SelectMenu{
+						CustomID:    fmt.Sprintf("shop:category:%s", sessionID),
+						Placeholder: "Browse a category",
+						Options:     options,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// buildPage shows one page of a category with a menu to buy from it
+func (h *ShopHandler) buildPage(sessionID, userID, category string, page int, notice string) (*discordgo.InteractionResponseData, error) {
+	ctx := context.Background()
+
+	stock, err := h.services.ShopService.ListStock(ctx, sessionID, category)
+	if err != nil {
+		return nil, err
+	}
+
+	char, err := h.services.ShopService.GetCustomer(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := max((len(stock)+itemsPerPage-1)/itemsPerPage, 1)
+	page = max(min(page, pages-1), 0)
+	start := page * itemsPerPage
+	items := stock[start:min(start+itemsPerPage, len(stock))]
+
+	info, _ := gameSession.GetShopCategory(category)
+
+	var lines []string
+	var options []discordgo.SelectMenuOption
+	for _, item := range items {
+		price := shared.FormatCopper(item.Price)
+		lines = append(lines, fmt.Sprintf("**%s** - %s", item.Equipment.GetName(), price))
+
+		option := discordgo.SelectMenuOption{
+			Label: item.Equipment.GetName(),
+			Value: item.Equipment.GetKey(),
+		}
+		if char.Wallet.CanAfford(item.Price) {
+			option.Description = price
+		} else {
+			option.Description = fmt.Sprintf("%s (can't afford)", price)
+		}
+		options = append(options, option)
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "*Nothing for sale*")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("%s %s", info.Emoji, info.Name),
+		Description: strings.Join(lines, "\n"),
+		Color:       0xe67e22, // Orange
+		Fields: []*discordgo.MessageEmbedField{
+			walletField(char),
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Page %d of %d", page+1, pages),
+		},
+	}
+
+	var components []discordgo.MessageComponent
+	if len(options) > 0 {
+		components = append(components, discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    fmt.Sprintf("shop:buy:%s:%s:%d", sessionID, category, page),
+					Placeholder: "Buy an item",
+					Options:     options,
+				},
+			},
+		})
+	}
+	components = append(components, discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    "Previous",
+				Style:    discordgo.SecondaryButton,
+				CustomID: fmt.Sprintf("shop:page:%s:%s:%d", sessionID, category, page-1),
+				Emoji:    &discordgo.ComponentEmoji{Name: "◀️"},
+				Disabled: page == 0,
+			},
+			discordgo.Button{
+				Label:    "Next",
+				Style:    discordgo.SecondaryButton,
+				CustomID: fmt.Sprintf("shop:page:%s:%s:%d", sessionID, category, page+1),
+				Emoji:    &discordgo.ComponentEmoji{Name: "▶️"},
+				Disabled: page >= pages-1,
+			},
+			discordgo.Button{
+				Label:    "Categories",
+				Style:    discordgo.SecondaryButton,
+				CustomID: fmt.Sprintf("shop:categories:%s", sessionID),
+				Emoji:    &discordgo.ComponentEmoji{Name: "🛒"},
+			},
+		},
+	})
+
+	return &discordgo.InteractionResponseData{
+		Content:    notice,
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: components,
+	}, nil
+}
+
+// buildSellMenu lists what the character can sell for half price
+func (h *ShopHandler) buildSellMenu(sessionID, userID, notice string) (*discordgo.InteractionResponseData, error) {
+	char, err := h.services.ShopService.GetCustomer(context.Background(), sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sellable := shopService.Sellable(char)
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("💰 Sell - %s", char.Name),
+		Description: "The merchant pays half price. Equipped items can't be sold.",
+		Color:       0xf1c40f, // Gold
+		Fields: []*discordgo.MessageEmbedField{
+			walletField(char),
+		},
+	}
+
+	components := []discordgo.MessageComponent{}
+	if len(sellable) == 0 {
+		embed.Description = "You have nothing the merchant wants to buy. Equipped items can't be sold."
+	} else {
+		// Discord select menus hold at most 25 options
+		var options []discordgo.SelectMenuOption
+		for _, item := range sellable[:min(len(sellable), 25)] {
+			options = append(options, discordgo.SelectMenuOption{
+				Label:       item.Equipment.GetName(),
+				Value:       item.Equipment.GetKey(),
+				Description: shared.FormatCopper(item.Price),
+			})
+		}
+		components = append(components, discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    fmt.Sprintf("shop:sell:%s", sessionID),
+					Placeholder: "Sell an item",
+					Options:     options,
+				},
+			},
+		})
+	}
+
+	return &discordgo.InteractionResponseData{
+		Content:    notice,
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: components,
+	}, nil
+}
+
+// findPlayerSession returns the most recently active session the user has a character in
+func (h *ShopHandler) findPlayerSession(userID string) (*gameSession.Session, error) {
+	sessions, err := h.services.SessionService.ListActiveUserSessions(context.Background(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var session *gameSession.Session
+	for _, s := range sessions {
+		if member, exists := s.Members[userID]; !exists || member.CharacterID == "" {
+			continue
+		}
+		if session == nil || s.LastActive.After(session.LastActive) {
+			session = s
+		}
+	}
+	return session, nil
+}
+
+// findDMSession returns the most recently active session the user is the DM of
+func (h *ShopHandler) findDMSession(userID string) (*gameSession.Session, error) {
+	sessions, err := h.services.SessionService.ListActiveUserSessions(context.Background(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var session *gameSession.Session
+	for _, s := range sessions {
+		member, exists := s.Members[userID]
+		if !exists || member.Role != gameSession.SessionRoleDM {
+			continue
+		}
+		if session == nil || s.LastActive.After(session.LastActive) {
+			session = s
+		}
+	}
+	return session, nil
+}
+
+func buildStockEmbed(session *gameSession.Session) *discordgo.MessageEmbed {
+	shop := session.GetShop()
+
+	status := "🔒 Closed"
+	if shop.Open {
+		status = "🛒 Open"
+	}
+
+	var lines []string
+	for _, info := range gameSession.AllShopCategories {
+		mark := "❌"
+		if shop.Stocks(info.Key) {
+			mark = "✅"
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s", mark, info.Emoji, info.Name))
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🛒 Merchant Stock - %s", session.Name),
+		Description: fmt.Sprintf("Toggle what the merchant sells. The shop is %s.\n\n%s", status, strings.Join(lines, "\n")),
+		Color:       0xe67e22, // Orange
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Session ID: %s", session.ID),
+		},
+	}
+}
+
+func buildStockComponents(session *gameSession.Session) []discordgo.MessageComponent {
+	shop := session.GetShop()
+
+	// Discord allows at most 5 buttons per row
+	var rows []discordgo.MessageComponent
+	var buttons []discordgo.MessageComponent
+	for _, info := range gameSession.AllShopCategories {
+		style := discordgo.SecondaryButton
+		if shop.Stocks(info.Key) {
+			style = discordgo.SuccessButton
+		}
+		buttons = append(buttons, discordgo.Button{
+			Label:    info.Name,
+			Style:    style,
+			CustomID: fmt.Sprintf("shop:stock:%s:%s", session.ID, info.Key),
+			Emoji:    &discordgo.ComponentEmoji{Name: info.Emoji},
+		})
+		if len(buttons) == 5 {
+			rows = append(rows, discordgo.ActionsRow{Components: buttons})
+			buttons = nil
+		}
+	}
+	if len(buttons) > 0 {
+		rows = append(rows, discordgo.ActionsRow{Components: buttons})
+	}
+
+	return rows
+}
+
+// BrowseButton lets anyone at the table open their own shop menu
+func BrowseButton(sessionID string) discordgo.ActionsRow {
+	return discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    "Browse Shop",
+				Style:    discordgo.PrimaryButton,
+				CustomID: fmt.Sprintf("shop:browse:%s", sessionID),
+				Emoji:    &discordgo.ComponentEmoji{Name: "🛒"},
+			},
+		},
+	}
+}
+
+func walletField(char *character.Character) *discordgo.MessageEmbedField {
+	return &discordgo.MessageEmbedField{
+		Name:   fmt.Sprintf("%s's Coins", char.Name),
+		Value:  char.Wallet.String(),
+		Inline: false,
+	}
+}
+
+func deferEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge interaction: %w", err)
+	}
+	return nil
+}
+
+func editResponse(s *discordgo.Session, i *discordgo.InteractionCreate, content string) error {
+	_, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: &content,
+	})
+	return err
+}
+
+func updateMessage(s *discordgo.Session, i *discordgo.InteractionCreate, data *discordgo.InteractionResponseData) error {
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: data,
+	})
+}
+
+func respondEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, content string) error {
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
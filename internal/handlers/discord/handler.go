@@ -3,6 +3,7 @@ package discord
 import (
 	"context"
 	"fmt"
+	charDomain "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
 	combat2 "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
@@ -19,11 +20,14 @@ import (
 	oldcombat "github.com/KirkDiggler/dnd-bot-discord/internal/handlers/discord/dnd/combat"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/handlers/discord/dnd/dungeon"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/handlers/discord/dnd/help"
+	sessionHandler "github.com/KirkDiggler/dnd-bot-discord/internal/handlers/discord/dnd/session"
+	shopHandler "github.com/KirkDiggler/dnd-bot-discord/internal/handlers/discord/dnd/shop"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/handlers/discord/dnd/testcombat"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/handlers/discord/helpers"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services"
 	characterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	itemService "github.com/KirkDiggler/dnd-bot-discord/internal/services/item"
 	"github.com/bwmarrin/discordgo"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -60,6 +64,14 @@ type Handler struct {
 	characterDeleteHandler                *character.DeleteHandler
 	characterClassFeaturesHandler         *character.ClassFeaturesHandler
 	characterFlowHandler                  *character.FlowHandler
+	characterLevelUpHandler               *character.LevelUpHandler
+	characterSpellsHandler                *character.SpellsHandler
+	characterPayHandler                   *character.PayHandler
+	characterExportHandler                *character.ExportHandler
+	characterImportHandler                *character.ImportHandler
+	characterHistoryHandler               *character.HistoryHandler
+	characterAuditHandler                 *character.AuditHandler
+	characterRandomHandler                *character.RandomHandler
 
 	// Test combat handler
 	testCombatHandler *testcombat.TestCombatHandler
@@ -74,6 +86,12 @@ type Handler struct {
 
 	// Admin handlers
 	adminInventoryHandler *admin.InventoryHandler
+	adminCoinsHandler     *admin.CoinsHandler
+
+	// Session handlers
+	sessionRulesHandler *sessionHandler.RulesHandler
+	restHandler         *sessionHandler.RestHandler
+	shopHandler         *shopHandler.ShopHandler
 
 	// Combat handlers
 	savingThrowHandler *oldcombat.SavingThrowHandler
@@ -146,6 +164,14 @@ func NewHandler(cfg *HandlerConfig) *Handler {
 		characterDeleteHandler:        character.NewDeleteHandler(cfg.ServiceProvider),
 		characterClassFeaturesHandler: character.NewClassFeaturesHandler(cfg.ServiceProvider.CharacterService),
 		characterFlowHandler:          character.NewFlowHandler(cfg.ServiceProvider),
+		characterLevelUpHandler:       character.NewLevelUpHandler(cfg.ServiceProvider),
+		characterSpellsHandler:        character.NewSpellsHandler(cfg.ServiceProvider),
+		characterPayHandler:           character.NewPayHandler(cfg.ServiceProvider),
+		characterExportHandler:        character.NewExportHandler(cfg.ServiceProvider),
+		characterImportHandler:        character.NewImportHandler(cfg.ServiceProvider),
+		characterHistoryHandler:       character.NewHistoryHandler(cfg.ServiceProvider),
+		characterAuditHandler:         character.NewAuditHandler(cfg.ServiceProvider),
+		characterRandomHandler:        character.NewRandomHandler(cfg.ServiceProvider),
 
 		// Initialize test combat handler
 		testCombatHandler: testcombat.NewTestCombatHandler(cfg.ServiceProvider),
@@ -160,16 +186,24 @@ func NewHandler(cfg *HandlerConfig) *Handler {
 
 		// Initialize admin handlers
 		adminInventoryHandler: admin.NewInventoryHandler(cfg.ServiceProvider),
+		adminCoinsHandler:     admin.NewCoinsHandler(cfg.ServiceProvider),
+
+		// Initialize session handlers
+		sessionRulesHandler: sessionHandler.NewRulesHandler(cfg.ServiceProvider),
+		restHandler:         sessionHandler.NewRestHandler(cfg.ServiceProvider),
+		shopHandler:         shopHandler.NewShopHandler(cfg.ServiceProvider),
 
 		// Initialize combat handlers
 		savingThrowHandler: oldcombat.NewSavingThrowHandler(&oldcombat.SavingThrowHandlerConfig{
 			CharacterService: cfg.ServiceProvider.CharacterService,
 			EncounterService: cfg.ServiceProvider.EncounterService,
+			SessionService:   cfg.ServiceProvider.SessionService,
 		}),
 		skillCheckHandler: oldcombat.NewSkillCheckHandler(&oldcombat.SkillCheckHandlerConfig{
 			CharacterService: cfg.ServiceProvider.CharacterService,
+			SessionService:   cfg.ServiceProvider.SessionService,
 		}),
-		combatHandler: combat.NewHandler(cfg.ServiceProvider.EncounterService, cfg.ServiceProvider.AbilityService, cfg.ServiceProvider.CharacterService),
+		combatHandler: combat.NewHandler(cfg.ServiceProvider.EncounterService, cfg.ServiceProvider.AbilityService, cfg.ServiceProvider.CharacterService, cfg.ServiceProvider.SpellService, cfg.ServiceProvider.ItemService),
 	}
 }
 
@@ -206,6 +240,10 @@ func (h *Handler) RegisterCommands(s *discordgo.Session, guildID string) error {
 		}
 	}
 
+	// Coin amounts must be at least one
+	minCoins := 1.0
+	minHistoryVersion := 1.0
+
 	commands := []*discordgo.ApplicationCommand{
 		{
 			Name:        "dnd",
@@ -252,6 +290,203 @@ func (h *Handler) RegisterCommands(s *discordgo.Session, guildID string) error {
 							Description: "Delete one of your characters",
 							Type:        discordgo.ApplicationCommandOptionSubCommand,
 						},
+						{
+							Name:        "levelup",
+							Description: "Advance one of your characters to the next level",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
+						{
+							Name:        "pay",
+							Description: "Give coins to another character in your party",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "to",
+									Description: "Name of the character to pay",
+									Required:    true,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Name:        "amount",
+									Description: "Number of coins",
+									Required:    true,
+									MinValue:    &minCoins,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "coin",
+									Description: "Type of coin",
+									Required:    true,
+									Choices: []*discordgo.ApplicationCommandOptionChoice{
+										{Name: "Copper (cp)", Value: "cp"},
+										{Name: "Silver (sp)", Value: "sp"},
+										{Name: "Electrum (ep)", Value: "ep"},
+										{Name: "Gold (gp)", Value: "gp"},
+										{Name: "Platinum (pp)", Value: "pp"},
+									},
+								},
+							},
+						},
+						{
+							Name:        "export",
+							Description: "Download one of your characters as a JSON file",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "name",
+									Description: "Character name",
+									Required:    true,
+								},
+							},
+						},
+						{
+							Name:        "import",
+							Description: "Create a character from an exported JSON file",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionAttachment,
+									Name:        "file",
+									Description: "File made with /dnd character export",
+									Required:    true,
+								},
+							},
+						},
+						{
+							Name:        "history",
+							Description: "See what changed on a character and restore earlier versions",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "name",
+									Description: "Character name (yours, or a player's in a session you DM)",
+									Required:    true,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Name:        "version",
+									Description: "Version to show in full, with a restore button",
+									Required:    false,
+									MinValue:    &minHistoryVersion,
+								},
+							},
+						},
+						{
+							Name:        "audit",
+							Description: "Check a character against the rules and repair what's wrong",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "name",
+									Description: "Character name (yours, or a player's in a session you DM)",
+									Required:    true,
+								},
+							},
+						},
+						{
+							Name:        "random",
+							Description: "Create a fully random character, ready to play",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "name",
+									Description: "Character name (a random one suited to the race if empty)",
+									Required:    false,
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:        "spells",
+					Description: "Manage your character's spells",
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "prepare",
+							Description: "Choose your prepared spells after a long rest",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
+						{
+							Name:        "copy",
+							Description: "Copy a spell into a wizard's spellbook",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "spell",
+									Description: "Spell name (e.g., 'Detect Magic')",
+									Required:    true,
+								},
+							},
+						},
+						{
+							Name:        "ritual",
+							Description: "Cast a ritual spell without using a spell slot",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "spell",
+									Description: "Spell name (e.g., 'Detect Magic')",
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:        "rest",
+					Description: "Rest with your party",
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "short",
+							Description: "Take a short rest and spend hit dice to heal",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
+						{
+							Name:        "long",
+							Description: "Take a long rest to restore HP, spell slots and hit dice",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
+					},
+				},
+				{
+					Name:        "shop",
+					Description: "Buy and sell equipment",
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "browse",
+							Description: "Browse the merchant's stock and buy equipment",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
+						{
+							Name:        "sell",
+							Description: "Sell equipment to the merchant for half price",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
+						{
+							Name:        "open",
+							Description: "Open the merchant for your session (DM only)",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
+						{
+							Name:        "close",
+							Description: "Close the merchant for your session (DM only)",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
+						{
+							Name:        "stock",
+							Description: "Choose what the merchant sells (DM only)",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
 					},
 				},
 				{
@@ -298,6 +533,18 @@ func (h *Handler) RegisterCommands(s *discordgo.Session, guildID string) error {
 						},
 					},
 				},
+				{
+					Name:        "session",
+					Description: "Manage your game session",
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "rules",
+							Description: "View and change house rules and the ability score method (DM only)",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
+					},
+				},
 				{
 					Name:        "admin",
 					Description: "Admin commands for testing",
@@ -353,6 +600,72 @@ func (h *Handler) RegisterCommands(s *discordgo.Session, guildID string) error {
 								},
 							},
 						},
+						{
+							Name:        "givecoins",
+							Description: "Give coins to a character in the session you run (DM only)",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "character",
+									Description: "Character name",
+									Required:    true,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Name:        "amount",
+									Description: "Number of coins",
+									Required:    true,
+									MinValue:    &minCoins,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "coin",
+									Description: "Type of coin",
+									Required:    true,
+									Choices: []*discordgo.ApplicationCommandOptionChoice{
+										{Name: "Copper (cp)", Value: "cp"},
+										{Name: "Silver (sp)", Value: "sp"},
+										{Name: "Electrum (ep)", Value: "ep"},
+										{Name: "Gold (gp)", Value: "gp"},
+										{Name: "Platinum (pp)", Value: "pp"},
+									},
+								},
+							},
+						},
+						{
+							Name:        "takecoins",
+							Description: "Take coins from a character in the session you run (DM only)",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "character",
+									Description: "Character name",
+									Required:    true,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Name:        "amount",
+									Description: "Number of coins",
+									Required:    true,
+									MinValue:    &minCoins,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "coin",
+									Description: "Type of coin",
+									Required:    true,
+									Choices: []*discordgo.ApplicationCommandOptionChoice{
+										{Name: "Copper (cp)", Value: "cp"},
+										{Name: "Silver (sp)", Value: "sp"},
+										{Name: "Electrum (ep)", Value: "ep"},
+										{Name: "Gold (gp)", Value: "gp"},
+										{Name: "Platinum (pp)", Value: "pp"},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -572,6 +885,161 @@ func (h *Handler) handleCommand(s *discordgo.Session, i *discordgo.InteractionCr
 			if err := h.characterDeleteHandler.Handle(req); err != nil {
 				log.Printf("Error handling character delete: %v", err)
 			}
+		case "levelup":
+			req := &character.LevelUpRequest{
+				Session:     s,
+				Interaction: i,
+			}
+			if err := h.characterLevelUpHandler.Handle(req); err != nil {
+				log.Printf("Error handling character level up: %v", err)
+			}
+		case "pay":
+			req := &character.PayRequest{
+				Session:     s,
+				Interaction: i,
+			}
+			for _, opt := range subcommand.Options {
+				switch opt.Name {
+				case "to":
+					req.To = opt.StringValue()
+				case "amount":
+					req.Amount = int(opt.IntValue())
+				case "coin":
+					req.Coin = opt.StringValue()
+				}
+			}
+			if err := h.characterPayHandler.Handle(req); err != nil {
+				log.Printf("Error handling character pay: %v", err)
+			}
+		case "export":
+			req := &character.ExportRequest{
+				Session:     s,
+				Interaction: i,
+			}
+			for _, opt := range subcommand.Options {
+				if opt.Name == "name" {
+					req.Name = opt.StringValue()
+				}
+			}
+			if err := h.characterExportHandler.Handle(req); err != nil {
+				log.Printf("Error handling character export: %v", err)
+			}
+		case "import":
+			req := &character.ImportRequest{
+				Session:     s,
+				Interaction: i,
+			}
+			for _, opt := range subcommand.Options {
+				if attachmentID, ok := opt.Value.(string); ok && opt.Name == "file" {
+					if resolved := i.ApplicationCommandData().Resolved; resolved != nil {
+						req.Attachment = resolved.Attachments[attachmentID]
+					}
+				}
+			}
+			if err := h.characterImportHandler.Handle(req); err != nil {
+				log.Printf("Error handling character import: %v", err)
+			}
+		case "history":
+			req := &character.HistoryRequest{
+				Session:     s,
+				Interaction: i,
+			}
+			for _, opt := range subcommand.Options {
+				switch opt.Name {
+				case "name":
+					req.Name = opt.StringValue()
+				case "version":
+					req.Version = int(opt.IntValue())
+				}
+			}
+			if err := h.characterHistoryHandler.Handle(req); err != nil {
+				log.Printf("Error handling character history: %v", err)
+			}
+		case "audit":
+			req := &character.AuditRequest{
+				Session:     s,
+				Interaction: i,
+			}
+			for _, opt := range subcommand.Options {
+				if opt.Name == "name" {
+					req.Name = opt.StringValue()
+				}
+			}
+			if err := h.characterAuditHandler.Handle(req); err != nil {
+				log.Printf("Error handling character audit: %v", err)
+			}
+		case "random":
+			req := &character.RandomRequest{
+				Session:     s,
+				Interaction: i,
+			}
+			for _, opt := range subcommand.Options {
+				if opt.Name == "name" {
+					req.Name = opt.StringValue()
+				}
+			}
+			if err := h.characterRandomHandler.Handle(req); err != nil {
+				log.Printf("Error handling random character: %v", err)
+			}
+		}
+	} else if subcommandGroup.Name == "spells" && len(subcommandGroup.Options) > 0 {
+		subcommand := subcommandGroup.Options[0]
+
+		req := &character.SpellsRequest{
+			Session:     s,
+			Interaction: i,
+			Action:      subcommand.Name,
+		}
+		for _, opt := range subcommand.Options {
+			if opt.Name == "spell" {
+				req.SpellName = opt.StringValue()
+			}
+		}
+		if err := h.characterSpellsHandler.Handle(req); err != nil {
+			log.Printf("Error handling spells %s: %v", subcommand.Name, err)
+		}
+	} else if subcommandGroup.Name == "rest" && len(subcommandGroup.Options) > 0 {
+		subcommand := subcommandGroup.Options[0]
+
+		req := &sessionHandler.RestRequest{
+			Session:     s,
+			Interaction: i,
+			RestType:    shared.RestType(subcommand.Name),
+		}
+		if err := h.restHandler.Handle(req); err != nil {
+			log.Printf("Error handling %s rest: %v", subcommand.Name, err)
+		}
+	} else if subcommandGroup.Name == "shop" && len(subcommandGroup.Options) > 0 {
+		subcommand := subcommandGroup.Options[0]
+
+		var err error
+		switch subcommand.Name {
+		case "browse":
+			err = h.shopHandler.HandleBrowse(s, i)
+		case "sell":
+			err = h.shopHandler.HandleSell(s, i)
+		case "open":
+			err = h.shopHandler.HandleOpen(s, i, true)
+		case "close":
+			err = h.shopHandler.HandleOpen(s, i, false)
+		case "stock":
+			err = h.shopHandler.HandleStock(s, i)
+		}
+		if err != nil {
+			log.Printf("Error handling shop %s: %v", subcommand.Name, err)
+		}
+	} else if subcommandGroup.Name == "session" && len(subcommandGroup.Options) > 0 {
+		subcommand := subcommandGroup.Options[0]
+
+		switch subcommand.Name {
+		case "rules":
+			req := &sessionHandler.RulesRequest{
+				Session:     s,
+				Interaction: i,
+			}
+			if err := h.sessionRulesHandler.Handle(req); err != nil {
+				log.Printf("Error handling session rules: %v", err)
+			}
 		}
 	} else if subcommandGroup.Name == "admin" && len(subcommandGroup.Options) > 0 {
 		subcommand := subcommandGroup.Options[0]
@@ -618,6 +1086,29 @@ func (h *Handler) handleCommand(s *discordgo.Session, i *discordgo.InteractionCr
 			if err := h.adminInventoryHandler.HandleTake(s, i, characterName, itemKey, quantity); err != nil {
 				log.Printf("Error handling admin take: %v", err)
 			}
+		case "givecoins", "takecoins":
+			var characterName, coin string
+			var amount int64
+			for _, opt := range subcommand.Options {
+				switch opt.Name {
+				case "character":
+					characterName = opt.StringValue()
+				case "amount":
+					amount = opt.IntValue()
+				case "coin":
+					coin = opt.StringValue()
+				}
+			}
+
+			var err error
+			if subcommand.Name == "givecoins" {
+				err = h.adminCoinsHandler.HandleGive(s, i, characterName, amount, coin)
+			} else {
+				err = h.adminCoinsHandler.HandleTake(s, i, characterName, amount, coin)
+			}
+			if err != nil {
+				log.Printf("Error handling admin %s: %v", subcommand.Name, err)
+			}
 		}
 	}
 }
@@ -840,6 +1331,11 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 								log.Printf("Unknown feature type to show: %s", featureType)
 							}
 						}
+					} else if h.characterFlowHandler != nil {
+						// Move to the background, then proficiency choices
+						if err := h.characterFlowHandler.ContinueToProficiencies(s, i, draftChar.ID); err != nil {
+							log.Printf("Error handling confirm abilities: %v", err)
+						}
 					} else {
 						// Move to proficiency choices
 						req := &character.ProficiencyChoicesRequest{
@@ -907,6 +1403,7 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 						)
 						if err != nil {
 							log.Printf("Error updating draft with ability scores: %v", err)
+							respondWithUpdateError(s, i, fmt.Sprintf("Failed to save ability scores: %v", err))
 						} else {
 							log.Printf("Successfully updated draft with ability scores and race/class")
 
@@ -944,6 +1441,11 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 									default:
 										log.Printf("Unknown feature type to show: %s", featureType)
 									}
+								} else if h.characterFlowHandler != nil {
+									// Move to the background, then proficiency choices
+									if profErr := h.characterFlowHandler.ContinueToProficiencies(s, i, updatedChar.ID); profErr != nil {
+										log.Printf("Error handling proficiency choices: %v", profErr)
+									}
 								} else {
 									// Move to proficiency choices
 									req := &character.ProficiencyChoicesRequest{
@@ -1450,7 +1952,14 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 						return
 					}
 
-					// All class features selected, move to proficiencies
+					// All class features selected, move to the background and proficiencies
+					if h.characterFlowHandler != nil {
+						if err := h.characterFlowHandler.ContinueToProficiencies(s, i, characterID); err != nil {
+							log.Printf("Error moving to proficiency choices: %v", err)
+						}
+						return
+					}
+
 					// Get race and class keys from the character
 					if char.Race != nil && char.Class != nil {
 						req := &character.ProficiencyChoicesRequest{
@@ -1555,7 +2064,7 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 				}
 			case "archive":
 				// Archive the character
-				err := h.ServiceProvider.CharacterService.UpdateStatus(characterID, shared.CharacterStatusArchived)
+				err := h.ServiceProvider.CharacterService.UpdateStatus(characterService.WithChange(context.Background(), i.Member.User.ID, "archived"), characterID, shared.CharacterStatusArchived)
 				if err != nil {
 					content := fmt.Sprintf("❌ Failed to archive character: %v", err)
 					err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -1583,7 +2092,7 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 				}
 			case "restore":
 				// Restore archived character to active
-				err := h.ServiceProvider.CharacterService.UpdateStatus(characterID, shared.CharacterStatusActive)
+				err := h.ServiceProvider.CharacterService.UpdateStatus(characterService.WithChange(context.Background(), i.Member.User.ID, "restored from the archive"), characterID, shared.CharacterStatusActive)
 				if err != nil {
 					content := fmt.Sprintf("❌ Failed to restore character: %v", err)
 					err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -1934,7 +2443,7 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 			// Build equipment category select menu
 			embed := &discordgo.MessageEmbed{
 				Title:       fmt.Sprintf("🎒 %s's Equipment", char.Name),
-				Description: "Select a category to view and manage your equipment:",
+				Description: fmt.Sprintf("%s\n\nSelect a category to view and manage your equipment:", character.FormatLoad(char)),
 				Color:       0x3498db,
 			}
 
@@ -1957,6 +2466,18 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 									Value:       "armor",
 									Emoji:       &discordgo.ComponentEmoji{Name: "🛡️"},
 								},
+								{
+									Label:       "Magic Items",
+									Description: "View, equip and attune magic items",
+									Value:       "magic",
+									Emoji:       &discordgo.ComponentEmoji{Name: "✨"},
+								},
+								{
+									Label:       "Consumables",
+									Description: "View and use potions, scrolls and ammunition",
+									Value:       "consumables",
+									Emoji:       &discordgo.ComponentEmoji{Name: "🧪"},
+								},
 								{
 									Label:       "All Items",
 									Description: "View all inventory items",
@@ -2033,6 +2554,24 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 						}
 					}
 				}
+			case "magic":
+				categoryName = "Magic Items"
+				for _, equipList := range char.Inventory {
+					for _, equip := range equipList {
+						if equipment.Magic(equip) != nil {
+							items = append(items, equip)
+						}
+					}
+				}
+			case "consumables":
+				categoryName = "Consumables"
+				for _, equipList := range char.Inventory {
+					for _, equip := range equipList {
+						if equipment.AsConsumable(equip) != nil {
+							items = append(items, equip)
+						}
+					}
+				}
 			case "all":
 				categoryName = "All Equipment"
 				for _, equipList := range char.Inventory {
@@ -2097,6 +2636,20 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 									Emoji:       &discordgo.ComponentEmoji{Name: "🛡️"},
 									Default:     category == "armor",
 								},
+								{
+									Label:       "Magic Items",
+									Description: "View, equip and attune magic items",
+									Value:       "magic",
+									Emoji:       &discordgo.ComponentEmoji{Name: "✨"},
+									Default:     category == "magic",
+								},
+								{
+									Label:       "Consumables",
+									Description: "View and use potions, scrolls and ammunition",
+									Value:       "consumables",
+									Emoji:       &discordgo.ComponentEmoji{Name: "🧪"},
+									Default:     category == "consumables",
+								},
 								{
 									Label:       "All Items",
 									Description: "View all inventory items",
@@ -2149,6 +2702,13 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 						} else {
 							desc = fmt.Sprintf("Type: %s", item.ArmorCategory)
 						}
+					case *equipment.MagicItem:
+						desc = item.Magic.Rarity.Name()
+						if item.Magic.RequiresAttunement {
+							desc += " (requires attunement)"
+						}
+					case *equipment.Consumable:
+						desc = fmt.Sprintf("×%d", item.Quantity)
 					}
 
 					// Check if equipped
@@ -2355,6 +2915,26 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 				)
 			}
 
+			if consumable := character.FormatConsumable(char, selectedItem); consumable != "" {
+				embed.Fields = append(embed.Fields,
+					&discordgo.MessageEmbedField{
+						Name:   "🧪 Consumable",
+						Value:  consumable,
+						Inline: false,
+					},
+				)
+			}
+
+			if magic := character.FormatMagic(char, selectedItem); magic != "" {
+				embed.Fields = append(embed.Fields,
+					&discordgo.MessageEmbedField{
+						Name:   "✨ Magic",
+						Value:  magic,
+						Inline: false,
+					},
+				)
+			}
+
 			// Add equipment status
 			statusValue := "Not equipped"
 			if isEquipped {
@@ -2371,17 +2951,24 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 			// Build action buttons
 			components := []discordgo.MessageComponent{}
 
+			magicButtons := character.AttuneButtons(char, selectedItem)
+			if useButton := character.UseChargeButton(char, selectedItem); useButton != nil {
+				magicButtons = append(magicButtons, *useButton)
+			}
+
 			if isEquipped {
 				// Show unequip button
-				components = append(components, discordgo.ActionsRow{
-					Components: []discordgo.MessageComponent{
-						discordgo.Button{
-							Label:    "Unequip",
-							Style:    discordgo.DangerButton,
-							CustomID: fmt.Sprintf("character:unequip:%s:%s", characterID, itemKey),
-							Emoji:    &discordgo.ComponentEmoji{Name: "❌"},
-						},
+				buttons := []discordgo.MessageComponent{
+					discordgo.Button{
+						Label:    "Unequip",
+						Style:    discordgo.DangerButton,
+						CustomID: fmt.Sprintf("character:unequip:%s:%s", characterID, itemKey),
+						Emoji:    &discordgo.ComponentEmoji{Name: "❌"},
 					},
+				}
+				buttons = append(buttons, magicButtons...)
+				components = append(components, discordgo.ActionsRow{
+					Components: buttons,
 				})
 			} else {
 				// Show equip buttons based on item type
@@ -2428,6 +3015,20 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 						CustomID: fmt.Sprintf("character:equip:%s:%s:body", characterID, itemKey),
 						Emoji:    &discordgo.ComponentEmoji{Name: "🛡️"},
 					})
+				case *equipment.MagicItem:
+					if item.GetSlot() != shared.SlotNone {
+						buttons = append(buttons, discordgo.Button{
+							Label:    "Wear",
+							Style:    discordgo.SuccessButton,
+							CustomID: fmt.Sprintf("character:equip:%s:%s:%s", characterID, itemKey, item.GetSlot()),
+							Emoji:    &discordgo.ComponentEmoji{Name: "💍"},
+						})
+					}
+				}
+
+				buttons = append(buttons, magicButtons...)
+				if useButton := character.UseItemButton(char, selectedItem); useButton != nil {
+					buttons = append(buttons, *useButton)
 				}
 
 				if len(buttons) > 0 {
@@ -2521,6 +3122,12 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 					respondWithUpdateError(s, i, "This item cannot be equipped as armor!")
 					return
 				}
+			case string(shared.SlotNeck), string(shared.SlotCloak), string(shared.SlotRing), string(shared.SlotSecondRing):
+				// Verify it's a magic item worn there
+				if selectedItem.GetSlot() != shared.Slot(slotType) {
+					respondWithUpdateError(s, i, "This item cannot be worn there!")
+					return
+				}
 			default:
 				respondWithUpdateError(s, i, "Invalid equipment slot!")
 				return
@@ -2534,7 +3141,8 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 			}
 
 			// Save the character equipment changes
-			err = h.ServiceProvider.CharacterService.UpdateEquipment(char)
+			ctx := characterService.WithChange(context.Background(), i.Member.User.ID, fmt.Sprintf("equipped %s", selectedItem.GetName()))
+			err = h.ServiceProvider.CharacterService.UpdateEquipment(ctx, char)
 			if err != nil {
 				respondWithUpdateError(s, i, fmt.Sprintf("Failed to save equipment: %v", err))
 				return
@@ -2599,6 +3207,46 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 				log.Printf("Error showing equip success: %v", err)
 			}
 		}
+	} else if ctx == "character" && action == "audit_repair" {
+		if len(parts) >= 3 {
+			req := &character.AuditRepairRequest{
+				Session:     s,
+				Interaction: i,
+				CharacterID: parts[2],
+			}
+			if err := h.characterAuditHandler.HandleRepair(req); err != nil {
+				log.Printf("Error repairing character: %v", err)
+			}
+		}
+	} else if ctx == "character" && action == "history_restore" {
+		if len(parts) >= 4 {
+			version, err := strconv.Atoi(parts[3])
+			if err != nil {
+				respondWithUpdateError(s, i, "Invalid version")
+				return
+			}
+			req := &character.HistoryRestoreRequest{
+				Session:     s,
+				Interaction: i,
+				CharacterID: parts[2],
+				Version:     version,
+			}
+			if err := h.characterHistoryHandler.HandleRestore(req); err != nil {
+				log.Printf("Error restoring character version: %v", err)
+			}
+		}
+	} else if ctx == "character" && action == "use_item" {
+		if len(parts) >= 4 {
+			h.handleUseItem(s, i, parts[2], parts[3])
+		}
+	} else if ctx == "character" && action == "use_charge" {
+		if len(parts) >= 4 {
+			h.handleUseCharge(s, i, parts[2], parts[3])
+		}
+	} else if ctx == "character" && (action == "attune" || action == "unattune") {
+		if len(parts) >= 4 {
+			h.handleAttune(s, i, parts[2], parts[3], action == "attune")
+		}
 	} else if ctx == "character" && action == "unequip" {
 		// Handle unequipping an item
 		if len(parts) >= 4 {
@@ -2634,11 +3282,12 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 				return
 			}
 
-			// Unequip the item by setting the slot to nil
-			char.EquippedSlots[foundSlot] = nil
+			// Unequip the item
+			char.Unequip(foundSlot)
 
 			// Save the character equipment changes
-			err = h.ServiceProvider.CharacterService.UpdateEquipment(char)
+			ctx := characterService.WithChange(context.Background(), i.Member.User.ID, fmt.Sprintf("unequipped %s", foundItem.GetName()))
+			err = h.ServiceProvider.CharacterService.UpdateEquipment(ctx, char)
 			if err != nil {
 				respondWithUpdateError(s, i, fmt.Sprintf("Failed to save equipment: %v", err))
 				return
@@ -3823,24 +4472,8 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 					}
 				}
 
-				// Display any monster attacks that followed
-				for _, monsterAttack := range attackResult.MonsterAttacks {
-					var monsterValue string
-					if monsterAttack.Hit {
-						monsterValue = fmt.Sprintf("%s attacks %s with %s!\n🎲 Attack: %d vs AC %d - **HIT!**\n💥 Damage: **%d**",
-							monsterAttack.AttackerName, monsterAttack.TargetName, monsterAttack.WeaponName,
-							monsterAttack.TotalAttack, monsterAttack.TargetAC, monsterAttack.Damage)
-					} else {
-						monsterValue = fmt.Sprintf("%s attacks %s with %s!\n🎲 Attack: %d vs AC %d - **MISS!**",
-							monsterAttack.AttackerName, monsterAttack.TargetName, monsterAttack.WeaponName,
-							monsterAttack.TotalAttack, monsterAttack.TargetAC)
-					}
-					embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-						Name:   fmt.Sprintf("🐉 %s's Turn", monsterAttack.AttackerName),
-						Value:  monsterValue,
-						Inline: false,
-					})
-				}
+				// Display any monster attacks that followed, grouping identical monsters
+				embed.Fields = append(embed.Fields, combat.BuildMonsterTurnFields(attackResult.MonsterAttacks)...)
 
 				// Check if combat ended
 				if attackResult.CombatEnded && attackResult.PlayersWon {
@@ -4099,6 +4732,44 @@ func (h *Handler) handleComponent(s *discordgo.Session, i *discordgo.Interaction
 				}
 			}
 		}
+	} else if ctx == "level_up" {
+		if err := h.characterLevelUpHandler.HandleComponent(s, i); err != nil {
+			log.Printf("Error handling level up: %v", err)
+		}
+	} else if ctx == "spells" {
+		if err := h.characterSpellsHandler.HandleComponent(s, i); err != nil {
+			log.Printf("Error handling spells: %v", err)
+		}
+	} else if ctx == "rest" {
+		if err := h.restHandler.HandleComponent(s, i); err != nil {
+			log.Printf("Error handling rest: %v", err)
+		}
+	} else if ctx == "shop" {
+		if err := h.shopHandler.HandleComponent(s, i); err != nil {
+			log.Printf("Error handling shop: %v", err)
+		}
+	} else if ctx == "session_rules" {
+		if action == "toggle" && len(parts) >= 4 {
+			req := &sessionHandler.RulesToggleRequest{
+				Session:     s,
+				Interaction: i,
+				SessionID:   parts[2],
+				Rule:        session.HouseRule(parts[3]),
+			}
+			if err := h.sessionRulesHandler.HandleToggle(req); err != nil {
+				log.Printf("Error toggling house rule: %v", err)
+			}
+		} else if action == "ability_method" && len(parts) >= 3 && len(i.MessageComponentData().Values) > 0 {
+			req := &sessionHandler.RulesAbilityMethodRequest{
+				Session:     s,
+				Interaction: i,
+				SessionID:   parts[2],
+				Method:      sessionHandler.ParseAbilityMethod(i.MessageComponentData().Values[0]),
+			}
+			if err := h.sessionRulesHandler.HandleAbilityMethod(req); err != nil {
+				log.Printf("Error setting ability score method: %v", err)
+			}
+		}
 	} else if ctx == "saving_throw" {
 		// Handle saving throw rolls
 		if len(parts) >= 4 {
@@ -4753,6 +5424,193 @@ func respondWithUpdateError(s *discordgo.Session, i *discordgo.InteractionCreate
 	}
 }
 
+// handleAttune attunes a character to a magic item or ends the attunement
+func (h *Handler) handleAttune(s *discordgo.Session, i *discordgo.InteractionCreate, characterID, itemKey string, attune bool) {
+	char, err := h.ServiceProvider.CharacterService.GetByID(characterID)
+	if err != nil {
+		respondWithUpdateError(s, i, fmt.Sprintf("Failed to get character: %v", err))
+		return
+	}
+
+	if char.OwnerID != i.Member.User.ID {
+		respondWithUpdateError(s, i, "You can only manage your own character's inventory!")
+		return
+	}
+
+	var title, reason string
+	if attune {
+		if err := char.Attune(itemKey); err != nil {
+			respondWithUpdateError(s, i, fmt.Sprintf("Can't attune: %v", err))
+			return
+		}
+		title = "✨ Attuned!"
+		reason = fmt.Sprintf("attuned to %s", itemKey)
+	} else {
+		if !char.Unattune(itemKey) {
+			respondWithUpdateError(s, i, "Not attuned to that item!")
+			return
+		}
+		title = "💤 Attunement Ended"
+		reason = fmt.Sprintf("ended attunement to %s", itemKey)
+	}
+
+	ctx := characterService.WithChange(context.Background(), i.Member.User.ID, reason)
+	if err := h.ServiceProvider.CharacterService.UpdateEquipment(ctx, char); err != nil {
+		respondWithUpdateError(s, i, fmt.Sprintf("Failed to save attunement: %v", err))
+		return
+	}
+
+	itemName := itemKey
+	if item := char.GetEquipment(itemKey); item != nil {
+		itemName = item.GetName()
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: fmt.Sprintf("**%s** - **%s**\nAttuned to %d/%d items. AC is now %d.", char.Name, itemName, len(char.Attuned), charDomain.MaxAttunedItems, char.AC),
+		Color:       0x9b59b6,
+	}
+
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "View Inventory",
+					Style:    discordgo.PrimaryButton,
+					CustomID: fmt.Sprintf("character:inventory:%s", characterID),
+					Emoji:    &discordgo.ComponentEmoji{Name: "🎒"},
+				},
+				discordgo.Button{
+					Label:    "Back to Sheet",
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("character:sheet_refresh:%s", characterID),
+					Emoji:    &discordgo.ComponentEmoji{Name: "📋"},
+				},
+			},
+		},
+	}
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	}); err != nil {
+		log.Printf("Error showing attunement: %v", err)
+	}
+}
+
+// handleUseItem drinks a potion or reads a scroll outside of combat
+func (h *Handler) handleUseItem(s *discordgo.Session, i *discordgo.InteractionCreate, characterID, itemKey string) {
+	result, err := h.ServiceProvider.ItemService.UseItem(context.Background(), &itemService.UseItemInput{
+		CharacterID: characterID,
+		UserID:      i.Member.User.ID,
+		ItemKey:     itemKey,
+	})
+	if err != nil {
+		respondWithUpdateError(s, i, fmt.Sprintf("Can't use that item: %v", err))
+		return
+	}
+
+	description := result.Message
+	if result.Item.Effect.Heals() {
+		description += fmt.Sprintf(" (%s: %v)", result.Item.Effect.HealingString(), result.HealingRolls)
+	}
+	description += fmt.Sprintf("\nHP: %d/%d • %d left", result.Character.CurrentHitPoints, result.Character.MaxHitPoints, result.Remaining)
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("%s %s", useItemEmoji(result.Item), result.Item.GetName()),
+		Description: description,
+		Color:       0x1abc9c,
+	}
+
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "View Inventory",
+					Style:    discordgo.PrimaryButton,
+					CustomID: fmt.Sprintf("character:inventory:%s", characterID),
+					Emoji:    &discordgo.ComponentEmoji{Name: "🎒"},
+				},
+				discordgo.Button{
+					Label:    "Back to Sheet",
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("character:sheet_refresh:%s", characterID),
+					Emoji:    &discordgo.ComponentEmoji{Name: "📋"},
+				},
+			},
+		},
+	}
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	}); err != nil {
+		log.Printf("Error showing item use: %v", err)
+	}
+}
+
+// handleUseCharge spends a charge of a wand or other charged item outside of combat
+func (h *Handler) handleUseCharge(s *discordgo.Session, i *discordgo.InteractionCreate, characterID, itemKey string) {
+	result, err := h.ServiceProvider.ItemService.UseCharge(context.Background(), &itemService.UseItemInput{
+		CharacterID: characterID,
+		UserID:      i.Member.User.ID,
+		ItemKey:     itemKey,
+	})
+	if err != nil {
+		respondWithUpdateError(s, i, fmt.Sprintf("Can't use that item: %v", err))
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🪄 %s", result.Item.GetName()),
+		Description: fmt.Sprintf("%s\n%d charges left", result.Message, result.ChargesLeft),
+		Color:       0x9b59b6,
+	}
+
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "View Inventory",
+					Style:    discordgo.PrimaryButton,
+					CustomID: fmt.Sprintf("character:inventory:%s", characterID),
+					Emoji:    &discordgo.ComponentEmoji{Name: "🎒"},
+				},
+				discordgo.Button{
+					Label:    "Back to Sheet",
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("character:sheet_refresh:%s", characterID),
+					Emoji:    &discordgo.ComponentEmoji{Name: "📋"},
+				},
+			},
+		},
+	}
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	}); err != nil {
+		log.Printf("Error showing charge use: %v", err)
+	}
+}
+
+// useItemEmoji returns the emoji for drinking a potion or reading a scroll
+func useItemEmoji(item *equipment.Consumable) string {
+	if item.Kind == equipment.ConsumableScroll {
+		return "📜"
+	}
+	return "🧪"
+}
+
 // getWeaponPropertiesString converts weapon properties to a comma-separated string
 func getWeaponPropertiesString(weapon *equipment.Weapon) string {
 	if len(weapon.Properties) == 0 {
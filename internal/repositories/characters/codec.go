@@ -0,0 +1,233 @@
+package characters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+)
+
+// MarshalCharacter serializes a character to the same JSON format used for storage
+func MarshalCharacter(char *character.Character) ([]byte, error) {
+	data, err := ToCharacterData(char)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(data, "", "  ")
+}
+
+// UnmarshalCharacter parses a character saved in the storage JSON format,
+// migrating it to the current schema first. Race, class and background are
+// left as bare keys; use LoadCharacter for a character to play or save.
+func UnmarshalCharacter(raw []byte) (*character.Character, error) {
+	raw, _, _, err := MigrateRecord(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var data CharacterData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal character: %w", err)
+	}
+
+	return FromCharacterData(&data)
+}
+
+// ToCharacterData converts a character to its serialized form
+func ToCharacterData(char *character.Character) (*CharacterData, error) {
+	// Convert inventory
+	inventory := make(map[equipment.EquipmentType][]EquipmentData)
+	for eqType, items := range char.Inventory {
+		var dataItems []EquipmentData
+		for _, item := range items {
+			data, err := equipmentToData(item)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert inventory item: %w", err)
+			}
+			dataItems = append(dataItems, data)
+		}
+		inventory[eqType] = dataItems
+	}
+
+	// Convert equipped slots
+	equippedSlots := make(map[shared.Slot]EquipmentData)
+	for slot, item := range char.EquippedSlots {
+		// Skip nil items (empty slots)
+		if item == nil {
+			continue
+		}
+		data, err := equipmentToData(item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert equipped item: %w", err)
+		}
+		equippedSlots[slot] = data
+	}
+
+	return &CharacterData{
+		SchemaVersion:      CurrentSchemaVersion,
+		ID:                 char.ID,
+		OwnerID:            char.OwnerID,
+		RealmID:            char.RealmID,
+		Name:               char.Name,
+		Speed:              char.Speed,
+		RaceKey:            raceKey(char.Race),
+		ClassKey:           classKey(char.Class),
+		ClassLevels:        classLevelsData(char),
+		BackgroundKey:      backgroundKey(char.Background),
+		Attributes:         char.Attributes,
+		AbilityRolls:       char.AbilityRolls,
+		AbilityAssignments: char.AbilityAssignments,
+		Proficiencies:      char.Proficiencies,
+		HitDie:             char.HitDie,
+		AC:                 char.AC,
+		MaxHitPoints:       char.MaxHitPoints,
+		CurrentHitPoints:   char.CurrentHitPoints,
+		Level:              char.Level,
+		Experience:         char.Experience,
+		Status:             char.Status,
+		Features:           char.Features,
+		Inventory:          inventory,
+		EquippedSlots:      equippedSlots,
+		Attuned:            char.Attuned,
+		Wallet:             char.Wallet,
+		Personality:        char.Personality,
+		Resources:          char.Resources,
+		Spells:             char.Spells,
+		LevelUp:            char.LevelUp,
+	}, nil
+}
+
+// FromCharacterData converts a serialized character back to an entity
+func FromCharacterData(data *CharacterData) (*character.Character, error) {
+	// Convert inventory back
+	inventory := make(map[equipment.EquipmentType][]equipment.Equipment)
+	for eqType, items := range data.Inventory {
+		var eqItems []equipment.Equipment
+		for _, item := range items {
+			eq, err := dataToEquipment(item)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert inventory data: %w", err)
+			}
+			eqItems = append(eqItems, eq)
+		}
+		inventory[eqType] = eqItems
+	}
+
+	// Convert equipped slots back
+	equippedSlots := make(map[shared.Slot]equipment.Equipment)
+	for slot, item := range data.EquippedSlots {
+		eq, err := dataToEquipment(item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert equipped data: %w", err)
+		}
+		equippedSlots[slot] = eq
+	}
+
+	class := classRef(data.ClassKey)
+	return &character.Character{
+		ID:                 data.ID,
+		OwnerID:            data.OwnerID,
+		RealmID:            data.RealmID,
+		Name:               data.Name,
+		Speed:              data.Speed,
+		Race:               raceRef(data.RaceKey),
+		Class:              class,
+		Classes:            classLevels(data.ClassLevels, class),
+		Background:         backgroundRef(data.BackgroundKey),
+		Attributes:         data.Attributes,
+		AbilityRolls:       data.AbilityRolls,
+		AbilityAssignments: data.AbilityAssignments,
+		Proficiencies:      data.Proficiencies,
+		HitDie:             data.HitDie,
+		AC:                 data.AC,
+		MaxHitPoints:       data.MaxHitPoints,
+		CurrentHitPoints:   data.CurrentHitPoints,
+		Level:              data.Level,
+		Experience:         data.Experience,
+		Status:             data.Status,
+		Features:           data.Features,
+		Inventory:          inventory,
+		EquippedSlots:      equippedSlots,
+		Attuned:            data.Attuned,
+		Wallet:             data.Wallet,
+		Personality:        data.Personality,
+		Resources:          data.Resources,
+		Spells:             data.Spells,
+		LevelUp:            data.LevelUp,
+	}, nil
+}
+
+// Races, classes and backgrounds are stored by key. They load as references
+// holding only the key until Rehydrate fills in the rulebook data.
+
+func raceKey(race *rulebook.Race) string {
+	if race == nil {
+		return ""
+	}
+	return race.Key
+}
+
+func classKey(class *rulebook.Class) string {
+	if class == nil {
+		return ""
+	}
+	return class.Key
+}
+
+func backgroundKey(background *rulebook.Background) string {
+	if background == nil {
+		return ""
+	}
+	return background.ID
+}
+
+// Class levels are only stored for multiclass characters; everyone else is
+// their class at their character level
+func classLevelsData(char *character.Character) []ClassLevelData {
+	if !char.IsMulticlass() {
+		return nil
+	}
+	var levels []ClassLevelData
+	for _, classLevel := range char.ClassLevels() {
+		levels = append(levels, ClassLevelData{ClassKey: classLevel.Class.Key, Level: classLevel.Level})
+	}
+	return levels
+}
+
+// classLevels loads stored class levels, sharing the starting class reference
+func classLevels(levels []ClassLevelData, startingClass *rulebook.Class) []*character.ClassLevel {
+	var classes []*character.ClassLevel
+	for _, level := range levels {
+		class := classRef(level.ClassKey)
+		if startingClass != nil && startingClass.Key == level.ClassKey {
+			class = startingClass
+		}
+		classes = append(classes, &character.ClassLevel{Class: class, Level: level.Level})
+	}
+	return classes
+}
+
+func raceRef(key string) *rulebook.Race {
+	if key == "" {
+		return nil
+	}
+	return &rulebook.Race{Key: key}
+}
+
+func classRef(key string) *rulebook.Class {
+	if key == "" {
+		return nil
+	}
+	return &rulebook.Class{Key: key}
+}
+
+func backgroundRef(key string) *rulebook.Background {
+	if key == "" {
+		return nil
+	}
+	return &rulebook.Background{ID: key}
+}
@@ -0,0 +1,143 @@
+package characters
+
+import (
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/damage"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/effects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalCharacter_RoundTrip(t *testing.T) {
+	sword := &equipment.Weapon{
+		Base:           equipment.BasicEquipment{Key: "longsword", Name: "Longsword"},
+		Damage:         &damage.Damage{DiceCount: 1, DiceSize: 8, DamageType: damage.TypeSlashing},
+		WeaponCategory: "Martial",
+		WeaponRange:    "Melee",
+	}
+
+	char := &character.Character{
+		ID:               "char-1",
+		OwnerID:          "user-1",
+		Name:             "Grunk",
+		Level:            3,
+		AC:               16,
+		MaxHitPoints:     28,
+		CurrentHitPoints: 20,
+		Class:            &rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10},
+		Attributes: map[shared.Attribute]*character.AbilityScore{
+			shared.AttributeStrength: {Score: 16, Bonus: 3},
+		},
+		Inventory: map[equipment.EquipmentType][]equipment.Equipment{
+			equipment.EquipmentTypeWeapon: {sword},
+		},
+		EquippedSlots: map[shared.Slot]equipment.Equipment{
+			shared.SlotMainHand: sword,
+		},
+		Wallet: shared.Wallet{Gold: 15, Silver: 4},
+	}
+
+	raw, err := MarshalCharacter(char)
+	require.NoError(t, err)
+
+	loaded, err := UnmarshalCharacter(raw)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Grunk", loaded.Name)
+	assert.Equal(t, 3, loaded.Level)
+	assert.Equal(t, 20, loaded.CurrentHitPoints)
+	assert.Equal(t, "fighter", loaded.Class.Key)
+	assert.Equal(t, 16, loaded.Attributes[shared.AttributeStrength].Score)
+	assert.Equal(t, shared.Wallet{Gold: 15, Silver: 4}, loaded.Wallet)
+
+	weapon, ok := loaded.EquippedSlots[shared.SlotMainHand].(*equipment.Weapon)
+	require.True(t, ok, "equipped weapon should keep its concrete type")
+	assert.Equal(t, 8, weapon.Damage.DiceSize)
+	require.Len(t, loaded.Inventory[equipment.EquipmentTypeWeapon], 1)
+}
+
+func TestMarshalCharacter_MagicItems(t *testing.T) {
+	cloak := equipment.NewSRDMagicItem("cloak-of-protection")
+	char := &character.Character{
+		ID: "char-1",
+		Inventory: map[equipment.EquipmentType][]equipment.Equipment{
+			equipment.EquipmentTypeMagicItem: {cloak},
+		},
+		EquippedSlots: map[shared.Slot]equipment.Equipment{
+			shared.SlotCloak: cloak,
+		},
+		Attuned: []string{"cloak-of-protection"},
+	}
+
+	raw, err := MarshalCharacter(char)
+	require.NoError(t, err)
+
+	loaded, err := UnmarshalCharacter(raw)
+	require.NoError(t, err)
+
+	item, ok := loaded.EquippedSlots[shared.SlotCloak].(*equipment.MagicItem)
+	require.True(t, ok, "equipped magic item should keep its concrete type")
+	assert.Equal(t, equipment.RarityUncommon, item.Magic.Rarity)
+	assert.Len(t, item.Magic.Bonuses, 2)
+	assert.Equal(t, []string{"cloak-of-protection"}, loaded.Attuned)
+	assert.Equal(t, 1, loaded.EffectBonus(effects.TargetAC, nil), "item effects are rebuilt on load")
+}
+
+func TestMarshalCharacter_Consumables(t *testing.T) {
+	char := &character.Character{ID: "char-1"}
+	char.AddInventory(equipment.NewSRDConsumable("potion-of-healing", 2))
+
+	raw, err := MarshalCharacter(char)
+	require.NoError(t, err)
+
+	loaded, err := UnmarshalCharacter(raw)
+	require.NoError(t, err)
+
+	require.Len(t, loaded.Inventory[equipment.EquipmentTypeConsumable], 1)
+	potion, ok := loaded.Inventory[equipment.EquipmentTypeConsumable][0].(*equipment.Consumable)
+	require.True(t, ok, "consumables should keep their concrete type")
+	assert.Equal(t, 2, potion.Quantity)
+	assert.Equal(t, "2d4+2", potion.Effect.HealingString())
+}
+
+func TestUnmarshalCharacter_InvalidJSON(t *testing.T) {
+	_, err := UnmarshalCharacter([]byte("{not json"))
+	assert.Error(t, err)
+}
+
+func TestMarshalCharacter_Multiclass(t *testing.T) {
+	fighter := &rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10}
+	char := &character.Character{
+		ID:    "char-1",
+		Level: 5,
+		Class: fighter,
+		Classes: []*character.ClassLevel{
+			{Class: fighter, Level: 3},
+			{Class: &rulebook.Class{Key: "wizard", Name: "Wizard", HitDie: 6}, Level: 2},
+		},
+	}
+	char.InitializeResources()
+
+	raw, err := MarshalCharacter(char)
+	require.NoError(t, err)
+
+	loaded, err := UnmarshalCharacter(raw)
+	require.NoError(t, err)
+
+	require.Len(t, loaded.Classes, 2)
+	assert.Same(t, loaded.Class, loaded.Classes[0].Class, "the starting class is shared")
+	assert.Equal(t, 3, loaded.LevelInClass("fighter"))
+	assert.Equal(t, 2, loaded.LevelInClass("wizard"))
+	assert.Equal(t, char.Resources.HitDice, loaded.Resources.HitDice)
+}
+
+func TestMarshalCharacter_SingleClassStoresNoClassLevels(t *testing.T) {
+	data, err := ToCharacterData(&character.Character{Class: &rulebook.Class{Key: "rogue"}, Level: 4})
+	require.NoError(t, err)
+	assert.Nil(t, data.ClassLevels)
+}
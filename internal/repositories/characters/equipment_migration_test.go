@@ -14,7 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestDataToEquipmentWithMigration(t *testing.T) {
+func TestDataToEquipment(t *testing.T) {
 	// Create test weapon data
 	weaponJSON, err := json.Marshal(&equipment.Weapon{
 		Base: equipment.BasicEquipment{
@@ -53,20 +53,6 @@ func TestDataToEquipmentWithMigration(t *testing.T) {
 				assert.Equal(t, "martial", weapon.WeaponCategory)
 			},
 		},
-		{
-			name: "weapon with empty type (legacy)",
-			data: EquipmentData{
-				Type:      "",
-				Equipment: weaponJSON,
-			},
-			expectedType: "*equipment.Weapon",
-			validate: func(t *testing.T, eq equipment.Equipment) {
-				weapon, ok := eq.(*equipment.Weapon)
-				require.True(t, ok)
-				assert.Equal(t, "greataxe", weapon.GetKey())
-				assert.Equal(t, "martial", weapon.WeaponCategory)
-			},
-		},
 		{
 			name: "weapon with unknown type",
 			data: EquipmentData{
@@ -97,7 +83,7 @@ func TestDataToEquipmentWithMigration(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			eq, err := DataToEquipmentWithMigration(tt.data)
+			eq, err := dataToEquipment(tt.data)
 			require.NoError(t, err)
 			require.NotNil(t, eq)
 
@@ -0,0 +1,72 @@
+package characters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+)
+
+// MaxHistory is how many versions of a character are kept
+const MaxHistory = 50
+
+// HistoryEntry is one saved version of a character
+type HistoryEntry struct {
+	Version  int             `json:"-"` // Numbered from 1 when the character was created or finalized
+	SavedAt  time.Time       `json:"saved_at"`
+	Actor    string          `json:"actor,omitempty"` // User who made the change; empty for automatic saves
+	Reason   string          `json:"reason,omitempty"`
+	Snapshot json.RawMessage `json:"snapshot"` // The character in the storage format
+}
+
+// Character decodes the snapshot, migrating it to the current schema and
+// loading its race, class and background from source
+func (e *HistoryEntry) Character(ctx context.Context, source RulebookSource) (*character.Character, error) {
+	return LoadCharacter(ctx, e.Snapshot, source)
+}
+
+type changeKey struct{}
+
+// change is who saved a character and why
+type change struct {
+	actor  string
+	reason string
+}
+
+// WithChange records who is saving a character and why in ctx, for the
+// character's history. A reason already in ctx is kept, so callers closer
+// to the user win over generic reasons added further down.
+func WithChange(ctx context.Context, actor, reason string) context.Context {
+	existing, _ := ctx.Value(changeKey{}).(change)
+	if existing.actor == "" {
+		existing.actor = actor
+	}
+	if existing.reason == "" {
+		existing.reason = reason
+	}
+	return context.WithValue(ctx, changeKey{}, existing)
+}
+
+// tracksHistory reports whether saves of the character are kept in its
+// history; drafts change on every creation step and aren't
+func tracksHistory(char *character.Character) bool {
+	return char.Status != shared.CharacterStatusDraft
+}
+
+// newHistoryEntry serializes a history entry for data saved with ctx
+func newHistoryEntry(ctx context.Context, snapshot []byte) ([]byte, error) {
+	info, _ := ctx.Value(changeKey{}).(change)
+	entry, err := json.Marshal(&HistoryEntry{
+		SavedAt:  time.Now().UTC(),
+		Actor:    info.actor,
+		Reason:   info.reason,
+		Snapshot: snapshot,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal history entry: %w", err)
+	}
+	return entry, nil
+}
@@ -2,6 +2,7 @@ package characters
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
 
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
@@ -14,12 +15,16 @@ import (
 type InMemoryRepository struct {
 	mu         sync.RWMutex
 	characters map[string]*character.Character
+	history    map[string][]*HistoryEntry // Newest first
+	versions   map[string]int
 }
 
 // NewInMemoryRepository creates a new in-memory repository
 func NewInMemoryRepository() Repository {
 	return &InMemoryRepository{
 		characters: make(map[string]*character.Character),
+		history:    make(map[string][]*HistoryEntry),
+		versions:   make(map[string]int),
 	}
 }
 
@@ -44,7 +49,7 @@ func (r *InMemoryRepository) Create(ctx context.Context, char *character.Charact
 	// Create a copy to avoid external modifications
 	r.characters[char.ID] = char.Clone()
 
-	return nil
+	return r.pushHistory(ctx, char)
 }
 
 // Get retrieves a character by ID
@@ -131,7 +136,7 @@ func (r *InMemoryRepository) Update(ctx context.Context, char *character.Charact
 	// Create a copy to avoid external modifications
 	r.characters[char.ID] = char.Clone()
 
-	return nil
+	return r.pushHistory(ctx, char)
 }
 
 // Delete removes a character
@@ -149,5 +154,45 @@ func (r *InMemoryRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	delete(r.characters, id)
+	delete(r.history, id)
+	delete(r.versions, id)
+	return nil
+}
+
+// GetHistory returns a character's saved versions, newest first
+func (r *InMemoryRepository) GetHistory(ctx context.Context, id string) ([]*HistoryEntry, error) {
+	if id == "" {
+		return nil, dnderr.InvalidArgument("character ID is required")
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]*HistoryEntry(nil), r.history[id]...), nil
+}
+
+// pushHistory adds a new version of a character to its history; callers hold the lock
+func (r *InMemoryRepository) pushHistory(ctx context.Context, char *character.Character) error {
+	if !tracksHistory(char) {
+		return nil
+	}
+
+	snapshot, err := MarshalCharacter(char)
+	if err != nil {
+		return err
+	}
+	raw, err := newHistoryEntry(ctx, snapshot)
+	if err != nil {
+		return err
+	}
+	var entry HistoryEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return err
+	}
+
+	r.versions[char.ID]++
+	entry.Version = r.versions[char.ID]
+	entries := append([]*HistoryEntry{&entry}, r.history[char.ID]...)
+	r.history[char.ID] = entries[:min(len(entries), MaxHistory)]
 	return nil
 }
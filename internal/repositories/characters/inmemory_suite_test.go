@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	"sync"
 	"testing"
 
 	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	mockrulebook "github.com/KirkDiggler/dnd-bot-discord/internal/services/rulebook/mock"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
 )
 
 // InMemoryRepositoryTestSuite defines the test suite for in-memory repository
@@ -331,6 +334,56 @@ func (s *InMemoryRepositoryTestSuite) TestDelete_NotFound() {
 
 // Concurrency Tests
 
+// History Tests
+
+func (s *InMemoryRepositoryTestSuite) TestGetHistory() {
+	char := &character.Character{
+		ID:      "char_123",
+		OwnerID: "user_456",
+		RealmID: "realm_789",
+		Name:    "Thorin",
+		Status:  shared.CharacterStatusActive,
+		AC:      14,
+	}
+	s.Require().NoError(s.repo.Create(s.ctx, char))
+
+	char.AC = 16
+	ctx := characters.WithChange(s.ctx, "dm_1", "gave shield")
+	s.Require().NoError(s.repo.Update(ctx, char))
+
+	history, err := s.repo.GetHistory(s.ctx, "char_123")
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Equal(2, history[0].Version)
+	s.Equal("dm_1", history[0].Actor)
+	s.Equal("gave shield", history[0].Reason)
+	s.Equal(1, history[1].Version)
+
+	restored, err := history[1].Character(s.ctx, mockrulebook.NewMockService(gomock.NewController(s.T())))
+	s.Require().NoError(err)
+	s.Equal(14, restored.AC)
+}
+
+func (s *InMemoryRepositoryTestSuite) TestGetHistory_SkipsDraftsAndIsCapped() {
+	char := &character.Character{ID: "char_123", OwnerID: "user_456", RealmID: "realm_789", Status: shared.CharacterStatusDraft}
+	s.Require().NoError(s.repo.Create(s.ctx, char))
+	s.Require().NoError(s.repo.Update(s.ctx, char))
+
+	history, err := s.repo.GetHistory(s.ctx, "char_123")
+	s.Require().NoError(err)
+	s.Empty(history)
+
+	char.Status = shared.CharacterStatusActive
+	for range characters.MaxHistory + 5 {
+		s.Require().NoError(s.repo.Update(s.ctx, char))
+	}
+
+	history, err = s.repo.GetHistory(s.ctx, "char_123")
+	s.Require().NoError(err)
+	s.Len(history, characters.MaxHistory)
+	s.Equal(characters.MaxHistory+5, history[0].Version)
+}
+
 func (s *InMemoryRepositoryTestSuite) TestConcurrentCreates() {
 	// Setup
 	var wg sync.WaitGroup
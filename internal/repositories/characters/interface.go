@@ -28,6 +28,10 @@ type Repository interface {
 
 	// Delete removes a character
 	Delete(ctx context.Context, id string) error
+
+	// GetHistory returns a character's saved versions, newest first.
+	// Every save of a finalized character adds a version; see WithChange.
+	GetHistory(ctx context.Context, id string) ([]*HistoryEntry, error)
 }
 
 // Deprecated: Use dnderr.NotFound instead
@@ -0,0 +1,450 @@
+package characters
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+)
+
+// Migration upgrades a stored character record by one schema version.
+// Migrations work on the raw JSON so they can read fields the current
+// CharacterData no longer has.
+type Migration struct {
+	Version     int    // Schema version the record is at after migrating
+	Description string // What the migration changes, shown in reports
+	Migrate     func(record map[string]any) error
+}
+
+// migrations is the ordered registry of schema migrations. Append new
+// migrations with the next version; never reorder or remove one.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "normalize equipment type tags",
+		Migrate:     normalizeEquipmentTypes,
+	},
+	{
+		Version:     2,
+		Description: "derive attributes from ability score assignments",
+		Migrate:     deriveAttributes,
+	},
+	{
+		Version:     3,
+		Description: "apply monk unarmored defense to AC",
+		Migrate:     monkUnarmoredDefense,
+	},
+	{
+		Version:     4,
+		Description: "store race, class and background by key",
+		Migrate:     rulebookKeys,
+	},
+	{
+		Version:     5,
+		Description: "store hit dice as pools by die size",
+		Migrate:     hitDicePools,
+	},
+	{
+		Version:     6,
+		Description: "add missing class and racial proficiencies",
+		Migrate:     startingProficiencies,
+	},
+}
+
+// CurrentSchemaVersion is the schema version new records are saved at
+var CurrentSchemaVersion = migrations[len(migrations)-1].Version
+
+// Migrations returns the registered migrations in order
+func Migrations() []Migration {
+	return append([]Migration(nil), migrations...)
+}
+
+// MigrateRecord upgrades a stored character record to the current schema.
+// It returns the migrated JSON, the record's original version and the
+// migrations applied; raw is returned as is when it's already current.
+func MigrateRecord(raw []byte) ([]byte, int, []Migration, error) {
+	var record map[string]any
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return nil, 0, nil, fmt.Errorf("failed to unmarshal character record: %w", err)
+	}
+
+	from := 0
+	if version, ok := record["schema_version"].(float64); ok {
+		from = int(version)
+	}
+	if from > CurrentSchemaVersion {
+		return nil, from, nil, fmt.Errorf("character record has schema version %d, newer than %d", from, CurrentSchemaVersion)
+	}
+	if from == CurrentSchemaVersion {
+		return raw, from, nil, nil
+	}
+
+	var applied []Migration
+	for _, migration := range migrations {
+		if migration.Version <= from {
+			continue
+		}
+		if err := migration.Migrate(record); err != nil {
+			return nil, from, applied, fmt.Errorf("migration %d (%s) failed: %w", migration.Version, migration.Description, err)
+		}
+		record["schema_version"] = migration.Version
+		applied = append(applied, migration)
+	}
+
+	migrated, err := json.Marshal(record)
+	if err != nil {
+		return nil, from, applied, fmt.Errorf("failed to marshal migrated record: %w", err)
+	}
+	return migrated, from, applied, nil
+}
+
+// decodeField reads a field of a record into target. Missing and null
+// fields leave target untouched.
+func decodeField(record map[string]any, field string, target any) error {
+	value, ok := record[field]
+	if !ok || value == nil {
+		return nil
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return fmt.Errorf("failed to read %s: %w", field, err)
+	}
+	return nil
+}
+
+// encodeField writes value into a field of a record
+func encodeField(record map[string]any, field string, value any) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var decoded any
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return err
+	}
+	record[field] = decoded
+	return nil
+}
+
+// normalizeEquipmentTypes lowercases the type tag of every stored item and
+// fills in missing tags from the item's fields, as old records used Go type
+// names or left the tag out
+func normalizeEquipmentTypes(record map[string]any) error {
+	normalize := func(item any) {
+		data, ok := item.(map[string]any)
+		if !ok {
+			return
+		}
+		tag, _ := data["type"].(string)
+		tag = strings.ToLower(tag)
+		if tag == "basicequipment" {
+			tag = "basic"
+		}
+		if tag == "" || tag == "unknown" {
+			fields, _ := data["equipment"].(map[string]any)
+			switch {
+			case fields["weapon_category"] != nil:
+				tag = "weapon"
+			case fields["armor_category"] != nil:
+				tag = "armor"
+			default:
+				tag = "basic"
+			}
+		}
+		data["type"] = tag
+	}
+
+	if inventory, ok := record["inventory"].(map[string]any); ok {
+		for _, items := range inventory {
+			list, _ := items.([]any)
+			for _, item := range list {
+				normalize(item)
+			}
+		}
+	}
+	if slots, ok := record["equipped_slots"].(map[string]any); ok {
+		for _, item := range slots {
+			normalize(item)
+		}
+	}
+	return nil
+}
+
+// assignmentAttributes maps the ability names used in assignments to attributes
+var assignmentAttributes = map[string]shared.Attribute{
+	"STR": shared.AttributeStrength,
+	"DEX": shared.AttributeDexterity,
+	"CON": shared.AttributeConstitution,
+	"INT": shared.AttributeIntelligence,
+	"WIS": shared.AttributeWisdom,
+	"CHA": shared.AttributeCharisma,
+}
+
+// deriveAttributes fills in the attributes of characters that were finalized
+// with ability score assignments but no attributes, along with the hit
+// points and unarmored AC that depend on them
+func deriveAttributes(record map[string]any) error {
+	var attributes map[shared.Attribute]*character.AbilityScore
+	var rolls []character.AbilityRoll
+	var assignments map[string]string
+	var race *rulebook.Race
+	var class *rulebook.Class
+	for field, target := range map[string]any{
+		"attributes":          &attributes,
+		"ability_rolls":       &rolls,
+		"ability_assignments": &assignments,
+		"race":                &race,
+		"class":               &class,
+	} {
+		if err := decodeField(record, field, target); err != nil {
+			return err
+		}
+	}
+	if len(attributes) > 0 || len(assignments) == 0 || len(rolls) == 0 {
+		return nil
+	}
+
+	rollValues := make(map[string]int, len(rolls))
+	for _, roll := range rolls {
+		rollValues[roll.ID] = roll.Value
+	}
+
+	attributes = make(map[shared.Attribute]*character.AbilityScore)
+	for ability, rollID := range assignments {
+		attr, known := assignmentAttributes[ability]
+		value, rolled := rollValues[rollID]
+		if !known || !rolled {
+			continue
+		}
+		if race != nil {
+			for _, bonus := range race.AbilityBonuses {
+				if bonus != nil && bonus.Attribute == attr {
+					value += bonus.Bonus
+				}
+			}
+		}
+		attributes[attr] = &character.AbilityScore{Score: value, Bonus: (value - 10) / 2}
+	}
+	if err := encodeField(record, "attributes", attributes); err != nil {
+		return err
+	}
+
+	if maxHP, _ := record["max_hit_points"].(float64); maxHP == 0 && class != nil {
+		hp := class.HitDie
+		if con := attributes[shared.AttributeConstitution]; con != nil {
+			hp += con.Bonus
+		}
+		record["max_hit_points"] = hp
+		record["current_hit_points"] = hp
+	}
+	if ac, _ := record["ac"].(float64); ac == 0 {
+		unarmored := 10
+		if dex := attributes[shared.AttributeDexterity]; dex != nil {
+			unarmored += dex.Bonus
+		}
+		record["ac"] = unarmored
+	}
+	return nil
+}
+
+// monkUnarmoredDefense raises the AC of unarmored monks saved before
+// Unarmored Defense was counted to 10 + DEX + WIS
+func monkUnarmoredDefense(record map[string]any) error {
+	var class *rulebook.Class
+	var attributes map[shared.Attribute]*character.AbilityScore
+	var slots map[shared.Slot]EquipmentData
+	if err := decodeField(record, "class", &class); err != nil {
+		return err
+	}
+	if class == nil || class.Key != "monk" {
+		return nil
+	}
+	if err := decodeField(record, "attributes", &attributes); err != nil {
+		return err
+	}
+	if err := decodeField(record, "equipped_slots", &slots); err != nil {
+		return err
+	}
+
+	// Armor and shields both turn Unarmored Defense off
+	for _, item := range slots {
+		if strings.EqualFold(item.Type, "armor") {
+			return nil
+		}
+	}
+
+	ac := 10
+	for _, attr := range []shared.Attribute{shared.AttributeDexterity, shared.AttributeWisdom} {
+		if score := attributes[attr]; score != nil {
+			ac += score.Bonus
+		}
+	}
+	if current, _ := record["ac"].(float64); int(current) < ac {
+		record["ac"] = ac
+	}
+	return nil
+}
+
+// rulebookKeys replaces the race, class and background snapshots with their
+// keys so characters pick up fixes to the rulebook data
+func rulebookKeys(record map[string]any) error {
+	for field, keyField := range map[string]string{"race": "key", "class": "key", "background": "id"} {
+		snapshot, _ := record[field].(map[string]any)
+		if key, _ := snapshot[keyField].(string); key != "" {
+			record[field+"_key"] = key
+		}
+		delete(record, field)
+	}
+	return nil
+}
+
+// hitDicePools turns the single hit dice resource into a list of pools, one
+// per die size, so multiclass characters can track each size separately
+func hitDicePools(record map[string]any) error {
+	resources, ok := record["resources"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	pool, ok := resources["hit_dice"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	if size, _ := pool["dice_type"].(float64); size == 0 {
+		resources["hit_dice"] = []any{}
+		return nil
+	}
+	resources["hit_dice"] = []any{pool}
+	return nil
+}
+
+// startingProficiencies adds the class and racial proficiencies of active
+// characters created before they were granted. Those characters are the
+// ones with no weapon proficiencies, which every class has.
+func startingProficiencies(record map[string]any) error {
+	if status, _ := record["status"].(string); status != string(shared.CharacterStatusActive) {
+		return nil
+	}
+
+	var proficiencies map[rulebook.ProficiencyType][]*rulebook.Proficiency
+	if err := decodeField(record, "proficiencies", &proficiencies); err != nil {
+		return err
+	}
+	if len(proficiencies[rulebook.ProficiencyTypeWeapon]) > 0 {
+		return nil
+	}
+
+	classKey, _ := record["class_key"].(string)
+	raceKey, _ := record["race_key"].(string)
+	granted := slices.Concat(classProficiencies[classKey], racialProficiencies[raceKey])
+	if len(granted) == 0 {
+		return nil
+	}
+
+	char := &character.Character{Proficiencies: proficiencies}
+	for _, prof := range granted {
+		char.AddProficiency(prof)
+	}
+	return encodeField(record, "proficiencies", char.Proficiencies)
+}
+
+func armorProficiency(key, name string) *rulebook.Proficiency {
+	return &rulebook.Proficiency{Key: key, Name: name, Type: rulebook.ProficiencyTypeArmor}
+}
+
+func weaponProficiencies(names ...string) []*rulebook.Proficiency {
+	profs := make([]*rulebook.Proficiency, 0, len(names))
+	for _, name := range names {
+		profs = append(profs, &rulebook.Proficiency{
+			Key:  strings.ReplaceAll(strings.ToLower(name), " ", "-"),
+			Name: name,
+			Type: rulebook.ProficiencyTypeWeapon,
+		})
+	}
+	return profs
+}
+
+func savingThrows(attrs ...shared.Attribute) []*rulebook.Proficiency {
+	profs := make([]*rulebook.Proficiency, 0, len(attrs))
+	for _, attr := range attrs {
+		profs = append(profs, &rulebook.Proficiency{
+			Key:  "saving-throw-" + strings.ToLower(attr.Short()),
+			Name: "Saving Throw: " + strings.ToUpper(attr.Short()),
+			Type: rulebook.ProficiencyTypeSavingThrow,
+		})
+	}
+	return profs
+}
+
+var (
+	lightArmorProficiency  = armorProficiency("light-armor", "Light Armor")
+	mediumArmorProficiency = armorProficiency("medium-armor", "Medium Armor")
+	allArmorProficiency    = armorProficiency("all-armor", "All armor")
+	shieldProficiency      = armorProficiency("shields", "Shields")
+)
+
+// classProficiencies are the PHB armor, weapon, tool and saving throw
+// proficiencies every member of a class starts with. Skills are chosen, so
+// they can't be restored.
+var classProficiencies = map[string][]*rulebook.Proficiency{
+	"barbarian": slices.Concat(
+		[]*rulebook.Proficiency{lightArmorProficiency, mediumArmorProficiency, shieldProficiency},
+		weaponProficiencies("Simple Weapons", "Martial Weapons"),
+		savingThrows(shared.AttributeStrength, shared.AttributeConstitution)),
+	"bard": slices.Concat(
+		[]*rulebook.Proficiency{lightArmorProficiency},
+		weaponProficiencies("Simple Weapons", "Hand Crossbows", "Longswords", "Rapiers", "Shortswords"),
+		savingThrows(shared.AttributeDexterity, shared.AttributeCharisma)),
+	"cleric": slices.Concat(
+		[]*rulebook.Proficiency{lightArmorProficiency, mediumArmorProficiency, shieldProficiency},
+		weaponProficiencies("Simple Weapons"),
+		savingThrows(shared.AttributeWisdom, shared.AttributeCharisma)),
+	"druid": slices.Concat(
+		[]*rulebook.Proficiency{lightArmorProficiency, mediumArmorProficiency, shieldProficiency},
+		weaponProficiencies("Clubs", "Daggers", "Darts", "Javelins", "Maces", "Quarterstaffs", "Scimitars", "Sickles", "Slings", "Spears"),
+		[]*rulebook.Proficiency{{Key: "herbalism-kit", Name: "Herbalism Kit", Type: rulebook.ProficiencyTypeTool}},
+		savingThrows(shared.AttributeIntelligence, shared.AttributeWisdom)),
+	"fighter": slices.Concat(
+		[]*rulebook.Proficiency{allArmorProficiency, shieldProficiency},
+		weaponProficiencies("Simple Weapons", "Martial Weapons"),
+		savingThrows(shared.AttributeStrength, shared.AttributeConstitution)),
+	"monk": slices.Concat(
+		weaponProficiencies("Simple Weapons", "Shortswords"),
+		savingThrows(shared.AttributeStrength, shared.AttributeDexterity)),
+	"paladin": slices.Concat(
+		[]*rulebook.Proficiency{allArmorProficiency, shieldProficiency},
+		weaponProficiencies("Simple Weapons", "Martial Weapons"),
+		savingThrows(shared.AttributeWisdom, shared.AttributeCharisma)),
+	"ranger": slices.Concat(
+		[]*rulebook.Proficiency{lightArmorProficiency, mediumArmorProficiency, shieldProficiency},
+		weaponProficiencies("Simple Weapons", "Martial Weapons"),
+		savingThrows(shared.AttributeStrength, shared.AttributeDexterity)),
+	"rogue": slices.Concat(
+		[]*rulebook.Proficiency{lightArmorProficiency},
+		weaponProficiencies("Simple Weapons", "Hand Crossbows", "Longswords", "Rapiers", "Shortswords"),
+		[]*rulebook.Proficiency{{Key: "thieves-tools", Name: "Thieves' Tools", Type: rulebook.ProficiencyTypeTool}},
+		savingThrows(shared.AttributeDexterity, shared.AttributeIntelligence)),
+	"sorcerer": slices.Concat(
+		weaponProficiencies("Daggers", "Darts", "Slings", "Quarterstaffs", "Light Crossbows"),
+		savingThrows(shared.AttributeConstitution, shared.AttributeCharisma)),
+	"warlock": slices.Concat(
+		[]*rulebook.Proficiency{lightArmorProficiency},
+		weaponProficiencies("Simple Weapons"),
+		savingThrows(shared.AttributeWisdom, shared.AttributeCharisma)),
+	"wizard": slices.Concat(
+		weaponProficiencies("Daggers", "Darts", "Slings", "Quarterstaffs", "Light Crossbows"),
+		savingThrows(shared.AttributeIntelligence, shared.AttributeWisdom)),
+}
+
+// racialProficiencies are the proficiencies every member of a race starts with
+var racialProficiencies = map[string][]*rulebook.Proficiency{
+	"dwarf":    weaponProficiencies("Battleaxes", "Handaxes", "Light Hammers", "Warhammers"),
+	"elf":      {rulebook.SkillProficiency("Perception")},
+	"half-orc": {rulebook.SkillProficiency("Intimidation")},
+}
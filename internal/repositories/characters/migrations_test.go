@@ -0,0 +1,272 @@
+package characters
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	mockredis "github.com/KirkDiggler/dnd-bot-discord/internal/mocks/redis"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// legacyRecord returns a version 0 record of a monk finalized with ability
+// score assignments but no attributes, HP or AC
+func legacyRecord(t *testing.T) []byte {
+	data := &CharacterData{
+		ID:      "broken_char",
+		Name:    "BrokenMonk",
+		OwnerID: "user_123",
+		RealmID: "realm_123",
+		Status:  shared.CharacterStatusActive,
+		Level:   1,
+		AbilityRolls: []character.AbilityRoll{
+			{ID: "roll_1", Value: 15},
+			{ID: "roll_2", Value: 14},
+			{ID: "roll_3", Value: 13},
+			{ID: "roll_4", Value: 12},
+			{ID: "roll_5", Value: 11},
+			{ID: "roll_6", Value: 10},
+		},
+		AbilityAssignments: map[string]string{
+			"STR": "roll_3",
+			"DEX": "roll_2",
+			"CON": "roll_4",
+			"INT": "roll_1",
+			"WIS": "roll_5",
+			"CHA": "roll_6",
+		},
+		Inventory: map[equipment.EquipmentType][]EquipmentData{
+			equipment.EquipmentTypeWeapon: {
+				{Type: "Weapon", Equipment: json.RawMessage(`{"base":{"key":"shortsword","name":"Shortsword"},"weapon_category":"Martial"}`)},
+				{Type: "", Equipment: json.RawMessage(`{"base":{"key":"dart","name":"Dart"},"weapon_category":"Simple"}`)},
+			},
+		},
+	}
+	raw, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	// Records saved before versioning have no schema_version at all and
+	// embed the race and class
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(raw, &record))
+	delete(record, "schema_version")
+	delete(record, "race_key")
+	delete(record, "class_key")
+	record["race"] = &rulebook.Race{
+		Key:  "elf",
+		Name: "Elf",
+		AbilityBonuses: []*shared.AbilityBonus{
+			{Attribute: shared.AttributeDexterity, Bonus: 2},
+		},
+	}
+	record["class"] = &rulebook.Class{Key: "monk", Name: "Monk", HitDie: 8}
+	raw, err = json.Marshal(record)
+	require.NoError(t, err)
+	return raw
+}
+
+func TestMigrations_AreOrdered(t *testing.T) {
+	for i, migration := range Migrations() {
+		assert.Equal(t, i+1, migration.Version, "migration %q is out of order", migration.Description)
+		assert.NotEmpty(t, migration.Description)
+		assert.NotNil(t, migration.Migrate)
+	}
+	assert.Equal(t, len(Migrations()), CurrentSchemaVersion)
+}
+
+func TestMigrateRecord_Legacy(t *testing.T) {
+	migrated, from, applied, err := MigrateRecord(legacyRecord(t))
+	require.NoError(t, err)
+	assert.Equal(t, 0, from)
+	assert.Len(t, applied, CurrentSchemaVersion)
+
+	char, err := UnmarshalCharacter(migrated)
+	require.NoError(t, err)
+
+	require.Len(t, char.Attributes, 6)
+	assert.Equal(t, 16, char.Attributes[shared.AttributeDexterity].Score, "DEX is 14 + 2 racial")
+	assert.Equal(t, 15, char.Attributes[shared.AttributeIntelligence].Score)
+	assert.Equal(t, 9, char.MaxHitPoints, "8 hit die + 1 CON")
+	assert.Equal(t, 9, char.CurrentHitPoints)
+	assert.Equal(t, 13, char.AC, "10 + 3 DEX + 0 WIS")
+	assert.Equal(t, &rulebook.Race{Key: "elf"}, char.Race, "only the key is kept")
+	assert.Equal(t, &rulebook.Class{Key: "monk"}, char.Class)
+	assert.Nil(t, char.Background)
+	assert.True(t, char.HasWeaponProficiency("dart"), "monks are proficient with simple weapons")
+	assert.True(t, char.HasSavingThrowProficiency(shared.AttributeDexterity))
+
+	weapons := char.Inventory[equipment.EquipmentTypeWeapon]
+	require.Len(t, weapons, 2)
+	for _, weapon := range weapons {
+		assert.IsType(t, &equipment.Weapon{}, weapon)
+	}
+}
+
+func TestMigrateRecord_Current(t *testing.T) {
+	raw, err := MarshalCharacter(&character.Character{ID: "char_123", Name: "Current"})
+	require.NoError(t, err)
+
+	migrated, from, applied, err := MigrateRecord(raw)
+	require.NoError(t, err)
+	assert.Equal(t, CurrentSchemaVersion, from)
+	assert.Empty(t, applied)
+	assert.Equal(t, raw, migrated)
+}
+
+func TestMigrateRecord_NewerThanCurrent(t *testing.T) {
+	_, _, _, err := MigrateRecord([]byte(`{"schema_version": 999}`))
+	assert.Error(t, err)
+}
+
+func TestMigrateRecord_Idempotent(t *testing.T) {
+	once, _, _, err := MigrateRecord(legacyRecord(t))
+	require.NoError(t, err)
+
+	twice, from, applied, err := MigrateRecord(once)
+	require.NoError(t, err)
+	assert.Equal(t, CurrentSchemaVersion, from)
+	assert.Empty(t, applied)
+	assert.Equal(t, once, twice)
+}
+
+func TestDeriveAttributes_KeepsExisting(t *testing.T) {
+	record := map[string]any{
+		"attributes":          map[string]any{"Str": map[string]any{"Score": 15, "Bonus": 2}},
+		"ability_rolls":       []any{map[string]any{"id": "roll_1", "value": 8}},
+		"ability_assignments": map[string]any{"STR": "roll_1"},
+		"ac":                  float64(12),
+	}
+
+	require.NoError(t, deriveAttributes(record))
+
+	assert.Equal(t, map[string]any{"Str": map[string]any{"Score": 15, "Bonus": 2}}, record["attributes"])
+	assert.Equal(t, float64(12), record["ac"])
+}
+
+func TestMonkUnarmoredDefense(t *testing.T) {
+	tests := []struct {
+		name   string
+		class  string
+		slots  map[string]any
+		wantAC float64
+	}{
+		{name: "unarmored monk", class: "monk", wantAC: 15},
+		{name: "armored monk", class: "monk", slots: map[string]any{"body": map[string]any{"type": "armor"}}, wantAC: 12},
+		{name: "fighter", class: "fighter", wantAC: 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := map[string]any{
+				"class": map[string]any{"key": tt.class},
+				"attributes": map[string]any{
+					"Dex": map[string]any{"Score": 14, "Bonus": 2},
+					"Wis": map[string]any{"Score": 16, "Bonus": 3},
+				},
+				"equipped_slots": tt.slots,
+				"ac":             float64(12),
+			}
+
+			require.NoError(t, monkUnarmoredDefense(record))
+
+			assert.EqualValues(t, tt.wantAC, record["ac"])
+		})
+	}
+}
+
+func TestHitDicePools(t *testing.T) {
+	raw := []byte(`{"schema_version": 4, "resources": {"hit_dice": {"dice_type": 8, "max": 3, "remaining": 1}}}`)
+
+	migrated, from, applied, err := MigrateRecord(raw)
+	require.NoError(t, err)
+	assert.Equal(t, 4, from)
+	require.Len(t, applied, CurrentSchemaVersion-4)
+
+	var data CharacterData
+	require.NoError(t, json.Unmarshal(migrated, &data))
+	require.NotNil(t, data.Resources)
+	assert.Equal(t, shared.HitDicePools{{DiceType: 8, Max: 3, Remaining: 1}}, data.Resources.HitDice)
+}
+
+func TestStartingProficiencies(t *testing.T) {
+	record := map[string]any{
+		"status":    string(shared.CharacterStatusActive),
+		"class_key": "fighter",
+		"race_key":  "dwarf",
+		"proficiencies": map[rulebook.ProficiencyType][]*rulebook.Proficiency{
+			rulebook.ProficiencyTypeSkill: {rulebook.SkillProficiency("Athletics")},
+		},
+	}
+	require.NoError(t, startingProficiencies(record))
+
+	var profs map[rulebook.ProficiencyType][]*rulebook.Proficiency
+	require.NoError(t, decodeField(record, "proficiencies", &profs))
+	assert.Len(t, profs[rulebook.ProficiencyTypeWeapon], 6, "simple, martial and 4 dwarven weapons")
+	assert.Len(t, profs[rulebook.ProficiencyTypeArmor], 2)
+	assert.Len(t, profs[rulebook.ProficiencyTypeSavingThrow], 2)
+	assert.Len(t, profs[rulebook.ProficiencyTypeSkill], 1, "chosen skills are kept")
+}
+
+func TestStartingProficiencies_SkipsCharactersWithWeapons(t *testing.T) {
+	record := map[string]any{
+		"status":    string(shared.CharacterStatusActive),
+		"class_key": "fighter",
+		"proficiencies": map[rulebook.ProficiencyType][]*rulebook.Proficiency{
+			rulebook.ProficiencyTypeWeapon: {{Key: "simple-weapons", Name: "Simple Weapons", Type: rulebook.ProficiencyTypeWeapon}},
+		},
+	}
+	require.NoError(t, startingProficiencies(record))
+
+	var profs map[rulebook.ProficiencyType][]*rulebook.Proficiency
+	require.NoError(t, decodeField(record, "proficiencies", &profs))
+	assert.Len(t, profs, 1, "characters with weapon proficiencies were already granted theirs")
+}
+
+func TestMigrator_MigrateAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockredis.NewMockUniversalClient(ctrl)
+	ctx := context.Background()
+
+	current, err := MarshalCharacter(&character.Character{ID: "current", Name: "Current"})
+	require.NoError(t, err)
+	legacy := legacyRecord(t)
+
+	client.EXPECT().Scan(ctx, uint64(0), "character:*", int64(100)).
+		Return(redis.NewScanCmdResult([]string{"character:current", "character:broken_char"}, 0, nil)).Times(2)
+	client.EXPECT().Get(ctx, "character:current").Return(redis.NewStringResult(string(current), nil)).Times(2)
+	client.EXPECT().Get(ctx, "character:broken_char").Return(redis.NewStringResult(string(legacy), nil)).Times(2)
+
+	migrator := NewMigrator(client)
+
+	// A dry run reports without saving
+	report, err := migrator.MigrateAll(ctx, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Scanned)
+	assert.Equal(t, 1, report.Migrated)
+	assert.Zero(t, report.Failed)
+	require.Len(t, report.Results, 1)
+	assert.Equal(t, "broken_char", report.Results[0].CharacterID)
+	assert.Len(t, report.Results[0].Applied, CurrentSchemaVersion)
+
+	// A real run saves the migrated record
+	client.EXPECT().Set(ctx, "character:broken_char", gomock.Any(), time.Duration(redis.KeepTTL)).
+		DoAndReturn(func(_ context.Context, _ string, value any, _ any) *redis.StatusCmd {
+			_, from, applied, err := MigrateRecord(value.([]byte))
+			assert.NoError(t, err)
+			assert.Equal(t, CurrentSchemaVersion, from)
+			assert.Empty(t, applied)
+			return redis.NewStatusResult("OK", nil)
+		})
+
+	report, err = migrator.MigrateAll(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Migrated)
+}
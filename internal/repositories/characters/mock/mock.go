@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	character "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	characters "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -114,6 +115,21 @@ func (mr *MockRepositoryMockRecorder) GetByOwnerAndRealm(ctx, ownerID, realmID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwnerAndRealm", reflect.TypeOf((*MockRepository)(nil).GetByOwnerAndRealm), ctx, ownerID, realmID)
 }
 
+// GetHistory mocks base method.
+func (m *MockRepository) GetHistory(ctx context.Context, id string) ([]*characters.HistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, id)
+	ret0, _ := ret[0].([]*characters.HistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockRepositoryMockRecorder) GetHistory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockRepository)(nil).GetHistory), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, arg1 *character.Character) error {
 	m.ctrl.T.Helper()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -23,16 +24,24 @@ type EquipmentData struct {
 	Equipment json.RawMessage `json:"equipment"`
 }
 
+// ClassLevelData is a class a multiclass character has levels in
+type ClassLevelData struct {
+	ClassKey string `json:"class_key"`
+	Level    int    `json:"level"`
+}
+
 // CharacterData represents the serialized form of a character in Redis
 type CharacterData struct {
+	SchemaVersion      int                                                  `json:"schema_version"`
 	ID                 string                                               `json:"id"`
 	OwnerID            string                                               `json:"owner_id"`
 	RealmID            string                                               `json:"realm_id"`
 	Name               string                                               `json:"name"`
 	Speed              int                                                  `json:"speed"`
-	Race               *rulebook.Race                                       `json:"race"`
-	Class              *rulebook.Class                                      `json:"class"`
-	Background         *rulebook.Background                                 `json:"background"`
+	RaceKey            string                                               `json:"race_key"`
+	ClassKey           string                                               `json:"class_key"`
+	ClassLevels        []ClassLevelData                                     `json:"class_levels,omitempty"`
+	BackgroundKey      string                                               `json:"background_key,omitempty"`
 	Attributes         map[shared.Attribute]*character.AbilityScore         `json:"attributes"`
 	AbilityRolls       []character.AbilityRoll                              `json:"ability_rolls"`
 	AbilityAssignments map[string]string                                    `json:"ability_assignments"`
@@ -47,8 +56,12 @@ type CharacterData struct {
 	Features           []*rulebook.CharacterFeature                         `json:"features"`
 	Inventory          map[equipment.EquipmentType][]EquipmentData          `json:"inventory"`
 	EquippedSlots      map[shared.Slot]EquipmentData                        `json:"equipped_slots"`
+	Attuned            []string                                             `json:"attuned,omitempty"`
+	Wallet             shared.Wallet                                        `json:"wallet"`
+	Personality        *character.Personality                               `json:"personality,omitempty"`
 	Resources          *character.CharacterResources                        `json:"resources"`
 	Spells             *character.SpellList                                 `json:"spells"`
+	LevelUp            *character.LevelUpProgress                           `json:"level_up,omitempty"`
 	CreatedAt          time.Time                                            `json:"created_at"`
 	UpdatedAt          time.Time                                            `json:"updated_at"`
 }
@@ -58,6 +71,7 @@ type redisRepo struct {
 	client        redis.UniversalClient
 	uuidGenerator uuid.Generator
 	ttl           time.Duration // TTL for draft characters
+	rulebook      RulebookSource
 }
 
 // equipmentToData converts an Equipment interface to EquipmentData for storage
@@ -75,6 +89,10 @@ func equipmentToData(eq equipment.Equipment) (EquipmentData, error) {
 		typeStr = "weapon"
 	case *equipment.Armor:
 		typeStr = "armor"
+	case *equipment.MagicItem:
+		typeStr = "magic_item"
+	case *equipment.Consumable:
+		typeStr = "consumable"
 	case *equipment.BasicEquipment:
 		typeStr = "basic"
 	default:
@@ -105,6 +123,18 @@ func dataToEquipment(data EquipmentData) (equipment.Equipment, error) {
 			return nil, fmt.Errorf("failed to unmarshal armor: %w", err)
 		}
 		return &armor, nil
+	case "magic_item":
+		var item equipment.MagicItem
+		if err := json.Unmarshal(data.Equipment, &item); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal magic item: %w", err)
+		}
+		return &item, nil
+	case "consumable":
+		var item equipment.Consumable
+		if err := json.Unmarshal(data.Equipment, &item); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal consumable: %w", err)
+		}
+		return &item, nil
 	case "basic", "basicequipment", "":
 		var basic equipment.BasicEquipment
 		if err := json.Unmarshal(data.Equipment, &basic); err != nil {
@@ -146,7 +176,8 @@ func dataToEquipment(data EquipmentData) (equipment.Equipment, error) {
 type RedisRepoConfig struct {
 	Client        redis.UniversalClient
 	UUIDGenerator uuid.Generator
-	DraftTTL      time.Duration // How long to keep draft characters (default: 24 hours)
+	DraftTTL      time.Duration  // How long to keep draft characters (default: 24 hours)
+	Rulebook      RulebookSource // Optional; without it races, classes and backgrounds load as bare keys
 }
 
 // NewRedisRepository creates a new Redis-backed character repository
@@ -170,6 +201,7 @@ func NewRedisRepository(cfg *RedisRepoConfig) Repository {
 		client:        cfg.Client,
 		uuidGenerator: cfg.UUIDGenerator,
 		ttl:           ttl,
+		rulebook:      cfg.Rulebook,
 	}
 }
 
@@ -178,6 +210,17 @@ func (r *redisRepo) key(id string) string {
 	return fmt.Sprintf("character:%s", id)
 }
 
+// historyKey generates the Redis key for a character's history. It sits
+// outside the character: prefix so scans over characters don't see it.
+func (r *redisRepo) historyKey(id string) string {
+	return fmt.Sprintf("character_history:%s", id)
+}
+
+// historyVersionKey generates the Redis key for a character's latest version number
+func (r *redisRepo) historyVersionKey(id string) string {
+	return fmt.Sprintf("character_history:%s:version", id)
+}
+
 // ownerCharactersKey generates the Redis key for an owner's character list
 func (r *redisRepo) ownerCharactersKey(ownerID string) string {
 	return fmt.Sprintf("owner:%s:characters", ownerID)
@@ -243,6 +286,13 @@ func (r *redisRepo) Create(ctx context.Context, char *character.Character) error
 	pipe.SAdd(ctx, r.realmCharactersKey(char.RealmID), char.ID)
 	pipe.SAdd(ctx, r.ownerRealmCharactersKey(char.OwnerID, char.RealmID), char.ID)
 
+	// Start the history of characters created complete, such as imports
+	if tracksHistory(char) {
+		if err := r.pushHistory(ctx, pipe, char.ID, jsonData); err != nil {
+			return err
+		}
+	}
+
 	// Execute pipeline
 	_, err = pipe.Exec(ctx)
 	if err != nil {
@@ -268,9 +318,15 @@ func (r *redisRepo) Get(ctx context.Context, id string) (*character.Character, e
 		return nil, fmt.Errorf("failed to get character: %w", err)
 	}
 
+	// Older records are upgraded in memory; the next save stores them at the current version
+	raw, _, _, err := MigrateRecord([]byte(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate character '%s': %w", id, err)
+	}
+
 	// Deserialize character data
 	var data CharacterData
-	if unmarshalErr := json.Unmarshal([]byte(jsonData), &data); unmarshalErr != nil {
+	if unmarshalErr := json.Unmarshal(raw, &data); unmarshalErr != nil {
 		return nil, fmt.Errorf("failed to unmarshal character: %w", unmarshalErr)
 	}
 
@@ -279,6 +335,13 @@ func (r *redisRepo) Get(ctx context.Context, id string) (*character.Character, e
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert character from data: %w", err)
 	}
+
+	if r.rulebook != nil {
+		if err := Rehydrate(ctx, char, r.rulebook); err != nil {
+			// Keep the character usable; only its keys are saved, so nothing is lost
+			log.Printf("Failed to load rulebook data for character %s: %v", id, err)
+		}
+	}
 	return char, nil
 }
 
@@ -378,8 +441,17 @@ func (r *redisRepo) Update(ctx context.Context, char *character.Character) error
 		return fmt.Errorf("failed to marshal character: %w", err)
 	}
 
-	// Update in Redis
-	err = r.client.Set(ctx, r.key(char.ID), jsonData, 0).Err()
+	// Update in Redis, along with the history when it's kept
+	if tracksHistory(char) {
+		pipe := r.client.TxPipeline()
+		pipe.Set(ctx, r.key(char.ID), jsonData, 0)
+		if err := r.pushHistory(ctx, pipe, char.ID, jsonData); err != nil {
+			return err
+		}
+		_, err = pipe.Exec(ctx)
+	} else {
+		err = r.client.Set(ctx, r.key(char.ID), jsonData, 0).Err()
+	}
 	if err != nil {
 		return fmt.Errorf("failed to update character: %w", err)
 	}
@@ -422,8 +494,8 @@ func (r *redisRepo) Delete(ctx context.Context, id string) error {
 	// Remove using pipeline
 	pipe := r.client.Pipeline()
 
-	// Remove character data
-	pipe.Del(ctx, r.key(id))
+	// Remove character data and history
+	pipe.Del(ctx, r.key(id), r.historyKey(id), r.historyVersionKey(id))
 
 	// Remove from index sets
 	pipe.SRem(ctx, r.ownerCharactersKey(char.OwnerID), id)
@@ -439,114 +511,60 @@ func (r *redisRepo) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// toCharacterData converts an entity to the data struct for storage
-func (r *redisRepo) toCharacterData(char *character.Character) (*CharacterData, error) {
-	// Convert inventory
-	inventory := make(map[equipment.EquipmentType][]EquipmentData)
-	for eqType, items := range char.Inventory {
-		var dataItems []EquipmentData
-		for _, item := range items {
-			data, err := equipmentToData(item)
-			if err != nil {
-				return nil, fmt.Errorf("failed to convert inventory item: %w", err)
-			}
-			dataItems = append(dataItems, data)
-		}
-		inventory[eqType] = dataItems
+// GetHistory returns a character's saved versions, newest first
+func (r *redisRepo) GetHistory(ctx context.Context, id string) ([]*HistoryEntry, error) {
+	if id == "" {
+		return nil, dnderr.InvalidArgument("character ID is required")
 	}
 
-	// Convert equipped slots
-	equippedSlots := make(map[shared.Slot]EquipmentData)
-	for slot, item := range char.EquippedSlots {
-		// Skip nil items (empty slots)
-		if item == nil {
-			continue
-		}
-		data, err := equipmentToData(item)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert equipped item: %w", err)
+	pipe := r.client.TxPipeline()
+	versionCmd := pipe.Get(ctx, r.historyVersionKey(id))
+	entriesCmd := pipe.LRange(ctx, r.historyKey(id), 0, -1)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("failed to get character history: %w", err)
+	}
+
+	latest, err := versionCmd.Int()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read character history version: %w", err)
+	}
+
+	// The version counter and list change together, so the newest entry is the latest version
+	raw := entriesCmd.Val()
+	entries := make([]*HistoryEntry, 0, len(raw))
+	for i, item := range raw {
+		var entry HistoryEntry
+		if err := json.Unmarshal([]byte(item), &entry); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal history entry: %w", err)
 		}
-		equippedSlots[slot] = data
-	}
-
-	return &CharacterData{
-		ID:                 char.ID,
-		OwnerID:            char.OwnerID,
-		RealmID:            char.RealmID,
-		Name:               char.Name,
-		Speed:              char.Speed,
-		Race:               char.Race,
-		Class:              char.Class,
-		Background:         char.Background,
-		Attributes:         char.Attributes,
-		AbilityRolls:       char.AbilityRolls,
-		AbilityAssignments: char.AbilityAssignments,
-		Proficiencies:      char.Proficiencies,
-		HitDie:             char.HitDie,
-		AC:                 char.AC,
-		MaxHitPoints:       char.MaxHitPoints,
-		CurrentHitPoints:   char.CurrentHitPoints,
-		Level:              char.Level,
-		Experience:         char.Experience,
-		Status:             char.Status,
-		Features:           char.Features,
-		Inventory:          inventory,
-		EquippedSlots:      equippedSlots,
-		Resources:          char.Resources,
-		Spells:             char.Spells,
-	}, nil
+		entry.Version = latest - i
+		entries = append(entries, &entry)
+	}
+	return entries, nil
 }
 
-// fromCharacterData converts a data struct to an entity
-func (r *redisRepo) fromCharacterData(data *CharacterData) (*character.Character, error) {
-	// Convert inventory back
-	inventory := make(map[equipment.EquipmentType][]equipment.Equipment)
-	for eqType, items := range data.Inventory {
-		var eqItems []equipment.Equipment
-		for _, item := range items {
-			eq, err := DataToEquipmentWithMigration(item)
-			if err != nil {
-				return nil, fmt.Errorf("failed to convert inventory data: %w", err)
-			}
-			eqItems = append(eqItems, eq)
-		}
-		inventory[eqType] = eqItems
+// pushHistory queues a new version of a character onto its history
+func (r *redisRepo) pushHistory(ctx context.Context, pipe redis.Pipeliner, id string, snapshot []byte) error {
+	entry, err := newHistoryEntry(ctx, snapshot)
+	if err != nil {
+		return err
 	}
 
-	// Convert equipped slots back
-	equippedSlots := make(map[shared.Slot]equipment.Equipment)
-	for slot, item := range data.EquippedSlots {
-		eq, err := DataToEquipmentWithMigration(item)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert equipped data: %w", err)
-		}
-		equippedSlots[slot] = eq
-	}
-
-	return &character.Character{
-		ID:                 data.ID,
-		OwnerID:            data.OwnerID,
-		RealmID:            data.RealmID,
-		Name:               data.Name,
-		Speed:              data.Speed,
-		Race:               data.Race,
-		Class:              data.Class,
-		Background:         data.Background,
-		Attributes:         data.Attributes,
-		AbilityRolls:       data.AbilityRolls,
-		AbilityAssignments: data.AbilityAssignments,
-		Proficiencies:      data.Proficiencies,
-		HitDie:             data.HitDie,
-		AC:                 data.AC,
-		MaxHitPoints:       data.MaxHitPoints,
-		CurrentHitPoints:   data.CurrentHitPoints,
-		Level:              data.Level,
-		Experience:         data.Experience,
-		Status:             data.Status,
-		Features:           data.Features,
-		Inventory:          inventory,
-		EquippedSlots:      equippedSlots,
-		Resources:          data.Resources,
-		Spells:             data.Spells,
-	}, nil
+	pipe.Incr(ctx, r.historyVersionKey(id))
+	pipe.LPush(ctx, r.historyKey(id), entry)
+	pipe.LTrim(ctx, r.historyKey(id), 0, MaxHistory-1)
+	return nil
+}
+
+// toCharacterData converts an entity to the data struct for storage
+func (r *redisRepo) toCharacterData(char *character.Character) (*CharacterData, error) {
+	return ToCharacterData(char)
+}
+
+// fromCharacterData converts a data struct to an entity
+func (r *redisRepo) fromCharacterData(data *CharacterData) (*character.Character, error) {
+	return FromCharacterData(data)
 }
@@ -7,11 +7,13 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
-// NewRedis creates a new Redis-backed character repository
-func NewRedis(client redis.UniversalClient) Repository {
+// NewRedis creates a new Redis-backed character repository that loads
+// races, classes and backgrounds from the rulebook
+func NewRedis(client redis.UniversalClient, rulebook RulebookSource) Repository {
 	return NewRedisRepository(&RedisRepoConfig{
 		Client:        client,
 		UUIDGenerator: uuid.NewGoogleUUIDGenerator(),
 		DraftTTL:      24 * time.Hour,
+		Rulebook:      rulebook,
 	})
 }
@@ -0,0 +1,126 @@
+package characters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// MigrationResult describes what migrating one stored character did
+type MigrationResult struct {
+	CharacterID string
+	FromVersion int
+	Applied     []string // Descriptions of the migrations applied
+	Err         error
+}
+
+// MigrationReport summarizes a bulk migration
+type MigrationReport struct {
+	DryRun   bool
+	Scanned  int
+	Migrated int // Records that needed migrating (and were saved unless a dry run)
+	Failed   int
+	Results  []*MigrationResult // Records that were migrated or failed
+}
+
+// Migrator upgrades every stored character to the current schema
+type Migrator struct {
+	client redis.UniversalClient
+}
+
+// NewMigrator creates a migrator for the characters stored in Redis
+func NewMigrator(client redis.UniversalClient) *Migrator {
+	if client == nil {
+		panic("Redis client cannot be nil")
+	}
+	return &Migrator{client: client}
+}
+
+// MigrateAll migrates every stored character. A dry run reports what would
+// change without saving anything.
+func (m *Migrator) MigrateAll(ctx context.Context, dryRun bool) (*MigrationReport, error) {
+	report := &MigrationReport{DryRun: dryRun}
+
+	err := ScanCharacterIDs(ctx, m.client, func(id string) error {
+		report.Scanned++
+
+		result := m.migrate(ctx, "character:"+id, id, dryRun)
+		if result == nil {
+			return nil
+		}
+		if result.Err != nil {
+			report.Failed++
+		} else {
+			report.Migrated++
+		}
+		report.Results = append(report.Results, result)
+		return nil
+	})
+	return report, err
+}
+
+// ScanCharacterIDs calls fn with the ID of every character stored in Redis,
+// stopping at the first error fn returns
+func ScanCharacterIDs(ctx context.Context, client redis.UniversalClient, fn func(id string) error) error {
+	var cursor uint64
+	for {
+		keys, next, err := client.Scan(ctx, cursor, "character:*", 100).Result()
+		if err != nil {
+			return fmt.Errorf("failed to scan characters: %w", err)
+		}
+
+		for _, key := range keys {
+			id := strings.TrimPrefix(key, "character:")
+			if strings.Contains(id, ":") {
+				continue // Not a character record
+			}
+			if err := fn(id); err != nil {
+				return err
+			}
+		}
+
+		cursor = next
+		if cursor == 0 {
+			return nil
+		}
+	}
+}
+
+// migrate migrates one stored character, returning nil if it's already current
+func (m *Migrator) migrate(ctx context.Context, key, id string, dryRun bool) *MigrationResult {
+	raw, err := m.client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil // Deleted since the scan
+	}
+	if err != nil {
+		return &MigrationResult{CharacterID: id, Err: fmt.Errorf("failed to get character: %w", err)}
+	}
+
+	migrated, from, applied, err := MigrateRecord(raw)
+	result := &MigrationResult{CharacterID: id, FromVersion: from}
+	for _, migration := range applied {
+		result.Applied = append(result.Applied, migration.Description)
+	}
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	if len(applied) == 0 {
+		return nil
+	}
+
+	// Make sure the migrated record still loads before saving it
+	if _, err := UnmarshalCharacter(migrated); err != nil {
+		result.Err = err
+		return result
+	}
+
+	if !dryRun {
+		if err := m.client.Set(ctx, key, migrated, redis.KeepTTL).Err(); err != nil {
+			result.Err = fmt.Errorf("failed to save character: %w", err)
+		}
+	}
+	return result
+}
@@ -12,6 +12,7 @@ import (
 
 	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
 	mockredis "github.com/KirkDiggler/dnd-bot-discord/internal/mocks/redis"
+	mockrulebook "github.com/KirkDiggler/dnd-bot-discord/internal/services/rulebook/mock"
 	mockUUID "github.com/KirkDiggler/dnd-bot-discord/internal/uuid/mocks"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/suite"
@@ -70,6 +71,13 @@ func (s *RedisMockTestSuite) createTestCharacter() *character.Character {
 	}
 }
 
+// expectHistoryPush expects a new version of test-id to be queued on the pipeline
+func (s *RedisMockTestSuite) expectHistoryPush(ctx context.Context, pipeline *mockredis.MockPipeliner) {
+	pipeline.EXPECT().Incr(ctx, "character_history:test-id:version").Return(redis.NewIntResult(1, nil))
+	pipeline.EXPECT().LPush(ctx, "character_history:test-id", gomock.Any()).Return(redis.NewIntResult(1, nil))
+	pipeline.EXPECT().LTrim(ctx, "character_history:test-id", int64(0), int64(MaxHistory-1)).Return(redis.NewStatusResult("OK", nil))
+}
+
 // Test Create with pipeline support
 func (s *RedisMockTestSuite) TestCreate_HappyPath() {
 	ctx := context.Background()
@@ -104,6 +112,9 @@ func (s *RedisMockTestSuite) TestCreate_HappyPath() {
 	sAddCmd3.SetVal(1)
 	pipeline.EXPECT().SAdd(ctx, "owner:owner-id:realm:realm-id:characters", "test-id").Return(sAddCmd3)
 
+	// Active characters start their history
+	s.expectHistoryPush(ctx, pipeline)
+
 	// Expect pipeline exec
 	cmds := []redis.Cmder{setCmd, sAddCmd1, sAddCmd2, sAddCmd3}
 	pipeline.EXPECT().Exec(ctx).Return(cmds, nil)
@@ -152,6 +163,99 @@ func (s *RedisMockTestSuite) TestGet_HappyPath() {
 	s.Equal(char.Name, result.Name)
 }
 
+func (s *RedisMockTestSuite) TestGet_RehydratesRulebookData() {
+	ctx := context.Background()
+	rulebookSvc := mockrulebook.NewMockService(s.mockCtrl)
+	s.repo.rulebook = rulebookSvc
+
+	jsonData, err := MarshalCharacter(s.createTestCharacter())
+	s.Require().NoError(err)
+	s.NotContains(string(jsonData), `"Fighter"`, "only keys are stored")
+
+	getCmd := redis.NewStringCmd(ctx, "get", "character:test-id")
+	getCmd.SetVal(string(jsonData))
+	s.mockClient.EXPECT().Get(ctx, "character:test-id").Return(getCmd)
+
+	fighter := &rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10}
+	rulebookSvc.EXPECT().GetRace(ctx, "human").Return(nil, dnderr.Internal("api down"))
+	rulebookSvc.EXPECT().GetClass(ctx, "fighter").Return(fighter, nil)
+
+	result, err := s.repo.Get(ctx, "test-id")
+	s.Require().NoError(err)
+	s.Same(fighter, result.Class)
+	s.Equal(&rulebook.Race{Key: "human"}, result.Race, "falls back to the key")
+}
+
+func (s *RedisMockTestSuite) TestLoadCharacter() {
+	ctx := context.Background()
+	rulebookSvc := mockrulebook.NewMockService(s.mockCtrl)
+	jsonData, err := MarshalCharacter(s.createTestCharacter())
+	s.Require().NoError(err)
+
+	human := &rulebook.Race{Key: "human", Name: "Human", Speed: 30}
+	fighter := &rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10}
+	rulebookSvc.EXPECT().GetRace(ctx, "human").Return(human, nil).Times(2)
+	rulebookSvc.EXPECT().GetClass(ctx, "fighter").Return(fighter, nil)
+
+	char, err := LoadCharacter(ctx, jsonData, rulebookSvc)
+	s.Require().NoError(err)
+	s.Same(human, char.Race)
+	s.Same(fighter, char.Class)
+
+	rulebookSvc.EXPECT().GetClass(ctx, "fighter").Return(nil, dnderr.Internal("api down"))
+	_, err = LoadCharacter(ctx, jsonData, rulebookSvc)
+	s.Error(err, "bare keys aren't handed back")
+}
+
+func (s *RedisMockTestSuite) TestGet_RehydratesMulticlassClasses() {
+	ctx := context.Background()
+	rulebookSvc := mockrulebook.NewMockService(s.mockCtrl)
+	s.repo.rulebook = rulebookSvc
+
+	char := s.createTestCharacter()
+	char.AddClassLevel(&rulebook.Class{Key: "wizard"})
+	jsonData, err := MarshalCharacter(char)
+	s.Require().NoError(err)
+
+	getCmd := redis.NewStringCmd(ctx, "get", "character:test-id")
+	getCmd.SetVal(string(jsonData))
+	s.mockClient.EXPECT().Get(ctx, "character:test-id").Return(getCmd)
+
+	fighter := &rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10}
+	wizard := &rulebook.Class{Key: "wizard", Name: "Wizard", HitDie: 6}
+	rulebookSvc.EXPECT().GetRace(ctx, "human").Return(&rulebook.Race{Key: "human"}, nil)
+	rulebookSvc.EXPECT().GetClass(ctx, "fighter").Return(fighter, nil)
+	rulebookSvc.EXPECT().GetClass(ctx, "wizard").Return(wizard, nil)
+
+	result, err := s.repo.Get(ctx, "test-id")
+	s.Require().NoError(err)
+	s.Require().Len(result.Classes, 2)
+	s.Same(fighter, result.Classes[0].Class)
+	s.Same(wizard, result.Classes[1].Class)
+}
+
+func (s *RedisMockTestSuite) TestGetHistory() {
+	ctx := context.Background()
+	newer, err := json.Marshal(&HistoryEntry{Actor: "dm_1", Reason: "gave shield", Snapshot: json.RawMessage(`{}`)})
+	s.Require().NoError(err)
+	older, err := json.Marshal(&HistoryEntry{Snapshot: json.RawMessage(`{}`)})
+	s.Require().NoError(err)
+
+	txPipeline := mockredis.NewMockPipeliner(s.mockCtrl)
+	s.mockClient.EXPECT().TxPipeline().Return(txPipeline)
+	txPipeline.EXPECT().Get(ctx, "character_history:test-id:version").Return(redis.NewStringResult("7", nil))
+	txPipeline.EXPECT().LRange(ctx, "character_history:test-id", int64(0), int64(-1)).
+		Return(redis.NewStringSliceResult([]string{string(newer), string(older)}, nil))
+	txPipeline.EXPECT().Exec(ctx).Return(nil, nil)
+
+	history, err := s.repo.GetHistory(ctx, "test-id")
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Equal(7, history[0].Version)
+	s.Equal("gave shield", history[0].Reason)
+	s.Equal(6, history[1].Version)
+}
+
 // Test Update with owner/realm change using pipeline
 func (s *RedisMockTestSuite) TestUpdate_WithOwnerRealmChange() {
 	ctx := context.Background()
@@ -175,10 +279,14 @@ func (s *RedisMockTestSuite) TestUpdate_WithOwnerRealmChange() {
 	getCmd.SetVal(string(jsonData))
 	s.mockClient.EXPECT().Get(ctx, "character:test-id").Return(getCmd)
 
-	// Expect set updated
+	// Expect set updated along with the history
+	txPipeline := mockredis.NewMockPipeliner(s.mockCtrl)
+	s.mockClient.EXPECT().TxPipeline().Return(txPipeline)
 	setCmd := redis.NewStatusCmd(ctx, "set", "character:test-id", gomock.Any(), time.Duration(0))
 	setCmd.SetVal("OK")
-	s.mockClient.EXPECT().Set(ctx, "character:test-id", gomock.Any(), time.Duration(0)).Return(setCmd)
+	txPipeline.EXPECT().Set(ctx, "character:test-id", gomock.Any(), time.Duration(0)).Return(setCmd)
+	s.expectHistoryPush(ctx, txPipeline)
+	txPipeline.EXPECT().Exec(ctx).Return([]redis.Cmder{setCmd}, nil)
 
 	// Create and expect pipeline for index updates
 	pipeline := mockredis.NewMockPipeliner(s.mockCtrl)
@@ -244,7 +352,7 @@ func (s *RedisMockTestSuite) TestDelete_HappyPath() {
 	// Expect DEL command
 	delCmd := redis.NewIntCmd(ctx, "del")
 	delCmd.SetVal(1)
-	pipeline.EXPECT().Del(ctx, "character:test-id").Return(delCmd)
+	pipeline.EXPECT().Del(ctx, "character:test-id", "character_history:test-id", "character_history:test-id:version").Return(delCmd)
 
 	// Expect 3 SREM commands
 	sRemCmd1 := redis.NewIntCmd(ctx, "srem")
@@ -0,0 +1,86 @@
+package characters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+)
+
+// RulebookSource loads the SRD data characters reference by key.
+// The rulebook service satisfies it.
+type RulebookSource interface {
+	GetRace(ctx context.Context, key string) (*rulebook.Race, error)
+	GetClass(ctx context.Context, key string) (*rulebook.Class, error)
+	GetBackground(ctx context.Context, key string) (*rulebook.Background, error)
+}
+
+// Rehydrate replaces the race, class and background references of a loaded
+// character, including the classes of a multiclass character, with the rulebook's current data. References that can't be
+// loaded are left as keys, so the character still saves correctly, and the
+// errors are returned joined.
+func Rehydrate(ctx context.Context, char *character.Character, source RulebookSource) error {
+	var errs []error
+
+	if char.Race != nil && char.Race.Key != "" {
+		race, err := source.GetRace(ctx, char.Race.Key)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			char.Race = race
+		}
+	}
+
+	if char.Class != nil && char.Class.Key != "" {
+		class, err := source.GetClass(ctx, char.Class.Key)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			char.Class = class
+		}
+	}
+
+	for _, classLevel := range char.Classes {
+		if classLevel == nil || classLevel.Class == nil || classLevel.Class.Key == "" {
+			continue
+		}
+		if char.Class != nil && char.Class.Key == classLevel.Class.Key {
+			classLevel.Class = char.Class
+			continue
+		}
+		class, err := source.GetClass(ctx, classLevel.Class.Key)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			classLevel.Class = class
+		}
+	}
+
+	if char.Background != nil && char.Background.ID != "" {
+		background, err := source.GetBackground(ctx, char.Background.ID)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			char.Background = background
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// LoadCharacter decodes a character in the storage format, like
+// UnmarshalCharacter, and rehydrates its rulebook references from source.
+// Unlike reads from the repository, a reference that can't be loaded is an
+// error, since callers go on to save or play the character.
+func LoadCharacter(ctx context.Context, raw []byte, source RulebookSource) (*character.Character, error) {
+	char, err := UnmarshalCharacter(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := Rehydrate(ctx, char, source); err != nil {
+		return nil, fmt.Errorf("failed to load rulebook data: %w", err)
+	}
+	return char, nil
+}
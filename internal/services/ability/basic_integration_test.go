@@ -21,7 +21,7 @@ func TestBasicRedisIntegration(t *testing.T) {
 	redisClient := testutils.CreateTestRedisClientOrSkip(t)
 
 	// Create repository
-	charRepo := characters.NewRedis(redisClient)
+	charRepo := characters.NewRedis(redisClient, nil)
 
 	// Create a simple character
 	char := &character.Character{
@@ -64,7 +64,7 @@ func TestRageAbilityIntegration(t *testing.T) {
 
 	// Mock expectations
 	mockCharService.EXPECT().GetByID("barb-1").Return(barbarian, nil).Times(1)
-	mockCharService.EXPECT().UpdateEquipment(gomock.Any()).Return(nil).Times(2) // Called by service and handler
+	mockCharService.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).Return(nil).Times(2) // Called by service and handler
 
 	// Test 1: Activate rage
 	ctx := context.Background()
@@ -91,7 +91,7 @@ func TestRageAbilityIntegration(t *testing.T) {
 
 	// Test 2: Deactivate rage
 	mockCharService.EXPECT().GetByID("barb-1").Return(barbarian, nil).Times(1)
-	mockCharService.EXPECT().UpdateEquipment(gomock.Any()).Return(nil).Times(2) // Called by service and handler
+	mockCharService.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).Return(nil).Times(2) // Called by service and handler
 
 	result2, err := svc.UseAbility(ctx, &ability.UseAbilityInput{
 		CharacterID: "barb-1",
@@ -158,7 +158,7 @@ func TestRageDamageBonusIntegration(t *testing.T) {
 			}
 
 			mockCharService.EXPECT().GetByID("barb-1").Return(barbarian, nil)
-			mockCharService.EXPECT().UpdateEquipment(gomock.Any()).Return(nil).Times(2)
+			mockCharService.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).Return(nil).Times(2)
 
 			result, err := svc.UseAbility(context.Background(), &ability.UseAbilityInput{
 				CharacterID: "barb-1",
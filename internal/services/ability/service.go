@@ -86,6 +86,9 @@ func (s *service) UseAbility(ctx context.Context, input *UseAbilityInput) (*UseA
 		}, nil
 	}
 
+	// A player uses their own character's abilities
+	ctx = charService.WithChange(ctx, char.OwnerID, fmt.Sprintf("used %s", ability.Name))
+
 	// Look up the handler for this ability
 	handler, hasHandler := s.registry.Get(input.AbilityKey)
 	if !hasHandler {
@@ -101,7 +104,7 @@ func (s *service) UseAbility(ctx context.Context, input *UseAbilityInput) (*UseA
 		s.updateActionEconomy(char, ability)
 
 		// Save character state
-		if updateErr := s.characterService.UpdateEquipment(char); updateErr != nil {
+		if updateErr := s.characterService.UpdateEquipment(ctx, char); updateErr != nil {
 			log.Printf("Failed to save character state after ability use: %v", updateErr)
 		}
 
@@ -162,7 +165,7 @@ func (s *service) UseAbility(ctx context.Context, input *UseAbilityInput) (*UseA
 	}
 
 	// Save character state
-	if updateErr := s.characterService.UpdateEquipment(char); updateErr != nil {
+	if updateErr := s.characterService.UpdateEquipment(ctx, char); updateErr != nil {
 		log.Printf("Failed to save character state after ability use: %v", updateErr)
 	}
 
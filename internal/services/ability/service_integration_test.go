@@ -37,7 +37,7 @@ func (s *AbilityServiceIntegrationSuite) SetupSuite() {
 	s.redisClient = testutils.CreateTestRedisClientOrSkip(s.T())
 
 	// Create repositories
-	s.charRepo = characters.NewRedis(s.redisClient)
+	s.charRepo = characters.NewRedis(s.redisClient, nil)
 
 	// Create services
 	s.charService = character.NewService(&character.ServiceConfig{
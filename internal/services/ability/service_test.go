@@ -193,7 +193,7 @@ func TestAbilityService_UseAbility(t *testing.T) {
 
 			// Allow UpdateEquipment to be called
 			mockCharSvc.EXPECT().
-				UpdateEquipment(gomock.Any()).
+				UpdateEquipment(gomock.Any(), gomock.Any()).
 				Return(nil).
 				AnyTimes()
 
@@ -68,7 +68,7 @@ func (s *CharacterServiceTestSuite) TestAbilityAssignmentBug_CharacterShowsZeroA
 	s.mockRepository.EXPECT().Get(s.ctx, characterID).Return(charAfterAssignment, nil).Times(1)
 
 	// Mock the Update call
-	s.mockRepository.EXPECT().Update(s.ctx, gomock.Any()).Return(nil).Times(1)
+	s.mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	// Mock getting class features
 	s.mockDNDClient.EXPECT().GetClassFeatures("monk", 1).Return([]*rulebook.CharacterFeature{
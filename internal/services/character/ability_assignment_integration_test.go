@@ -66,7 +66,7 @@ func TestAbilityAssignmentFlow_Integration(t *testing.T) {
 
 	// Mock Get and Update for race update
 	mockRepo.EXPECT().Get(ctx, char.ID).Return(char, nil)
-	mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, updated *character2.Character) error {
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updated *character2.Character) error {
 		char = updated
 		return nil
 	})
@@ -192,7 +192,7 @@ func TestUpdateDraftCharacter_AbilityAssignmentConversion(t *testing.T) {
 	mockRepo.EXPECT().Get(ctx, char.ID).Return(char, nil)
 	// Mock the Update call and capture the updated character
 	var capturedChar *character2.Character
-	mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, c *character2.Character) error {
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, c *character2.Character) error {
 		capturedChar = c
 		return nil
 	})
@@ -88,7 +88,7 @@ func TestAbilityAssignmentPersistence_SimulateDiscordFlow(t *testing.T) {
 
 	// Here's the potential bug: UpdateDraftCharacter should convert assignments to attributes
 	var updatedChar *character2.Character
-	mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, char *character2.Character) error {
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, char *character2.Character) error {
 		updatedChar = char
 		// Verify that UpdateDraftCharacter populated the Attributes
 		assert.NotEmpty(t, char.Attributes, "UpdateDraftCharacter should populate Attributes from AbilityAssignments")
@@ -0,0 +1,129 @@
+package character
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	charDomain "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+)
+
+// PointBuyInput is a finished point buy for a draft character
+type PointBuyInput struct {
+	CharacterID string
+	Scores      map[shared.Attribute]int // Score bought for each ability, before racial bonuses
+}
+
+// RequiredAbilityScoreMethod returns the method forced by the user's most
+// recently active session, or AbilityScoreMethodAny if none is forced
+func (s *service) RequiredAbilityScoreMethod(ctx context.Context, userID string) (shared.AbilityScoreMethod, error) {
+	if s.sessionRepository == nil {
+		return shared.AbilityScoreMethodAny, nil
+	}
+
+	sessions, err := s.sessionRepository.GetActiveByUser(ctx, userID)
+	if err != nil {
+		return shared.AbilityScoreMethodAny, dnderr.Wrap(err, "failed to get active sessions").
+			WithMeta("user_id", userID)
+	}
+
+	required := shared.AbilityScoreMethodAny
+	var latest int64
+	for _, sess := range sessions {
+		method := sess.GetAbilityScoreMethod()
+		if method == shared.AbilityScoreMethodAny {
+			continue
+		}
+		if active := sess.LastActive.UnixNano(); required == shared.AbilityScoreMethodAny || active > latest {
+			required, latest = method, active
+		}
+	}
+	return required, nil
+}
+
+// ApplyPointBuy validates a point buy and assigns the scores to a draft
+func (s *service) ApplyPointBuy(ctx context.Context, input *PointBuyInput) (*charDomain.Character, error) {
+	if input == nil {
+		return nil, dnderr.InvalidArgument("input is required")
+	}
+
+	rolls := make([]charDomain.AbilityRoll, 0, len(shared.Attributes))
+	assignments := make(map[string]string, len(shared.Attributes))
+	for _, attr := range shared.Attributes {
+		score, ok := input.Scores[attr]
+		if !ok {
+			return nil, dnderr.InvalidArgumentf("point buy is missing a score for %s", attr).
+				WithMeta("character_id", input.CharacterID)
+		}
+		key := strings.ToUpper(attr.Short())
+		rollID := "point_buy_" + key
+		rolls = append(rolls, charDomain.AbilityRoll{ID: rollID, Value: score})
+		assignments[key] = rollID
+	}
+
+	return s.UpdateDraftCharacter(ctx, input.CharacterID, &UpdateDraftInput{
+		AbilityMethod:      shared.AbilityScoreMethodPointBuy,
+		AbilityRolls:       rolls,
+		AbilityAssignments: assignments,
+	})
+}
+
+// abilityScoreRolls turns legacy ability -> score updates into rolls so
+// they can be validated like any other
+func abilityScoreRolls(scores map[string]int) []charDomain.AbilityRoll {
+	rolls := make([]charDomain.AbilityRoll, 0, len(scores))
+	for ability, score := range scores {
+		rolls = append(rolls, charDomain.AbilityRoll{ID: "legacy_" + strings.ToUpper(ability), Value: score})
+	}
+	return rolls
+}
+
+// validateAbilityRolls checks new ability rolls follow the method they were
+// made with and the method the owner's session requires
+func (s *service) validateAbilityRolls(ctx context.Context, char *charDomain.Character, method shared.AbilityScoreMethod, rolls []charDomain.AbilityRoll) error {
+	if method == shared.AbilityScoreMethodAny {
+		method = shared.AbilityScoreMethodRoll
+	}
+
+	required, err := s.RequiredAbilityScoreMethod(ctx, char.OwnerID)
+	if err != nil {
+		return err
+	}
+	if !required.Allows(method) {
+		return dnderr.InvalidArgumentf("your session requires %s for ability scores", required.DisplayName()).
+			WithMeta("character_id", char.ID).
+			WithMeta("method", string(method)).
+			WithMeta("required_method", string(required))
+	}
+
+	values := make([]int, len(rolls))
+	for i, roll := range rolls {
+		values[i] = roll.Value
+	}
+
+	switch method {
+	case shared.AbilityScoreMethodPointBuy:
+		err = rulebook.ValidatePointBuy(values)
+	case shared.AbilityScoreMethodStandardArray:
+		err = rulebook.ValidateStandardArray(values)
+	case shared.AbilityScoreMethodRoll:
+		// Rolls are added one at a time, so only check each is possible on 4d6 drop lowest
+		for _, value := range values {
+			if value < 3 || value > 18 {
+				err = fmt.Errorf("rolled scores must be 3-18, got %d", value)
+				break
+			}
+		}
+	default:
+		err = fmt.Errorf("unknown ability score method %q", method)
+	}
+	if err != nil {
+		return dnderr.InvalidArgument(err.Error()).
+			WithMeta("character_id", char.ID).
+			WithMeta("method", string(method))
+	}
+	return nil
+}
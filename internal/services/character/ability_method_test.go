@@ -0,0 +1,147 @@
+package character_test
+
+import (
+	"context"
+	"testing"
+
+	mockdnd5e "github.com/KirkDiggler/dnd-bot-discord/internal/clients/dnd5e/mock"
+	charDomain "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	characterdraft "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/gamesessions"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// setupAbilityMethod saves a dwarf fighter draft owned by user_1, who plays
+// in a session forcing the given method
+func setupAbilityMethod(t *testing.T, method shared.AbilityScoreMethod) character.Service {
+	ctx := context.Background()
+	repo := characters.NewInMemoryRepository()
+	require.NoError(t, repo.Create(ctx, &charDomain.Character{
+		ID:      "char_1",
+		OwnerID: "user_1",
+		Level:   1,
+		Status:  shared.CharacterStatusDraft,
+		Race: &rulebook.Race{Key: "dwarf", Name: "Dwarf", AbilityBonuses: []*shared.AbilityBonus{
+			{Attribute: shared.AttributeConstitution, Bonus: 2},
+		}},
+		Class:  &rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10},
+		HitDie: 10,
+	}))
+
+	sessionRepo := gamesessions.NewInMemoryRepository()
+	sess := gameSession.NewSession("session_1", "Friday Game", "realm_1", "channel_1", "dm_1")
+	sess.AddMember("dm_1", gameSession.SessionRoleDM)
+	sess.AddMember("user_1", gameSession.SessionRolePlayer)
+	sess.Settings.AbilityScoreMethod = method
+	require.NoError(t, sessionRepo.Create(ctx, sess))
+
+	return character.NewService(&character.ServiceConfig{
+		DNDClient:         mockdnd5e.NewMockClient(gomock.NewController(t)),
+		Repository:        repo,
+		DraftRepository:   characterdraft.NewInMemoryRepository(),
+		SessionRepository: sessionRepo,
+	})
+}
+
+func pointBuy(str, dex, con, intel, wis, cha int) *character.PointBuyInput {
+	return &character.PointBuyInput{
+		CharacterID: "char_1",
+		Scores: map[shared.Attribute]int{
+			shared.AttributeStrength:     str,
+			shared.AttributeDexterity:    dex,
+			shared.AttributeConstitution: con,
+			shared.AttributeIntelligence: intel,
+			shared.AttributeWisdom:       wis,
+			shared.AttributeCharisma:     cha,
+		},
+	}
+}
+
+func TestApplyPointBuy(t *testing.T) {
+	service := setupAbilityMethod(t, shared.AbilityScoreMethodAny)
+
+	char, err := service.ApplyPointBuy(context.Background(), pointBuy(15, 14, 14, 8, 10, 8))
+
+	require.NoError(t, err)
+	assert.Equal(t, 15, char.Attributes[shared.AttributeStrength].Score)
+	assert.Equal(t, 16, char.Attributes[shared.AttributeConstitution].Score, "racial bonus applies on top")
+	assert.Equal(t, 8, char.Attributes[shared.AttributeCharisma].Score)
+	assert.Len(t, char.AbilityRolls, 6)
+	assert.Equal(t, 13, char.MaxHitPoints)
+}
+
+func TestApplyPointBuy_Invalid(t *testing.T) {
+	service := setupAbilityMethod(t, shared.AbilityScoreMethodAny)
+	ctx := context.Background()
+
+	_, err := service.ApplyPointBuy(ctx, pointBuy(15, 15, 15, 9, 8, 8))
+	assert.True(t, dnderr.IsInvalidArgument(err), "28 points is over budget")
+
+	_, err = service.ApplyPointBuy(ctx, pointBuy(16, 8, 8, 8, 8, 8))
+	assert.True(t, dnderr.IsInvalidArgument(err), "16 is above the limit")
+
+	_, err = service.ApplyPointBuy(ctx, pointBuy(7, 15, 15, 8, 8, 8))
+	assert.True(t, dnderr.IsInvalidArgument(err), "7 is below the limit")
+}
+
+func TestSessionForcesAbilityScoreMethod(t *testing.T) {
+	service := setupAbilityMethod(t, shared.AbilityScoreMethodPointBuy)
+	ctx := context.Background()
+
+	required, err := service.RequiredAbilityScoreMethod(ctx, "user_1")
+	require.NoError(t, err)
+	assert.Equal(t, shared.AbilityScoreMethodPointBuy, required)
+
+	rolls := make([]charDomain.AbilityRoll, 6)
+	for i, value := range rulebook.StandardArray {
+		rolls[i] = charDomain.AbilityRoll{ID: "standard", Value: value}
+	}
+	_, err = service.UpdateDraftCharacter(ctx, "char_1", &character.UpdateDraftInput{
+		AbilityRolls:  rolls,
+		AbilityMethod: shared.AbilityScoreMethodStandardArray,
+	})
+	assert.True(t, dnderr.IsInvalidArgument(err), "standard array isn't allowed")
+
+	_, err = service.UpdateDraftCharacter(ctx, "char_1", &character.UpdateDraftInput{AbilityRolls: rolls})
+	assert.True(t, dnderr.IsInvalidArgument(err), "rolling isn't allowed")
+
+	_, err = service.ApplyPointBuy(ctx, pointBuy(15, 15, 15, 8, 8, 8))
+	assert.NoError(t, err)
+
+	// Other players are free to choose
+	required, err = service.RequiredAbilityScoreMethod(ctx, "user_2")
+	require.NoError(t, err)
+	assert.Equal(t, shared.AbilityScoreMethodAny, required)
+}
+
+func TestSessionForcesAbilityScoreMethod_LegacyScores(t *testing.T) {
+	service := setupAbilityMethod(t, shared.AbilityScoreMethodPointBuy)
+	ctx := context.Background()
+
+	// Scores set directly can't skip the session's method
+	_, err := service.UpdateDraftCharacter(ctx, "char_1", &character.UpdateDraftInput{
+		AbilityScores: map[string]int{"STR": 18, "DEX": 18, "CON": 18, "INT": 18, "WIS": 18, "CHA": 18},
+	})
+	assert.True(t, dnderr.IsInvalidArgument(err), "rolling isn't allowed")
+
+	_, err = service.UpdateDraftCharacter(ctx, "char_1", &character.UpdateDraftInput{
+		AbilityScores: map[string]int{"STR": 18, "DEX": 18, "CON": 18, "INT": 18, "WIS": 18, "CHA": 18},
+		AbilityMethod: shared.AbilityScoreMethodPointBuy,
+	})
+	assert.True(t, dnderr.IsInvalidArgument(err), "18s are over the point buy budget")
+
+	char, err := service.UpdateDraftCharacter(ctx, "char_1", &character.UpdateDraftInput{
+		AbilityScores: map[string]int{"STR": 15, "DEX": 15, "CON": 15, "INT": 8, "WIS": 8, "CHA": 8},
+		AbilityMethod: shared.AbilityScoreMethodPointBuy,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 17, char.Attributes[shared.AttributeConstitution].Score)
+}
@@ -0,0 +1,816 @@
+package character
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+)
+
+// AuditSeverity says how serious a violation is
+type AuditSeverity string
+
+const (
+	// AuditSeverityError breaks the rules and should be repaired
+	AuditSeverityError AuditSeverity = "error"
+	// AuditSeverityWarning is unusual but can be legal, e.g. a feature granting an extra skill
+	AuditSeverityWarning AuditSeverity = "warning"
+)
+
+// Audit rules, one per area of the character sheet
+const (
+	AuditRuleAbilityScores = "ability_scores"
+	AuditRuleProficiencies = "proficiencies"
+	AuditRuleEquipment     = "equipment"
+	AuditRuleArmorClass    = "armor_class"
+	AuditRuleHitPoints     = "hit_points"
+	AuditRuleSpells        = "spells"
+)
+
+const (
+	minAbilityScore = 3  // Lowest roll on 3d6
+	maxAbilityScore = 20 // Cap without magic
+
+	maxRepairPasses = 2 // Enough for rules waiting on restored ability scores
+)
+
+// AuditViolation is one way a character breaks the rules
+type AuditViolation struct {
+	Rule     string
+	Severity AuditSeverity
+	Message  string
+	Repair   string // Suggested repair
+
+	// apply makes the repair; nil when it has to be made by hand
+	apply func(char *character.Character)
+}
+
+// AutoRepairable reports whether RepairCharacter can fix the violation
+func (v *AuditViolation) AutoRepairable() bool {
+	return v.apply != nil
+}
+
+// AuditReport lists the violations found on a character
+type AuditReport struct {
+	CharacterID   string
+	CharacterName string
+	OwnerID       string
+	Violations    []*AuditViolation
+	Repaired      []*AuditViolation // Violations RepairCharacter fixed
+}
+
+// Legal reports whether the character has no errors; warnings are allowed
+func (r *AuditReport) Legal() bool {
+	for _, v := range r.Violations {
+		if v.Severity == AuditSeverityError {
+			return false
+		}
+	}
+	return true
+}
+
+// AutoRepairable returns the violations RepairCharacter can fix
+func (r *AuditReport) AutoRepairable() []*AuditViolation {
+	var repairable []*AuditViolation
+	for _, v := range r.Violations {
+		if v.AutoRepairable() {
+			repairable = append(repairable, v)
+		}
+	}
+	return repairable
+}
+
+// RepairCharacterInput identifies a character to repair
+type RepairCharacterInput struct {
+	CharacterID string
+	Actor       string // User asking for the repair; empty for bulk repairs
+}
+
+// AuditCharacter checks a finalized character against the 5e rules and its
+// race, class and background
+func (s *service) AuditCharacter(ctx context.Context, characterID string) (*AuditReport, error) {
+	char, err := s.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if char.Status == shared.CharacterStatusDraft {
+		return nil, dnderr.InvalidArgumentf("%s is still a draft", char.Name).
+			WithMeta("character_id", characterID)
+	}
+	return s.audit(char), nil
+}
+
+// RepairCharacter audits a character and saves it with every violation that
+// has an automatic repair fixed. The returned report lists what was repaired
+// and what is left to fix by hand.
+func (s *service) RepairCharacter(ctx context.Context, input *RepairCharacterInput) (*AuditReport, error) {
+	if input == nil || strings.TrimSpace(input.CharacterID) == "" {
+		return nil, dnderr.InvalidArgument("character ID is required")
+	}
+
+	char, err := s.GetCharacter(ctx, input.CharacterID)
+	if err != nil {
+		return nil, err
+	}
+	if char.Status == shared.CharacterStatusDraft {
+		return nil, dnderr.InvalidArgumentf("%s is still a draft", char.Name).
+			WithMeta("character_id", input.CharacterID)
+	}
+
+	// Repairs run in rule order, so the AC repair sees fixed ability scores.
+	// Rules that need a missing score wait for a second pass.
+	report := s.audit(char)
+	var repaired []*AuditViolation
+	for pass := 0; pass < maxRepairPasses; pass++ {
+		fixes := report.AutoRepairable()
+		if len(fixes) == 0 {
+			break
+		}
+		for _, violation := range fixes {
+			violation.apply(char)
+		}
+		repaired = append(repaired, fixes...)
+		report = s.audit(char)
+	}
+	if len(repaired) == 0 {
+		return report, nil
+	}
+	// Unequipping recalculates AC without class features
+	char.AC = s.acCalculator.Calculate(char)
+
+	ctx = WithChange(ctx, input.Actor, fmt.Sprintf("audit repaired %d problem(s)", len(repaired)))
+	if err := s.repository.Update(ctx, char); err != nil {
+		return nil, dnderr.Wrap(err, "failed to save repaired character").
+			WithMeta("character_id", input.CharacterID)
+	}
+
+	report = s.audit(char)
+	report.Repaired = repaired
+	return report, nil
+}
+
+// audit runs every rule against the character
+func (s *service) audit(char *character.Character) *AuditReport {
+	report := &AuditReport{
+		CharacterID:   char.ID,
+		CharacterName: char.Name,
+		OwnerID:       char.OwnerID,
+	}
+
+	for _, rule := range []func(*character.Character) []*AuditViolation{
+		s.auditAbilityScores,
+		s.auditProficiencies,
+		s.auditEquipment,
+		s.auditHitPoints,
+		s.auditArmorClass,
+		s.auditSpells,
+	} {
+		report.Violations = append(report.Violations, rule(char)...)
+	}
+	return report
+}
+
+func (s *service) auditAbilityScores(char *character.Character) []*AuditViolation {
+	var missing []string
+	for _, attr := range shared.Attributes {
+		if char.Attributes[attr] == nil {
+			missing = append(missing, attr.Short())
+		}
+	}
+	if len(missing) > 0 {
+		violation := &AuditViolation{
+			Rule:     AuditRuleAbilityScores,
+			Severity: AuditSeverityError,
+			Message:  fmt.Sprintf("Missing ability scores: %s", strings.Join(missing, ", ")),
+			Repair:   "Recreate the character or ask a DM to set the scores",
+		}
+		// Characters from before attributes were saved still have their rolls
+		if len(char.AbilityAssignments) > 0 && len(attributesFromRolls(char)) == len(shared.Attributes) {
+			violation.Repair = "Rebuild the scores from the assigned ability rolls"
+			violation.apply = func(char *character.Character) {
+				char.Attributes = attributesFromRolls(char)
+			}
+		}
+		return []*AuditViolation{violation}
+	}
+
+	var violations []*AuditViolation
+	for _, attr := range shared.Attributes {
+		score := char.Attributes[attr]
+		if score.Score < minAbilityScore || score.Score > maxAbilityScore {
+			clamped := min(max(score.Score, minAbilityScore), maxAbilityScore)
+			violations = append(violations, &AuditViolation{
+				Rule:     AuditRuleAbilityScores,
+				Severity: AuditSeverityError,
+				Message:  fmt.Sprintf("%s is %d; scores must be %d-%d", attr.Short(), score.Score, minAbilityScore, maxAbilityScore),
+				Repair:   fmt.Sprintf("Set %s to %d", attr.Short(), clamped),
+				apply: func(char *character.Character) {
+					char.Attributes[attr].Score = clamped
+					char.Attributes[attr].Bonus = (clamped - 10) / 2
+				},
+			})
+			continue
+		}
+		if expected := (score.Score - 10) / 2; score.Bonus != expected {
+			violations = append(violations, &AuditViolation{
+				Rule:     AuditRuleAbilityScores,
+				Severity: AuditSeverityError,
+				Message:  fmt.Sprintf("%s modifier is %+d but a score of %d gives %+d", attr.Short(), score.Bonus, score.Score, expected),
+				Repair:   fmt.Sprintf("Set the %s modifier to %+d", attr.Short(), expected),
+				apply: func(char *character.Character) {
+					char.Attributes[attr].Bonus = expected
+				},
+			})
+		}
+	}
+	return violations
+}
+
+func (s *service) auditProficiencies(char *character.Character) []*AuditViolation {
+	var violations []*AuditViolation
+	have := proficiencyKeys(char)
+
+	// Proficiencies every member of the class, race and background gets
+	var classRefs, raceRefs []*shared.ReferenceItem
+	if char.Class != nil {
+		classRefs = char.Class.Proficiencies
+	}
+	if char.Race != nil {
+		raceRefs = char.Race.StartingProficiencies
+	}
+	violations = append(violations, s.missingProficiencies(char, "class", classRefs, have)...)
+	violations = append(violations, s.missingProficiencies(char, "race", raceRefs, have)...)
+	if char.Background != nil {
+		var missing []*rulebook.Proficiency
+		for _, prof := range slices.Concat(char.Background.SkillProficiencies, char.Background.ToolProficiencies) {
+			if prof != nil && !have[prof.Key] {
+				missing = append(missing, prof)
+			}
+		}
+		if len(missing) > 0 {
+			violations = append(violations, missingProficiencyViolation("background", missing))
+		}
+	}
+
+	// Only the class grants saving throws
+	savingThrows := make(map[string]bool)
+	for _, ref := range classRefs {
+		if ref != nil && strings.HasPrefix(ref.Key, "saving-throw-") {
+			savingThrows[ref.Key] = true
+		}
+	}
+	if len(savingThrows) > 0 {
+		var extra []string
+		for _, prof := range char.Proficiencies[rulebook.ProficiencyTypeSavingThrow] {
+			if !savingThrows[prof.Key] {
+				extra = append(extra, prof.Name)
+			}
+		}
+		if len(extra) > 0 {
+			violations = append(violations, &AuditViolation{
+				Rule:     AuditRuleProficiencies,
+				Severity: AuditSeverityError,
+				Message:  fmt.Sprintf("Saving throw proficiencies the class doesn't grant: %s", strings.Join(extra, ", ")),
+				Repair:   "Remove them",
+				apply: func(char *character.Character) {
+					kept := slices.DeleteFunc(slices.Clone(char.Proficiencies[rulebook.ProficiencyTypeSavingThrow]),
+						func(prof *rulebook.Proficiency) bool { return !savingThrows[prof.Key] })
+					char.SetProficiencies(rulebook.ProficiencyTypeSavingThrow, kept)
+				},
+			})
+		}
+	}
+
+	// Skills granted outright plus skills chosen
+	if expected, ok := expectedSkillCount(char); ok {
+		skills := len(char.Proficiencies[rulebook.ProficiencyTypeSkill])
+		switch {
+		case skills > expected:
+			violations = append(violations, &AuditViolation{
+				Rule:     AuditRuleProficiencies,
+				Severity: AuditSeverityWarning,
+				Message:  fmt.Sprintf("Has %d skill proficiencies; race, class and background grant %d", skills, expected),
+				Repair:   "Check for a feature that grants more, otherwise remove the extras",
+			})
+		case skills < expected:
+			violations = append(violations, &AuditViolation{
+				Rule:     AuditRuleProficiencies,
+				Severity: AuditSeverityWarning,
+				Message:  fmt.Sprintf("Has %d skill proficiencies; race, class and background grant %d", skills, expected),
+				Repair:   "Pick the missing skills",
+			})
+		}
+	}
+
+	return violations
+}
+
+// missingProficiencies reports the fixed proficiencies from a source the
+// character lacks, loading them from the rulebook so they can be added
+func (s *service) missingProficiencies(char *character.Character, source string, refs []*shared.ReferenceItem, have map[string]bool) []*AuditViolation {
+	var missing []*rulebook.Proficiency
+	unresolved := false
+	for _, ref := range refs {
+		if ref == nil || have[ref.Key] {
+			continue
+		}
+		var prof *rulebook.Proficiency
+		if s.dndClient != nil {
+			prof, _ = s.dndClient.GetProficiency(ref.Key)
+		}
+		if prof == nil {
+			prof = &rulebook.Proficiency{Key: ref.Key, Name: ref.Name}
+			unresolved = true
+		}
+		missing = append(missing, prof)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	violation := missingProficiencyViolation(source, missing)
+	if unresolved {
+		violation.Repair = "Add them by hand; the rulebook couldn't be reached"
+		violation.apply = nil
+	}
+	return []*AuditViolation{violation}
+}
+
+func missingProficiencyViolation(source string, missing []*rulebook.Proficiency) *AuditViolation {
+	names := make([]string, 0, len(missing))
+	for _, prof := range missing {
+		names = append(names, prof.Name)
+	}
+	return &AuditViolation{
+		Rule:     AuditRuleProficiencies,
+		Severity: AuditSeverityError,
+		Message:  fmt.Sprintf("Missing %s proficiencies: %s", source, strings.Join(names, ", ")),
+		Repair:   "Add them",
+		apply: func(char *character.Character) {
+			for _, prof := range missing {
+				char.AddProficiency(prof)
+			}
+		},
+	}
+}
+
+// proficiencyKeys returns the keys of every proficiency the character has
+func proficiencyKeys(char *character.Character) map[string]bool {
+	keys := make(map[string]bool)
+	for _, profs := range char.Proficiencies {
+		for _, prof := range profs {
+			if prof != nil {
+				keys[prof.Key] = true
+			}
+		}
+	}
+	return keys
+}
+
+// expectedSkillCount returns how many skills the character's race, classes and
+// background grant, or false if the class hasn't been loaded from the rulebook
+func expectedSkillCount(char *character.Character) (int, bool) {
+	if char.Class == nil || len(char.Class.ProficiencyChoices) == 0 {
+		return 0, false
+	}
+
+	granted := make(map[string]bool)
+	count := 0
+	for _, choice := range char.Class.ProficiencyChoices {
+		if isSkillChoice(choice) {
+			count += choice.Count
+		}
+	}
+	for _, classLevel := range char.ClassLevels()[1:] {
+		count += rulebook.GetMulticlassProficiencies(classLevel.Class.Key).Skills
+	}
+	if char.Race != nil {
+		for _, ref := range char.Race.StartingProficiencies {
+			if ref != nil && strings.HasPrefix(ref.Key, "skill-") {
+				granted[ref.Key] = true
+			}
+		}
+		if isSkillChoice(char.Race.StartingProficiencyOptions) {
+			count += char.Race.StartingProficiencyOptions.Count
+		}
+	}
+	if char.Background != nil {
+		for _, prof := range char.Background.SkillProficiencies {
+			if prof != nil {
+				granted[prof.Key] = true
+			}
+		}
+	}
+	return count + len(granted), true
+}
+
+// isSkillChoice reports whether a choice picks skills
+func isSkillChoice(choice *shared.Choice) bool {
+	if choice == nil {
+		return false
+	}
+	for _, option := range choice.Options {
+		if option != nil && strings.HasPrefix(option.GetKey(), "skill-") {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *service) auditEquipment(char *character.Character) []*AuditViolation {
+	var violations []*AuditViolation
+
+	for slot, item := range char.EquippedSlots {
+		if item == nil {
+			continue
+		}
+		if char.GetEquipment(item.GetKey()) == nil {
+			violations = append(violations, &AuditViolation{
+				Rule:     AuditRuleEquipment,
+				Severity: AuditSeverityError,
+				Message:  fmt.Sprintf("%s is equipped (%s) but not in the inventory", item.GetName(), slot),
+				Repair:   fmt.Sprintf("Unequip %s", item.GetName()),
+				apply: func(char *character.Character) {
+					char.Unequip(slot)
+				},
+			})
+			continue
+		}
+		if slot == shared.SlotBody && item.GetEquipmentType() != equipment.EquipmentTypeArmor {
+			violations = append(violations, &AuditViolation{
+				Rule:     AuditRuleEquipment,
+				Severity: AuditSeverityError,
+				Message:  fmt.Sprintf("%s is worn as armor but isn't armor", item.GetName()),
+				Repair:   fmt.Sprintf("Unequip %s", item.GetName()),
+				apply: func(char *character.Character) {
+					char.Unequip(slot)
+				},
+			})
+			continue
+		}
+		if armor, ok := item.(*equipment.Armor); ok && !hasArmorProficiency(char, armor.ArmorCategory) {
+			violations = append(violations, &AuditViolation{
+				Rule:     AuditRuleEquipment,
+				Severity: AuditSeverityWarning,
+				Message:  fmt.Sprintf("Wearing %s without %s armor proficiency", item.GetName(), armor.ArmorCategory),
+				Repair:   "Take it off, or accept disadvantage on STR and DEX rolls and no spellcasting",
+			})
+		}
+	}
+
+	// A two-handed weapon leaves no hand free
+	if char.EquippedSlots[shared.SlotTwoHanded] != nil {
+		for _, slot := range []shared.Slot{shared.SlotMainHand, shared.SlotOffHand} {
+			if item := char.EquippedSlots[slot]; item != nil {
+				violations = append(violations, &AuditViolation{
+					Rule:     AuditRuleEquipment,
+					Severity: AuditSeverityError,
+					Message: fmt.Sprintf("%s is held in the %s alongside the two-handed %s",
+						item.GetName(), slot, char.EquippedSlots[shared.SlotTwoHanded].GetName()),
+					Repair: fmt.Sprintf("Unequip %s", item.GetName()),
+					apply: func(char *character.Character) {
+						char.Unequip(slot)
+					},
+				})
+			}
+		}
+	}
+
+	// Each entry in Attuned is one attuned copy, so count copies, not keys
+	var attuned, notCarried []string
+	copiesLeft := make(map[string]int)
+	for _, key := range char.Attuned {
+		if _, ok := copiesLeft[key]; !ok {
+			copiesLeft[key] = char.CountEquipment(key)
+		}
+		if copiesLeft[key] == 0 {
+			notCarried = append(notCarried, key)
+			continue
+		}
+		copiesLeft[key]--
+		attuned = append(attuned, key)
+	}
+	if len(notCarried) > 0 {
+		violations = append(violations, &AuditViolation{
+			Rule:     AuditRuleEquipment,
+			Severity: AuditSeverityError,
+			Message:  fmt.Sprintf("Attuned to items not in the inventory: %s", strings.Join(notCarried, ", ")),
+			Repair:   "End the attunements",
+			apply: func(char *character.Character) {
+				char.Attuned = withoutAttunements(char.Attuned, notCarried)
+				char.RefreshItemEffects()
+			},
+		})
+	}
+	if len(attuned) > character.MaxAttunedItems {
+		extra := attuned[character.MaxAttunedItems:]
+		violations = append(violations, &AuditViolation{
+			Rule:     AuditRuleEquipment,
+			Severity: AuditSeverityError,
+			Message:  fmt.Sprintf("Attuned to %d items; the limit is %d", len(attuned), character.MaxAttunedItems),
+			Repair:   fmt.Sprintf("End attunement to %s", strings.Join(extra, ", ")),
+			apply: func(char *character.Character) {
+				char.Attuned = withoutAttunements(char.Attuned, extra)
+				char.RefreshItemEffects()
+			},
+		})
+	}
+
+	return violations
+}
+
+// withoutAttunements removes one attunement for each key, starting with the
+// most recent, so other copies of the same item stay attuned
+func withoutAttunements(attuned, remove []string) []string {
+	attuned = slices.Clone(attuned)
+	for _, key := range remove {
+		for i := len(attuned) - 1; i >= 0; i-- {
+			if attuned[i] == key {
+				attuned = slices.Delete(attuned, i, i+1)
+				break
+			}
+		}
+	}
+	return attuned
+}
+
+// hasArmorProficiency reports whether the character is proficient with a category of armor
+func hasArmorProficiency(char *character.Character, category equipment.ArmorCategory) bool {
+	have := proficiencyKeys(char)
+	switch category {
+	case equipment.ArmorCategoryShield:
+		return have["shields"]
+	case equipment.ArmorCategoryLight, equipment.ArmorCategoryMedium, equipment.ArmorCategoryHeavy:
+		return have["all-armor"] || have[string(category)+"-armor"]
+	}
+	return true
+}
+
+func (s *service) auditHitPoints(char *character.Character) []*AuditViolation {
+	var violations []*AuditViolation
+	if char.Class == nil || char.Class.HitDie == 0 {
+		return nil // Class not loaded from the rulebook
+	}
+
+	if char.Level < 1 || char.Level > rulebook.MaxLevel {
+		return []*AuditViolation{{
+			Rule:     AuditRuleHitPoints,
+			Severity: AuditSeverityError,
+			Message:  fmt.Sprintf("Level %d is outside 1-%d", char.Level, rulebook.MaxLevel),
+			Repair:   "Ask a DM to set the level",
+		}}
+	}
+
+	hitDie := char.Class.HitDie
+	if char.HitDie != hitDie {
+		violations = append(violations, &AuditViolation{
+			Rule:     AuditRuleHitPoints,
+			Severity: AuditSeverityError,
+			Message:  fmt.Sprintf("Hit die is d%d but %s uses d%d", char.HitDie, char.Class.Name, hitDie),
+			Repair:   fmt.Sprintf("Set the hit die to d%d", hitDie),
+			apply: func(char *character.Character) {
+				char.HitDie = hitDie
+			},
+		})
+	}
+
+	// Max HP at first level, then between the lowest and highest roll each
+	// level after, at least 1 per level. Multiclass levels roll their own
+	// class's hit die.
+	con := char.Attributes[shared.AttributeConstitution]
+	if con == nil {
+		return violations // Judged once the ability score rule restores CON
+	}
+	conMod := (con.Score - 10) / 2
+	perLevelBonus := 0
+	if char.HasFeature("tough") {
+		perLevelBonus = 2
+	}
+	lowest := hitDie + conMod + perLevelBonus
+	highest := lowest
+	for i, classLevel := range char.ClassLevels() {
+		classHitDie, err := char.HitDieFor(classLevel.Class)
+		if err != nil {
+			return violations // Class not loaded from the rulebook
+		}
+		levels := classLevel.Level
+		if i == 0 {
+			levels-- // The starting class's first level is already counted
+		}
+		lowest += levels * max(1+conMod+perLevelBonus, 1)
+		highest += levels * max(classHitDie+conMod+perLevelBonus, 1)
+	}
+	lowest, highest = max(lowest, 1), max(highest, 1)
+
+	if char.MaxHitPoints < lowest || char.MaxHitPoints > highest {
+		target := min(max(char.MaxHitPoints, lowest), highest)
+		expected := fmt.Sprintf("%d-%d", lowest, highest)
+		if lowest == highest {
+			expected = fmt.Sprint(lowest)
+		}
+		violations = append(violations, &AuditViolation{
+			Rule:     AuditRuleHitPoints,
+			Severity: AuditSeverityError,
+			Message:  fmt.Sprintf("Max HP is %d; a level %d %s with CON %+d has %s", char.MaxHitPoints, char.Level, char.ClassSummary(), conMod, expected),
+			Repair:   fmt.Sprintf("Set max HP to %d", target),
+			apply: func(char *character.Character) {
+				// Characters saved before HP was calculated start at full health
+				if char.MaxHitPoints == 0 {
+					char.CurrentHitPoints = target
+				}
+				char.MaxHitPoints = target
+				char.CurrentHitPoints = min(char.CurrentHitPoints, target)
+			},
+		})
+	} else if char.CurrentHitPoints > char.MaxHitPoints || char.CurrentHitPoints < 0 {
+		violations = append(violations, &AuditViolation{
+			Rule:     AuditRuleHitPoints,
+			Severity: AuditSeverityError,
+			Message:  fmt.Sprintf("Current HP is %d of %d", char.CurrentHitPoints, char.MaxHitPoints),
+			Repair:   fmt.Sprintf("Set current HP to %d", min(max(char.CurrentHitPoints, 0), char.MaxHitPoints)),
+			apply: func(char *character.Character) {
+				char.CurrentHitPoints = min(max(char.CurrentHitPoints, 0), char.MaxHitPoints)
+			},
+		})
+	}
+
+	return violations
+}
+
+func (s *service) auditArmorClass(char *character.Character) []*AuditViolation {
+	expected := s.acCalculator.Calculate(char)
+	if char.AC == expected {
+		return nil
+	}
+	return []*AuditViolation{{
+		Rule:     AuditRuleArmorClass,
+		Severity: AuditSeverityError,
+		Message:  fmt.Sprintf("AC is %d but armor, abilities and features give %d", char.AC, expected),
+		Repair:   "Recalculate AC",
+		apply: func(char *character.Character) {
+			// Recalculated now, since earlier repairs may change it
+			char.AC = s.acCalculator.Calculate(char)
+		},
+	}}
+}
+
+func (s *service) auditSpells(char *character.Character) []*AuditViolation {
+	if char.Spells == nil || len(char.ClassLevels()) == 0 {
+		return nil
+	}
+	var violations []*AuditViolation
+
+	// Each class brings its own cantrips, spells and prepared spells; the
+	// character's lists hold them all together
+	cantripLimit, knownLimit, preparedLimit, maxLevel := 0, 0, 0, 0
+	knownOnly, prepares := true, false
+	for _, classLevel := range char.ClassLevels() {
+		classKey := classLevel.Class.Key
+		cantripLimit += rulebook.CantripsKnown(classKey, classLevel.Level)
+		maxLevel = max(maxLevel, rulebook.MaxSpellLevel(classKey, char.SubclassKeyFor(classKey), classLevel.Level))
+		if !rulebook.PreparesSpells(classKey) {
+			knownLimit += rulebook.SpellsKnown(classKey, classLevel.Level)
+			continue
+		}
+		knownOnly, prepares = false, true
+		abilityMod := 0
+		if score := char.Attributes[rulebook.SpellcastingAbility(classKey, char.SubclassKeyFor(classKey))]; score != nil {
+			abilityMod = score.Bonus
+		}
+		preparedLimit += rulebook.PreparedSpellLimit(classKey, classLevel.Level, abilityMod)
+	}
+	who := fmt.Sprintf("a level %d %s", char.Level, char.ClassSummary())
+
+	if len(char.Spells.Cantrips) > cantripLimit {
+		violations = append(violations, &AuditViolation{
+			Rule:     AuditRuleSpells,
+			Severity: AuditSeverityError,
+			Message:  fmt.Sprintf("Knows %d cantrips; %s knows %d", len(char.Spells.Cantrips), who, cantripLimit),
+			Repair:   "Forget the extra cantrips",
+		})
+	}
+
+	// Wizards' spellbooks grow with copied spells and other prepared casters
+	// keep known spells until they prepare, so only classes that all know a
+	// fixed list have a known spell limit
+	if knownOnly && len(char.Spells.KnownSpells) > knownLimit {
+		violations = append(violations, &AuditViolation{
+			Rule:     AuditRuleSpells,
+			Severity: AuditSeverityError,
+			Message:  fmt.Sprintf("Knows %d spells; %s knows %d", len(char.Spells.KnownSpells), who, knownLimit),
+			Repair:   "Forget the extra spells",
+		})
+	}
+	if prepares && len(char.Spells.PreparedSpells) > preparedLimit {
+		violations = append(violations, &AuditViolation{
+			Rule:     AuditRuleSpells,
+			Severity: AuditSeverityError,
+			Message:  fmt.Sprintf("Has %d spells prepared; the limit is %d", len(char.Spells.PreparedSpells), preparedLimit),
+			Repair:   fmt.Sprintf("Keep the first %d prepared spells", preparedLimit),
+			apply: func(char *character.Character) {
+				char.Spells.PreparedSpells = char.Spells.PreparedSpells[:preparedLimit]
+			},
+		})
+	}
+
+	// Spells above the highest level the character can cast
+	if s.dndClient == nil {
+		return violations
+	}
+	var tooHigh []string
+	var names []string
+	for _, key := range slices.Concat(char.Spells.KnownSpells, char.Spells.PreparedSpells) {
+		if slices.Contains(tooHigh, key) {
+			continue
+		}
+		spell, err := s.dndClient.GetSpell(key)
+		if err == nil && spell != nil && spell.Level > maxLevel {
+			tooHigh = append(tooHigh, key)
+			names = append(names, fmt.Sprintf("%s (level %d)", spell.Name, spell.Level))
+		}
+	}
+	if len(tooHigh) > 0 {
+		violations = append(violations, &AuditViolation{
+			Rule:     AuditRuleSpells,
+			Severity: AuditSeverityError,
+			Message:  fmt.Sprintf("Spells above level %d: %s", maxLevel, strings.Join(names, ", ")),
+			Repair:   "Remove them",
+			apply: func(char *character.Character) {
+				remove := func(key string) bool { return slices.Contains(tooHigh, key) }
+				char.Spells.KnownSpells = slices.DeleteFunc(char.Spells.KnownSpells, remove)
+				char.Spells.PreparedSpells = slices.DeleteFunc(char.Spells.PreparedSpells, remove)
+			},
+		})
+	}
+
+	return violations
+}
+
+// attributesFromRolls builds ability scores from the rolls assigned to each
+// ability, adding racial bonuses
+func attributesFromRolls(char *character.Character) map[shared.Attribute]*character.AbilityScore {
+	// Create roll ID to value map
+	rollValues := make(map[string]int)
+	for _, roll := range char.AbilityRolls {
+		rollValues[roll.ID] = roll.Value
+	}
+
+	attributes := make(map[shared.Attribute]*character.AbilityScore)
+
+	// Convert assignments to attributes
+	for abilityStr, rollID := range char.AbilityAssignments {
+		if _, ok := rollValues[rollID]; !ok {
+			log.Printf("Roll ID %s not found for character %s", rollID, char.ID)
+			continue
+		}
+		rollValue := rollValues[rollID]
+		// Parse ability string to Attribute type
+		var attr shared.Attribute
+		switch abilityStr {
+		case "STR":
+			attr = shared.AttributeStrength
+		case "DEX":
+			attr = shared.AttributeDexterity
+		case "CON":
+			attr = shared.AttributeConstitution
+		case "INT":
+			attr = shared.AttributeIntelligence
+		case "WIS":
+			attr = shared.AttributeWisdom
+		case "CHA":
+			attr = shared.AttributeCharisma
+		default:
+			continue
+		}
+
+		// Create base ability score
+		score := rollValue
+
+		// Apply racial bonuses
+		if char.Race != nil {
+			for _, bonus := range char.Race.AbilityBonuses {
+				if bonus.Attribute == attr {
+					score += bonus.Bonus
+				}
+			}
+		}
+
+		// Calculate modifier
+		modifier := (score - 10) / 2
+
+		// Create ability score
+		attributes[attr] = &character.AbilityScore{
+			Score: score,
+			Bonus: modifier,
+		}
+	}
+
+	return attributes
+}
@@ -0,0 +1,246 @@
+package character_test
+
+import (
+	"context"
+	"testing"
+
+	mockdnd5e "github.com/KirkDiggler/dnd-bot-discord/internal/clients/dnd5e/mock"
+	charDomain "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	mockdraftrepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	strSave   = &rulebook.Proficiency{Key: "saving-throw-str", Name: "Saving Throw: STR", Type: rulebook.ProficiencyTypeSavingThrow}
+	conSave   = &rulebook.Proficiency{Key: "saving-throw-con", Name: "Saving Throw: CON", Type: rulebook.ProficiencyTypeSavingThrow}
+	wisSave   = &rulebook.Proficiency{Key: "saving-throw-wis", Name: "Saving Throw: WIS", Type: rulebook.ProficiencyTypeSavingThrow}
+	lightArmr = &rulebook.Proficiency{Key: "light-armor", Name: "Light Armor", Type: rulebook.ProficiencyTypeArmor}
+	athletics = &rulebook.Proficiency{Key: "skill-athletics", Name: "Skill: Athletics", Type: rulebook.ProficiencyTypeSkill}
+	survival  = &rulebook.Proficiency{Key: "skill-survival", Name: "Skill: Survival", Type: rulebook.ProficiencyTypeSkill}
+)
+
+// legalFighter is a first level fighter that passes every audit rule
+func legalFighter() *charDomain.Character {
+	return &charDomain.Character{
+		ID:      "char_1",
+		OwnerID: "user_1",
+		Name:    "Thorin",
+		Level:   1,
+		Status:  shared.CharacterStatusActive,
+		Race:    &rulebook.Race{Key: "human", Name: "Human"},
+		Class: &rulebook.Class{
+			Key:    "fighter",
+			Name:   "Fighter",
+			HitDie: 10,
+			Proficiencies: []*shared.ReferenceItem{
+				{Key: "saving-throw-str", Name: "Saving Throw: STR"},
+				{Key: "saving-throw-con", Name: "Saving Throw: CON"},
+				{Key: "light-armor", Name: "Light Armor"},
+			},
+			ProficiencyChoices: []*shared.Choice{{
+				Type:  shared.ChoiceTypeProficiency,
+				Count: 2,
+				Options: []shared.Option{
+					&shared.ReferenceOption{Reference: &shared.ReferenceItem{Key: "skill-athletics"}},
+					&shared.ReferenceOption{Reference: &shared.ReferenceItem{Key: "skill-survival"}},
+					&shared.ReferenceOption{Reference: &shared.ReferenceItem{Key: "skill-history"}},
+				},
+			}},
+		},
+		HitDie:           10,
+		MaxHitPoints:     12,
+		CurrentHitPoints: 12,
+		AC:               11,
+		Attributes: map[shared.Attribute]*charDomain.AbilityScore{
+			shared.AttributeStrength:     {Score: 16, Bonus: 3},
+			shared.AttributeDexterity:    {Score: 12, Bonus: 1},
+			shared.AttributeConstitution: {Score: 14, Bonus: 2},
+			shared.AttributeIntelligence: {Score: 10},
+			shared.AttributeWisdom:       {Score: 11},
+			shared.AttributeCharisma:     {Score: 8, Bonus: -1},
+		},
+		Proficiencies: map[rulebook.ProficiencyType][]*rulebook.Proficiency{
+			rulebook.ProficiencyTypeSavingThrow: {strSave, conSave},
+			rulebook.ProficiencyTypeArmor:       {lightArmr},
+			rulebook.ProficiencyTypeSkill:       {athletics, survival},
+		},
+	}
+}
+
+func setupAudit(t *testing.T, char *charDomain.Character) (character.Service, *mockdnd5e.MockClient, characters.Repository) {
+	ctrl := gomock.NewController(t)
+	dndClient := mockdnd5e.NewMockClient(ctrl)
+	repo := characters.NewInMemoryRepository()
+	require.NoError(t, repo.Create(context.Background(), char))
+
+	service := character.NewService(&character.ServiceConfig{
+		DNDClient:       dndClient,
+		Repository:      repo,
+		DraftRepository: mockdraftrepo.NewMockRepository(ctrl),
+	})
+	return service, dndClient, repo
+}
+
+// rules returns the rule of each violation
+func rules(violations []*character.AuditViolation) []string {
+	var result []string
+	for _, v := range violations {
+		result = append(result, v.Rule)
+	}
+	return result
+}
+
+func TestAuditCharacter_Legal(t *testing.T) {
+	service, _, _ := setupAudit(t, legalFighter())
+
+	report, err := service.AuditCharacter(context.Background(), "char_1")
+
+	require.NoError(t, err)
+	assert.Empty(t, report.Violations)
+	assert.True(t, report.Legal())
+}
+
+func TestAuditCharacter_Violations(t *testing.T) {
+	char := legalFighter()
+	char.Attributes[shared.AttributeStrength] = &charDomain.AbilityScore{Score: 22, Bonus: 6}
+	char.Attributes[shared.AttributeDexterity].Bonus = 3
+	char.Proficiencies[rulebook.ProficiencyTypeSavingThrow] = []*rulebook.Proficiency{strSave, wisSave}
+	char.Proficiencies[rulebook.ProficiencyTypeSkill] = []*rulebook.Proficiency{athletics}
+	char.MaxHitPoints = 30
+	char.CurrentHitPoints = 30
+	char.EquippedSlots = map[shared.Slot]equipment.Equipment{
+		shared.SlotMainHand: &equipment.Weapon{Base: equipment.BasicEquipment{Key: "longsword", Name: "Longsword"}},
+	}
+	service, dndClient, _ := setupAudit(t, char)
+	dndClient.EXPECT().GetProficiency("saving-throw-con").Return(conSave, nil)
+
+	report, err := service.AuditCharacter(context.Background(), "char_1")
+
+	require.NoError(t, err)
+	assert.False(t, report.Legal())
+	assert.Equal(t, []string{
+		character.AuditRuleAbilityScores, // STR above 20
+		character.AuditRuleAbilityScores, // DEX modifier
+		character.AuditRuleProficiencies, // Missing CON save
+		character.AuditRuleProficiencies, // Extra WIS save
+		character.AuditRuleProficiencies, // One skill short
+		character.AuditRuleEquipment,     // Longsword not carried
+		character.AuditRuleHitPoints,     // Max HP too high
+		character.AuditRuleArmorClass,    // DEX modifier changes AC
+	}, rules(report.Violations))
+	assert.Equal(t, character.AuditSeverityWarning, report.Violations[4].Severity)
+	assert.False(t, report.Violations[4].AutoRepairable(), "skills have to be picked")
+	assert.Len(t, report.AutoRepairable(), 7)
+}
+
+func TestAuditCharacter_MulticlassHitPoints(t *testing.T) {
+	char := legalFighter()
+	char.AddClassLevel(&rulebook.Class{Key: "barbarian", Name: "Barbarian", HitDie: 12})
+	char.MaxHitPoints = 26 // 12 at first level, then up to 12 + 2 CON on the barbarian's d12
+	char.CurrentHitPoints = 26
+	service, _, _ := setupAudit(t, char)
+
+	report, err := service.AuditCharacter(context.Background(), "char_1")
+
+	require.NoError(t, err)
+	assert.NotContains(t, rules(report.Violations), character.AuditRuleHitPoints)
+}
+
+func TestAuditCharacter_MulticlassSpells(t *testing.T) {
+	char := legalFighter()
+	char.AddClassLevel(&rulebook.Class{Key: "wizard", Name: "Wizard", HitDie: 6})
+	char.MaxHitPoints = 16
+	char.CurrentHitPoints = 16
+	char.Spells = &charDomain.SpellList{
+		Cantrips:       []string{"fire-bolt", "light", "mage-hand", "prestidigitation"},
+		KnownSpells:    []string{"magic-missile", "shield", "sleep"},
+		PreparedSpells: []string{"magic-missile", "shield"},
+	}
+	service, dndClient, _ := setupAudit(t, char)
+	dndClient.EXPECT().GetSpell(gomock.Any()).Return(&rulebook.Spell{Level: 1}, nil).AnyTimes()
+
+	report, err := service.AuditCharacter(context.Background(), "char_1")
+
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		character.AuditRuleSpells, // 4 cantrips; a first level wizard knows 3
+		character.AuditRuleSpells, // 2 prepared with INT +0 at wizard level 1
+	}, rules(report.Violations))
+	assert.Contains(t, report.Violations[0].Message, "Fighter 1 / Wizard 1")
+	assert.True(t, report.Violations[1].AutoRepairable())
+}
+
+func TestRepairCharacter_RebuildsFromAbilityRolls(t *testing.T) {
+	char := legalFighter()
+	char.Attributes = nil
+	char.AbilityRolls = []charDomain.AbilityRoll{
+		{ID: "roll_1", Value: 16}, {ID: "roll_2", Value: 12}, {ID: "roll_3", Value: 14},
+		{ID: "roll_4", Value: 10}, {ID: "roll_5", Value: 11}, {ID: "roll_6", Value: 8},
+	}
+	char.AbilityAssignments = map[string]string{
+		"STR": "roll_1", "DEX": "roll_2", "CON": "roll_3", "INT": "roll_4", "WIS": "roll_5", "CHA": "roll_6",
+	}
+	char.MaxHitPoints = 0
+	char.CurrentHitPoints = 0
+	char.AC = 0
+	service, _, repo := setupAudit(t, char)
+	ctx := context.Background()
+
+	report, err := service.RepairCharacter(ctx, &character.RepairCharacterInput{CharacterID: "char_1"})
+
+	require.NoError(t, err)
+	assert.True(t, report.Legal())
+	assert.Equal(t, []string{
+		character.AuditRuleAbilityScores,
+		character.AuditRuleArmorClass,
+		character.AuditRuleHitPoints, // Waits for CON
+	}, rules(report.Repaired))
+
+	saved, err := repo.Get(ctx, "char_1")
+	require.NoError(t, err)
+	require.Len(t, saved.Attributes, 6)
+	assert.Equal(t, 16, saved.Attributes[shared.AttributeStrength].Score)
+	assert.Equal(t, 12, saved.MaxHitPoints, "d10 + 2 CON")
+	assert.Equal(t, 12, saved.CurrentHitPoints)
+	assert.Equal(t, 11, saved.AC, "10 + 1 DEX")
+}
+
+func TestRepairCharacter(t *testing.T) {
+	char := legalFighter()
+	char.Attributes[shared.AttributeStrength] = &charDomain.AbilityScore{Score: 22, Bonus: 6}
+	char.Proficiencies[rulebook.ProficiencyTypeSavingThrow] = []*rulebook.Proficiency{strSave, wisSave}
+	char.Proficiencies[rulebook.ProficiencyTypeSkill] = []*rulebook.Proficiency{athletics}
+	char.MaxHitPoints = 30
+	char.CurrentHitPoints = 30
+	char.AC = 18
+	service, dndClient, repo := setupAudit(t, char)
+	dndClient.EXPECT().GetProficiency("saving-throw-con").Return(conSave, nil)
+	ctx := context.Background()
+
+	report, err := service.RepairCharacter(ctx, &character.RepairCharacterInput{CharacterID: "char_1", Actor: "dm_1"})
+
+	require.NoError(t, err)
+	assert.Len(t, report.Repaired, 5)
+	assert.Equal(t, []string{character.AuditRuleProficiencies}, rules(report.Violations), "only the skill choice is left")
+
+	saved, err := repo.Get(ctx, "char_1")
+	require.NoError(t, err)
+	assert.Equal(t, 20, saved.Attributes[shared.AttributeStrength].Score)
+	assert.Equal(t, 5, saved.Attributes[shared.AttributeStrength].Bonus)
+	assert.ElementsMatch(t, []*rulebook.Proficiency{strSave, conSave}, saved.Proficiencies[rulebook.ProficiencyTypeSavingThrow])
+	assert.Equal(t, 12, saved.MaxHitPoints)
+	assert.Equal(t, 12, saved.CurrentHitPoints)
+	assert.Equal(t, 11, saved.AC)
+
+	history, err := repo.GetHistory(ctx, "char_1")
+	require.NoError(t, err)
+	assert.Equal(t, "dm_1", history[0].Actor)
+	assert.Equal(t, "audit repaired 5 problem(s)", history[0].Reason)
+}
@@ -0,0 +1,65 @@
+package character
+
+import (
+	"log"
+	"strings"
+
+	charDomain "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+)
+
+// addBackground grants the proficiencies, starting equipment, gold and
+// feature of the character's background
+func (s *service) addBackground(char *charDomain.Character) {
+	background := char.Background
+	if background == nil {
+		return
+	}
+
+	for _, prof := range background.SkillProficiencies {
+		char.AddProficiency(prof)
+	}
+	for _, prof := range background.ToolProficiencies {
+		char.AddProficiency(prof)
+	}
+
+	for _, item := range background.StartingEquipment {
+		char.AddInventory(s.backgroundEquipment(item))
+	}
+	char.Wallet.Add(background.StartingGold, shared.CoinGold)
+
+	if char.GetBackgroundFeature() == nil {
+		char.SetBackground(background)
+	}
+}
+
+// backgroundEquipment looks up a background item in the SRD, keeping items
+// the SRD doesn't have as basic equipment
+func (s *service) backgroundEquipment(item rulebook.BackgroundItem) equipment.Equipment {
+	if item.Key != "" && s.dndClient != nil {
+		found, err := s.dndClient.GetEquipment(item.Key)
+		if err == nil && found != nil {
+			return found
+		}
+		log.Printf("Failed to get background equipment %s: %v", item.Key, err)
+	}
+
+	key := item.Key
+	if key == "" {
+		key = itemKey(item.Name)
+	}
+	return &equipment.BasicEquipment{
+		Key:  key,
+		Name: item.Name,
+	}
+}
+
+// itemKey turns an item name like "Incense (5 sticks)" into "incense-5-sticks"
+func itemKey(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	})
+	return strings.Join(words, "-")
+}
@@ -0,0 +1,181 @@
+package character_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockdnd5e "github.com/KirkDiggler/dnd-bot-discord/internal/clients/dnd5e/mock"
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
+	character2 "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newBackgroundFlow(t *testing.T, roller *mockdice.ManualMockRoller) (character.Service, character2.CreationFlowService, characters.Repository) {
+	t.Helper()
+	repo := characters.NewInMemoryRepository()
+	service := character.NewService(&character.ServiceConfig{
+		Repository:      repo,
+		DraftRepository: character_draft.NewInMemoryRepository(),
+	})
+	flow := character.NewCreationFlowServiceWithRoller(service, character.NewFlowBuilder(nil), roller)
+	return service, flow, repo
+}
+
+func createDraft(t *testing.T, repo characters.Repository) *character2.Character {
+	t.Helper()
+	char := &character2.Character{
+		ID:      "bg-char",
+		OwnerID: "user",
+		RealmID: "realm",
+		Name:    "Brother Aldous",
+		Status:  shared.CharacterStatusDraft,
+		Level:   1,
+	}
+	require.NoError(t, repo.Create(context.Background(), char))
+	return char
+}
+
+func TestCreationFlow_BackgroundAndLanguages(t *testing.T) {
+	service, flow, repo := newBackgroundFlow(t, mockdice.NewManualMockRoller())
+	char := createDraft(t, repo)
+	ctx := context.Background()
+
+	_, err := flow.ProcessStepResult(ctx, char.ID, &character2.CreationStepResult{
+		StepType:   character2.StepTypeBackgroundSelection,
+		Selections: []string{"acolyte"},
+	})
+	require.NoError(t, err)
+
+	_, err = flow.ProcessStepResult(ctx, char.ID, &character2.CreationStepResult{
+		StepType:   character2.StepTypeLanguageSelection,
+		Selections: []string{"elvish"},
+		Metadata:   map[string]any{"source": "background"},
+	})
+	assert.Error(t, err, "acolytes learn two languages")
+
+	_, err = flow.ProcessStepResult(ctx, char.ID, &character2.CreationStepResult{
+		StepType:   character2.StepTypeLanguageSelection,
+		Selections: []string{"elvish", "celestial"},
+		Metadata:   map[string]any{"source": "background"},
+	})
+	require.NoError(t, err)
+
+	saved, err := service.GetByID(char.ID)
+	require.NoError(t, err)
+	require.NotNil(t, saved.Background)
+	assert.Equal(t, "acolyte", saved.Background.ID)
+	assert.Equal(t, []string{"elvish", "celestial"}, saved.GetBackgroundLanguages())
+	require.NotNil(t, saved.GetBackgroundFeature())
+	assert.Equal(t, "Shelter of the Faithful", saved.GetBackgroundFeature().Name)
+}
+
+func TestCreationFlow_PickAndRollPersonality(t *testing.T) {
+	roller := mockdice.NewManualMockRoller()
+	service, flow, repo := newBackgroundFlow(t, roller)
+	char := createDraft(t, repo)
+	ctx := context.Background()
+
+	_, err := flow.ProcessStepResult(ctx, char.ID, &character2.CreationStepResult{
+		StepType:   character2.StepTypeBackgroundSelection,
+		Selections: []string{"soldier"},
+	})
+	require.NoError(t, err)
+
+	soldier := rulebook.GetSRDBackground("soldier")
+
+	// Picking the same trait twice isn't allowed
+	_, err = flow.ProcessStepResult(ctx, char.ID, &character2.CreationStepResult{
+		StepType:   character2.StepTypePersonalitySelection,
+		Selections: []string{"trait-2", "trait-2"},
+	})
+	assert.Error(t, err)
+
+	_, err = flow.ProcessStepResult(ctx, char.ID, &character2.CreationStepResult{
+		StepType:   character2.StepTypePersonalitySelection,
+		Selections: []string{"trait-1", "trait-3", "ideal-2"},
+	})
+	require.NoError(t, err)
+
+	// Rolling everything fills in the bond and flaw, leaving the picks alone
+	roller.SetRolls([]int{4, 1})
+	_, err = flow.ProcessStepResult(ctx, char.ID, &character2.CreationStepResult{
+		StepType:   character2.StepTypePersonalitySelection,
+		Selections: []string{character.PersonalityRoll},
+	})
+	require.NoError(t, err)
+
+	saved, err := service.GetByID(char.ID)
+	require.NoError(t, err)
+	require.True(t, saved.Personality.IsComplete())
+	assert.Equal(t, []string{soldier.PersonalityTraits[0], soldier.PersonalityTraits[2]}, saved.Personality.Traits)
+	assert.Equal(t, soldier.Ideals[1], saved.Personality.Ideal)
+	assert.Equal(t, soldier.Bonds[3], saved.Personality.Bond)
+	assert.Equal(t, soldier.Flaws[0], saved.Personality.Flaw)
+
+	// Rerolling the traits never rolls the same one twice
+	roller.SetRolls([]int{5, 5})
+	_, err = flow.ProcessStepResult(ctx, char.ID, &character2.CreationStepResult{
+		StepType:   character2.StepTypePersonalitySelection,
+		Selections: []string{character.PersonalityRoll + "-trait"},
+	})
+	require.NoError(t, err)
+
+	saved, err = service.GetByID(char.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{soldier.PersonalityTraits[4], soldier.PersonalityTraits[5]}, saved.Personality.Traits)
+}
+
+func TestFinalizeDraftCharacter_GrantsBackground(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClient := mockdnd5e.NewMockClient(ctrl)
+	crowbar := &equipment.BasicEquipment{Key: "crowbar", Name: "Crowbar"}
+	mockClient.EXPECT().GetEquipment("crowbar").Return(crowbar, nil)
+	mockClient.EXPECT().GetEquipment(gomock.Any()).Return(nil, errors.New("not found")).AnyTimes()
+
+	repo := characters.NewInMemoryRepository()
+	service := character.NewService(&character.ServiceConfig{
+		Repository:      repo,
+		DraftRepository: character_draft.NewInMemoryRepository(),
+		DNDClient:       mockClient,
+	})
+
+	char := createDraft(t, repo)
+	char.Class = &rulebook.Class{Key: "rogue", Name: "Rogue", HitDie: 8}
+	char.SetBackground(rulebook.GetSRDBackground("criminal"))
+	require.NoError(t, repo.Update(context.Background(), char))
+
+	finalized, err := service.FinalizeDraftCharacter(context.Background(), char.ID)
+	require.NoError(t, err)
+
+	assert.True(t, finalized.HasSkillProficiency("skill-deception"))
+	assert.True(t, finalized.HasSkillProficiency("skill-stealth"))
+	assert.Contains(t, proficiencyKeys(finalized, rulebook.ProficiencyTypeTool), "thieves-tools")
+	assert.Equal(t, 15, finalized.Wallet.Get(shared.CoinGold))
+
+	var items []string
+	for _, list := range finalized.Inventory {
+		for _, item := range list {
+			items = append(items, item.GetKey())
+		}
+	}
+	assert.ElementsMatch(t, []string{"crowbar", "clothes-common", "pouch"}, items)
+	require.NotNil(t, finalized.GetBackgroundFeature())
+	assert.Equal(t, "Criminal Contact", finalized.GetBackgroundFeature().Name)
+}
+
+func proficiencyKeys(char *character2.Character, profType rulebook.ProficiencyType) []string {
+	var keys []string
+	for _, prof := range char.Proficiencies[profType] {
+		keys = append(keys, prof.Key)
+	}
+	return keys
+}
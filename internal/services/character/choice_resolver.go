@@ -227,7 +227,7 @@ func (r *choiceResolver) extractEquipmentOptions(options []shared.Option) []Choi
 			if bundleKey == "" {
 				bundleKey = fmt.Sprintf("bundle-%d", len(result))
 			}
-			hasNestedChoice := false
+			var nested *SimplifiedChoice
 			nestedChoiceDesc := ""
 
 			for _, item := range o.Items {
@@ -265,7 +265,7 @@ func (r *choiceResolver) extractEquipmentOptions(options []shared.Option) []Choi
 					}
 				case *shared.Choice:
 					// Handle nested choices like "a martial weapon" or "two martial weapons"
-					hasNestedChoice = true
+					nested = r.nestedChoice(itemRef)
 					if itemRef.Count > 1 {
 						names = append(names, fmt.Sprintf("%d %s", itemRef.Count, itemRef.Name))
 						nestedChoiceDesc = fmt.Sprintf("Choose %d %s", itemRef.Count, itemRef.Name)
@@ -283,13 +283,14 @@ func (r *choiceResolver) extractEquipmentOptions(options []shared.Option) []Choi
 				}
 
 				// If this bundle contains nested choices, mark it specially
-				if hasNestedChoice {
+				if nested != nil {
 					choiceOpt.Key = fmt.Sprintf("nested-%d", len(result))
 					if nestedChoiceDesc != "" {
 						choiceOpt.Description = nestedChoiceDesc
 					}
 					// Add bundle items to the nested choice
 					choiceOpt.BundleItems = bundleItems
+					choiceOpt.Nested = nested
 				} else if len(descriptions) > 0 {
 					choiceOpt.Description = strings.Join(descriptions, ", ")
 				}
@@ -304,6 +305,7 @@ func (r *choiceResolver) extractEquipmentOptions(options []shared.Option) []Choi
 					Key:         fmt.Sprintf("nested-%d", len(result)),
 					Name:        o.Name,
 					Description: fmt.Sprintf("Choose %d from %s", o.Count, o.Name),
+					Nested:      r.nestedChoice(o),
 				})
 			}
 		}
@@ -312,6 +314,17 @@ func (r *choiceResolver) extractEquipmentOptions(options []shared.Option) []Choi
 	return result
 }
 
+// nestedChoice simplifies a choice made inside an equipment option, like
+// the martial weapon in "a martial weapon and a shield"
+func (r *choiceResolver) nestedChoice(choice *shared.Choice) *SimplifiedChoice {
+	return &SimplifiedChoice{
+		Name:    choice.Name,
+		Type:    "equipment",
+		Choose:  max(choice.Count, 1),
+		Options: r.extractOptions(choice.Options),
+	}
+}
+
 // joinWithAnd joins strings with commas and "and" before the last item
 func joinWithAnd(items []string) string {
 	if len(items) == 0 {
@@ -448,3 +461,33 @@ func (r *choiceResolver) filterDuplicateProficiencies(options []ChoiceOption, ex
 	}
 	return filtered
 }
+
+// withoutBackgroundProficiencies removes the proficiencies a background grants
+// from the options of proficiency choices
+func withoutBackgroundProficiencies(choices []SimplifiedChoice, background *rulebook.Background) []SimplifiedChoice {
+	if background == nil {
+		return choices
+	}
+
+	granted := make(map[string]bool)
+	for _, prof := range background.SkillProficiencies {
+		granted[prof.Key] = true
+	}
+	for _, prof := range background.ToolProficiencies {
+		granted[prof.Key] = true
+	}
+
+	for i, choice := range choices {
+		if choice.Type != string(shared.ChoiceTypeProficiency) {
+			continue
+		}
+		options := make([]ChoiceOption, 0, len(choice.Options))
+		for _, opt := range choice.Options {
+			if !granted[opt.Key] {
+				options = append(options, opt)
+			}
+		}
+		choices[i].Options = options
+	}
+	return choices
+}
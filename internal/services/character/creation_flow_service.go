@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
@@ -14,13 +15,21 @@ import (
 type CreationFlowServiceImpl struct {
 	characterService Service
 	flowBuilder      character.FlowBuilder
+	diceRoller       dice.Roller // Rolls on the personality tables
 }
 
 // NewCreationFlowService creates a new creation flow service
 func NewCreationFlowService(characterService Service, flowBuilder character.FlowBuilder) character.CreationFlowService {
+	return NewCreationFlowServiceWithRoller(characterService, flowBuilder, dice.NewRandomRoller())
+}
+
+// NewCreationFlowServiceWithRoller creates a creation flow service that rolls
+// personality traits with the given dice roller
+func NewCreationFlowServiceWithRoller(characterService Service, flowBuilder character.FlowBuilder, roller dice.Roller) character.CreationFlowService {
 	return &CreationFlowServiceImpl{
 		characterService: characterService,
 		flowBuilder:      flowBuilder,
+		diceRoller:       roller,
 	}
 }
 
@@ -185,8 +194,15 @@ func (s *CreationFlowServiceImpl) isStepComplete(char *character.Character, step
 		// Check if character has completed domain-specific skill selection
 		return s.hasCompletedDomainSkills(char)
 	case character.StepTypeLanguageSelection:
+		if isBackgroundStep(step.Context) {
+			return s.hasCompletedBackgroundLanguages(char)
+		}
 		// Check if character has completed domain-specific language selection
 		return s.hasCompletedDomainLanguages(char)
+	case character.StepTypeBackgroundSelection:
+		return char.Background != nil
+	case character.StepTypePersonalitySelection:
+		return char.Personality.IsComplete()
 	case character.StepTypeFightingStyleSelection:
 		return s.hasSelectedFightingStyle(char)
 	case character.StepTypeDivineDomainSelection:
@@ -209,8 +225,10 @@ func (s *CreationFlowServiceImpl) isStepComplete(char *character.Character, step
 	// Spellcaster steps
 	case character.StepTypeCantripsSelection:
 		return s.hasSelectedCantrips(char)
-	case character.StepTypeSpellSelection, character.StepTypeSpellbookSelection:
+	case character.StepTypeSpellSelection, character.StepTypeSpellbookSelection, character.StepTypeSpellsKnownSelection:
 		return s.hasSelectedSpells(char)
+	case character.StepTypeExpertiseSelection:
+		return s.hasSelectedExpertise(char)
 
 	// Subclass steps
 	case character.StepTypeSubclassSelection, character.StepTypePatronSelection, character.StepTypeSorcerousOriginSelection:
@@ -408,8 +426,22 @@ func (s *CreationFlowServiceImpl) hasSelectedSpells(char *character.Character) b
 }
 
 func (s *CreationFlowServiceImpl) hasSelectedSubclass(char *character.Character) bool {
-	// TODO: Implement when we have subclass tracking
-	// For now, return false to show the step
+	return char.GetSubclassKey() != ""
+}
+
+func (s *CreationFlowServiceImpl) hasSelectedExpertise(char *character.Character) bool {
+	for _, feature := range char.Features {
+		if feature.Key != "expertise" || feature.Metadata == nil {
+			continue
+		}
+		// Check both []string and []interface{} since JSON unmarshaling can produce either
+		if skills, ok := feature.Metadata["skills"].([]string); ok && len(skills) > 0 {
+			return true
+		}
+		if skills, ok := feature.Metadata["skills"].([]any); ok && len(skills) > 0 {
+			return true
+		}
+	}
 	return false
 }
 
@@ -426,17 +458,40 @@ func (s *CreationFlowServiceImpl) applyStepResult(ctx context.Context, character
 	case character.StepTypeClassSelection:
 		return s.applyClassSelection(ctx, char, result)
 	case character.StepTypeSkillSelection:
-		return s.applySkillSelection(char, result)
+		return s.applySkillSelection(ctx, char, result)
 	case character.StepTypeLanguageSelection:
-		return s.applyLanguageSelection(char, result)
+		if isBackgroundStep(result.Metadata) {
+			return s.applyBackgroundLanguages(ctx, char, result)
+		}
+		return s.applyLanguageSelection(ctx, char, result)
+	case character.StepTypeBackgroundSelection:
+		return s.applyBackgroundSelection(ctx, char, result)
+	case character.StepTypePersonalitySelection:
+		return s.applyPersonalitySelection(ctx, char, result)
 	case character.StepTypeCantripsSelection:
-		return s.applyCantripSelection(char, result)
+		return s.applyCantripSelection(ctx, char, result)
 	case character.StepTypeSpellSelection, character.StepTypeSpellbookSelection, character.StepTypeSpellsKnownSelection:
-		return s.applySpellSelection(char, result)
+		return s.applySpellSelection(ctx, char, result)
 	case character.StepTypeProficiencySelection:
-		return s.applyProficiencySelection(char, result)
+		return s.applyProficiencySelection(ctx, char, result)
 	case character.StepTypeEquipmentSelection:
-		return s.applyEquipmentSelection(char, result)
+		return s.applyEquipmentSelection(ctx, char, result)
+	case character.StepTypeFightingStyleSelection:
+		className := ""
+		if char.Class != nil {
+			className = char.Class.Key
+		}
+		return s.applyFeatureChoice(ctx, char, result, rulebook.GetFightingStyleChoice(className), "style")
+	case character.StepTypeDivineDomainSelection:
+		return s.applyFeatureChoice(ctx, char, result, rulebook.GetDivineDomainChoice(), "domain")
+	case character.StepTypeFavoredEnemySelection:
+		return s.applyFeatureChoice(ctx, char, result, rulebook.GetFavoredEnemyChoice(), "enemy_type")
+	case character.StepTypeNaturalExplorerSelection:
+		return s.applyFeatureChoice(ctx, char, result, rulebook.GetNaturalExplorerChoice(), "terrain_type")
+	case character.StepTypeSubclassSelection, character.StepTypePatronSelection, character.StepTypeSorcerousOriginSelection:
+		return s.applySubclassSelection(ctx, char, result)
+	case character.StepTypeExpertiseSelection:
+		return s.applyExpertiseSelection(ctx, char, result)
 	// Add other step result handlers as needed
 	default:
 		// For steps handled by existing handlers, we don't need to do anything here
@@ -444,7 +499,7 @@ func (s *CreationFlowServiceImpl) applyStepResult(ctx context.Context, character
 	}
 }
 
-func (s *CreationFlowServiceImpl) applySkillSelection(char *character.Character, result *character.CreationStepResult) error {
+func (s *CreationFlowServiceImpl) applySkillSelection(ctx context.Context, char *character.Character, result *character.CreationStepResult) error {
 	// Find the divine domain feature and add bonus skills
 	for _, feature := range char.Features {
 		if feature.Key != "divine_domain" {
@@ -473,10 +528,10 @@ func (s *CreationFlowServiceImpl) applySkillSelection(char *character.Character,
 	}
 
 	// Save the character
-	return s.characterService.UpdateEquipment(char)
+	return s.characterService.UpdateEquipment(ctx, char)
 }
 
-func (s *CreationFlowServiceImpl) applyLanguageSelection(char *character.Character, result *character.CreationStepResult) error {
+func (s *CreationFlowServiceImpl) applyLanguageSelection(ctx context.Context, char *character.Character, result *character.CreationStepResult) error {
 	// Find the divine domain feature and add bonus languages
 	for _, feature := range char.Features {
 		if feature.Key != "divine_domain" {
@@ -494,7 +549,7 @@ func (s *CreationFlowServiceImpl) applyLanguageSelection(char *character.Charact
 	}
 
 	// Save the character
-	return s.characterService.UpdateEquipment(char)
+	return s.characterService.UpdateEquipment(ctx, char)
 }
 
 func (s *CreationFlowServiceImpl) applyRaceSelection(ctx context.Context, char *character.Character, result *character.CreationStepResult) error {
@@ -551,7 +606,7 @@ func (s *CreationFlowServiceImpl) applyClassSelection(ctx context.Context, char
 			}
 
 			// Save the updated character with initialized Spells
-			err = s.characterService.UpdateEquipment(updatedChar)
+			err = s.characterService.UpdateEquipment(ctx, updatedChar)
 			if err != nil {
 				return fmt.Errorf("failed to initialize spells for character: %w", err)
 			}
@@ -577,7 +632,7 @@ func isSpellcastingClass(classKey string) bool {
 	return spellcastingClasses[classKey]
 }
 
-func (s *CreationFlowServiceImpl) applyCantripSelection(char *character.Character, result *character.CreationStepResult) error {
+func (s *CreationFlowServiceImpl) applyCantripSelection(ctx context.Context, char *character.Character, result *character.CreationStepResult) error {
 	// Get a fresh copy of the character to ensure we have the latest state
 	freshChar, err := s.characterService.GetByID(char.ID)
 	if err != nil {
@@ -608,10 +663,10 @@ func (s *CreationFlowServiceImpl) applyCantripSelection(char *character.Characte
 	freshChar.Features = append(freshChar.Features, confirmationFeature)
 
 	// Save the character
-	return s.characterService.UpdateEquipment(freshChar)
+	return s.characterService.UpdateEquipment(ctx, freshChar)
 }
 
-func (s *CreationFlowServiceImpl) applySpellSelection(char *character.Character, result *character.CreationStepResult) error {
+func (s *CreationFlowServiceImpl) applySpellSelection(ctx context.Context, char *character.Character, result *character.CreationStepResult) error {
 	// Get a fresh copy of the character to ensure we have the latest state
 	freshChar, err := s.characterService.GetByID(char.ID)
 	if err != nil {
@@ -645,10 +700,10 @@ func (s *CreationFlowServiceImpl) applySpellSelection(char *character.Character,
 	freshChar.Features = append(freshChar.Features, confirmationFeature)
 
 	// Save the character
-	return s.characterService.UpdateEquipment(freshChar)
+	return s.characterService.UpdateEquipment(ctx, freshChar)
 }
 
-func (s *CreationFlowServiceImpl) applyProficiencySelection(char *character.Character, result *character.CreationStepResult) error {
+func (s *CreationFlowServiceImpl) applyProficiencySelection(ctx context.Context, char *character.Character, result *character.CreationStepResult) error {
 	// Get a fresh copy of the character to ensure we have the latest state
 	freshChar, err := s.characterService.GetByID(char.ID)
 	if err != nil {
@@ -671,10 +726,10 @@ func (s *CreationFlowServiceImpl) applyProficiencySelection(char *character.Char
 	freshChar.Features = append(freshChar.Features, proficiencyFeature)
 
 	// Save the character
-	return s.characterService.UpdateEquipment(freshChar)
+	return s.characterService.UpdateEquipment(ctx, freshChar)
 }
 
-func (s *CreationFlowServiceImpl) applyEquipmentSelection(char *character.Character, result *character.CreationStepResult) error {
+func (s *CreationFlowServiceImpl) applyEquipmentSelection(ctx context.Context, char *character.Character, result *character.CreationStepResult) error {
 	// Get a fresh copy of the character to ensure we have the latest state
 	freshChar, err := s.characterService.GetByID(char.ID)
 	if err != nil {
@@ -697,5 +752,127 @@ func (s *CreationFlowServiceImpl) applyEquipmentSelection(char *character.Charac
 	freshChar.Features = append(freshChar.Features, equipmentFeature)
 
 	// Save the character
-	return s.characterService.UpdateEquipment(freshChar)
+	return s.characterService.UpdateEquipment(ctx, freshChar)
+}
+
+// applyFeatureChoice records a class feature choice in the feature's metadata,
+// the same way the class features handler does
+func (s *CreationFlowServiceImpl) applyFeatureChoice(ctx context.Context, char *character.Character, result *character.CreationStepResult, choice *rulebook.FeatureChoice, metaKey string) error {
+	if len(result.Selections) == 0 {
+		return fmt.Errorf("no option selected")
+	}
+	if choice == nil {
+		return fmt.Errorf("%s has no %s choice", char.Name, result.StepType)
+	}
+
+	selection := result.Selections[0]
+	var option *rulebook.FeatureOption
+	for i := range choice.Options {
+		if choice.Options[i].Key == selection {
+			option = &choice.Options[i]
+			break
+		}
+	}
+	if option == nil {
+		return fmt.Errorf("unknown %s option: %s", choice.Name, selection)
+	}
+
+	feature := findFeature(char, choice.FeatureKey)
+	if feature == nil {
+		feature = &rulebook.CharacterFeature{
+			Key:   choice.FeatureKey,
+			Name:  choice.Name,
+			Type:  rulebook.FeatureTypeClass,
+			Level: 1,
+		}
+		if char.Class != nil {
+			feature.Source = char.Class.Name
+		}
+		char.Features = append(char.Features, feature)
+	}
+	if feature.Metadata == nil {
+		feature.Metadata = make(map[string]any)
+	}
+	feature.Metadata[metaKey] = option.Key
+	feature.Metadata["selection_display"] = option.Name
+
+	return s.characterService.UpdateEquipment(ctx, char)
+}
+
+// applySubclassSelection records the subclass of a class that picks it at 1st level
+func (s *CreationFlowServiceImpl) applySubclassSelection(ctx context.Context, char *character.Character, result *character.CreationStepResult) error {
+	if len(result.Selections) == 0 {
+		return fmt.Errorf("no subclass selected")
+	}
+	if char.Class == nil {
+		return fmt.Errorf("choose a class before a subclass")
+	}
+
+	subclass, ok := rulebook.GetSubclass(char.Class.Key, result.Selections[0])
+	if !ok {
+		return fmt.Errorf("unknown %s subclass: %s", char.Class.Name, result.Selections[0])
+	}
+
+	features := make([]*rulebook.CharacterFeature, 0, len(char.Features)+1)
+	for _, feature := range char.Features {
+		if feature.Key != "subclass" {
+			features = append(features, feature)
+		}
+	}
+	char.Features = append(features, &rulebook.CharacterFeature{
+		Key:         "subclass",
+		Name:        subclass.Name,
+		Description: subclass.Description,
+		Type:        rulebook.FeatureTypeClass,
+		Level:       char.Level,
+		Source:      char.Class.Name,
+		Metadata: map[string]any{
+			"subclass": subclass.Key,
+		},
+	})
+
+	return s.characterService.UpdateEquipment(ctx, char)
+}
+
+// applyExpertiseSelection records the skills a rogue or bard doubles their proficiency bonus for
+func (s *CreationFlowServiceImpl) applyExpertiseSelection(ctx context.Context, char *character.Character, result *character.CreationStepResult) error {
+	if len(result.Selections) == 0 {
+		return fmt.Errorf("no expertise selected")
+	}
+	for _, key := range result.Selections {
+		if !char.HasSkillProficiency(key) {
+			return fmt.Errorf("expertise requires proficiency in %s", key)
+		}
+	}
+
+	feature := findFeature(char, "expertise")
+	if feature == nil {
+		feature = &rulebook.CharacterFeature{
+			Key:         "expertise",
+			Name:        "Expertise",
+			Description: "Your proficiency bonus is doubled for ability checks using the chosen skills.",
+			Type:        rulebook.FeatureTypeClass,
+			Level:       char.Level,
+		}
+		if char.Class != nil {
+			feature.Source = char.Class.Name
+		}
+		char.Features = append(char.Features, feature)
+	}
+	if feature.Metadata == nil {
+		feature.Metadata = make(map[string]any)
+	}
+	feature.Metadata["skills"] = result.Selections
+
+	return s.characterService.UpdateEquipment(ctx, char)
+}
+
+// findFeature returns the character's feature with the given key, or nil
+func findFeature(char *character.Character, key string) *rulebook.CharacterFeature {
+	for _, feature := range char.Features {
+		if feature != nil && feature.Key == key {
+			return feature
+		}
+	}
+	return nil
 }
@@ -0,0 +1,193 @@
+package character
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+)
+
+// PersonalityRoll is the personality step selection that rolls every table
+// that hasn't been picked from yet. "roll-<table>" rerolls a single table.
+const PersonalityRoll = "roll"
+
+// isBackgroundStep checks if a step context or result metadata belongs to
+// the background rather than a class feature
+func isBackgroundStep(meta map[string]any) bool {
+	source, ok := meta["source"].(string)
+	return ok && source == BackgroundLanguageSource
+}
+
+func (s *CreationFlowServiceImpl) hasCompletedBackgroundLanguages(char *character.Character) bool {
+	if char.Background == nil {
+		return false
+	}
+	return len(char.GetBackgroundLanguages()) >= char.Background.Languages
+}
+
+// applyBackgroundSelection sets the background and the feature it grants.
+// Its proficiencies, equipment and gold are added when the character is finalized.
+func (s *CreationFlowServiceImpl) applyBackgroundSelection(ctx context.Context, char *character.Character, result *character.CreationStepResult) error {
+	if len(result.Selections) == 0 {
+		return fmt.Errorf("no background selected")
+	}
+
+	background := rulebook.GetSRDBackground(result.Selections[0])
+	if background == nil {
+		return fmt.Errorf("unknown background: %s", result.Selections[0])
+	}
+	char.SetBackground(background)
+
+	return s.characterService.UpdateEquipment(ctx, char)
+}
+
+// applyBackgroundLanguages records the languages the background lets the character pick
+func (s *CreationFlowServiceImpl) applyBackgroundLanguages(ctx context.Context, char *character.Character, result *character.CreationStepResult) error {
+	if char.Background == nil {
+		return fmt.Errorf("choose a background before its languages")
+	}
+	if len(result.Selections) != char.Background.Languages {
+		return fmt.Errorf("choose %d language(s) for the %s background", char.Background.Languages, char.Background.Name)
+	}
+
+	seen := make(map[string]bool)
+	for _, key := range result.Selections {
+		if _, ok := rulebook.GetLanguage(key); !ok {
+			return fmt.Errorf("unknown language: %s", key)
+		}
+		if seen[key] {
+			return fmt.Errorf("%s was chosen twice", key)
+		}
+		seen[key] = true
+	}
+
+	feature := char.GetBackgroundFeature()
+	if feature == nil {
+		char.SetBackground(char.Background)
+		feature = char.GetBackgroundFeature()
+	}
+	if feature.Metadata == nil {
+		feature.Metadata = make(map[string]any)
+	}
+	feature.Metadata["languages"] = result.Selections
+
+	return s.characterService.UpdateEquipment(ctx, char)
+}
+
+// applyPersonalitySelection records picks from the background's personality
+// tables. Picks replace what was chosen from their table before; "roll" fills
+// in every table still missing picks and "roll-<table>" rerolls one table.
+func (s *CreationFlowServiceImpl) applyPersonalitySelection(ctx context.Context, char *character.Character, result *character.CreationStepResult) error {
+	background := char.Background
+	if background == nil {
+		return fmt.Errorf("choose a background before its personality")
+	}
+	if len(result.Selections) == 0 {
+		return fmt.Errorf("no personality selected")
+	}
+
+	if char.Personality == nil {
+		char.Personality = &character.Personality{}
+	}
+	personality := char.Personality
+
+	picks := make(map[rulebook.PersonalityTable][]string)
+	for _, selection := range result.Selections {
+		if selection == PersonalityRoll {
+			for _, table := range rulebook.PersonalityTables {
+				if personality.IsTableComplete(table) {
+					continue
+				}
+				if err := s.rollPersonality(personality, background, table); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if tableKey, ok := strings.CutPrefix(selection, PersonalityRoll+"-"); ok {
+			table, err := personalityTable(tableKey)
+			if err != nil {
+				return err
+			}
+			if err := s.rollPersonality(personality, background, table); err != nil {
+				return err
+			}
+			continue
+		}
+
+		table, entry, err := personalityEntry(background, selection)
+		if err != nil {
+			return err
+		}
+		picks[table] = append(picks[table], entry)
+	}
+
+	for table, entries := range picks {
+		if len(entries) != table.Picks() {
+			return fmt.Errorf("choose %d from %s", table.Picks(), table.DisplayName())
+		}
+		if len(entries) == 2 && entries[0] == entries[1] {
+			return fmt.Errorf("choose two different %s", strings.ToLower(table.DisplayName()))
+		}
+		personality.Set(table, entries)
+	}
+
+	return s.characterService.UpdateEquipment(ctx, char)
+}
+
+// rollPersonality rolls a table's picks, without rolling the same entry twice
+func (s *CreationFlowServiceImpl) rollPersonality(personality *character.Personality, background *rulebook.Background, table rulebook.PersonalityTable) error {
+	remaining := append([]string(nil), background.Table(table)...)
+	if len(remaining) < table.Picks() {
+		return fmt.Errorf("%s has no %s table", background.Name, table.DisplayName())
+	}
+
+	entries := make([]string, 0, table.Picks())
+	for len(entries) < table.Picks() {
+		roll, err := s.diceRoller.Roll(1, len(remaining), 0)
+		if err != nil {
+			return fmt.Errorf("failed to roll %s: %w", table.DisplayName(), err)
+		}
+		index := roll.Total - 1
+		if index < 0 || index >= len(remaining) {
+			index = 0
+		}
+		entries = append(entries, remaining[index])
+		remaining = append(remaining[:index], remaining[index+1:]...)
+	}
+
+	personality.Set(table, entries)
+	return nil
+}
+
+// personalityEntry looks up a personality option key, like "trait-3"
+func personalityEntry(background *rulebook.Background, key string) (rulebook.PersonalityTable, string, error) {
+	i := strings.LastIndex(key, "-")
+	if i < 0 {
+		return "", "", fmt.Errorf("unknown personality option: %s", key)
+	}
+
+	table, err := personalityTable(key[:i])
+	if err != nil {
+		return "", "", err
+	}
+	n, err := strconv.Atoi(key[i+1:])
+	entries := background.Table(table)
+	if err != nil || n < 1 || n > len(entries) {
+		return "", "", fmt.Errorf("unknown personality option: %s", key)
+	}
+	return table, entries[n-1], nil
+}
+
+func personalityTable(key string) (rulebook.PersonalityTable, error) {
+	for _, table := range rulebook.PersonalityTables {
+		if string(table) == key {
+			return table, nil
+		}
+	}
+	return "", fmt.Errorf("unknown personality table: %s", key)
+}
@@ -235,9 +235,15 @@ func (s *EquipmentChoiceResolverTestSuite) TestFighterEquipmentChoices_Complete(
 	s.Contains(choices[1].Options[0].Key, "nested")
 	s.Contains(choices[1].Options[0].Name, "martial weapon")
 	s.Contains(choices[1].Options[0].Description, "Choose")
+	s.Require().NotNil(choices[1].Options[0].Nested)
+	s.Equal(1, choices[1].Options[0].Nested.Choose)
+	s.Len(choices[1].Options[0].Nested.Options, 2)
+	s.Equal("longsword", choices[1].Options[0].Nested.Options[0].Key)
 	// Second option should also be nested (choose 2 weapons)
 	s.Contains(choices[1].Options[1].Key, "nested")
 	s.Contains(choices[1].Options[1].Name, "two martial weapons")
+	s.Require().NotNil(choices[1].Options[1].Nested)
+	s.Equal(2, choices[1].Options[1].Nested.Choose)
 
 	// Choice 3 - Ranged weapon choice
 	s.Equal("fighter-equip-2", choices[2].ID)
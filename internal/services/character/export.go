@@ -0,0 +1,541 @@
+package character
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/feats"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/features"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	characterRepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+)
+
+const (
+	// ExportFormat identifies character export files
+	ExportFormat = "dnd-bot-character"
+
+	// ExportVersion is the version of the export envelope written by
+	// ExportCharacter. The character inside carries its own schema_version.
+	ExportVersion = 1
+
+	// maxImportCoins caps each kind of coin an imported character can bring
+	maxImportCoins = 100000
+
+	// maxImportQuantity caps the size of an imported stack of consumables
+	maxImportQuantity = 100
+)
+
+// CharacterExport is the envelope of an exported character file,
+// documented in docs/character-export.md
+type CharacterExport struct {
+	Format     string          `json:"format"`
+	Version    int             `json:"version"`
+	ExportedAt time.Time       `json:"exported_at"`
+	Character  json.RawMessage `json:"character"` // Same JSON the character repository stores
+}
+
+// ImportCharacterInput contains an exported character file to import
+type ImportCharacterInput struct {
+	UserID  string
+	RealmID string
+	Data    []byte
+}
+
+// ExportCharacter serializes one of the user's characters to the export format
+func (s *service) ExportCharacter(ctx context.Context, characterID, userID string) ([]byte, error) {
+	char, err := s.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if char.OwnerID != userID {
+		return nil, dnderr.PermissionDenied("you can only export your own characters").
+			WithMeta("character_id", characterID)
+	}
+	if char.Status == shared.CharacterStatusDraft {
+		return nil, dnderr.InvalidArgument("finish creating the character before exporting it").
+			WithMeta("character_id", characterID)
+	}
+
+	raw, err := characterRepo.MarshalCharacter(char)
+	if err != nil {
+		return nil, dnderr.Wrap(err, "failed to serialize character").
+			WithMeta("character_id", characterID)
+	}
+
+	data, err := json.MarshalIndent(&CharacterExport{
+		Format:     ExportFormat,
+		Version:    ExportVersion,
+		ExportedAt: time.Now().UTC(),
+		Character:  raw,
+	}, "", "  ")
+	if err != nil {
+		return nil, dnderr.Wrap(err, "failed to serialize export").
+			WithMeta("character_id", characterID)
+	}
+	return data, nil
+}
+
+// ImportCharacter validates an exported character against the rulebook and
+// saves it as a new character owned by the user
+func (s *service) ImportCharacter(ctx context.Context, input *ImportCharacterInput) (*character.Character, error) {
+	if err := ValidateInput(input); err != nil {
+		return nil, err
+	}
+
+	var export CharacterExport
+	if err := json.Unmarshal(input.Data, &export); err != nil {
+		return nil, dnderr.InvalidArgument("the file isn't a character export")
+	}
+	if export.Format != ExportFormat || len(export.Character) == 0 {
+		return nil, dnderr.InvalidArgument("the file isn't a character export")
+	}
+	if export.Version > ExportVersion {
+		return nil, dnderr.InvalidArgumentf("the export is version %d; this bot reads up to version %d", export.Version, ExportVersion)
+	}
+
+	char, err := characterRepo.LoadCharacter(ctx, export.Character, s)
+	if err != nil {
+		return nil, dnderr.WrapWithCode(err, dnderr.CodeInvalidArgument, "the exported character can't be read")
+	}
+
+	if err := s.validateImport(ctx, char); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.ListCharacters(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range existing {
+		if strings.EqualFold(other.Name, char.Name) && other.Status != shared.CharacterStatusDraft {
+			return nil, dnderr.AlreadyExistsf("you already have a character named %s", char.Name)
+		}
+	}
+
+	// The import is a new character; nothing ties it to the server it came from
+	char.ID = generateID()
+	char.OwnerID = input.UserID
+	char.RealmID = input.RealmID
+	char.LevelUp = nil
+	// Spell slots, hit dice and ability uses come from the rulebook, never the file
+	char.InitializeResources()
+
+	if err := s.repository.Create(ctx, char); err != nil {
+		return nil, dnderr.Wrap(err, "failed to save imported character").
+			WithMeta("character_name", char.Name).
+			WithMeta("owner_id", input.UserID)
+	}
+	return char, nil
+}
+
+// validateImport checks an imported character, whose race, classes and
+// background are already loaded from the rulebook, against the same rules
+// characters are created and levelled under. Items are reloaded from the
+// rulebook rather than trusted, and the character must pass the audit.
+func (s *service) validateImport(ctx context.Context, char *character.Character) error {
+	if char.Status != shared.CharacterStatusActive {
+		return dnderr.Validationf("only active characters can be imported, got %q", char.Status)
+	}
+	if name := strings.TrimSpace(char.Name); name == "" || len(name) > 50 {
+		return dnderr.Validation("character name must be between 1 and 50 characters")
+	}
+	if char.Level < 1 || char.Level > rulebook.MaxLevel {
+		return dnderr.Validationf("level must be between 1 and %d, got %d", rulebook.MaxLevel, char.Level)
+	}
+	if char.Experience < 0 {
+		return dnderr.Validation("experience can't be negative")
+	}
+	if char.Race == nil || char.Class == nil {
+		return dnderr.Validation("race and class are required")
+	}
+	char.HitDie = char.Class.HitDie
+
+	if err := validateImportClasses(char); err != nil {
+		return err
+	}
+	if err := validateImportFeatures(char); err != nil {
+		return err
+	}
+
+	// Bonuses are derived, so only scores are trusted. The audit checks the range.
+	for _, attr := range shared.Attributes {
+		score := char.Attributes[attr]
+		if score == nil {
+			return dnderr.Validationf("missing ability score for %s", attr.Short())
+		}
+		score.Bonus = (score.Score - 10) / 2
+	}
+
+	wallet := char.Wallet
+	for _, coins := range []int{wallet.Copper, wallet.Silver, wallet.Electrum, wallet.Gold, wallet.Platinum} {
+		if coins < 0 || coins > maxImportCoins {
+			return dnderr.Validationf("each kind of coin must be between 0 and %d", maxImportCoins)
+		}
+	}
+
+	for _, profs := range char.Proficiencies {
+		for _, prof := range profs {
+			if prof == nil {
+				continue
+			}
+			if _, err := s.dndClient.GetProficiency(prof.Key); err != nil {
+				return dnderr.Validationf("unknown proficiency %q", prof.Key)
+			}
+		}
+	}
+
+	if err := s.validateImportItems(ctx, char); err != nil {
+		return err
+	}
+	if err := s.validateImportSpells(char); err != nil {
+		return err
+	}
+
+	// AC is derived from the reloaded items and features, like the bonuses
+	char.RefreshItemEffects()
+	char.AC = s.acCalculator.Calculate(char)
+
+	report := s.audit(char)
+	if !report.Legal() {
+		var problems []string
+		for _, violation := range report.Violations {
+			if violation.Severity == AuditSeverityError {
+				problems = append(problems, violation.Message)
+			}
+		}
+		return dnderr.Validationf("the character breaks the rules: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// validateImportClasses checks the class levels of a multiclass character
+// add up to the character level
+func validateImportClasses(char *character.Character) error {
+	if len(char.Classes) == 0 {
+		return nil
+	}
+
+	first := char.Classes[0]
+	if first == nil || first.Class == nil || first.Class.Key != char.Class.Key {
+		return dnderr.Validationf("the first class must be the starting class, %s", char.Class.Name)
+	}
+
+	seen := make(map[string]bool, len(char.Classes))
+	total := 0
+	for _, classLevel := range char.Classes {
+		if classLevel == nil || classLevel.Class == nil {
+			return dnderr.Validation("class levels must name a class")
+		}
+		key := classLevel.Class.Key
+		if seen[key] {
+			return dnderr.Validationf("class %q is listed twice", key)
+		}
+		seen[key] = true
+		if classLevel.Level < 1 {
+			return dnderr.Validationf("%s level must be at least 1, got %d", key, classLevel.Level)
+		}
+		total += classLevel.Level
+	}
+
+	if total != char.Level {
+		return dnderr.Validationf("class levels add up to %d but the character is level %d", total, char.Level)
+	}
+	return nil
+}
+
+// featureChoiceMetadata is the metadata key each class feature choice is
+// saved under
+var featureChoiceMetadata = map[rulebook.FeatureChoiceType]string{
+	rulebook.FeatureChoiceTypeFightingStyle:   "style",
+	rulebook.FeatureChoiceTypeDivineDomain:    "domain",
+	rulebook.FeatureChoiceTypeFavoredEnemy:    "enemy_type",
+	rulebook.FeatureChoiceTypeNaturalExplorer: "terrain_type",
+}
+
+// validateImportFeatures rebuilds the character's features from its race,
+// class levels, subclasses, background and feats. Only the choices saved on
+// a feature, like a fighting style, come from the file, and they must be
+// options the rulebook offers. Features nothing grants are rejected.
+func validateImportFeatures(char *character.Character) error {
+	imported := make(map[string]*rulebook.CharacterFeature, len(char.Features))
+	var subclasses, featFeatures []*rulebook.CharacterFeature
+	for _, feature := range char.Features {
+		switch {
+		case feature == nil || feature.Type == "marker":
+			// Creation markers only matter while the character is a draft
+		case feature.Key == "subclass":
+			subclasses = append(subclasses, feature)
+		case feature.Type == rulebook.FeatureTypeFeat:
+			featFeatures = append(featFeatures, feature)
+		default:
+			imported[feature.Key] = feature
+		}
+	}
+
+	rebuilt := make([]*rulebook.CharacterFeature, 0, len(char.Features))
+	granted := make(map[string]*rulebook.CharacterFeature)
+	grant := func(feature rulebook.CharacterFeature) {
+		if _, ok := granted[feature.Key]; ok {
+			return
+		}
+		if existing, ok := imported[feature.Key]; ok {
+			feature.Metadata = existing.Metadata
+			delete(imported, feature.Key)
+		}
+		granted[feature.Key] = &feature
+		rebuilt = append(rebuilt, &feature)
+	}
+
+	for _, feature := range features.GetRacialFeatures(char.Race.Key) {
+		grant(feature)
+	}
+	classLevels := char.ClassLevels()
+	classNames := make(map[string]string, len(classLevels))
+	for _, classLevel := range classLevels {
+		classNames[classLevel.Class.Key] = classLevel.Class.Name
+		for _, feature := range features.GetClassFeatures(classLevel.Class.Key, classLevel.Level) {
+			grant(feature)
+		}
+	}
+
+	// Each class keeps its subclass on its own "subclass" feature; clerics
+	// keep theirs on Divine Domain, which is checked with the other choices
+	chosen := make(map[string]bool)
+	for _, feature := range subclasses {
+		subclassKey, _ := feature.Metadata["subclass"].(string)
+		classKey, _ := feature.Metadata["class"].(string)
+		if classKey == "" {
+			classKey = char.Class.Key
+		}
+		subclass, ok := rulebook.GetSubclass(classKey, subclassKey)
+		if !ok || chosen[classKey] || char.LevelInClass(classKey) < rulebook.SubclassLevel(classKey) {
+			return dnderr.Validationf("a %s can't have the %q subclass", char.ClassSummary(), subclassKey)
+		}
+		chosen[classKey] = true
+		rebuilt = append(rebuilt, &rulebook.CharacterFeature{
+			Key:         "subclass",
+			Name:        subclass.Name,
+			Description: subclass.Description,
+			Type:        rulebook.FeatureTypeClass,
+			Level:       rulebook.SubclassLevel(classKey),
+			Source:      classNames[classKey],
+			Metadata:    map[string]any{"subclass": subclass.Key, "class": classKey},
+		})
+	}
+
+	for _, classLevel := range classLevels {
+		classKey := classLevel.Class.Key
+		for _, choice := range rulebook.GetClassFeatureChoices(classKey, classLevel.Level) {
+			feature, ok := granted[choice.FeatureKey]
+			if !ok || feature.Metadata == nil {
+				continue
+			}
+			selected, ok := feature.Metadata[featureChoiceMetadata[choice.Type]]
+			if !ok {
+				continue
+			}
+			if !slices.ContainsFunc(choice.Options, func(option rulebook.FeatureOption) bool { return option.Key == selected }) {
+				return dnderr.Validationf("%v isn't a %s option", selected, choice.Name)
+			}
+		}
+		for _, feature := range features.GetSubclassFeatures(classKey, char.SubclassKeyFor(classKey), classLevel.Level) {
+			grant(feature)
+		}
+	}
+
+	if char.Background != nil {
+		if background := rulebook.GetSRDBackground(char.Background.ID); background != nil {
+			feature := rulebook.CharacterFeature{
+				Key:    background.FeatureKey(),
+				Name:   background.Name,
+				Type:   rulebook.FeatureTypeBackground,
+				Source: background.Name,
+			}
+			if background.Feature != nil {
+				feature.Name = background.Feature.Name
+				feature.Description = background.Feature.Description
+			}
+			grant(feature)
+		}
+	}
+
+	taken := make(map[string]bool)
+	for _, feature := range featFeatures {
+		feat, ok := feats.GlobalRegistry.Get(feature.Key)
+		if !ok {
+			return dnderr.Validationf("unknown feat %q", feature.Key)
+		}
+		if taken[feat.Key()] {
+			return dnderr.Validationf("the %s feat is listed twice", feat.Name())
+		}
+		taken[feat.Key()] = true
+		rebuilt = append(rebuilt, &rulebook.CharacterFeature{
+			Key:         feat.Key(),
+			Name:        feat.Name(),
+			Description: feat.Description(),
+			Type:        rulebook.FeatureTypeFeat,
+			Level:       min(max(feature.Level, 1), char.Level),
+			Source:      "Feat",
+			Metadata:    feature.Metadata,
+		})
+	}
+
+	for _, feature := range char.Features {
+		if feature != nil && imported[feature.Key] != nil {
+			return dnderr.Validationf("nothing grants the %s feature", feature.Name)
+		}
+	}
+
+	char.Features = rebuilt
+	return nil
+}
+
+// validateImportItems replaces every carried and equipped item with a fresh
+// copy from the rulebook, so names, damage and magic bonuses can't be forged.
+// Equipped and attuned items must be carried.
+func (s *service) validateImportItems(ctx context.Context, char *character.Character) error {
+	inventory := make(map[equipment.EquipmentType][]equipment.Equipment)
+	carried := make(map[string][]equipment.Equipment)
+	for _, items := range char.Inventory {
+		for _, item := range items {
+			if item == nil {
+				continue
+			}
+			loaded, err := s.importItem(ctx, item)
+			if err != nil {
+				return err
+			}
+			inventory[loaded.GetEquipmentType()] = append(inventory[loaded.GetEquipmentType()], loaded)
+			carried[loaded.GetKey()] = append(carried[loaded.GetKey()], loaded)
+		}
+	}
+	char.Inventory = inventory
+
+	// Each equipped item is a different carried copy while there are copies left
+	equipped := make(map[string]int)
+	for slot, item := range char.EquippedSlots {
+		if item == nil {
+			continue
+		}
+		copies := carried[item.GetKey()]
+		if len(copies) == 0 {
+			return dnderr.Validationf("%s is equipped but not carried", item.GetName())
+		}
+		char.EquippedSlots[slot] = copies[min(equipped[item.GetKey()], len(copies)-1)]
+		equipped[item.GetKey()]++
+	}
+
+	// Attuned holds one entry per attuned copy
+	attuned := make(map[string]int)
+	for _, key := range char.Attuned {
+		copies := carried[key]
+		if len(copies) == 0 {
+			return dnderr.Validationf("attuned to %q, which isn't carried", key)
+		}
+		if magic := equipment.Magic(copies[0]); magic == nil || !magic.RequiresAttunement {
+			return dnderr.Validationf("%s doesn't need attunement", copies[0].GetName())
+		}
+		attuned[key]++
+		if attuned[key] > len(copies) {
+			return dnderr.Validationf("attuned to more copies of %s than are carried", copies[0].GetName())
+		}
+	}
+
+	return nil
+}
+
+// importItem loads an imported item from the rulebook by key, keeping only
+// what the character can change: a stack's quantity and an item's charges
+func (s *service) importItem(ctx context.Context, item equipment.Equipment) (equipment.Equipment, error) {
+	key := item.GetKey()
+
+	if consumable, ok := item.(*equipment.Consumable); ok {
+		if consumable.Quantity < 1 || consumable.Quantity > maxImportQuantity {
+			return nil, dnderr.Validationf("%s quantity must be between 1 and %d", consumable.GetName(), maxImportQuantity)
+		}
+		if spellKey := equipment.ScrollSpell(key); spellKey != "" {
+			spell, err := s.GetSpell(ctx, spellKey)
+			if err != nil || spell == nil {
+				return nil, dnderr.Validationf("unknown spell scroll %q", key)
+			}
+			scroll := equipment.NewSpellScroll(spell.Key, spell.Name, spell.Level)
+			scroll.Quantity = consumable.Quantity
+			return scroll, nil
+		}
+		if loaded := equipment.NewSRDConsumable(key, consumable.Quantity); loaded != nil {
+			return loaded, nil
+		}
+		return nil, dnderr.Validationf("unknown item %q", key)
+	}
+
+	if loaded := equipment.NewSRDMagicItem(key); loaded != nil {
+		if magic := equipment.Magic(item); magic != nil {
+			loaded.Magic.Charges = min(max(magic.Charges, 0), loaded.Magic.MaxCharges)
+		}
+		return loaded, nil
+	}
+
+	// +X weapons and armor are keyed by their mundane item, e.g. longsword-plus-1
+	if idx := strings.LastIndex(key, "-plus-"); idx != -1 {
+		bonus, err := strconv.Atoi(key[idx+len("-plus-"):])
+		if err == nil && bonus >= 1 && bonus <= 3 {
+			base, err := s.dndClient.GetEquipment(key[:idx])
+			if err == nil {
+				switch base := base.(type) {
+				case *equipment.Weapon:
+					return equipment.NewMagicWeapon(base, bonus), nil
+				case *equipment.Armor:
+					return equipment.NewMagicArmor(base, bonus), nil
+				}
+			}
+		}
+		return nil, dnderr.Validationf("unknown item %q", key)
+	}
+
+	loaded, err := s.dndClient.GetEquipment(key)
+	if err != nil || loaded == nil {
+		return nil, dnderr.Validationf("unknown item %q", key)
+	}
+	return loaded, nil
+}
+
+// validateImportSpells checks every spell is on the spell list of one of the
+// character's classes, at a level that class can cast
+func (s *service) validateImportSpells(char *character.Character) error {
+	if char.Spells == nil {
+		return nil
+	}
+
+	for _, list := range [][]string{char.Spells.Cantrips, char.Spells.KnownSpells, char.Spells.PreparedSpells} {
+		for _, key := range list {
+			spell, err := s.dndClient.GetSpell(key)
+			if err != nil || spell == nil {
+				return dnderr.Validationf("unknown spell %q", key)
+			}
+
+			castable := false
+			for _, classLevel := range char.ClassLevels() {
+				classKey := classLevel.Class.Key
+				subclassKey := char.SubclassKeyFor(classKey)
+				if slices.Contains(spell.Classes, rulebook.SpellListClass(classKey, subclassKey)) &&
+					spell.Level <= rulebook.MaxSpellLevel(classKey, subclassKey, classLevel.Level) {
+					castable = true
+					break
+				}
+			}
+			if !castable {
+				return dnderr.Validationf("a %s can't know %s", char.ClassSummary(), spell.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+package character_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	mockdnd5e "github.com/KirkDiggler/dnd-bot-discord/internal/clients/dnd5e/mock"
+	charDomain "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/effects"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	mockdraftrepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft/mock"
+	mockrepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type exportDeps struct {
+	service   character.Service
+	dndClient *mockdnd5e.MockClient
+	repo      *mockrepo.MockRepository
+}
+
+func setupExport(t *testing.T) *exportDeps {
+	ctrl := gomock.NewController(t)
+	deps := &exportDeps{
+		dndClient: mockdnd5e.NewMockClient(ctrl),
+		repo:      mockrepo.NewMockRepository(ctrl),
+	}
+	deps.service = character.NewService(&character.ServiceConfig{
+		DNDClient:       deps.dndClient,
+		Repository:      deps.repo,
+		DraftRepository: mockdraftrepo.NewMockRepository(ctrl),
+	})
+	return deps
+}
+
+func exportableCharacter() *charDomain.Character {
+	return &charDomain.Character{
+		ID:               "char_1",
+		OwnerID:          "user_1",
+		RealmID:          "guild_1",
+		Name:             "Thorin",
+		Level:            2,
+		Status:           shared.CharacterStatusActive,
+		Race:             &rulebook.Race{Key: "dwarf", Name: "Dwarf"},
+		Class:            &rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10},
+		HitDie:           10,
+		MaxHitPoints:     20,
+		CurrentHitPoints: 14,
+		AC:               16,
+		Attributes: map[shared.Attribute]*charDomain.AbilityScore{
+			shared.AttributeStrength:     {Score: 16, Bonus: 3},
+			shared.AttributeDexterity:    {Score: 12, Bonus: 1},
+			shared.AttributeConstitution: {Score: 14, Bonus: 2},
+			shared.AttributeIntelligence: {Score: 10},
+			shared.AttributeWisdom:       {Score: 11},
+			shared.AttributeCharisma:     {Score: 8, Bonus: -1},
+		},
+		Proficiencies: map[rulebook.ProficiencyType][]*rulebook.Proficiency{
+			rulebook.ProficiencyTypeSkill: {{Key: "skill-athletics", Name: "Skill: Athletics", Type: rulebook.ProficiencyTypeSkill}},
+		},
+		Wallet: shared.Wallet{Gold: 12},
+	}
+}
+
+// export runs ExportCharacter on the character and returns the file
+func export(t *testing.T, char *charDomain.Character) []byte {
+	deps := setupExport(t)
+	deps.repo.EXPECT().Get(gomock.Any(), char.ID).Return(char, nil)
+
+	data, err := deps.service.ExportCharacter(context.Background(), char.ID, char.OwnerID)
+	require.NoError(t, err)
+	return data
+}
+
+// expectRulebook makes the character's race, class and proficiencies resolve
+func expectRulebook(deps *exportDeps) {
+	deps.dndClient.EXPECT().GetRace("dwarf").Return(&rulebook.Race{Key: "dwarf", Name: "Dwarf", Speed: 25}, nil).AnyTimes()
+	deps.dndClient.EXPECT().GetClass("fighter").Return(&rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10}, nil).AnyTimes()
+	deps.dndClient.EXPECT().GetProficiency("skill-athletics").Return(&rulebook.Proficiency{Key: "skill-athletics"}, nil).AnyTimes()
+}
+
+func TestExportCharacter(t *testing.T) {
+	data := export(t, exportableCharacter())
+
+	var file character.CharacterExport
+	require.NoError(t, json.Unmarshal(data, &file))
+	assert.Equal(t, character.ExportFormat, file.Format)
+	assert.Equal(t, character.ExportVersion, file.Version)
+	assert.Contains(t, string(file.Character), `"class_key": "fighter"`)
+
+	t.Run("only the owner can export", func(t *testing.T) {
+		deps := setupExport(t)
+		char := exportableCharacter()
+		deps.repo.EXPECT().Get(gomock.Any(), char.ID).Return(char, nil)
+
+		_, err := deps.service.ExportCharacter(context.Background(), char.ID, "someone_else")
+		assert.True(t, dnderr.Is(err, dnderr.CodePermissionDenied))
+	})
+}
+
+func TestImportCharacter(t *testing.T) {
+	data := export(t, exportableCharacter())
+
+	deps := setupExport(t)
+	expectRulebook(deps)
+	deps.repo.EXPECT().GetByOwner(gomock.Any(), "user_2").Return(nil, nil)
+	deps.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	char, err := deps.service.ImportCharacter(context.Background(), &character.ImportCharacterInput{
+		UserID:  "user_2",
+		RealmID: "guild_2",
+		Data:    data,
+	})
+
+	require.NoError(t, err)
+	assert.NotEqual(t, "char_1", char.ID, "imports are new characters")
+	assert.Equal(t, "user_2", char.OwnerID)
+	assert.Equal(t, "guild_2", char.RealmID)
+	assert.Equal(t, 25, char.Race.Speed, "rulebook data is loaded")
+	assert.Equal(t, 14, char.CurrentHitPoints)
+	assert.Equal(t, shared.Wallet{Gold: 12}, char.Wallet)
+	assert.NotNil(t, char.Resources)
+}
+
+func longsword() *equipment.Weapon {
+	return &equipment.Weapon{
+		Base:           equipment.BasicEquipment{Key: "longsword", Name: "Longsword"},
+		WeaponCategory: "Martial",
+		WeaponRange:    "Melee",
+	}
+}
+
+func TestImportCharacter_ReloadsRulebookData(t *testing.T) {
+	char := exportableCharacter()
+	char.AC = 25
+	forged := longsword()
+	forged.Magic = &equipment.MagicProperties{Bonuses: []equipment.MagicBonus{{Target: effects.TargetAttackRoll, Value: 5}}}
+	char.Inventory = map[equipment.EquipmentType][]equipment.Equipment{equipment.EquipmentTypeWeapon: {forged}}
+	char.EquippedSlots = map[shared.Slot]equipment.Equipment{shared.SlotMainHand: forged}
+	char.InitializeResources()
+	char.Resources.HitDice.Spend(10)
+	char.Resources.HitDice.Spend(10)
+	data := export(t, char)
+
+	deps := setupExport(t)
+	expectRulebook(deps)
+	deps.dndClient.EXPECT().GetEquipment("longsword").Return(longsword(), nil)
+	deps.repo.EXPECT().GetByOwner(gomock.Any(), "user_2").Return(nil, nil)
+	deps.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	imported, err := deps.service.ImportCharacter(context.Background(), &character.ImportCharacterInput{
+		UserID:  "user_2",
+		RealmID: "guild_2",
+		Data:    data,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 11, imported.AC, "AC is recalculated")
+	weapon, ok := imported.EquippedSlots[shared.SlotMainHand].(*equipment.Weapon)
+	require.True(t, ok)
+	assert.Nil(t, weapon.Magic, "magic comes from the rulebook, not the file")
+	assert.Same(t, imported.Inventory[equipment.EquipmentTypeWeapon][0], imported.EquippedSlots[shared.SlotMainHand])
+	assert.Equal(t, 2, imported.Resources.HitDice.Remaining(), "resources are rebuilt")
+}
+
+func TestImportCharacter_RebuildsFeatures(t *testing.T) {
+	char := exportableCharacter()
+	char.Features = []*rulebook.CharacterFeature{{
+		Key:         "fighting_style",
+		Name:        "Fighting Style",
+		Description: "+10 to AC",
+		Type:        rulebook.FeatureTypeClass,
+		Metadata:    map[string]any{"style": "defense"},
+	}}
+	data := export(t, char)
+
+	deps := setupExport(t)
+	expectRulebook(deps)
+	deps.repo.EXPECT().GetByOwner(gomock.Any(), "user_2").Return(nil, nil)
+	deps.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	imported, err := deps.service.ImportCharacter(context.Background(), &character.ImportCharacterInput{
+		UserID:  "user_2",
+		RealmID: "guild_2",
+		Data:    data,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, imported.HasFeature("darkvision"), "racial features are granted")
+	assert.True(t, imported.HasFeature("action_surge"), "class features up to the character's level are granted")
+	var style *rulebook.CharacterFeature
+	for _, feature := range imported.Features {
+		if feature.Key == "fighting_style" {
+			style = feature
+		}
+	}
+	require.NotNil(t, style)
+	assert.NotEqual(t, "+10 to AC", style.Description, "descriptions come from the rulebook")
+	assert.Equal(t, "defense", style.Metadata["style"], "the chosen style is kept")
+}
+
+func TestImportCharacter_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(char *charDomain.Character)
+		data   []byte
+	}{
+		{name: "not an export", data: []byte(`{"name": "Thorin"}`)},
+		{name: "newer export version", data: []byte(`{"format": "dnd-bot-character", "version": 99, "character": {}}`)},
+		{name: "too many hit points", modify: func(char *charDomain.Character) { char.MaxHitPoints = 40 }},
+		{name: "ability score out of range", modify: func(char *charDomain.Character) {
+			char.Attributes[shared.AttributeStrength].Score = 31
+		}},
+		{name: "missing ability score", modify: func(char *charDomain.Character) {
+			delete(char.Attributes, shared.AttributeWisdom)
+		}},
+		{name: "unknown proficiency", modify: func(char *charDomain.Character) {
+			char.Proficiencies[rulebook.ProficiencyTypeSkill] = []*rulebook.Proficiency{{Key: "skill-flying"}}
+		}},
+		{name: "ability score above 20", modify: func(char *charDomain.Character) {
+			char.Attributes[shared.AttributeStrength].Score = 24
+		}},
+		{name: "too many coins", modify: func(char *charDomain.Character) { char.Wallet.Platinum = 1000000 }},
+		{name: "unknown item", modify: func(char *charDomain.Character) {
+			char.Inventory = map[equipment.EquipmentType][]equipment.Equipment{
+				equipment.EquipmentTypeOther: {&equipment.BasicEquipment{Key: "vorpal-spoon", Name: "Vorpal Spoon"}},
+			}
+		}},
+		{name: "equipped item not carried", modify: func(char *charDomain.Character) {
+			char.EquippedSlots = map[shared.Slot]equipment.Equipment{shared.SlotMainHand: longsword()}
+		}},
+		{name: "class levels don't add up", modify: func(char *charDomain.Character) {
+			char.Classes = []*charDomain.ClassLevel{{Class: char.Class, Level: 1}, {Class: &rulebook.Class{Key: "wizard"}, Level: 3}}
+		}},
+		{name: "spell not on the class list", modify: func(char *charDomain.Character) {
+			char.Spells = &charDomain.SpellList{Cantrips: []string{"fire-bolt"}}
+		}},
+		{name: "feature nothing grants", modify: func(char *charDomain.Character) {
+			char.Features = []*rulebook.CharacterFeature{{Key: "rage", Name: "Rage", Type: rulebook.FeatureTypeClass}}
+		}},
+		{name: "fighting style that isn't an option", modify: func(char *charDomain.Character) {
+			char.Features = []*rulebook.CharacterFeature{{Key: "fighting_style", Type: rulebook.FeatureTypeClass, Metadata: map[string]any{"style": "flying"}}}
+		}},
+		{name: "subclass before the class reaches it", modify: func(char *charDomain.Character) {
+			char.Features = []*rulebook.CharacterFeature{{Key: "subclass", Metadata: map[string]any{"subclass": "champion", "class": "fighter"}}}
+		}},
+		{name: "unknown feat", modify: func(char *charDomain.Character) {
+			char.Features = []*rulebook.CharacterFeature{{Key: "flight", Name: "Flight", Type: rulebook.FeatureTypeFeat}}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.data
+			if data == nil {
+				char := exportableCharacter()
+				tt.modify(char)
+				data = export(t, char)
+			}
+
+			deps := setupExport(t)
+			expectRulebook(deps)
+			deps.dndClient.EXPECT().GetProficiency("skill-flying").Return(nil, errors.New("not found")).AnyTimes()
+			deps.dndClient.EXPECT().GetEquipment("vorpal-spoon").Return(nil, errors.New("not found")).AnyTimes()
+			deps.dndClient.EXPECT().GetClass("wizard").Return(&rulebook.Class{Key: "wizard", Name: "Wizard", HitDie: 6}, nil).AnyTimes()
+			deps.dndClient.EXPECT().GetSpell("fire-bolt").Return(&rulebook.Spell{Key: "fire-bolt", Name: "Fire Bolt", Classes: []string{"sorcerer", "wizard"}}, nil).AnyTimes()
+
+			_, err := deps.service.ImportCharacter(context.Background(), &character.ImportCharacterInput{
+				UserID:  "user_2",
+				RealmID: "guild_2",
+				Data:    data,
+			})
+
+			require.Error(t, err)
+			assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument) || dnderr.Is(err, dnderr.CodeValidation), err.Error())
+		})
+	}
+}
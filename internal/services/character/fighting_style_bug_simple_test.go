@@ -93,7 +93,7 @@ func TestFinalizeDraftCharacterPreservesMetadata(t *testing.T) {
 		// Mock expectations
 		mockRepo.EXPECT().Get(ctx, characterID).Return(draftChar, nil)
 		mockDraftRepo.EXPECT().GetByCharacterID(ctx, characterID).Return(nil, nil) // No draft exists
-		mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, char *character2.Character) error {
+		mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, char *character2.Character) error {
 			// This is where we'll capture the finalized character to verify the fix
 			// Copy the character back to draftChar so we can verify it
 			// Update key fields to avoid copying mutex
@@ -5,6 +5,7 @@ import (
 	character2 "github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	"testing"
 
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
@@ -70,4 +71,42 @@ func TestFinalizeDraftCharacter(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, character2.CharacterStatusActive, finalized.Status)
 	})
+	t.Run("subclass chosen at first level grants its benefits", func(t *testing.T) {
+		draft := testutils.CreateTestCharacter("test-char-4", "user-123", "realm-456", "Sorin")
+		draft.Status = character2.CharacterStatusDraft
+		draft.Class = testutils.CreateTestClass("sorcerer", "Sorcerer", 6)
+		draft.HitDie = 6
+		draft.SetHitpoints()
+		draft.Features = append(draft.Features, &rulebook.CharacterFeature{
+			Key:      "subclass",
+			Name:     "Draconic Bloodline",
+			Metadata: map[string]any{"subclass": "draconic", "class": "sorcerer"},
+		})
+		require.NoError(t, repo.Create(ctx, draft))
+
+		finalized, err := service.FinalizeDraftCharacter(ctx, draft.ID)
+		require.NoError(t, err)
+		assert.True(t, finalized.HasFeature("draconic_resilience"))
+		assert.Equal(t, 9, finalized.MaxHitPoints, "d6 + 2 CON + 1 Draconic Resilience")
+		assert.Equal(t, 15, finalized.AC, "13 + 2 DEX")
+	})
+
+	t.Run("domain bonus proficiencies", func(t *testing.T) {
+		draft := testutils.CreateTestCharacter("test-char-5", "user-123", "realm-456", "Tempus")
+		draft.Status = character2.CharacterStatusDraft
+		draft.Class = testutils.CreateTestClass("cleric", "Cleric", 8)
+		draft.Features = append(draft.Features, &rulebook.CharacterFeature{
+			Key:      "divine_domain",
+			Name:     "Divine Domain",
+			Metadata: map[string]any{"domain": "tempest"},
+		})
+		require.NoError(t, repo.Create(ctx, draft))
+
+		finalized, err := service.FinalizeDraftCharacter(ctx, draft.ID)
+		require.NoError(t, err)
+		assert.True(t, finalized.HasFeature("wrath_of_the_storm"), "domain features come from the divine domain")
+		require.Len(t, finalized.Proficiencies[rulebook.ProficiencyTypeArmor], 1)
+		assert.Equal(t, "heavy-armor", finalized.Proficiencies[rulebook.ProficiencyTypeArmor][0].Key)
+		assert.True(t, finalized.HasWeaponProficiency("martial-weapons"))
+	})
 }
@@ -103,7 +103,7 @@ func TestFinalizeCharacterWithNameBug(t *testing.T) {
 
 		// Mock the first Update call (from UpdateDraftCharacter with name change)
 		// This should now preserve the metadata (bug fixed)
-		mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, char *character2.Character) error {
+		mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, char *character2.Character) error {
 			// Verify the fix: name changes but metadata is preserved
 			t.Logf("UPDATE 1: Character name changed to '%s', checking metadata...", char.Name)
 			fightingStyle := findFeatureByKey(char.Features, "fighting_style")
@@ -124,7 +124,7 @@ func TestFinalizeCharacterWithNameBug(t *testing.T) {
 		mockDraftRepo.EXPECT().GetByCharacterID(ctx, characterID).Return(nil, nil).AnyTimes() // No draft exists
 
 		// Mock the second Update call (from FinalizeDraftCharacter)
-		mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, char *character2.Character) error {
+		mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, char *character2.Character) error {
 			// Update key fields to avoid copying mutex
 			draftChar.Name = char.Name
 			draftChar.Status = char.Status
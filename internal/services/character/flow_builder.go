@@ -9,12 +9,19 @@ import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/clients/dnd5e"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 )
 
+// AbilityScoreMethodSource reports which ability score method a user's table requires
+type AbilityScoreMethodSource interface {
+	RequiredAbilityScoreMethod(ctx context.Context, userID string) (shared.AbilityScoreMethod, error)
+}
+
 // FlowBuilderImpl implements the FlowBuilder interface
 type FlowBuilderImpl struct {
 	dndClient      dnd5e.Client
 	choiceResolver ChoiceResolver
+	methods        AbilityScoreMethodSource // Optional, offers every method if nil
 }
 
 // NewFlowBuilder creates a new flow builder
@@ -33,6 +40,16 @@ func NewFlowBuilderWithResolver(dndClient dnd5e.Client, choiceResolver ChoiceRes
 	}
 }
 
+// NewFlowBuilderWithMethods creates a flow builder that only offers the
+// ability score methods a user's session allows
+func NewFlowBuilderWithMethods(dndClient dnd5e.Client, choiceResolver ChoiceResolver, methods AbilityScoreMethodSource) character.FlowBuilder {
+	return &FlowBuilderImpl{
+		dndClient:      dndClient,
+		choiceResolver: choiceResolver,
+		methods:        methods,
+	}
+}
+
 // BuildFlow creates a complete character creation flow based on character state
 func (b *FlowBuilderImpl) BuildFlow(ctx context.Context, char *character.Character) (*character.CreationFlow, error) {
 	var steps []character.CreationStep
@@ -203,12 +220,11 @@ func (b *FlowBuilderImpl) BuildFlow(ctx context.Context, char *character.Charact
 	// Only add subsequent steps if race and class are selected
 	if char.Race != nil && char.Class != nil {
 		// 3. Ability Scores
-		steps = append(steps, character.CreationStep{
-			Type:        character.StepTypeAbilityScores,
-			Title:       "Roll Ability Scores",
-			Description: "Generate your character's six ability scores.",
-			Required:    true,
-		})
+		abilityStep, err := b.buildAbilityScoresStep(ctx, char)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build ability scores step: %w", err)
+		}
+		steps = append(steps, abilityStep)
 
 		// 4. Ability Assignment (only if scores exist but not assigned)
 		if len(char.Attributes) == 0 {
@@ -259,6 +275,9 @@ func (b *FlowBuilderImpl) BuildFlow(ctx context.Context, char *character.Charact
 				}
 			}
 
+			// Background, its languages and personality come before proficiencies
+			// so the proficiency choices can leave out the background's skills
+			steps = append(steps, b.buildBackgroundSteps(char)...)
 			steps = append(steps, proficiencyStep)
 			steps = append(steps, equipmentSteps...)
 			steps = append(steps,
@@ -278,6 +297,48 @@ func (b *FlowBuilderImpl) BuildFlow(ctx context.Context, char *character.Charact
 	}, nil
 }
 
+// buildAbilityScoresStep offers each ability score method the owner's session allows
+func (b *FlowBuilderImpl) buildAbilityScoresStep(ctx context.Context, char *character.Character) (character.CreationStep, error) {
+	required := shared.AbilityScoreMethodAny
+	if b.methods != nil {
+		var err error
+		required, err = b.methods.RequiredAbilityScoreMethod(ctx, char.OwnerID)
+		if err != nil {
+			return character.CreationStep{}, err
+		}
+	}
+
+	step := character.CreationStep{
+		Type:        character.StepTypeAbilityScores,
+		Title:       "Roll Ability Scores",
+		Description: "Generate your character's six ability scores.",
+		Required:    true,
+		MinChoices:  1,
+		MaxChoices:  1,
+	}
+	if required != shared.AbilityScoreMethodAny {
+		step.Context = map[string]any{"required_method": string(required)}
+	}
+
+	for _, method := range shared.AbilityScoreMethods {
+		if !required.Allows(method) {
+			continue
+		}
+		step.Options = append(step.Options, character.CreationOption{
+			Key:         string(method),
+			Name:        method.DisplayName(),
+			Description: abilityScoreMethodDescriptions[method],
+		})
+	}
+	return step, nil
+}
+
+var abilityScoreMethodDescriptions = map[shared.AbilityScoreMethod]string{
+	shared.AbilityScoreMethodRoll:          "Roll 4d6 and drop the lowest die, six times",
+	shared.AbilityScoreMethodStandardArray: "Assign 15, 14, 13, 12, 10 and 8",
+	shared.AbilityScoreMethodPointBuy:      fmt.Sprintf("Spend %d points on scores from %d to %d", rulebook.PointBuyBudget, rulebook.PointBuyMin, rulebook.PointBuyMax),
+}
+
 // buildClassSpecificSteps creates steps specific to the character's class
 func (b *FlowBuilderImpl) buildClassSpecificSteps(ctx context.Context, char *character.Character) ([]character.CreationStep, error) {
 	var steps []character.CreationStep
@@ -526,6 +587,7 @@ func (b *FlowBuilderImpl) buildProficiencyStep(ctx context.Context, char *charac
 	if err != nil {
 		return character.CreationStep{}, fmt.Errorf("failed to resolve proficiency choices: %w", err)
 	}
+	simplifiedChoices = withoutBackgroundProficiencies(simplifiedChoices, char.Background)
 
 	// Convert simplified choices to creation options
 	var allOptions []character.CreationOption
@@ -594,6 +656,7 @@ func (b *FlowBuilderImpl) buildEquipmentSteps(ctx context.Context, char *charact
 					"choice_id":    choice.ID,
 					"choice_type":  choice.Type,
 					"bundle_items": option.BundleItems, // For equipment bundles
+					"nested":       option.Nested,      // For options like "a martial weapon"
 				},
 			})
 		}
@@ -0,0 +1,136 @@
+package character
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+)
+
+// BackgroundLanguageSource is the "source" in the metadata of the language
+// step that belongs to the background rather than a class feature
+const BackgroundLanguageSource = "background"
+
+// buildBackgroundSteps creates the background selection step and, once a
+// background is chosen, its language and personality steps
+func (b *FlowBuilderImpl) buildBackgroundSteps(char *character.Character) []character.CreationStep {
+	var options []character.CreationOption
+	for _, background := range rulebook.SRDBackgrounds() {
+		options = append(options, character.CreationOption{
+			Key:         background.ID,
+			Name:        background.Name,
+			Description: backgroundSummary(background),
+		})
+	}
+
+	steps := []character.CreationStep{{
+		Type:        character.StepTypeBackgroundSelection,
+		Title:       "Choose Your Background",
+		Description: "Your background reveals where you came from and your place in the world. It grants skills, tools, languages, equipment and a feature.",
+		Options:     options,
+		MinChoices:  1,
+		MaxChoices:  1,
+		Required:    true,
+		Context: map[string]any{
+			"color":       0x8e44ad, // Purple
+			"placeholder": "Choose a background...",
+		},
+	}}
+
+	background := char.Background
+	if background == nil {
+		return steps
+	}
+
+	if background.Languages > 0 {
+		var languageOptions []character.CreationOption
+		for _, language := range rulebook.Languages {
+			languageOptions = append(languageOptions, character.CreationOption{
+				Key:         language.Key,
+				Name:        language.Name,
+				Description: "Spoken by " + strings.ToLower(language.Speakers),
+			})
+		}
+
+		steps = append(steps, character.CreationStep{
+			Type:        character.StepTypeLanguageSelection,
+			Title:       fmt.Sprintf("Choose %s Languages", background.Name),
+			Description: fmt.Sprintf("Your background teaches you %d additional language%s of your choice.", background.Languages, plural(background.Languages)),
+			Options:     languageOptions,
+			MinChoices:  background.Languages,
+			MaxChoices:  background.Languages,
+			Required:    true,
+			Context: map[string]any{
+				"source":      BackgroundLanguageSource,
+				"color":       0xe67e22, // Orange
+				"placeholder": fmt.Sprintf("Select %d language%s...", background.Languages, plural(background.Languages)),
+			},
+		})
+	}
+
+	var personalityOptions []character.CreationOption
+	picks := 0
+	for _, table := range rulebook.PersonalityTables {
+		picks += table.Picks()
+		for i, entry := range background.Table(table) {
+			personalityOptions = append(personalityOptions, character.CreationOption{
+				Key:         personalityOptionKey(table, i),
+				Name:        entry,
+				Description: table.DisplayName(),
+				Metadata:    map[string]any{"table": string(table)},
+			})
+		}
+	}
+
+	steps = append(steps, character.CreationStep{
+		Type:        character.StepTypePersonalitySelection,
+		Title:       "Choose Your Personality",
+		Description: "Pick or roll two personality traits, an ideal, a bond and a flaw from your background.",
+		Options:     personalityOptions,
+		MinChoices:  picks,
+		MaxChoices:  picks,
+		Required:    true,
+		Context: map[string]any{
+			"color": 0x1abc9c, // Teal
+		},
+	})
+
+	return steps
+}
+
+// backgroundSummary describes a background in the 100 characters a select
+// option allows
+func backgroundSummary(background *rulebook.Background) string {
+	var skills []string
+	for _, skill := range background.SkillProficiencies {
+		skills = append(skills, strings.TrimPrefix(skill.Name, "Skill: "))
+	}
+
+	details := []string{strings.Join(skills, ", ")}
+	if background.Languages > 0 {
+		details = append(details, fmt.Sprintf("%d language%s", background.Languages, plural(background.Languages)))
+	}
+	if background.Feature != nil {
+		details = append(details, background.Feature.Name)
+	}
+
+	description := strings.Join(details, " • ")
+	if len(description) > 100 {
+		description = description[:97] + "..."
+	}
+	return description
+}
+
+// personalityOptionKey builds the option key of a personality table entry,
+// like "trait-3"
+func personalityOptionKey(table rulebook.PersonalityTable, index int) string {
+	return fmt.Sprintf("%s-%d", table, index+1)
+}
+
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
@@ -2,7 +2,9 @@ package character
 
 import (
 	"context"
+
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 )
 
 // buildBardSteps creates bard-specific steps
@@ -14,6 +16,7 @@ func (b *FlowBuilderImpl) buildBardSteps(ctx context.Context, char *character.Ch
 		Type:        character.StepTypeExpertiseSelection,
 		Title:       "Choose Your Expertise",
 		Description: "Your proficiency bonus is doubled for ability checks using these skills. Choose 2 skills you are proficient with.",
+		Options:     expertiseOptions(char),
 		MinChoices:  2,
 		MaxChoices:  2,
 		Required:    true,
@@ -154,6 +157,7 @@ func (b *FlowBuilderImpl) buildRogueSteps(ctx context.Context, char *character.C
 		Type:        character.StepTypeExpertiseSelection,
 		Title:       "Choose Your Expertise",
 		Description: "Your proficiency bonus is doubled for ability checks using these skills. Choose 2 skills you are proficient with.",
+		Options:     expertiseOptions(char),
 		MinChoices:  2,
 		MaxChoices:  2,
 		Required:    true,
@@ -186,6 +190,7 @@ func (b *FlowBuilderImpl) buildSorcererSteps(ctx context.Context, char *characte
 		Type:        character.StepTypeSubclassSelection,
 		Title:       "Choose Your Sorcerous Origin",
 		Description: "Choose the source of your innate magical power.",
+		Options:     subclassOptions("sorcerer"),
 		MinChoices:  1,
 		MaxChoices:  1,
 		Required:    true,
@@ -280,6 +285,7 @@ func (b *FlowBuilderImpl) buildWarlockSteps(ctx context.Context, char *character
 		Type:        character.StepTypeSubclassSelection,
 		Title:       "Choose Your Otherworldly Patron",
 		Description: "Choose the otherworldly being that has granted you power.",
+		Options:     subclassOptions("warlock"),
 		MinChoices:  1,
 		MaxChoices:  1,
 		Required:    true,
@@ -364,3 +370,32 @@ func (b *FlowBuilderImpl) buildWarlockSteps(ctx context.Context, char *character
 
 	return steps
 }
+
+// subclassOptions lists a class's subclasses as step options
+func subclassOptions(classKey string) []character.CreationOption {
+	subclasses := rulebook.GetSubclasses(classKey)
+	options := make([]character.CreationOption, 0, len(subclasses))
+	for _, subclass := range subclasses {
+		options = append(options, character.CreationOption{
+			Key:         subclass.Key,
+			Name:        subclass.Name,
+			Description: subclass.Description,
+		})
+	}
+	return options
+}
+
+// expertiseOptions lists the skills the character is already proficient with
+func expertiseOptions(char *character.Character) []character.CreationOption {
+	var options []character.CreationOption
+	for _, prof := range char.Proficiencies[rulebook.ProficiencyTypeSkill] {
+		if prof == nil {
+			continue
+		}
+		options = append(options, character.CreationOption{
+			Key:  prof.Key,
+			Name: prof.Name,
+		})
+	}
+	return options
+}
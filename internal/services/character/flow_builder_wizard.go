@@ -110,6 +110,7 @@ func (b *FlowBuilderImpl) buildWizardSteps(ctx context.Context, char *character.
 			Type:        character.StepTypeSubclassSelection,
 			Title:       "Choose Your Arcane Tradition",
 			Description: "At 2nd level, you choose an arcane tradition, shaping your practice of magic.",
+			Options:     subclassOptions("wizard"),
 			MinChoices:  1,
 			MaxChoices:  1,
 			Required:    true,
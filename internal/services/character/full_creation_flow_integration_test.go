@@ -122,7 +122,7 @@ func TestFullCharacterCreationFlow(t *testing.T) {
 			{ID: "4", Value: 10},
 			{ID: "5", Value: 8},
 		}
-		err = svc.UpdateEquipment(char)
+		err = svc.UpdateEquipment(ctx, char)
 		require.NoError(t, err)
 
 		// Process ability score step to move forward
@@ -207,7 +207,31 @@ func TestFullCharacterCreationFlow(t *testing.T) {
 		require.NotNil(t, char.Spells)
 		assert.Len(t, char.Spells.KnownSpells, 6)
 
-		// Step 8: Proficiencies (this is where you're getting stuck)
+		// Step 8: Background, its languages and personality
+		assert.Equal(t, character.StepTypeBackgroundSelection, nextStep.Type)
+		nextStep, err = flowService.ProcessStepResult(ctx, char.ID, &character.CreationStepResult{
+			StepType:   character.StepTypeBackgroundSelection,
+			Selections: []string{"sage"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, character.StepTypeLanguageSelection, nextStep.Type)
+		nextStep, err = flowService.ProcessStepResult(ctx, char.ID, &character.CreationStepResult{
+			StepType:   character.StepTypeLanguageSelection,
+			Selections: []string{"elvish", "draconic"},
+			Metadata:   map[string]any{"source": "background"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, character.StepTypePersonalitySelection, nextStep.Type)
+		nextStep, err = flowService.ProcessStepResult(ctx, char.ID, &character.CreationStepResult{
+			StepType:   character.StepTypePersonalitySelection,
+			Selections: []string{charService.PersonalityRoll},
+		})
+		require.NoError(t, err)
+		stepsCompleted = append(stepsCompleted, "background")
+
+		// Step 9: Proficiencies (this is where you're getting stuck)
 		assert.Equal(t, character.StepTypeProficiencySelection, nextStep.Type)
 		t.Logf("🔍 Proficiency step: %+v", nextStep)
 
@@ -243,7 +267,7 @@ func TestFullCharacterCreationFlow(t *testing.T) {
 			}
 		}
 
-		// Step 9: Equipment selection
+		// Step 10: Equipment selection
 		if nextStep.Type == character.StepTypeEquipmentSelection {
 			t.Logf("📍 Equipment step reached")
 			// For now, just process with empty selections
@@ -257,7 +281,7 @@ func TestFullCharacterCreationFlow(t *testing.T) {
 			t.Logf("✅ Processed equipment, next step: %s", nextStep.Type)
 		}
 
-		// Step 10: Character details (name and finalize)
+		// Step 11: Character details (name and finalize)
 		if nextStep.Type == character.StepTypeCharacterDetails {
 			t.Logf("📍 Character details step reached")
 
@@ -330,7 +354,7 @@ func TestFullCharacterCreationFlow(t *testing.T) {
 			{ID: "4", Value: 10},
 			{ID: "5", Value: 8},
 		}
-		err = svc.UpdateEquipment(char)
+		err = svc.UpdateEquipment(ctx, char)
 		require.NoError(t, err)
 
 		_, err = flowService.ProcessStepResult(ctx, char.ID, &character.CreationStepResult{
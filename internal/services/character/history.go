@@ -0,0 +1,241 @@
+package character
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	characterRepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+)
+
+// CharacterVersion is a saved version of a character and what changed
+// since the version before it
+type CharacterVersion struct {
+	Version int
+	SavedAt time.Time
+	Actor   string // User who made the change; empty for automatic saves
+	Reason  string
+	Changes []*FieldChange // Empty for the oldest version kept
+}
+
+// FieldChange is one field that differs between two versions
+type FieldChange struct {
+	Field string // Path in the storage format, e.g. "inventory.weapon[0].equipment.base.name"
+	Old   string // Empty when the field was added
+	New   string // Empty when the field was removed
+}
+
+// RestoreVersionInput identifies a saved version to restore a character to
+type RestoreVersionInput struct {
+	CharacterID string
+	Version     int
+	Actor       string // User restoring the character
+}
+
+// WithChange records who is saving a character and why in ctx, for the
+// character's history. Handlers set it for the user acting; services add a
+// fallback reason that the handler's wins over.
+func WithChange(ctx context.Context, actor, reason string) context.Context {
+	return characterRepo.WithChange(ctx, actor, reason)
+}
+
+// historyIgnoredFields change on every save and aren't worth showing
+var historyIgnoredFields = map[string]bool{
+	"schema_version": true,
+	"created_at":     true,
+	"updated_at":     true,
+}
+
+// GetCharacterHistory returns a character's saved versions, newest first,
+// with the fields each version changed
+func (s *service) GetCharacterHistory(ctx context.Context, characterID string) ([]*CharacterVersion, error) {
+	if strings.TrimSpace(characterID) == "" {
+		return nil, dnderr.InvalidArgument("character ID is required")
+	}
+
+	entries, err := s.repository.GetHistory(ctx, characterID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get history for character '%s'", characterID).
+			WithMeta("character_id", characterID)
+	}
+
+	versions := make([]*CharacterVersion, 0, len(entries))
+	for i, entry := range entries {
+		version := &CharacterVersion{
+			Version: entry.Version,
+			SavedAt: entry.SavedAt,
+			Actor:   entry.Actor,
+			Reason:  entry.Reason,
+		}
+		if i+1 < len(entries) {
+			version.Changes, err = diffSnapshots(entries[i+1].Snapshot, entry.Snapshot)
+			if err != nil {
+				return nil, dnderr.Wrapf(err, "failed to compare version %d", entry.Version).
+					WithMeta("character_id", characterID)
+			}
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// RestoreCharacterVersion saves a character as it was at an earlier
+// version. The restore is itself a new version, so it can be undone.
+func (s *service) RestoreCharacterVersion(ctx context.Context, input *RestoreVersionInput) (*character.Character, error) {
+	if input == nil || strings.TrimSpace(input.CharacterID) == "" {
+		return nil, dnderr.InvalidArgument("character ID is required")
+	}
+
+	current, err := s.GetCharacter(ctx, input.CharacterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkRestoreAllowed(ctx, current, input.Actor); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.repository.GetHistory(ctx, input.CharacterID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get history for character '%s'", input.CharacterID).
+			WithMeta("character_id", input.CharacterID)
+	}
+
+	var entry *characterRepo.HistoryEntry
+	for _, e := range entries {
+		if e.Version == input.Version {
+			entry = e
+			break
+		}
+	}
+	if entry == nil {
+		return nil, dnderr.NotFoundf("version %d of %s isn't in the history", input.Version, current.Name).
+			WithMeta("character_id", input.CharacterID)
+	}
+
+	restored, err := entry.Character(ctx, s)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to read version %d", input.Version).
+			WithMeta("character_id", input.CharacterID)
+	}
+
+	// A restore changes the character, not who it belongs to. Its race and
+	// classes are the version's, loaded from the rulebook when it was read.
+	restored.ID = current.ID
+	restored.OwnerID = current.OwnerID
+	restored.RealmID = current.RealmID
+
+	// Derived state isn't trusted from the snapshot: resource maximums follow
+	// the version's classes and levels, and item effects its equipment. The
+	// save recalculates AC.
+	restored.RefreshResources()
+	restored.RefreshItemEffects()
+
+	ctx = WithChange(ctx, input.Actor, fmt.Sprintf("restored version %d", input.Version))
+	if err := s.UpdateEquipment(ctx, restored); err != nil {
+		return nil, dnderr.Wrap(err, "failed to save restored character").
+			WithMeta("character_id", input.CharacterID)
+	}
+	return restored, nil
+}
+
+// checkRestoreAllowed stops a restore from undoing play: while the character
+// is in an active session, a restore could bring back spent coins, items and
+// hit points, so only the session's DM can make one
+func (s *service) checkRestoreAllowed(ctx context.Context, char *character.Character, actor string) error {
+	if s.sessionRepository == nil {
+		return nil
+	}
+
+	sessions, err := s.sessionRepository.GetActiveByUser(ctx, char.OwnerID)
+	if err != nil {
+		return dnderr.Wrap(err, "failed to get active sessions").
+			WithMeta("character_id", char.ID)
+	}
+	for _, sess := range sessions {
+		for _, member := range sess.Members {
+			if member.CharacterID == char.ID && sess.DMID != actor {
+				return dnderr.PermissionDeniedf("%s is in the active session %s; only its DM can restore them until it ends", char.Name, sess.Name).
+					WithMeta("character_id", char.ID).
+					WithMeta("session_id", sess.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// diffSnapshots lists the fields that differ between two stored characters
+func diffSnapshots(older, newer json.RawMessage) ([]*FieldChange, error) {
+	before, err := flattenSnapshot(older)
+	if err != nil {
+		return nil, err
+	}
+	after, err := flattenSnapshot(newer)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, 0, len(before)+len(after))
+	for field := range before {
+		fields = append(fields, field)
+	}
+	for field := range after {
+		if _, ok := before[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	var changes []*FieldChange
+	for _, field := range fields {
+		if before[field] != after[field] {
+			changes = append(changes, &FieldChange{Field: field, Old: before[field], New: after[field]})
+		}
+	}
+	return changes, nil
+}
+
+// flattenSnapshot migrates a stored character to the current schema and
+// flattens it to field paths and values
+func flattenSnapshot(snapshot json.RawMessage) (map[string]string, error) {
+	migrated, _, _, err := characterRepo.MigrateRecord(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	var record map[string]any
+	if err := json.Unmarshal(migrated, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+
+	fields := make(map[string]string)
+	for key, value := range record {
+		if !historyIgnoredFields[key] {
+			flattenValue(key, value, fields)
+		}
+	}
+	return fields, nil
+}
+
+func flattenValue(path string, value any, fields map[string]string) {
+	switch v := value.(type) {
+	case nil:
+	case map[string]any:
+		for key, child := range v {
+			flattenValue(path+"."+key, child, fields)
+		}
+	case []any:
+		for i, child := range v {
+			flattenValue(fmt.Sprintf("%s[%d]", path, i), child, fields)
+		}
+	case string:
+		fields[path] = v
+	default:
+		raw, _ := json.Marshal(v)
+		fields[path] = string(raw)
+	}
+}
@@ -0,0 +1,215 @@
+package character_test
+
+import (
+	"context"
+	"testing"
+
+	mockdnd5e "github.com/KirkDiggler/dnd-bot-discord/internal/clients/dnd5e/mock"
+	charDomain "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	mockdraftrepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/gamesessions"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/levelup"
+	mocksession "github.com/KirkDiggler/dnd-bot-discord/internal/services/session/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/shop"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// setupHistory returns a service over an in-memory repository holding a
+// fighter who was saved three times: created, lost their sword, then hurt
+func setupHistory(t *testing.T, sessions gamesessions.Repository) (character.Service, characters.Repository) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	repo := characters.NewInMemoryRepository()
+	dndClient := mockdnd5e.NewMockClient(ctrl)
+	dndClient.EXPECT().GetClass("fighter").Return(&rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10}, nil).AnyTimes()
+	dndClient.EXPECT().GetEquipmentByCategory("simple-weapons").Return([]equipment.Equipment{
+		&equipment.Weapon{Base: equipment.BasicEquipment{Key: "club", Name: "Club", Weight: 2, Cost: &shared.Cost{Quantity: 1, Unit: "sp"}}},
+	}, nil).AnyTimes()
+	service := character.NewService(&character.ServiceConfig{
+		DNDClient:         dndClient,
+		Repository:        repo,
+		DraftRepository:   mockdraftrepo.NewMockRepository(ctrl),
+		SessionRepository: sessions,
+	})
+
+	char := &charDomain.Character{
+		ID:               "char_1",
+		OwnerID:          "user_1",
+		RealmID:          "guild_1",
+		Name:             "Thorin",
+		Level:            1,
+		Status:           shared.CharacterStatusActive,
+		Class:            &rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10},
+		MaxHitPoints:     12,
+		CurrentHitPoints: 12,
+		AC:               16,
+	}
+	char.AddInventory(&equipment.Weapon{Base: equipment.BasicEquipment{Key: "longsword", Name: "Longsword"}})
+	require.NoError(t, repo.Create(ctx, char))
+
+	char.Inventory = nil
+	require.NoError(t, repo.Update(characters.WithChange(ctx, "user_2", "stole the sword"), char))
+
+	char.CurrentHitPoints = 5
+	require.NoError(t, repo.Update(ctx, char))
+
+	return service, repo
+}
+
+func TestGetCharacterHistory(t *testing.T) {
+	service, _ := setupHistory(t, nil)
+
+	history, err := service.GetCharacterHistory(context.Background(), "char_1")
+
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+
+	assert.Equal(t, 3, history[0].Version)
+	assert.Equal(t, []*character.FieldChange{{Field: "current_hit_points", Old: "12", New: "5"}}, history[0].Changes)
+
+	assert.Equal(t, "user_2", history[1].Actor)
+	assert.Equal(t, "stole the sword", history[1].Reason)
+	assert.Contains(t, history[1].Changes, &character.FieldChange{
+		Field: "inventory.weapon[0].equipment.base.name", Old: "Longsword",
+	})
+
+	assert.Empty(t, history[2].Changes, "nothing to compare the oldest version with")
+}
+
+func TestRestoreCharacterVersion(t *testing.T) {
+	service, repo := setupHistory(t, nil)
+	ctx := context.Background()
+
+	restored, err := service.RestoreCharacterVersion(ctx, &character.RestoreVersionInput{
+		CharacterID: "char_1",
+		Version:     1,
+		Actor:       "dm_1",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 12, restored.CurrentHitPoints)
+	require.NotNil(t, restored.Class)
+	assert.Equal(t, 10, restored.Class.HitDie, "the class is loaded from the rulebook")
+	require.Len(t, restored.Inventory[equipment.EquipmentTypeWeapon], 1)
+	assert.Equal(t, 10, restored.AC, "AC is recalculated, not read from the snapshot")
+	require.NotNil(t, restored.Resources)
+	assert.Equal(t, 1, restored.Resources.HitDice.Remaining(), "resources follow the version's class and level")
+
+	saved, err := repo.Get(ctx, "char_1")
+	require.NoError(t, err)
+	assert.Equal(t, "user_1", saved.OwnerID)
+	assert.Equal(t, 12, saved.CurrentHitPoints)
+
+	history, err := service.GetCharacterHistory(ctx, "char_1")
+	require.NoError(t, err)
+	assert.Equal(t, 4, history[0].Version, "the restore is a new version")
+	assert.Equal(t, "dm_1", history[0].Actor)
+	assert.Equal(t, "restored version 1", history[0].Reason)
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := service.RestoreCharacterVersion(ctx, &character.RestoreVersionInput{CharacterID: "char_1", Version: 99})
+		assert.True(t, dnderr.IsNotFound(err))
+	})
+}
+
+func TestRestoreCharacterVersion_ActiveSession(t *testing.T) {
+	ctx := context.Background()
+	sessions := gamesessions.NewInMemoryRepository()
+	service, repo := setupHistory(t, sessions)
+
+	sess := session.NewSession("session_1", "Goblin Caves", "guild_1", "channel_1", "dm_1")
+	sess.AddMember("user_1", session.SessionRolePlayer)
+	sess.SetCharacter("user_1", "char_1")
+	require.True(t, sess.Start())
+	require.NoError(t, sessions.Create(ctx, sess))
+
+	_, err := service.RestoreCharacterVersion(ctx, &character.RestoreVersionInput{
+		CharacterID: "char_1",
+		Version:     1,
+		Actor:       "user_1",
+	})
+	assert.True(t, dnderr.Is(err, dnderr.CodePermissionDenied), "the owner can't undo spending mid-session")
+
+	saved, err := repo.Get(ctx, "char_1")
+	require.NoError(t, err)
+	assert.Equal(t, 5, saved.CurrentHitPoints)
+
+	restored, err := service.RestoreCharacterVersion(ctx, &character.RestoreVersionInput{
+		CharacterID: "char_1",
+		Version:     1,
+		Actor:       "dm_1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 12, restored.CurrentHitPoints)
+}
+
+func TestSavesRecordTheirActor(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	service, repo := setupHistory(t, nil)
+	char, err := repo.Get(ctx, "char_1")
+	require.NoError(t, err)
+
+	latest := func() *character.CharacterVersion {
+		history, err := service.GetCharacterHistory(ctx, "char_1")
+		require.NoError(t, err)
+		return history[0]
+	}
+
+	t.Run("equipping", func(t *testing.T) {
+		char.AddInventory(&equipment.Weapon{Base: equipment.BasicEquipment{Key: "dagger", Name: "Dagger"}})
+		require.True(t, char.Equip("dagger"))
+
+		// The equip handlers save with the acting user
+		require.NoError(t, service.UpdateEquipment(character.WithChange(ctx, "user_1", "equipped Dagger"), char))
+
+		assert.Equal(t, "user_1", latest().Actor)
+		assert.Equal(t, "equipped Dagger", latest().Reason)
+	})
+
+	t.Run("buying", func(t *testing.T) {
+		sessionSvc := mocksession.NewMockService(ctrl)
+		sess := &session.Session{
+			ID:     "session_1",
+			Status: session.SessionStatusActive,
+			Members: map[string]*session.SessionMember{
+				"user_1": {UserID: "user_1", Role: session.SessionRolePlayer, CharacterID: "char_1"},
+			},
+		}
+		sess.OpenShop()
+		sessionSvc.EXPECT().GetSession(gomock.Any(), "session_1").Return(sess, nil).AnyTimes()
+		char.Wallet = shared.Wallet{Gold: 5}
+		require.NoError(t, repo.Update(ctx, char))
+
+		merchant := shop.NewService(&shop.ServiceConfig{SessionService: sessionSvc, CharacterService: service})
+		_, err := merchant.Buy(ctx, &shop.BuyInput{
+			SessionID: "session_1",
+			UserID:    "user_1",
+			Category:  "simple-weapons",
+			ItemKey:   "club",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "user_1", latest().Actor)
+		assert.Equal(t, "bought 1 Club", latest().Reason)
+	})
+
+	t.Run("leveling up", func(t *testing.T) {
+		leveler := levelup.NewService(&levelup.ServiceConfig{CharacterService: service})
+
+		_, err := leveler.StartLevelUp(ctx, "char_1", "user_1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "user_1", latest().Actor)
+		assert.Equal(t, "started leveling up to 2", latest().Reason)
+	})
+}
@@ -87,7 +87,7 @@ func TestLevel1Features(t *testing.T) {
 
 		// Mock repository Update
 		mockRepo.EXPECT().
-			Update(ctx, gomock.Any()).
+			Update(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, char *character2.Character) error {
 				// Verify features were applied
 				assert.NotNil(t, char.Features)
@@ -179,7 +179,7 @@ func TestLevel1Features(t *testing.T) {
 
 		// Mock repository Update
 		mockRepo.EXPECT().
-			Update(ctx, gomock.Any()).
+			Update(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, char *character2.Character) error {
 				// Verify features were applied
 				assert.NotNil(t, char.Features)
@@ -295,7 +295,7 @@ func TestLevel1Features(t *testing.T) {
 
 		// Mock repository Update
 		mockRepo.EXPECT().
-			Update(ctx, gomock.Any()).
+			Update(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, char *character2.Character) error {
 				// Verify features were applied
 				assert.NotNil(t, char.Features)
@@ -376,7 +376,7 @@ func TestLevel1Features(t *testing.T) {
 
 		// Mock repository Update
 		mockRepo.EXPECT().
-			Update(ctx, gomock.Any()).
+			Update(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, updated *character2.Character) error {
 				// Verify barbarian features were applied
 				hasUnarmoredDefense := false
@@ -15,6 +15,7 @@ import (
 
 	character "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	equipment "github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	session "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
 	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	shared "github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	character0 "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
@@ -45,6 +46,36 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// ApplyPointBuy mocks base method.
+func (m *MockService) ApplyPointBuy(ctx context.Context, input *character0.PointBuyInput) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyPointBuy", ctx, input)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyPointBuy indicates an expected call of ApplyPointBuy.
+func (mr *MockServiceMockRecorder) ApplyPointBuy(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyPointBuy", reflect.TypeOf((*MockService)(nil).ApplyPointBuy), ctx, input)
+}
+
+// AuditCharacter mocks base method.
+func (m *MockService) AuditCharacter(ctx context.Context, characterID string) (*character0.AuditReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditCharacter", ctx, characterID)
+	ret0, _ := ret[0].(*character0.AuditReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditCharacter indicates an expected call of AuditCharacter.
+func (mr *MockServiceMockRecorder) AuditCharacter(ctx, characterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditCharacter", reflect.TypeOf((*MockService)(nil).AuditCharacter), ctx, characterID)
+}
+
 // CreateCharacter mocks base method.
 func (m *MockService) CreateCharacter(ctx context.Context, input *character0.CreateCharacterInput) (*character0.CreateCharacterOutput, error) {
 	m.ctrl.T.Helper()
@@ -74,6 +105,21 @@ func (mr *MockServiceMockRecorder) Delete(characterID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), characterID)
 }
 
+// ExportCharacter mocks base method.
+func (m *MockService) ExportCharacter(ctx context.Context, characterID, userID string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCharacter", ctx, characterID, userID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportCharacter indicates an expected call of ExportCharacter.
+func (mr *MockServiceMockRecorder) ExportCharacter(ctx, characterID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCharacter", reflect.TypeOf((*MockService)(nil).ExportCharacter), ctx, characterID, userID)
+}
+
 // FinalizeCharacterWithName mocks base method.
 func (m *MockService) FinalizeCharacterWithName(ctx context.Context, characterID, name, raceKey, classKey string) (*character.Character, error) {
 	m.ctrl.T.Helper()
@@ -104,21 +150,6 @@ func (mr *MockServiceMockRecorder) FinalizeDraftCharacter(ctx, characterID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinalizeDraftCharacter", reflect.TypeOf((*MockService)(nil).FinalizeDraftCharacter), ctx, characterID)
 }
 
-// FixCharacterAttributes mocks base method.
-func (m *MockService) FixCharacterAttributes(ctx context.Context, characterID string) (*character.Character, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FixCharacterAttributes", ctx, characterID)
-	ret0, _ := ret[0].(*character.Character)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// FixCharacterAttributes indicates an expected call of FixCharacterAttributes.
-func (mr *MockServiceMockRecorder) FixCharacterAttributes(ctx, characterID any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FixCharacterAttributes", reflect.TypeOf((*MockService)(nil).FixCharacterAttributes), ctx, characterID)
-}
-
 // GetByID mocks base method.
 func (m *MockService) GetByID(characterID string) (*character.Character, error) {
 	m.ctrl.T.Helper()
@@ -179,6 +210,21 @@ func (mr *MockServiceMockRecorder) GetCharacterFromSession(ctx, sessionID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharacterFromSession", reflect.TypeOf((*MockService)(nil).GetCharacterFromSession), ctx, sessionID)
 }
 
+// GetCharacterHistory mocks base method.
+func (m *MockService) GetCharacterHistory(ctx context.Context, characterID string) ([]*character0.CharacterVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharacterHistory", ctx, characterID)
+	ret0, _ := ret[0].([]*character0.CharacterVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharacterHistory indicates an expected call of GetCharacterHistory.
+func (mr *MockServiceMockRecorder) GetCharacterHistory(ctx, characterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharacterHistory", reflect.TypeOf((*MockService)(nil).GetCharacterHistory), ctx, characterID)
+}
+
 // GetChoiceResolver mocks base method.
 func (m *MockService) GetChoiceResolver() character0.ChoiceResolver {
 	m.ctrl.T.Helper()
@@ -253,6 +299,21 @@ func (mr *MockServiceMockRecorder) GetOrCreateDraftCharacter(ctx, userID, realmI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateDraftCharacter", reflect.TypeOf((*MockService)(nil).GetOrCreateDraftCharacter), ctx, userID, realmID)
 }
 
+// GetParty mocks base method.
+func (m *MockService) GetParty(sess *session.Session) ([]*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParty", sess)
+	ret0, _ := ret[0].([]*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParty indicates an expected call of GetParty.
+func (mr *MockServiceMockRecorder) GetParty(sess any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParty", reflect.TypeOf((*MockService)(nil).GetParty), sess)
+}
+
 // GetPendingFeatureChoices mocks base method.
 func (m *MockService) GetPendingFeatureChoices(ctx context.Context, characterID string) ([]*rulebook.FeatureChoice, error) {
 	m.ctrl.T.Helper()
@@ -313,6 +374,21 @@ func (mr *MockServiceMockRecorder) GetSpell(ctx, spellKey any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpell", reflect.TypeOf((*MockService)(nil).GetSpell), ctx, spellKey)
 }
 
+// ImportCharacter mocks base method.
+func (m *MockService) ImportCharacter(ctx context.Context, input *character0.ImportCharacterInput) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCharacter", ctx, input)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCharacter indicates an expected call of ImportCharacter.
+func (mr *MockServiceMockRecorder) ImportCharacter(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCharacter", reflect.TypeOf((*MockService)(nil).ImportCharacter), ctx, input)
+}
+
 // ListByOwner mocks base method.
 func (m *MockService) ListByOwner(ownerID string) ([]*character.Character, error) {
 	m.ctrl.T.Helper()
@@ -373,6 +449,36 @@ func (mr *MockServiceMockRecorder) ListSpellsByClassAndLevel(ctx, classKey, leve
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSpellsByClassAndLevel", reflect.TypeOf((*MockService)(nil).ListSpellsByClassAndLevel), ctx, classKey, level)
 }
 
+// RepairCharacter mocks base method.
+func (m *MockService) RepairCharacter(ctx context.Context, input *character0.RepairCharacterInput) (*character0.AuditReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepairCharacter", ctx, input)
+	ret0, _ := ret[0].(*character0.AuditReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepairCharacter indicates an expected call of RepairCharacter.
+func (mr *MockServiceMockRecorder) RepairCharacter(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepairCharacter", reflect.TypeOf((*MockService)(nil).RepairCharacter), ctx, input)
+}
+
+// RequiredAbilityScoreMethod mocks base method.
+func (m *MockService) RequiredAbilityScoreMethod(ctx context.Context, userID string) (shared.AbilityScoreMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequiredAbilityScoreMethod", ctx, userID)
+	ret0, _ := ret[0].(shared.AbilityScoreMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequiredAbilityScoreMethod indicates an expected call of RequiredAbilityScoreMethod.
+func (mr *MockServiceMockRecorder) RequiredAbilityScoreMethod(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequiredAbilityScoreMethod", reflect.TypeOf((*MockService)(nil).RequiredAbilityScoreMethod), ctx, userID)
+}
+
 // ResolveChoices mocks base method.
 func (m *MockService) ResolveChoices(ctx context.Context, input *character0.ResolveChoicesInput) (*character0.ResolveChoicesOutput, error) {
 	m.ctrl.T.Helper()
@@ -388,6 +494,21 @@ func (mr *MockServiceMockRecorder) ResolveChoices(ctx, input any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveChoices", reflect.TypeOf((*MockService)(nil).ResolveChoices), ctx, input)
 }
 
+// RestoreCharacterVersion mocks base method.
+func (m *MockService) RestoreCharacterVersion(ctx context.Context, input *character0.RestoreVersionInput) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCharacterVersion", ctx, input)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCharacterVersion indicates an expected call of RestoreCharacterVersion.
+func (mr *MockServiceMockRecorder) RestoreCharacterVersion(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCharacterVersion", reflect.TypeOf((*MockService)(nil).RestoreCharacterVersion), ctx, input)
+}
+
 // StartCharacterCreation mocks base method.
 func (m *MockService) StartCharacterCreation(ctx context.Context, userID, guildID string) (*character.CharacterCreationSession, error) {
 	m.ctrl.T.Helper()
@@ -448,31 +569,31 @@ func (mr *MockServiceMockRecorder) UpdateDraftCharacter(ctx, characterID, update
 }
 
 // UpdateEquipment mocks base method.
-func (m *MockService) UpdateEquipment(arg0 *character.Character) error {
+func (m *MockService) UpdateEquipment(ctx context.Context, arg1 *character.Character) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEquipment", arg0)
+	ret := m.ctrl.Call(m, "UpdateEquipment", ctx, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateEquipment indicates an expected call of UpdateEquipment.
-func (mr *MockServiceMockRecorder) UpdateEquipment(arg0 any) *gomock.Call {
+func (mr *MockServiceMockRecorder) UpdateEquipment(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEquipment", reflect.TypeOf((*MockService)(nil).UpdateEquipment), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEquipment", reflect.TypeOf((*MockService)(nil).UpdateEquipment), ctx, arg1)
 }
 
 // UpdateStatus mocks base method.
-func (m *MockService) UpdateStatus(characterID string, status shared.CharacterStatus) error {
+func (m *MockService) UpdateStatus(ctx context.Context, characterID string, status shared.CharacterStatus) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStatus", characterID, status)
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, characterID, status)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateStatus indicates an expected call of UpdateStatus.
-func (mr *MockServiceMockRecorder) UpdateStatus(characterID, status any) *gomock.Call {
+func (mr *MockServiceMockRecorder) UpdateStatus(ctx, characterID, status any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockService)(nil).UpdateStatus), characterID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockService)(nil).UpdateStatus), ctx, characterID, status)
 }
 
 // ValidateCharacterCreation mocks base method.
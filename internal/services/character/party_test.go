@@ -0,0 +1,53 @@
+package character_test
+
+import (
+	"context"
+	"testing"
+
+	charDomain "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	mockdraftrepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetParty(t *testing.T) {
+	ctx := context.Background()
+	repo := characters.NewInMemoryRepository()
+	service := character.NewService(&character.ServiceConfig{
+		Repository:      repo,
+		DraftRepository: mockdraftrepo.NewMockRepository(gomock.NewController(t)),
+	})
+	for _, char := range []*charDomain.Character{
+		{ID: "char_1", OwnerID: "user_1", Name: "Thorin"},
+		{ID: "char_2", OwnerID: "user_2", Name: "Arwen"},
+		{ID: "char_3", OwnerID: "user_3", Name: "Gollum"},
+	} {
+		require.NoError(t, repo.Create(ctx, char))
+	}
+
+	sess := gameSession.NewSession("session_1", "Goblin Caves", "guild_1", "channel_1", "dm_1")
+	sess.AddMember("user_1", gameSession.SessionRolePlayer)
+	sess.SetCharacter("user_1", "char_1")
+	sess.AddMember("user_2", gameSession.SessionRolePlayer)
+	sess.SetCharacter("user_2", "char_2")
+	sess.AddMember("user_3", gameSession.SessionRoleSpectator)
+	sess.SetCharacter("user_3", "char_3")
+
+	party, err := service.GetParty(sess)
+
+	require.NoError(t, err)
+	require.Len(t, party, 2, "spectators aren't in the party")
+	assert.Equal(t, "Arwen", party[0].Name)
+	assert.Equal(t, "Thorin", party[1].Name)
+
+	t.Run("no characters selected", func(t *testing.T) {
+		empty := gameSession.NewSession("session_2", "Empty", "guild_1", "channel_1", "dm_1")
+		_, err := service.GetParty(empty)
+		assert.True(t, dnderr.IsInvalidArgument(err))
+	})
+}
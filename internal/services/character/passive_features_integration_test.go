@@ -86,7 +86,7 @@ func TestPassiveFeaturesIntegration(t *testing.T) {
 
 		// Mock repository Update
 		mockRepo.EXPECT().
-			Update(ctx, gomock.Any()).
+			Update(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, char *character2.Character) error {
 				// Verify that passive features were applied
 				assert.NotNil(t, char.Features)
@@ -163,7 +163,7 @@ func TestPassiveFeaturesIntegration(t *testing.T) {
 
 		// Mock repository Update
 		mockRepo.EXPECT().
-			Update(ctx, gomock.Any()).
+			Update(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, char *character2.Character) error {
 				// Verify that dwarf racial features exist
 				hasDarkvision := false
@@ -241,7 +241,7 @@ func TestPassiveFeaturesIntegration(t *testing.T) {
 
 		// Mock repository Update
 		mockRepo.EXPECT().
-			Update(ctx, gomock.Any()).
+			Update(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, updated *character2.Character) error {
 				// Verify elf racial features were applied
 				hasKeenSenses := false
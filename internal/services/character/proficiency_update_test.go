@@ -63,7 +63,7 @@ func TestProficiencyUpdateHandling(t *testing.T) {
 		// Mock repository calls
 		mockRepo.EXPECT().Get(ctx, "test-char-1").Return(char, nil)
 		mockDraftRepo.EXPECT().GetByCharacterID(ctx, "test-char-1").Return(nil, nil)
-		mockRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 
 		// Mock proficiency lookups for new selections
 		mockDNDClient.EXPECT().GetProficiency("skill-perception").Return(
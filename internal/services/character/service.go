@@ -6,11 +6,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
 	charDomain "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
 	features2 "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/features"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
@@ -19,6 +21,7 @@ import (
 	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
 	draftRepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft"
 	characterRepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/gamesessions"
 )
 
 // Repository is an alias for the character repository interface
@@ -38,6 +41,35 @@ type Service interface {
 	// ValidateCharacterCreation validates character creation choices
 	ValidateCharacterCreation(ctx context.Context, input *ValidateCharacterInput) error
 
+	// ExportCharacter serializes one of the user's characters to the export format
+	ExportCharacter(ctx context.Context, characterID, userID string) ([]byte, error)
+
+	// ImportCharacter validates an exported character against the rulebook
+	// and saves it as a new character owned by the user
+	ImportCharacter(ctx context.Context, input *ImportCharacterInput) (*charDomain.Character, error)
+
+	// GetCharacterHistory returns a character's saved versions, newest
+	// first, with the fields each version changed
+	GetCharacterHistory(ctx context.Context, characterID string) ([]*CharacterVersion, error)
+
+	// RestoreCharacterVersion saves a character as it was at an earlier version.
+	// While the character is in an active session only its DM can restore it.
+	RestoreCharacterVersion(ctx context.Context, input *RestoreVersionInput) (*charDomain.Character, error)
+
+	// AuditCharacter checks a finalized character against the 5e rules and
+	// reports each violation with a suggested repair
+	AuditCharacter(ctx context.Context, characterID string) (*AuditReport, error)
+
+	// RepairCharacter applies every automatic repair the audit suggests
+	RepairCharacter(ctx context.Context, input *RepairCharacterInput) (*AuditReport, error)
+
+	// RequiredAbilityScoreMethod returns the ability score method the user's
+	// session forces, or AbilityScoreMethodAny
+	RequiredAbilityScoreMethod(ctx context.Context, userID string) (shared.AbilityScoreMethod, error)
+
+	// ApplyPointBuy validates a 27-point buy and assigns it to a draft
+	ApplyPointBuy(ctx context.Context, input *PointBuyInput) (*charDomain.Character, error)
+
 	// ResolveChoices resolves proficiency/equipment choices for a class/race combo
 	ResolveChoices(ctx context.Context, input *ResolveChoicesInput) (*ResolveChoicesOutput, error)
 
@@ -66,10 +98,11 @@ type Service interface {
 	GetEquipmentByCategory(ctx context.Context, category string) ([]equipment.Equipment, error)
 
 	// UpdateStatus updates a character's status
-	UpdateStatus(characterID string, status shared.CharacterStatus) error
+	UpdateStatus(ctx context.Context, characterID string, status shared.CharacterStatus) error
 
-	// UpdateEquipment saves equipment changes for a character
-	UpdateEquipment(character *charDomain.Character) error
+	// UpdateEquipment saves changes to a character, recording the actor and
+	// reason set with WithChange in its history
+	UpdateEquipment(ctx context.Context, character *charDomain.Character) error
 
 	// GetPendingFeatureChoices returns feature choices that need to be made for a character
 	GetPendingFeatureChoices(ctx context.Context, characterID string) ([]*rulebook.FeatureChoice, error)
@@ -83,8 +116,9 @@ type Service interface {
 	// GetByID retrieves a character by ID
 	GetByID(characterID string) (*charDomain.Character, error)
 
-	// FixCharacterAttributes fixes characters that have AbilityAssignments but no Attributes
-	FixCharacterAttributes(ctx context.Context, characterID string) (*charDomain.Character, error)
+	// GetParty loads the characters a session's players have selected,
+	// sorted by name
+	GetParty(sess *gameSession.Session) ([]*charDomain.Character, error)
 
 	// FinalizeCharacterWithName sets the name and finalizes a draft character in one operation
 	FinalizeCharacterWithName(ctx context.Context, characterID, name, raceKey, classKey string) (*charDomain.Character, error)
@@ -146,8 +180,9 @@ type ValidateCharacterInput struct {
 
 // ResolveChoicesInput asks for available choices for a race/class
 type ResolveChoicesInput struct {
-	RaceKey  string
-	ClassKey string
+	RaceKey       string
+	ClassKey      string
+	BackgroundKey string // Optional, leaves the background's proficiencies out of the choices
 }
 
 // ResolveChoicesOutput contains simplified choices for the UI
@@ -160,9 +195,10 @@ type ResolveChoicesOutput struct {
 type UpdateDraftInput struct {
 	RaceKey            *string
 	ClassKey           *string
-	AbilityScores      map[string]int           // Legacy: direct ability -> score mapping
-	AbilityRolls       []charDomain.AbilityRoll // New: rolls with IDs
-	AbilityAssignments map[string]string        // New: ability -> roll ID mapping
+	AbilityScores      map[string]int            // Legacy: direct ability -> score mapping
+	AbilityRolls       []charDomain.AbilityRoll  // New: rolls with IDs
+	AbilityMethod      shared.AbilityScoreMethod // How AbilityRolls were generated; defaults to rolling
+	AbilityAssignments map[string]string         // New: ability -> roll ID mapping
 	Proficiencies      []string
 	Equipment          []string
 	Name               *string
@@ -184,16 +220,18 @@ type ChoiceOption struct {
 	Key         string
 	Name        string
 	Description string
-	BundleItems []string // Equipment keys that come with this choice (e.g., shield with weapon+shield)
+	BundleItems []string          // Equipment keys that come with this choice (e.g., shield with weapon+shield)
+	Nested      *SimplifiedChoice // The choice inside a "nested-" option (e.g., which martial weapon)
 }
 
 // service implements the Service interface
 type service struct {
-	dndClient       dnd5e.Client
-	choiceResolver  ChoiceResolver
-	repository      Repository
-	draftRepository draftRepo.Repository
-	acCalculator    charDomain.ACCalculator
+	dndClient         dnd5e.Client
+	choiceResolver    ChoiceResolver
+	repository        Repository
+	draftRepository   draftRepo.Repository
+	acCalculator      charDomain.ACCalculator
+	sessionRepository gamesessions.Repository
 	// Temporary in-memory session store (should be Redis in production)
 	sessions map[string]*charDomain.CharacterCreationSession
 	// Later we'll add:
@@ -202,11 +240,12 @@ type service struct {
 
 // ServiceConfig holds configuration for the service
 type ServiceConfig struct {
-	DNDClient       dnd5e.Client
-	ChoiceResolver  ChoiceResolver          // Optional, will create default if nil
-	Repository      Repository              // Required
-	DraftRepository draftRepo.Repository    // Required
-	ACCalculator    charDomain.ACCalculator // Optional, will use default if nil
+	DNDClient         dnd5e.Client
+	ChoiceResolver    ChoiceResolver          // Optional, will create default if nil
+	Repository        Repository              // Required
+	DraftRepository   draftRepo.Repository    // Required
+	ACCalculator      charDomain.ACCalculator // Optional, will use default if nil
+	SessionRepository gamesessions.Repository // Optional, enables session-forced ability score methods
 }
 
 // NewService creates a new character service
@@ -219,10 +258,11 @@ func NewService(cfg *ServiceConfig) Service {
 	}
 
 	svc := &service{
-		dndClient:       cfg.DNDClient,
-		repository:      cfg.Repository,
-		draftRepository: cfg.DraftRepository,
-		sessions:        make(map[string]*charDomain.CharacterCreationSession),
+		dndClient:         cfg.DNDClient,
+		repository:        cfg.Repository,
+		draftRepository:   cfg.DraftRepository,
+		sessionRepository: cfg.SessionRepository,
+		sessions:          make(map[string]*charDomain.CharacterCreationSession),
 	}
 
 	// Use provided choice resolver or create default
@@ -448,6 +488,9 @@ func (s *service) ResolveChoices(ctx context.Context, input *ResolveChoicesInput
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve proficiency choices: %w", err)
 	}
+	if input.BackgroundKey != "" {
+		proficiencyChoices = withoutBackgroundProficiencies(proficiencyChoices, rulebook.GetSRDBackground(input.BackgroundKey))
+	}
 
 	// Resolve equipment choices
 	equipmentChoices, err := s.choiceResolver.ResolveEquipmentChoices(ctx, class)
@@ -491,6 +534,17 @@ func (s *service) GetClass(ctx context.Context, classKey string) (*rulebook.Clas
 	return class, nil
 }
 
+// GetBackground retrieves an SRD background. With GetRace and GetClass it
+// makes the service a RulebookSource for rehydrating saved characters.
+func (s *service) GetBackground(ctx context.Context, backgroundKey string) (*rulebook.Background, error) {
+	background := rulebook.GetSRDBackground(backgroundKey)
+	if background == nil {
+		return nil, dnderr.NotFoundf("background '%s' not found", backgroundKey).
+			WithMeta("background_key", backgroundKey)
+	}
+	return background, nil
+}
+
 // GetRaces retrieves all available races
 func (s *service) GetRaces(ctx context.Context) ([]*rulebook.Race, error) {
 	races, err := s.dndClient.ListRaces()
@@ -699,6 +753,11 @@ func (s *service) UpdateDraftCharacter(ctx context.Context, characterID string,
 
 	// Update ability rolls if provided (including clearing with empty slice)
 	if updates.AbilityRolls != nil {
+		if len(updates.AbilityRolls) > 0 {
+			if err := s.validateAbilityRolls(ctx, char, updates.AbilityMethod, updates.AbilityRolls); err != nil {
+				return nil, err
+			}
+		}
 		char.AbilityRolls = updates.AbilityRolls
 	}
 
@@ -742,6 +801,11 @@ func (s *service) UpdateDraftCharacter(ctx context.Context, characterID string,
 
 	// Legacy: Update ability scores if provided directly
 	if len(updates.AbilityScores) > 0 && updates.AbilityAssignments == nil {
+		// Direct scores follow the same method rules as rolls
+		if err := s.validateAbilityRolls(ctx, char, updates.AbilityMethod, abilityScoreRolls(updates.AbilityScores)); err != nil {
+			return nil, err
+		}
+
 		// Clear existing scores
 		char.Attributes = make(map[shared.Attribute]*charDomain.AbilityScore)
 
@@ -1012,6 +1076,21 @@ func (s *service) FinalizeDraftCharacter(ctx context.Context, characterID string
 			}
 			// If feature already exists, keep it as-is with its metadata
 		}
+
+		// Subclasses chosen at level 1 (domains, origins, patrons) grant features right away
+		subclassKey := char.GetSubclassKey()
+		for _, templateFeat := range features2.GetSubclassFeatures(char.Class.Key, subclassKey, char.Level) {
+			if _, exists := existingFeatures[templateFeat.Key]; !exists {
+				featCopy := templateFeat
+				char.Features = append(char.Features, &featCopy)
+				existingFeatures[templateFeat.Key] = &featCopy
+			}
+		}
+
+		// Draconic Resilience and the like add HP for each class level
+		subclassHP := rulebook.SubclassHitPointsPerLevel(subclassKey) * char.Level
+		char.MaxHitPoints += subclassHP
+		char.CurrentHitPoints += subclassHP
 	}
 
 	// Apply passive effects from all features
@@ -1056,6 +1135,12 @@ func (s *service) FinalizeDraftCharacter(ctx context.Context, characterID string
 		}
 	}
 
+	// Bonus proficiencies from the subclass, after the class's own so they
+	// don't count as the class proficiencies already being present
+	for _, proficiency := range rulebook.GetSubclassProficiencies(char.GetSubclassKey()) {
+		char.AddProficiency(proficiency)
+	}
+
 	// Add starting equipment if class is set and DND client is available
 	if s.dndClient != nil && char.Class != nil && char.Class.StartingEquipment != nil {
 		for _, se := range char.Class.StartingEquipment {
@@ -1074,14 +1159,20 @@ func (s *service) FinalizeDraftCharacter(ctx context.Context, characterID string
 		}
 	}
 
+	// Add the background's proficiencies, starting equipment and gold
+	s.addBackground(char)
+
 	// Initialize resources before finalizing
 	char.InitializeResources()
 
+	// New prepared casters choose their spells as if after a long rest
+	char.OpenSpellPreparation()
+
 	// Update status to active
 	char.Status = shared.CharacterStatusActive
 
 	// Save changes
-	if updateErr := s.repository.Update(ctx, char); updateErr != nil {
+	if updateErr := s.repository.Update(WithChange(ctx, char.OwnerID, "finished creating the character"), char); updateErr != nil {
 		return nil, dnderr.Wrap(updateErr, "failed to finalize character").
 			WithMeta("character_id", characterID)
 	}
@@ -1122,13 +1213,11 @@ func generateID() string {
 }
 
 // UpdateStatus updates a character's status
-func (s *service) UpdateStatus(characterID string, status shared.CharacterStatus) error {
+func (s *service) UpdateStatus(ctx context.Context, characterID string, status shared.CharacterStatus) error {
 	if strings.TrimSpace(characterID) == "" {
 		return dnderr.InvalidArgument("character ID is required")
 	}
 
-	ctx := context.Background()
-
 	// Get the character
 	char, err := s.repository.Get(ctx, characterID)
 	if err != nil {
@@ -1140,7 +1229,7 @@ func (s *service) UpdateStatus(characterID string, status shared.CharacterStatus
 	char.Status = status
 
 	// Save changes
-	if err := s.repository.Update(ctx, char); err != nil {
+	if err := s.repository.Update(WithChange(ctx, "", fmt.Sprintf("status changed to %s", status)), char); err != nil {
 		return dnderr.Wrap(err, "failed to update character status").
 			WithMeta("character_id", characterID).
 			WithMeta("status", string(status))
@@ -1149,8 +1238,8 @@ func (s *service) UpdateStatus(characterID string, status shared.CharacterStatus
 	return nil
 }
 
-// UpdateEquipment saves equipment changes for a character
-func (s *service) UpdateEquipment(character *charDomain.Character) error {
+// UpdateEquipment saves changes to a character
+func (s *service) UpdateEquipment(ctx context.Context, character *charDomain.Character) error {
 	if character == nil {
 		return dnderr.InvalidArgument("character is required")
 	}
@@ -1159,8 +1248,6 @@ func (s *service) UpdateEquipment(character *charDomain.Character) error {
 		return dnderr.InvalidArgument("character ID is required")
 	}
 
-	ctx := context.Background()
-
 	// Recalculate AC with the features calculator
 	character.AC = s.acCalculator.Calculate(character)
 
@@ -1262,6 +1349,31 @@ func (s *service) ListByOwner(ownerID string) ([]*charDomain.Character, error) {
 	return chars, nil
 }
 
+// GetParty loads the characters a session's players have selected, sorted by name
+func (s *service) GetParty(sess *gameSession.Session) ([]*charDomain.Character, error) {
+	var party []*charDomain.Character
+	for _, member := range sess.Members {
+		if member.CharacterID == "" || member.Role == gameSession.SessionRoleSpectator {
+			continue
+		}
+		char, err := s.GetByID(member.CharacterID)
+		if err != nil {
+			return nil, err
+		}
+		party = append(party, char)
+	}
+
+	if len(party) == 0 {
+		return nil, dnderr.InvalidArgument("no one in the session has selected a character").
+			WithMeta("session_id", sess.ID)
+	}
+
+	sort.Slice(party, func(i, j int) bool {
+		return party[i].Name < party[j].Name
+	})
+	return party, nil
+}
+
 // GetByID retrieves a character by ID
 func (s *service) GetByID(characterID string) (*charDomain.Character, error) {
 	if strings.TrimSpace(characterID) == "" {
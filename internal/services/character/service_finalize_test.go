@@ -78,7 +78,7 @@ func TestService_FinalizeDraftCharacter_ConvertsAbilityAssignments(t *testing.T)
 	mockDraftRepo.EXPECT().GetByCharacterID(ctx, characterID).Return(nil, nil)
 
 	// Expect update with converted attributes
-	mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, char *character2.Character) error {
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, char *character2.Character) error {
 		// Verify attributes were converted correctly
 		assert.Equal(t, shared.CharacterStatusActive, char.Status)
 
@@ -166,7 +166,7 @@ func TestService_FinalizeDraftCharacter_PreservesExistingAttributes(t *testing.T
 	// Mock GetClassFeatures call (happens during finalization)
 
 	// Expect update without conversion
-	mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, char *character2.Character) error {
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, char *character2.Character) error {
 		// Verify attributes were NOT changed
 		assert.Equal(t, shared.CharacterStatusActive, char.Status)
 		assert.Equal(t, 16, char.Attributes[shared.AttributeStrength].Score)
@@ -94,7 +94,7 @@ func TestSpellSelectionRegression(t *testing.T) {
 			"STR": "0", "DEX": "1", "CON": "2",
 			"INT": "3", "WIS": "4", "CHA": "5",
 		}
-		err = svc.UpdateEquipment(char)
+		err = svc.UpdateEquipment(ctx, char)
 		require.NoError(t, err)
 
 		// Process cantrip selection
@@ -140,7 +140,7 @@ func TestSpellSelectionRegression(t *testing.T) {
 			"STR": "0", "DEX": "1", "CON": "2",
 			"INT": "3", "WIS": "4", "CHA": "5",
 		}
-		err = svc.UpdateEquipment(char)
+		err = svc.UpdateEquipment(ctx, char)
 		require.NoError(t, err)
 
 		// Get flow before cantrip selection
@@ -118,3 +118,24 @@ func (i *ValidateCharacterInput) Validate() error {
 
 	return nil
 }
+
+// Validate checks ImportCharacterInput for validity
+func (i *ImportCharacterInput) Validate() error {
+	if i == nil {
+		return dnderr.InvalidArgument("ImportCharacterInput cannot be nil")
+	}
+
+	if strings.TrimSpace(i.UserID) == "" {
+		return dnderr.InvalidArgument("user ID is required")
+	}
+
+	if strings.TrimSpace(i.RealmID) == "" {
+		return dnderr.InvalidArgument("realm ID is required")
+	}
+
+	if len(i.Data) == 0 {
+		return dnderr.InvalidArgument("export file is empty")
+	}
+
+	return nil
+}
@@ -56,6 +56,21 @@ func (mr *MockServiceMockRecorder) AbandonDungeon(ctx, dungeonID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbandonDungeon", reflect.TypeOf((*MockService)(nil).AbandonDungeon), ctx, dungeonID)
 }
 
+// ClaimTreasure mocks base method.
+func (m *MockService) ClaimTreasure(ctx context.Context, sessionID string) (*dungeon.TreasureClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimTreasure", ctx, sessionID)
+	ret0, _ := ret[0].(*dungeon.TreasureClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimTreasure indicates an expected call of ClaimTreasure.
+func (mr *MockServiceMockRecorder) ClaimTreasure(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimTreasure", reflect.TypeOf((*MockService)(nil).ClaimTreasure), ctx, sessionID)
+}
+
 // CompleteRoom mocks base method.
 func (m *MockService) CompleteRoom(ctx context.Context, dungeonID string) error {
 	m.ctrl.T.Helper()
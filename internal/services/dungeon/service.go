@@ -9,12 +9,16 @@ import (
 	"math/rand"
 	"time"
 
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+
 	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/dungeons"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/loot"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/monster"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/wallet"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/uuid"
 )
 
@@ -46,6 +50,10 @@ type Service interface {
 
 	// AbandonDungeon ends the dungeon run
 	AbandonDungeon(ctx context.Context, dungeonID string) error
+
+	// ClaimTreasure splits the gold in the session's current treasure room
+	// across the party and marks the room looted
+	ClaimTreasure(ctx context.Context, sessionID string) (*TreasureClaim, error)
 }
 
 // CreateDungeonInput contains data for creating a dungeon
@@ -55,6 +63,13 @@ type CreateDungeonInput struct {
 	CreatorID  string
 }
 
+// TreasureClaim is what the party took from a treasure room
+type TreasureClaim struct {
+	Gold   int // Gold pieces split between the party
+	Shares []*wallet.Share
+	Items  []string
+}
+
 // DungeonAction represents an action players can take
 type DungeonAction struct {
 	ID          string
@@ -71,6 +86,7 @@ type service struct {
 	encounterService encounter.Service
 	monsterService   monster.Service
 	lootService      loot.Service
+	walletService    wallet.Service
 	uuidGenerator    uuid.Generator
 	random           *rand.Rand
 }
@@ -80,6 +96,7 @@ type ServiceConfig struct {
 	Repository       Repository        // Required
 	SessionService   session.Service   // Required
 	EncounterService encounter.Service // Required
+	WalletService    wallet.Service    // Required
 	MonsterService   monster.Service   // Optional (will use hardcoded if nil)
 	LootService      loot.Service      // Optional (will use hardcoded if nil)
 	UUIDGenerator    uuid.Generator    // Optional
@@ -96,6 +113,9 @@ func NewService(cfg *ServiceConfig) Service {
 	if cfg.EncounterService == nil {
 		panic("encounter service is required")
 	}
+	if cfg.WalletService == nil {
+		panic("wallet service is required")
+	}
 
 	svc := &service{
 		repository:       cfg.Repository,
@@ -103,6 +123,7 @@ func NewService(cfg *ServiceConfig) Service {
 		encounterService: cfg.EncounterService,
 		monsterService:   cfg.MonsterService,
 		lootService:      cfg.LootService,
+		walletService:    cfg.WalletService,
 		random:           rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 
@@ -385,31 +406,33 @@ func (s *service) generateTrapRoom(difficulty string, roomNumber int) *explorati
 	}
 }
 
-// generateTreasureRoom creates a treasure room
+// generateTreasureRoom creates a treasure room. The hoard is rolled once
+// here and kept on the room, so the party claims what they were shown.
 func (s *service) generateTreasureRoom(difficulty string, roomNumber int) *exploration.DungeonRoom {
+	room := &exploration.DungeonRoom{
+		Type:        exploration.RoomTypeTreasure,
+		Name:        "Treasury Vault",
+		Description: "Chests and artifacts fill the room. Gold coins glitter in piles.",
+		Completed:   false,
+		Challenge:   "Claim your rewards!",
+	}
+
 	// Generate treasure using loot service if available
-	var treasure []string
 	if s.lootService != nil {
-		ctx := context.Background()
-		generatedTreasure, err := s.lootService.GenerateTreasure(ctx, difficulty, roomNumber)
-		if err == nil && len(generatedTreasure) > 0 {
-			treasure = generatedTreasure
+		hoard, err := s.lootService.GenerateHoard(context.Background(), difficulty, roomNumber)
+		if err == nil && hoard != nil {
+			room.Gold = hoard.Gold
+			room.Treasure = hoard.Items
 		}
 	}
 
 	// Fallback to hardcoded treasure if loot service failed or unavailable
-	if len(treasure) == 0 {
-		treasure = []string{"gold", "healing potion", "mysterious artifact"}
+	if room.Gold == 0 && len(room.Treasure) == 0 {
+		room.Gold = 10 * roomNumber
+		room.Treasure = []string{"healing potion", "mysterious artifact"}
 	}
 
-	return &exploration.DungeonRoom{
-		Type:        exploration.RoomTypeTreasure,
-		Name:        "Treasury Vault",
-		Description: "Chests and artifacts fill the room. Gold coins glitter in piles.",
-		Completed:   false,
-		Treasure:    treasure,
-		Challenge:   "Claim your rewards!",
-	}
+	return room
 }
 
 // generateRestRoom creates a rest room
@@ -596,3 +619,82 @@ func (s *service) AbandonDungeon(ctx context.Context, dungeonID string) error {
 
 	return s.repository.Update(ctx, dungeon)
 }
+
+// ClaimTreasure splits the current treasure room's gold across the party
+func (s *service) ClaimTreasure(ctx context.Context, sessionID string) (*TreasureClaim, error) {
+	if sessionID == "" {
+		return nil, dnderr.InvalidArgument("session ID is required")
+	}
+
+	sess, err := s.sessionService.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get session '%s'", sessionID)
+	}
+
+	roomNumber := sess.GetRoomNumber()
+	if sess.Metadata.GetIntOrDefault(string(gameSession.MetadataKeyLootedRoom), 0) == roomNumber {
+		return nil, dnderr.AlreadyExists("the party has already emptied this room").
+			WithMeta("session_id", sessionID)
+	}
+
+	room, dungeon, err := s.currentTreasureRoom(ctx, sess)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mark the room looted before paying out, so a second claim can't pay
+	// the party twice
+	if sess.Metadata == nil {
+		sess.Metadata = make(shared.Metadata)
+	}
+	sess.Metadata.Set(string(gameSession.MetadataKeyLootedRoom), roomNumber)
+	if err := s.sessionService.SaveSession(ctx, sess); err != nil {
+		return nil, dnderr.Wrap(err, "failed to save session")
+	}
+
+	claim := &TreasureClaim{
+		Gold:  room.Gold,
+		Items: room.Treasure,
+	}
+	if room.Gold > 0 {
+		claim.Shares, err = s.walletService.SplitCoins(ctx, sessionID, room.Gold*shared.CoinGold.Value())
+		if err != nil {
+			// Leave the treasure for another try
+			delete(sess.Metadata, string(gameSession.MetadataKeyLootedRoom))
+			if saveErr := s.sessionService.SaveSession(ctx, sess); saveErr != nil {
+				fmt.Printf("Warning: Failed to reopen treasure room: %v\n", saveErr)
+			}
+			return nil, err
+		}
+	}
+
+	if dungeon != nil {
+		dungeon.LootCollected = append(dungeon.LootCollected, room.Treasure...)
+		if err := s.repository.Update(ctx, dungeon); err != nil {
+			// The party has their gold; only the record is out of date
+			fmt.Printf("Warning: Failed to record collected loot: %v\n", err)
+		}
+	}
+
+	return claim, nil
+}
+
+// currentTreasureRoom returns the treasure room the session's party is in.
+// Delves started without a dungeon record roll the room when it's claimed.
+func (s *service) currentTreasureRoom(ctx context.Context, sess *gameSession.Session) (*exploration.DungeonRoom, *exploration.Dungeon, error) {
+	dungeonID := sess.Metadata.GetStringOrDefault("dungeonID", "")
+	if dungeonID == "" {
+		return s.generateTreasureRoom(sess.GetDifficulty(), sess.GetRoomNumber()), nil, nil
+	}
+
+	dungeon, err := s.GetDungeon(ctx, dungeonID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if dungeon.CurrentRoom == nil || dungeon.CurrentRoom.Type != exploration.RoomTypeTreasure {
+		return nil, nil, dnderr.InvalidArgument("the party isn't in a treasure room").
+			WithMeta("dungeon_id", dungeonID)
+	}
+
+	return dungeon.CurrentRoom, dungeon, nil
+}
@@ -7,12 +7,17 @@ import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
 	"testing"
 
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/dungeons"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/services/dungeon"
 	mockencounter "github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/loot"
 	mockloot "github.com/KirkDiggler/dnd-bot-discord/internal/services/loot/mock"
 	mockmonster "github.com/KirkDiggler/dnd-bot-discord/internal/services/monster/mock"
 	mocksession "github.com/KirkDiggler/dnd-bot-discord/internal/services/session/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/wallet"
+	mockwallet "github.com/KirkDiggler/dnd-bot-discord/internal/services/wallet/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -45,6 +50,7 @@ func TestDungeonService_CreateDungeon_WithMonsterService(t *testing.T) {
 		Repository:       repo,
 		SessionService:   sessionService,
 		EncounterService: encounterService,
+		WalletService:    mockwallet.NewMockService(ctrl),
 		MonsterService:   monsterService,
 	})
 
@@ -90,6 +96,7 @@ func TestDungeonService_CreateDungeon_WithLootService(t *testing.T) {
 		Repository:       repo,
 		SessionService:   sessionService,
 		EncounterService: encounterService,
+		WalletService:    mockwallet.NewMockService(ctrl),
 		LootService:      lootService,
 	})
 
@@ -131,6 +138,7 @@ func TestDungeonService_CreateDungeon_FallbackWithoutServices(t *testing.T) {
 		Repository:       repo,
 		SessionService:   sessionService,
 		EncounterService: encounterService,
+		WalletService:    mockwallet.NewMockService(ctrl),
 		// No MonsterService or LootService - should fallback to hardcoded
 	})
 
@@ -152,3 +160,119 @@ func TestDungeonService_CreateDungeon_FallbackWithoutServices(t *testing.T) {
 		assert.Contains(t, possibleMonsters, monster)
 	}
 }
+
+func TestDungeonService_ClaimTreasure_SplitsTheRoomsGold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := dungeons.NewInMemoryRepository()
+	sessionService := mocksession.NewMockService(ctrl)
+	lootService := mockloot.NewMockService(ctrl)
+	walletService := mockwallet.NewMockService(ctrl)
+
+	sess := &session.Session{
+		ID:       "session-123",
+		Metadata: shared.Metadata{"roomNumber": 3, "difficulty": "easy"},
+	}
+	sessionService.EXPECT().GetSession(gomock.Any(), "session-123").Return(sess, nil).AnyTimes()
+	sessionService.EXPECT().SaveSession(gomock.Any(), sess).Return(nil)
+
+	// The hoard is rolled once, as the room is generated
+	lootService.EXPECT().GenerateHoard(gomock.Any(), "easy", 3).Return(&loot.Hoard{
+		Gold:  30,
+		Items: []string{"healing potion"},
+	}, nil).Times(1)
+	walletService.EXPECT().SplitCoins(gomock.Any(), "session-123", 3000).Return([]*wallet.Share{
+		{Value: 1500}, {Value: 1500},
+	}, nil)
+
+	service := dungeon.NewService(&dungeon.ServiceConfig{
+		Repository:       repo,
+		SessionService:   sessionService,
+		EncounterService: mockencounter.NewMockService(ctrl),
+		LootService:      lootService,
+		WalletService:    walletService,
+	})
+
+	claim, err := service.ClaimTreasure(context.Background(), "session-123")
+	require.NoError(t, err)
+	assert.Equal(t, 30, claim.Gold)
+	assert.Len(t, claim.Shares, 2)
+	assert.Equal(t, []string{"healing potion"}, claim.Items)
+
+	_, err = service.ClaimTreasure(context.Background(), "session-123")
+	assert.True(t, dnderr.IsAlreadyExists(err), "each room is looted once")
+}
+
+func TestDungeonService_ClaimTreasure_UsesTheStoredHoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := dungeons.NewInMemoryRepository()
+	sessionService := mocksession.NewMockService(ctrl)
+	walletService := mockwallet.NewMockService(ctrl)
+
+	require.NoError(t, repo.Create(context.Background(), &exploration.Dungeon{
+		ID:         "dungeon-123",
+		SessionID:  "session-123",
+		RoomNumber: 2,
+		CurrentRoom: &exploration.DungeonRoom{
+			Type:     exploration.RoomTypeTreasure,
+			Gold:     50,
+			Treasure: []string{"mysterious artifact"},
+		},
+	}))
+
+	sess := &session.Session{
+		ID:       "session-123",
+		Metadata: shared.Metadata{"roomNumber": 2, "dungeonID": "dungeon-123"},
+	}
+	sessionService.EXPECT().GetSession(gomock.Any(), "session-123").Return(sess, nil)
+	sessionService.EXPECT().SaveSession(gomock.Any(), sess).Return(nil)
+	walletService.EXPECT().SplitCoins(gomock.Any(), "session-123", 5000).Return([]*wallet.Share{{Value: 5000}}, nil)
+
+	// No loot service expectations: claiming must not roll a new hoard
+	service := dungeon.NewService(&dungeon.ServiceConfig{
+		Repository:       repo,
+		SessionService:   sessionService,
+		EncounterService: mockencounter.NewMockService(ctrl),
+		LootService:      mockloot.NewMockService(ctrl),
+		WalletService:    walletService,
+	})
+
+	claim, err := service.ClaimTreasure(context.Background(), "session-123")
+	require.NoError(t, err)
+	assert.Equal(t, 50, claim.Gold)
+
+	dung, err := repo.Get(context.Background(), "dungeon-123")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mysterious artifact"}, dung.LootCollected)
+}
+
+func TestDungeonService_ClaimTreasure_FailedSplitLeavesTheTreasure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionService := mocksession.NewMockService(ctrl)
+	walletService := mockwallet.NewMockService(ctrl)
+
+	sess := &session.Session{
+		ID:       "session-123",
+		Metadata: shared.Metadata{"roomNumber": 4},
+	}
+	sessionService.EXPECT().GetSession(gomock.Any(), "session-123").Return(sess, nil)
+	sessionService.EXPECT().SaveSession(gomock.Any(), sess).Return(nil).Times(2)
+	walletService.EXPECT().SplitCoins(gomock.Any(), "session-123", gomock.Any()).
+		Return(nil, dnderr.InvalidArgument("no one in the party has a character"))
+
+	service := dungeon.NewService(&dungeon.ServiceConfig{
+		Repository:       dungeons.NewInMemoryRepository(),
+		SessionService:   sessionService,
+		EncounterService: mockencounter.NewMockService(ctrl),
+		WalletService:    walletService,
+	})
+
+	_, err := service.ClaimTreasure(context.Background(), "session-123")
+	require.Error(t, err)
+	assert.Equal(t, 0, sess.Metadata.GetIntOrDefault(string(session.MetadataKeyLootedRoom), 0))
+}
@@ -0,0 +1,86 @@
+package encounter
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+)
+
+// attackingWeapon returns the weapon a character attacks with, or nil when unarmed
+func attackingWeapon(char *character.Character) *equipment.Weapon {
+	for _, slot := range []shared.Slot{shared.SlotMainHand, shared.SlotTwoHanded} {
+		if weapon, ok := char.EquippedSlots[slot].(*equipment.Weapon); ok {
+			return weapon
+		}
+	}
+	return nil
+}
+
+// spendAmmunition uses up a piece of ammunition for an attack with a ranged
+// weapon, recording it on the attacker so some can be recovered later
+func spendAmmunition(attacker *combat.Combatant, char *character.Character) error {
+	weapon := attackingWeapon(char)
+	key, ok := char.SpendAmmunition(weapon)
+	if !ok {
+		return dnderr.InvalidArgumentf("%s is out of ammunition for the %s", char.Name, weapon.GetName()).
+			WithMeta("ammunition", key)
+	}
+	if key == "" {
+		return nil
+	}
+
+	if attacker.AmmoSpent == nil {
+		attacker.AmmoSpent = make(map[string]int)
+	}
+	attacker.AmmoSpent[key]++
+	return nil
+}
+
+// recoverAmmunition gives players back half the ammunition they fired once
+// the encounter is over. The caller saves the encounter.
+func (s *service) recoverAmmunition(ctx context.Context, encounter *combat.Encounter) {
+	if encounter.Status != combat.EncounterStatusCompleted {
+		return
+	}
+
+	for _, combatant := range encounter.Combatants {
+		if len(combatant.AmmoSpent) == 0 || combatant.CharacterID == "" {
+			continue
+		}
+
+		char, err := s.characterService.GetByID(combatant.CharacterID)
+		if err != nil {
+			log.Printf("Failed to get %s to recover ammunition: %v", combatant.Name, err)
+			continue
+		}
+
+		for key, spent := range combatant.AmmoSpent {
+			recovered := spent / 2
+			if recovered == 0 {
+				continue
+			}
+			char.RecoverAmmunition(key, recovered)
+			encounter.AddCombatLogEntry(fmt.Sprintf("🏹 %s recovers %d of %d %s", combatant.Name, recovered, spent, ammunitionName(key)))
+		}
+		combatant.AmmoSpent = nil
+
+		if err := s.characterService.UpdateEquipment(charService.WithChange(ctx, char.OwnerID, "recovered ammunition"), char); err != nil {
+			log.Printf("Failed to save %s after recovering ammunition: %v", char.Name, err)
+		}
+	}
+}
+
+// ammunitionName returns the display name of an ammunition key
+func ammunitionName(key string) string {
+	if ammo := equipment.NewSRDConsumable(key, 1); ammo != nil {
+		return ammo.GetName() + "s"
+	}
+	return key
+}
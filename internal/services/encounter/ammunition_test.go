@@ -0,0 +1,122 @@
+package encounter_test
+
+import (
+	"context"
+	"testing"
+
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/damage"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	mockencrepo "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/encounters/mock"
+	mockcharacter "github.com/KirkDiggler/dnd-bot-discord/internal/services/character/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	mocksession "github.com/KirkDiggler/dnd-bot-discord/internal/services/session/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// setupArcher creates an encounter where an archer carrying the given number
+// of arrows faces a goblin with 1 HP
+func setupArcher(t *testing.T, arrows int, roller *mockdice.ManualMockRoller) (encounter.Service, *combat.Encounter, *character.Character) {
+	ctrl := gomock.NewController(t)
+	repo := mockencrepo.NewMockRepository(ctrl)
+	charSvc := mockcharacter.NewMockService(ctrl)
+	sessionSvc := mocksession.NewMockService(ctrl)
+
+	shortbow := &equipment.Weapon{
+		Base:           equipment.BasicEquipment{Key: "shortbow", Name: "Shortbow"},
+		Damage:         &damage.Damage{DiceCount: 1, DiceSize: 6, DamageType: damage.TypePiercing},
+		WeaponCategory: "Simple",
+		WeaponRange:    "Ranged",
+		Properties: []*shared.ReferenceItem{
+			{Key: "ammunition"},
+			{Key: "two-handed"},
+		},
+	}
+	archer := &character.Character{
+		ID:      "char-1",
+		Name:    "Archer",
+		OwnerID: "player-1",
+		Level:   1,
+		Attributes: map[shared.Attribute]*character.AbilityScore{
+			shared.AttributeDexterity: {Score: 14, Bonus: 2},
+		},
+		EquippedSlots: map[shared.Slot]equipment.Equipment{shared.SlotTwoHanded: shortbow},
+	}
+	archer.WithDiceRoller(roller)
+	if arrows > 0 {
+		archer.AddInventory(equipment.NewSRDConsumable("arrow", arrows))
+	}
+
+	enc := combat.NewEncounter("enc-1", "session-1", "channel-1", "Ambush", "dm-1")
+	enc.AddCombatant(&combat.Combatant{
+		ID: "player", Name: "Archer", Type: combat.CombatantTypePlayer,
+		PlayerID: "player-1", CharacterID: archer.ID,
+		CurrentHP: 10, MaxHP: 10, AC: 14, IsActive: true,
+	})
+	enc.AddCombatant(&combat.Combatant{
+		ID: "goblin", Name: "Goblin", Type: combat.CombatantTypeMonster,
+		CurrentHP: 1, MaxHP: 7, AC: 10, IsActive: true,
+	})
+	enc.Status = combat.EncounterStatusActive
+	enc.TurnOrder = []string{"player", "goblin"}
+
+	repo.EXPECT().Get(gomock.Any(), enc.ID).Return(enc, nil).AnyTimes()
+	repo.EXPECT().Update(gomock.Any(), enc).Return(nil).AnyTimes()
+	sessionSvc.EXPECT().GetSession(gomock.Any(), "session-1").Return(&session.Session{ID: "session-1"}, nil).AnyTimes()
+	charSvc.EXPECT().GetByID(archer.ID).Return(archer, nil).AnyTimes()
+	charSvc.EXPECT().UpdateEquipment(gomock.Any(), archer).Return(nil).AnyTimes()
+
+	svc := encounter.NewService(&encounter.ServiceConfig{
+		Repository:       repo,
+		SessionService:   sessionSvc,
+		CharacterService: charSvc,
+		DiceRoller:       roller,
+	})
+	return svc, enc, archer
+}
+
+func TestPerformAttack_SpendsAndRecoversAmmunition(t *testing.T) {
+	roller := mockdice.NewManualMockRoller()
+	svc, enc, archer := setupArcher(t, 4, roller)
+	attack := &encounter.AttackInput{EncounterID: enc.ID, AttackerID: "player", TargetID: "goblin", UserID: "player-1"}
+
+	// A natural 1 misses; damage is rolled either way
+	roller.SetRolls([]int{1, 1})
+	result, err := svc.PerformAttack(context.Background(), attack)
+	require.NoError(t, err)
+	assert.False(t, result.Hit)
+	assert.Equal(t, 3, archer.ConsumableCount("arrow"))
+	assert.Equal(t, 1, enc.Combatants["player"].AmmoSpent["arrow"])
+
+	// The second arrow kills the goblin and ends the fight
+	roller.SetRolls([]int{15, 4})
+	result, err = svc.PerformAttack(context.Background(), attack)
+	require.NoError(t, err)
+	assert.True(t, result.CombatEnded)
+
+	// Half of the 2 arrows fired are recovered
+	assert.Equal(t, 3, archer.ConsumableCount("arrow"))
+	assert.Empty(t, enc.Combatants["player"].AmmoSpent)
+}
+
+func TestPerformAttack_OutOfAmmunition(t *testing.T) {
+	roller := mockdice.NewManualMockRoller()
+	svc, enc, _ := setupArcher(t, 0, roller)
+
+	_, err := svc.PerformAttack(context.Background(), &encounter.AttackInput{
+		EncounterID: enc.ID,
+		AttackerID:  "player",
+		TargetID:    "goblin",
+		UserID:      "player-1",
+	})
+
+	require.Error(t, err)
+	assert.True(t, dnderr.IsInvalidArgument(err))
+}
@@ -0,0 +1,157 @@
+package encounter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/adapters/rpgtoolkit"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	rpgevents "github.com/KirkDiggler/rpg-toolkit/events"
+)
+
+// saveReasonConcentration marks a concentration save for saving throw subscribers such as War Caster
+const saveReasonConcentration = "concentration"
+
+// StartConcentration records the spell a caster is concentrating on,
+// ending the caster's previous concentration spell and its effects, and
+// adds the new spell's effects, keyed by combatant ID, in the same update
+func (s *service) StartConcentration(ctx context.Context, encounterID, casterID, spellKey, spellName string, effects map[string]*shared.ActiveEffect) (*combat.Concentration, error) {
+	encounter, err := s.repository.Get(ctx, encounterID)
+	if err != nil {
+		return nil, dnderr.Wrap(err, "failed to get encounter")
+	}
+
+	if _, exists := encounter.Combatants[casterID]; !exists {
+		return nil, dnderr.InvalidArgument("combatant not found")
+	}
+	for combatantID := range effects {
+		if _, exists := encounter.Combatants[combatantID]; !exists {
+			return nil, dnderr.InvalidArgument("combatant not found")
+		}
+	}
+
+	// The previous spell's effects end before the new ones go on
+	dropped := encounter.StartConcentration(casterID, spellKey, spellName)
+	for combatantID, effect := range effects {
+		if effect.ID == "" {
+			effect.ID = s.uuidGenerator.New()
+		}
+		combatant := encounter.Combatants[combatantID]
+		combatant.ActiveEffects = append(combatant.ActiveEffects, effect)
+	}
+
+	if err := s.repository.Update(ctx, encounter); err != nil {
+		return nil, dnderr.Wrap(err, "failed to update encounter")
+	}
+	return dropped, nil
+}
+
+// checkConcentration makes a concentrating combatant roll a Constitution
+// save after taking damage. Dropping to 0 HP ends concentration outright.
+// The caller saves the encounter.
+func (s *service) checkConcentration(encounter *combat.Encounter, combatant *combat.Combatant, damage int, rules *gameSession.HouseRules) {
+	concentration := encounter.GetConcentration(combatant.ID)
+	if concentration == nil || damage <= 0 {
+		return
+	}
+
+	if combatant.CurrentHP == 0 {
+		encounter.EndConcentration(combatant.ID, "incapacitated")
+		return
+	}
+
+	dc := combat.ConcentrationSaveDC(damage)
+	bonus, advantage := s.concentrationSaveBonus(combatant, dc)
+
+	disadvantage := s.encumbranceDisadvantage(rules, combatant, shared.AttributeConstitution)
+	roll, err := s.rollSave(advantage, disadvantage)
+	if err != nil {
+		log.Printf("Failed to roll concentration save for %s: %v", combatant.Name, err)
+		return
+	}
+	total := roll + bonus
+
+	rollText := fmt.Sprintf("%d", total)
+	switch {
+	case advantage && !disadvantage:
+		rollText += " with advantage"
+	case disadvantage && !advantage:
+		rollText += " with disadvantage"
+	}
+	if total >= dc {
+		encounter.AddCombatLogEntry(fmt.Sprintf("🧠 %s keeps concentration on %s (CON save %s vs DC %d)",
+			combatant.Name, concentration.SpellName, rollText, dc))
+		return
+	}
+
+	encounter.EndConcentration(combatant.ID, fmt.Sprintf("failed CON save %s vs DC %d", rollText, dc))
+}
+
+// concentrationSaveBonus returns a combatant's Constitution save bonus and
+// whether they roll with advantage. Players use their character sheet and
+// let feats like War Caster grant advantage; monsters use their ability score.
+func (s *service) concentrationSaveBonus(combatant *combat.Combatant, dc int) (bonus int, advantage bool) {
+	if combatant.CharacterID == "" {
+		if score, ok := combatant.Abilities[strings.ToUpper(string(shared.AttributeConstitution))]; ok {
+			bonus = abilityModifier(score)
+		}
+		return bonus, false
+	}
+
+	char, err := s.characterService.GetByID(combatant.CharacterID)
+	if err != nil || char == nil {
+		log.Printf("Failed to load %s for concentration save: %v", combatant.Name, err)
+		return 0, false
+	}
+	bonus = char.GetSavingThrowBonus(shared.AttributeConstitution)
+
+	saveEvent, err := rpgtoolkit.CreateAndEmitEvent(s.eventBus, rpgevents.EventBeforeSavingThrow, char, nil, map[string]interface{}{
+		rpgtoolkit.ContextSaveType: "constitution",
+		"save_reason":              saveReasonConcentration,
+		rpgtoolkit.ContextDC:       dc,
+	})
+	if err != nil {
+		rpgtoolkit.LogEventError("BeforeSavingThrow", err)
+	}
+	if saveEvent != nil {
+		advantage, _ = rpgtoolkit.GetBoolContext(saveEvent, rpgtoolkit.ContextHasAdvantage)
+	}
+	return bonus, advantage
+}
+
+// rollSave rolls a d20 with advantage or disadvantage. Having both cancels out.
+func (s *service) rollSave(advantage, disadvantage bool) (int, error) {
+	if advantage && !disadvantage {
+		roll, err := s.diceRoller.RollWithAdvantage(20, 0)
+		if err != nil {
+			return 0, err
+		}
+		return roll.Total, nil
+	}
+	if disadvantage && !advantage {
+		roll, err := s.diceRoller.RollWithDisadvantage(20, 0)
+		if err != nil {
+			return 0, err
+		}
+		return roll.Total, nil
+	}
+
+	roll, err := s.diceRoller.Roll(1, 20, 0)
+	if err != nil {
+		return 0, err
+	}
+	return roll.Total, nil
+}
+
+// abilityModifier converts an ability score to its modifier
+func abilityModifier(score int) int {
+	if score >= 10 {
+		return (score - 10) / 2
+	}
+	return (score - 11) / 2
+}
@@ -0,0 +1,173 @@
+package encounter_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
+	character2 "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	session2 "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/encounters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/gamesessions"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+	rpgevents "github.com/KirkDiggler/rpg-toolkit/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupConcentrationEncounter creates an encounter with a CON 14 cleric
+// concentrating on Bless, which is blessing a goblin-fighting ally
+func setupConcentrationEncounter(t *testing.T, roller *mockdice.ManualMockRoller, warCaster bool) (encounter.Service, *combat.Encounter, string) {
+	t.Helper()
+	ctx := context.Background()
+
+	cleric := &character2.Character{
+		ID:               "char-1",
+		Name:             "Cleric",
+		OwnerID:          "player-1",
+		Status:           shared.CharacterStatusActive,
+		Level:            3,
+		CurrentHitPoints: 24,
+		MaxHitPoints:     24,
+		AC:               16,
+		Attributes: map[shared.Attribute]*character2.AbilityScore{
+			shared.AttributeConstitution: {Score: 14, Bonus: 2},
+		},
+	}
+	if warCaster {
+		cleric.Features = []*rulebook.CharacterFeature{{Key: "war_caster", Name: "War Caster", Type: rulebook.FeatureTypeFeat}}
+	}
+
+	charRepo := characters.NewInMemoryRepository()
+	require.NoError(t, charRepo.Create(ctx, cleric))
+	charService := character.NewService(&character.ServiceConfig{
+		Repository:      charRepo,
+		DraftRepository: character_draft.NewInMemoryRepository(),
+	})
+
+	sessionRepo := gamesessions.NewInMemoryRepository()
+	sessionService := session.NewService(&session.ServiceConfig{
+		Repository:       sessionRepo,
+		CharacterService: charService,
+	})
+	require.NoError(t, sessionRepo.Create(ctx, &session2.Session{
+		ID:         "test-session",
+		Name:       "Concentration",
+		InviteCode: "CONC01",
+		ChannelID:  "channel-1",
+		CreatorID:  "user-1",
+		DMID:       "user-1",
+		Members: map[string]*session2.SessionMember{
+			"user-1": {UserID: "user-1", Role: session2.SessionRoleDM},
+		},
+		Settings:   session2.DefaultSessionSettings(),
+		Status:     session2.SessionStatusActive,
+		CreatedAt:  time.Now(),
+		LastActive: time.Now(),
+	}))
+
+	svc := encounter.NewService(&encounter.ServiceConfig{
+		Repository:       encounters.NewInMemoryRepository(),
+		SessionService:   sessionService,
+		CharacterService: charService,
+		DiceRoller:       roller,
+		EventBus:         rpgevents.NewBus(),
+	})
+
+	enc, err := svc.CreateEncounter(ctx, &encounter.CreateEncounterInput{
+		SessionID: "test-session",
+		ChannelID: "channel-1",
+		Name:      "Goblin Ambush",
+		UserID:    "user-1",
+	})
+	require.NoError(t, err)
+
+	goblin, err := svc.AddMonster(ctx, enc.ID, "user-1", &encounter.AddMonsterInput{Name: "Goblin", MaxHP: 7, AC: 15})
+	require.NoError(t, err)
+	player, err := svc.AddPlayer(ctx, enc.ID, "player-1", "char-1")
+	require.NoError(t, err)
+
+	dropped, err := svc.StartConcentration(ctx, enc.ID, player.ID, "bless", "Bless", map[string]*shared.ActiveEffect{
+		player.ID: {
+			Name:                  "Bless",
+			SourceID:              player.ID,
+			RequiresConcentration: true,
+		},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, dropped)
+
+	enc, err = svc.GetEncounter(ctx, enc.ID)
+	require.NoError(t, err)
+	require.NotNil(t, enc.Combatants[goblin.ID])
+	return svc, enc, player.ID
+}
+
+func TestApplyDamage_ConcentrationSave(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("failed save ends the spell and its effects", func(t *testing.T) {
+		roller := mockdice.NewManualMockRoller()
+		svc, enc, casterID := setupConcentrationEncounter(t, roller, false)
+		roller.SetRolls([]int{7}) // 7 + 2 = 9 vs DC 10
+
+		require.NoError(t, svc.ApplyDamage(ctx, enc.ID, casterID, "user-1", 8))
+
+		enc, err := svc.GetEncounter(ctx, enc.ID)
+		require.NoError(t, err)
+		assert.Nil(t, enc.GetConcentration(casterID))
+		assert.Empty(t, enc.Combatants[casterID].ActiveEffects)
+
+		logText := strings.Join(enc.CombatLog, "\n")
+		assert.Contains(t, logText, "Cleric loses concentration on Bless (failed CON save 9 vs DC 10)")
+		assert.Contains(t, logText, "Bless ends on Cleric")
+	})
+
+	t.Run("DC is half the damage when that is higher", func(t *testing.T) {
+		roller := mockdice.NewManualMockRoller()
+		svc, enc, casterID := setupConcentrationEncounter(t, roller, false)
+		roller.SetRolls([]int{10}) // 10 + 2 = 12 vs DC 11
+
+		require.NoError(t, svc.ApplyDamage(ctx, enc.ID, casterID, "user-1", 22))
+
+		enc, err := svc.GetEncounter(ctx, enc.ID)
+		require.NoError(t, err)
+		require.NotNil(t, enc.GetConcentration(casterID))
+		assert.Len(t, enc.Combatants[casterID].ActiveEffects, 1)
+		assert.Contains(t, strings.Join(enc.CombatLog, "\n"), "Cleric keeps concentration on Bless (CON save 12 vs DC 11)")
+	})
+
+	t.Run("War Caster rolls with advantage", func(t *testing.T) {
+		roller := mockdice.NewManualMockRoller()
+		svc, enc, casterID := setupConcentrationEncounter(t, roller, true)
+		roller.SetRolls([]int{3, 15})
+
+		require.NoError(t, svc.ApplyDamage(ctx, enc.ID, casterID, "user-1", 8))
+
+		enc, err := svc.GetEncounter(ctx, enc.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, enc.GetConcentration(casterID))
+		assert.Contains(t, strings.Join(enc.CombatLog, "\n"), "CON save 17 with advantage vs DC 10")
+	})
+
+	t.Run("dropping to 0 HP ends concentration", func(t *testing.T) {
+		roller := mockdice.NewManualMockRoller()
+		svc, enc, casterID := setupConcentrationEncounter(t, roller, false)
+
+		require.NoError(t, svc.ApplyDamage(ctx, enc.ID, casterID, "user-1", 30))
+
+		enc, err := svc.GetEncounter(ctx, enc.ID)
+		require.NoError(t, err)
+		assert.Nil(t, enc.GetConcentration(casterID))
+		assert.Contains(t, strings.Join(enc.CombatLog, "\n"), "loses concentration on Bless (incapacitated)")
+	})
+}
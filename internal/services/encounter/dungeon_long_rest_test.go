@@ -93,7 +93,7 @@ func TestAddPlayer_DungeonLongRest(t *testing.T) {
 	mockSessionService.EXPECT().GetSession(ctx, sessionID).Return(dungeonSession, nil)
 
 	// Expect the character to be saved after long rest
-	mockCharService.EXPECT().UpdateEquipment(gomock.Any()).DoAndReturn(func(char *character.Character) error {
+	mockCharService.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, char *character.Character) error {
 		// Verify the character's resources were reset
 		assert.Equal(t, 3, char.Resources.Abilities["rage"].UsesRemaining, "Rage uses should be reset to max")
 		assert.Equal(t, 30, char.Resources.HP.Current, "HP should be restored to max")
@@ -101,7 +101,7 @@ func TestAddPlayer_DungeonLongRest(t *testing.T) {
 	})
 
 	// Expect the character to be saved after action economy reset
-	mockCharService.EXPECT().UpdateEquipment(gomock.Any()).Return(nil)
+	mockCharService.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).Return(nil)
 
 	mockUUID.EXPECT().New().Return(combatantID)
 	mockRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
@@ -194,7 +194,7 @@ func TestAddPlayer_NonDungeonNoLongRest(t *testing.T) {
 	mockSessionService.EXPECT().GetSession(ctx, sessionID).Return(regularSession, nil)
 
 	// Expect the character to be saved after action economy reset (but no long rest)
-	mockCharService.EXPECT().UpdateEquipment(gomock.Any()).Return(nil)
+	mockCharService.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).Return(nil)
 
 	mockUUID.EXPECT().New().Return(combatantID)
 	mockRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
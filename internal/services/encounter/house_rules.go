@@ -0,0 +1,214 @@
+package encounter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+)
+
+// getHouseRules looks up the house rules for the encounter's session.
+// Falls back to standard rules if the session can't be loaded.
+func (s *service) getHouseRules(ctx context.Context, encounter *combat.Encounter) *gameSession.HouseRules {
+	if encounter.SessionID == "" {
+		return &gameSession.HouseRules{}
+	}
+
+	sess, err := s.sessionService.GetSession(ctx, encounter.SessionID)
+	if err != nil {
+		log.Printf("Failed to load house rules for session %s, using standard rules: %v", encounter.SessionID, err)
+		return &gameSession.HouseRules{}
+	}
+
+	return sess.GetHouseRules()
+}
+
+// encumbranceDisadvantage checks if a player's load gives them disadvantage on
+// rolls using the attribute under the variant encumbrance rule. The character
+// is loaded for every roll so inventory changes mid-combat count.
+func (s *service) encumbranceDisadvantage(rules *gameSession.HouseRules, combatant *combat.Combatant, attribute shared.Attribute) bool {
+	if !rules.VariantEncumbrance || combatant.CharacterID == "" {
+		return false
+	}
+
+	char, err := s.characterService.GetByID(combatant.CharacterID)
+	if err != nil {
+		log.Printf("Failed to load %s for encumbrance: %v", combatant.Name, err)
+		return false
+	}
+	return char.EncumbranceDisadvantageOn(attribute, true)
+}
+
+// refreshSpeed recomputes a player's speed from their current load
+func (s *service) refreshSpeed(rules *gameSession.HouseRules, combatant *combat.Combatant) {
+	if combatant == nil || combatant.CharacterID == "" {
+		return
+	}
+
+	char, err := s.characterService.GetByID(combatant.CharacterID)
+	if err != nil {
+		log.Printf("Failed to load %s for speed: %v", combatant.Name, err)
+		return
+	}
+	combatant.Speed = char.EffectiveSpeed(rules.VariantEncumbrance)
+}
+
+// rollInitiative rolls initiative for every active combatant and returns the
+// resulting turn order. Defeated combatants are kept at the end of the order
+// so they can still be found by index.
+func (s *service) rollInitiative(encounter *combat.Encounter, rules *gameSession.HouseRules) ([]string, error) {
+	// Sort combatant IDs to ensure deterministic order for testing
+	combatantIDs := make([]string, 0, len(encounter.Combatants))
+	for id := range encounter.Combatants {
+		combatantIDs = append(combatantIDs, id)
+	}
+	sort.Strings(combatantIDs)
+
+	var active, defeated []string
+	for _, id := range combatantIDs {
+		combatant := encounter.Combatants[id]
+		if encounter.Status == combat.EncounterStatusSetup || (combatant.IsActive && combatant.CurrentHP > 0) {
+			active = append(active, id)
+		} else {
+			defeated = append(defeated, id)
+		}
+	}
+
+	if rules.IsEnabled(gameSession.HouseRuleSideInitiative) {
+		if err := s.rollSideInitiative(encounter, active); err != nil {
+			return nil, err
+		}
+	} else {
+		groupInitiative := rules.IsEnabled(gameSession.HouseRuleGroupInitiative)
+		groupRolls := make(map[string]int)
+
+		for _, id := range active {
+			combatant := encounter.Combatants[id]
+
+			// Identical monsters share the first group member's roll
+			groupSize := encounter.GroupSize(combatant)
+			inGroup := groupInitiative && combatant.Type == combat.CombatantTypeMonster && groupSize > 1
+			if inGroup {
+				if initiative, rolled := groupRolls[combatant.GroupKey]; rolled {
+					combatant.Initiative = initiative
+					continue
+				}
+			}
+
+			// Initiative is a DEX check, so a heavy load gives disadvantage
+			var result *dice.RollResult
+			var err error
+			if s.encumbranceDisadvantage(rules, combatant, shared.AttributeDexterity) {
+				result, err = s.diceRoller.RollWithDisadvantage(20, combatant.InitiativeBonus)
+			} else {
+				result, err = s.diceRoller.Roll(1, 20, combatant.InitiativeBonus)
+			}
+			if err != nil {
+				return nil, dnderr.Wrap(err, "failed to roll initiative")
+			}
+			combatant.Initiative = result.Total
+
+			// Log the initiative roll
+			name := combatant.Name
+			if inGroup {
+				groupRolls[combatant.GroupKey] = combatant.Initiative
+				name = fmt.Sprintf("%s group (x%d)", combatant.GroupKey, groupSize)
+			}
+			logEntry := fmt.Sprintf("**%s** rolls initiative: %v + %d = **%d**",
+				name,
+				result.Total-result.Bonus, // The d20 roll
+				combatant.InitiativeBonus,
+				combatant.Initiative)
+			if len(result.Rolls) > 1 {
+				logEntry += " (disadvantage from a heavy load)"
+			}
+			encounter.CombatLog = append(encounter.CombatLog, logEntry)
+		}
+	}
+
+	// Sort combatants by initiative (descending). Ties go to the players,
+	// then to the higher initiative bonus. Monster groups stay together.
+	sort.SliceStable(active, func(i, j int) bool {
+		a, b := encounter.Combatants[active[i]], encounter.Combatants[active[j]]
+		if a.Initiative != b.Initiative {
+			return a.Initiative > b.Initiative
+		}
+		if a.IsPlayerSide() != b.IsPlayerSide() {
+			return a.IsPlayerSide()
+		}
+		if a.InitiativeBonus != b.InitiativeBonus {
+			return a.InitiativeBonus > b.InitiativeBonus
+		}
+		if a.GroupKey != b.GroupKey {
+			return a.GroupKey < b.GroupKey
+		}
+		return a.Name < b.Name
+	})
+
+	return append(active, defeated...), nil
+}
+
+// rollSideInitiative rolls a single d20 for the party and one for the monsters.
+// Every member of a side shares its roll; the party wins ties when sorted.
+func (s *service) rollSideInitiative(encounter *combat.Encounter, combatantIDs []string) error {
+	partyRoll, err := s.diceRoller.Roll(1, 20, 0)
+	if err != nil {
+		return dnderr.Wrap(err, "failed to roll party initiative")
+	}
+	monsterRoll, err := s.diceRoller.Roll(1, 20, 0)
+	if err != nil {
+		return dnderr.Wrap(err, "failed to roll monster initiative")
+	}
+
+	for _, id := range combatantIDs {
+		combatant := encounter.Combatants[id]
+		if combatant.IsPlayerSide() {
+			combatant.Initiative = partyRoll.Total
+		} else {
+			combatant.Initiative = monsterRoll.Total
+		}
+	}
+
+	encounter.CombatLog = append(encounter.CombatLog,
+		fmt.Sprintf("🛡️ **Party** rolls initiative: **%d**", partyRoll.Total),
+		fmt.Sprintf("👹 **Monsters** roll initiative: **%d**", monsterRoll.Total))
+
+	return nil
+}
+
+// isMeleeAttack reports whether the character attacks with a melee weapon.
+// Unarmed strikes count as melee.
+func isMeleeAttack(char *character.Character) bool {
+	for _, slot := range []shared.Slot{shared.SlotMainHand, shared.SlotTwoHanded} {
+		if weapon, ok := char.EquippedSlots[slot].(*equipment.Weapon); ok {
+			return !weapon.IsRanged()
+		}
+	}
+	return true
+}
+
+// rollCritDice rolls the extra damage dice for a critical hit, dealing
+// maximum damage instead when the max-dice crits house rule is enabled
+func (s *service) rollCritDice(count, sides int, rules *gameSession.HouseRules) (int, []int, error) {
+	if rules.MaxDiceCrits {
+		rolls := make([]int, count)
+		for i := range rolls {
+			rolls[i] = sides
+		}
+		return count * sides, rolls, nil
+	}
+
+	critResult, err := s.diceRoller.Roll(count, sides, 0)
+	if err != nil {
+		return 0, nil, err
+	}
+	return critResult.Total, critResult.Rolls, nil
+}
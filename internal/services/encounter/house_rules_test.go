@@ -0,0 +1,278 @@
+package encounter_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
+	character2 "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	session2 "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/encounters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/gamesessions"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupHouseRulesEncounter creates an encounter with one player and two goblins
+// in a session using the given house rules
+func setupHouseRulesEncounter(t *testing.T, rules *session2.HouseRules, roller *mockdice.ManualMockRoller) (encounter.Service, *combat.Encounter, character.Service) {
+	t.Helper()
+	ctx := context.Background()
+
+	charRepo := characters.NewInMemoryRepository()
+	charService := character.NewService(&character.ServiceConfig{
+		Repository:      charRepo,
+		DraftRepository: character_draft.NewInMemoryRepository(),
+	})
+	require.NoError(t, charRepo.Create(ctx, &character2.Character{
+		ID:               "char-1",
+		Name:             "Fighter",
+		OwnerID:          "player-1",
+		Status:           shared.CharacterStatusActive,
+		Level:            1,
+		CurrentHitPoints: 12,
+		MaxHitPoints:     12,
+		AC:               16,
+		Attributes: map[shared.Attribute]*character2.AbilityScore{
+			shared.AttributeDexterity: {Score: 14, Bonus: 2},
+		},
+	}))
+
+	sessionRepo := gamesessions.NewInMemoryRepository()
+	sessionService := session.NewService(&session.ServiceConfig{
+		Repository:       sessionRepo,
+		CharacterService: charService,
+	})
+
+	settings := session2.DefaultSessionSettings()
+	settings.HouseRules = rules
+	sess := &session2.Session{
+		ID:         "test-session",
+		Name:       "House Rules",
+		InviteCode: "RULES1",
+		ChannelID:  "channel-1",
+		CreatorID:  "user-1",
+		DMID:       "user-1",
+		Members: map[string]*session2.SessionMember{
+			"user-1": {UserID: "user-1", Role: session2.SessionRoleDM},
+		},
+		Settings:   settings,
+		Status:     session2.SessionStatusActive,
+		CreatedAt:  time.Now(),
+		LastActive: time.Now(),
+	}
+	require.NoError(t, sessionRepo.Create(ctx, sess))
+
+	svc := encounter.NewService(&encounter.ServiceConfig{
+		Repository:       encounters.NewInMemoryRepository(),
+		SessionService:   sessionService,
+		CharacterService: charService,
+		DiceRoller:       roller,
+	})
+
+	enc, err := svc.CreateEncounter(ctx, &encounter.CreateEncounterInput{
+		SessionID: "test-session",
+		ChannelID: "channel-1",
+		Name:      "Goblin Ambush",
+		UserID:    "user-1",
+	})
+	require.NoError(t, err)
+
+	for _, name := range []string{"Goblin A", "Goblin B"} {
+		_, err = svc.AddMonster(ctx, enc.ID, "user-1", &encounter.AddMonsterInput{
+			Name:            name,
+			MaxHP:           7,
+			AC:              15,
+			InitiativeBonus: 2,
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = svc.AddPlayer(ctx, enc.ID, "player-1", "char-1")
+	require.NoError(t, err)
+
+	return svc, enc, charService
+}
+
+func TestRollInitiative_SideInitiative(t *testing.T) {
+	ctx := context.Background()
+	roller := mockdice.NewManualMockRoller()
+	roller.SetRolls([]int{
+		8,  // Party
+		14, // Monsters
+	})
+
+	svc, enc, _ := setupHouseRulesEncounter(t, &session2.HouseRules{SideInitiative: true}, roller)
+
+	require.NoError(t, svc.RollInitiative(ctx, enc.ID, "user-1"))
+
+	enc, err := svc.GetEncounter(ctx, enc.ID)
+	require.NoError(t, err)
+
+	require.Len(t, enc.TurnOrder, 3)
+	for i, id := range enc.TurnOrder {
+		combatant := enc.Combatants[id]
+		if i < 2 {
+			assert.Equal(t, combat.CombatantTypeMonster, combatant.Type, "monsters won initiative and should act first")
+			assert.Equal(t, 14, combatant.Initiative)
+		} else {
+			assert.Equal(t, combat.CombatantTypePlayer, combatant.Type)
+			assert.Equal(t, 8, combatant.Initiative)
+		}
+	}
+
+	logText := strings.Join(enc.CombatLog, "\n")
+	assert.Contains(t, logText, "**Party** rolls initiative: **8**")
+	assert.Contains(t, logText, "**Monsters** roll initiative: **14**")
+}
+
+func TestRollInitiative_SideInitiativeTieGoesToParty(t *testing.T) {
+	ctx := context.Background()
+	roller := mockdice.NewManualMockRoller()
+	roller.SetRolls([]int{11, 11})
+
+	svc, enc, _ := setupHouseRulesEncounter(t, &session2.HouseRules{SideInitiative: true}, roller)
+
+	require.NoError(t, svc.RollInitiative(ctx, enc.ID, "user-1"))
+
+	enc, err := svc.GetEncounter(ctx, enc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, combat.CombatantTypePlayer, enc.Combatants[enc.TurnOrder[0]].Type)
+}
+
+func TestNextTurn_RerollInitiativeEachRound(t *testing.T) {
+	ctx := context.Background()
+	roller := mockdice.NewManualMockRoller()
+	roller.SetRolls([]int{
+		// Round 1, rolled in combatant ID order
+		18, 12, 4,
+		// Round 2 re-roll
+		2, 3, 19,
+	})
+
+	svc, enc, _ := setupHouseRulesEncounter(t, &session2.HouseRules{RerollInitiativeEachRound: true}, roller)
+
+	require.NoError(t, svc.RollInitiative(ctx, enc.ID, "user-1"))
+	require.NoError(t, svc.StartEncounter(ctx, enc.ID, "user-1"))
+
+	enc, err := svc.GetEncounter(ctx, enc.ID)
+	require.NoError(t, err)
+	firstRoundLeader := enc.TurnOrder[0]
+
+	// Finish round one
+	for i := 0; i < 3; i++ {
+		require.NoError(t, svc.NextTurn(ctx, enc.ID, "user-1"))
+	}
+
+	enc, err = svc.GetEncounter(ctx, enc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, enc.Round)
+	assert.Equal(t, 0, enc.Turn)
+	assert.NotEqual(t, firstRoundLeader, enc.TurnOrder[0], "the round two order should come from the new rolls")
+	assert.Contains(t, strings.Join(enc.CombatLog, "\n"), "re-rolling initiative")
+}
+
+func TestRollInitiative_GroupInitiative(t *testing.T) {
+	ctx := context.Background()
+	roller := mockdice.NewManualMockRoller()
+
+	svc, enc, _ := setupHouseRulesEncounter(t, &session2.HouseRules{GroupInitiative: true}, roller)
+
+	// Add a pack of wolves - they should be lettered and share one roll
+	for i := 0; i < 3; i++ {
+		_, err := svc.AddMonster(ctx, enc.ID, "user-1", &encounter.AddMonsterInput{
+			Name:            "Wolf",
+			MaxHP:           11,
+			AC:              13,
+			InitiativeBonus: 2,
+		})
+		require.NoError(t, err)
+	}
+
+	// Goblin A, Goblin B, the player and one roll for the wolves
+	roller.SetRolls([]int{10, 10, 10, 10})
+
+	require.NoError(t, svc.RollInitiative(ctx, enc.ID, "user-1"))
+
+	enc, err := svc.GetEncounter(ctx, enc.ID)
+	require.NoError(t, err)
+
+	var wolfPositions []int
+	for i, id := range enc.TurnOrder {
+		combatant := enc.Combatants[id]
+		if combatant.GroupKey == "Wolf" {
+			wolfPositions = append(wolfPositions, i)
+			assert.Equal(t, 12, combatant.Initiative)
+		}
+	}
+	require.Len(t, wolfPositions, 3)
+	assert.Equal(t, wolfPositions[0]+1, wolfPositions[1], "wolves should act back to back")
+	assert.Equal(t, wolfPositions[1]+1, wolfPositions[2], "wolves should act back to back")
+
+	names := make([]string, 0, 3)
+	for _, pos := range wolfPositions {
+		names = append(names, enc.Combatants[enc.TurnOrder[pos]].Name)
+	}
+	assert.Equal(t, []string{"Wolf A", "Wolf B", "Wolf C"}, names)
+
+	assert.Contains(t, strings.Join(enc.CombatLog, "\n"), "**Wolf group (x3)** rolls initiative")
+}
+
+func TestVariantEncumbrance_UsesCurrentLoad(t *testing.T) {
+	ctx := context.Background()
+	roller := mockdice.NewManualMockRoller()
+	roller.SetRolls([]int{10, 10, 10}) // Initiative ties go to the player
+
+	svc, enc, chars := setupHouseRulesEncounter(t, &session2.HouseRules{VariantEncumbrance: true}, roller)
+	require.NoError(t, svc.RollInitiative(ctx, enc.ID, "user-1"))
+	require.NoError(t, svc.StartEncounter(ctx, enc.ID, "user-1"))
+
+	enc, err := svc.GetEncounter(ctx, enc.ID)
+	require.NoError(t, err)
+	player := enc.GetCurrentCombatant()
+	require.Equal(t, combat.CombatantTypePlayer, player.Type)
+	assert.Equal(t, 30, player.Speed)
+
+	// Pick up a heavy load after joining the fight
+	char, err := chars.GetByID("char-1")
+	require.NoError(t, err)
+	char.AddInventory(&equipment.BasicEquipment{Key: "anvil", Name: "Anvil", Weight: 120})
+	require.NoError(t, chars.UpdateEquipment(ctx, char))
+
+	var goblinID string
+	for id, combatant := range enc.Combatants {
+		if combatant.Type == combat.CombatantTypeMonster {
+			goblinID = id
+			break
+		}
+	}
+
+	// The attack is rolled with disadvantage
+	result, err := svc.PerformAttack(ctx, &encounter.AttackInput{
+		EncounterID: enc.ID,
+		AttackerID:  player.ID,
+		TargetID:    goblinID,
+		UserID:      "player-1",
+	})
+	require.NoError(t, err)
+	require.Len(t, result.DiceRolls, 2)
+	assert.Equal(t, min(result.DiceRolls[0], result.DiceRolls[1]), result.AttackRoll)
+
+	// Speed is recomputed when the player's next turn starts
+	for i := 0; i < 3; i++ {
+		require.NoError(t, svc.NextTurn(ctx, enc.ID, "user-1"))
+	}
+	enc, err = svc.GetEncounter(ctx, enc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 10, enc.Combatants[player.ID].Speed)
+}
@@ -78,6 +78,9 @@ func TestInitiativeRollDisplay(t *testing.T) {
 			CombatLog: []string{},
 		}
 
+		// Mock session lookup for house rules
+		mockSessionService.EXPECT().GetSession(ctx, "test-session").Return(&session.Session{ID: "test-session"}, nil)
+
 		// Mock repository calls
 		mockRepo.EXPECT().Get(ctx, encounterID).Return(enc, nil)
 		mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, e *combat.Encounter) error {
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	combat "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	shared "github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	encounter "github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,6 +43,20 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AddCombatantEffect mocks base method.
+func (m *MockService) AddCombatantEffect(ctx context.Context, encounterID, combatantID string, effect *shared.ActiveEffect) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCombatantEffect", ctx, encounterID, combatantID, effect)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCombatantEffect indicates an expected call of AddCombatantEffect.
+func (mr *MockServiceMockRecorder) AddCombatantEffect(ctx, encounterID, combatantID, effect any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCombatantEffect", reflect.TypeOf((*MockService)(nil).AddCombatantEffect), ctx, encounterID, combatantID, effect)
+}
+
 // AddMonster mocks base method.
 func (m *MockService) AddMonster(ctx context.Context, encounterID, userID string, input *encounter.AddMonsterInput) (*combat.Combatant, error) {
 	m.ctrl.T.Helper()
@@ -275,6 +290,21 @@ func (mr *MockServiceMockRecorder) RollInitiative(ctx, encounterID, userID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollInitiative", reflect.TypeOf((*MockService)(nil).RollInitiative), ctx, encounterID, userID)
 }
 
+// StartConcentration mocks base method.
+func (m *MockService) StartConcentration(ctx context.Context, encounterID, casterID, spellKey, spellName string, effects map[string]*shared.ActiveEffect) (*combat.Concentration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartConcentration", ctx, encounterID, casterID, spellKey, spellName, effects)
+	ret0, _ := ret[0].(*combat.Concentration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartConcentration indicates an expected call of StartConcentration.
+func (mr *MockServiceMockRecorder) StartConcentration(ctx, encounterID, casterID, spellKey, spellName, effects any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartConcentration", reflect.TypeOf((*MockService)(nil).StartConcentration), ctx, encounterID, casterID, spellKey, spellName, effects)
+}
+
 // StartEncounter mocks base method.
 func (m *MockService) StartEncounter(ctx context.Context, encounterID, userID string) error {
 	m.ctrl.T.Helper()
@@ -117,7 +117,7 @@ func TestAddPlayer_RageEffectsClearedOnDungeonEntry(t *testing.T) {
 	mockSessionService.EXPECT().GetSession(ctx, sessionID).Return(dungeonSession, nil)
 
 	// Expect the character to be saved after long rest
-	mockCharService.EXPECT().UpdateEquipment(gomock.Any()).DoAndReturn(func(c *character.Character) error {
+	mockCharService.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *character.Character) error {
 		// Verify rage is completely reset
 		rage := c.Resources.Abilities[shared.AbilityKeyRage]
 		assert.False(t, rage.IsActive, "Rage should be deactivated after long rest")
@@ -134,7 +134,7 @@ func TestAddPlayer_RageEffectsClearedOnDungeonEntry(t *testing.T) {
 	})
 
 	// Expect the character to be saved after action economy reset
-	mockCharService.EXPECT().UpdateEquipment(gomock.Any()).Return(nil)
+	mockCharService.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).Return(nil)
 
 	mockUUID.EXPECT().New().Return(combatantID)
 	mockRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
@@ -6,7 +6,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"sort"
 	"strings"
 
 	"github.com/KirkDiggler/dnd-bot-discord/internal/adapters/rpgtoolkit"
@@ -68,9 +67,18 @@ type Service interface {
 	// HealCombatant heals a combatant
 	HealCombatant(ctx context.Context, encounterID, combatantID, userID string, amount int) error
 
+	// AddCombatantEffect adds a temporary effect to a combatant
+	AddCombatantEffect(ctx context.Context, encounterID, combatantID string, effect *shared.ActiveEffect) error
+
 	// EndEncounter ends the encounter
 	EndEncounter(ctx context.Context, encounterID, userID string) error
 
+	// StartConcentration records the spell a caster is concentrating on,
+	// ending the caster's previous concentration spell and its effects, and
+	// adds the new spell's effects, keyed by combatant ID, in the same update
+	// Returns the concentration that was dropped, if any
+	StartConcentration(ctx context.Context, encounterID, casterID, spellKey, spellName string, effects map[string]*shared.ActiveEffect) (*combat.Concentration, error)
+
 	// LogCombatAction logs a combat action (like a miss) without damage
 	LogCombatAction(ctx context.Context, encounterID, action string) error
 
@@ -154,10 +162,14 @@ type AttackResult struct {
 	// Great Weapon Fighting reroll information
 	RerollInfo []attack.DieReroll
 
+	// Flanked is true when the attacker gained advantage from the flanking house rule
+	Flanked bool
+
 	// Combatant information
-	AttackerName string
-	TargetName   string
-	WeaponName   string
+	AttackerName  string
+	AttackerGroup string // Group key when the attacker is one of several identical monsters
+	TargetName    string
+	WeaponName    string
 
 	// Results
 	TargetNewHP    int
@@ -391,8 +403,8 @@ func (s *service) AddMonster(ctx context.Context, encounterID, userID string, in
 		Actions:         input.Actions,
 	}
 
-	// Add to encounter
-	encounter.AddCombatant(combatant)
+	// Add to encounter, lettering duplicates (Goblin A, Goblin B, ...)
+	encounter.AddMonster(combatant)
 
 	// Save changes
 	if err := s.repository.Update(ctx, encounter); err != nil {
@@ -420,19 +432,23 @@ func (s *service) AddPlayer(ctx context.Context, encounterID, playerID, characte
 	// Character retrieved successfully
 
 	// Ensure resources are initialized (lazy initialization)
-	resources := char.GetResources()
+	char.GetResources()
 
 	// Check if this is a dungeon session - if so, perform a long rest
 	// to reset abilities like rage uses and lay on hands
+	variantEncumbrance := false
 	if encounter.SessionID != "" {
 		session, err := s.sessionService.GetSession(ctx, encounter.SessionID)
+		if err == nil {
+			variantEncumbrance = session.GetHouseRules().VariantEncumbrance
+		}
 		if err == nil && session.Metadata != nil {
 			if sessionType, ok := session.Metadata["sessionType"].(string); ok && sessionType == "dungeon" {
 				// Dungeon session detected, performing long rest
-				resources.LongRest()
+				char.LongRest(session.GetHouseRules().SlowNaturalHealing)
 
 				// Save the character to persist the reset abilities
-				if err := s.characterService.UpdateEquipment(char); err != nil {
+				if err := s.characterService.UpdateEquipment(charService.WithChange(ctx, playerID, "long rest on entering the dungeon"), char); err != nil {
 					log.Printf("Failed to save character after long rest: %v", err)
 					// Continue anyway - the abilities are reset in memory
 				}
@@ -444,7 +460,7 @@ func (s *service) AddPlayer(ctx context.Context, encounterID, playerID, characte
 	char.StartNewTurn()
 
 	// Save character to persist the reset action economy
-	if err := s.characterService.UpdateEquipment(char); err != nil {
+	if err := s.characterService.UpdateEquipment(charService.WithChange(ctx, playerID, "joined an encounter"), char); err != nil {
 		log.Printf("Failed to save character after action economy reset: %v", err)
 	}
 
@@ -493,7 +509,7 @@ func (s *service) AddPlayer(ctx context.Context, encounterID, playerID, characte
 		CurrentHP:       char.CurrentHitPoints,
 		MaxHP:           char.MaxHitPoints,
 		AC:              char.AC,
-		Speed:           30, // Default, should come from race
+		Speed:           char.EffectiveSpeed(variantEncumbrance), // Heavy loads slow the character down
 		IsActive:        true,
 		PlayerID:        playerID,
 		CharacterID:     characterID,
@@ -544,12 +560,14 @@ func (s *service) RollInitiative(ctx context.Context, encounterID, userID string
 		return dnderr.Wrap(err, "failed to get encounter")
 	}
 
+	// Look up the session for permissions and house rules
+	session, sessionErr := s.sessionService.GetSession(ctx, encounter.SessionID)
+
 	// Check permissions
 	if encounter.CreatedBy != userID {
 		// Allow system/bot for dungeon encounters
-		session, err := s.sessionService.GetSession(ctx, encounter.SessionID)
-		if err != nil {
-			return dnderr.Wrap(err, "failed to get session")
+		if sessionErr != nil {
+			return dnderr.Wrap(sessionErr, "failed to get session")
 		}
 		if !session.IsDungeon() {
 			return dnderr.PermissionDenied("only the DM can roll initiative")
@@ -565,46 +583,11 @@ func (s *service) RollInitiative(ctx context.Context, encounterID, userID string
 	encounter.CombatLog = []string{"🎲 **Rolling Initiative**"}
 
 	// Roll initiative for each combatant
-	// Sort combatant IDs to ensure deterministic order for testing
-	combatantIDs := make([]string, 0, len(encounter.Combatants))
-	for id := range encounter.Combatants {
-		combatantIDs = append(combatantIDs, id)
-	}
-	sort.Strings(combatantIDs)
-
-	initiatives := make(map[string]int)
-	for _, id := range combatantIDs {
-		combatant := encounter.Combatants[id]
-		result, err := s.diceRoller.Roll(1, 20, combatant.InitiativeBonus)
-		if err != nil {
-			return dnderr.Wrap(err, "failed to roll initiative")
-		}
-		combatant.Initiative = result.Total
-		initiatives[id] = combatant.Initiative
-
-		// Log the initiative roll
-		logEntry := fmt.Sprintf("**%s** rolls initiative: %v + %d = **%d**",
-			combatant.Name,
-			result.Rolls[0], // The d20 roll
-			combatant.InitiativeBonus,
-			combatant.Initiative)
-		encounter.CombatLog = append(encounter.CombatLog, logEntry)
-	}
-
-	// Sort combatants by initiative (descending)
-	encounter.TurnOrder = make([]string, 0, len(encounter.Combatants))
-	for id := range encounter.Combatants {
-		encounter.TurnOrder = append(encounter.TurnOrder, id)
-	}
-
-	// Simple bubble sort for now
-	for i := 0; i < len(encounter.TurnOrder)-1; i++ {
-		for j := 0; j < len(encounter.TurnOrder)-i-1; j++ {
-			if initiatives[encounter.TurnOrder[j]] < initiatives[encounter.TurnOrder[j+1]] {
-				encounter.TurnOrder[j], encounter.TurnOrder[j+1] = encounter.TurnOrder[j+1], encounter.TurnOrder[j]
-			}
-		}
+	turnOrder, err := s.rollInitiative(encounter, session.GetHouseRules())
+	if err != nil {
+		return err
 	}
+	encounter.TurnOrder = turnOrder
 
 	encounter.Status = combat.EncounterStatusRolling
 
@@ -693,6 +676,23 @@ func (s *service) NextTurn(ctx context.Context, encounterID, userID string) erro
 
 	// Advance turn
 	encounter.NextTurn()
+	s.recoverAmmunition(ctx, encounter)
+
+	// Re-roll initiative at the top of each round if the table plays that way
+	if encounter.Round > prevRound && encounter.Status == combat.EncounterStatusActive {
+		if rules := s.getHouseRules(ctx, encounter); rules.RerollInitiativeEachRound {
+			encounter.AddCombatLogEntry(fmt.Sprintf("🔄 **Round %d** - re-rolling initiative", encounter.Round))
+			turnOrder, err := s.rollInitiative(encounter, rules)
+			if err != nil {
+				return err
+			}
+			encounter.ResetTurnOrder(turnOrder)
+		}
+	}
+
+	// Inventory can change between turns, so the new combatant's speed is
+	// recomputed from their current load
+	s.refreshSpeed(s.getHouseRules(ctx, encounter), encounter.GetCurrentCombatant())
 
 	// Emit OnTurnStart event for duration tracking
 	if s.eventBus != nil {
@@ -734,7 +734,7 @@ func (s *service) NextTurn(ctx context.Context, encounterID, userID string) erro
 			char.StartNewTurn()
 
 			// Save character to persist the reset
-			if err := s.characterService.UpdateEquipment(char); err != nil {
+			if err := s.characterService.UpdateEquipment(charService.WithChange(ctx, userID, "new turn"), char); err != nil {
 				log.Printf("Failed to update character %s after turn reset: %v", char.ID, err)
 			}
 		}
@@ -805,6 +805,12 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 		TargetName:   target.Name,
 		TargetAC:     target.AC,
 	}
+	if encounter.GroupSize(attacker) > 1 {
+		result.AttackerGroup = attacker.GroupKey
+	}
+
+	rules := s.getHouseRules(ctx, encounter)
+	isMelee := true
 
 	// Handle different attacker types
 	if attacker.Type == combat.CombatantTypePlayer && attacker.CharacterID != "" {
@@ -870,8 +876,23 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 			}
 		}
 
+		// Apply situational modifiers and house rules to the roll
+		isMelee = isMeleeAttack(char)
+		result.Flanked = rules.Flanking && isMelee && encounter.IsFlanked(target.ID, attacker.ID)
+		rollOpts := &attack.RollOptions{
+			Advantage: input.HasAdvantage || result.Flanked,
+			// Weapon attacks use STR or DEX, both hampered by a heavy load
+			Disadvantage: input.HasDisadvantage || s.encumbranceDisadvantage(rules, attacker, shared.AttributeStrength),
+			MaxDiceCrits: rules.MaxDiceCrits,
+		}
+
+		// Bows, crossbows and slings use up ammunition
+		if err := spendAmmunition(attacker, char); err != nil {
+			return nil, err
+		}
+
 		// Use character's attack method
-		attackResults, err := char.Attack()
+		attackResults, err := char.AttackWithOptions(rollOpts)
 		if err != nil {
 			return nil, dnderr.Wrap(err, "failed to perform character attack")
 		}
@@ -897,7 +918,7 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 		char.RecordAction("attack", "weapon", weaponKey)
 
 		// Save character to persist action economy changes
-		if err := s.characterService.UpdateEquipment(char); err != nil {
+		if err := s.characterService.UpdateEquipment(charService.WithChange(ctx, input.UserID, "attacked"), char); err != nil {
 			log.Printf("Failed to save character after attack action: %v", err)
 		}
 
@@ -907,9 +928,9 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 		for _, ba := range char.GetAvailableBonusActions() {
 			log.Printf("[ACTION ECONOMY] Available bonus action: %s (%s)", ba.Name, ba.Key)
 		}
-		result.AttackRoll = attackResult.AttackResult.Rolls[0]      // The d20 roll
-		result.TotalAttack = attackResult.AttackRoll                // Total including bonuses
-		result.AttackBonus = result.TotalAttack - result.AttackRoll // Calculate bonus from total minus d20
+		result.AttackRoll = attack.NaturalRoll(attackResult.AttackResult) // The d20 roll that was kept
+		result.TotalAttack = attackResult.AttackRoll                      // Total including bonuses
+		result.AttackBonus = result.TotalAttack - result.AttackRoll       // Calculate bonus from total minus d20
 		result.DiceRolls = attackResult.AttackResult.Rolls
 
 		// Set weapon damage info
@@ -964,7 +985,7 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 
 		// Check hit
 		result.Hit = result.TotalAttack >= target.AC
-		result.Critical = result.AttackRoll == 20
+		result.Critical = result.AttackRoll >= char.CriticalRange()
 
 		// Emit AfterAttackRoll event
 		if s.eventBus != nil {
@@ -1050,11 +1071,10 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 					damageContext[rpgtoolkit.ContextWeaponType] = weaponType
 				}
 
-				// Add combat conditions (these would need to be passed in via input)
-				// For now, we'll use defaults - this should be enhanced later
-				damageContext[rpgtoolkit.ContextHasAdvantage] = false
-				damageContext[rpgtoolkit.ContextHasDisadvantage] = false
-				damageContext[rpgtoolkit.ContextAllyAdjacent] = false
+				// Add combat conditions
+				damageContext[rpgtoolkit.ContextHasAdvantage] = rollOpts.Advantage && !rollOpts.Disadvantage
+				damageContext[rpgtoolkit.ContextHasDisadvantage] = rollOpts.Disadvantage && !rollOpts.Advantage
+				damageContext[rpgtoolkit.ContextAllyAdjacent] = input.AllyAdjacent || result.Flanked
 
 				damageEvent, emitErr := rpgtoolkit.CreateAndEmitEvent(
 					s.eventBus,
@@ -1096,7 +1116,7 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 			}
 
 			// Check for sneak attack
-			if char.Class != nil && char.Class.Key == "rogue" {
+			if char.LevelInClass("rogue") > 0 {
 				// Get the weapon used
 				var weapon *equipment.Weapon
 				if char.EquippedSlots[shared.SlotMainHand] != nil {
@@ -1110,22 +1130,22 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 				}
 
 				// Check if sneak attack is eligible
-				if weapon != nil && char.CanSneakAttack(weapon, input.HasAdvantage, input.AllyAdjacent, input.HasDisadvantage) {
+				if weapon != nil && char.CanSneakAttack(weapon, rollOpts.Advantage, input.AllyAdjacent || result.Flanked, rollOpts.Disadvantage) {
 					// Create combat context for sneak attack
-					ctx := &character.CombatContext{
+					combatCtx := &character.CombatContext{
 						AttackResult: attackResult,
 						IsCritical:   result.Critical,
 					}
 
 					// Apply sneak attack damage
-					sneakDamage := char.ApplySneakAttack(ctx)
+					sneakDamage := char.ApplySneakAttack(combatCtx)
 					if sneakDamage > 0 {
 						result.SneakAttackDamage = sneakDamage
 						result.SneakAttackDice = char.GetSneakAttackDice()
 						result.Damage += sneakDamage
 
 						// Save character to persist SneakAttackUsedThisTurn flag
-						if err := s.characterService.UpdateEquipment(char); err != nil {
+						if err := s.characterService.UpdateEquipment(charService.WithChange(ctx, input.UserID, "used sneak attack"), char); err != nil {
 							log.Printf("Failed to update character after sneak attack: %v", err)
 						}
 					}
@@ -1199,6 +1219,10 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 			}
 		}
 
+		// Flanking grants advantage on melee attacks
+		isMelee = action.IsMelee()
+		result.Flanked = rules.Flanking && isMelee && encounter.IsFlanked(target.ID, attacker.ID)
+
 		// Check for disadvantage effects (like Vicious Mockery)
 		hasDisadvantage := false
 		disadvantageEffectIndex := -1
@@ -1227,8 +1251,8 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 			Rolls []int
 		}
 
-		if hasDisadvantage {
-			// Roll twice for disadvantage
+		if hasDisadvantage || result.Flanked {
+			// Roll twice for advantage or disadvantage
 			firstRoll, err := s.diceRoller.Roll(1, 20, action.AttackBonus)
 			if err != nil {
 				return nil, dnderr.Wrap(err, "failed to roll first attack with disadvantage")
@@ -1239,8 +1263,16 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 				return nil, dnderr.Wrap(err, "failed to roll second attack with disadvantage")
 			}
 
-			// Take the lower total
-			if firstRoll.Total <= secondRoll.Total {
+			// Advantage and disadvantage cancel out - keep the first roll
+			keepFirst := true
+			switch {
+			case hasDisadvantage && !result.Flanked:
+				keepFirst = firstRoll.Total <= secondRoll.Total
+			case result.Flanked && !hasDisadvantage:
+				keepFirst = firstRoll.Total >= secondRoll.Total
+			}
+
+			if keepFirst {
 				attackResult.Total = firstRoll.Total
 				attackResult.Rolls = firstRoll.Rolls
 			} else {
@@ -1248,9 +1280,18 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 				attackResult.Rolls = secondRoll.Rolls
 			}
 
-			// Log the disadvantage rolls
-			encounter.AddCombatLogEntry(fmt.Sprintf("%s attacks with disadvantage (Vicious Mockery) - rolled %d and %d, taking %d",
-				attacker.Name, firstRoll.Rolls[0], secondRoll.Rolls[0], attackResult.Rolls[0]))
+			// Log the rolls
+			switch {
+			case hasDisadvantage && result.Flanked:
+				encounter.AddCombatLogEntry(fmt.Sprintf("%s is flanking but has disadvantage (Vicious Mockery) - they cancel out, rolled %d",
+					attacker.Name, attackResult.Rolls[0]))
+			case hasDisadvantage:
+				encounter.AddCombatLogEntry(fmt.Sprintf("%s attacks with disadvantage (Vicious Mockery) - rolled %d and %d, taking %d",
+					attacker.Name, firstRoll.Rolls[0], secondRoll.Rolls[0], attackResult.Rolls[0]))
+			default:
+				encounter.AddCombatLogEntry(fmt.Sprintf("%s attacks with advantage (flanking) - rolled %d and %d, taking %d",
+					attacker.Name, firstRoll.Rolls[0], secondRoll.Rolls[0], attackResult.Rolls[0]))
+			}
 
 			// Remove the disadvantage effect after use
 			if disadvantageEffectIndex >= 0 {
@@ -1393,10 +1434,10 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 
 				// Double dice on critical
 				if result.Critical {
-					critResult, err := s.diceRoller.Roll(dmg.DiceCount, dmg.DiceSize, 0)
+					critTotal, critRolls, err := s.rollCritDice(dmg.DiceCount, dmg.DiceSize, rules)
 					if err == nil {
-						damageResult.Total += critResult.Total
-						damageResult.Rolls = append(damageResult.Rolls, critResult.Rolls...)
+						damageResult.Total += critTotal
+						damageResult.Rolls = append(damageResult.Rolls, critRolls...)
 					}
 				}
 
@@ -1443,10 +1484,10 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 			}
 
 			if result.Critical {
-				critResult, err := s.diceRoller.Roll(1, 4, 0)
+				critTotal, critRolls, err := s.rollCritDice(1, 4, rules)
 				if err == nil {
-					damageResult.Total += critResult.Total
-					damageResult.Rolls = append(damageResult.Rolls, critResult.Rolls...)
+					damageResult.Total += critTotal
+					damageResult.Rolls = append(damageResult.Rolls, critRolls...)
 				}
 			}
 
@@ -1457,6 +1498,11 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 		}
 	}
 
+	// Track melee engagement so allies can flank this target
+	if isMelee {
+		target.RecordMeleeAttacker(attacker.ID)
+	}
+
 	// Apply damage if hit
 	if result.Hit && result.Damage > 0 {
 		// Use the ApplyDamage method which handles defeat and combat end detection
@@ -1574,11 +1620,13 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 		target.ApplyDamage(finalDamage)
 		result.TargetNewHP = target.CurrentHP
 		result.TargetDefeated = target.CurrentHP == 0
+		s.checkConcentration(encounter, target, finalDamage, rules)
 
 		// Check if combat should end
 		if shouldEnd, playersWon := encounter.CheckCombatEnd(); shouldEnd {
 			log.Printf("Combat ending after attack - Players won: %v", playersWon)
 			encounter.End()
+			s.recoverAmmunition(ctx, encounter)
 			result.CombatEnded = true
 			result.PlayersWon = playersWon
 			if playersWon {
@@ -1763,6 +1811,10 @@ func (s *service) PerformAttack(ctx context.Context, input *AttackInput) (*Attac
 			result.AttackRoll, result.AttackBonus, result.TotalAttack, result.TargetAC, profIndicator)
 	}
 
+	if result.Flanked {
+		result.LogEntry += " 🗡️ Flanking"
+	}
+
 	// Add to combat log
 	encounter.CombatLog = append(encounter.CombatLog, result.LogEntry)
 	if err := s.repository.Update(ctx, encounter); err != nil {
@@ -1816,12 +1868,14 @@ func (s *service) ApplyDamage(ctx context.Context, encounterID, combatantID, use
 		if combatant.CurrentHP == 0 {
 			encounter.AddCombatLogEntry(fmt.Sprintf("%s was defeated!", combatant.Name))
 		}
+		s.checkConcentration(encounter, combatant, damageAmount, s.getHouseRules(ctx, encounter))
 	}
 
 	// Check if combat should end
 	if shouldEnd, playersWon := encounter.CheckCombatEnd(); shouldEnd {
 		log.Printf("Combat ending - Players won: %v", playersWon)
 		encounter.End()
+		s.recoverAmmunition(ctx, encounter)
 		if playersWon {
 			encounter.AddCombatLogEntry("Victory! All enemies have been defeated!")
 		} else {
@@ -1867,6 +1921,34 @@ func (s *service) HealCombatant(ctx context.Context, encounterID, combatantID, u
 	return nil
 }
 
+// AddCombatantEffect adds a temporary effect to a combatant
+func (s *service) AddCombatantEffect(ctx context.Context, encounterID, combatantID string, effect *shared.ActiveEffect) error {
+	if effect == nil {
+		return dnderr.InvalidArgument("effect is required")
+	}
+
+	encounter, err := s.repository.Get(ctx, encounterID)
+	if err != nil {
+		return dnderr.Wrap(err, "failed to get encounter")
+	}
+
+	combatant, exists := encounter.Combatants[combatantID]
+	if !exists {
+		return dnderr.InvalidArgument("combatant not found")
+	}
+
+	if effect.ID == "" {
+		effect.ID = uuid.NewGoogleUUIDGenerator().New()
+	}
+	combatant.ActiveEffects = append(combatant.ActiveEffects, effect)
+
+	if err := s.repository.Update(ctx, encounter); err != nil {
+		return dnderr.Wrap(err, "failed to update encounter")
+	}
+
+	return nil
+}
+
 // EndEncounter ends the encounter
 func (s *service) EndEncounter(ctx context.Context, encounterID, userID string) error {
 	// Get encounter
@@ -1882,6 +1964,7 @@ func (s *service) EndEncounter(ctx context.Context, encounterID, userID string)
 
 	// End encounter
 	encounter.End()
+	s.recoverAmmunition(ctx, encounter)
 
 	// Save changes
 	if err := s.repository.Update(ctx, encounter); err != nil {
@@ -1924,20 +2007,30 @@ func (s *service) ProcessMonsterTurn(ctx context.Context, encounterID, monsterID
 		return nil, dnderr.InvalidArgument("monster not found or inactive")
 	}
 
-	// Find a target (first active player)
-	var target *combat.Combatant
-	for _, combatant := range encounter.Combatants {
-		if combatant.Type == combat.CombatantTypePlayer && combatant.IsActive {
-			target = combatant
-			break
+	// Pick a random active player with the dice roller, so a seeded roller
+	// repeats the same targets. Turn order keeps the candidates in a stable order.
+	var targets []*combat.Combatant
+	for _, id := range encounter.TurnOrder {
+		combatant := encounter.Combatants[id]
+		if combatant != nil && combatant.Type == combat.CombatantTypePlayer && combatant.IsActive {
+			targets = append(targets, combatant)
 		}
 	}
 
-	if target == nil {
+	if len(targets) == 0 {
 		log.Printf("ProcessMonsterTurn - No valid player targets found for monster %s", monster.Name)
 		return nil, dnderr.NotFound("no valid target found")
 	}
 
+	target := targets[0]
+	if len(targets) > 1 {
+		pick, err := s.diceRoller.Roll(1, len(targets), 0)
+		if err != nil {
+			return nil, dnderr.Wrap(err, "failed to roll for a target")
+		}
+		target = targets[pick.Total-1]
+	}
+
 	// Use PerformAttack with the first action
 	actionIndex := 0
 	if len(monster.Actions) == 0 {
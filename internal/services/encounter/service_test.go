@@ -267,7 +267,7 @@ func TestAddPlayer(t *testing.T) {
 
 		// Expect the character to be saved after action economy reset
 		mockCharService.EXPECT().
-			UpdateEquipment(gomock.Any()).
+			UpdateEquipment(gomock.Any(), gomock.Any()).
 			Return(nil)
 
 		// Using default session mock from parent test setup
@@ -341,7 +341,7 @@ func TestAddPlayer(t *testing.T) {
 
 		// Expect the character to be saved after action economy reset
 		mockCharService.EXPECT().
-			UpdateEquipment(gomock.Any()).
+			UpdateEquipment(gomock.Any(), gomock.Any()).
 			Return(nil)
 
 		// Add player
@@ -413,7 +413,7 @@ func TestAddPlayer(t *testing.T) {
 
 		// Expect the character to be saved after action economy reset
 		mockCharService.EXPECT().
-			UpdateEquipment(gomock.Any()).
+			UpdateEquipment(gomock.Any(), gomock.Any()).
 			Return(nil)
 
 		// Add player
@@ -463,7 +463,7 @@ func TestAddPlayer(t *testing.T) {
 		// Expect the character to be saved after action economy reset
 		// (happens before ownership check)
 		mockCharService.EXPECT().
-			UpdateEquipment(gomock.Any()).
+			UpdateEquipment(gomock.Any(), gomock.Any()).
 			Return(nil)
 
 		// Try to add player with someone else's character
@@ -503,7 +503,7 @@ func TestAddPlayer(t *testing.T) {
 		// Expect the character to be saved after action economy reset
 		// (happens before duplicate player check)
 		mockCharService.EXPECT().
-			UpdateEquipment(gomock.Any()).
+			UpdateEquipment(gomock.Any(), gomock.Any()).
 			Return(nil)
 
 		combatant, err := svc.AddPlayer(context.Background(), encounterID, "player-123", characterID)
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mock_service.go -package=mockitem -source=service.go
+//
+
+// Package mockitem is a generated GoMock package.
+package mockitem
+
+import (
+	context "context"
+	reflect "reflect"
+
+	item "github.com/KirkDiggler/dnd-bot-discord/internal/services/item"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// UseCharge mocks base method.
+func (m *MockService) UseCharge(ctx context.Context, input *item.UseItemInput) (*item.UseChargeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseCharge", ctx, input)
+	ret0, _ := ret[0].(*item.UseChargeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseCharge indicates an expected call of UseCharge.
+func (mr *MockServiceMockRecorder) UseCharge(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseCharge", reflect.TypeOf((*MockService)(nil).UseCharge), ctx, input)
+}
+
+// UseItem mocks base method.
+func (m *MockService) UseItem(ctx context.Context, input *item.UseItemInput) (*item.UseItemResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseItem", ctx, input)
+	ret0, _ := ret[0].(*item.UseItemResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseItem indicates an expected call of UseItem.
+func (mr *MockServiceMockRecorder) UseItem(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseItem", reflect.TypeOf((*MockService)(nil).UseItem), ctx, input)
+}
@@ -0,0 +1,457 @@
+// Package item handles using consumable items such as potions and spell
+// scrolls, in and out of combat.
+package item
+
+//go:generate mockgen -destination=mock/mock_service.go -package=mockitem -source=service.go
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	encounterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	sessService "github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+	spellService "github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
+)
+
+// Service manages using items
+type Service interface {
+	// UseItem uses one of a character's potions or spell scrolls. In combat
+	// it costs the character's action, or their bonus action for potions
+	// when the potions as bonus action house rule is on. A scroll's spell
+	// costs whatever its casting time does.
+	UseItem(ctx context.Context, input *UseItemInput) (*UseItemResult, error)
+
+	// UseCharge spends a charge of a magic item such as a wand to cast the
+	// spell stored in it at the spell's own level. In combat it costs
+	// whatever the spell's casting time does.
+	UseCharge(ctx context.Context, input *UseItemInput) (*UseChargeResult, error)
+}
+
+// UseItemInput contains the item to use
+type UseItemInput struct {
+	CharacterID string
+	UserID      string
+	ItemKey     string
+	EncounterID string   // Optional outside of combat
+	TargetIDs   []string // Combatant IDs for a scroll's spell
+}
+
+// UseItemResult describes what using an item did
+type UseItemResult struct {
+	Character *character.Character
+	Item      *equipment.Consumable
+	Remaining int // How many of the item are left
+
+	// Potions
+	HealingRolls []int
+	Healed       int
+	Effect       string // Name of the effect a potion granted
+
+	// Spell scrolls
+	Spell        *spellService.CastSpellResult
+	ScrollFailed bool // The check to read a scroll above the character's level failed
+
+	BonusAction bool // Used the bonus action instead of the action
+	Message     string
+}
+
+// UseChargeResult describes what spending a magic item's charge did
+type UseChargeResult struct {
+	Character   *character.Character
+	Item        equipment.Equipment
+	ChargesLeft int // Charges left across every copy of the item
+	Spell       *spellService.CastSpellResult
+	Message     string
+}
+
+type service struct {
+	characterService charService.Service
+	encounterService encounterService.Service
+	sessionService   sessService.Service
+	spellService     spellService.Service
+	diceRoller       dice.Roller
+}
+
+// ServiceConfig holds configuration for the item service
+type ServiceConfig struct {
+	CharacterService charService.Service      // Required
+	EncounterService encounterService.Service // Required
+	SessionService   sessService.Service      // Required
+	SpellService     spellService.Service     // Required
+	DiceRoller       dice.Roller              // Optional, defaults to random
+}
+
+// NewService creates a new item service
+func NewService(cfg *ServiceConfig) Service {
+	if cfg.CharacterService == nil {
+		panic("character service is required")
+	}
+	if cfg.EncounterService == nil {
+		panic("encounter service is required")
+	}
+	if cfg.SessionService == nil {
+		panic("session service is required")
+	}
+	if cfg.SpellService == nil {
+		panic("spell service is required")
+	}
+
+	svc := &service{
+		characterService: cfg.CharacterService,
+		encounterService: cfg.EncounterService,
+		sessionService:   cfg.SessionService,
+		spellService:     cfg.SpellService,
+		diceRoller:       cfg.DiceRoller,
+	}
+
+	if svc.diceRoller == nil {
+		svc.diceRoller = dice.NewRandomRoller()
+	}
+
+	return svc
+}
+
+// UseItem uses a potion or spell scroll
+func (s *service) UseItem(ctx context.Context, input *UseItemInput) (*UseItemResult, error) {
+	if input == nil {
+		return nil, dnderr.InvalidArgument("input cannot be nil")
+	}
+	if input.CharacterID == "" {
+		return nil, dnderr.InvalidArgument("character ID is required")
+	}
+	if input.ItemKey == "" {
+		return nil, dnderr.InvalidArgument("item key is required")
+	}
+
+	char, err := s.characterService.GetByID(input.CharacterID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", input.CharacterID)
+	}
+	if input.UserID != "" && char.OwnerID != input.UserID {
+		return nil, dnderr.PermissionDenied("only the character's owner can use their items").
+			WithMeta("character_id", input.CharacterID)
+	}
+
+	item := findUsable(char, input.ItemKey)
+	if item == nil {
+		return nil, dnderr.NotFoundf("%s has no '%s' to use", char.Name, input.ItemKey).
+			WithMeta("item_key", input.ItemKey)
+	}
+
+	var encounter *combat.Encounter
+	var combatant *combat.Combatant
+	if input.EncounterID != "" {
+		encounter, combatant, err = s.getTurn(ctx, input.EncounterID, char)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &UseItemResult{Character: char, Item: item}
+	if encounter != nil && item.Kind == equipment.ConsumablePotion {
+		result.BonusAction = s.getHouseRules(ctx, encounter).PotionsAsBonusAction
+	}
+
+	switch item.Kind {
+	case equipment.ConsumableScroll:
+		if err := s.readScroll(ctx, input, encounter, result); err != nil {
+			return nil, err
+		}
+	case equipment.ConsumablePotion:
+		if ok, reason := s.canUseNow(result, encounter); !ok {
+			return nil, dnderr.InvalidArgumentf("can't drink %s: %s", item.GetName(), reason)
+		}
+		if err := s.drinkPotion(ctx, input, encounter, combatant, result); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, dnderr.InvalidArgumentf("%s can't be used on its own", item.GetName())
+	}
+
+	// The spell service saves its own copy of a scroll's reader
+	char = result.Character
+	if char.ConsumeItem(item.GetKey()) == nil {
+		return nil, dnderr.NotFoundf("%s has no '%s' to use", char.Name, item.GetKey())
+	}
+	if encounter != nil && result.Spell == nil {
+		char.RecordItemUse(item.GetKey(), result.BonusAction)
+	}
+	result.Remaining = char.ConsumableCount(item.GetKey())
+
+	ctx = charService.WithChange(ctx, input.UserID, fmt.Sprintf("used %s", item.GetName()))
+	if err := s.characterService.UpdateEquipment(ctx, char); err != nil {
+		return nil, dnderr.Wrapf(err, "failed to save %s", char.Name)
+	}
+
+	if encounter != nil {
+		if err := s.encounterService.LogCombatAction(ctx, encounter.ID, result.Message); err != nil {
+			log.Printf("Failed to log item use: %v", err)
+		}
+	}
+
+	return result, nil
+}
+
+// UseCharge casts the spell stored in a charged magic item
+func (s *service) UseCharge(ctx context.Context, input *UseItemInput) (*UseChargeResult, error) {
+	if input == nil {
+		return nil, dnderr.InvalidArgument("input cannot be nil")
+	}
+	if input.CharacterID == "" {
+		return nil, dnderr.InvalidArgument("character ID is required")
+	}
+	if input.ItemKey == "" {
+		return nil, dnderr.InvalidArgument("item key is required")
+	}
+
+	char, err := s.characterService.GetByID(input.CharacterID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", input.CharacterID)
+	}
+	if input.UserID != "" && char.OwnerID != input.UserID {
+		return nil, dnderr.PermissionDenied("only the character's owner can use their items").
+			WithMeta("character_id", input.CharacterID)
+	}
+
+	item := char.GetEquipment(input.ItemKey)
+	if item == nil {
+		return nil, dnderr.NotFoundf("%s has no '%s' to use", char.Name, input.ItemKey).
+			WithMeta("item_key", input.ItemKey)
+	}
+	magic := equipment.Magic(item)
+	if magic == nil || magic.Spell == "" || magic.MaxCharges == 0 {
+		return nil, dnderr.InvalidArgumentf("%s has no charges to spend", item.GetName())
+	}
+	if magic.RequiresAttunement && !char.IsAttuned(item.GetKey()) {
+		return nil, dnderr.InvalidArgumentf("%s must be attuned to %s to use it", char.Name, item.GetName())
+	}
+	if char.ItemCharges(item.GetKey()) == 0 {
+		return nil, dnderr.InvalidArgumentf("%s has no charges left", item.GetName())
+	}
+
+	var encounter *combat.Encounter
+	if input.EncounterID != "" {
+		encounter, _, err = s.getTurn(ctx, input.EncounterID, char)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cast, err := s.spellService.CastSpell(ctx, &spellService.CastSpellInput{
+		CharacterID: char.ID,
+		UserID:      input.UserID,
+		SpellKey:    magic.Spell,
+		EncounterID: input.EncounterID,
+		TargetIDs:   input.TargetIDs,
+		Item:        true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Reload to keep the action the spell used
+	char, err = s.characterService.GetByID(char.ID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", input.CharacterID)
+	}
+	if _, err := char.UseItemCharge(item.GetKey()); err != nil {
+		return nil, dnderr.InvalidArgument(err.Error())
+	}
+
+	result := &UseChargeResult{
+		Character:   char,
+		Item:        item,
+		ChargesLeft: char.ItemCharges(item.GetKey()),
+		Spell:       cast,
+		Message:     fmt.Sprintf("%s uses a charge of the %s. %s", char.Name, item.GetName(), cast.Message),
+	}
+
+	ctx = charService.WithChange(ctx, input.UserID, fmt.Sprintf("used a charge of %s", item.GetName()))
+	if err := s.characterService.UpdateEquipment(ctx, char); err != nil {
+		return nil, dnderr.Wrapf(err, "failed to save %s", char.Name)
+	}
+
+	if encounter != nil {
+		if err := s.encounterService.LogCombatAction(ctx, encounter.ID, result.Message); err != nil {
+			log.Printf("Failed to log item use: %v", err)
+		}
+	}
+
+	return result, nil
+}
+
+// canUseNow checks the action economy for using an item in combat
+func (s *service) canUseNow(result *UseItemResult, encounter *combat.Encounter) (bool, string) {
+	if encounter == nil {
+		return true, ""
+	}
+	return result.Character.CanUseItemThisTurn(result.BonusAction)
+}
+
+// drinkPotion heals the character or grants the potion's effect
+func (s *service) drinkPotion(ctx context.Context, input *UseItemInput, encounter *combat.Encounter, combatant *combat.Combatant, result *UseItemResult) error {
+	char := result.Character
+	effect := result.Item.Effect
+
+	if effect.Heals() {
+		roll, err := s.diceRoller.Roll(effect.HealingDice, effect.HealingDieSize, effect.HealingBonus)
+		if err != nil {
+			return dnderr.Wrap(err, "failed to roll healing")
+		}
+		result.HealingRolls = roll.Rolls
+
+		// In combat the combatant's hit points are the ones that count
+		if combatant != nil {
+			result.Healed = min(roll.Total, combatant.MaxHP-combatant.CurrentHP)
+			if err := s.encounterService.HealCombatant(ctx, encounter.ID, combatant.ID, input.UserID, roll.Total); err != nil {
+				return dnderr.Wrapf(err, "failed to heal %s", char.Name)
+			}
+			char.Heal(roll.Total)
+		} else {
+			result.Healed = char.Heal(roll.Total)
+		}
+	}
+
+	if buff := result.Item.BuffEffect(); buff != nil {
+		if err := char.AddStatusEffect(buff); err != nil {
+			return dnderr.Wrapf(err, "failed to apply %s", buff.Name)
+		}
+		result.Effect = buff.Name
+	}
+
+	result.Message = fmt.Sprintf("%s drinks a %s", char.Name, result.Item.GetName())
+	if effect.Heals() {
+		result.Message += fmt.Sprintf(" and regains %d HP", result.Healed)
+	}
+	return nil
+}
+
+// readScroll casts the spell on a scroll. Only characters with the spell on
+// their class's list can read it. A scroll of a higher level than the
+// character can cast needs a check with the spell's casting ability against
+// DC 10 + the spell's level; on a failure the scroll crumbles uselessly.
+func (s *service) readScroll(ctx context.Context, input *UseItemInput, encounter *combat.Encounter, result *UseItemResult) error {
+	char := result.Character
+	scroll := result.Item.Effect
+
+	spell, err := s.characterService.GetSpell(ctx, scroll.Spell)
+	if err != nil {
+		return dnderr.Wrapf(err, "failed to get spell '%s'", scroll.Spell)
+	}
+	if !char.HasSpellOnClassList(spell) {
+		return dnderr.InvalidArgumentf("%s isn't on %s's spell list", spell.Name, char.Name).
+			WithMeta("spell_key", spell.Key)
+	}
+
+	if scroll.SpellLevel > highestSlotLevel(char) {
+		if ok, reason := s.canUseNow(result, encounter); !ok {
+			return dnderr.InvalidArgumentf("can't read %s: %s", result.Item.GetName(), reason)
+		}
+
+		bonus := 0
+		if ability := char.Attributes[char.SpellcastingAbilityFor(spell)]; ability != nil {
+			bonus = ability.Bonus
+		}
+		roll, err := s.diceRoller.Roll(1, 20, bonus)
+		if err != nil {
+			return dnderr.Wrap(err, "failed to roll ability check")
+		}
+		if dc := 10 + scroll.SpellLevel; roll.Total < dc {
+			result.ScrollFailed = true
+			result.Message = fmt.Sprintf("%s tries to read a %s but fails (%d vs DC %d) and the scroll crumbles",
+				char.Name, result.Item.GetName(), roll.Total, dc)
+			return nil
+		}
+	}
+
+	cast, err := s.spellService.CastSpell(ctx, &spellService.CastSpellInput{
+		CharacterID: char.ID,
+		UserID:      input.UserID,
+		SpellKey:    scroll.Spell,
+		SlotLevel:   scroll.SpellLevel,
+		EncounterID: input.EncounterID,
+		TargetIDs:   input.TargetIDs,
+		Scroll:      true,
+	})
+	if err != nil {
+		return err
+	}
+	result.Spell = cast
+	result.Message = fmt.Sprintf("%s reads a %s. %s", char.Name, result.Item.GetName(), cast.Message)
+
+	// Reload to keep the action the spell used
+	reloaded, err := s.characterService.GetByID(char.ID)
+	if err != nil {
+		return dnderr.Wrapf(err, "failed to get character '%s'", char.ID)
+	}
+	result.Character = reloaded
+	return nil
+}
+
+// getTurn loads the encounter and the character's combatant, checking it's
+// their turn
+func (s *service) getTurn(ctx context.Context, encounterID string, char *character.Character) (*combat.Encounter, *combat.Combatant, error) {
+	encounter, err := s.encounterService.GetEncounter(ctx, encounterID)
+	if err != nil {
+		return nil, nil, dnderr.Wrap(err, "failed to get encounter")
+	}
+	if encounter.Status != combat.EncounterStatusActive {
+		return nil, nil, dnderr.InvalidArgument("encounter is not active")
+	}
+
+	var combatant *combat.Combatant
+	for _, c := range encounter.Combatants {
+		if c.CharacterID == char.ID {
+			combatant = c
+			break
+		}
+	}
+	if combatant == nil {
+		return nil, nil, dnderr.InvalidArgumentf("%s is not in the fight", char.Name)
+	}
+	if current := encounter.GetCurrentCombatant(); current == nil || current.ID != combatant.ID {
+		return nil, nil, dnderr.InvalidArgument("it's not your turn")
+	}
+
+	return encounter, combatant, nil
+}
+
+// getHouseRules looks up the house rules for the encounter's session,
+// falling back to standard rules
+func (s *service) getHouseRules(ctx context.Context, encounter *combat.Encounter) *gameSession.HouseRules {
+	sess, err := s.sessionService.GetSession(ctx, encounter.SessionID)
+	if err != nil {
+		log.Printf("Failed to load house rules for session %s, using standard rules: %v", encounter.SessionID, err)
+		return &gameSession.HouseRules{}
+	}
+	return sess.GetHouseRules()
+}
+
+// findUsable returns the character's potion or scroll with the given key
+func findUsable(char *character.Character, key string) *equipment.Consumable {
+	for _, item := range char.UsableConsumables() {
+		if item.GetKey() == key {
+			return item
+		}
+	}
+	return nil
+}
+
+// highestSlotLevel returns the highest level of spell slot the character has
+func highestSlotLevel(char *character.Character) int {
+	highest := 0
+	for level, slot := range char.GetResources().SpellSlots {
+		if slot.Max > 0 && level > highest {
+			highest = level
+		}
+	}
+	return highest
+}
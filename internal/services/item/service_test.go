@@ -0,0 +1,372 @@
+package item_test
+
+import (
+	"context"
+	"testing"
+
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	mockcharacter "github.com/KirkDiggler/dnd-bot-discord/internal/services/character/mock"
+	mockencounter "github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/item"
+	mocksession "github.com/KirkDiggler/dnd-bot-discord/internal/services/session/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
+	mockspell "github.com/KirkDiggler/dnd-bot-discord/internal/services/spell/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testPlayerID = "user_123"
+
+var (
+	magicMissile = &rulebook.Spell{Key: "magic-missile", Name: "Magic Missile", Level: 1, Classes: []string{"sorcerer", "wizard"}}
+	fireball     = &rulebook.Spell{Key: "fireball", Name: "Fireball", Level: 3, Classes: []string{"sorcerer", "wizard"}}
+)
+
+type testDeps struct {
+	service    item.Service
+	charSvc    *mockcharacter.MockService
+	encSvc     *mockencounter.MockService
+	sessionSvc *mocksession.MockService
+	spellSvc   *mockspell.MockService
+	roller     *mockdice.ManualMockRoller
+}
+
+func setup(t *testing.T) *testDeps {
+	ctrl := gomock.NewController(t)
+
+	deps := &testDeps{
+		charSvc:    mockcharacter.NewMockService(ctrl),
+		encSvc:     mockencounter.NewMockService(ctrl),
+		sessionSvc: mocksession.NewMockService(ctrl),
+		spellSvc:   mockspell.NewMockService(ctrl),
+		roller:     mockdice.NewManualMockRoller(),
+	}
+	deps.service = item.NewService(&item.ServiceConfig{
+		CharacterService: deps.charSvc,
+		EncounterService: deps.encSvc,
+		SessionService:   deps.sessionSvc,
+		SpellService:     deps.spellSvc,
+		DiceRoller:       deps.roller,
+	})
+	return deps
+}
+
+// createWizard returns a level 1 wizard with 3 of 8 HP
+func createWizard() *character.Character {
+	char := &character.Character{
+		ID:               "char_123",
+		OwnerID:          testPlayerID,
+		Name:             "Elminster",
+		Level:            1,
+		Class:            &rulebook.Class{Key: "wizard", Name: "Wizard", HitDie: 6},
+		MaxHitPoints:     8,
+		CurrentHitPoints: 3,
+	}
+	char.AddAttribute(shared.AttributeIntelligence, 16)
+	char.InitializeResources()
+	return char
+}
+
+// createFight returns an active encounter where it's the character's turn
+func createFight(char *character.Character) *combat.Encounter {
+	enc := combat.NewEncounter("enc_123", "session_123", "channel_123", "Ambush", "dm_123")
+	enc.AddCombatant(&combat.Combatant{
+		ID: "player", Name: char.Name, Type: combat.CombatantTypePlayer,
+		PlayerID: testPlayerID, CharacterID: char.ID,
+		CurrentHP: 3, MaxHP: 8, IsActive: true,
+	})
+	enc.Status = combat.EncounterStatusActive
+	enc.TurnOrder = []string{"player"}
+	return enc
+}
+
+func TestUseItem_PotionOutOfCombat(t *testing.T) {
+	deps := setup(t)
+	char := createWizard()
+	char.AddInventory(equipment.NewSRDConsumable("potion-of-healing", 2))
+
+	deps.charSvc.EXPECT().GetByID(char.ID).Return(char, nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), char).Return(nil)
+	deps.roller.SetRolls([]int{1, 2})
+
+	result, err := deps.service.UseItem(context.Background(), &item.UseItemInput{
+		CharacterID: char.ID,
+		UserID:      testPlayerID,
+		ItemKey:     "potion-of-healing",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, result.HealingRolls)
+	assert.Equal(t, 5, result.Healed, "2d4+2 rolls 5 and 3 HP is missing")
+	assert.Equal(t, 8, char.CurrentHitPoints)
+	assert.Equal(t, 1, result.Remaining)
+	assert.Equal(t, 1, char.ConsumableCount("potion-of-healing"))
+}
+
+func TestUseItem_PotionAsBonusActionHouseRule(t *testing.T) {
+	deps := setup(t)
+	char := createWizard()
+	char.AddInventory(equipment.NewSRDConsumable("potion-of-healing", 1))
+	enc := createFight(char)
+	sess := &gameSession.Session{
+		ID:       "session_123",
+		Settings: &gameSession.SessionSettings{HouseRules: &gameSession.HouseRules{PotionsAsBonusAction: true}},
+	}
+
+	deps.charSvc.EXPECT().GetByID(char.ID).Return(char, nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), char).Return(nil)
+	deps.encSvc.EXPECT().GetEncounter(gomock.Any(), enc.ID).Return(enc, nil)
+	deps.sessionSvc.EXPECT().GetSession(gomock.Any(), "session_123").Return(sess, nil)
+	deps.encSvc.EXPECT().HealCombatant(gomock.Any(), enc.ID, "player", testPlayerID, 6).Return(nil)
+	deps.encSvc.EXPECT().LogCombatAction(gomock.Any(), enc.ID, gomock.Any()).Return(nil)
+	deps.roller.SetRolls([]int{2, 2})
+
+	result, err := deps.service.UseItem(context.Background(), &item.UseItemInput{
+		CharacterID: char.ID,
+		UserID:      testPlayerID,
+		ItemKey:     "potion-of-healing",
+		EncounterID: enc.ID,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.BonusAction)
+	assert.Equal(t, 5, result.Healed)
+	economy := char.GetResources().ActionEconomy
+	assert.True(t, economy.BonusActionUsed)
+	assert.False(t, economy.ActionUsed, "the action is still free")
+	assert.Zero(t, result.Remaining)
+}
+
+func TestUseItem_PotionAfterActionUsed(t *testing.T) {
+	deps := setup(t)
+	char := createWizard()
+	char.AddInventory(equipment.NewSRDConsumable("potion-of-healing", 1))
+	char.GetResources().ActionEconomy.ActionUsed = true
+	enc := createFight(char)
+
+	deps.charSvc.EXPECT().GetByID(char.ID).Return(char, nil)
+	deps.encSvc.EXPECT().GetEncounter(gomock.Any(), enc.ID).Return(enc, nil)
+	deps.sessionSvc.EXPECT().GetSession(gomock.Any(), "session_123").Return(&gameSession.Session{ID: "session_123"}, nil)
+
+	_, err := deps.service.UseItem(context.Background(), &item.UseItemInput{
+		CharacterID: char.ID,
+		UserID:      testPlayerID,
+		ItemKey:     "potion-of-healing",
+		EncounterID: enc.ID,
+	})
+
+	require.Error(t, err)
+	assert.True(t, dnderr.IsInvalidArgument(err))
+	assert.Equal(t, 1, char.ConsumableCount("potion-of-healing"))
+}
+
+func TestUseItem_ResistancePotionGrantsEffect(t *testing.T) {
+	deps := setup(t)
+	char := createWizard()
+	char.AddInventory(equipment.NewSRDConsumable("potion-of-fire-resistance", 1))
+
+	deps.charSvc.EXPECT().GetByID(char.ID).Return(char, nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), char).Return(nil)
+
+	result, err := deps.service.UseItem(context.Background(), &item.UseItemInput{
+		CharacterID: char.ID,
+		UserID:      testPlayerID,
+		ItemKey:     "potion-of-fire-resistance",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Potion of Fire Resistance", result.Effect)
+	assert.Zero(t, result.Healed)
+	assert.Equal(t, 5, char.ApplyDamageResistance("fire", 10))
+}
+
+func TestUseItem_ScrollCastsSpell(t *testing.T) {
+	deps := setup(t)
+	char := createWizard()
+	char.GetResources().SpellSlots = map[int]shared.SpellSlotInfo{1: {Max: 2, Remaining: 2}}
+	char.AddInventory(equipment.NewSpellScroll("magic-missile", "Magic Missile", 1))
+	reloaded := createWizard()
+	reloaded.AddInventory(equipment.NewSpellScroll("magic-missile", "Magic Missile", 1))
+
+	gomock.InOrder(
+		deps.charSvc.EXPECT().GetByID(char.ID).Return(char, nil),
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "magic-missile").Return(magicMissile, nil),
+		deps.spellSvc.EXPECT().CastSpell(gomock.Any(), &spell.CastSpellInput{
+			CharacterID: char.ID,
+			UserID:      testPlayerID,
+			SpellKey:    "magic-missile",
+			SlotLevel:   1,
+			TargetIDs:   []string{"goblin"},
+			Scroll:      true,
+		}).Return(&spell.CastSpellResult{SpellKey: "magic-missile", Message: "3 darts strike"}, nil),
+		deps.charSvc.EXPECT().GetByID(char.ID).Return(reloaded, nil),
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), reloaded).Return(nil),
+	)
+
+	result, err := deps.service.UseItem(context.Background(), &item.UseItemInput{
+		CharacterID: char.ID,
+		UserID:      testPlayerID,
+		ItemKey:     "spell-scroll-magic-missile",
+		TargetIDs:   []string{"goblin"},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, result.Spell)
+	assert.Same(t, reloaded, result.Character)
+	assert.Zero(t, reloaded.ConsumableCount("spell-scroll-magic-missile"))
+}
+
+func TestUseCharge_WandCastsSpell(t *testing.T) {
+	deps := setup(t)
+	char := createWizard()
+	char.AddInventory(equipment.NewSRDMagicItem("wand-of-magic-missiles"))
+	reloaded := createWizard()
+	reloaded.AddInventory(equipment.NewSRDMagicItem("wand-of-magic-missiles"))
+
+	gomock.InOrder(
+		deps.charSvc.EXPECT().GetByID(char.ID).Return(char, nil),
+		deps.spellSvc.EXPECT().CastSpell(gomock.Any(), &spell.CastSpellInput{
+			CharacterID: char.ID,
+			UserID:      testPlayerID,
+			SpellKey:    "magic-missile",
+			TargetIDs:   []string{"goblin"},
+			Item:        true,
+		}).Return(&spell.CastSpellResult{SpellKey: "magic-missile", Message: "3 darts strike"}, nil),
+		deps.charSvc.EXPECT().GetByID(char.ID).Return(reloaded, nil),
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), reloaded).Return(nil),
+	)
+
+	result, err := deps.service.UseCharge(context.Background(), &item.UseItemInput{
+		CharacterID: char.ID,
+		UserID:      testPlayerID,
+		ItemKey:     "wand-of-magic-missiles",
+		TargetIDs:   []string{"goblin"},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, result.Spell)
+	assert.Equal(t, 6, result.ChargesLeft)
+	assert.Equal(t, 6, reloaded.ItemCharges("wand-of-magic-missiles"))
+}
+
+func TestUseCharge_NoChargesLeft(t *testing.T) {
+	deps := setup(t)
+	char := createWizard()
+	wand := equipment.NewSRDMagicItem("wand-of-magic-missiles")
+	wand.Magic.Charges = 0
+	char.AddInventory(wand)
+
+	deps.charSvc.EXPECT().GetByID(char.ID).Return(char, nil)
+
+	_, err := deps.service.UseCharge(context.Background(), &item.UseItemInput{
+		CharacterID: char.ID,
+		UserID:      testPlayerID,
+		ItemKey:     "wand-of-magic-missiles",
+	})
+
+	assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument))
+}
+
+func TestUseItem_ScrollAboveLevelFailsCheck(t *testing.T) {
+	deps := setup(t)
+	char := createWizard()
+	char.GetResources().SpellSlots = map[int]shared.SpellSlotInfo{1: {Max: 2, Remaining: 2}}
+	char.AddInventory(equipment.NewSpellScroll("fireball", "Fireball", 3))
+
+	deps.charSvc.EXPECT().GetByID(char.ID).Return(char, nil)
+	deps.charSvc.EXPECT().GetSpell(gomock.Any(), "fireball").Return(fireball, nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), char).Return(nil)
+	deps.roller.SetRolls([]int{5}) // 5 + 3 INT misses DC 13
+
+	result, err := deps.service.UseItem(context.Background(), &item.UseItemInput{
+		CharacterID: char.ID,
+		UserID:      testPlayerID,
+		ItemKey:     "spell-scroll-fireball",
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.ScrollFailed)
+	assert.Nil(t, result.Spell)
+	assert.Zero(t, char.ConsumableCount("spell-scroll-fireball"), "a failed scroll is still used up")
+}
+
+func TestUseItem_ScrollNotOnClassList(t *testing.T) {
+	deps := setup(t)
+	char := createWizard()
+	char.Class = &rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10}
+	char.AddInventory(equipment.NewSpellScroll("fireball", "Fireball", 3))
+
+	deps.charSvc.EXPECT().GetByID(char.ID).Return(char, nil)
+	deps.charSvc.EXPECT().GetSpell(gomock.Any(), "fireball").Return(fireball, nil)
+
+	_, err := deps.service.UseItem(context.Background(), &item.UseItemInput{
+		CharacterID: char.ID,
+		UserID:      testPlayerID,
+		ItemKey:     "spell-scroll-fireball",
+	})
+
+	assert.True(t, dnderr.IsInvalidArgument(err), "got %v", err)
+	assert.Equal(t, 1, char.ConsumableCount("spell-scroll-fireball"), "the scroll isn't used up")
+}
+
+func TestUseItem_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   *item.UseItemInput
+		code    dnderr.Code
+		getChar bool
+	}{
+		{
+			name:  "nil input",
+			input: nil,
+			code:  dnderr.CodeInvalidArgument,
+		},
+		{
+			name:  "missing item key",
+			input: &item.UseItemInput{CharacterID: "char_123", UserID: testPlayerID},
+			code:  dnderr.CodeInvalidArgument,
+		},
+		{
+			name:    "not the owner",
+			input:   &item.UseItemInput{CharacterID: "char_123", UserID: "someone_else", ItemKey: "potion-of-healing"},
+			code:    dnderr.CodePermissionDenied,
+			getChar: true,
+		},
+		{
+			name:    "item not carried",
+			input:   &item.UseItemInput{CharacterID: "char_123", UserID: testPlayerID, ItemKey: "potion-of-healing"},
+			code:    dnderr.CodeNotFound,
+			getChar: true,
+		},
+		{
+			name:    "ammunition can't be used",
+			input:   &item.UseItemInput{CharacterID: "char_123", UserID: testPlayerID, ItemKey: "arrow"},
+			code:    dnderr.CodeNotFound,
+			getChar: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := setup(t)
+			if tt.getChar {
+				char := createWizard()
+				char.AddInventory(equipment.NewSRDConsumable("arrow", 20))
+				deps.charSvc.EXPECT().GetByID("char_123").Return(char, nil)
+			}
+
+			_, err := deps.service.UseItem(context.Background(), tt.input)
+
+			require.Error(t, err)
+			assert.True(t, dnderr.Is(err, tt.code), "got %v", err)
+		})
+	}
+}
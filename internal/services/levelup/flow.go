@@ -0,0 +1,652 @@
+package levelup
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/features"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+)
+
+const (
+	// featOptionPrefix marks feat options in the ASI step
+	featOptionPrefix = "feat:"
+
+	maxAbilityScore = 20
+
+	// maxSelectOptions is Discord's limit on select menu options
+	maxSelectOptions = 25
+
+	levelUpColor = 0xF1C40F // Gold
+)
+
+var attributeNames = map[shared.Attribute]string{
+	shared.AttributeStrength:     "Strength",
+	shared.AttributeDexterity:    "Dexterity",
+	shared.AttributeConstitution: "Constitution",
+	shared.AttributeIntelligence: "Intelligence",
+	shared.AttributeWisdom:       "Wisdom",
+	shared.AttributeCharisma:     "Charisma",
+}
+
+// nextStep returns the first incomplete step, or the completion summary
+func (s *service) nextStep(ctx context.Context, char *character.Character) (*character.CreationStep, error) {
+	steps, err := s.buildSteps(ctx, char)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, step := range steps {
+		if !isStepComplete(char.LevelUp, step) {
+			return &step, nil
+		}
+	}
+
+	class, err := s.levelingClass(ctx, char)
+	if err != nil {
+		return nil, err
+	}
+	return buildCompleteStep(char, class), nil
+}
+
+// levelingClass returns the class the pending level is taken in: the chosen
+// class, or the starting class until one is chosen
+func (s *service) levelingClass(ctx context.Context, char *character.Character) (*rulebook.Class, error) {
+	key := char.LevelUp.ClassKey
+	if key == "" {
+		return char.Class, nil
+	}
+	for _, classLevel := range char.ClassLevels() {
+		if classLevel.Class.Key == key {
+			return classLevel.Class, nil
+		}
+	}
+
+	class, err := s.characterService.GetClass(ctx, key)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get class '%s'", key)
+	}
+	return class, nil
+}
+
+// buildSteps builds the steps for the character's pending level.
+// Steps are derived from the leveling class and its new class level, so a
+// resumed level-up always rebuilds the same flow.
+func (s *service) buildSteps(ctx context.Context, char *character.Character) ([]character.CreationStep, error) {
+	if char.Class == nil {
+		return nil, dnderr.InvalidArgumentf("%s has no class", char.Name)
+	}
+
+	class, err := s.levelingClass(ctx, char)
+	if err != nil {
+		return nil, err
+	}
+	classKey := class.Key
+	newClass := char.LevelInClass(classKey) == 0
+	level := char.LevelInClass(classKey) + 1
+
+	var steps []character.CreationStep
+	if step := s.buildClassStep(ctx, char); step != nil {
+		steps = append(steps, *step)
+	}
+	hitDie, err := char.HitDieFor(class)
+	if err != nil {
+		return nil, dnderr.Wrap(err, "failed to get hit die")
+	}
+	steps = append(steps, buildHitPointStep(char, hitDie))
+
+	if newClass {
+		grants := rulebook.GetMulticlassProficiencies(classKey)
+		if step := buildSkillStep(char, class, grants); step != nil {
+			steps = append(steps, *step)
+		}
+		if step := buildInstrumentStep(char, grants); step != nil {
+			steps = append(steps, *step)
+		}
+	}
+
+	newFeatures := features.GetClassFeaturesAtLevel(classKey, level)
+	newFeatures = append(newFeatures, features.GetSubclassFeaturesAtLevel(classKey, char.SubclassKeyFor(classKey), level)...)
+	if len(newFeatures) > 0 || rulebook.ProficiencyBonusForLevel(char.LevelUp.TargetLevel) > char.GetProficiencyBonus() {
+		steps = append(steps, buildFeaturesStep(char, class, level, newFeatures))
+	}
+
+	if features.HasFeature(newFeatures, "fighting_style") && !char.HasFightingStyle() {
+		if step := buildFightingStyleStep(classKey); step != nil {
+			steps = append(steps, *step)
+		}
+	}
+
+	if level >= rulebook.SubclassLevel(classKey) && char.SubclassKeyFor(classKey) == "" {
+		if step := buildSubclassStep(class); step != nil {
+			steps = append(steps, *step)
+		}
+	}
+
+	if rulebook.IsAbilityScoreImprovementLevel(classKey, level) {
+		steps = append(steps, s.buildImprovementStep(char))
+	}
+
+	if count := rulebook.CantripsKnown(classKey, level) - rulebook.CantripsKnown(classKey, level-1); count > 0 {
+		if step := s.buildSpellStep(ctx, char, classKey, character.StepTypeCantripsSelection, count, 0, 0); step != nil {
+			steps = append(steps, *step)
+		}
+	}
+
+	if count := rulebook.SpellsKnown(classKey, level) - rulebook.SpellsKnown(classKey, level-1); count > 0 {
+		maxSpellLevel := rulebook.MaxSpellLevel(classKey, char.SubclassKeyFor(classKey), level)
+		if step := s.buildSpellStep(ctx, char, classKey, character.StepTypeSpellsKnownSelection, count, 1, maxSpellLevel); step != nil {
+			steps = append(steps, *step)
+		}
+	}
+
+	return steps, nil
+}
+
+// isStepComplete reports whether the level-up progress covers a step
+func isStepComplete(progress *character.LevelUpProgress, step character.CreationStep) bool {
+	switch step.Type {
+	case character.StepTypeClassSelection:
+		return progress.ClassKey != ""
+	case character.StepTypeLevelUpHitPoints:
+		return progress.HitPoints > 0
+	case character.StepTypeSkillSelection:
+		return len(progress.Skills) > 0
+	case character.StepTypeProficiencySelection:
+		return len(progress.Instruments) > 0
+	case character.StepTypeLevelUpFeatures:
+		return progress.FeaturesConfirmed
+	case character.StepTypeFightingStyleSelection:
+		return progress.FightingStyle != ""
+	case character.StepTypeSubclassSelection:
+		return progress.Subclass != ""
+	case character.StepTypeAbilityScoreImprovement:
+		return progress.HasImprovement()
+	case character.StepTypeCantripsSelection:
+		return len(progress.Cantrips) > 0
+	case character.StepTypeSpellsKnownSelection:
+		return len(progress.Spells) > 0
+	}
+	return true
+}
+
+// buildClassStep offers the character's classes and, when the session
+// allows multiclassing, any class they qualify for. Returns nil when their
+// class is the only option.
+func (s *service) buildClassStep(ctx context.Context, char *character.Character) *character.CreationStep {
+	var candidates []string
+	if s.multiclassingAllowed(ctx, char) {
+		for _, key := range rulebook.MulticlassClasses() {
+			if char.LevelInClass(key) == 0 && char.CanMulticlassInto(key) == nil {
+				candidates = append(candidates, key)
+			}
+		}
+	}
+	if len(candidates) == 0 && !char.IsMulticlass() {
+		return nil
+	}
+
+	step := &character.CreationStep{
+		Type:        character.StepTypeClassSelection,
+		Title:       "Choose a Class",
+		Description: "Advance one of your classes, or take your first level in a new class.",
+		MinChoices:  1,
+		MaxChoices:  1,
+		Required:    true,
+		UIHints: &character.StepUIHints{
+			Color:        levelUpColor,
+			ShowProgress: true,
+		},
+	}
+	for _, classLevel := range char.ClassLevels() {
+		step.Options = append(step.Options, character.CreationOption{
+			Key:         classLevel.Class.Key,
+			Name:        classLevel.Class.Name,
+			Description: fmt.Sprintf("Advance to %s level %d", classLevel.Class.Name, classLevel.Level+1),
+		})
+	}
+
+	names := make(map[string]string)
+	if len(candidates) > 0 {
+		if classes, err := s.characterService.GetClasses(ctx); err == nil {
+			for _, class := range classes {
+				if class != nil {
+					names[class.Key] = class.Name
+				}
+			}
+		}
+	}
+	for _, key := range candidates {
+		name := names[key]
+		if name == "" {
+			name = key
+		}
+		prerequisite, _ := rulebook.GetMulticlassPrerequisite(key)
+		step.Options = append(step.Options, character.CreationOption{
+			Key:         key,
+			Name:        "Multiclass: " + name,
+			Description: fmt.Sprintf("Start at %s level 1 (requires %s)", name, prerequisite),
+		})
+	}
+	return step
+}
+
+// multiclassingAllowed checks the multiclassing house rule of the owner's
+// most recently active session. Multiclassing is an optional rule, so it's
+// off outside a session.
+func (s *service) multiclassingAllowed(ctx context.Context, char *character.Character) bool {
+	if s.sessionService == nil {
+		return false
+	}
+
+	sessions, err := s.sessionService.ListActiveUserSessions(ctx, char.OwnerID)
+	if err != nil {
+		log.Printf("Failed to get active sessions for %s: %v", char.OwnerID, err)
+		return false
+	}
+
+	var latest *gameSession.Session
+	for _, sess := range sessions {
+		if latest == nil || sess.LastActive.After(latest.LastActive) {
+			latest = sess
+		}
+	}
+	return latest.GetHouseRules().IsEnabled(gameSession.HouseRuleMulticlassing)
+}
+
+// buildSkillStep offers the skills a new class grants when multiclassing
+func buildSkillStep(char *character.Character, class *rulebook.Class, grants rulebook.MulticlassProficiencies) *character.CreationStep {
+	if grants.Skills == 0 {
+		return nil
+	}
+	skills := grants.SkillOptions
+	if len(skills) == 0 {
+		skills = rulebook.SkillNames
+	}
+
+	var options []character.CreationOption
+	for _, name := range skills {
+		if char.HasSkillProficiency(rulebook.SkillProficiency(name).Key) {
+			continue
+		}
+		options = append(options, character.CreationOption{Key: name, Name: name})
+	}
+	if len(options) == 0 {
+		return nil
+	}
+	count := min(grants.Skills, len(options))
+
+	return &character.CreationStep{
+		Type:        character.StepTypeSkillSelection,
+		Title:       fmt.Sprintf("Choose %s Skills", class.Name),
+		Description: fmt.Sprintf("Multiclassing into %s grants proficiency in %d skill(s).", class.Name, count),
+		Options:     options,
+		MinChoices:  count,
+		MaxChoices:  count,
+		Required:    true,
+		UIHints: &character.StepUIHints{
+			Color:        levelUpColor,
+			ShowProgress: true,
+		},
+	}
+}
+
+// buildInstrumentStep offers the musical instruments a new class grants
+func buildInstrumentStep(char *character.Character, grants rulebook.MulticlassProficiencies) *character.CreationStep {
+	if grants.Instruments == 0 {
+		return nil
+	}
+
+	known := make(map[string]bool)
+	for _, proficiency := range char.Proficiencies[rulebook.ProficiencyTypeInstrument] {
+		if proficiency != nil {
+			known[proficiency.Key] = true
+		}
+	}
+
+	var options []character.CreationOption
+	for _, instrument := range rulebook.MusicalInstruments {
+		if !known[instrument.Key] {
+			options = append(options, character.CreationOption{Key: instrument.Key, Name: instrument.Name})
+		}
+	}
+	if len(options) == 0 {
+		return nil
+	}
+	count := min(grants.Instruments, len(options))
+
+	return &character.CreationStep{
+		Type:        character.StepTypeProficiencySelection,
+		Title:       "Choose a Musical Instrument",
+		Description: fmt.Sprintf("Choose %d musical instrument(s) to become proficient with.", count),
+		Options:     options,
+		MinChoices:  count,
+		MaxChoices:  count,
+		Required:    true,
+		UIHints: &character.StepUIHints{
+			Color:        levelUpColor,
+			ShowProgress: true,
+		},
+	}
+}
+
+func buildHitPointStep(char *character.Character, hitDie int) character.CreationStep {
+	average := averageHitDie(hitDie)
+	conBonus := constitutionBonus(char)
+
+	return character.CreationStep{
+		Type:  character.StepTypeLevelUpHitPoints,
+		Title: "Hit Points",
+		Description: fmt.Sprintf("Your hit die is a d%d. Take the fixed value or roll for it; "+
+			"your Constitution modifier (%+d) is added either way.", hitDie, conBonus),
+		Options: []character.CreationOption{
+			{
+				Key:         character.HitPointMethodAverage,
+				Name:        fmt.Sprintf("Take %d (+%d max HP)", average, hitPointGain(char, average)),
+				Description: "The safe choice: half your hit die plus one",
+			},
+			{
+				Key:         character.HitPointMethodRoll,
+				Name:        fmt.Sprintf("Roll 1d%d", hitDie),
+				Description: "Could be higher, could be lower",
+			},
+		},
+		MinChoices: 1,
+		MaxChoices: 1,
+		Required:   true,
+		UIHints: &character.StepUIHints{
+			Color:        levelUpColor,
+			ShowProgress: true,
+		},
+	}
+}
+
+func buildFeaturesStep(char *character.Character, class *rulebook.Class, level int, newFeatures []rulebook.CharacterFeature) character.CreationStep {
+	var lines []string
+	for _, feat := range newFeatures {
+		lines = append(lines, fmt.Sprintf("**%s**: %s", feat.Name, feat.Description))
+	}
+	if bonus := rulebook.ProficiencyBonusForLevel(char.LevelUp.TargetLevel); bonus > char.GetProficiencyBonus() {
+		lines = append(lines, fmt.Sprintf("**Proficiency Bonus**: increases to +%d", bonus))
+	}
+
+	return character.CreationStep{
+		Type:        character.StepTypeLevelUpFeatures,
+		Title:       fmt.Sprintf("New %s Features", class.Name),
+		Description: fmt.Sprintf("At %s level %d you gain:\n\n%s", class.Name, level, strings.Join(lines, "\n\n")),
+		Options: []character.CreationOption{
+			{Key: "confirm", Name: "Continue"},
+		},
+		MinChoices: 1,
+		MaxChoices: 1,
+		Required:   true,
+		UIHints: &character.StepUIHints{
+			Color:        levelUpColor,
+			ShowProgress: true,
+		},
+	}
+}
+
+func buildFightingStyleStep(classKey string) *character.CreationStep {
+	choice := rulebook.GetFightingStyleChoice(classKey)
+	if choice == nil {
+		return nil
+	}
+
+	step := &character.CreationStep{
+		Type:        character.StepTypeFightingStyleSelection,
+		Title:       "Choose a Fighting Style",
+		Description: choice.Description,
+		MinChoices:  1,
+		MaxChoices:  1,
+		Required:    true,
+		UIHints: &character.StepUIHints{
+			Color:        levelUpColor,
+			ShowProgress: true,
+		},
+	}
+	for _, option := range choice.Options {
+		step.Options = append(step.Options, character.CreationOption{
+			Key:         option.Key,
+			Name:        option.Name,
+			Description: option.Description,
+		})
+	}
+	return step
+}
+
+func buildSubclassStep(class *rulebook.Class) *character.CreationStep {
+	subclasses := rulebook.GetSubclasses(class.Key)
+	if len(subclasses) == 0 {
+		return nil
+	}
+
+	step := &character.CreationStep{
+		Type:        character.StepTypeSubclassSelection,
+		Title:       fmt.Sprintf("Choose Your %s Subclass", class.Name),
+		Description: "Your subclass shapes the features you gain for the rest of your career.",
+		MinChoices:  1,
+		MaxChoices:  1,
+		Required:    true,
+		UIHints: &character.StepUIHints{
+			Color:        levelUpColor,
+			ShowProgress: true,
+		},
+	}
+	for _, subclass := range subclasses {
+		step.Options = append(step.Options, character.CreationOption{
+			Key:         subclass.Key,
+			Name:        subclass.Name,
+			Description: subclass.Description,
+		})
+	}
+	return step
+}
+
+// buildImprovementStep offers +2 to one ability, +1 to two, or a feat
+func (s *service) buildImprovementStep(char *character.Character) character.CreationStep {
+	step := character.CreationStep{
+		Type:  character.StepTypeAbilityScoreImprovement,
+		Title: "Ability Score Improvement",
+		Description: "Pick one ability to increase by 2, or two abilities to increase by 1 each. " +
+			"You can take a feat instead. Scores can't go above 20.",
+		MinChoices: 1,
+		MaxChoices: 2,
+		Required:   true,
+		Context: map[string]any{
+			"placeholder": "Choose abilities or a feat...",
+		},
+		UIHints: &character.StepUIHints{
+			Color:        levelUpColor,
+			ShowProgress: true,
+		},
+	}
+
+	for _, attr := range shared.Attributes {
+		score := 0
+		if ability := char.Attributes[attr]; ability != nil {
+			score = ability.Score
+		}
+		if score >= maxAbilityScore {
+			continue
+		}
+		description := fmt.Sprintf("Currently %d", score)
+		if score == maxAbilityScore-1 {
+			description += ", so only +1 with another ability"
+		}
+		step.Options = append(step.Options, character.CreationOption{
+			Key:         string(attr),
+			Name:        attributeNames[attr],
+			Description: description,
+		})
+	}
+
+	available := s.featRegistry.AvailableFeats(char)
+	sort.Slice(available, func(i, j int) bool {
+		return available[i].Name() < available[j].Name()
+	})
+	for _, feat := range available {
+		if len(step.Options) >= maxSelectOptions {
+			break
+		}
+		step.Options = append(step.Options, character.CreationOption{
+			Key:         featOptionPrefix + feat.Key(),
+			Name:        "Feat: " + feat.Name(),
+			Description: feat.Description(),
+		})
+	}
+
+	return step
+}
+
+// buildSpellStep offers spells the character doesn't know yet, highest level
+// first so newly unlocked spell levels are always shown. Returns nil if the
+// class spell list has nothing left to learn.
+func (s *service) buildSpellStep(ctx context.Context, char *character.Character, classKey string, stepType character.CreationStepType, count, minLevel, maxLevel int) *character.CreationStep {
+	known := make(map[string]bool)
+	if char.Spells != nil {
+		for _, key := range char.Spells.Cantrips {
+			known[key] = true
+		}
+		for _, key := range char.Spells.KnownSpells {
+			known[key] = true
+		}
+	}
+
+	var options []character.CreationOption
+	for spellLevel := maxLevel; spellLevel >= minLevel && len(options) < maxSelectOptions; spellLevel-- {
+		spells, err := s.characterService.ListSpellsByClassAndLevel(ctx, classKey, spellLevel)
+		if err != nil {
+			continue
+		}
+		for _, spell := range spells {
+			if spell == nil || known[spell.Key] || len(options) >= maxSelectOptions {
+				continue
+			}
+			options = append(options, character.CreationOption{
+				Key:         spell.Key,
+				Name:        spell.Name,
+				Description: spellLevelLabel(spellLevel),
+			})
+		}
+	}
+
+	if len(options) == 0 {
+		return nil
+	}
+	count = min(count, len(options))
+
+	title := "Learn New Spells"
+	description := fmt.Sprintf("Choose %d new spell(s) of up to %s.", count, strings.ToLower(spellLevelLabel(maxLevel)))
+	if stepType == character.StepTypeCantripsSelection {
+		title = "Learn New Cantrips"
+		description = fmt.Sprintf("Choose %d new cantrip(s).", count)
+	}
+	if classKey == "wizard" && stepType == character.StepTypeSpellsKnownSelection {
+		title = "Add Spells to Your Spellbook"
+	}
+
+	return &character.CreationStep{
+		Type:        stepType,
+		Title:       title,
+		Description: description,
+		Options:     options,
+		MinChoices:  count,
+		MaxChoices:  count,
+		Required:    true,
+		UIHints: &character.StepUIHints{
+			Color:          levelUpColor,
+			ShowProgress:   true,
+			ProgressFormat: "%d/%d spells selected",
+		},
+	}
+}
+
+// buildCompleteStep summarizes the pending level-up for confirmation
+func buildCompleteStep(char *character.Character, class *rulebook.Class) *character.CreationStep {
+	progress := char.LevelUp
+	classLevel := char.LevelInClass(class.Key) + 1
+
+	var lines []string
+	if class.Key != char.Class.Key || char.IsMulticlass() {
+		lines = append(lines, fmt.Sprintf("**Class**: %s level %d", class.Name, classLevel))
+	}
+	lines = append(lines, fmt.Sprintf("**Hit Points**: +%d (max %d)", progress.HitPoints, char.MaxHitPoints+progress.HitPoints))
+	if len(progress.Skills) > 0 {
+		lines = append(lines, fmt.Sprintf("**Skills**: %s", strings.Join(progress.Skills, ", ")))
+	}
+	if len(progress.Instruments) > 0 {
+		lines = append(lines, fmt.Sprintf("**Instruments**: %s", strings.Join(progress.Instruments, ", ")))
+	}
+	if progress.FightingStyle != "" {
+		lines = append(lines, fmt.Sprintf("**Fighting Style**: %s", optionName(rulebook.GetFightingStyleChoice(class.Key), progress.FightingStyle)))
+	}
+	if progress.Subclass != "" {
+		if subclass, ok := rulebook.GetSubclass(class.Key, progress.Subclass); ok {
+			lines = append(lines, fmt.Sprintf("**Subclass**: %s", subclass.Name))
+		}
+		var names []string
+		for _, feat := range features.GetSubclassFeatures(class.Key, progress.Subclass, classLevel) {
+			names = append(names, feat.Name)
+		}
+		if len(names) > 0 {
+			lines = append(lines, fmt.Sprintf("**Subclass Features**: %s", strings.Join(names, ", ")))
+		}
+	}
+	if progress.Feat != "" {
+		lines = append(lines, fmt.Sprintf("**Feat**: %s", progress.Feat))
+	}
+	for _, attr := range shared.Attributes {
+		if increase := progress.AbilityIncreases[attr]; increase > 0 {
+			lines = append(lines, fmt.Sprintf("**%s**: +%d", attributeNames[attr], increase))
+		}
+	}
+	if len(progress.Cantrips) > 0 {
+		lines = append(lines, fmt.Sprintf("**Cantrips**: %s", strings.Join(progress.Cantrips, ", ")))
+	}
+	if len(progress.Spells) > 0 {
+		lines = append(lines, fmt.Sprintf("**Spells**: %s", strings.Join(progress.Spells, ", ")))
+	}
+
+	return &character.CreationStep{
+		Type:        character.StepTypeComplete,
+		Title:       fmt.Sprintf("Ready to Reach Level %d", progress.TargetLevel),
+		Description: strings.Join(lines, "\n"),
+		UIHints: &character.StepUIHints{
+			Color: levelUpColor,
+		},
+	}
+}
+
+func optionName(choice *rulebook.FeatureChoice, key string) string {
+	if choice != nil {
+		for _, option := range choice.Options {
+			if option.Key == key {
+				return option.Name
+			}
+		}
+	}
+	return key
+}
+
+func spellLevelLabel(level int) string {
+	switch level {
+	case 0:
+		return "Cantrip"
+	case 1:
+		return "1st level"
+	case 2:
+		return "2nd level"
+	case 3:
+		return "3rd level"
+	default:
+		return fmt.Sprintf("%dth level", level)
+	}
+}
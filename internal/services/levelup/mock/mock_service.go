@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mock_service.go -package=mocklevelup -source=service.go
+//
+
+// Package mocklevelup is a generated GoMock package.
+package mocklevelup
+
+import (
+	context "context"
+	reflect "reflect"
+
+	character "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	levelup "github.com/KirkDiggler/dnd-bot-discord/internal/services/levelup"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelLevelUp mocks base method.
+func (m *MockService) CancelLevelUp(ctx context.Context, characterID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelLevelUp", ctx, characterID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelLevelUp indicates an expected call of CancelLevelUp.
+func (mr *MockServiceMockRecorder) CancelLevelUp(ctx, characterID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLevelUp", reflect.TypeOf((*MockService)(nil).CancelLevelUp), ctx, characterID, userID)
+}
+
+// CompleteLevelUp mocks base method.
+func (m *MockService) CompleteLevelUp(ctx context.Context, characterID, userID string) (*levelup.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteLevelUp", ctx, characterID, userID)
+	ret0, _ := ret[0].(*levelup.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteLevelUp indicates an expected call of CompleteLevelUp.
+func (mr *MockServiceMockRecorder) CompleteLevelUp(ctx, characterID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteLevelUp", reflect.TypeOf((*MockService)(nil).CompleteLevelUp), ctx, characterID, userID)
+}
+
+// GetNextStep mocks base method.
+func (m *MockService) GetNextStep(ctx context.Context, characterID string) (*character.CreationStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextStep", ctx, characterID)
+	ret0, _ := ret[0].(*character.CreationStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextStep indicates an expected call of GetNextStep.
+func (mr *MockServiceMockRecorder) GetNextStep(ctx, characterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextStep", reflect.TypeOf((*MockService)(nil).GetNextStep), ctx, characterID)
+}
+
+// GetProgressSteps mocks base method.
+func (m *MockService) GetProgressSteps(ctx context.Context, characterID string) ([]character.ProgressStepInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProgressSteps", ctx, characterID)
+	ret0, _ := ret[0].([]character.ProgressStepInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProgressSteps indicates an expected call of GetProgressSteps.
+func (mr *MockServiceMockRecorder) GetProgressSteps(ctx, characterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProgressSteps", reflect.TypeOf((*MockService)(nil).GetProgressSteps), ctx, characterID)
+}
+
+// ProcessStepResult mocks base method.
+func (m *MockService) ProcessStepResult(ctx context.Context, characterID, userID string, result *character.CreationStepResult) (*character.CreationStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessStepResult", ctx, characterID, userID, result)
+	ret0, _ := ret[0].(*character.CreationStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessStepResult indicates an expected call of ProcessStepResult.
+func (mr *MockServiceMockRecorder) ProcessStepResult(ctx, characterID, userID, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessStepResult", reflect.TypeOf((*MockService)(nil).ProcessStepResult), ctx, characterID, userID, result)
+}
+
+// StartLevelUp mocks base method.
+func (m *MockService) StartLevelUp(ctx context.Context, characterID, userID string) (*character.CreationStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLevelUp", ctx, characterID, userID)
+	ret0, _ := ret[0].(*character.CreationStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartLevelUp indicates an expected call of StartLevelUp.
+func (mr *MockServiceMockRecorder) StartLevelUp(ctx, characterID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLevelUp", reflect.TypeOf((*MockService)(nil).StartLevelUp), ctx, characterID, userID)
+}
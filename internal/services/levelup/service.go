@@ -0,0 +1,662 @@
+// Package levelup advances characters from one level to the next through a
+// step-by-step flow modeled on the character creation flow.
+package levelup
+
+//go:generate mockgen -destination=mock/mock_service.go -package=mocklevelup -source=service.go
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/calculators"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/feats"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/features"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	sessService "github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+)
+
+// Service manages leveling up characters
+type Service interface {
+	// StartLevelUp begins leveling a character, or resumes a level-up in progress,
+	// and returns the first step that still needs a choice
+	StartLevelUp(ctx context.Context, characterID, userID string) (*character.CreationStep, error)
+
+	// GetNextStep returns the next step that needs a choice. Once every step is
+	// done it returns a StepTypeComplete step summarizing the level-up.
+	GetNextStep(ctx context.Context, characterID string) (*character.CreationStep, error)
+
+	// GetProgressSteps returns all steps with their completion status
+	GetProgressSteps(ctx context.Context, characterID string) ([]character.ProgressStepInfo, error)
+
+	// ProcessStepResult records the choice for a step and returns the next step
+	ProcessStepResult(ctx context.Context, characterID, userID string, result *character.CreationStepResult) (*character.CreationStep, error)
+
+	// CompleteLevelUp applies every recorded choice to the character
+	CompleteLevelUp(ctx context.Context, characterID, userID string) (*Result, error)
+
+	// CancelLevelUp discards a level-up in progress without changing the character
+	CancelLevelUp(ctx context.Context, characterID, userID string) error
+}
+
+// Result describes what a completed level-up changed
+type Result struct {
+	Character        *character.Character
+	Class            *rulebook.Class // Class the level was taken in
+	ClassLevel       int             // Level reached in that class
+	PreviousLevel    int
+	NewLevel         int
+	HitPointsGained  int
+	ProficiencyBonus int
+	NewFeatures      []*rulebook.CharacterFeature
+}
+
+type service struct {
+	characterService charService.Service
+	sessionService   sessService.Service
+	diceRoller       dice.Roller
+	acCalculator     character.ACCalculator
+	featRegistry     *feats.Registry
+}
+
+// ServiceConfig holds configuration for the service
+type ServiceConfig struct {
+	CharacterService charService.Service    // Required
+	SessionService   sessService.Service    // Optional; without it multiclassing is off
+	DiceRoller       dice.Roller            // Optional, defaults to random
+	ACCalculator     character.ACCalculator // Optional, defaults to the D&D 5e calculator
+	FeatRegistry     *feats.Registry        // Optional, defaults to the global registry
+}
+
+// NewService creates a new level-up service
+func NewService(cfg *ServiceConfig) Service {
+	if cfg.CharacterService == nil {
+		panic("character service is required")
+	}
+
+	svc := &service{
+		characterService: cfg.CharacterService,
+		sessionService:   cfg.SessionService,
+		diceRoller:       cfg.DiceRoller,
+		acCalculator:     cfg.ACCalculator,
+		featRegistry:     cfg.FeatRegistry,
+	}
+
+	if svc.diceRoller == nil {
+		svc.diceRoller = dice.NewRandomRoller()
+	}
+	if svc.acCalculator == nil {
+		svc.acCalculator = calculators.NewDnD5eACCalculator()
+	}
+	if svc.featRegistry == nil {
+		svc.featRegistry = feats.GlobalRegistry
+	}
+
+	return svc
+}
+
+// StartLevelUp begins or resumes a level-up
+func (s *service) StartLevelUp(ctx context.Context, characterID, userID string) (*character.CreationStep, error) {
+	char, err := s.getOwnedCharacter(characterID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if char.LevelUp == nil {
+		if char.Status != shared.CharacterStatusActive {
+			return nil, dnderr.InvalidArgumentf("%s must be active to level up", char.Name).
+				WithMeta("character_id", characterID)
+		}
+		if !char.CanLevelUp() {
+			return nil, dnderr.InvalidArgumentf("%s cannot level up past level %d", char.Name, char.Level).
+				WithMeta("character_id", characterID)
+		}
+
+		char.LevelUp = &character.LevelUpProgress{TargetLevel: char.Level + 1}
+		if err := s.characterService.UpdateEquipment(charService.WithChange(ctx, userID, fmt.Sprintf("started leveling up to %d", char.LevelUp.TargetLevel)), char); err != nil {
+			return nil, dnderr.Wrap(err, "failed to save level-up progress")
+		}
+	}
+
+	return s.nextStep(ctx, char)
+}
+
+// GetNextStep returns the next step for a level-up in progress
+func (s *service) GetNextStep(ctx context.Context, characterID string) (*character.CreationStep, error) {
+	char, err := s.getLevelingCharacter(characterID)
+	if err != nil {
+		return nil, err
+	}
+	return s.nextStep(ctx, char)
+}
+
+// GetProgressSteps returns all steps with their completion status
+func (s *service) GetProgressSteps(ctx context.Context, characterID string) ([]character.ProgressStepInfo, error) {
+	char, err := s.getLevelingCharacter(characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := s.buildSteps(ctx, char)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]character.ProgressStepInfo, 0, len(steps))
+	foundCurrent := false
+	for _, step := range steps {
+		completed := isStepComplete(char.LevelUp, step)
+		current := !completed && !foundCurrent
+		if current {
+			foundCurrent = true
+		}
+		infos = append(infos, character.ProgressStepInfo{
+			Step:      step,
+			Completed: completed,
+			Current:   current,
+		})
+	}
+	return infos, nil
+}
+
+// ProcessStepResult validates and records a step choice
+func (s *service) ProcessStepResult(ctx context.Context, characterID, userID string, result *character.CreationStepResult) (*character.CreationStep, error) {
+	if result == nil {
+		return nil, dnderr.InvalidArgument("step result is required")
+	}
+
+	char, err := s.getOwnedCharacter(characterID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if char.LevelUp == nil {
+		return nil, dnderr.InvalidArgumentf("%s is not leveling up", char.Name).
+			WithMeta("character_id", characterID)
+	}
+
+	steps, err := s.buildSteps(ctx, char)
+	if err != nil {
+		return nil, err
+	}
+
+	var step *character.CreationStep
+	for i := range steps {
+		if steps[i].Type == result.StepType {
+			step = &steps[i]
+			break
+		}
+	}
+	if step == nil {
+		return nil, dnderr.InvalidArgumentf("step %s is not part of this level-up", result.StepType)
+	}
+
+	if err := validateSelections(step, result.Selections); err != nil {
+		return nil, err
+	}
+
+	if err := s.applyStepResult(ctx, char, step, result.Selections); err != nil {
+		return nil, err
+	}
+
+	if err := s.characterService.UpdateEquipment(charService.WithChange(ctx, userID, fmt.Sprintf("level-up choice: %s", step.Title)), char); err != nil {
+		return nil, dnderr.Wrap(err, "failed to save level-up progress")
+	}
+
+	return s.nextStep(ctx, char)
+}
+
+// CompleteLevelUp applies the level-up to the character
+func (s *service) CompleteLevelUp(ctx context.Context, characterID, userID string) (*Result, error) {
+	char, err := s.getOwnedCharacter(characterID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if char.LevelUp == nil {
+		return nil, dnderr.InvalidArgumentf("%s is not leveling up", char.Name).
+			WithMeta("character_id", characterID)
+	}
+
+	step, err := s.nextStep(ctx, char)
+	if err != nil {
+		return nil, err
+	}
+	if step.Type != character.StepTypeComplete {
+		return nil, dnderr.InvalidArgumentf("level-up is not finished: %s still needs a choice", step.Title)
+	}
+
+	result, err := s.applyLevelUp(ctx, char)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.characterService.UpdateEquipment(charService.WithChange(ctx, userID, fmt.Sprintf("leveled up to %d", result.NewLevel)), char); err != nil {
+		return nil, dnderr.Wrap(err, "failed to save character")
+	}
+
+	log.Printf("Character %s (%s) advanced from level %d to %d", char.Name, char.ID, result.PreviousLevel, result.NewLevel)
+	return result, nil
+}
+
+// CancelLevelUp discards a level-up in progress
+func (s *service) CancelLevelUp(ctx context.Context, characterID, userID string) error {
+	char, err := s.getOwnedCharacter(characterID, userID)
+	if err != nil {
+		return err
+	}
+	if char.LevelUp == nil {
+		return nil
+	}
+
+	char.LevelUp = nil
+	if err := s.characterService.UpdateEquipment(charService.WithChange(ctx, userID, "canceled level-up"), char); err != nil {
+		return dnderr.Wrap(err, "failed to cancel level-up")
+	}
+	return nil
+}
+
+func (s *service) getOwnedCharacter(characterID, userID string) (*character.Character, error) {
+	if characterID == "" {
+		return nil, dnderr.InvalidArgument("character ID is required")
+	}
+
+	char, err := s.characterService.GetByID(characterID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", characterID)
+	}
+	if char.OwnerID != userID {
+		return nil, dnderr.PermissionDenied("only the character's owner can level it up").
+			WithMeta("character_id", characterID)
+	}
+	return char, nil
+}
+
+func (s *service) getLevelingCharacter(characterID string) (*character.Character, error) {
+	char, err := s.characterService.GetByID(characterID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", characterID)
+	}
+	if char.LevelUp == nil {
+		return nil, dnderr.InvalidArgumentf("%s is not leveling up", char.Name).
+			WithMeta("character_id", characterID)
+	}
+	return char, nil
+}
+
+// validateSelections checks the selection count and that every selection is an offered option
+func validateSelections(step *character.CreationStep, selections []string) error {
+	minChoices := max(step.MinChoices, 1)
+	maxChoices := max(step.MaxChoices, minChoices)
+	if len(selections) < minChoices || len(selections) > maxChoices {
+		if minChoices == maxChoices {
+			return dnderr.InvalidArgumentf("%s needs exactly %d choice(s), got %d", step.Title, minChoices, len(selections))
+		}
+		return dnderr.InvalidArgumentf("%s needs %d to %d choices, got %d", step.Title, minChoices, maxChoices, len(selections))
+	}
+
+	seen := make(map[string]bool, len(selections))
+	for _, selection := range selections {
+		if seen[selection] {
+			return dnderr.InvalidArgumentf("'%s' was selected more than once", selection)
+		}
+		seen[selection] = true
+
+		found := false
+		for _, option := range step.Options {
+			if option.Key == selection {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return dnderr.InvalidArgumentf("'%s' is not an option for %s", selection, step.Title)
+		}
+	}
+	return nil
+}
+
+// applyStepResult records a validated choice on the level-up progress
+func (s *service) applyStepResult(ctx context.Context, char *character.Character, step *character.CreationStep, selections []string) error {
+	progress := char.LevelUp
+
+	switch step.Type {
+	case character.StepTypeClassSelection:
+		// Every other choice depends on the class, so changing it starts over
+		current := progress.ClassKey
+		if current == "" {
+			current = char.Class.Key
+		}
+		if selections[0] != current {
+			if progress.HitPoints > 0 {
+				return dnderr.InvalidArgument("the class can't be changed after hit points are chosen")
+			}
+			*progress = character.LevelUpProgress{TargetLevel: progress.TargetLevel}
+		}
+		progress.ClassKey = selections[0]
+
+	case character.StepTypeLevelUpHitPoints:
+		// Hit points are chosen once, so a roll can't be retried
+		if progress.HitPoints > 0 {
+			return dnderr.InvalidArgumentf("hit points for level %d have already been chosen", progress.TargetLevel)
+		}
+		class, err := s.levelingClass(ctx, char)
+		if err != nil {
+			return err
+		}
+		hitDie, err := char.HitDieFor(class)
+		if err != nil {
+			return dnderr.Wrap(err, "failed to get hit die")
+		}
+		base := averageHitDie(hitDie)
+		if selections[0] == character.HitPointMethodRoll {
+			roll, err := s.diceRoller.Roll(1, hitDie, 0)
+			if err != nil {
+				return dnderr.Wrap(err, "failed to roll hit points")
+			}
+			base = roll.Total
+		}
+		progress.HitPointMethod = selections[0]
+		progress.HitPointRoll = base
+		progress.HitPoints = hitPointGain(char, base)
+
+	case character.StepTypeSkillSelection:
+		progress.Skills = append([]string(nil), selections...)
+
+	case character.StepTypeProficiencySelection:
+		progress.Instruments = append([]string(nil), selections...)
+
+	case character.StepTypeLevelUpFeatures:
+		progress.FeaturesConfirmed = true
+
+	case character.StepTypeFightingStyleSelection:
+		progress.FightingStyle = selections[0]
+
+	case character.StepTypeSubclassSelection:
+		progress.Subclass = selections[0]
+
+	case character.StepTypeAbilityScoreImprovement:
+		return s.applyImprovement(char, selections)
+
+	case character.StepTypeCantripsSelection:
+		progress.Cantrips = append([]string(nil), selections...)
+
+	case character.StepTypeSpellsKnownSelection:
+		progress.Spells = append([]string(nil), selections...)
+	}
+
+	return nil
+}
+
+// applyImprovement records either a feat or ability score increases.
+// One ability gets +2; two abilities get +1 each. An increase that would
+// take a score above 20 is rejected so no point is lost.
+func (s *service) applyImprovement(char *character.Character, selections []string) error {
+	progress := char.LevelUp
+
+	for _, selection := range selections {
+		featKey, isFeat := strings.CutPrefix(selection, featOptionPrefix)
+		if !isFeat {
+			continue
+		}
+		if len(selections) > 1 {
+			return dnderr.InvalidArgument("choose a feat or ability score increases, not both")
+		}
+		progress.Feat = featKey
+		progress.AbilityIncreases = nil
+		return nil
+	}
+
+	increase := 2
+	if len(selections) == 2 {
+		increase = 1
+	}
+
+	increases := make(map[shared.Attribute]int, len(selections))
+	for _, selection := range selections {
+		attr := shared.Attribute(selection)
+		name, ok := attributeNames[attr]
+		if !ok {
+			return dnderr.InvalidArgumentf("unknown ability '%s'", selection)
+		}
+		if _, ok := increases[attr]; ok {
+			return dnderr.InvalidArgumentf("pick %s once to increase it by 2", name)
+		}
+
+		score := 0
+		if ability := char.Attributes[attr]; ability != nil {
+			score = ability.Score
+		}
+		if score+increase > maxAbilityScore {
+			if score >= maxAbilityScore {
+				return dnderr.InvalidArgumentf("%s is already %d; pick another ability", name, maxAbilityScore).
+					WithMeta("ability", selection)
+			}
+			return dnderr.InvalidArgumentf("%s can only go up by %d; put the other point in another ability", name, maxAbilityScore-score).
+				WithMeta("ability", selection)
+		}
+		increases[attr] = increase
+	}
+
+	progress.AbilityIncreases = increases
+	progress.Feat = ""
+	return nil
+}
+
+// applyLevelUp applies a finished level-up to the character
+func (s *service) applyLevelUp(ctx context.Context, char *character.Character) (*Result, error) {
+	progress := char.LevelUp
+	class, err := s.levelingClass(ctx, char)
+	if err != nil {
+		return nil, err
+	}
+	newClass := char.LevelInClass(class.Key) == 0
+
+	result := &Result{
+		Character:       char,
+		Class:           class,
+		PreviousLevel:   char.Level,
+		NewLevel:        progress.TargetLevel,
+		HitPointsGained: progress.HitPoints,
+	}
+
+	conBefore := constitutionBonus(char)
+
+	char.AddClassLevel(class)
+	result.ClassLevel = char.LevelInClass(class.Key)
+	char.MaxHitPoints += progress.HitPoints
+	char.CurrentHitPoints += progress.HitPoints
+
+	// Ability score increases. A higher CON modifier raises HP for every level.
+	for attr, increase := range progress.AbilityIncreases {
+		if char.Attributes == nil {
+			char.Attributes = make(map[shared.Attribute]*character.AbilityScore)
+		}
+		if char.Attributes[attr] == nil {
+			char.Attributes[attr] = &character.AbilityScore{}
+		}
+		char.Attributes[attr].AddBonus(increase)
+	}
+	if delta := constitutionBonus(char) - conBefore; delta != 0 {
+		char.MaxHitPoints += delta * char.Level
+		char.CurrentHitPoints += delta * char.Level
+		result.HitPointsGained += delta * char.Level
+	}
+
+	if newClass {
+		applyMulticlassProficiencies(char, class.Key, progress)
+	}
+
+	// Class features for the new class level
+	for _, feat := range features.GetClassFeatures(class.Key, result.ClassLevel) {
+		if char.HasFeature(feat.Key) {
+			continue
+		}
+		featCopy := feat
+		char.Features = append(char.Features, &featCopy)
+		result.NewFeatures = append(result.NewFeatures, &featCopy)
+	}
+
+	if progress.FightingStyle != "" {
+		setFeatureMetadata(char, "fighting_style", "style", progress.FightingStyle)
+	}
+
+	if progress.Subclass != "" {
+		if feature := s.applySubclass(char, class, result.ClassLevel, progress.Subclass); feature != nil {
+			result.NewFeatures = append(result.NewFeatures, feature)
+		}
+	}
+
+	// Subclass features for the new level, and any earlier ones a newly
+	// chosen subclass grants
+	for _, feat := range features.GetSubclassFeatures(class.Key, char.SubclassKeyFor(class.Key), result.ClassLevel) {
+		if char.HasFeature(feat.Key) {
+			continue
+		}
+		featCopy := feat
+		char.Features = append(char.Features, &featCopy)
+		result.NewFeatures = append(result.NewFeatures, &featCopy)
+	}
+
+	// Draconic Resilience and the like add HP each class level, and for
+	// every earlier level when the subclass is first chosen
+	if perLevel := rulebook.SubclassHitPointsPerLevel(char.SubclassKeyFor(class.Key)); perLevel > 0 {
+		levels := 1
+		if progress.Subclass != "" {
+			levels = result.ClassLevel
+		}
+		char.MaxHitPoints += perLevel * levels
+		char.CurrentHitPoints += perLevel * levels
+		result.HitPointsGained += perLevel * levels
+	}
+	if progress.Subclass != "" {
+		for _, proficiency := range rulebook.GetSubclassProficiencies(progress.Subclass) {
+			char.AddProficiency(proficiency)
+		}
+	}
+
+	if progress.Feat != "" {
+		hpBefore := char.MaxHitPoints
+		if err := s.featRegistry.ApplyFeat(progress.Feat, char, nil); err != nil {
+			return nil, dnderr.Wrapf(err, "failed to apply feat '%s'", progress.Feat)
+		}
+		result.HitPointsGained += char.MaxHitPoints - hpBefore
+		if len(char.Features) > 0 {
+			result.NewFeatures = append(result.NewFeatures, char.Features[len(char.Features)-1])
+		}
+	}
+
+	for _, cantrip := range progress.Cantrips {
+		char.AddCantrip(cantrip)
+	}
+	for _, spell := range progress.Spells {
+		char.AddKnownSpell(spell)
+	}
+
+	// Milestone leveling: make sure XP reflects the new level
+	char.Experience = max(char.Experience, rulebook.ExperienceForLevel(char.Level))
+	char.NextLevel = rulebook.ExperienceForLevel(char.Level + 1)
+
+	char.LevelUp = nil
+
+	// Hit dice, spell slots and class ability uses all scale with level.
+	// Whatever was spent before the level-up stays spent.
+	char.RefreshResources()
+	char.AC = s.acCalculator.Calculate(char)
+
+	result.ProficiencyBonus = char.GetProficiencyBonus()
+	return result, nil
+}
+
+// applySubclass records the subclass choice. Clerics keep theirs on the
+// Divine Domain feature; everyone else gets a subclass feature naming the
+// class it belongs to.
+func (s *service) applySubclass(char *character.Character, class *rulebook.Class, classLevel int, subclassKey string) *rulebook.CharacterFeature {
+	subclass, ok := rulebook.GetSubclass(class.Key, subclassKey)
+	if !ok {
+		return nil
+	}
+
+	if class.Key == "cleric" && setFeatureMetadata(char, "divine_domain", "domain", subclassKey) {
+		return nil
+	}
+
+	feature := &rulebook.CharacterFeature{
+		Key:         "subclass",
+		Name:        subclass.Name,
+		Description: subclass.Description,
+		Type:        rulebook.FeatureTypeClass,
+		Level:       classLevel,
+		Source:      class.Name,
+		Metadata: map[string]any{
+			"subclass": subclassKey,
+			"class":    class.Key,
+		},
+	}
+	char.Features = append(char.Features, feature)
+	return feature
+}
+
+// applyMulticlassProficiencies grants the proficiencies of a class taken as a
+// second class, along with the skills and instruments chosen for it
+func applyMulticlassProficiencies(char *character.Character, classKey string, progress *character.LevelUpProgress) {
+	for _, proficiency := range rulebook.GetMulticlassProficiencies(classKey).Proficiencies {
+		granted := *proficiency
+		char.AddProficiency(&granted)
+	}
+	for _, skill := range progress.Skills {
+		char.AddProficiency(rulebook.SkillProficiency(skill))
+	}
+	for _, key := range progress.Instruments {
+		for _, instrument := range rulebook.MusicalInstruments {
+			if instrument.Key == key {
+				granted := *instrument
+				char.AddProficiency(&granted)
+			}
+		}
+	}
+}
+
+// setFeatureMetadata sets a metadata value on the feature with the given key
+func setFeatureMetadata(char *character.Character, featureKey, metaKey, value string) bool {
+	for _, feature := range char.Features {
+		if feature == nil || feature.Key != featureKey {
+			continue
+		}
+		if feature.Metadata == nil {
+			feature.Metadata = make(map[string]any)
+		}
+		feature.Metadata[metaKey] = value
+		return true
+	}
+	return false
+}
+
+// averageHitDie returns the fixed hit point value for a hit die (half plus one)
+func averageHitDie(hitDie int) int {
+	return hitDie/2 + 1
+}
+
+// hitPointGain returns the max HP gained for a hit die result, including
+// the CON modifier and the Tough feat. A level always grants at least 1 HP.
+func hitPointGain(char *character.Character, base int) int {
+	gain := max(base+constitutionBonus(char), 1)
+	for _, feature := range char.Features {
+		if feature != nil && feature.Key == "tough" && feature.Type == rulebook.FeatureTypeFeat {
+			gain += 2
+			break
+		}
+	}
+	return gain
+}
+
+func constitutionBonus(char *character.Character) int {
+	if con := char.Attributes[shared.AttributeConstitution]; con != nil {
+		return con.Bonus
+	}
+	return 0
+}
@@ -0,0 +1,496 @@
+package levelup
+
+import (
+	"context"
+	"testing"
+
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	mockchar "github.com/KirkDiggler/dnd-bot-discord/internal/services/character/mock"
+	mocksession "github.com/KirkDiggler/dnd-bot-discord/internal/services/session/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testOwner = "user_123"
+
+// classHitDice are the PHB hit dice the mock rulebook serves
+var classHitDice = map[string]int{
+	"barbarian": 12,
+	"fighter":   10, "paladin": 10, "ranger": 10,
+	"bard": 8, "cleric": 8, "druid": 8, "monk": 8, "rogue": 8, "warlock": 8,
+	"sorcerer": 6, "wizard": 6,
+}
+
+// setupService returns a service backed by a mock character service that
+// keeps the last saved character, playing in a session that allows
+// multiclassing
+func setupService(t *testing.T, char *character.Character) (Service, *mockdice.ManualMockRoller) {
+	return setupServiceWithRules(t, char, &gameSession.HouseRules{Multiclassing: true})
+}
+
+func setupServiceWithRules(t *testing.T, char *character.Character, rules *gameSession.HouseRules) (Service, *mockdice.ManualMockRoller) {
+	ctrl := gomock.NewController(t)
+	charSvc := mockchar.NewMockService(ctrl)
+	sessSvc := mocksession.NewMockService(ctrl)
+	roller := mockdice.NewManualMockRoller()
+
+	sessSvc.EXPECT().ListActiveUserSessions(gomock.Any(), testOwner).Return([]*gameSession.Session{{
+		ID:       "session_123",
+		Settings: &gameSession.SessionSettings{HouseRules: rules},
+	}}, nil).AnyTimes()
+
+	charSvc.EXPECT().GetByID(char.ID).Return(char, nil).AnyTimes()
+	charSvc.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	charSvc.EXPECT().GetClass(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key string) (*rulebook.Class, error) {
+			return testutils.CreateTestClass(key, key, classHitDice[key]), nil
+		}).AnyTimes()
+	charSvc.EXPECT().GetClasses(gomock.Any()).
+		DoAndReturn(func(_ context.Context) ([]*rulebook.Class, error) {
+			var classes []*rulebook.Class
+			for _, key := range rulebook.MulticlassClasses() {
+				classes = append(classes, testutils.CreateTestClass(key, key, classHitDice[key]))
+			}
+			return classes, nil
+		}).AnyTimes()
+	charSvc.EXPECT().ListSpellsByClassAndLevel(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, level int) ([]*rulebook.SpellReference, error) {
+			if level == 0 {
+				return []*rulebook.SpellReference{{Key: "light", Name: "Light"}}, nil
+			}
+			return []*rulebook.SpellReference{
+				{Key: "magic-missile", Name: "Magic Missile"},
+				{Key: "shield", Name: "Shield"},
+				{Key: "misty-step", Name: "Misty Step"},
+			}, nil
+		}).AnyTimes()
+
+	return NewService(&ServiceConfig{
+		CharacterService: charSvc,
+		SessionService:   sessSvc,
+		DiceRoller:       roller,
+	}), roller
+}
+
+func createTestCharacter(classKey string, hitDie, level int, con int) *character.Character {
+	char := &character.Character{
+		ID:      "char_123",
+		OwnerID: testOwner,
+		Name:    "Test Character",
+		Level:   level,
+		Status:  shared.CharacterStatusActive,
+		Class:   testutils.CreateTestClass(classKey, classKey, hitDie),
+		HitDie:  hitDie,
+	}
+	char.AddAttribute(shared.AttributeStrength, 16)
+	char.AddAttribute(shared.AttributeDexterity, 12)
+	char.AddAttribute(shared.AttributeConstitution, con)
+	char.AddAttribute(shared.AttributeIntelligence, 14)
+	char.AddAttribute(shared.AttributeWisdom, 10)
+	char.AddAttribute(shared.AttributeCharisma, 8)
+	char.MaxHitPoints = 30
+	char.CurrentHitPoints = 30
+	return char
+}
+
+// startLevelUp starts a level-up and stays in the character's class when
+// they could multiclass
+func startLevelUp(t *testing.T, svc Service, char *character.Character) *character.CreationStep {
+	step, err := svc.StartLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+	if step.Type == character.StepTypeClassSelection {
+		step = selectStep(t, svc, character.StepTypeClassSelection, char.Class.Key)
+	}
+	return step
+}
+
+func selectStep(t *testing.T, svc Service, stepType character.CreationStepType, selections ...string) *character.CreationStep {
+	step, err := svc.ProcessStepResult(context.Background(), "char_123", testOwner, &character.CreationStepResult{
+		StepType:   stepType,
+		Selections: selections,
+	})
+	require.NoError(t, err)
+	return step
+}
+
+func TestLevelUp_FighterAbilityScoreImprovement(t *testing.T) {
+	char := createTestCharacter("fighter", 10, 3, 15)
+	char.Features = append(char.Features, &rulebook.CharacterFeature{
+		Key:      "subclass",
+		Name:     "Champion",
+		Metadata: map[string]any{"subclass": "champion"},
+	})
+	svc, _ := setupService(t, char)
+
+	step, err := svc.StartLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+	assert.Equal(t, character.StepTypeClassSelection, step.Type)
+
+	step = selectStep(t, svc, character.StepTypeClassSelection, "fighter")
+	assert.Equal(t, character.StepTypeLevelUpHitPoints, step.Type)
+	require.NotNil(t, char.LevelUp)
+	assert.Equal(t, 4, char.LevelUp.TargetLevel)
+
+	// Average d10 is 6, plus CON +2
+	step = selectStep(t, svc, character.StepTypeLevelUpHitPoints, character.HitPointMethodAverage)
+	assert.Equal(t, 8, char.LevelUp.HitPoints)
+	assert.Equal(t, character.StepTypeAbilityScoreImprovement, step.Type)
+
+	// Nothing is applied until the level-up is confirmed
+	assert.Equal(t, 3, char.Level)
+	assert.Equal(t, 30, char.MaxHitPoints)
+
+	step = selectStep(t, svc, character.StepTypeAbilityScoreImprovement,
+		string(shared.AttributeStrength), string(shared.AttributeConstitution))
+	assert.Equal(t, character.StepTypeComplete, step.Type)
+
+	result, err := svc.CompleteLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, result.PreviousLevel)
+	assert.Equal(t, 4, result.NewLevel)
+	assert.Equal(t, 4, char.Level)
+	assert.Nil(t, char.LevelUp)
+	assert.Equal(t, 17, char.Attributes[shared.AttributeStrength].Score)
+	assert.Equal(t, 16, char.Attributes[shared.AttributeConstitution].Score)
+
+	// CON 15 -> 16 raises the modifier, adding 1 HP for each of the 4 levels
+	assert.Equal(t, 12, result.HitPointsGained)
+	assert.Equal(t, 42, char.MaxHitPoints)
+	assert.Equal(t, rulebook.ExperienceForLevel(4), char.Experience)
+	require.NotNil(t, char.Resources)
+	assert.Equal(t, 4, char.Resources.HitDice.Max())
+}
+
+func TestLevelUp_ImprovementCappedAt20(t *testing.T) {
+	char := createTestCharacter("fighter", 10, 3, 14)
+	char.Attributes[shared.AttributeStrength] = &character.AbilityScore{Score: 20, Bonus: 5}
+	char.Attributes[shared.AttributeDexterity] = &character.AbilityScore{Score: 19, Bonus: 4}
+	char.Features = append(char.Features, &rulebook.CharacterFeature{
+		Key:      "subclass",
+		Metadata: map[string]any{"subclass": "champion"},
+	})
+	svc, _ := setupService(t, char)
+
+	startLevelUp(t, svc, char)
+	step := selectStep(t, svc, character.StepTypeLevelUpHitPoints, character.HitPointMethodAverage)
+	require.Equal(t, character.StepTypeAbilityScoreImprovement, step.Type)
+
+	for _, selections := range [][]string{
+		{string(shared.AttributeStrength)},                                 // Already 20
+		{string(shared.AttributeStrength), string(shared.AttributeWisdom)}, // Still no room
+		{string(shared.AttributeDexterity)},                                // +2 would pass 20
+		{string(shared.AttributeWisdom), string(shared.AttributeWisdom)},   // Same ability twice
+	} {
+		_, err := svc.ProcessStepResult(context.Background(), char.ID, testOwner, &character.CreationStepResult{
+			StepType:   character.StepTypeAbilityScoreImprovement,
+			Selections: selections,
+		})
+		require.Error(t, err, "%v", selections)
+		assert.True(t, dnderr.IsInvalidArgument(err))
+	}
+
+	selectStep(t, svc, character.StepTypeAbilityScoreImprovement,
+		string(shared.AttributeDexterity), string(shared.AttributeWisdom))
+	_, err := svc.CompleteLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+	assert.Equal(t, 20, char.Attributes[shared.AttributeDexterity].Score)
+	assert.Equal(t, 11, char.Attributes[shared.AttributeWisdom].Score)
+}
+
+func TestLevelUp_RolledHitPointsAndFeatures(t *testing.T) {
+	char := createTestCharacter("fighter", 10, 4, 10)
+	char.Features = append(char.Features, &rulebook.CharacterFeature{
+		Key:      "subclass",
+		Metadata: map[string]any{"subclass": "champion"},
+	})
+	svc, roller := setupService(t, char)
+	roller.SetNextRoll(1)
+
+	startLevelUp(t, svc, char)
+
+	step := selectStep(t, svc, character.StepTypeLevelUpHitPoints, character.HitPointMethodRoll)
+	assert.Equal(t, 1, char.LevelUp.HitPointRoll)
+	assert.Equal(t, 1, char.LevelUp.HitPoints)
+	assert.Equal(t, character.StepTypeLevelUpFeatures, step.Type)
+
+	step = selectStep(t, svc, character.StepTypeLevelUpFeatures, step.Options[0].Key)
+	assert.Equal(t, character.StepTypeComplete, step.Type)
+
+	result, err := svc.CompleteLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.ProficiencyBonus)
+	assert.True(t, char.HasFeature("extra_attack"))
+}
+
+func TestLevelUp_SubclassSelection(t *testing.T) {
+	char := createTestCharacter("fighter", 10, 2, 14)
+	svc, _ := setupService(t, char)
+
+	startLevelUp(t, svc, char)
+
+	step := selectStep(t, svc, character.StepTypeLevelUpHitPoints, character.HitPointMethodAverage)
+	assert.Equal(t, character.StepTypeSubclassSelection, step.Type)
+
+	_, err := svc.ProcessStepResult(context.Background(), char.ID, testOwner, &character.CreationStepResult{
+		StepType:   character.StepTypeSubclassSelection,
+		Selections: []string{"not-a-subclass"},
+	})
+	require.Error(t, err)
+	assert.True(t, dnderr.IsInvalidArgument(err))
+
+	step = selectStep(t, svc, character.StepTypeSubclassSelection, "battle-master")
+	assert.Equal(t, character.StepTypeComplete, step.Type)
+
+	_, err = svc.CompleteLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+	assert.Equal(t, "battle-master", char.GetSubclassKey())
+}
+
+func TestLevelUp_SubclassFeatures(t *testing.T) {
+	char := createTestCharacter("fighter", 10, 2, 14)
+	svc, _ := setupService(t, char)
+
+	startLevelUp(t, svc, char)
+
+	selectStep(t, svc, character.StepTypeLevelUpHitPoints, character.HitPointMethodAverage)
+	step := selectStep(t, svc, character.StepTypeSubclassSelection, "champion")
+	assert.Equal(t, character.StepTypeComplete, step.Type)
+
+	result, err := svc.CompleteLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+	assert.True(t, char.HasFeature("improved_critical"))
+	assert.False(t, char.HasFeature("remarkable_athlete"))
+	assert.Equal(t, 19, char.CriticalRange())
+
+	var names []string
+	for _, feature := range result.NewFeatures {
+		names = append(names, feature.Name)
+	}
+	assert.Contains(t, names, "Improved Critical")
+}
+
+func TestLevelUp_SubclassAbilities(t *testing.T) {
+	char := createTestCharacter("fighter", 10, 2, 14)
+	svc, _ := setupService(t, char)
+
+	startLevelUp(t, svc, char)
+	selectStep(t, svc, character.StepTypeLevelUpHitPoints, character.HitPointMethodAverage)
+	selectStep(t, svc, character.StepTypeSubclassSelection, "battle-master")
+
+	_, err := svc.CompleteLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+	dice := char.Resources.Abilities[shared.AbilityKeySuperiorityDice]
+	require.NotNil(t, dice, "battle masters get superiority dice")
+	assert.Equal(t, 4, dice.UsesMax)
+	assert.Equal(t, shared.RestTypeShort, dice.RestType)
+}
+
+func TestLevelUp_WizardLearnsSpells(t *testing.T) {
+	char := createTestCharacter("wizard", 6, 2, 12)
+	char.Features = append(char.Features, &rulebook.CharacterFeature{
+		Key:      "subclass",
+		Metadata: map[string]any{"subclass": "evocation"},
+	})
+	svc, _ := setupService(t, char)
+
+	startLevelUp(t, svc, char)
+
+	step := selectStep(t, svc, character.StepTypeLevelUpHitPoints, character.HitPointMethodAverage)
+	for step.Type != character.StepTypeSpellsKnownSelection {
+		require.NotEqual(t, character.StepTypeComplete, step.Type)
+		step = selectStep(t, svc, step.Type, step.Options[0].Key)
+	}
+	assert.Equal(t, 2, step.MinChoices)
+
+	step = selectStep(t, svc, character.StepTypeSpellsKnownSelection, "magic-missile", "misty-step")
+	assert.Equal(t, character.StepTypeComplete, step.Type)
+
+	_, err := svc.CompleteLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+	require.NotNil(t, char.Spells)
+	assert.Contains(t, char.Spells.KnownSpells, "misty-step")
+}
+
+func TestLevelUp_Multiclass(t *testing.T) {
+	char := createTestCharacter("fighter", 10, 3, 14)
+	char.Features = append(char.Features, &rulebook.CharacterFeature{
+		Key:      "subclass",
+		Metadata: map[string]any{"subclass": "champion"},
+	})
+	svc, _ := setupService(t, char)
+
+	step, err := svc.StartLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+	require.Equal(t, character.StepTypeClassSelection, step.Type)
+
+	var offered []string
+	for _, option := range step.Options {
+		offered = append(offered, option.Key)
+	}
+	assert.Contains(t, offered, "wizard", "INT 14 qualifies")
+	assert.NotContains(t, offered, "bard", "CHA 8 doesn't")
+
+	_, err = svc.ProcessStepResult(context.Background(), char.ID, testOwner, &character.CreationStepResult{
+		StepType:   character.StepTypeClassSelection,
+		Selections: []string{"bard"},
+	})
+	require.Error(t, err)
+
+	// Hit points use the wizard's d6: 4 + CON 2
+	step = selectStep(t, svc, character.StepTypeClassSelection, "wizard")
+	require.Equal(t, character.StepTypeLevelUpHitPoints, step.Type)
+	step = selectStep(t, svc, character.StepTypeLevelUpHitPoints, character.HitPointMethodAverage)
+	assert.Equal(t, 6, char.LevelUp.HitPoints)
+
+	for step.Type != character.StepTypeComplete {
+		var selections []string
+		for _, option := range step.Options[:max(step.MinChoices, 1)] {
+			selections = append(selections, option.Key)
+		}
+		step = selectStep(t, svc, step.Type, selections...)
+	}
+
+	result, err := svc.CompleteLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+	assert.Equal(t, "wizard", result.Class.Key)
+	assert.Equal(t, 1, result.ClassLevel)
+	assert.Equal(t, 4, char.Level)
+	assert.Equal(t, 3, char.LevelInClass("fighter"))
+	assert.Equal(t, 1, char.LevelInClass("wizard"))
+	assert.Equal(t, 2, char.GetProficiencyBonus())
+	assert.Equal(t, "champion", char.GetSubclassKey())
+	require.NotNil(t, char.Spells)
+	assert.Contains(t, char.Spells.Cantrips, "light")
+
+	require.NotNil(t, char.Resources)
+	assert.Equal(t, shared.HitDicePools{
+		{DiceType: 10, Max: 3, Remaining: 3},
+		{DiceType: 6, Max: 1, Remaining: 1},
+	}, char.Resources.HitDice)
+	assert.Equal(t, 2, char.Resources.SpellSlots[1].Max)
+}
+
+func TestLevelUp_KeepsSpentResources(t *testing.T) {
+	char := createTestCharacter("wizard", 6, 2, 12)
+	char.Features = append(char.Features, &rulebook.CharacterFeature{
+		Key:      "subclass",
+		Metadata: map[string]any{"subclass": "evocation"},
+	})
+	char.InitializeResources()
+	require.True(t, char.Resources.UseSpellSlot(1))
+	require.True(t, char.Resources.HitDice.Spend(6))
+	svc, _ := setupService(t, char)
+
+	step := startLevelUp(t, svc, char)
+	for step.Type != character.StepTypeComplete {
+		var selections []string
+		for _, option := range step.Options[:max(step.MinChoices, 1)] {
+			selections = append(selections, option.Key)
+		}
+		step = selectStep(t, svc, step.Type, selections...)
+	}
+	_, err := svc.CompleteLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, char.Resources.SpellSlots[1].Max)
+	assert.Equal(t, 3, char.Resources.SpellSlots[1].Remaining, "the spent slot stays spent")
+	assert.Equal(t, 2, char.Resources.SpellSlots[2].Remaining, "new slots start full")
+	assert.Equal(t, 3, char.Resources.HitDice.Max())
+	assert.Equal(t, 2, char.Resources.HitDice.Remaining())
+}
+
+func TestLevelUp_MulticlassingIsAHouseRule(t *testing.T) {
+	char := createTestCharacter("fighter", 10, 3, 14)
+	char.Features = append(char.Features, &rulebook.CharacterFeature{
+		Key:      "subclass",
+		Metadata: map[string]any{"subclass": "champion"},
+	})
+	svc, _ := setupServiceWithRules(t, char, &gameSession.HouseRules{})
+
+	step, err := svc.StartLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+	assert.Equal(t, character.StepTypeLevelUpHitPoints, step.Type, "no class step without the rule")
+
+	_, err = svc.ProcessStepResult(context.Background(), char.ID, testOwner, &character.CreationStepResult{
+		StepType:   character.StepTypeClassSelection,
+		Selections: []string{"wizard"},
+	})
+	require.Error(t, err)
+}
+
+func TestLevelUp_HitPointsChosenOnce(t *testing.T) {
+	char := createTestCharacter("fighter", 10, 4, 10)
+	svc, roller := setupService(t, char)
+	roller.SetNextRoll(2)
+
+	startLevelUp(t, svc, char)
+	selectStep(t, svc, character.StepTypeLevelUpHitPoints, character.HitPointMethodRoll)
+	assert.Equal(t, 2, char.LevelUp.HitPoints)
+
+	roller.SetNextRoll(10)
+	_, err := svc.ProcessStepResult(context.Background(), char.ID, testOwner, &character.CreationStepResult{
+		StepType:   character.StepTypeLevelUpHitPoints,
+		Selections: []string{character.HitPointMethodRoll},
+	})
+	require.Error(t, err)
+	assert.True(t, dnderr.IsInvalidArgument(err))
+	assert.Equal(t, 2, char.LevelUp.HitPoints, "the first roll stands")
+
+	_, err = svc.ProcessStepResult(context.Background(), char.ID, testOwner, &character.CreationStepResult{
+		StepType:   character.StepTypeClassSelection,
+		Selections: []string{"wizard"},
+	})
+	require.Error(t, err, "switching class would discard the roll")
+}
+
+func TestLevelUp_OnlyOwnerCanLevelUp(t *testing.T) {
+	char := createTestCharacter("fighter", 10, 1, 12)
+	svc, _ := setupService(t, char)
+
+	_, err := svc.StartLevelUp(context.Background(), char.ID, "someone_else")
+	require.Error(t, err)
+	assert.True(t, dnderr.Is(err, dnderr.CodePermissionDenied))
+	assert.Nil(t, char.LevelUp)
+}
+
+func TestLevelUp_CompleteRequiresAllSteps(t *testing.T) {
+	char := createTestCharacter("fighter", 10, 1, 12)
+	svc, _ := setupService(t, char)
+
+	_, err := svc.StartLevelUp(context.Background(), char.ID, testOwner)
+	require.NoError(t, err)
+
+	_, err = svc.CompleteLevelUp(context.Background(), char.ID, testOwner)
+	require.Error(t, err)
+	assert.Equal(t, 1, char.Level)
+}
+
+func TestLevelUp_Cancel(t *testing.T) {
+	char := createTestCharacter("fighter", 10, 1, 12)
+	svc, _ := setupService(t, char)
+
+	startLevelUp(t, svc, char)
+	selectStep(t, svc, character.StepTypeLevelUpHitPoints, character.HitPointMethodAverage)
+
+	require.NoError(t, svc.CancelLevelUp(context.Background(), char.ID, testOwner))
+	assert.Nil(t, char.LevelUp)
+	assert.Equal(t, 1, char.Level)
+	assert.Equal(t, 30, char.MaxHitPoints)
+}
+
+func TestLevelUp_MaxLevel(t *testing.T) {
+	char := createTestCharacter("fighter", 10, rulebook.MaxLevel, 12)
+	svc, _ := setupService(t, char)
+
+	_, err := svc.StartLevelUp(context.Background(), char.ID, testOwner)
+	require.Error(t, err)
+	assert.True(t, dnderr.IsInvalidArgument(err))
+}
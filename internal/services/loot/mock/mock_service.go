@@ -41,6 +41,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// GenerateHoard mocks base method.
+func (m *MockService) GenerateHoard(ctx context.Context, difficulty string, roomNumber int) (*loot.Hoard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHoard", ctx, difficulty, roomNumber)
+	ret0, _ := ret[0].(*loot.Hoard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHoard indicates an expected call of GenerateHoard.
+func (mr *MockServiceMockRecorder) GenerateHoard(ctx, difficulty, roomNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHoard", reflect.TypeOf((*MockService)(nil).GenerateHoard), ctx, difficulty, roomNumber)
+}
+
 // GenerateLootTable mocks base method.
 func (m *MockService) GenerateLootTable(ctx context.Context, challengeRating float64) (*loot.LootTable, error) {
 	m.ctrl.T.Helper()
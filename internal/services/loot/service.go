@@ -20,10 +20,20 @@ type Service interface {
 	// GenerateTreasure generates treasure based on difficulty and room number
 	GenerateTreasure(ctx context.Context, difficulty string, roomNumber int) ([]string, error)
 
+	// GenerateHoard generates the same treasure with the gold kept as a
+	// number, so it can be credited to the party
+	GenerateHoard(ctx context.Context, difficulty string, roomNumber int) (*Hoard, error)
+
 	// GenerateLootTable creates a loot table for a given CR
 	GenerateLootTable(ctx context.Context, challengeRating float64) (*LootTable, error)
 }
 
+// Hoard is the treasure found in a room
+type Hoard struct {
+	Gold  int      // Gold pieces
+	Items []string // Everything other than coins
+}
+
 // LootTable represents a collection of possible loot
 type LootTable struct {
 	Gold      GoldRange
@@ -68,6 +78,17 @@ func NewService(cfg *ServiceConfig) Service {
 
 // GenerateTreasure generates treasure based on difficulty and room number
 func (s *service) GenerateTreasure(ctx context.Context, difficulty string, roomNumber int) ([]string, error) {
+	hoard, err := s.GenerateHoard(ctx, difficulty, roomNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	treasure := []string{fmt.Sprintf("%d gold pieces", hoard.Gold)}
+	return append(treasure, hoard.Items...), nil
+}
+
+// GenerateHoard generates treasure based on difficulty and room number
+func (s *service) GenerateHoard(ctx context.Context, difficulty string, roomNumber int) (*Hoard, error) {
 	if difficulty == "" {
 		return nil, dnderr.InvalidArgument("difficulty is required")
 	}
@@ -77,7 +98,6 @@ func (s *service) GenerateTreasure(ctx context.Context, difficulty string, roomN
 	// Base gold amount
 	goldMin, goldMax := s.getGoldRange(difficulty, roomNumber)
 	goldAmount := goldMin + s.random.Intn(goldMax-goldMin+1)
-	treasure = append(treasure, fmt.Sprintf("%d gold pieces", goldAmount))
 
 	// Healing potions
 	potionChance := 0.3
@@ -108,7 +128,10 @@ func (s *service) GenerateTreasure(ctx context.Context, difficulty string, roomN
 		treasure = append(treasure, specialItems...)
 	}
 
-	return treasure, nil
+	return &Hoard{
+		Gold:  goldAmount,
+		Items: treasure,
+	}, nil
 }
 
 // GenerateLootTable creates a loot table for a given CR
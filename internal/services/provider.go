@@ -6,6 +6,7 @@ import (
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/abilities"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/calculators"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/spells"
 	characterdraft "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
 	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/dungeons"
@@ -15,9 +16,17 @@ import (
 	characterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
 	dungeonService "github.com/KirkDiggler/dnd-bot-discord/internal/services/dungeon"
 	encounterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	itemService "github.com/KirkDiggler/dnd-bot-discord/internal/services/item"
+	levelUpService "github.com/KirkDiggler/dnd-bot-discord/internal/services/levelup"
 	lootService "github.com/KirkDiggler/dnd-bot-discord/internal/services/loot"
 	monsterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/monster"
+	quickBuildService "github.com/KirkDiggler/dnd-bot-discord/internal/services/quickbuild"
+	restService "github.com/KirkDiggler/dnd-bot-discord/internal/services/rest"
+	rulebookService "github.com/KirkDiggler/dnd-bot-discord/internal/services/rulebook"
 	sessionService "github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+	shopService "github.com/KirkDiggler/dnd-bot-discord/internal/services/shop"
+	spellService "github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
+	walletService "github.com/KirkDiggler/dnd-bot-discord/internal/services/wallet"
 	rpgevents "github.com/KirkDiggler/rpg-toolkit/events"
 )
 
@@ -25,12 +34,20 @@ import (
 type Provider struct {
 	CharacterService    characterService.Service
 	CreationFlowService character.CreationFlowService
+	QuickBuildService   quickBuildService.Service
 	SessionService      sessionService.Service
 	EncounterService    encounterService.Service
 	DungeonService      dungeonService.Service
 	MonsterService      monsterService.Service
 	LootService         lootService.Service
 	AbilityService      abilityService.Service
+	LevelUpService      levelUpService.Service
+	SpellService        spellService.Service
+	RestService         restService.Service
+	WalletService       walletService.Service
+	ShopService         shopService.Service
+	ItemService         itemService.Service
+	RulebookService     rulebookService.Service
 	DiceRoller          dice.Roller
 	EventBus            *rpgevents.Bus // Using rpg-toolkit directly
 }
@@ -43,6 +60,7 @@ type ProviderConfig struct {
 	SessionRepository        gamesessions.Repository
 	EncounterRepository      encounters.Repository
 	DungeonRepository        dungeons.Repository
+	RulebookService          rulebookService.Service // Optional; created from the DND client if nil
 	DiceRoller               dice.Roller
 }
 
@@ -51,6 +69,14 @@ func NewProvider(cfg *ProviderConfig) *Provider {
 	// Use rpg-toolkit event bus directly
 	eventBus := rpgevents.NewBus()
 
+	// Share the rulebook cache with the character repository when it has one
+	rulebookSvc := cfg.RulebookService
+	if rulebookSvc == nil {
+		rulebookSvc = rulebookService.NewService(&rulebookService.ServiceConfig{
+			DNDClient: cfg.DNDClient,
+		})
+	}
+
 	// Use in-memory repository if none provided
 	charRepo := cfg.CharacterRepository
 	if charRepo == nil {
@@ -83,15 +109,22 @@ func NewProvider(cfg *ProviderConfig) *Provider {
 
 	// Create character service
 	charService := characterService.NewService(&characterService.ServiceConfig{
-		DNDClient:       cfg.DNDClient,
-		Repository:      charRepo,
-		DraftRepository: draftRepo,
-		ACCalculator:    acCalculator,
+		DNDClient:         cfg.DNDClient,
+		Repository:        charRepo,
+		DraftRepository:   draftRepo,
+		ACCalculator:      acCalculator,
+		SessionRepository: sessionRepo,
 	})
 
 	// Create flow builder and creation flow service
-	flowBuilder := characterService.NewFlowBuilderWithResolver(cfg.DNDClient, charService.GetChoiceResolver())
+	flowBuilder := characterService.NewFlowBuilderWithMethods(cfg.DNDClient, charService.GetChoiceResolver(), charService)
 	creationFlowService := characterService.NewCreationFlowService(charService, flowBuilder)
+	qckBuildService := quickBuildService.NewService(&quickBuildService.ServiceConfig{
+		CharacterService: charService,
+		FlowService:      creationFlowService,
+		FlowBuilder:      flowBuilder,
+		DiceRoller:       cfg.DiceRoller,
+	})
 
 	// Create session service
 	sessService := sessionService.NewService(&sessionService.ServiceConfig{
@@ -118,6 +151,12 @@ func NewProvider(cfg *ProviderConfig) *Provider {
 		DNDClient: cfg.DNDClient,
 	})
 
+	// Create wallet service
+	wltService := walletService.NewService(&walletService.ServiceConfig{
+		SessionService:   sessService,
+		CharacterService: charService,
+	})
+
 	// Create dungeon service
 	dungService := dungeonService.NewService(&dungeonService.ServiceConfig{
 		Repository:       dungeonRepo,
@@ -125,6 +164,7 @@ func NewProvider(cfg *ProviderConfig) *Provider {
 		EncounterService: encService,
 		MonsterService:   monstService,
 		LootService:      ltService,
+		WalletService:    wltService,
 	})
 
 	// Create ability service
@@ -143,15 +183,65 @@ func NewProvider(cfg *ProviderConfig) *Provider {
 		CharacterService: charService,
 	})
 
+	// Create spell service and register spells with special logic
+	splService := spellService.NewService(&spellService.ServiceConfig{
+		CharacterService: charService,
+		EncounterService: encService,
+		SessionService:   sessService,
+		DiceRoller:       cfg.DiceRoller,
+		EventBus:         eventBus,
+	})
+	spells.RegisterAll(splService)
+
+	// Create level-up service
+	lvlUpService := levelUpService.NewService(&levelUpService.ServiceConfig{
+		CharacterService: charService,
+		SessionService:   sessService,
+		DiceRoller:       cfg.DiceRoller,
+		ACCalculator:     acCalculator,
+	})
+
+	// Create rest service
+	rstService := restService.NewService(&restService.ServiceConfig{
+		SessionService:   sessService,
+		CharacterService: charService,
+		EncounterService: encService,
+		DiceRoller:       cfg.DiceRoller,
+		EventBus:         eventBus,
+	})
+
+	// Create shop service
+	shpService := shopService.NewService(&shopService.ServiceConfig{
+		SessionService:   sessService,
+		CharacterService: charService,
+	})
+
+	// Create item service
+	itmService := itemService.NewService(&itemService.ServiceConfig{
+		CharacterService: charService,
+		EncounterService: encService,
+		SessionService:   sessService,
+		SpellService:     splService,
+		DiceRoller:       cfg.DiceRoller,
+	})
+
 	return &Provider{
 		CharacterService:    charService,
 		CreationFlowService: creationFlowService,
+		QuickBuildService:   qckBuildService,
 		SessionService:      sessService,
 		EncounterService:    encService,
 		DungeonService:      dungService,
 		MonsterService:      monstService,
 		LootService:         ltService,
 		AbilityService:      abilService,
+		LevelUpService:      lvlUpService,
+		SpellService:        splService,
+		RestService:         rstService,
+		WalletService:       wltService,
+		ShopService:         shpService,
+		ItemService:         itmService,
+		RulebookService:     rulebookSvc,
 		DiceRoller:          cfg.DiceRoller,
 		EventBus:            eventBus,
 	}
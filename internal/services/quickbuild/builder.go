@@ -0,0 +1,471 @@
+package quickbuild
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+)
+
+// maxSteps bounds the build so a step that never completes can't loop forever
+const maxSteps = 50
+
+// builder answers the creation steps for one character
+type builder struct {
+	svc      *service
+	input    *BuildInput
+	quick    *rulebook.QuickBuild // nil when every choice is random
+	answered map[string]bool      // Steps already answered, by stepKey
+}
+
+// answerSteps rebuilds the flow after every answer, since choices like
+// class or divine domain add steps, and answers the first open step until
+// only the name is left
+func (b *builder) answerSteps(ctx context.Context, characterID string) error {
+	for range maxSteps {
+		char, err := b.svc.characterService.GetByID(characterID)
+		if err != nil {
+			return dnderr.Wrapf(err, "failed to get character '%s'", characterID).
+				WithMeta("character_id", characterID)
+		}
+
+		flow, err := b.svc.flowBuilder.BuildFlow(ctx, char)
+		if err != nil {
+			return dnderr.Wrap(err, "failed to build creation flow").
+				WithMeta("character_id", characterID)
+		}
+
+		step, key := b.nextStep(flow.Steps)
+		if step == nil {
+			return nil
+		}
+
+		if err := b.answer(ctx, char, step); err != nil {
+			return dnderr.Wrapf(err, "failed to choose %s", strings.ToLower(step.Title)).
+				WithMeta("character_id", characterID).
+				WithMeta("step", string(step.Type))
+		}
+		b.answered[key] = true
+	}
+
+	return dnderr.Internal("character creation did not finish").
+		WithMeta("character_id", characterID)
+}
+
+// nextStep returns the first step still to answer and its key. Steps of the
+// same type are told apart by their order, e.g. each equipment choice.
+func (b *builder) nextStep(steps []character.CreationStep) (*character.CreationStep, string) {
+	pendingProficiencies := false
+	for _, step := range steps {
+		if step.Type == character.StepTypeProficiencySelection && !b.answered[stepKey(step.Type, 0)] {
+			pendingProficiencies = true
+		}
+	}
+
+	seen := make(map[character.CreationStepType]int)
+	for i := range steps {
+		step := &steps[i]
+		key := stepKey(step.Type, seen[step.Type])
+		seen[step.Type]++
+
+		switch {
+		case b.answered[key]:
+			continue
+		case step.Type == character.StepTypeAbilityAssignment:
+			// Scores are assigned when they are generated
+			continue
+		case step.Type == character.StepTypeCharacterDetails, step.Type == character.StepTypeComplete:
+			// The name is set when the character is finalized
+			continue
+		case step.Type == character.StepTypeExpertiseSelection && pendingProficiencies:
+			// Expertise needs the skills chosen in the proficiency step
+			continue
+		}
+		return step, key
+	}
+	return nil, ""
+}
+
+func stepKey(stepType character.CreationStepType, index int) string {
+	return fmt.Sprintf("%s#%d", stepType, index)
+}
+
+// answer makes the choice for one step
+func (b *builder) answer(ctx context.Context, char *character.Character, step *character.CreationStep) error {
+	switch step.Type {
+	case character.StepTypeAbilityScores:
+		return b.applyAbilityScores(ctx, char)
+	case character.StepTypeProficiencySelection:
+		return b.applyProficiencies(ctx, char)
+	case character.StepTypeEquipmentSelection:
+		return b.applyEquipment(ctx, char, step)
+	case character.StepTypePersonalitySelection:
+		_, err := b.svc.flowService.ProcessStepResult(ctx, char.ID, &character.CreationStepResult{
+			StepType:   step.Type,
+			Selections: []string{charService.PersonalityRoll},
+		})
+		return err
+	}
+
+	keys := make([]string, 0, len(step.Options))
+	for _, option := range step.Options {
+		keys = append(keys, option.Key)
+	}
+
+	count := step.MinChoices
+	if count == 0 {
+		count = step.MaxChoices
+	}
+	if len(keys) < count {
+		return fmt.Errorf("only %d options for %d choices", len(keys), count)
+	}
+
+	result := &character.CreationStepResult{
+		StepType:   step.Type,
+		Selections: b.pick(keys, count, b.preferred(step.Type)),
+	}
+	// Steps of the same type, like the domain and background languages, are
+	// told apart by their source
+	if source, ok := step.Context["source"]; ok {
+		result.Metadata = map[string]any{"source": source}
+	}
+
+	_, err := b.svc.flowService.ProcessStepResult(ctx, char.ID, result)
+	return err
+}
+
+// preferred returns the quick build's picks for a step type
+func (b *builder) preferred(stepType character.CreationStepType) []string {
+	var picks []string
+	switch stepType {
+	case character.StepTypeRaceSelection:
+		picks = []string{b.input.RaceKey}
+		if b.quick != nil {
+			picks = append(picks, b.quick.RaceKey)
+		}
+		return picks
+	case character.StepTypeClassSelection:
+		return []string{b.input.ClassKey}
+	}
+
+	if b.quick == nil {
+		return nil
+	}
+
+	switch stepType {
+	case character.StepTypeBackgroundSelection:
+		picks = []string{b.quick.BackgroundKey}
+	case character.StepTypeFightingStyleSelection:
+		picks = []string{b.quick.FightingStyle}
+	case character.StepTypeDivineDomainSelection:
+		picks = []string{b.quick.DivineDomain}
+	case character.StepTypeFavoredEnemySelection:
+		picks = []string{b.quick.FavoredEnemy}
+	case character.StepTypeNaturalExplorerSelection:
+		picks = []string{b.quick.Terrain}
+	case character.StepTypeSubclassSelection, character.StepTypePatronSelection, character.StepTypeSorcerousOriginSelection:
+		picks = []string{b.quick.Subclass}
+	case character.StepTypeExpertiseSelection:
+		picks = b.quick.Expertise
+	case character.StepTypeCantripsSelection:
+		picks = b.quick.Cantrips
+	case character.StepTypeSpellSelection, character.StepTypeSpellbookSelection, character.StepTypeSpellsKnownSelection:
+		picks = b.quick.Spells
+	}
+	return picks
+}
+
+// applyAbilityScores generates scores with a method the user's session
+// allows and assigns the highest to the class's most important abilities
+func (b *builder) applyAbilityScores(ctx context.Context, char *character.Character) error {
+	required, err := b.svc.characterService.RequiredAbilityScoreMethod(ctx, char.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	// Quick builds use the standard array, which is also a legal point buy
+	method := shared.AbilityScoreMethodStandardArray
+	if b.quick == nil {
+		method = shared.AbilityScoreMethodRoll
+	}
+	if !required.Allows(method) {
+		method = required
+	}
+
+	var values []int
+	prefix := "standard"
+	switch method {
+	case shared.AbilityScoreMethodRoll:
+		prefix = "roll"
+		for range shared.Attributes {
+			value, err := b.rollAbility()
+			if err != nil {
+				return err
+			}
+			values = append(values, value)
+		}
+	case shared.AbilityScoreMethodPointBuy:
+		prefix = "point_buy"
+		values = slices.Clone(rulebook.StandardArray)
+	default:
+		values = slices.Clone(rulebook.StandardArray)
+	}
+	slices.Sort(values)
+	slices.Reverse(values)
+
+	priority := shared.Attributes
+	if char.Class != nil {
+		if classBuild, ok := rulebook.GetQuickBuild(char.Class.Key); ok {
+			priority = classBuild.AbilityPriority
+		}
+	}
+
+	rolls := make([]character.AbilityRoll, len(values))
+	assignments := make(map[string]string, len(values))
+	for i, value := range values {
+		rolls[i] = character.AbilityRoll{ID: fmt.Sprintf("%s_%d", prefix, i+1), Value: value}
+		assignments[strings.ToUpper(priority[i].Short())] = rolls[i].ID
+	}
+
+	_, err = b.svc.characterService.UpdateDraftCharacter(ctx, char.ID, &charService.UpdateDraftInput{
+		AbilityMethod:      method,
+		AbilityRolls:       rolls,
+		AbilityAssignments: assignments,
+	})
+	return err
+}
+
+// rollAbility rolls 4d6 and drops the lowest die
+func (b *builder) rollAbility() (int, error) {
+	result, err := b.svc.diceRoller.Roll(4, 6, 0)
+	if err != nil {
+		return 0, err
+	}
+	rolls := slices.Clone(result.Rolls)
+	slices.Sort(rolls)
+	total := 0
+	for _, roll := range rolls[1:] {
+		total += roll
+	}
+	return total, nil
+}
+
+// applyProficiencies makes every class and race proficiency choice, never
+// picking a proficiency twice
+func (b *builder) applyProficiencies(ctx context.Context, char *character.Character) error {
+	choices, err := b.svc.characterService.GetChoiceResolver().ResolveProficiencyChoices(ctx, char.Race, char.Class)
+	if err != nil {
+		return err
+	}
+
+	taken := make(map[string]bool)
+	if char.Race != nil {
+		for _, prof := range char.Race.StartingProficiencies {
+			if prof != nil {
+				taken[prof.Key] = true
+			}
+		}
+	}
+
+	if char.Background != nil {
+		for _, prof := range char.Background.SkillProficiencies {
+			taken[prof.Key] = true
+		}
+		for _, prof := range char.Background.ToolProficiencies {
+			taken[prof.Key] = true
+		}
+	}
+
+	var preferred []string
+	if b.quick != nil {
+		preferred = b.quick.Skills
+	}
+
+	var selections []string
+	for _, choice := range choices {
+		var keys []string
+		for _, option := range choice.Options {
+			if !taken[option.Key] {
+				keys = append(keys, option.Key)
+			}
+		}
+		for _, key := range b.pick(keys, choice.Choose, preferred) {
+			taken[key] = true
+			selections = append(selections, key)
+		}
+	}
+
+	if len(selections) > 0 {
+		if _, err := b.svc.characterService.UpdateDraftCharacter(ctx, char.ID, &charService.UpdateDraftInput{
+			Proficiencies: selections,
+		}); err != nil {
+			return err
+		}
+	}
+
+	_, err = b.svc.flowService.ProcessStepResult(ctx, char.ID, &character.CreationStepResult{
+		StepType:   character.StepTypeProficiencySelection,
+		Selections: selections,
+	})
+	return err
+}
+
+// applyEquipment picks one option of an equipment choice and adds its items
+func (b *builder) applyEquipment(ctx context.Context, char *character.Character, step *character.CreationStep) error {
+	var selections, items []string
+	if len(step.Options) > 0 {
+		option := b.pickEquipmentOption(step.Options)
+		selections = []string{option.Key}
+
+		var err error
+		items, err = b.equipmentItems(option)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(items) > 0 {
+		if _, err := b.svc.characterService.UpdateDraftCharacter(ctx, char.ID, &charService.UpdateDraftInput{
+			Equipment: items,
+		}); err != nil {
+			return err
+		}
+	}
+
+	_, err := b.svc.flowService.ProcessStepResult(ctx, char.ID, &character.CreationStepResult{
+		StepType:   character.StepTypeEquipmentSelection,
+		Selections: selections,
+	})
+	return err
+}
+
+// pickEquipmentOption prefers an option holding quick build equipment, then
+// one without a nested choice
+func (b *builder) pickEquipmentOption(options []character.CreationOption) character.CreationOption {
+	if b.quick == nil {
+		return options[b.randomIndex(len(options))]
+	}
+
+	for _, want := range b.quick.Equipment {
+		for _, option := range options {
+			if option.Key == want || slices.Contains(bundleItems(option), want) {
+				return option
+			}
+		}
+	}
+	for _, option := range options {
+		if nestedChoice(option) == nil {
+			return option
+		}
+	}
+	return options[0]
+}
+
+// equipmentItems expands an equipment option into equipment keys, choosing
+// the weapons for options like "any martial weapon"
+func (b *builder) equipmentItems(option character.CreationOption) ([]string, error) {
+	items := bundleItems(option)
+	nested := nestedChoice(option)
+	if nested == nil {
+		if len(items) > 0 {
+			return items, nil
+		}
+		// Counted options are named like "4x Javelin"
+		count := 1
+		if _, err := fmt.Sscanf(option.Name, "%dx ", &count); err != nil || count < 1 {
+			count = 1
+		}
+		for range count {
+			items = append(items, option.Key)
+		}
+		return items, nil
+	}
+
+	keys := make([]string, 0, len(nested.Options))
+	for _, weapon := range nested.Options {
+		keys = append(keys, weapon.Key)
+	}
+	if len(keys) < nested.Choose {
+		return nil, fmt.Errorf("only %d options for %d choices of %s", len(keys), nested.Choose, nested.Name)
+	}
+
+	var preferred []string
+	if b.quick != nil {
+		preferred = b.quick.Equipment
+	}
+	return append(items, b.pick(keys, nested.Choose, preferred)...), nil
+}
+
+// nestedChoice returns the choice inside an option like "a martial weapon
+// and a shield", or nil for an option of fixed items
+func nestedChoice(option character.CreationOption) *charService.SimplifiedChoice {
+	nested, _ := option.Metadata["nested"].(*charService.SimplifiedChoice)
+	return nested
+}
+
+func bundleItems(option character.CreationOption) []string {
+	items, _ := option.Metadata["bundle_items"].([]string)
+	return items
+}
+
+// pick chooses count keys. Quick builds take the preferred keys that are
+// offered and then the first others; random builds ignore all but explicit
+// requests from the player and choose the rest at random.
+func (b *builder) pick(keys []string, count int, preferred []string) []string {
+	picked := make([]string, 0, count)
+	for _, want := range preferred {
+		if len(picked) == count {
+			return picked
+		}
+		if want != "" && slices.Contains(keys, want) && !slices.Contains(picked, want) {
+			picked = append(picked, want)
+		}
+	}
+
+	rest := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if !slices.Contains(picked, key) {
+			rest = append(rest, key)
+		}
+	}
+	if b.quick == nil {
+		// Sort first so a seeded roller always gives the same character
+		slices.Sort(rest)
+		b.shuffle(rest)
+	}
+
+	for _, key := range rest {
+		if len(picked) == count {
+			break
+		}
+		picked = append(picked, key)
+	}
+	return picked
+}
+
+// shuffle reorders keys at random
+func (b *builder) shuffle(keys []string) {
+	for i := len(keys) - 1; i > 0; i-- {
+		j := b.randomIndex(i + 1)
+		keys[i], keys[j] = keys[j], keys[i]
+	}
+}
+
+// randomIndex returns a random index below n. A failed roll falls back to
+// the first index, which is still a legal choice.
+func (b *builder) randomIndex(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	result, err := b.svc.diceRoller.Roll(1, n, 0)
+	if err != nil || result.Total < 1 || result.Total > n {
+		return 0
+	}
+	return result.Total - 1
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mock_service.go -package=mockquickbuild -source=service.go
+//
+
+// Package mockquickbuild is a generated GoMock package.
+package mockquickbuild
+
+import (
+	context "context"
+	reflect "reflect"
+
+	character "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	quickbuild "github.com/KirkDiggler/dnd-bot-discord/internal/services/quickbuild"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// QuickBuild mocks base method.
+func (m *MockService) QuickBuild(ctx context.Context, input *quickbuild.BuildInput) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuickBuild", ctx, input)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuickBuild indicates an expected call of QuickBuild.
+func (mr *MockServiceMockRecorder) QuickBuild(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuickBuild", reflect.TypeOf((*MockService)(nil).QuickBuild), ctx, input)
+}
+
+// RandomCharacter mocks base method.
+func (m *MockService) RandomCharacter(ctx context.Context, input *quickbuild.BuildInput) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RandomCharacter", ctx, input)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RandomCharacter indicates an expected call of RandomCharacter.
+func (mr *MockServiceMockRecorder) RandomCharacter(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RandomCharacter", reflect.TypeOf((*MockService)(nil).RandomCharacter), ctx, input)
+}
@@ -0,0 +1,197 @@
+// Package quickbuild creates finished characters in one step, either from the
+// PHB quick build for a class or from random choices. Both answer every step
+// of the normal creation flow, so the result is as legal as a hand-built one.
+package quickbuild
+
+//go:generate mockgen -destination=mock/mock_service.go -package=mockquickbuild -source=service.go
+
+import (
+	"context"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+)
+
+// Service builds finished characters without the step-by-step flow
+type Service interface {
+	// QuickBuild creates a character from the PHB quick build for a class.
+	// It replaces any character the user has in progress.
+	QuickBuild(ctx context.Context, input *BuildInput) (*character.Character, error)
+
+	// RandomCharacter creates a character from random legal choices.
+	// It replaces any character the user has in progress.
+	RandomCharacter(ctx context.Context, input *BuildInput) (*character.Character, error)
+}
+
+// BuildInput describes the character to build
+type BuildInput struct {
+	UserID   string
+	RealmID  string
+	ClassKey string // Required for a quick build, random if empty
+	RaceKey  string // Optional, defaults to the quick build's race or a random race
+	Name     string // Optional, defaults to a name suited to the race
+}
+
+type service struct {
+	characterService charService.Service
+	flowService      character.CreationFlowService
+	flowBuilder      character.FlowBuilder
+	diceRoller       dice.Roller
+}
+
+// ServiceConfig holds configuration for the service
+type ServiceConfig struct {
+	CharacterService charService.Service           // Required
+	FlowService      character.CreationFlowService // Required
+	FlowBuilder      character.FlowBuilder         // Required
+	DiceRoller       dice.Roller                   // Optional, defaults to random
+}
+
+// NewService creates a new quick build service
+func NewService(cfg *ServiceConfig) Service {
+	if cfg.CharacterService == nil {
+		panic("character service is required")
+	}
+	if cfg.FlowService == nil {
+		panic("creation flow service is required")
+	}
+	if cfg.FlowBuilder == nil {
+		panic("flow builder is required")
+	}
+
+	svc := &service{
+		characterService: cfg.CharacterService,
+		flowService:      cfg.FlowService,
+		flowBuilder:      cfg.FlowBuilder,
+		diceRoller:       cfg.DiceRoller,
+	}
+
+	if svc.diceRoller == nil {
+		svc.diceRoller = dice.NewRandomRoller()
+	}
+
+	return svc
+}
+
+// QuickBuild creates a character from a class's quick build
+func (s *service) QuickBuild(ctx context.Context, input *BuildInput) (*character.Character, error) {
+	if err := validateInput(input); err != nil {
+		return nil, err
+	}
+
+	quick, ok := rulebook.GetQuickBuild(input.ClassKey)
+	if !ok {
+		return nil, dnderr.InvalidArgumentf("there is no quick build for class '%s'", input.ClassKey).
+			WithMeta("class_key", input.ClassKey)
+	}
+
+	return s.build(ctx, input, quick)
+}
+
+// RandomCharacter creates a character from random choices
+func (s *service) RandomCharacter(ctx context.Context, input *BuildInput) (*character.Character, error) {
+	if err := validateInput(input); err != nil {
+		return nil, err
+	}
+
+	return s.build(ctx, input, nil)
+}
+
+func validateInput(input *BuildInput) error {
+	if input == nil {
+		return dnderr.InvalidArgument("input is required")
+	}
+	if strings.TrimSpace(input.UserID) == "" {
+		return dnderr.InvalidArgument("user ID is required")
+	}
+	if strings.TrimSpace(input.RealmID) == "" {
+		return dnderr.InvalidArgument("realm ID is required")
+	}
+	return nil
+}
+
+// build answers every creation step on a fresh draft, then finalizes it.
+// A nil quick build makes every choice at random.
+func (s *service) build(ctx context.Context, input *BuildInput, quick *rulebook.QuickBuild) (*character.Character, error) {
+	draft, err := s.characterService.StartFreshCharacterCreation(ctx, input.UserID, input.RealmID)
+	if err != nil {
+		return nil, dnderr.Wrap(err, "failed to start character creation").
+			WithMeta("user_id", input.UserID)
+	}
+
+	char, err := s.characterService.GetByID(draft.ID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", draft.ID).
+			WithMeta("character_id", draft.ID)
+	}
+	if err := s.resetDraft(ctx, char); err != nil {
+		return nil, err
+	}
+
+	b := &builder{
+		svc:      s,
+		input:    input,
+		quick:    quick,
+		answered: make(map[string]bool),
+	}
+	if err := b.answerSteps(ctx, char.ID); err != nil {
+		return nil, err
+	}
+
+	char, err = s.characterService.GetByID(char.ID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", char.ID).
+			WithMeta("character_id", char.ID)
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		raceKey := ""
+		if char.Race != nil {
+			raceKey = char.Race.Key
+		}
+		names := rulebook.CharacterNames(raceKey)
+		name = names[b.randomIndex(len(names))]
+	}
+
+	return s.characterService.FinalizeCharacterWithName(ctx, char.ID, name, "", "")
+}
+
+// resetDraft clears a draft back to an empty character so no earlier choices
+// leak into the new one
+func (s *service) resetDraft(ctx context.Context, char *character.Character) error {
+	if char.Race == nil && char.Class == nil && len(char.Features) == 0 && len(char.AbilityRolls) == 0 {
+		return nil
+	}
+
+	char.Name = ""
+	char.Speed = 0
+	char.Race = nil
+	char.Class = nil
+	char.Background = nil
+	char.Personality = nil
+	char.Attributes = make(map[shared.Attribute]*character.AbilityScore)
+	char.AbilityRolls = nil
+	char.AbilityAssignments = nil
+	char.Proficiencies = make(map[rulebook.ProficiencyType][]*rulebook.Proficiency)
+	char.Inventory = make(map[equipment.EquipmentType][]equipment.Equipment)
+	char.EquippedSlots = make(map[shared.Slot]equipment.Equipment)
+	char.Features = nil
+	char.Spells = nil
+	char.HitDie = 0
+	char.AC = 0
+	char.MaxHitPoints = 0
+	char.CurrentHitPoints = 0
+
+	if err := s.characterService.UpdateEquipment(ctx, char); err != nil {
+		return dnderr.Wrap(err, "failed to reset draft").
+			WithMeta("character_id", char.ID)
+	}
+	return nil
+}
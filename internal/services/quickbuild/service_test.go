@@ -0,0 +1,407 @@
+package quickbuild
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mockdnd5e "github.com/KirkDiggler/dnd-bot-discord/internal/clients/dnd5e/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/damage"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	characterdraft "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	mockchar "github.com/KirkDiggler/dnd-bot-discord/internal/services/character/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testOwner = "user_123"
+	testRealm = "realm_123"
+)
+
+// recorder keeps what the builder sent to the character and flow services
+type recorder struct {
+	char       *character.Character
+	selections map[character.CreationStepType][][]string
+	drafts     []*charService.UpdateDraftInput
+	finalName  string
+}
+
+// testFlow is a fighter-shaped flow: every step the builder handles itself
+// plus a fighting style and background choice
+func testFlow() *character.CreationFlow {
+	return &character.CreationFlow{Steps: []character.CreationStep{
+		{Type: character.StepTypeRaceSelection, Title: "Race", MinChoices: 1, MaxChoices: 1, Options: []character.CreationOption{
+			{Key: "human", Name: "Human"}, {Key: "elf", Name: "Elf"}, {Key: "dwarf", Name: "Dwarf"},
+		}},
+		{Type: character.StepTypeClassSelection, Title: "Class", MinChoices: 1, MaxChoices: 1, Options: []character.CreationOption{
+			{Key: "fighter", Name: "Fighter"}, {Key: "wizard", Name: "Wizard"},
+		}},
+		{Type: character.StepTypeAbilityScores, Title: "Ability Scores"},
+		{Type: character.StepTypeAbilityAssignment, Title: "Assign Abilities"},
+		{Type: character.StepTypeFightingStyleSelection, Title: "Fighting Style", MinChoices: 1, MaxChoices: 1, Options: []character.CreationOption{
+			{Key: "archery", Name: "Archery"}, {Key: "defense", Name: "Defense"}, {Key: "dueling", Name: "Dueling"},
+		}},
+		{Type: character.StepTypeBackgroundSelection, Title: "Background", MinChoices: 1, MaxChoices: 1, Options: []character.CreationOption{
+			{Key: "acolyte", Name: "Acolyte"}, {Key: "soldier", Name: "Soldier"},
+		}},
+		{Type: character.StepTypePersonalitySelection, Title: "Personality", MinChoices: 5, MaxChoices: 5},
+		{Type: character.StepTypeProficiencySelection, Title: "Proficiencies"},
+		{Type: character.StepTypeEquipmentSelection, Title: "Armor", Options: []character.CreationOption{
+			{Key: "chain-mail", Name: "Chain Mail"},
+			{Key: "bundle-0", Name: "Leather Armor and Longbow", Metadata: map[string]any{
+				"bundle_items": []string{"leather-armor", "longbow"},
+			}},
+		}},
+		{Type: character.StepTypeEquipmentSelection, Title: "Ranged", Options: []character.CreationOption{
+			{Key: "handaxe", Name: "2x Handaxe"},
+			{Key: "light-crossbow", Name: "Light Crossbow"},
+		}},
+		{Type: character.StepTypeCharacterDetails, Title: "Name"},
+	}}
+}
+
+func setupService(t *testing.T, roller dice.Roller) (Service, *recorder) {
+	ctrl := gomock.NewController(t)
+	charSvc := mockchar.NewMockService(ctrl)
+	flowSvc := mockchar.NewMockCreationFlowService(ctrl)
+	flowBuilder := mockchar.NewMockFlowBuilder(ctrl)
+	resolver := mockchar.NewMockChoiceResolver(ctrl)
+
+	rec := &recorder{
+		char: &character.Character{
+			ID:      "char_123",
+			OwnerID: testOwner,
+			RealmID: testRealm,
+			Status:  shared.CharacterStatusDraft,
+		},
+		selections: make(map[character.CreationStepType][][]string),
+	}
+
+	charSvc.EXPECT().StartFreshCharacterCreation(gomock.Any(), testOwner, testRealm).Return(rec.char, nil)
+	charSvc.EXPECT().GetByID(rec.char.ID).Return(rec.char, nil).AnyTimes()
+	charSvc.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	charSvc.EXPECT().RequiredAbilityScoreMethod(gomock.Any(), testOwner).Return(shared.AbilityScoreMethod(""), nil).AnyTimes()
+	charSvc.EXPECT().GetChoiceResolver().Return(resolver).AnyTimes()
+	charSvc.EXPECT().UpdateDraftCharacter(gomock.Any(), rec.char.ID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, input *charService.UpdateDraftInput) (*character.Character, error) {
+			rec.drafts = append(rec.drafts, input)
+			return rec.char, nil
+		}).AnyTimes()
+	charSvc.EXPECT().FinalizeCharacterWithName(gomock.Any(), rec.char.ID, gomock.Any(), "", "").
+		DoAndReturn(func(_ context.Context, _, name, _, _ string) (*character.Character, error) {
+			rec.finalName = name
+			rec.char.Name = name
+			return rec.char, nil
+		})
+
+	resolver.EXPECT().ResolveProficiencyChoices(gomock.Any(), gomock.Any(), gomock.Any()).Return([]charService.SimplifiedChoice{
+		{ID: "fighter-skills", Type: "skill", Choose: 2, Options: []charService.ChoiceOption{
+			{Key: "skill-acrobatics"}, {Key: "skill-athletics"}, {Key: "skill-history"}, {Key: "skill-perception"},
+		}},
+	}, nil).AnyTimes()
+
+	flowBuilder.EXPECT().BuildFlow(gomock.Any(), gomock.Any()).Return(testFlow(), nil).AnyTimes()
+
+	flowSvc.EXPECT().ProcessStepResult(gomock.Any(), rec.char.ID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, result *character.CreationStepResult) (*character.CreationStep, error) {
+			rec.selections[result.StepType] = append(rec.selections[result.StepType], result.Selections)
+			switch result.StepType {
+			case character.StepTypeRaceSelection:
+				rec.char.Race = testutils.CreateTestRace(result.Selections[0], result.Selections[0])
+			case character.StepTypeClassSelection:
+				rec.char.Class = testutils.CreateTestClass(result.Selections[0], result.Selections[0], 10)
+			case character.StepTypeBackgroundSelection:
+				rec.char.Background = rulebook.GetSRDBackground(result.Selections[0])
+			}
+			return nil, nil
+		}).AnyTimes()
+
+	return NewService(&ServiceConfig{
+		CharacterService: charSvc,
+		FlowService:      flowSvc,
+		FlowBuilder:      flowBuilder,
+		DiceRoller:       roller,
+	}), rec
+}
+
+// abilityDraft returns the draft update that set ability scores
+func (r *recorder) abilityDraft(t *testing.T) *charService.UpdateDraftInput {
+	for _, draft := range r.drafts {
+		if len(draft.AbilityRolls) > 0 {
+			return draft
+		}
+	}
+	require.Fail(t, "ability scores were never set")
+	return nil
+}
+
+// rollValue returns the value of the roll assigned to an ability
+func (r *recorder) rollValue(t *testing.T, attr shared.Attribute) int {
+	draft := r.abilityDraft(t)
+	rollID := draft.AbilityAssignments[strings.ToUpper(attr.Short())]
+	for _, roll := range draft.AbilityRolls {
+		if roll.ID == rollID {
+			return roll.Value
+		}
+	}
+	require.Failf(t, "ability not assigned", "%s has no roll", attr)
+	return 0
+}
+
+func (r *recorder) equipment() []string {
+	var items []string
+	for _, draft := range r.drafts {
+		items = append(items, draft.Equipment...)
+	}
+	return items
+}
+
+func TestQuickBuild_Fighter(t *testing.T) {
+	svc, rec := setupService(t, dice.NewSeededRoller(1))
+
+	char, err := svc.QuickBuild(context.Background(), &BuildInput{
+		UserID:   testOwner,
+		RealmID:  testRealm,
+		ClassKey: "fighter",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, char)
+
+	assert.Equal(t, [][]string{{"human"}}, rec.selections[character.StepTypeRaceSelection])
+	assert.Equal(t, [][]string{{"fighter"}}, rec.selections[character.StepTypeClassSelection])
+	assert.Equal(t, [][]string{{"defense"}}, rec.selections[character.StepTypeFightingStyleSelection])
+	assert.Equal(t, [][]string{{"soldier"}}, rec.selections[character.StepTypeBackgroundSelection])
+	assert.Equal(t, [][]string{{charService.PersonalityRoll}}, rec.selections[character.StepTypePersonalitySelection])
+	// Soldiers already know Athletics, so the class skills go elsewhere
+	assert.Equal(t, [][]string{{"skill-perception", "skill-acrobatics"}}, rec.selections[character.StepTypeProficiencySelection])
+
+	// Standard array, highest scores to the fighter's priorities
+	draft := rec.abilityDraft(t)
+	assert.Equal(t, shared.AbilityScoreMethodStandardArray, draft.AbilityMethod)
+	assert.Equal(t, 15, rec.rollValue(t, shared.AttributeStrength))
+	assert.Equal(t, 14, rec.rollValue(t, shared.AttributeConstitution))
+	assert.Equal(t, 13, rec.rollValue(t, shared.AttributeDexterity))
+	assert.Equal(t, 8, rec.rollValue(t, shared.AttributeIntelligence))
+
+	// Both equipment choices answered, preferring the quick build's gear
+	assert.Equal(t, [][]string{{"chain-mail"}, {"light-crossbow"}}, rec.selections[character.StepTypeEquipmentSelection])
+	assert.Equal(t, []string{"chain-mail", "light-crossbow"}, rec.equipment())
+
+	assert.Contains(t, rulebook.CharacterNames("human"), rec.finalName)
+}
+
+func TestQuickBuild_KeepsRequestedName(t *testing.T) {
+	svc, rec := setupService(t, dice.NewSeededRoller(1))
+
+	_, err := svc.QuickBuild(context.Background(), &BuildInput{
+		UserID:   testOwner,
+		RealmID:  testRealm,
+		ClassKey: "fighter",
+		RaceKey:  "dwarf",
+		Name:     "Torvin",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]string{{"dwarf"}}, rec.selections[character.StepTypeRaceSelection])
+	assert.Equal(t, "Torvin", rec.finalName)
+}
+
+func TestQuickBuild_UnknownClass(t *testing.T) {
+	svc := NewService(&ServiceConfig{
+		CharacterService: mockchar.NewMockService(gomock.NewController(t)),
+		FlowService:      mockchar.NewMockCreationFlowService(gomock.NewController(t)),
+		FlowBuilder:      mockchar.NewMockFlowBuilder(gomock.NewController(t)),
+	})
+
+	_, err := svc.QuickBuild(context.Background(), &BuildInput{
+		UserID:   testOwner,
+		RealmID:  testRealm,
+		ClassKey: "artificer",
+	})
+	require.Error(t, err)
+	assert.True(t, dnderr.IsInvalidArgument(err))
+}
+
+func TestRandomCharacter_AnswersEveryStep(t *testing.T) {
+	svc, rec := setupService(t, dice.NewSeededRoller(42))
+
+	char, err := svc.RandomCharacter(context.Background(), &BuildInput{
+		UserID:  testOwner,
+		RealmID: testRealm,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, char)
+
+	require.Len(t, rec.selections[character.StepTypeRaceSelection], 1)
+	require.Len(t, rec.selections[character.StepTypeClassSelection], 1)
+	assert.Len(t, rec.selections[character.StepTypeFightingStyleSelection], 1)
+	assert.Len(t, rec.selections[character.StepTypeBackgroundSelection], 1)
+	assert.Len(t, rec.selections[character.StepTypePersonalitySelection], 1)
+	assert.Len(t, rec.selections[character.StepTypeEquipmentSelection], 2)
+
+	skills := rec.selections[character.StepTypeProficiencySelection]
+	require.Len(t, skills, 1)
+	assert.Len(t, skills[0], 2)
+	assert.NotEqual(t, skills[0][0], skills[0][1])
+
+	// Rolled 4d6 drop lowest for every ability
+	draft := rec.abilityDraft(t)
+	assert.Equal(t, shared.AbilityScoreMethodRoll, draft.AbilityMethod)
+	require.Len(t, draft.AbilityRolls, 6)
+	for _, roll := range draft.AbilityRolls {
+		assert.True(t, strings.HasPrefix(roll.ID, "roll_"))
+		assert.GreaterOrEqual(t, roll.Value, 3)
+		assert.LessOrEqual(t, roll.Value, 18)
+	}
+	assert.Len(t, draft.AbilityAssignments, 6)
+
+	assert.NotNil(t, char.Background)
+	assert.NotEmpty(t, rec.finalName)
+}
+
+func TestRandomCharacter_RequiresUser(t *testing.T) {
+	svc := NewService(&ServiceConfig{
+		CharacterService: mockchar.NewMockService(gomock.NewController(t)),
+		FlowService:      mockchar.NewMockCreationFlowService(gomock.NewController(t)),
+		FlowBuilder:      mockchar.NewMockFlowBuilder(gomock.NewController(t)),
+	})
+
+	_, err := svc.RandomCharacter(context.Background(), &BuildInput{RealmID: testRealm})
+	require.Error(t, err)
+	assert.True(t, dnderr.IsInvalidArgument(err))
+}
+
+// fighterClass is a fighter whose second equipment choice holds nested
+// martial weapon choices, like the one the API returns
+func fighterClass() *rulebook.Class {
+	martialWeapons := func(name string, count int) *shared.Choice {
+		return &shared.Choice{Name: name, Count: count, Type: shared.ChoiceTypeEquipment, Options: []shared.Option{
+			&shared.ReferenceOption{Reference: &shared.ReferenceItem{Key: "battleaxe", Name: "Battleaxe"}},
+			&shared.ReferenceOption{Reference: &shared.ReferenceItem{Key: "longsword", Name: "Longsword"}},
+			&shared.ReferenceOption{Reference: &shared.ReferenceItem{Key: "warhammer", Name: "Warhammer"}},
+		}}
+	}
+
+	return &rulebook.Class{
+		Key:    "fighter",
+		Name:   "Fighter",
+		HitDie: 10,
+		Proficiencies: []*shared.ReferenceItem{
+			{Key: "saving-throw-str", Name: "Saving Throw: STR"},
+			{Key: "saving-throw-con", Name: "Saving Throw: CON"},
+			{Key: "all-armor", Name: "All armor"},
+			{Key: "shields", Name: "Shields"},
+			{Key: "simple-weapons", Name: "Simple Weapons"},
+			{Key: "martial-weapons", Name: "Martial Weapons"},
+		},
+		ProficiencyChoices: []*shared.Choice{
+			testutils.CreateTestProficiencyChoice("Choose two skills", 2, []string{
+				"skill-acrobatics", "skill-athletics", "skill-history", "skill-perception",
+			}),
+		},
+		StartingEquipmentChoices: []*shared.Choice{
+			{Name: "(a) a martial weapon and a shield or (b) two martial weapons", Count: 1, Type: shared.ChoiceTypeEquipment, Options: []shared.Option{
+				&shared.MultipleOption{Items: []shared.Option{
+					martialWeapons("a martial weapon", 1),
+					&shared.ReferenceOption{Reference: &shared.ReferenceItem{Key: "shield", Name: "Shield"}},
+				}},
+				martialWeapons("two martial weapons", 2),
+			}},
+		},
+	}
+}
+
+func TestQuickBuild_RealFlowIsLegal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dndClient := mockdnd5e.NewMockClient(ctrl)
+	human := &rulebook.Race{Key: "human", Name: "Human", Speed: 30}
+	for _, attr := range shared.Attributes {
+		human.AbilityBonuses = append(human.AbilityBonuses, &shared.AbilityBonus{Attribute: attr, Bonus: 1})
+	}
+	fighter := fighterClass()
+	weapon := func(key, name string) *equipment.Weapon {
+		return &equipment.Weapon{
+			Base:           equipment.BasicEquipment{Key: key, Name: name},
+			WeaponCategory: "Martial",
+			WeaponRange:    "Melee",
+			Damage:         &damage.Damage{DiceCount: 1, DiceSize: 8, DamageType: damage.TypeSlashing},
+		}
+	}
+	gear := map[string]equipment.Equipment{
+		"battleaxe": weapon("battleaxe", "Battleaxe"),
+		"longsword": weapon("longsword", "Longsword"),
+		"warhammer": weapon("warhammer", "Warhammer"),
+		"shield": &equipment.Armor{
+			Base:          equipment.BasicEquipment{Key: "shield", Name: "Shield"},
+			ArmorCategory: equipment.ArmorCategoryShield,
+			ArmorClass:    &equipment.ArmorClass{Base: 2},
+		},
+	}
+
+	dndClient.EXPECT().ListRaces().Return([]*rulebook.Race{human}, nil).AnyTimes()
+	dndClient.EXPECT().ListClasses().Return([]*rulebook.Class{fighter}, nil).AnyTimes()
+	dndClient.EXPECT().GetRace("human").Return(human, nil).AnyTimes()
+	dndClient.EXPECT().GetClass("fighter").Return(fighter, nil).AnyTimes()
+	dndClient.EXPECT().GetProficiency(gomock.Any()).DoAndReturn(func(key string) (*rulebook.Proficiency, error) {
+		profType := rulebook.ProficiencyTypeUnknown
+		switch {
+		case strings.HasPrefix(key, "skill-"):
+			profType = rulebook.ProficiencyTypeSkill
+		case strings.HasPrefix(key, "saving-throw-"):
+			profType = rulebook.ProficiencyTypeSavingThrow
+		case strings.HasSuffix(key, "-weapons"):
+			profType = rulebook.ProficiencyTypeWeapon
+		case strings.HasSuffix(key, "armor"), key == "shields":
+			profType = rulebook.ProficiencyTypeArmor
+		}
+		return &rulebook.Proficiency{Key: key, Name: key, Type: profType}, nil
+	}).AnyTimes()
+	dndClient.EXPECT().GetEquipment(gomock.Any()).DoAndReturn(func(key string) (equipment.Equipment, error) {
+		if item, ok := gear[key]; ok {
+			return item, nil
+		}
+		return nil, dnderr.NotFoundf("equipment '%s' not found", key)
+	}).AnyTimes()
+	dndClient.EXPECT().GetClassFeatures(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	charSvc := charService.NewService(&charService.ServiceConfig{
+		DNDClient:       dndClient,
+		Repository:      characters.NewInMemoryRepository(),
+		DraftRepository: characterdraft.NewInMemoryRepository(),
+	})
+	flowBuilder := charService.NewFlowBuilder(dndClient)
+	svc := NewService(&ServiceConfig{
+		CharacterService: charSvc,
+		FlowService:      charService.NewCreationFlowServiceWithRoller(charSvc, flowBuilder, dice.NewSeededRoller(1)),
+		FlowBuilder:      flowBuilder,
+		DiceRoller:       dice.NewSeededRoller(1),
+	})
+
+	char, err := svc.QuickBuild(context.Background(), &BuildInput{
+		UserID:   testOwner,
+		RealmID:  testRealm,
+		ClassKey: "fighter",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, shared.CharacterStatusActive, char.Status)
+
+	// The nested martial weapon came from the choice's own options
+	assert.Equal(t, 1, char.CountEquipment("longsword"))
+	assert.Equal(t, 1, char.CountEquipment("shield"))
+
+	report, err := charSvc.AuditCharacter(context.Background(), char.ID)
+	require.NoError(t, err)
+	for _, violation := range report.Violations {
+		assert.Failf(t, "audit violation", "%s: %s", violation.Rule, violation.Message)
+	}
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mock_service.go -package=mockrest -source=service.go
+//
+
+// Package mockrest is a generated GoMock package.
+package mockrest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	rest "github.com/KirkDiggler/dnd-bot-discord/internal/services/rest"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// EndShortRest mocks base method.
+func (m *MockService) EndShortRest(ctx context.Context, sessionID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndShortRest", ctx, sessionID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndShortRest indicates an expected call of EndShortRest.
+func (mr *MockServiceMockRecorder) EndShortRest(ctx, sessionID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndShortRest", reflect.TypeOf((*MockService)(nil).EndShortRest), ctx, sessionID, userID)
+}
+
+// LongRest mocks base method.
+func (m *MockService) LongRest(ctx context.Context, sessionID, userID string) (*rest.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LongRest", ctx, sessionID, userID)
+	ret0, _ := ret[0].(*rest.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LongRest indicates an expected call of LongRest.
+func (mr *MockServiceMockRecorder) LongRest(ctx, sessionID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LongRest", reflect.TypeOf((*MockService)(nil).LongRest), ctx, sessionID, userID)
+}
+
+// ShortRest mocks base method.
+func (m *MockService) ShortRest(ctx context.Context, sessionID, userID string) (*rest.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShortRest", ctx, sessionID, userID)
+	ret0, _ := ret[0].(*rest.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShortRest indicates an expected call of ShortRest.
+func (mr *MockServiceMockRecorder) ShortRest(ctx, sessionID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShortRest", reflect.TypeOf((*MockService)(nil).ShortRest), ctx, sessionID, userID)
+}
+
+// SpendHitDice mocks base method.
+func (m *MockService) SpendHitDice(ctx context.Context, input *rest.SpendHitDiceInput) (*rest.HitDiceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendHitDice", ctx, input)
+	ret0, _ := ret[0].(*rest.HitDiceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpendHitDice indicates an expected call of SpendHitDice.
+func (mr *MockServiceMockRecorder) SpendHitDice(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendHitDice", reflect.TypeOf((*MockService)(nil).SpendHitDice), ctx, input)
+}
@@ -0,0 +1,365 @@
+// Package rest handles short and long rests for the party in a session.
+package rest
+
+//go:generate mockgen -destination=mock/mock_service.go -package=mockrest -source=service.go
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/adapters/rpgtoolkit"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/feats"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	encounterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	sessService "github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+	rpgevents "github.com/KirkDiggler/rpg-toolkit/events"
+)
+
+const (
+	// metadataShortRest marks a session whose party is taking a short rest
+	metadataShortRest = "shortRest"
+
+	// metadataLongRestVote prefixes the user IDs of players who have agreed
+	// to a long rest
+	metadataLongRestVote = "longRestVote:"
+)
+
+// Service manages resting
+type Service interface {
+	// ShortRest starts a short rest for the party. Short rest abilities and
+	// pact magic slots recover right away; each player then spends hit dice
+	// with SpendHitDice until the rest ends.
+	ShortRest(ctx context.Context, sessionID, userID string) (*Result, error)
+
+	// SpendHitDice rolls hit dice for the user's character during a short rest
+	SpendHitDice(ctx context.Context, input *SpendHitDiceInput) (*HitDiceResult, error)
+
+	// EndShortRest ends the party's short rest
+	EndShortRest(ctx context.Context, sessionID, userID string) error
+
+	// LongRest restores the party's hit points, spell slots, abilities and
+	// half their hit dice. The DM can call a long rest at any time; a player
+	// calling one is agreeing to it, and the party rests once every player
+	// has agreed.
+	LongRest(ctx context.Context, sessionID, userID string) (*Result, error)
+}
+
+// Result lists the party characters that rested
+type Result struct {
+	SessionID  string
+	RestType   shared.RestType
+	Characters []*character.Character
+
+	// AwaitingApproval lists the players who haven't agreed to a long rest
+	// yet. No one has rested while it's set.
+	AwaitingApproval []string
+}
+
+// SpendHitDiceInput contains a player's hit dice choice
+type SpendHitDiceInput struct {
+	SessionID string
+	UserID    string
+	Count     int
+}
+
+// HitDiceResult describes the hit dice a character spent
+type HitDiceResult struct {
+	Character   *character.Character
+	Rolls       []int
+	Modifier    int // Constitution modifier added to each die
+	Healed      int
+	HitDiceLeft int
+}
+
+type service struct {
+	sessionService   sessService.Service
+	characterService charService.Service
+	encounterService encounterService.Service
+	diceRoller       dice.Roller
+	eventBus         *rpgevents.Bus
+	featRegistry     *feats.Registry
+}
+
+// ServiceConfig holds configuration for the rest service
+type ServiceConfig struct {
+	SessionService   sessService.Service      // Required
+	CharacterService charService.Service      // Required
+	EncounterService encounterService.Service // Required
+	DiceRoller       dice.Roller              // Optional, defaults to random
+	EventBus         *rpgevents.Bus           // Optional
+	FeatRegistry     *feats.Registry          // Optional, defaults to the global registry
+}
+
+// NewService creates a new rest service
+func NewService(cfg *ServiceConfig) Service {
+	if cfg.SessionService == nil {
+		panic("session service is required")
+	}
+	if cfg.CharacterService == nil {
+		panic("character service is required")
+	}
+	if cfg.EncounterService == nil {
+		panic("encounter service is required")
+	}
+
+	svc := &service{
+		sessionService:   cfg.SessionService,
+		characterService: cfg.CharacterService,
+		encounterService: cfg.EncounterService,
+		diceRoller:       cfg.DiceRoller,
+		eventBus:         cfg.EventBus,
+		featRegistry:     cfg.FeatRegistry,
+	}
+
+	if svc.diceRoller == nil {
+		svc.diceRoller = dice.NewRandomRoller()
+	}
+	if svc.featRegistry == nil {
+		svc.featRegistry = feats.GlobalRegistry
+	}
+
+	return svc
+}
+
+// ShortRest starts a short rest for the party
+func (s *service) ShortRest(ctx context.Context, sessionID, userID string) (*Result, error) {
+	sess, err := s.getRestingSession(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	party, err := s.characterService.GetParty(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = charService.WithChange(ctx, userID, "short rest")
+	for _, char := range party {
+		char.ShortRest()
+		if err := rpgtoolkit.EmitEvent(s.eventBus, rpgevents.EventOnShortRest, char, nil, nil); err != nil {
+			log.Printf("Failed to emit OnShortRest event for %s: %v", char.Name, err)
+		}
+		if err := s.characterService.UpdateEquipment(ctx, char); err != nil {
+			return nil, dnderr.Wrapf(err, "failed to save %s after resting", char.Name)
+		}
+	}
+
+	if sess.Metadata == nil {
+		sess.Metadata = make(shared.Metadata)
+	}
+	sess.Metadata[metadataShortRest] = true
+	if err := s.sessionService.SaveSession(ctx, sess); err != nil {
+		return nil, dnderr.Wrap(err, "failed to save session")
+	}
+
+	return &Result{
+		SessionID:  sess.ID,
+		RestType:   shared.RestTypeShort,
+		Characters: party,
+	}, nil
+}
+
+// SpendHitDice rolls hit dice for the user's character during a short rest
+func (s *service) SpendHitDice(ctx context.Context, input *SpendHitDiceInput) (*HitDiceResult, error) {
+	if input == nil {
+		return nil, dnderr.InvalidArgument("input cannot be nil")
+	}
+	if input.Count <= 0 {
+		return nil, dnderr.InvalidArgument("spend at least one hit die")
+	}
+
+	sess, err := s.sessionService.GetSession(ctx, input.SessionID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get session '%s'", input.SessionID)
+	}
+	if !sess.Metadata.GetBoolOrDefault(metadataShortRest, false) {
+		return nil, dnderr.InvalidArgument("hit dice can only be spent during a short rest")
+	}
+
+	member, exists := sess.Members[input.UserID]
+	if !exists || member.CharacterID == "" {
+		return nil, dnderr.PermissionDenied("you don't have a character in this session").
+			WithMeta("session_id", input.SessionID)
+	}
+
+	char, err := s.characterService.GetByID(member.CharacterID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", member.CharacterID)
+	}
+
+	remaining := char.GetResources().HitDice.Remaining()
+	if input.Count > remaining {
+		return nil, dnderr.InvalidArgumentf("%s only has %d hit dice left", char.Name, remaining).
+			WithMeta("requested", input.Count)
+	}
+
+	result := &HitDiceResult{Character: char}
+	if con := char.Attributes[shared.AttributeConstitution]; con != nil {
+		result.Modifier = con.Bonus
+	}
+
+	// Multiclass characters spend their largest hit dice first
+	for i := 0; i < input.Count; i++ {
+		roll, err := s.diceRoller.Roll(1, char.HitDieType(), 0)
+		if err != nil {
+			return nil, dnderr.Wrap(err, "failed to roll hit die")
+		}
+		healed, _ := char.SpendHitDie(roll.Total)
+		result.Rolls = append(result.Rolls, roll.Total)
+		result.Healed += healed
+	}
+	result.HitDiceLeft = char.GetResources().HitDice.Remaining()
+
+	ctx = charService.WithChange(ctx, input.UserID, fmt.Sprintf("spent %d hit dice", input.Count))
+	if err := s.characterService.UpdateEquipment(ctx, char); err != nil {
+		return nil, dnderr.Wrapf(err, "failed to save %s", char.Name)
+	}
+
+	return result, nil
+}
+
+// EndShortRest ends the party's short rest
+func (s *service) EndShortRest(ctx context.Context, sessionID, userID string) error {
+	sess, err := s.sessionService.GetSession(ctx, sessionID)
+	if err != nil {
+		return dnderr.Wrapf(err, "failed to get session '%s'", sessionID)
+	}
+	if _, exists := sess.Members[userID]; !exists {
+		return dnderr.PermissionDenied("only party members can end the rest")
+	}
+	if !sess.Metadata.Has(metadataShortRest) {
+		return nil
+	}
+
+	delete(sess.Metadata, metadataShortRest)
+	if err := s.sessionService.SaveSession(ctx, sess); err != nil {
+		return dnderr.Wrap(err, "failed to save session")
+	}
+	return nil
+}
+
+// LongRest restores the party, including feats such as Lucky
+func (s *service) LongRest(ctx context.Context, sessionID, userID string) (*Result, error) {
+	sess, err := s.getRestingSession(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if waiting := voteForLongRest(sess, userID); len(waiting) > 0 {
+		if err := s.sessionService.SaveSession(ctx, sess); err != nil {
+			return nil, dnderr.Wrap(err, "failed to save session")
+		}
+		return &Result{
+			SessionID:        sess.ID,
+			RestType:         shared.RestTypeLong,
+			AwaitingApproval: waiting,
+		}, nil
+	}
+
+	party, err := s.characterService.GetParty(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	slowNaturalHealing := sess.GetHouseRules().SlowNaturalHealing
+	ctx = charService.WithChange(ctx, userID, "long rest")
+	for _, char := range party {
+		char.LongRest(slowNaturalHealing)
+		s.featRegistry.LongRest(char)
+		if err := rpgtoolkit.EmitEvent(s.eventBus, rpgevents.EventOnLongRest, char, nil, nil); err != nil {
+			log.Printf("Failed to emit OnLongRest event for %s: %v", char.Name, err)
+		}
+		if err := s.characterService.UpdateEquipment(ctx, char); err != nil {
+			return nil, dnderr.Wrapf(err, "failed to save %s after resting", char.Name)
+		}
+	}
+
+	// A long rest ends any short rest in progress
+	changed := clearLongRestVotes(sess)
+	if sess.Metadata.Has(metadataShortRest) {
+		delete(sess.Metadata, metadataShortRest)
+		changed = true
+	}
+	if changed {
+		if err := s.sessionService.SaveSession(ctx, sess); err != nil {
+			return nil, dnderr.Wrap(err, "failed to save session")
+		}
+	}
+
+	return &Result{
+		SessionID:  sess.ID,
+		RestType:   shared.RestTypeLong,
+		Characters: party,
+	}, nil
+}
+
+// voteForLongRest records the user agreeing to a long rest and returns the
+// players who still have to. The DM calling the rest is approval enough.
+func voteForLongRest(sess *gameSession.Session, userID string) []string {
+	if sess.Members[userID].Role == gameSession.SessionRoleDM {
+		return nil
+	}
+
+	if sess.Metadata == nil {
+		sess.Metadata = make(shared.Metadata)
+	}
+	sess.Metadata[metadataLongRestVote+userID] = true
+
+	var waiting []string
+	for id, member := range sess.Members {
+		if member.Role != gameSession.SessionRolePlayer || member.CharacterID == "" {
+			continue
+		}
+		if !sess.Metadata.GetBoolOrDefault(metadataLongRestVote+id, false) {
+			waiting = append(waiting, id)
+		}
+	}
+	sort.Strings(waiting)
+	return waiting
+}
+
+// clearLongRestVotes forgets who agreed to the last long rest
+func clearLongRestVotes(sess *gameSession.Session) bool {
+	cleared := false
+	for key := range sess.Metadata {
+		if strings.HasPrefix(key, metadataLongRestVote) {
+			delete(sess.Metadata, key)
+			cleared = true
+		}
+	}
+	return cleared
+}
+
+// getRestingSession loads a session the user's party can rest in: the user
+// must be a member, and the party can't rest in the middle of a fight
+func (s *service) getRestingSession(ctx context.Context, sessionID, userID string) (*gameSession.Session, error) {
+	if sessionID == "" {
+		return nil, dnderr.InvalidArgument("session ID is required")
+	}
+
+	sess, err := s.sessionService.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get session '%s'", sessionID)
+	}
+	if _, exists := sess.Members[userID]; !exists {
+		return nil, dnderr.PermissionDenied("only party members can rest").
+			WithMeta("session_id", sessionID)
+	}
+	if sess.Status == gameSession.SessionStatusEnded {
+		return nil, dnderr.InvalidArgument("the session has ended")
+	}
+
+	if encounter, err := s.encounterService.GetActiveEncounter(ctx, sessionID); err == nil && encounter != nil {
+		return nil, dnderr.InvalidArgument("the party can't rest during combat")
+	}
+
+	return sess, nil
+}
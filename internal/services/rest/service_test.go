@@ -0,0 +1,314 @@
+package rest_test
+
+import (
+	"context"
+	"testing"
+
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e/feats"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	mockcharacter "github.com/KirkDiggler/dnd-bot-discord/internal/services/character/mock"
+	mockencounter "github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/rest"
+	mocksession "github.com/KirkDiggler/dnd-bot-discord/internal/services/session/mock"
+	rpgevents "github.com/KirkDiggler/rpg-toolkit/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testSessionID = "session_123"
+	testPlayerID  = "user_123"
+)
+
+type testDeps struct {
+	service    rest.Service
+	sessionSvc *mocksession.MockService
+	charSvc    *mockcharacter.MockService
+	encSvc     *mockencounter.MockService
+	roller     *mockdice.ManualMockRoller
+	eventBus   *rpgevents.Bus
+}
+
+func setup(t *testing.T) *testDeps {
+	ctrl := gomock.NewController(t)
+
+	deps := &testDeps{
+		sessionSvc: mocksession.NewMockService(ctrl),
+		charSvc:    mockcharacter.NewMockService(ctrl),
+		encSvc:     mockencounter.NewMockService(ctrl),
+		roller:     mockdice.NewManualMockRoller(),
+		eventBus:   rpgevents.NewBus(),
+	}
+	deps.service = rest.NewService(&rest.ServiceConfig{
+		SessionService:   deps.sessionSvc,
+		CharacterService: deps.charSvc,
+		EncounterService: deps.encSvc,
+		DiceRoller:       deps.roller,
+		EventBus:         deps.eventBus,
+	})
+	return deps
+}
+
+// createFighter returns a level 4 fighter with CON 14 and 10 of 36 HP
+func createFighter() *character.Character {
+	char := &character.Character{
+		ID:               "char_123",
+		OwnerID:          testPlayerID,
+		Name:             "Brienne",
+		Level:            4,
+		Class:            &rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10},
+		MaxHitPoints:     36,
+		CurrentHitPoints: 10,
+	}
+	char.AddAttribute(shared.AttributeConstitution, 14)
+	char.InitializeResources()
+	return char
+}
+
+func createSession(char *character.Character) *gameSession.Session {
+	return &gameSession.Session{
+		ID:     testSessionID,
+		Name:   "Lost Mine",
+		Status: gameSession.SessionStatusActive,
+		Members: map[string]*gameSession.SessionMember{
+			"dm_123":     {UserID: "dm_123", Role: gameSession.SessionRoleDM},
+			testPlayerID: {UserID: testPlayerID, Role: gameSession.SessionRolePlayer, CharacterID: char.ID},
+		},
+		Metadata: make(shared.Metadata),
+	}
+}
+
+func TestShortRest(t *testing.T) {
+	t.Run("starts a short rest for the party", func(t *testing.T) {
+		deps := setup(t)
+		fighter := createFighter()
+		fighter.Resources.Abilities["action-surge"] = &shared.ActiveAbility{
+			Key:      "action-surge",
+			RestType: shared.RestTypeShort,
+			UsesMax:  1,
+		}
+		sess := createSession(fighter)
+
+		deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil)
+		deps.encSvc.EXPECT().GetActiveEncounter(gomock.Any(), testSessionID).Return(nil, nil)
+		deps.charSvc.EXPECT().GetParty(sess).Return([]*character.Character{fighter}, nil)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), fighter).Return(nil)
+		deps.sessionSvc.EXPECT().SaveSession(gomock.Any(), sess).Return(nil)
+
+		result, err := deps.service.ShortRest(context.Background(), testSessionID, "dm_123")
+
+		require.NoError(t, err)
+		assert.Equal(t, shared.RestTypeShort, result.RestType)
+		require.Len(t, result.Characters, 1)
+		assert.Equal(t, 1, fighter.Resources.Abilities["action-surge"].UsesRemaining)
+		assert.Equal(t, 10, fighter.CurrentHitPoints, "hit points only come back by spending hit dice")
+		assert.True(t, sess.Metadata.GetBoolOrDefault("shortRest", false))
+	})
+
+	t.Run("can't rest during combat", func(t *testing.T) {
+		deps := setup(t)
+		sess := createSession(createFighter())
+
+		deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil)
+		deps.encSvc.EXPECT().GetActiveEncounter(gomock.Any(), testSessionID).
+			Return(&combat.Encounter{ID: "enc_123", Status: combat.EncounterStatusActive}, nil)
+
+		_, err := deps.service.ShortRest(context.Background(), testSessionID, testPlayerID)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "during combat")
+	})
+
+	t.Run("rejects users outside the session", func(t *testing.T) {
+		deps := setup(t)
+		sess := createSession(createFighter())
+
+		deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil)
+
+		_, err := deps.service.ShortRest(context.Background(), testSessionID, "stranger")
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodePermissionDenied))
+	})
+}
+
+func TestSpendHitDice(t *testing.T) {
+	t.Run("rolls hit dice with the Constitution modifier", func(t *testing.T) {
+		deps := setup(t)
+		fighter := createFighter()
+		sess := createSession(fighter)
+		sess.Metadata["shortRest"] = true
+
+		deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil)
+		deps.charSvc.EXPECT().GetByID(fighter.ID).Return(fighter, nil)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), fighter).Return(nil)
+		deps.roller.SetRolls([]int{6, 3})
+
+		result, err := deps.service.SpendHitDice(context.Background(), &rest.SpendHitDiceInput{
+			SessionID: testSessionID,
+			UserID:    testPlayerID,
+			Count:     2,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{6, 3}, result.Rolls)
+		assert.Equal(t, 2, result.Modifier)
+		assert.Equal(t, 13, result.Healed)
+		assert.Equal(t, 2, result.HitDiceLeft)
+		assert.Equal(t, 23, fighter.CurrentHitPoints)
+	})
+
+	t.Run("can't spend more hit dice than are left", func(t *testing.T) {
+		deps := setup(t)
+		fighter := createFighter()
+		fighter.Resources.HitDice[0].Remaining = 1
+		sess := createSession(fighter)
+		sess.Metadata["shortRest"] = true
+
+		deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil)
+		deps.charSvc.EXPECT().GetByID(fighter.ID).Return(fighter, nil)
+
+		_, err := deps.service.SpendHitDice(context.Background(), &rest.SpendHitDiceInput{
+			SessionID: testSessionID,
+			UserID:    testPlayerID,
+			Count:     2,
+		})
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument))
+		assert.Equal(t, 10, fighter.CurrentHitPoints)
+	})
+
+	t.Run("only during a short rest", func(t *testing.T) {
+		deps := setup(t)
+		sess := createSession(createFighter())
+
+		deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil)
+
+		_, err := deps.service.SpendHitDice(context.Background(), &rest.SpendHitDiceInput{
+			SessionID: testSessionID,
+			UserID:    testPlayerID,
+			Count:     1,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "during a short rest")
+	})
+}
+
+func TestEndShortRest(t *testing.T) {
+	deps := setup(t)
+	sess := createSession(createFighter())
+	sess.Metadata["shortRest"] = true
+
+	deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil)
+	deps.sessionSvc.EXPECT().SaveSession(gomock.Any(), sess).Return(nil)
+
+	err := deps.service.EndShortRest(context.Background(), testSessionID, testPlayerID)
+
+	require.NoError(t, err)
+	assert.False(t, sess.Metadata.Has("shortRest"))
+}
+
+func TestLongRest(t *testing.T) {
+	deps := setup(t)
+	fighter := createFighter()
+	fighter.Resources.HitDice[0].Remaining = 0
+	sess := createSession(fighter)
+	sess.Metadata["shortRest"] = true
+
+	var rested []string
+	deps.eventBus.SubscribeFunc(rpgevents.EventOnLongRest, 100, func(_ context.Context, event rpgevents.Event) error {
+		rested = append(rested, event.Source().GetID())
+		return nil
+	})
+
+	deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil)
+	deps.encSvc.EXPECT().GetActiveEncounter(gomock.Any(), testSessionID).Return(nil, nil)
+	deps.charSvc.EXPECT().GetParty(sess).Return([]*character.Character{fighter}, nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), fighter).Return(nil)
+	deps.sessionSvc.EXPECT().SaveSession(gomock.Any(), sess).Return(nil)
+
+	result, err := deps.service.LongRest(context.Background(), testSessionID, testPlayerID)
+
+	require.NoError(t, err)
+	assert.Equal(t, shared.RestTypeLong, result.RestType)
+	assert.Equal(t, 36, fighter.CurrentHitPoints)
+	assert.Equal(t, 2, fighter.Resources.HitDice.Remaining(), "half the hit dice come back")
+	assert.Equal(t, []string{fighter.ID}, rested, "OnLongRest subscribers like Lucky are triggered")
+	assert.False(t, sess.Metadata.Has("shortRest"))
+}
+
+func TestLongRest_RestoresLuckyWithoutAnEncounter(t *testing.T) {
+	deps := setup(t)
+	fighter := createFighter()
+	require.NoError(t, feats.GlobalRegistry.ApplyFeat("lucky", fighter, nil))
+	fighter.Features[len(fighter.Features)-1].Metadata["luck_points"] = 0
+	sess := createSession(fighter)
+
+	deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil)
+	deps.encSvc.EXPECT().GetActiveEncounter(gomock.Any(), testSessionID).Return(nil, nil)
+	deps.charSvc.EXPECT().GetParty(sess).Return([]*character.Character{fighter}, nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), fighter).Return(nil)
+	deps.sessionSvc.EXPECT().SaveSession(gomock.Any(), sess).Return(nil)
+
+	_, err := deps.service.LongRest(context.Background(), testSessionID, testPlayerID)
+
+	require.NoError(t, err)
+	lucky := fighter.Features[len(fighter.Features)-1]
+	require.Equal(t, "lucky", lucky.Key)
+	assert.Equal(t, 3, lucky.Metadata["luck_points"])
+}
+
+func TestLongRest_NeedsTheWholeParty(t *testing.T) {
+	deps := setup(t)
+	fighter := createFighter()
+	wizard := createFighter()
+	wizard.ID = "char_456"
+	wizard.OwnerID = "user_456"
+	sess := createSession(fighter)
+	sess.Members[wizard.OwnerID] = &gameSession.SessionMember{UserID: wizard.OwnerID, Role: gameSession.SessionRolePlayer, CharacterID: wizard.ID}
+
+	deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil).Times(2)
+	deps.encSvc.EXPECT().GetActiveEncounter(gomock.Any(), testSessionID).Return(nil, nil).Times(2)
+	deps.sessionSvc.EXPECT().SaveSession(gomock.Any(), sess).Return(nil).Times(2)
+
+	result, err := deps.service.LongRest(context.Background(), testSessionID, testPlayerID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{wizard.OwnerID}, result.AwaitingApproval)
+	assert.Empty(t, result.Characters)
+	assert.Equal(t, 10, fighter.CurrentHitPoints, "no one rests until everyone agrees")
+
+	deps.charSvc.EXPECT().GetParty(sess).Return([]*character.Character{fighter, wizard}, nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	result, err = deps.service.LongRest(context.Background(), testSessionID, wizard.OwnerID)
+	require.NoError(t, err)
+	assert.Empty(t, result.AwaitingApproval)
+	assert.Equal(t, 36, fighter.CurrentHitPoints)
+	assert.False(t, sess.Metadata.Has("longRestVote:"+testPlayerID), "votes are cleared after the rest")
+}
+
+func TestLongRest_DMCanCallIt(t *testing.T) {
+	deps := setup(t)
+	fighter := createFighter()
+	sess := createSession(fighter)
+
+	deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil)
+	deps.encSvc.EXPECT().GetActiveEncounter(gomock.Any(), testSessionID).Return(nil, nil)
+	deps.charSvc.EXPECT().GetParty(sess).Return([]*character.Character{fighter}, nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), fighter).Return(nil)
+
+	result, err := deps.service.LongRest(context.Background(), testSessionID, "dm_123")
+
+	require.NoError(t, err)
+	assert.Empty(t, result.AwaitingApproval)
+	assert.Equal(t, 36, fighter.CurrentHitPoints)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mock_service.go -package=mockrulebook -source=service.go
+//
+
+// Package mockrulebook is a generated GoMock package.
+package mockrulebook
+
+import (
+	context "context"
+	reflect "reflect"
+
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// GetBackground mocks base method.
+func (m *MockService) GetBackground(ctx context.Context, key string) (*rulebook.Background, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackground", ctx, key)
+	ret0, _ := ret[0].(*rulebook.Background)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBackground indicates an expected call of GetBackground.
+func (mr *MockServiceMockRecorder) GetBackground(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackground", reflect.TypeOf((*MockService)(nil).GetBackground), ctx, key)
+}
+
+// GetClass mocks base method.
+func (m *MockService) GetClass(ctx context.Context, key string) (*rulebook.Class, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClass", ctx, key)
+	ret0, _ := ret[0].(*rulebook.Class)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClass indicates an expected call of GetClass.
+func (mr *MockServiceMockRecorder) GetClass(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClass", reflect.TypeOf((*MockService)(nil).GetClass), ctx, key)
+}
+
+// GetRace mocks base method.
+func (m *MockService) GetRace(ctx context.Context, key string) (*rulebook.Race, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRace", ctx, key)
+	ret0, _ := ret[0].(*rulebook.Race)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRace indicates an expected call of GetRace.
+func (mr *MockServiceMockRecorder) GetRace(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRace", reflect.TypeOf((*MockService)(nil).GetRace), ctx, key)
+}
@@ -0,0 +1,117 @@
+// Package rulebook serves the SRD races, classes and backgrounds that
+// characters reference by key, caching them for the life of the process.
+package rulebook
+
+//go:generate mockgen -destination=mock/mock_service.go -package=mockrulebook -source=service.go
+
+import (
+	"context"
+	"sync"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/clients/dnd5e"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+)
+
+// Service looks up SRD rulebook data by key
+type Service interface {
+	// GetRace returns the race with the given key
+	GetRace(ctx context.Context, key string) (*rulebook.Race, error)
+
+	// GetClass returns the class with the given key
+	GetClass(ctx context.Context, key string) (*rulebook.Class, error)
+
+	// GetBackground returns the background with the given key
+	GetBackground(ctx context.Context, key string) (*rulebook.Background, error)
+}
+
+type service struct {
+	dndClient dnd5e.Client
+
+	mu      sync.RWMutex
+	races   map[string]*rulebook.Race
+	classes map[string]*rulebook.Class
+}
+
+// ServiceConfig holds configuration for the service
+type ServiceConfig struct {
+	DNDClient dnd5e.Client // Required
+}
+
+// NewService creates a new rulebook service
+func NewService(cfg *ServiceConfig) Service {
+	if cfg.DNDClient == nil {
+		panic("DND client is required")
+	}
+
+	return &service{
+		dndClient: cfg.DNDClient,
+		races:     make(map[string]*rulebook.Race),
+		classes:   make(map[string]*rulebook.Class),
+	}
+}
+
+// GetRace returns the race with the given key
+func (s *service) GetRace(ctx context.Context, key string) (*rulebook.Race, error) {
+	if key == "" {
+		return nil, dnderr.InvalidArgument("race key is required")
+	}
+
+	s.mu.RLock()
+	cached, ok := s.races[key]
+	s.mu.RUnlock()
+	if ok {
+		return cached, nil
+	}
+
+	race, err := s.dndClient.GetRace(key)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get race '%s'", key)
+	}
+
+	s.mu.Lock()
+	s.races[key] = race
+	s.mu.Unlock()
+
+	return race, nil
+}
+
+// GetClass returns the class with the given key
+func (s *service) GetClass(ctx context.Context, key string) (*rulebook.Class, error) {
+	if key == "" {
+		return nil, dnderr.InvalidArgument("class key is required")
+	}
+
+	s.mu.RLock()
+	cached, ok := s.classes[key]
+	s.mu.RUnlock()
+	if ok {
+		return cached, nil
+	}
+
+	class, err := s.dndClient.GetClass(key)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get class '%s'", key)
+	}
+
+	s.mu.Lock()
+	s.classes[key] = class
+	s.mu.Unlock()
+
+	return class, nil
+}
+
+// GetBackground returns the background with the given key
+func (s *service) GetBackground(ctx context.Context, key string) (*rulebook.Background, error) {
+	if key == "" {
+		return nil, dnderr.InvalidArgument("background key is required")
+	}
+
+	background := rulebook.GetSRDBackground(key)
+	if background == nil {
+		return nil, dnderr.NotFoundf("background '%s' not found", key).
+			WithMeta("background_key", key)
+	}
+
+	return background, nil
+}
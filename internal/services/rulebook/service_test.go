@@ -0,0 +1,61 @@
+package rulebook_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockdnd5e "github.com/KirkDiggler/dnd-bot-discord/internal/clients/dnd5e/mock"
+	rulebookDomain "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/rulebook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetClass_Caches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockdnd5e.NewMockClient(ctrl)
+	service := rulebook.NewService(&rulebook.ServiceConfig{DNDClient: client})
+
+	fighter := &rulebookDomain.Class{Key: "fighter", Name: "Fighter", HitDie: 10}
+	client.EXPECT().GetClass("fighter").Return(fighter, nil).Times(1)
+
+	for range 3 {
+		class, err := service.GetClass(context.Background(), "fighter")
+		require.NoError(t, err)
+		assert.Same(t, fighter, class)
+	}
+}
+
+func TestGetRace_ErrorsAreNotCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockdnd5e.NewMockClient(ctrl)
+	service := rulebook.NewService(&rulebook.ServiceConfig{DNDClient: client})
+
+	elf := &rulebookDomain.Race{Key: "elf", Name: "Elf"}
+	gomock.InOrder(
+		client.EXPECT().GetRace("elf").Return(nil, errors.New("api down")),
+		client.EXPECT().GetRace("elf").Return(elf, nil),
+	)
+
+	_, err := service.GetRace(context.Background(), "elf")
+	require.Error(t, err)
+
+	race, err := service.GetRace(context.Background(), "elf")
+	require.NoError(t, err)
+	assert.Same(t, elf, race)
+}
+
+func TestGetBackground(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := rulebook.NewService(&rulebook.ServiceConfig{DNDClient: mockdnd5e.NewMockClient(ctrl)})
+
+	background, err := service.GetBackground(context.Background(), "acolyte")
+	require.NoError(t, err)
+	assert.Equal(t, "Acolyte", background.Name)
+
+	_, err = service.GetBackground(context.Background(), "pirate-king")
+	assert.True(t, dnderr.Is(err, dnderr.CodeNotFound))
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	session "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	shared "github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	session0 "github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -289,6 +290,66 @@ func (mr *MockServiceMockRecorder) SelectCharacter(ctx, sessionID, userID, chara
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCharacter", reflect.TypeOf((*MockService)(nil).SelectCharacter), ctx, sessionID, userID, characterID)
 }
 
+// SetAbilityScoreMethod mocks base method.
+func (m *MockService) SetAbilityScoreMethod(ctx context.Context, sessionID, userID string, method shared.AbilityScoreMethod) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAbilityScoreMethod", ctx, sessionID, userID, method)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAbilityScoreMethod indicates an expected call of SetAbilityScoreMethod.
+func (mr *MockServiceMockRecorder) SetAbilityScoreMethod(ctx, sessionID, userID, method any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAbilityScoreMethod", reflect.TypeOf((*MockService)(nil).SetAbilityScoreMethod), ctx, sessionID, userID, method)
+}
+
+// SetHouseRule mocks base method.
+func (m *MockService) SetHouseRule(ctx context.Context, sessionID, userID string, rule session.HouseRule, enabled bool) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHouseRule", ctx, sessionID, userID, rule, enabled)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetHouseRule indicates an expected call of SetHouseRule.
+func (mr *MockServiceMockRecorder) SetHouseRule(ctx, sessionID, userID, rule, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHouseRule", reflect.TypeOf((*MockService)(nil).SetHouseRule), ctx, sessionID, userID, rule, enabled)
+}
+
+// SetShopCategory mocks base method.
+func (m *MockService) SetShopCategory(ctx context.Context, sessionID, userID, category string, stocked bool) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetShopCategory", ctx, sessionID, userID, category, stocked)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetShopCategory indicates an expected call of SetShopCategory.
+func (mr *MockServiceMockRecorder) SetShopCategory(ctx, sessionID, userID, category, stocked any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShopCategory", reflect.TypeOf((*MockService)(nil).SetShopCategory), ctx, sessionID, userID, category, stocked)
+}
+
+// SetShopOpen mocks base method.
+func (m *MockService) SetShopOpen(ctx context.Context, sessionID, userID string, open bool) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetShopOpen", ctx, sessionID, userID, open)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetShopOpen indicates an expected call of SetShopOpen.
+func (mr *MockServiceMockRecorder) SetShopOpen(ctx, sessionID, userID, open any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShopOpen", reflect.TypeOf((*MockService)(nil).SetShopOpen), ctx, sessionID, userID, open)
+}
+
 // StartSession mocks base method.
 func (m *MockService) StartSession(ctx context.Context, sessionID, userID string) error {
 	m.ctrl.T.Helper()
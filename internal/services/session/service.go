@@ -8,6 +8,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
 	"strings"
 	"time"
 
@@ -78,6 +79,18 @@ type Service interface {
 
 	// SaveSession saves a session to the repository
 	SaveSession(ctx context.Context, session *gameSession.Session) error
+
+	// SetHouseRule enables or disables a house rule (DM only)
+	SetHouseRule(ctx context.Context, sessionID, userID string, rule gameSession.HouseRule, enabled bool) (*gameSession.Session, error)
+
+	// SetAbilityScoreMethod forces how new characters generate ability scores (DM only)
+	SetAbilityScoreMethod(ctx context.Context, sessionID, userID string, method shared.AbilityScoreMethod) (*gameSession.Session, error)
+
+	// SetShopOpen opens or closes the session's merchant (DM only)
+	SetShopOpen(ctx context.Context, sessionID, userID string, open bool) (*gameSession.Session, error)
+
+	// SetShopCategory adds or removes a category from the merchant's stock (DM only)
+	SetShopCategory(ctx context.Context, sessionID, userID, category string, stocked bool) (*gameSession.Session, error)
 }
 
 // CreateSessionInput contains data for creating a session
@@ -699,3 +712,165 @@ func generateInviteCode() string {
 	}
 	return strings.ToUpper(hex.EncodeToString(b))
 }
+
+// SetHouseRule enables or disables a house rule for a session
+func (s *service) SetHouseRule(ctx context.Context, sessionID, userID string, rule gameSession.HouseRule, enabled bool) (*gameSession.Session, error) {
+	if strings.TrimSpace(sessionID) == "" {
+		return nil, dnderr.InvalidArgument("session ID is required")
+	}
+	if strings.TrimSpace(userID) == "" {
+		return nil, dnderr.InvalidArgument("user ID is required")
+	}
+
+	// Get session
+	session, err := s.repository.Get(ctx, sessionID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get session '%s'", sessionID).
+			WithMeta("session_id", sessionID)
+	}
+
+	// Check if user is DM
+	member, exists := session.Members[userID]
+	if !exists || member.Role != gameSession.SessionRoleDM {
+		return nil, dnderr.PermissionDenied("only the DM can change house rules").
+			WithMeta("user_id", userID).
+			WithMeta("session_id", sessionID)
+	}
+
+	if session.Settings == nil {
+		session.Settings = gameSession.DefaultSessionSettings()
+	}
+	if session.Settings.HouseRules == nil {
+		session.Settings.HouseRules = &gameSession.HouseRules{}
+	}
+
+	if !session.Settings.HouseRules.Set(rule, enabled) {
+		return nil, dnderr.InvalidArgument("unknown house rule").
+			WithMeta("rule", string(rule))
+	}
+
+	session.UpdateActivity()
+
+	// Save changes
+	if err := s.repository.Update(ctx, session); err != nil {
+		return nil, dnderr.Wrap(err, "failed to update session").
+			WithMeta("session_id", sessionID)
+	}
+
+	return session, nil
+}
+
+// SetShopOpen opens or closes the session's merchant
+func (s *service) SetShopOpen(ctx context.Context, sessionID, userID string, open bool) (*gameSession.Session, error) {
+	session, err := s.getShopSession(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	session.Settings.Shop.Open = open
+
+	return s.saveShop(ctx, session)
+}
+
+// SetShopCategory adds or removes a category from the merchant's stock
+func (s *service) SetShopCategory(ctx context.Context, sessionID, userID, category string, stocked bool) (*gameSession.Session, error) {
+	session, err := s.getShopSession(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.Settings.Shop.SetStocked(category, stocked) {
+		return nil, dnderr.InvalidArgument("the merchant must stock at least one known category").
+			WithMeta("category", category)
+	}
+
+	return s.saveShop(ctx, session)
+}
+
+// getShopSession loads a session for a DM changing its merchant
+func (s *service) getShopSession(ctx context.Context, sessionID, userID string) (*gameSession.Session, error) {
+	if strings.TrimSpace(sessionID) == "" {
+		return nil, dnderr.InvalidArgument("session ID is required")
+	}
+	if strings.TrimSpace(userID) == "" {
+		return nil, dnderr.InvalidArgument("user ID is required")
+	}
+
+	session, err := s.repository.Get(ctx, sessionID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get session '%s'", sessionID).
+			WithMeta("session_id", sessionID)
+	}
+
+	member, exists := session.Members[userID]
+	if !exists || member.Role != gameSession.SessionRoleDM {
+		return nil, dnderr.PermissionDenied("only the DM can run the shop").
+			WithMeta("user_id", userID).
+			WithMeta("session_id", sessionID)
+	}
+
+	if session.Settings == nil {
+		session.Settings = gameSession.DefaultSessionSettings()
+	}
+	if session.Settings.Shop == nil {
+		session.Settings.Shop = &gameSession.Shop{}
+	}
+
+	return session, nil
+}
+
+func (s *service) saveShop(ctx context.Context, session *gameSession.Session) (*gameSession.Session, error) {
+	session.UpdateActivity()
+
+	if err := s.repository.Update(ctx, session); err != nil {
+		return nil, dnderr.Wrap(err, "failed to update session").
+			WithMeta("session_id", session.ID)
+	}
+
+	return session, nil
+}
+
+// SetAbilityScoreMethod forces the ability score method for characters created
+// by the session's players. AbilityScoreMethodAny lets players choose.
+func (s *service) SetAbilityScoreMethod(ctx context.Context, sessionID, userID string, method shared.AbilityScoreMethod) (*gameSession.Session, error) {
+	if strings.TrimSpace(sessionID) == "" {
+		return nil, dnderr.InvalidArgument("session ID is required")
+	}
+	if strings.TrimSpace(userID) == "" {
+		return nil, dnderr.InvalidArgument("user ID is required")
+	}
+	if !method.IsValid() {
+		return nil, dnderr.InvalidArgument("unknown ability score method").
+			WithMeta("method", string(method))
+	}
+
+	// Get session
+	session, err := s.repository.Get(ctx, sessionID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get session '%s'", sessionID).
+			WithMeta("session_id", sessionID)
+	}
+
+	// Check if user is DM
+	member, exists := session.Members[userID]
+	if !exists || member.Role != gameSession.SessionRoleDM {
+		return nil, dnderr.PermissionDenied("only the DM can change the ability score method").
+			WithMeta("user_id", userID).
+			WithMeta("session_id", sessionID)
+	}
+
+	if session.Settings == nil {
+		session.Settings = gameSession.DefaultSessionSettings()
+	}
+	session.Settings.AbilityScoreMethod = method
+
+	session.UpdateActivity()
+
+	// Save changes
+	if err := s.repository.Update(ctx, session); err != nil {
+		return nil, dnderr.Wrap(err, "failed to update session").
+			WithMeta("session_id", sessionID)
+	}
+
+	return session, nil
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mock_service.go -package=mockshop -source=service.go
+//
+
+// Package mockshop is a generated GoMock package.
+package mockshop
+
+import (
+	context "context"
+	reflect "reflect"
+
+	character "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	shop "github.com/KirkDiggler/dnd-bot-discord/internal/services/shop"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Buy mocks base method.
+func (m *MockService) Buy(ctx context.Context, input *shop.BuyInput) (*shop.TradeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Buy", ctx, input)
+	ret0, _ := ret[0].(*shop.TradeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Buy indicates an expected call of Buy.
+func (mr *MockServiceMockRecorder) Buy(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Buy", reflect.TypeOf((*MockService)(nil).Buy), ctx, input)
+}
+
+// GetCustomer mocks base method.
+func (m *MockService) GetCustomer(ctx context.Context, sessionID, userID string) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomer", ctx, sessionID, userID)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomer indicates an expected call of GetCustomer.
+func (mr *MockServiceMockRecorder) GetCustomer(ctx, sessionID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomer", reflect.TypeOf((*MockService)(nil).GetCustomer), ctx, sessionID, userID)
+}
+
+// ListStock mocks base method.
+func (m *MockService) ListStock(ctx context.Context, sessionID, category string) ([]*shop.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStock", ctx, sessionID, category)
+	ret0, _ := ret[0].([]*shop.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStock indicates an expected call of ListStock.
+func (mr *MockServiceMockRecorder) ListStock(ctx, sessionID, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStock", reflect.TypeOf((*MockService)(nil).ListStock), ctx, sessionID, category)
+}
+
+// Sell mocks base method.
+func (m *MockService) Sell(ctx context.Context, input *shop.SellInput) (*shop.TradeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sell", ctx, input)
+	ret0, _ := ret[0].(*shop.TradeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sell indicates an expected call of Sell.
+func (mr *MockServiceMockRecorder) Sell(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sell", reflect.TypeOf((*MockService)(nil).Sell), ctx, input)
+}
@@ -0,0 +1,365 @@
+// Package shop runs the merchant players buy and sell equipment from.
+package shop
+
+//go:generate mockgen -destination=mock/mock_service.go -package=mockshop -source=service.go
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	sessService "github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+)
+
+// Service buys and sells equipment for the characters in a session
+type Service interface {
+	// ListStock returns what the session's merchant sells in a category,
+	// sorted by name
+	ListStock(ctx context.Context, sessionID, category string) ([]*Item, error)
+
+	// GetCustomer returns the character the user shops with in a session
+	GetCustomer(ctx context.Context, sessionID, userID string) (*character.Character, error)
+
+	// Buy pays for items and puts them in the character's inventory
+	Buy(ctx context.Context, input *BuyInput) (*TradeResult, error)
+
+	// Sell removes an item from the character's inventory for half its price
+	Sell(ctx context.Context, input *SellInput) (*TradeResult, error)
+}
+
+// Item is a piece of equipment the merchant sells
+type Item struct {
+	Equipment equipment.Equipment
+	Price     int // In copper pieces
+}
+
+// BuyInput describes a purchase
+type BuyInput struct {
+	SessionID string
+	UserID    string
+	Category  string
+	ItemKey   string
+	Quantity  int // Defaults to 1
+}
+
+// SellInput describes an item being sold
+type SellInput struct {
+	SessionID string
+	UserID    string
+	ItemKey   string
+}
+
+// TradeResult describes a completed purchase or sale
+type TradeResult struct {
+	Character *character.Character
+	Item      equipment.Equipment
+	Quantity  int
+	Price     int // Total paid or received, in copper pieces
+}
+
+// SellPrice is what the merchant pays for an item: half its price
+func SellPrice(item equipment.Equipment) int {
+	return equipment.Price(item) / 2
+}
+
+type service struct {
+	sessionService   sessService.Service
+	characterService charService.Service
+
+	// SRD equipment doesn't change, so each category is only fetched once
+	mu    sync.Mutex
+	stock map[string][]*Item
+}
+
+// ServiceConfig holds configuration for the shop service
+type ServiceConfig struct {
+	SessionService   sessService.Service // Required
+	CharacterService charService.Service // Required
+}
+
+// NewService creates a new shop service
+func NewService(cfg *ServiceConfig) Service {
+	if cfg.SessionService == nil {
+		panic("session service is required")
+	}
+	if cfg.CharacterService == nil {
+		panic("character service is required")
+	}
+
+	return &service{
+		sessionService:   cfg.SessionService,
+		characterService: cfg.CharacterService,
+		stock:            make(map[string][]*Item),
+	}
+}
+
+// ListStock returns what the session's merchant sells in a category
+func (s *service) ListStock(ctx context.Context, sessionID, category string) ([]*Item, error) {
+	sess, err := s.getOpenShop(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !sess.GetShop().Stocks(category) {
+		return nil, dnderr.InvalidArgumentf("the merchant doesn't sell %s", category).
+			WithMeta("category", category)
+	}
+
+	return s.getCategory(ctx, category)
+}
+
+// GetCustomer returns the character the user shops with in a session
+func (s *service) GetCustomer(ctx context.Context, sessionID, userID string) (*character.Character, error) {
+	sess, err := s.getOpenShop(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getCustomer(sess, userID)
+}
+
+// Buy pays for items and puts them in the character's inventory
+func (s *service) Buy(ctx context.Context, input *BuyInput) (*TradeResult, error) {
+	if input == nil {
+		return nil, dnderr.InvalidArgument("input cannot be nil")
+	}
+	quantity := max(input.Quantity, 1)
+
+	sess, err := s.getOpenShop(ctx, input.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !sess.GetShop().Stocks(input.Category) {
+		return nil, dnderr.InvalidArgumentf("the merchant doesn't sell %s", input.Category).
+			WithMeta("category", input.Category)
+	}
+
+	stock, err := s.getCategory(ctx, input.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	var item *Item
+	for _, stocked := range stock {
+		if stocked.Equipment.GetKey() == input.ItemKey {
+			item = stocked
+			break
+		}
+	}
+	if item == nil {
+		return nil, dnderr.NotFoundf("the merchant doesn't sell '%s'", input.ItemKey).
+			WithMeta("category", input.Category)
+	}
+
+	char, err := s.getCustomer(sess, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Characters can't buy more than they can carry
+	if load := char.Load() + equipment.Weight(item.Equipment)*float64(quantity); load > char.CarryingCapacity() {
+		return nil, dnderr.InvalidArgumentf("%s can't carry that much (%g/%g lb)",
+			char.Name, load, char.CarryingCapacity())
+	}
+
+	total := item.Price * quantity
+	if !char.Wallet.Spend(total) {
+		return nil, dnderr.InvalidArgumentf("%s costs %s but %s only has %s",
+			item.Equipment.GetName(), shared.FormatCopper(total), char.Name, char.Wallet)
+	}
+
+	// Each purchase gets its own copy so buyers don't share charges or stacks
+	for i := 0; i < quantity; i++ {
+		char.AddInventory(equipment.Clone(item.Equipment))
+	}
+
+	ctx = charService.WithChange(ctx, input.UserID, fmt.Sprintf("bought %d %s", quantity, item.Equipment.GetName()))
+	if err := s.characterService.UpdateEquipment(ctx, char); err != nil {
+		return nil, dnderr.Wrapf(err, "failed to save %s", char.Name)
+	}
+
+	return &TradeResult{
+		Character: char,
+		Item:      item.Equipment,
+		Quantity:  quantity,
+		Price:     total,
+	}, nil
+}
+
+// Sell removes one of an item from the character's inventory for half its
+// price. Equipped copies can't be sold.
+func (s *service) Sell(ctx context.Context, input *SellInput) (*TradeResult, error) {
+	if input == nil {
+		return nil, dnderr.InvalidArgument("input cannot be nil")
+	}
+
+	char, err := s.GetCustomer(ctx, input.SessionID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, equipType, index := findUnequipped(char, input.ItemKey)
+	if item == nil {
+		return nil, dnderr.NotFoundf("%s has no unequipped '%s' to sell", char.Name, input.ItemKey).
+			WithMeta("item_key", input.ItemKey)
+	}
+
+	price := SellPrice(item)
+	if price == 0 {
+		return nil, dnderr.InvalidArgumentf("the merchant won't buy %s", item.GetName())
+	}
+
+	// Stacks of consumables sell one at a time
+	if stack, ok := item.(*equipment.Consumable); ok && stack.Quantity > 1 {
+		item = char.ConsumeItem(stack.GetKey())
+	} else {
+		items := char.Inventory[equipType]
+		char.Inventory[equipType] = append(items[:index:index], items[index+1:]...)
+		if len(char.Inventory[equipType]) == 0 {
+			delete(char.Inventory, equipType)
+		}
+	}
+	char.Wallet.AddCopper(price)
+
+	ctx = charService.WithChange(ctx, input.UserID, fmt.Sprintf("sold %s", item.GetName()))
+	if err := s.characterService.UpdateEquipment(ctx, char); err != nil {
+		return nil, dnderr.Wrapf(err, "failed to save %s", char.Name)
+	}
+
+	return &TradeResult{
+		Character: char,
+		Item:      item,
+		Quantity:  1,
+		Price:     price,
+	}, nil
+}
+
+// getOpenShop loads a session whose merchant is open
+func (s *service) getOpenShop(ctx context.Context, sessionID string) (*gameSession.Session, error) {
+	if sessionID == "" {
+		return nil, dnderr.InvalidArgument("session ID is required")
+	}
+
+	sess, err := s.sessionService.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get session '%s'", sessionID)
+	}
+	if !sess.GetShop().Open {
+		return nil, dnderr.InvalidArgument("there's no merchant here right now").
+			WithMeta("session_id", sessionID)
+	}
+
+	return sess, nil
+}
+
+// getCustomer loads the user's character in the session
+func (s *service) getCustomer(sess *gameSession.Session, userID string) (*character.Character, error) {
+	member, exists := sess.Members[userID]
+	if !exists || member.CharacterID == "" {
+		return nil, dnderr.PermissionDenied("you don't have a character in this session").
+			WithMeta("session_id", sess.ID)
+	}
+
+	char, err := s.characterService.GetByID(member.CharacterID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", member.CharacterID)
+	}
+	return char, nil
+}
+
+// getCategory returns the priced equipment in a category
+func (s *service) getCategory(ctx context.Context, category string) ([]*Item, error) {
+	s.mu.Lock()
+	cached, exists := s.stock[category]
+	s.mu.Unlock()
+	if exists {
+		return cached, nil
+	}
+
+	equipmentList, err := s.characterService.GetEquipmentByCategory(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*Item
+	for _, eq := range equipmentList {
+		// Skip anything the SRD doesn't price
+		if price := equipment.Price(eq); price > 0 {
+			items = append(items, &Item{Equipment: eq, Price: price})
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Equipment.GetName() < items[j].Equipment.GetName()
+	})
+
+	s.mu.Lock()
+	s.stock[category] = items
+	s.mu.Unlock()
+
+	return items, nil
+}
+
+// Sellable returns one entry per item the character could sell, sorted by
+// name. Equipped copies and items the merchant won't buy are left out.
+func Sellable(char *character.Character) []*Item {
+	var items []*Item
+	seen := make(map[string]bool)
+	for _, inventory := range char.Inventory {
+		for _, item := range inventory {
+			if item == nil || seen[item.GetKey()] {
+				continue
+			}
+			seen[item.GetKey()] = true
+
+			price := SellPrice(item)
+			if price == 0 {
+				continue
+			}
+			if found, _, _ := findUnequipped(char, item.GetKey()); found != nil {
+				items = append(items, &Item{Equipment: item, Price: price})
+			}
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Equipment.GetName() < items[j].Equipment.GetName()
+	})
+	return items
+}
+
+// findUnequipped finds the last inventory copy of an item, as long as the
+// character holds more copies than they have equipped
+func findUnequipped(char *character.Character, itemKey string) (equipment.Equipment, equipment.EquipmentType, int) {
+	equipped := 0
+	for _, item := range char.EquippedSlots {
+		if item != nil && item.GetKey() == itemKey {
+			equipped++
+		}
+	}
+
+	var (
+		found     equipment.Equipment
+		foundType equipment.EquipmentType
+		index     = -1
+		owned     int
+	)
+	for equipType, items := range char.Inventory {
+		for i, item := range items {
+			if item == nil || item.GetKey() != itemKey {
+				continue
+			}
+			owned++
+			found, foundType, index = item, equipType, i
+		}
+	}
+
+	if owned <= equipped {
+		return nil, "", -1
+	}
+	return found, foundType, index
+}
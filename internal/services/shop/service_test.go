@@ -0,0 +1,286 @@
+package shop_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	mockcharacter "github.com/KirkDiggler/dnd-bot-discord/internal/services/character/mock"
+	mocksession "github.com/KirkDiggler/dnd-bot-discord/internal/services/session/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/shop"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testSessionID = "session_123"
+	testUserID    = "user_1"
+)
+
+type testDeps struct {
+	service    shop.Service
+	sessionSvc *mocksession.MockService
+	charSvc    *mockcharacter.MockService
+}
+
+func setup(t *testing.T) *testDeps {
+	ctrl := gomock.NewController(t)
+
+	deps := &testDeps{
+		sessionSvc: mocksession.NewMockService(ctrl),
+		charSvc:    mockcharacter.NewMockService(ctrl),
+	}
+	deps.service = shop.NewService(&shop.ServiceConfig{
+		SessionService:   deps.sessionSvc,
+		CharacterService: deps.charSvc,
+	})
+	return deps
+}
+
+// setupShop creates a session with an open merchant and the character as its
+// only player
+func setupShop(deps *testDeps, char *character.Character) *gameSession.Session {
+	sess := &gameSession.Session{
+		ID:     testSessionID,
+		Status: gameSession.SessionStatusActive,
+		Members: map[string]*gameSession.SessionMember{
+			char.OwnerID: {UserID: char.OwnerID, Role: gameSession.SessionRolePlayer, CharacterID: char.ID},
+		},
+	}
+	sess.OpenShop()
+
+	deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil).AnyTimes()
+	deps.charSvc.EXPECT().GetByID(char.ID).Return(char, nil).AnyTimes()
+	deps.charSvc.EXPECT().GetEquipmentByCategory(gomock.Any(), "simple-weapons").Return([]equipment.Equipment{
+		newWeapon("dagger", "Dagger", 2, "gp"),
+		newWeapon("club", "Club", 1, "sp"),
+		newWeapon("unpriced", "Unpriced", 0, "gp"),
+	}, nil).AnyTimes()
+	return sess
+}
+
+func newWeapon(key, name string, quantity int, unit string) *equipment.Weapon {
+	return &equipment.Weapon{
+		Base: equipment.BasicEquipment{
+			Key:  key,
+			Name: name,
+			Cost: &shared.Cost{Quantity: quantity, Unit: unit},
+		},
+	}
+}
+
+func newCharacter(coins shared.Wallet) *character.Character {
+	return &character.Character{
+		ID:      "char_1",
+		OwnerID: testUserID,
+		Name:    "Alice",
+		Wallet:  coins,
+	}
+}
+
+func TestListStock(t *testing.T) {
+	deps := setup(t)
+	setupShop(deps, newCharacter(shared.Wallet{}))
+
+	items, err := deps.service.ListStock(context.Background(), testSessionID, "simple-weapons")
+
+	require.NoError(t, err)
+	require.Len(t, items, 2, "unpriced items aren't for sale")
+	assert.Equal(t, "Club", items[0].Equipment.GetName())
+	assert.Equal(t, 10, items[0].Price)
+	assert.Equal(t, "Dagger", items[1].Equipment.GetName())
+	assert.Equal(t, 200, items[1].Price)
+}
+
+func TestBuy(t *testing.T) {
+	t.Run("pays for the item and adds it to the inventory", func(t *testing.T) {
+		deps := setup(t)
+		char := newCharacter(shared.Wallet{Gold: 5})
+		setupShop(deps, char)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), char).Return(nil)
+
+		result, err := deps.service.Buy(context.Background(), &shop.BuyInput{
+			SessionID: testSessionID,
+			UserID:    testUserID,
+			Category:  "simple-weapons",
+			ItemKey:   "dagger",
+			Quantity:  2,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 400, result.Price)
+		assert.Equal(t, shared.Wallet{Gold: 1}, char.Wallet)
+		assert.Len(t, char.Inventory[equipment.EquipmentTypeWeapon], 2)
+	})
+
+	t.Run("every purchase is a separate copy", func(t *testing.T) {
+		deps := setup(t)
+		char := newCharacter(shared.Wallet{Gold: 5})
+		setupShop(deps, char)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), char).Return(nil)
+
+		_, err := deps.service.Buy(context.Background(), &shop.BuyInput{
+			SessionID: testSessionID,
+			UserID:    testUserID,
+			Category:  "simple-weapons",
+			ItemKey:   "dagger",
+			Quantity:  2,
+		})
+		require.NoError(t, err)
+
+		weapons := char.Inventory[equipment.EquipmentTypeWeapon]
+		require.Len(t, weapons, 2)
+		weapons[0].(*equipment.Weapon).Base.Name = "Notched Dagger"
+		assert.Equal(t, "Dagger", weapons[1].GetName())
+
+		stock, err := deps.service.ListStock(context.Background(), testSessionID, "simple-weapons")
+		require.NoError(t, err)
+		assert.Equal(t, "Dagger", stock[1].Equipment.GetName(), "the merchant's stock is untouched")
+	})
+
+	t.Run("can't buy what the character can't afford", func(t *testing.T) {
+		deps := setup(t)
+		char := newCharacter(shared.Wallet{Gold: 1})
+		setupShop(deps, char)
+
+		_, err := deps.service.Buy(context.Background(), &shop.BuyInput{
+			SessionID: testSessionID,
+			UserID:    testUserID,
+			Category:  "simple-weapons",
+			ItemKey:   "dagger",
+		})
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument))
+		assert.Equal(t, shared.Wallet{Gold: 1}, char.Wallet)
+		assert.Empty(t, char.Inventory)
+	})
+
+	t.Run("can't buy from a closed shop", func(t *testing.T) {
+		deps := setup(t)
+		char := newCharacter(shared.Wallet{Gold: 5})
+		sess := setupShop(deps, char)
+		sess.Settings.Shop.Open = false
+
+		_, err := deps.service.Buy(context.Background(), &shop.BuyInput{
+			SessionID: testSessionID,
+			UserID:    testUserID,
+			Category:  "simple-weapons",
+			ItemKey:   "dagger",
+		})
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument))
+	})
+
+	t.Run("can't buy from a category the merchant doesn't stock", func(t *testing.T) {
+		deps := setup(t)
+		char := newCharacter(shared.Wallet{Gold: 5})
+		sess := setupShop(deps, char)
+		sess.Settings.Shop.Categories = []string{"tools"}
+
+		_, err := deps.service.Buy(context.Background(), &shop.BuyInput{
+			SessionID: testSessionID,
+			UserID:    testUserID,
+			Category:  "simple-weapons",
+			ItemKey:   "dagger",
+		})
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument))
+	})
+}
+
+func TestSell(t *testing.T) {
+	t.Run("sells for half price", func(t *testing.T) {
+		deps := setup(t)
+		char := newCharacter(shared.Wallet{})
+		char.AddInventory(newWeapon("dagger", "Dagger", 2, "gp"))
+		setupShop(deps, char)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), char).Return(nil)
+
+		result, err := deps.service.Sell(context.Background(), &shop.SellInput{
+			SessionID: testSessionID,
+			UserID:    testUserID,
+			ItemKey:   "dagger",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 100, result.Price)
+		assert.Equal(t, shared.Wallet{Gold: 1}, char.Wallet)
+		assert.Empty(t, char.Inventory)
+	})
+
+	t.Run("sells one of a stack", func(t *testing.T) {
+		deps := setup(t)
+		char := newCharacter(shared.Wallet{})
+		char.AddInventory(equipment.NewSRDConsumable("potion-of-healing", 3))
+		setupShop(deps, char)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), char).Return(nil)
+
+		result, err := deps.service.Sell(context.Background(), &shop.SellInput{
+			SessionID: testSessionID,
+			UserID:    testUserID,
+			ItemKey:   "potion-of-healing",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2500, result.Price)
+		assert.Equal(t, 1, result.Item.(*equipment.Consumable).Quantity)
+		assert.Equal(t, 2, char.ConsumableCount("potion-of-healing"))
+	})
+
+	t.Run("equipped items can't be sold", func(t *testing.T) {
+		deps := setup(t)
+		char := newCharacter(shared.Wallet{})
+		dagger := newWeapon("dagger", "Dagger", 2, "gp")
+		char.AddInventory(dagger)
+		char.EquippedSlots = map[shared.Slot]equipment.Equipment{shared.SlotMainHand: dagger}
+		setupShop(deps, char)
+
+		_, err := deps.service.Sell(context.Background(), &shop.SellInput{
+			SessionID: testSessionID,
+			UserID:    testUserID,
+			ItemKey:   "dagger",
+		})
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodeNotFound))
+		assert.Empty(t, shop.Sellable(char))
+	})
+}
+
+func TestBuy_CarryingCapacity(t *testing.T) {
+	deps := setup(t)
+	char := newCharacter(shared.Wallet{Gold: 100})
+	char.Attributes = map[shared.Attribute]*character.AbilityScore{
+		shared.AttributeStrength: {Score: 1}, // Carries 15 lb
+	}
+	char.AddInventory(&equipment.BasicEquipment{Key: "bedroll", Name: "Bedroll", Weight: 7})
+	sess := setupShop(deps, char)
+	sess.Settings.Shop.Categories = []string{"heavy-armor"}
+	deps.charSvc.EXPECT().GetEquipmentByCategory(gomock.Any(), "heavy-armor").Return([]equipment.Equipment{
+		&equipment.Armor{Base: equipment.BasicEquipment{
+			Key:    "chain-mail",
+			Name:   "Chain Mail",
+			Cost:   &shared.Cost{Quantity: 75, Unit: "gp"},
+			Weight: 55,
+		}},
+	}, nil)
+
+	_, err := deps.service.Buy(context.Background(), &shop.BuyInput{
+		SessionID: testSessionID,
+		UserID:    testUserID,
+		Category:  "heavy-armor",
+		ItemKey:   "chain-mail",
+	})
+
+	require.Error(t, err)
+	assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument))
+	assert.Equal(t, shared.Wallet{Gold: 100}, char.Wallet)
+}
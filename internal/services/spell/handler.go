@@ -0,0 +1,109 @@
+package spell
+
+import (
+	"context"
+	"sync"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+)
+
+// Handler resolves a spell that the generic resolver can't.
+// Handlers only compute outcomes; the service consumes the slot and
+// applies damage and effects to the encounter.
+type Handler interface {
+	// Key returns the spell key this handler resolves (e.g., "magic-missile")
+	Key() string
+
+	// Resolve computes the spell's outcome for each target
+	Resolve(ctx context.Context, cast *Cast) (*CastSpellResult, error)
+}
+
+// Healer is implemented by handlers of healing spells. Their targets may be
+// at 0 hit points, so a downed ally can be brought back into the fight.
+type Healer interface {
+	Heals() bool
+}
+
+// Cast holds everything needed to resolve a single cast
+type Cast struct {
+	Caster    *character.Character
+	Spell     *rulebook.Spell
+	SlotLevel int // 0 for cantrips
+	Encounter *combat.Encounter
+	Targets   []*combat.Combatant
+	Roller    dice.Roller
+
+	// VariantEncumbrance gives heavily loaded players disadvantage on STR,
+	// DEX and CON saves
+	VariantEncumbrance bool
+
+	characters characterGetter
+}
+
+// characterGetter looks up player characters for saving throws
+type characterGetter interface {
+	GetByID(characterID string) (*character.Character, error)
+}
+
+// SavingThrowBonus returns a target's saving throw bonus. Players use their
+// character sheet; monsters use their ability modifier.
+func (c *Cast) SavingThrowBonus(target *combat.Combatant, attribute shared.Attribute) int {
+	if target.CharacterID != "" && c.characters != nil {
+		if char, err := c.characters.GetByID(target.CharacterID); err == nil && char != nil {
+			return char.GetSavingThrowBonus(attribute)
+		}
+	}
+
+	score, ok := target.Abilities[monsterAbilityKey(attribute)]
+	if !ok {
+		return 0
+	}
+	return abilityModifier(score)
+}
+
+// SaveDisadvantage checks if a target rolls the saving throw with
+// disadvantage. Heavily loaded players do under variant encumbrance.
+func (c *Cast) SaveDisadvantage(target *combat.Combatant, attribute shared.Attribute) bool {
+	if !c.VariantEncumbrance || target.CharacterID == "" || c.characters == nil {
+		return false
+	}
+	char, err := c.characters.GetByID(target.CharacterID)
+	if err != nil || char == nil {
+		return false
+	}
+	return char.EncumbranceDisadvantageOn(attribute, true)
+}
+
+// HandlerRegistry manages spell handlers
+type HandlerRegistry struct {
+	mu       sync.RWMutex
+	handlers map[string]Handler
+}
+
+// NewHandlerRegistry creates a new handler registry
+func NewHandlerRegistry() *HandlerRegistry {
+	return &HandlerRegistry{
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Register adds a handler to the registry
+func (r *HandlerRegistry) Register(handler Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.handlers[handler.Key()] = handler
+}
+
+// Get retrieves a handler by spell key
+func (r *HandlerRegistry) Get(key string) (Handler, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	handler, exists := r.handlers[key]
+	return handler, exists
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: types.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mock_service.go -package=mockspell -source=types.go
+//
+
+// Package mockspell is a generated GoMock package.
+package mockspell
+
+import (
+	context "context"
+	reflect "reflect"
+
+	spell "github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// CastSpell mocks base method.
+func (m *MockService) CastSpell(ctx context.Context, input *spell.CastSpellInput) (*spell.CastSpellResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CastSpell", ctx, input)
+	ret0, _ := ret[0].(*spell.CastSpellResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CastSpell indicates an expected call of CastSpell.
+func (mr *MockServiceMockRecorder) CastSpell(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CastSpell", reflect.TypeOf((*MockService)(nil).CastSpell), ctx, input)
+}
+
+// CopyToSpellbook mocks base method.
+func (m *MockService) CopyToSpellbook(ctx context.Context, input *spell.CopySpellInput) (*spell.CopySpellResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyToSpellbook", ctx, input)
+	ret0, _ := ret[0].(*spell.CopySpellResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyToSpellbook indicates an expected call of CopyToSpellbook.
+func (mr *MockServiceMockRecorder) CopyToSpellbook(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyToSpellbook", reflect.TypeOf((*MockService)(nil).CopyToSpellbook), ctx, input)
+}
+
+// FinishPreparation mocks base method.
+func (m *MockService) FinishPreparation(ctx context.Context, characterID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishPreparation", ctx, characterID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishPreparation indicates an expected call of FinishPreparation.
+func (mr *MockServiceMockRecorder) FinishPreparation(ctx, characterID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishPreparation", reflect.TypeOf((*MockService)(nil).FinishPreparation), ctx, characterID, userID)
+}
+
+// PrepareSpells mocks base method.
+func (m *MockService) PrepareSpells(ctx context.Context, input *spell.PrepareSpellsInput) (*spell.PreparationOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareSpells", ctx, input)
+	ret0, _ := ret[0].(*spell.PreparationOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareSpells indicates an expected call of PrepareSpells.
+func (mr *MockServiceMockRecorder) PrepareSpells(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareSpells", reflect.TypeOf((*MockService)(nil).PrepareSpells), ctx, input)
+}
+
+// RegisterHandler mocks base method.
+func (m *MockService) RegisterHandler(handler spell.Handler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterHandler", handler)
+}
+
+// RegisterHandler indicates an expected call of RegisterHandler.
+func (mr *MockServiceMockRecorder) RegisterHandler(handler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterHandler", reflect.TypeOf((*MockService)(nil).RegisterHandler), handler)
+}
+
+// StartPreparation mocks base method.
+func (m *MockService) StartPreparation(ctx context.Context, characterID, userID string) (*spell.PreparationOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPreparation", ctx, characterID, userID)
+	ret0, _ := ret[0].(*spell.PreparationOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartPreparation indicates an expected call of StartPreparation.
+func (mr *MockServiceMockRecorder) StartPreparation(ctx, characterID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPreparation", reflect.TypeOf((*MockService)(nil).StartPreparation), ctx, characterID, userID)
+}
@@ -0,0 +1,243 @@
+package spell
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+)
+
+// StartPreparation lists the spells a prepared caster can choose from. Only
+// allowed after a long rest, or for a new character, until they finish.
+func (s *service) StartPreparation(ctx context.Context, characterID, userID string) (*PreparationOptions, error) {
+	caster, err := s.getOwnedCharacter(characterID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !caster.PreparesSpells() {
+		return nil, dnderr.InvalidArgumentf("%s doesn't prepare spells", caster.Name)
+	}
+	if !caster.CanPrepareSpells() {
+		return nil, dnderr.InvalidArgumentf("%s can change their prepared spells after a long rest", caster.Name)
+	}
+
+	return s.preparationOptions(ctx, caster)
+}
+
+// PrepareSpells replaces the caster's prepared spells of one spell level
+func (s *service) PrepareSpells(ctx context.Context, input *PrepareSpellsInput) (*PreparationOptions, error) {
+	if input == nil {
+		return nil, dnderr.InvalidArgument("input cannot be nil")
+	}
+
+	caster, err := s.getOwnedCharacter(input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if caster.Spells == nil || !caster.Spells.CanChangePrepared {
+		return nil, dnderr.InvalidArgumentf("%s can change their prepared spells after a long rest", caster.Name)
+	}
+
+	options, err := s.preparationOptions(ctx, caster)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[string]bool, len(options.Spells[input.SpellLevel]))
+	for _, ref := range options.Spells[input.SpellLevel] {
+		available[ref.Key] = true
+	}
+
+	// Keep prepared spells of other levels
+	prepared := make([]string, 0, len(options.Prepared)+len(input.SpellKeys))
+	for _, key := range options.Prepared {
+		if !available[key] {
+			prepared = append(prepared, key)
+		}
+	}
+	for _, key := range input.SpellKeys {
+		if !available[key] {
+			return nil, dnderr.InvalidArgumentf("%s can't prepare '%s' as a level %d spell", caster.Name, key, input.SpellLevel).
+				WithMeta("spell_key", key)
+		}
+		if !slices.Contains(prepared, key) {
+			prepared = append(prepared, key)
+		}
+	}
+	if len(prepared) > options.MaxPrepared {
+		return nil, dnderr.InvalidArgumentf("%s can only prepare %d spells", caster.Name, options.MaxPrepared).
+			WithMeta("requested", len(prepared))
+	}
+
+	caster.Spells.PreparedSpells = prepared
+	ctx = charService.WithChange(ctx, input.UserID, fmt.Sprintf("prepared level %d spells", input.SpellLevel))
+	if err := s.characterService.UpdateEquipment(ctx, caster); err != nil {
+		return nil, dnderr.Wrap(err, "failed to save prepared spells")
+	}
+
+	options.Prepared = prepared
+	return options, nil
+}
+
+// FinishPreparation locks in the prepared spells until the next long rest
+func (s *service) FinishPreparation(ctx context.Context, characterID, userID string) error {
+	caster, err := s.getOwnedCharacter(characterID, userID)
+	if err != nil {
+		return err
+	}
+	if caster.Spells == nil || !caster.Spells.CanChangePrepared {
+		return nil
+	}
+
+	caster.Spells.CanChangePrepared = false
+	if err := s.characterService.UpdateEquipment(charService.WithChange(ctx, userID, "finished preparing spells"), caster); err != nil {
+		return dnderr.Wrap(err, "failed to save character")
+	}
+	return nil
+}
+
+// CopyToSpellbook copies a spell into a wizard's spellbook, paying the gold
+// it costs from their wallet. The hours it takes are returned.
+func (s *service) CopyToSpellbook(ctx context.Context, input *CopySpellInput) (*CopySpellResult, error) {
+	if input == nil {
+		return nil, dnderr.InvalidArgument("input cannot be nil")
+	}
+	if input.SpellKey == "" {
+		return nil, dnderr.InvalidArgument("spell key is required")
+	}
+
+	caster, err := s.getOwnedCharacter(input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	wizardLevel := caster.LevelInClass("wizard")
+	if wizardLevel == 0 {
+		return nil, dnderr.InvalidArgumentf("only wizards keep a spellbook")
+	}
+	if caster.InSpellbook(input.SpellKey) {
+		return nil, dnderr.InvalidArgumentf("%s is already in %s's spellbook", input.SpellKey, caster.Name)
+	}
+
+	spell, err := s.characterService.GetSpell(ctx, input.SpellKey)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get spell '%s'", input.SpellKey)
+	}
+	if spell.Level == 0 {
+		return nil, dnderr.InvalidArgumentf("cantrips can't be copied into a spellbook")
+	}
+	if !hasClass(spell, "wizard") {
+		return nil, dnderr.InvalidArgumentf("%s is not a wizard spell", spell.Name)
+	}
+	if maxLevel := rulebook.MaxSpellLevel("wizard", caster.SubclassKeyFor("wizard"), wizardLevel); spell.Level > maxLevel {
+		return nil, dnderr.InvalidArgumentf("%s can't copy level %d spells yet", caster.Name, spell.Level).
+			WithMeta("max_level", maxLevel)
+	}
+
+	gold, hours := rulebook.SpellbookCopyCost(spell.Level)
+	if !caster.Wallet.Spend(gold * shared.CoinGold.Value()) {
+		return nil, dnderr.InvalidArgumentf("copying %s costs %d gp but %s only has %s", spell.Name, gold, caster.Name, caster.Wallet).
+			WithMeta("gold_cost", gold)
+	}
+
+	caster.AddKnownSpell(spell.Key)
+	ctx = charService.WithChange(ctx, input.UserID, fmt.Sprintf("copied %s into the spellbook", spell.Name))
+	if err := s.characterService.UpdateEquipment(ctx, caster); err != nil {
+		return nil, dnderr.Wrap(err, "failed to save spellbook")
+	}
+
+	return &CopySpellResult{
+		SpellName: spell.Name,
+		GoldCost:  gold,
+		Hours:     hours,
+	}, nil
+}
+
+// preparationOptions lists what the caster can prepare. Wizards prepare from
+// their spellbook; other classes from their whole class list. A multiclass
+// caster prepares each class's spells up to the level that class can cast.
+func (s *service) preparationOptions(ctx context.Context, caster *character.Character) (*PreparationOptions, error) {
+	options := &PreparationOptions{
+		CharacterID: caster.ID,
+		MaxPrepared: caster.MaxPreparedSpells(),
+		Spells:      make(map[int][]*rulebook.SpellReference),
+	}
+	if caster.Spells != nil {
+		options.Prepared = slices.Clone(caster.Spells.PreparedSpells)
+	}
+
+	seen := make(map[string]bool)
+	add := func(level int, ref *rulebook.SpellReference) {
+		if !seen[ref.Key] {
+			seen[ref.Key] = true
+			options.Spells[level] = append(options.Spells[level], ref)
+		}
+	}
+
+	for _, classLevel := range caster.ClassLevels() {
+		classKey := classLevel.Class.Key
+		if !rulebook.PreparesSpells(classKey) {
+			continue
+		}
+		maxLevel := rulebook.MaxSpellLevel(classKey, caster.SubclassKeyFor(classKey), classLevel.Level)
+
+		if classKey == "wizard" {
+			if caster.Spells == nil {
+				continue
+			}
+			for _, key := range caster.Spells.KnownSpells {
+				spell, err := s.characterService.GetSpell(ctx, key)
+				if err != nil {
+					return nil, dnderr.Wrapf(err, "failed to get spell '%s'", key)
+				}
+				if spell.Level == 0 || spell.Level > maxLevel || (caster.IsMulticlass() && !hasClass(spell, "wizard")) {
+					continue
+				}
+				add(spell.Level, &rulebook.SpellReference{Key: spell.Key, Name: spell.Name})
+			}
+			continue
+		}
+
+		for level := 1; level <= maxLevel; level++ {
+			refs, err := s.characterService.ListSpellsByClassAndLevel(ctx, classKey, level)
+			if err != nil {
+				return nil, dnderr.Wrapf(err, "failed to list level %d spells", level)
+			}
+			for _, ref := range refs {
+				add(level, ref)
+			}
+		}
+	}
+	return options, nil
+}
+
+// getOwnedCharacter loads a character and checks the user owns it
+func (s *service) getOwnedCharacter(characterID, userID string) (*character.Character, error) {
+	if characterID == "" {
+		return nil, dnderr.InvalidArgument("character ID is required")
+	}
+
+	char, err := s.characterService.GetByID(characterID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", characterID)
+	}
+	if userID != "" && char.OwnerID != userID {
+		return nil, dnderr.PermissionDenied("only the character's owner can manage their spells").
+			WithMeta("character_id", characterID)
+	}
+	return char, nil
+}
+
+func hasClass(spell *rulebook.Spell, classKey string) bool {
+	for _, class := range spell.Classes {
+		if strings.EqualFold(class, classKey) {
+			return true
+		}
+	}
+	return false
+}
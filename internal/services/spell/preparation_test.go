@@ -0,0 +1,373 @@
+package spell
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	burningHands = &rulebook.Spell{Key: "burning-hands", Name: "Burning Hands", Level: 1, Classes: []string{"Sorcerer", "Wizard"}}
+	detectMagic  = &rulebook.Spell{Key: "detect-magic", Name: "Detect Magic", Level: 1, Ritual: true, Classes: []string{"Cleric", "Wizard"}}
+	cureWounds   = &rulebook.Spell{Key: "cure-wounds", Name: "Cure Wounds", Level: 1, Classes: []string{"Cleric"}}
+)
+
+// createCleric returns a level 3 cleric with WIS 14 who can prepare 5 spells
+func createCleric() *character.Character {
+	char := &character.Character{
+		ID:      "char_456",
+		OwnerID: testOwner,
+		Name:    "Tomas",
+		Level:   3,
+		Class:   &rulebook.Class{Key: "cleric", Name: "Cleric", HitDie: 8},
+		Spells: &character.SpellList{
+			PreparedSpells: []string{"bless"},
+		},
+	}
+	char.AddAttribute(shared.AttributeWisdom, 14)
+	char.InitializeResources()
+	return char
+}
+
+func clericSpells() map[int][]*rulebook.SpellReference {
+	return map[int][]*rulebook.SpellReference{
+		1: {
+			{Key: "bless", Name: "Bless"},
+			{Key: "cure-wounds", Name: "Cure Wounds"},
+			{Key: "guiding-bolt", Name: "Guiding Bolt"},
+			{Key: "healing-word", Name: "Healing Word"},
+			{Key: "sanctuary", Name: "Sanctuary"},
+		},
+		2: {
+			{Key: "aid", Name: "Aid"},
+			{Key: "spiritual-weapon", Name: "Spiritual Weapon"},
+		},
+	}
+}
+
+func expectClericSpells(deps *testDeps) {
+	for level, refs := range clericSpells() {
+		deps.charSvc.EXPECT().ListSpellsByClassAndLevel(gomock.Any(), "cleric", level).Return(refs, nil).AnyTimes()
+	}
+}
+
+func TestStartPreparation(t *testing.T) {
+	t.Run("requires a long rest once spells are prepared", func(t *testing.T) {
+		deps := setup(t)
+		cleric := createCleric()
+		deps.charSvc.EXPECT().GetByID(cleric.ID).Return(cleric, nil)
+
+		_, err := deps.service.StartPreparation(context.Background(), cleric.ID, testOwner)
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument))
+		assert.Contains(t, err.Error(), "after a long rest")
+	})
+
+	t.Run("lists class spells after a long rest", func(t *testing.T) {
+		deps := setup(t)
+		cleric := createCleric()
+		cleric.LongRest(false)
+		deps.charSvc.EXPECT().GetByID(cleric.ID).Return(cleric, nil)
+		expectClericSpells(deps)
+
+		options, err := deps.service.StartPreparation(context.Background(), cleric.ID, testOwner)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, options.MaxPrepared)
+		assert.Equal(t, []int{1, 2}, options.Levels())
+		assert.Equal(t, []string{"bless"}, options.PreparedAtLevel(1))
+		assert.True(t, cleric.Spells.CanChangePrepared)
+	})
+
+	t.Run("wizards prepare from their spellbook", func(t *testing.T) {
+		deps := setup(t)
+		wizard := createWizard()
+		wizard.LongRest(false)
+		deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "fireball").Return(fireball, nil)
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "burning-hands").Return(burningHands, nil)
+
+		options, err := deps.service.StartPreparation(context.Background(), wizard.ID, testOwner)
+
+		require.NoError(t, err)
+		assert.Equal(t, 8, options.MaxPrepared)
+		assert.Equal(t, []int{1, 3}, options.Levels())
+	})
+
+	t.Run("multiclass wizards prepare up to their wizard level", func(t *testing.T) {
+		deps := setup(t)
+		wizard := createWizard()
+		wizard.Level = 9
+		wizard.Classes = []*character.ClassLevel{
+			{Class: wizard.Class, Level: 1},
+			{Class: &rulebook.Class{Key: "fighter", Name: "Fighter", HitDie: 10}, Level: 8},
+		}
+		wizard.Spells.KnownSpells = []string{"burning-hands", "cone-of-cold"}
+		wizard.LongRest(false)
+		coneOfCold := &rulebook.Spell{Key: "cone-of-cold", Name: "Cone of Cold", Level: 5, Classes: []string{"Sorcerer", "Wizard"}}
+		deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "burning-hands").Return(burningHands, nil)
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "cone-of-cold").Return(coneOfCold, nil)
+
+		options, err := deps.service.StartPreparation(context.Background(), wizard.ID, testOwner)
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, options.MaxPrepared, "INT +3 plus wizard level 1")
+		assert.Equal(t, []int{1}, options.Levels())
+	})
+
+	t.Run("a wizard who never prepared still needs a long rest", func(t *testing.T) {
+		deps := setup(t)
+		wizard := createWizard()
+		deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+
+		_, err := deps.service.StartPreparation(context.Background(), wizard.ID, testOwner)
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument))
+	})
+
+	t.Run("only the owner can prepare", func(t *testing.T) {
+		deps := setup(t)
+		cleric := createCleric()
+		deps.charSvc.EXPECT().GetByID(cleric.ID).Return(cleric, nil)
+
+		_, err := deps.service.StartPreparation(context.Background(), cleric.ID, "someone_else")
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodePermissionDenied))
+	})
+}
+
+func TestPrepareSpells(t *testing.T) {
+	t.Run("replaces the prepared spells of one level", func(t *testing.T) {
+		deps := setup(t)
+		cleric := createCleric()
+		cleric.Spells.PreparedSpells = []string{"bless", "aid"}
+		cleric.Spells.CanChangePrepared = true
+		deps.charSvc.EXPECT().GetByID(cleric.ID).Return(cleric, nil)
+		expectClericSpells(deps)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), cleric).Return(nil)
+
+		_, err := deps.service.PrepareSpells(context.Background(), &PrepareSpellsInput{
+			CharacterID: cleric.ID,
+			UserID:      testOwner,
+			SpellLevel:  1,
+			SpellKeys:   []string{"cure-wounds", "healing-word"},
+		})
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"aid", "cure-wounds", "healing-word"}, cleric.Spells.PreparedSpells)
+		assert.True(t, cleric.CanCastSpell(&rulebook.Spell{Key: "cure-wounds"}))
+		assert.False(t, cleric.CanCastSpell(&rulebook.Spell{Key: "bless"}))
+	})
+
+	t.Run("enforces the preparation limit", func(t *testing.T) {
+		deps := setup(t)
+		cleric := createCleric()
+		cleric.Spells.PreparedSpells = []string{"aid", "spiritual-weapon"}
+		cleric.Spells.CanChangePrepared = true
+		deps.charSvc.EXPECT().GetByID(cleric.ID).Return(cleric, nil)
+		expectClericSpells(deps)
+
+		_, err := deps.service.PrepareSpells(context.Background(), &PrepareSpellsInput{
+			CharacterID: cleric.ID,
+			UserID:      testOwner,
+			SpellLevel:  1,
+			SpellKeys:   []string{"bless", "cure-wounds", "guiding-bolt", "healing-word"},
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "can only prepare 5 spells")
+	})
+
+	t.Run("rejects spells from another level", func(t *testing.T) {
+		deps := setup(t)
+		cleric := createCleric()
+		cleric.Spells.CanChangePrepared = true
+		deps.charSvc.EXPECT().GetByID(cleric.ID).Return(cleric, nil)
+		expectClericSpells(deps)
+
+		_, err := deps.service.PrepareSpells(context.Background(), &PrepareSpellsInput{
+			CharacterID: cleric.ID,
+			UserID:      testOwner,
+			SpellLevel:  1,
+			SpellKeys:   []string{"aid"},
+		})
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument))
+	})
+
+	t.Run("requires the preparation window", func(t *testing.T) {
+		deps := setup(t)
+		cleric := createCleric()
+		deps.charSvc.EXPECT().GetByID(cleric.ID).Return(cleric, nil)
+
+		_, err := deps.service.PrepareSpells(context.Background(), &PrepareSpellsInput{
+			CharacterID: cleric.ID,
+			UserID:      testOwner,
+			SpellLevel:  1,
+			SpellKeys:   []string{"cure-wounds"},
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "after a long rest")
+	})
+}
+
+func TestFinishPreparation(t *testing.T) {
+	deps := setup(t)
+	cleric := createCleric()
+	cleric.Spells.CanChangePrepared = true
+	deps.charSvc.EXPECT().GetByID(cleric.ID).Return(cleric, nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), cleric).Return(nil)
+
+	err := deps.service.FinishPreparation(context.Background(), cleric.ID, testOwner)
+
+	require.NoError(t, err)
+	assert.False(t, cleric.Spells.CanChangePrepared)
+	assert.False(t, cleric.CanPrepareSpells())
+}
+
+func TestCopyToSpellbook(t *testing.T) {
+	t.Run("copies a wizard spell and pays the cost", func(t *testing.T) {
+		deps := setup(t)
+		wizard := createWizard()
+		wizard.Wallet.Gold = 60
+		deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "detect-magic").Return(detectMagic, nil)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), wizard).Return(nil)
+
+		result, err := deps.service.CopyToSpellbook(context.Background(), &CopySpellInput{
+			CharacterID: wizard.ID,
+			UserID:      testOwner,
+			SpellKey:    "detect-magic",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 50, result.GoldCost)
+		assert.Equal(t, 2, result.Hours)
+		assert.True(t, wizard.InSpellbook("detect-magic"))
+		assert.Equal(t, 10*shared.CoinGold.Value(), wizard.Wallet.Total())
+	})
+
+	t.Run("rejects the copy without enough gold", func(t *testing.T) {
+		deps := setup(t)
+		wizard := createWizard()
+		wizard.Wallet.Gold = 40
+		deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "detect-magic").Return(detectMagic, nil)
+
+		_, err := deps.service.CopyToSpellbook(context.Background(), &CopySpellInput{
+			CharacterID: wizard.ID,
+			UserID:      testOwner,
+			SpellKey:    "detect-magic",
+		})
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument))
+		assert.False(t, wizard.InSpellbook("detect-magic"))
+		assert.Equal(t, 40, wizard.Wallet.Gold)
+	})
+
+	t.Run("rejects spells from other class lists", func(t *testing.T) {
+		deps := setup(t)
+		wizard := createWizard()
+		deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "cure-wounds").Return(cureWounds, nil)
+
+		_, err := deps.service.CopyToSpellbook(context.Background(), &CopySpellInput{
+			CharacterID: wizard.ID,
+			UserID:      testOwner,
+			SpellKey:    "cure-wounds",
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not a wizard spell")
+	})
+
+	t.Run("only wizards have a spellbook", func(t *testing.T) {
+		deps := setup(t)
+		cleric := createCleric()
+		deps.charSvc.EXPECT().GetByID(cleric.ID).Return(cleric, nil)
+
+		_, err := deps.service.CopyToSpellbook(context.Background(), &CopySpellInput{
+			CharacterID: cleric.ID,
+			UserID:      testOwner,
+			SpellKey:    "detect-magic",
+		})
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument))
+	})
+}
+
+func TestCastSpell_Ritual(t *testing.T) {
+	t.Run("wizard casts a spellbook ritual without a slot", func(t *testing.T) {
+		deps := setup(t)
+		wizard := createWizard()
+		wizard.Spells.KnownSpells = append(wizard.Spells.KnownSpells, "detect-magic")
+		wizard.Spells.PreparedSpells = []string{"fireball"}
+		slotsBefore := wizard.Resources.SpellSlots[1].Remaining
+		deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "detect-magic").Return(detectMagic, nil)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), wizard).Return(nil)
+
+		result, err := deps.service.CastSpell(context.Background(), &CastSpellInput{
+			CharacterID: wizard.ID,
+			UserID:      testOwner,
+			SpellKey:    "detect-magic",
+			Ritual:      true,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, result.Ritual)
+		assert.Equal(t, slotsBefore, wizard.Resources.SpellSlots[1].Remaining)
+		assert.Contains(t, result.Message, "as a ritual")
+	})
+
+	t.Run("not in combat", func(t *testing.T) {
+		deps := setup(t)
+		wizard := createWizard()
+		wizard.Spells.KnownSpells = append(wizard.Spells.KnownSpells, "detect-magic")
+		deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "detect-magic").Return(detectMagic, nil)
+
+		_, err := deps.service.CastSpell(context.Background(), &CastSpellInput{
+			CharacterID: wizard.ID,
+			UserID:      testOwner,
+			SpellKey:    "detect-magic",
+			EncounterID: testEncounter,
+			Ritual:      true,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "in combat")
+	})
+
+	t.Run("spell must have the ritual tag", func(t *testing.T) {
+		deps := setup(t)
+		wizard := createWizard()
+		deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "burning-hands").Return(burningHands, nil)
+
+		_, err := deps.service.CastSpell(context.Background(), &CastSpellInput{
+			CharacterID: wizard.ID,
+			UserID:      testOwner,
+			SpellKey:    "burning-hands",
+			Ritual:      true,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "as a ritual")
+	})
+}
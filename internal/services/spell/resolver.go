@@ -0,0 +1,226 @@
+package spell
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+)
+
+// Saving throw outcomes from the spell data
+const saveSuccessHalf = "half"
+
+// Resolve resolves a spell from its rulebook data alone:
+//   - spells with a DC force a saving throw; damage is rolled once for all
+//     targets and halved or negated on a success
+//   - spells with an attack type make a spell attack per target; a natural
+//     20 doubles the damage dice
+//   - other damage spells hit automatically
+//
+// Spells without damage or a DC are simply cast.
+func Resolve(ctx context.Context, cast *Cast) (*CastSpellResult, error) {
+	spell := cast.Spell
+	result := &CastSpellResult{
+		SpellKey:      spell.Key,
+		SpellName:     spell.Name,
+		SlotLevel:     cast.SlotLevel,
+		Concentration: spell.Concentration,
+	}
+	if spell.Damage != nil {
+		result.DamageType = spell.Damage.DamageType
+	}
+
+	notation := spell.Damage.DiceAt(cast.SlotLevel, cast.Caster.Level)
+	if notation == "" && spell.DC == nil {
+		return result, nil
+	}
+
+	modifier := 0
+	if ability := cast.Caster.Attributes[cast.Caster.SpellcastingAbilityFor(spell)]; ability != nil {
+		modifier = ability.Bonus
+	}
+
+	// Save and auto-hit spells roll damage once for every target
+	sharedDamage := 0
+	if notation != "" && spell.AttackType == "" {
+		damage, err := RollDamage(cast.Roller, notation, modifier, false)
+		if err != nil {
+			return nil, err
+		}
+		sharedDamage = damage
+	}
+
+	for _, target := range cast.Targets {
+		targetResult := &TargetResult{
+			CombatantID: target.ID,
+			Name:        target.Name,
+		}
+
+		switch {
+		case spell.DC != nil:
+			if err := resolveSave(cast, target, targetResult); err != nil {
+				return nil, err
+			}
+			targetResult.Damage = sharedDamage
+			if targetResult.Saved {
+				if strings.EqualFold(spell.DC.Success, saveSuccessHalf) {
+					targetResult.Damage = sharedDamage / 2
+				} else {
+					targetResult.Damage = 0
+				}
+			}
+
+		case spell.AttackType != "":
+			if err := resolveAttack(cast, target, targetResult); err != nil {
+				return nil, err
+			}
+			if targetResult.Hit {
+				damage, err := RollDamage(cast.Roller, notation, modifier, targetResult.Critical)
+				if err != nil {
+					return nil, err
+				}
+				targetResult.Damage = damage
+			}
+
+		default:
+			targetResult.Damage = sharedDamage
+		}
+
+		result.Targets = append(result.Targets, targetResult)
+	}
+
+	return result, nil
+}
+
+// resolveSave rolls the target's saving throw against the caster's spell save DC
+func resolveSave(cast *Cast, target *combat.Combatant, result *TargetResult) error {
+	var roll *dice.RollResult
+	var err error
+	if cast.SaveDisadvantage(target, cast.Spell.DC.Type) {
+		roll, err = cast.Roller.RollWithDisadvantage(20, 0)
+	} else {
+		roll, err = cast.Roller.Roll(1, 20, 0)
+	}
+	if err != nil {
+		return dnderr.Wrap(err, "failed to roll saving throw")
+	}
+
+	result.SaveDC = cast.Caster.GetSpellSaveDC(cast.Spell)
+	result.SaveRoll = roll.Total
+	result.SaveTotal = roll.Total + cast.SavingThrowBonus(target, cast.Spell.DC.Type)
+	result.Saved = result.SaveTotal >= result.SaveDC
+	return nil
+}
+
+// resolveAttack rolls a spell attack against the target's AC
+func resolveAttack(cast *Cast, target *combat.Combatant, result *TargetResult) error {
+	roll, err := cast.Roller.Roll(1, 20, 0)
+	if err != nil {
+		return dnderr.Wrap(err, "failed to roll spell attack")
+	}
+
+	result.AttackRoll = roll.Total
+	result.AttackTotal = roll.Total + cast.Caster.GetSpellAttackBonus(cast.Spell)
+	result.Critical = roll.Total == 20
+	result.Hit = result.Critical || (roll.Total != 1 && result.AttackTotal >= target.AC)
+	return nil
+}
+
+// RollDamage rolls spell damage notation such as "8d6" or "1d4 + MOD".
+// MOD is replaced with the caster's spellcasting modifier; a critical hit
+// doubles the dice but not the flat bonus.
+func RollDamage(roller dice.Roller, notation string, modifier int, critical bool) (int, error) {
+	notation = strings.ReplaceAll(notation, "MOD", strconv.Itoa(modifier))
+
+	count, sides, bonus, err := dice.ParseNotation(notation)
+	if err != nil {
+		return 0, dnderr.Wrap(err, "invalid spell damage")
+	}
+	if critical {
+		count *= 2
+	}
+
+	roll, err := roller.Roll(count, sides, bonus)
+	if err != nil {
+		return 0, dnderr.Wrap(err, "failed to roll spell damage")
+	}
+	return max(roll.Total, 0), nil
+}
+
+// describe builds the combat log line for a cast
+func describe(casterName string, result *CastSpellResult) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s casts %s", casterName, result.SpellName))
+	if result.Ritual {
+		sb.WriteString(" as a ritual")
+	} else if result.SlotLevel > 0 {
+		sb.WriteString(fmt.Sprintf(" (level %d)", result.SlotLevel))
+	}
+
+	if len(result.Targets) == 0 {
+		sb.WriteString("!")
+		return sb.String()
+	}
+
+	parts := make([]string, 0, len(result.Targets))
+	for _, target := range result.Targets {
+		parts = append(parts, describeTarget(target, result.DamageType))
+	}
+	sb.WriteString(": ")
+	sb.WriteString(strings.Join(parts, "; "))
+	return sb.String()
+}
+
+func describeTarget(target *TargetResult, damageType string) string {
+	var outcome string
+	switch {
+	case target.SaveDC > 0 && target.Saved:
+		outcome = fmt.Sprintf("%s saves (%d vs DC %d)", target.Name, target.SaveTotal, target.SaveDC)
+	case target.SaveDC > 0:
+		outcome = fmt.Sprintf("%s fails the save (%d vs DC %d)", target.Name, target.SaveTotal, target.SaveDC)
+	case target.AttackRoll > 0 && target.Critical:
+		outcome = fmt.Sprintf("critical hit on %s", target.Name)
+	case target.AttackRoll > 0 && target.Hit:
+		outcome = fmt.Sprintf("hits %s (%d)", target.Name, target.AttackTotal)
+	case target.AttackRoll > 0:
+		return fmt.Sprintf("misses %s (%d)", target.Name, target.AttackTotal)
+	default:
+		outcome = target.Name
+	}
+
+	if target.Damage > 0 {
+		damage := strconv.Itoa(target.Damage)
+		if damageType != "" {
+			damage += " " + strings.ToLower(damageType)
+		}
+		outcome += fmt.Sprintf(", taking %s damage", damage)
+	}
+	if target.Healing > 0 {
+		outcome += fmt.Sprintf(", regaining %d hit points", target.Healing)
+	}
+	if target.Effect != nil {
+		outcome += fmt.Sprintf(" [%s]", target.Effect.Name)
+	}
+	if target.Defeated {
+		outcome += " - defeated!"
+	}
+	return outcome
+}
+
+// monsterAbilityKey converts an attribute to the key used by monster ability maps ("DEX")
+func monsterAbilityKey(attribute shared.Attribute) string {
+	return strings.ToUpper(string(attribute))
+}
+
+// abilityModifier converts an ability score to its modifier
+func abilityModifier(score int) int {
+	if score >= 10 {
+		return (score - 10) / 2
+	}
+	return (score - 11) / 2
+}
@@ -0,0 +1,434 @@
+package spell
+
+//go:generate mockgen -destination=mock/mock_service.go -package=mockspell -source=types.go
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/adapters/rpgtoolkit"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	encounterService "github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	sessService "github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+	rpgevents "github.com/KirkDiggler/rpg-toolkit/events"
+)
+
+const maxSpellLevel = 9
+
+type service struct {
+	characterService charService.Service
+	encounterService encounterService.Service
+	sessionService   sessService.Service
+	diceRoller       dice.Roller
+	eventBus         *rpgevents.Bus
+	registry         *HandlerRegistry
+}
+
+// ServiceConfig holds configuration for the spell service
+type ServiceConfig struct {
+	CharacterService charService.Service      // Required
+	EncounterService encounterService.Service // Required
+	SessionService   sessService.Service      // Optional, for house rules such as variant encumbrance
+	DiceRoller       dice.Roller              // Optional, defaults to random
+	EventBus         *rpgevents.Bus           // Optional
+}
+
+// NewService creates a new spell service
+func NewService(cfg *ServiceConfig) Service {
+	if cfg.CharacterService == nil {
+		panic("character service is required")
+	}
+	if cfg.EncounterService == nil {
+		panic("encounter service is required")
+	}
+
+	svc := &service{
+		characterService: cfg.CharacterService,
+		encounterService: cfg.EncounterService,
+		sessionService:   cfg.SessionService,
+		diceRoller:       cfg.DiceRoller,
+		eventBus:         cfg.EventBus,
+		registry:         NewHandlerRegistry(),
+	}
+
+	if svc.diceRoller == nil {
+		svc.diceRoller = dice.NewRandomRoller()
+	}
+
+	return svc
+}
+
+// RegisterHandler registers a handler for a spell that needs special logic
+func (s *service) RegisterHandler(handler Handler) {
+	s.registry.Register(handler)
+}
+
+// CastSpell casts a spell, consuming a slot and resolving it against encounter targets
+func (s *service) CastSpell(ctx context.Context, input *CastSpellInput) (*CastSpellResult, error) {
+	if input == nil {
+		return nil, dnderr.InvalidArgument("input cannot be nil")
+	}
+	if input.CharacterID == "" {
+		return nil, dnderr.InvalidArgument("character ID is required")
+	}
+	if input.SpellKey == "" {
+		return nil, dnderr.InvalidArgument("spell key is required")
+	}
+
+	caster, err := s.characterService.GetByID(input.CharacterID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", input.CharacterID)
+	}
+	if input.UserID != "" && caster.OwnerID != input.UserID {
+		return nil, dnderr.PermissionDenied("only the character's owner can cast their spells").
+			WithMeta("character_id", input.CharacterID)
+	}
+
+	spell, err := s.characterService.GetSpell(ctx, input.SpellKey)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get spell '%s'", input.SpellKey)
+	}
+	if !input.Ritual && !input.Scroll && !input.Item && !caster.CanCastSpell(spell) {
+		return nil, dnderr.InvalidArgumentf("%s doesn't know %s", caster.Name, input.SpellKey).
+			WithMeta("spell_key", input.SpellKey)
+	}
+
+	var slotLevel int
+	if input.Scroll {
+		// Anyone with the spell on their class's list can read it from a scroll
+		if !caster.HasSpellOnClassList(spell) {
+			return nil, dnderr.InvalidArgumentf("%s isn't on %s's spell list", spell.Name, caster.Name).
+				WithMeta("spell_key", spell.Key)
+		}
+		slotLevel = max(input.SlotLevel, spell.Level)
+	} else if input.Item {
+		slotLevel = max(input.SlotLevel, spell.Level)
+	} else if input.Ritual {
+		// Rituals take ten minutes longer and don't use a slot
+		if input.EncounterID != "" {
+			return nil, dnderr.InvalidArgument("rituals take too long to cast in combat")
+		}
+		if !caster.CanCastAsRitual(spell) {
+			return nil, dnderr.InvalidArgumentf("%s can't cast %s as a ritual", caster.Name, spell.Name).
+				WithMeta("spell_key", spell.Key)
+		}
+		slotLevel = spell.Level
+	} else {
+		slotLevel, err = chooseSlotLevel(caster, spell, input.SlotLevel)
+		if err != nil {
+			return nil, err
+		}
+	}
+	spendsSlot := slotLevel > 0 && !input.Ritual && !input.Scroll && !input.Item
+
+	handler, hasHandler := s.registry.Get(spell.Key)
+	healer, heals := handler.(Healer)
+	heals = heals && healer.Heals()
+
+	encounter, targets, err := s.getTargets(ctx, input, heals)
+	if err != nil {
+		return nil, err
+	}
+	if !hasHandler {
+		if err := validateTargetCount(spell, len(targets)); err != nil {
+			return nil, err
+		}
+	}
+
+	// In combat the spell costs its action, bonus action or reaction
+	if encounter != nil {
+		if ok, reason := caster.CanCastSpellThisTurn(spell.Level, spell.CastingTime); !ok {
+			return nil, dnderr.InvalidArgumentf("can't cast %s: %s", spell.Name, reason).
+				WithMeta("casting_time", spell.CastingTime)
+		}
+	}
+
+	resources := caster.GetResources()
+	if spendsSlot && !resources.UseSpellSlot(slotLevel) {
+		return nil, dnderr.InvalidArgumentf("%s has no level %d spell slots remaining", caster.Name, slotLevel)
+	}
+
+	cast := &Cast{
+		Caster:             caster,
+		Spell:              spell,
+		SlotLevel:          slotLevel,
+		Encounter:          encounter,
+		Targets:            targets,
+		Roller:             s.diceRoller,
+		VariantEncumbrance: s.variantEncumbrance(ctx, encounter),
+		characters:         s.characterService,
+	}
+
+	var result *CastSpellResult
+	if hasHandler {
+		result, err = handler.Resolve(ctx, cast)
+	} else {
+		result, err = Resolve(ctx, cast)
+	}
+	if err != nil {
+		if spendsSlot {
+			restoreSpellSlot(resources, slotLevel)
+		}
+		return nil, dnderr.Wrapf(err, "failed to cast %s", spell.Name)
+	}
+
+	// A concentration spell's effects go on with the concentration, after
+	// everything else, so a failed cast doesn't end the previous spell
+	var concentratorID string
+	if encounter != nil && spell.Concentration {
+		if concentratorID = findCombatantID(encounter, caster); concentratorID == "" {
+			if spendsSlot {
+				restoreSpellSlot(resources, slotLevel)
+			}
+			return nil, dnderr.InvalidArgumentf("%s is not in the fight", caster.Name)
+		}
+	}
+
+	if err := s.applyToEncounter(ctx, input, targets, result, concentratorID == ""); err != nil {
+		if spendsSlot {
+			restoreSpellSlot(resources, slotLevel)
+		}
+		return nil, err
+	}
+
+	if concentratorID != "" {
+		if err := s.startConcentration(ctx, encounter.ID, concentratorID, spell, result); err != nil {
+			if spendsSlot {
+				restoreSpellSlot(resources, slotLevel)
+			}
+			return nil, err
+		}
+	}
+
+	result.SlotLevel = slotLevel
+	result.Ritual = input.Ritual
+	if spendsSlot {
+		result.SlotsLeft = resources.SpellSlots[slotLevel].Remaining
+	}
+	if result.Message == "" {
+		result.Message = describe(caster.Name, result)
+	}
+	if result.DroppedConcentration != "" {
+		result.Message += fmt.Sprintf(" (%s ends)", result.DroppedConcentration)
+	}
+
+	if encounter != nil {
+		caster.RecordSpellCast(spell.Key, spell.Level, spell.CastingTime)
+	}
+
+	s.emitSpellCast(caster, spell, slotLevel)
+
+	ctx = charService.WithChange(ctx, input.UserID, fmt.Sprintf("cast %s", spell.Name))
+	if err := s.characterService.UpdateEquipment(ctx, caster); err != nil {
+		return nil, dnderr.Wrap(err, "failed to save character after casting")
+	}
+
+	if encounter != nil {
+		if err := s.encounterService.LogCombatAction(ctx, encounter.ID, result.Message); err != nil {
+			log.Printf("Failed to log spell cast: %v", err)
+		}
+	}
+
+	return result, nil
+}
+
+// variantEncumbrance checks if the encounter's session plays with the
+// variant encumbrance rule
+func (s *service) variantEncumbrance(ctx context.Context, encounter *combat.Encounter) bool {
+	if s.sessionService == nil || encounter == nil || encounter.SessionID == "" {
+		return false
+	}
+
+	sess, err := s.sessionService.GetSession(ctx, encounter.SessionID)
+	if err != nil {
+		log.Printf("Failed to load house rules for session %s: %v", encounter.SessionID, err)
+		return false
+	}
+	return sess.GetHouseRules().VariantEncumbrance
+}
+
+// chooseSlotLevel picks the slot to spend. Cantrips need none; casters with
+// only pact magic always cast at the pact slot level; everything else may be
+// upcast, including with the pact slots of a multiclass warlock.
+func chooseSlotLevel(caster *character.Character, spell *rulebook.Spell, requested int) (int, error) {
+	if spell.Level == 0 {
+		return 0, nil
+	}
+
+	if resources := caster.GetResources(); resources.PactMagicOnly() {
+		pactLevel := resources.PactSlotLevel()
+		if spell.Level > pactLevel {
+			return 0, dnderr.InvalidArgumentf("%s can't cast level %d spells yet", caster.Name, spell.Level)
+		}
+		if requested != 0 && requested != pactLevel {
+			return 0, dnderr.InvalidArgumentf("pact magic slots are always cast at level %d", pactLevel)
+		}
+		return pactLevel, nil
+	}
+
+	level := requested
+	if level == 0 {
+		level = spell.Level
+	}
+	if level < spell.Level {
+		return 0, dnderr.InvalidArgumentf("%s can't be cast with a level %d slot", spell.Name, level)
+	}
+	if level > maxSpellLevel {
+		return 0, dnderr.InvalidArgumentf("there are no level %d spell slots", level)
+	}
+	return level, nil
+}
+
+// validateTargetCount checks targets for spells resolved from data alone
+func validateTargetCount(spell *rulebook.Spell, count int) error {
+	offensive := spell.Damage != nil || spell.DC != nil
+	if offensive && count == 0 {
+		return dnderr.InvalidArgumentf("%s needs a target", spell.Name)
+	}
+	if spell.AreaOfEffect == nil && count > 1 {
+		return dnderr.InvalidArgumentf("%s can only target one creature", spell.Name)
+	}
+	return nil
+}
+
+// getTargets loads the encounter and the targeted combatants
+func (s *service) getTargets(ctx context.Context, input *CastSpellInput, heals bool) (*combat.Encounter, []*combat.Combatant, error) {
+	if input.EncounterID == "" {
+		if len(input.TargetIDs) > 0 {
+			return nil, nil, dnderr.InvalidArgument("targets can only be chosen in an encounter")
+		}
+		return nil, nil, nil
+	}
+
+	encounter, err := s.encounterService.GetEncounter(ctx, input.EncounterID)
+	if err != nil {
+		return nil, nil, dnderr.Wrap(err, "failed to get encounter")
+	}
+
+	targets := make([]*combat.Combatant, 0, len(input.TargetIDs))
+	for _, targetID := range input.TargetIDs {
+		target, exists := encounter.Combatants[targetID]
+		if !exists || !canTarget(target, heals) {
+			return nil, nil, dnderr.InvalidArgumentf("target '%s' is not in the fight", targetID)
+		}
+		targets = append(targets, target)
+	}
+	return encounter, targets, nil
+}
+
+// findCombatantID returns the ID of the character's combatant, or "" if
+// they aren't in the encounter
+func findCombatantID(encounter *combat.Encounter, char *character.Character) string {
+	for _, combatant := range encounter.Combatants {
+		if combatant.CharacterID == char.ID {
+			return combatant.ID
+		}
+	}
+	return ""
+}
+
+// startConcentration makes the caster concentrate on the spell, dropping
+// their previous concentration spell. Effects from the cast are linked to
+// the caster so they end with the concentration, and are applied in the
+// same encounter update.
+func (s *service) startConcentration(ctx context.Context, encounterID, casterID string, spell *rulebook.Spell, result *CastSpellResult) error {
+	effects := make(map[string]*shared.ActiveEffect)
+	for _, target := range result.Targets {
+		if target.Effect == nil || target.Defeated {
+			continue
+		}
+		target.Effect.RequiresConcentration = true
+		target.Effect.SourceID = casterID
+		if target.Effect.Source == "" {
+			target.Effect.Source = spell.Name
+		}
+		effects[target.CombatantID] = target.Effect
+	}
+
+	dropped, err := s.encounterService.StartConcentration(ctx, encounterID, casterID, spell.Key, spell.Name, effects)
+	if err != nil {
+		return dnderr.Wrap(err, "failed to start concentration")
+	}
+	if dropped != nil {
+		result.DroppedConcentration = dropped.SpellName
+	}
+	result.Concentration = true
+	return nil
+}
+
+// canTarget reports whether a combatant is still in the fight. Combatants at
+// 0 hit points can only be healed.
+func canTarget(target *combat.Combatant, heals bool) bool {
+	if !target.IsAlive() {
+		return heals
+	}
+	return target.IsActive
+}
+
+// applyToEncounter applies damage, healing and, unless they go on with a
+// concentration, effects from a resolved cast
+func (s *service) applyToEncounter(ctx context.Context, input *CastSpellInput, targets []*combat.Combatant, result *CastSpellResult, applyEffects bool) error {
+	if input.EncounterID == "" {
+		return nil
+	}
+
+	combatants := make(map[string]*combat.Combatant, len(targets))
+	for _, target := range targets {
+		combatants[target.ID] = target
+	}
+
+	for _, targetResult := range result.Targets {
+		if targetResult.Damage > 0 {
+			if target := combatants[targetResult.CombatantID]; target != nil {
+				targetResult.Defeated = target.CurrentHP+target.TempHP <= targetResult.Damage
+			}
+			if err := s.encounterService.ApplyDamage(ctx, input.EncounterID, targetResult.CombatantID, input.UserID, targetResult.Damage); err != nil {
+				return dnderr.Wrapf(err, "failed to damage %s", targetResult.Name)
+			}
+		}
+		if targetResult.Healing > 0 {
+			if err := s.encounterService.HealCombatant(ctx, input.EncounterID, targetResult.CombatantID, input.UserID, targetResult.Healing); err != nil {
+				return dnderr.Wrapf(err, "failed to heal %s", targetResult.Name)
+			}
+		}
+		if applyEffects && targetResult.Effect != nil && !targetResult.Defeated {
+			if err := s.encounterService.AddCombatantEffect(ctx, input.EncounterID, targetResult.CombatantID, targetResult.Effect); err != nil {
+				return dnderr.Wrapf(err, "failed to apply %s to %s", targetResult.Effect.Name, targetResult.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// restoreSpellSlot gives back a slot spent on a cast that failed
+func restoreSpellSlot(resources *character.CharacterResources, slotLevel int) {
+	slot, exists := resources.SpellSlots[slotLevel]
+	if slotLevel == 0 || !exists {
+		return
+	}
+	slot.Remaining = min(slot.Remaining+1, slot.Max)
+	resources.SpellSlots[slotLevel] = slot
+}
+
+func (s *service) emitSpellCast(caster *character.Character, spell *rulebook.Spell, slotLevel int) {
+	if s.eventBus == nil {
+		return
+	}
+
+	contextData := map[string]interface{}{
+		rpgtoolkit.ContextSpellLevel: slotLevel,
+		rpgtoolkit.ContextSpellName:  spell.Name,
+		"spell_school":               spell.School,
+		"spell_key":                  spell.Key,
+	}
+	if err := rpgtoolkit.EmitEvent(s.eventBus, rpgevents.EventOnSpellCast, caster, nil, contextData); err != nil {
+		log.Printf("Failed to emit OnSpellCast event: %v", err)
+	}
+}
@@ -0,0 +1,547 @@
+package spell
+
+import (
+	"context"
+	"testing"
+
+	mockdice "github.com/KirkDiggler/dnd-bot-discord/internal/dice/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	mockchar "github.com/KirkDiggler/dnd-bot-discord/internal/services/character/mock"
+	mockencounter "github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testOwner     = "user_123"
+	testEncounter = "enc_123"
+)
+
+var (
+	fireball = &rulebook.Spell{
+		Key:         "fireball",
+		Name:        "Fireball",
+		Level:       3,
+		CastingTime: rulebook.CastingTimeAction,
+		Damage: &rulebook.SpellDamage{
+			DamageType:    "Fire",
+			DamageAtLevel: map[int]string{3: "8d6", 4: "9d6"},
+		},
+		DC:           &rulebook.SpellDC{Type: shared.AttributeDexterity, Success: "half"},
+		AreaOfEffect: &rulebook.SpellAreaOfEffect{Type: "sphere", Size: 20},
+	}
+	fireBolt = &rulebook.Spell{
+		Key:         "fire-bolt",
+		Name:        "Fire Bolt",
+		CastingTime: rulebook.CastingTimeAction,
+		AttackType:  rulebook.SpellAttackRanged,
+		Damage: &rulebook.SpellDamage{
+			DamageType:             "Fire",
+			DamageAtCharacterLevel: rulebook.CantripDamageAtCharacterLevel("fire-bolt"),
+		},
+	}
+)
+
+type testDeps struct {
+	charSvc      *mockchar.MockService
+	encounterSvc *mockencounter.MockService
+	roller       *mockdice.ManualMockRoller
+	service      Service
+}
+
+func setup(t *testing.T) *testDeps {
+	ctrl := gomock.NewController(t)
+	deps := &testDeps{
+		charSvc:      mockchar.NewMockService(ctrl),
+		encounterSvc: mockencounter.NewMockService(ctrl),
+		roller:       mockdice.NewManualMockRoller(),
+	}
+	deps.service = NewService(&ServiceConfig{
+		CharacterService: deps.charSvc,
+		EncounterService: deps.encounterSvc,
+		DiceRoller:       deps.roller,
+	})
+	return deps
+}
+
+// createWizard returns a level 5 wizard with INT 16: spell save DC 14, spell attack +6
+func createWizard() *character.Character {
+	char := &character.Character{
+		ID:      "char_123",
+		OwnerID: testOwner,
+		Name:    "Elminster",
+		Level:   5,
+		Class:   &rulebook.Class{Key: "wizard", Name: "Wizard", HitDie: 6},
+		Spells: &character.SpellList{
+			Cantrips:    []string{"fire-bolt"},
+			KnownSpells: []string{"fireball", "burning-hands"},
+		},
+	}
+	char.AddAttribute(shared.AttributeIntelligence, 16)
+	char.InitializeResources()
+	return char
+}
+
+func createEncounter() *combat.Encounter {
+	enc := combat.NewEncounter(testEncounter, "session_123", "channel_123", "Ambush", testOwner)
+	enc.AddCombatant(&combat.Combatant{
+		ID: "goblin_1", Name: "Goblin 1", Type: combat.CombatantTypeMonster,
+		CurrentHP: 7, MaxHP: 7, AC: 15, IsActive: true,
+		Abilities: map[string]int{"DEX": 14},
+	})
+	enc.AddCombatant(&combat.Combatant{
+		ID: "goblin_2", Name: "Goblin 2", Type: combat.CombatantTypeMonster,
+		CurrentHP: 30, MaxHP: 30, AC: 15, IsActive: true,
+		Abilities: map[string]int{"DEX": 10},
+	})
+	return enc
+}
+
+func TestCastSpell_SaveForHalfAreaSpell(t *testing.T) {
+	deps := setup(t)
+	wizard := createWizard()
+
+	deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+	deps.charSvc.EXPECT().GetSpell(gomock.Any(), "fireball").Return(fireball, nil)
+	deps.encounterSvc.EXPECT().GetEncounter(gomock.Any(), testEncounter).Return(createEncounter(), nil)
+
+	// 8d6 of 3s = 24. Goblin 1 saves (15+2 vs 14), Goblin 2 fails (5+0)
+	deps.roller.SetRolls([]int{3, 3, 3, 3, 3, 3, 3, 3, 15, 5})
+
+	deps.encounterSvc.EXPECT().ApplyDamage(gomock.Any(), testEncounter, "goblin_1", testOwner, 12).Return(nil)
+	deps.encounterSvc.EXPECT().ApplyDamage(gomock.Any(), testEncounter, "goblin_2", testOwner, 24).Return(nil)
+	deps.encounterSvc.EXPECT().LogCombatAction(gomock.Any(), testEncounter, gomock.Any()).Return(nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), wizard).Return(nil)
+
+	result, err := deps.service.CastSpell(context.Background(), &CastSpellInput{
+		CharacterID: wizard.ID,
+		UserID:      testOwner,
+		SpellKey:    "fireball",
+		EncounterID: testEncounter,
+		TargetIDs:   []string{"goblin_1", "goblin_2"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, result.SlotLevel)
+	assert.Equal(t, 1, result.SlotsLeft)
+	require.Len(t, result.Targets, 2)
+	assert.True(t, result.Targets[0].Saved)
+	assert.True(t, result.Targets[0].Defeated)
+	assert.False(t, result.Targets[1].Saved)
+	assert.Equal(t, 14, result.Targets[1].SaveDC)
+	assert.Equal(t, 36, result.TotalDamage())
+	assert.Contains(t, result.Message, "Fireball")
+	assert.Equal(t, 1, wizard.Resources.SpellSlots[3].Remaining)
+	assert.True(t, wizard.Resources.ActionEconomy.ActionUsed)
+	assert.True(t, wizard.Resources.ActionEconomy.LeveledSpellCast)
+}
+
+func TestCastSpell_CantripCriticalHit(t *testing.T) {
+	deps := setup(t)
+	wizard := createWizard()
+
+	deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+	deps.charSvc.EXPECT().GetSpell(gomock.Any(), "fire-bolt").Return(fireBolt, nil)
+	deps.encounterSvc.EXPECT().GetEncounter(gomock.Any(), testEncounter).Return(createEncounter(), nil)
+
+	// Natural 20 doubles the 2d10 a level 5 caster rolls
+	deps.roller.SetRolls([]int{20, 5, 5, 5, 5})
+
+	deps.encounterSvc.EXPECT().ApplyDamage(gomock.Any(), testEncounter, "goblin_2", testOwner, 20).Return(nil)
+	deps.encounterSvc.EXPECT().LogCombatAction(gomock.Any(), testEncounter, gomock.Any()).Return(nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), wizard).Return(nil)
+
+	result, err := deps.service.CastSpell(context.Background(), &CastSpellInput{
+		CharacterID: wizard.ID,
+		UserID:      testOwner,
+		SpellKey:    "fire-bolt",
+		EncounterID: testEncounter,
+		TargetIDs:   []string{"goblin_2"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, result.SlotLevel)
+	require.Len(t, result.Targets, 1)
+	assert.True(t, result.Targets[0].Critical)
+	assert.Equal(t, 20, result.Targets[0].Damage)
+	assert.Equal(t, 4, wizard.Resources.SpellSlots[1].Remaining)
+}
+
+func TestCastSpell_AttackMiss(t *testing.T) {
+	deps := setup(t)
+	wizard := createWizard()
+
+	deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+	deps.charSvc.EXPECT().GetSpell(gomock.Any(), "fire-bolt").Return(fireBolt, nil)
+	deps.encounterSvc.EXPECT().GetEncounter(gomock.Any(), testEncounter).Return(createEncounter(), nil)
+	deps.encounterSvc.EXPECT().LogCombatAction(gomock.Any(), testEncounter, gomock.Any()).Return(nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), wizard).Return(nil)
+
+	// 8 + 6 = 14 against AC 15
+	deps.roller.SetRolls([]int{8})
+
+	result, err := deps.service.CastSpell(context.Background(), &CastSpellInput{
+		CharacterID: wizard.ID,
+		UserID:      testOwner,
+		SpellKey:    "fire-bolt",
+		EncounterID: testEncounter,
+		TargetIDs:   []string{"goblin_1"},
+	})
+	require.NoError(t, err)
+	assert.False(t, result.Targets[0].Hit)
+	assert.Equal(t, 0, result.TotalDamage())
+	assert.Contains(t, result.Message, "misses Goblin 1")
+}
+
+func TestCastSpell_FromScroll(t *testing.T) {
+	deps := setup(t)
+	wizard := createWizard()
+	lightningBolt := *fireball
+	lightningBolt.Key = "lightning-bolt"
+	lightningBolt.Name = "Lightning Bolt"
+	lightningBolt.Classes = []string{"Sorcerer", "Wizard"}
+
+	deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+	deps.charSvc.EXPECT().GetSpell(gomock.Any(), "lightning-bolt").Return(&lightningBolt, nil)
+	deps.encounterSvc.EXPECT().GetEncounter(gomock.Any(), testEncounter).Return(createEncounter(), nil)
+	deps.encounterSvc.EXPECT().ApplyDamage(gomock.Any(), testEncounter, "goblin_2", testOwner, 24).Return(nil)
+	deps.encounterSvc.EXPECT().LogCombatAction(gomock.Any(), testEncounter, gomock.Any()).Return(nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), wizard).Return(nil)
+
+	// 8d6 of 3s = 24, Goblin 2 fails its save
+	deps.roller.SetRolls([]int{3, 3, 3, 3, 3, 3, 3, 3, 5})
+
+	result, err := deps.service.CastSpell(context.Background(), &CastSpellInput{
+		CharacterID: wizard.ID,
+		UserID:      testOwner,
+		SpellKey:    "lightning-bolt",
+		SlotLevel:   3,
+		EncounterID: testEncounter,
+		TargetIDs:   []string{"goblin_2"},
+		Scroll:      true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, result.SlotLevel)
+	assert.Equal(t, 2, wizard.Resources.SpellSlots[3].Remaining, "scrolls don't use spell slots")
+	assert.True(t, wizard.Resources.ActionEconomy.ActionUsed)
+}
+
+func TestCastSpell_Validation(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *CastSpellInput
+		setup     func(*testDeps, *character.Character)
+		checkCode func(error) bool
+	}{
+		{
+			name:  "unknown spell",
+			input: &CastSpellInput{SpellKey: "wish"},
+			setup: func(d *testDeps, c *character.Character) {
+				d.charSvc.EXPECT().GetByID(c.ID).Return(c, nil)
+				d.charSvc.EXPECT().GetSpell(gomock.Any(), "wish").Return(&rulebook.Spell{Key: "wish", Name: "Wish", Level: 9}, nil)
+			},
+			checkCode: dnderr.IsInvalidArgument,
+		},
+		{
+			name:  "scroll of a spell not on the class list",
+			input: &CastSpellInput{SpellKey: "fireball", Scroll: true},
+			setup: func(d *testDeps, c *character.Character) {
+				d.charSvc.EXPECT().GetByID(c.ID).Return(c, nil)
+				d.charSvc.EXPECT().GetSpell(gomock.Any(), "fireball").Return(fireball, nil)
+			},
+			checkCode: dnderr.IsInvalidArgument,
+		},
+		{
+			name:  "not the owner",
+			input: &CastSpellInput{SpellKey: "fireball", UserID: "someone_else"},
+			setup: func(d *testDeps, c *character.Character) {
+				d.charSvc.EXPECT().GetByID(c.ID).Return(c, nil)
+			},
+			checkCode: func(err error) bool { return dnderr.Is(err, dnderr.CodePermissionDenied) },
+		},
+		{
+			name:  "slot below spell level",
+			input: &CastSpellInput{SpellKey: "fireball", SlotLevel: 2, EncounterID: testEncounter, TargetIDs: []string{"goblin_1"}},
+			setup: func(d *testDeps, c *character.Character) {
+				d.charSvc.EXPECT().GetByID(c.ID).Return(c, nil)
+				d.charSvc.EXPECT().GetSpell(gomock.Any(), "fireball").Return(fireball, nil)
+			},
+			checkCode: dnderr.IsInvalidArgument,
+		},
+		{
+			name:  "no slots remaining",
+			input: &CastSpellInput{SpellKey: "fireball", EncounterID: testEncounter, TargetIDs: []string{"goblin_1"}},
+			setup: func(d *testDeps, c *character.Character) {
+				c.Resources.SpellSlots[3] = shared.SpellSlotInfo{Max: 2, Remaining: 0, Source: rulebook.SpellSlotSourceSpellcasting}
+				d.charSvc.EXPECT().GetByID(c.ID).Return(c, nil)
+				d.charSvc.EXPECT().GetSpell(gomock.Any(), "fireball").Return(fireball, nil)
+				d.encounterSvc.EXPECT().GetEncounter(gomock.Any(), testEncounter).Return(createEncounter(), nil)
+			},
+			checkCode: dnderr.IsInvalidArgument,
+		},
+		{
+			name:  "leveled spell after a bonus action spell",
+			input: &CastSpellInput{SpellKey: "fireball", EncounterID: testEncounter, TargetIDs: []string{"goblin_1"}},
+			setup: func(d *testDeps, c *character.Character) {
+				c.RecordSpellCast("misty-step", 2, rulebook.CastingTimeBonusAction)
+				d.charSvc.EXPECT().GetByID(c.ID).Return(c, nil)
+				d.charSvc.EXPECT().GetSpell(gomock.Any(), "fireball").Return(fireball, nil)
+				d.encounterSvc.EXPECT().GetEncounter(gomock.Any(), testEncounter).Return(createEncounter(), nil)
+			},
+			checkCode: dnderr.IsInvalidArgument,
+		},
+		{
+			name:  "single target spell with two targets",
+			input: &CastSpellInput{SpellKey: "fire-bolt", EncounterID: testEncounter, TargetIDs: []string{"goblin_1", "goblin_2"}},
+			setup: func(d *testDeps, c *character.Character) {
+				d.charSvc.EXPECT().GetByID(c.ID).Return(c, nil)
+				d.charSvc.EXPECT().GetSpell(gomock.Any(), "fire-bolt").Return(fireBolt, nil)
+				d.encounterSvc.EXPECT().GetEncounter(gomock.Any(), testEncounter).Return(createEncounter(), nil)
+			},
+			checkCode: dnderr.IsInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := setup(t)
+			wizard := createWizard()
+			tt.setup(deps, wizard)
+
+			input := *tt.input
+			input.CharacterID = wizard.ID
+			if input.UserID == "" {
+				input.UserID = testOwner
+			}
+
+			_, err := deps.service.CastSpell(context.Background(), &input)
+			require.Error(t, err)
+			assert.True(t, tt.checkCode(err), "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestChooseSlotLevel_PactMagic(t *testing.T) {
+	warlock := &character.Character{
+		Name:  "Hexblade",
+		Level: 5,
+		Class: &rulebook.Class{Key: "warlock", HitDie: 8},
+	}
+	warlock.InitializeResources()
+
+	burningHands := &rulebook.Spell{Key: "burning-hands", Name: "Burning Hands", Level: 1}
+
+	level, err := chooseSlotLevel(warlock, burningHands, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, level, "pact magic always casts at the pact slot level")
+
+	_, err = chooseSlotLevel(warlock, burningHands, 2)
+	assert.Error(t, err)
+
+	_, err = chooseSlotLevel(warlock, &rulebook.Spell{Name: "Dominate Monster", Level: 8}, 0)
+	assert.Error(t, err)
+}
+
+func TestChooseSlotLevel_MulticlassPactMagic(t *testing.T) {
+	wizard := &rulebook.Class{Key: "wizard", HitDie: 6}
+	caster := &character.Character{
+		Name:  "Mixed",
+		Level: 7,
+		Class: wizard,
+		Classes: []*character.ClassLevel{
+			{Class: wizard, Level: 5},
+			{Class: &rulebook.Class{Key: "warlock", HitDie: 8}, Level: 2},
+		},
+	}
+	caster.InitializeResources()
+	require.Equal(t, 2, caster.Resources.SpellSlots[1].PactMax, "pact slots join the 1st level slots")
+
+	level, err := chooseSlotLevel(caster, &rulebook.Spell{Name: "Fireball", Level: 3}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, level)
+
+	level, err = chooseSlotLevel(caster, &rulebook.Spell{Name: "Magic Missile", Level: 1}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, level, "mixed casters can upcast")
+}
+
+// heldHandler holds every target that fails its save
+type heldHandler struct{}
+
+func (heldHandler) Key() string { return "hold-person" }
+
+func (heldHandler) Resolve(ctx context.Context, cast *Cast) (*CastSpellResult, error) {
+	result, err := Resolve(ctx, cast)
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range result.Targets {
+		if !target.Saved {
+			target.Effect = &shared.ActiveEffect{Name: "Paralyzed"}
+		}
+	}
+	return result, nil
+}
+
+func TestCastSpell_ConcentrationLinksEffectsToCaster(t *testing.T) {
+	deps := setup(t)
+	deps.service.RegisterHandler(heldHandler{})
+	wizard := createWizard()
+	wizard.Spells.KnownSpells = append(wizard.Spells.KnownSpells, "hold-person")
+	holdPerson := &rulebook.Spell{
+		Key:           "hold-person",
+		Name:          "Hold Person",
+		Level:         2,
+		CastingTime:   rulebook.CastingTimeAction,
+		Concentration: true,
+		DC:            &rulebook.SpellDC{Type: shared.AttributeWisdom},
+	}
+	enc := createEncounter()
+	enc.AddCombatant(&combat.Combatant{
+		ID: "wizard_1", Name: wizard.Name, Type: combat.CombatantTypePlayer,
+		CharacterID: wizard.ID, PlayerID: testOwner, CurrentHP: 30, MaxHP: 30, IsActive: true,
+	})
+
+	deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+	deps.charSvc.EXPECT().GetSpell(gomock.Any(), "hold-person").Return(holdPerson, nil)
+	deps.encounterSvc.EXPECT().GetEncounter(gomock.Any(), testEncounter).Return(enc, nil)
+	deps.roller.SetRolls([]int{2}) // Goblin fails its save
+	deps.encounterSvc.EXPECT().StartConcentration(gomock.Any(), testEncounter, "wizard_1", "hold-person", "Hold Person", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _, _ string, effects map[string]*shared.ActiveEffect) (*combat.Concentration, error) {
+			require.Len(t, effects, 1)
+			effect := effects["goblin_2"]
+			require.NotNil(t, effect)
+			assert.True(t, effect.RequiresConcentration)
+			assert.Equal(t, "wizard_1", effect.SourceID)
+			assert.Equal(t, "Hold Person", effect.Source)
+			return &combat.Concentration{SpellKey: "bless", SpellName: "Bless"}, nil
+		})
+	deps.encounterSvc.EXPECT().LogCombatAction(gomock.Any(), testEncounter, gomock.Any()).Return(nil)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), wizard).Return(nil)
+
+	result, err := deps.service.CastSpell(context.Background(), &CastSpellInput{
+		CharacterID: wizard.ID,
+		UserID:      testOwner,
+		SpellKey:    "hold-person",
+		EncounterID: testEncounter,
+		TargetIDs:   []string{"goblin_2"},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.Concentration)
+	assert.Equal(t, "Bless", result.DroppedConcentration)
+	assert.Contains(t, result.Message, "(Bless ends)")
+}
+
+// mendHandler heals its target for a flat 5 hit points
+type mendHandler struct{}
+
+func (mendHandler) Key() string { return "mend" }
+
+func (mendHandler) Heals() bool { return true }
+
+func (mendHandler) Resolve(ctx context.Context, cast *Cast) (*CastSpellResult, error) {
+	result := &CastSpellResult{SpellKey: cast.Spell.Key, SpellName: cast.Spell.Name}
+	for _, target := range cast.Targets {
+		result.Targets = append(result.Targets, &TargetResult{CombatantID: target.ID, Name: target.Name, Healing: 5})
+	}
+	return result, nil
+}
+
+func TestCastSpell_HealsDownedAlly(t *testing.T) {
+	mend := &rulebook.Spell{Key: "mend", Name: "Mend", Level: 1, CastingTime: rulebook.CastingTimeBonusAction}
+	enc := createEncounter()
+	enc.AddCombatant(&combat.Combatant{
+		ID: "fighter_1", Name: "Bruenor", Type: combat.CombatantTypePlayer,
+		CurrentHP: 0, MaxHP: 20,
+	})
+
+	t.Run("healing spells can target a downed ally", func(t *testing.T) {
+		deps := setup(t)
+		deps.service.RegisterHandler(mendHandler{})
+		wizard := createWizard()
+		wizard.Spells.KnownSpells = append(wizard.Spells.KnownSpells, "mend")
+
+		deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "mend").Return(mend, nil)
+		deps.encounterSvc.EXPECT().GetEncounter(gomock.Any(), testEncounter).Return(enc, nil)
+		deps.encounterSvc.EXPECT().HealCombatant(gomock.Any(), testEncounter, "fighter_1", testOwner, 5).Return(nil)
+		deps.encounterSvc.EXPECT().LogCombatAction(gomock.Any(), testEncounter, gomock.Any()).Return(nil)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), wizard).Return(nil)
+
+		result, err := deps.service.CastSpell(context.Background(), &CastSpellInput{
+			CharacterID: wizard.ID,
+			UserID:      testOwner,
+			SpellKey:    "mend",
+			EncounterID: testEncounter,
+			TargetIDs:   []string{"fighter_1"},
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, result.Message, "Bruenor, regaining 5 hit points")
+		assert.Equal(t, 3, wizard.Resources.SpellSlots[1].Remaining)
+	})
+
+	t.Run("other spells can't", func(t *testing.T) {
+		deps := setup(t)
+		wizard := createWizard()
+
+		deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+		deps.charSvc.EXPECT().GetSpell(gomock.Any(), "fireball").Return(fireball, nil)
+		deps.encounterSvc.EXPECT().GetEncounter(gomock.Any(), testEncounter).Return(enc, nil)
+
+		_, err := deps.service.CastSpell(context.Background(), &CastSpellInput{
+			CharacterID: wizard.ID,
+			UserID:      testOwner,
+			SpellKey:    "fireball",
+			EncounterID: testEncounter,
+			TargetIDs:   []string{"fighter_1"},
+		})
+
+		assert.True(t, dnderr.IsInvalidArgument(err))
+		assert.Equal(t, 2, wizard.Resources.SpellSlots[3].Remaining)
+	})
+}
+
+func TestCastSpell_FailedCastKeepsConcentration(t *testing.T) {
+	deps := setup(t)
+	wizard := createWizard()
+	wizard.Spells.KnownSpells = append(wizard.Spells.KnownSpells, "witch-bolt")
+	witchBolt := &rulebook.Spell{
+		Key:           "witch-bolt",
+		Name:          "Witch Bolt",
+		Level:         1,
+		CastingTime:   rulebook.CastingTimeAction,
+		Concentration: true,
+		Damage:        &rulebook.SpellDamage{DamageType: "Lightning", DamageAtLevel: map[int]string{1: "1d12"}},
+	}
+	enc := createEncounter()
+	enc.AddCombatant(&combat.Combatant{
+		ID: "wizard_1", Name: wizard.Name, Type: combat.CombatantTypePlayer,
+		CharacterID: wizard.ID, PlayerID: testOwner, CurrentHP: 30, MaxHP: 30, IsActive: true,
+	})
+
+	deps.charSvc.EXPECT().GetByID(wizard.ID).Return(wizard, nil)
+	deps.charSvc.EXPECT().GetSpell(gomock.Any(), "witch-bolt").Return(witchBolt, nil)
+	deps.encounterSvc.EXPECT().GetEncounter(gomock.Any(), testEncounter).Return(enc, nil)
+	deps.roller.SetRolls([]int{5}) // Auto-hit damage
+	deps.encounterSvc.EXPECT().ApplyDamage(gomock.Any(), testEncounter, "goblin_2", testOwner, 5).
+		Return(dnderr.PermissionDenied("not your turn"))
+
+	_, err := deps.service.CastSpell(context.Background(), &CastSpellInput{
+		CharacterID: wizard.ID,
+		UserID:      testOwner,
+		SpellKey:    "witch-bolt",
+		EncounterID: testEncounter,
+		TargetIDs:   []string{"goblin_2"},
+	})
+
+	require.Error(t, err, "the previous concentration spell isn't dropped")
+	assert.Equal(t, 4, wizard.Resources.SpellSlots[1].Remaining)
+}
@@ -0,0 +1,159 @@
+package spell
+
+import (
+	"context"
+	"slices"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+)
+
+// Service defines the spellcasting service interface
+type Service interface {
+	// CastSpell casts a spell, consuming a slot and resolving it against encounter targets
+	CastSpell(ctx context.Context, input *CastSpellInput) (*CastSpellResult, error)
+
+	// RegisterHandler registers a handler for a spell that needs special logic
+	RegisterHandler(handler Handler)
+
+	// StartPreparation lists the spells a prepared caster can choose from.
+	// Only allowed after a long rest, or for a new character, until they finish.
+	StartPreparation(ctx context.Context, characterID, userID string) (*PreparationOptions, error)
+
+	// PrepareSpells replaces the caster's prepared spells of one spell level
+	PrepareSpells(ctx context.Context, input *PrepareSpellsInput) (*PreparationOptions, error)
+
+	// FinishPreparation locks in the prepared spells until the next long rest
+	FinishPreparation(ctx context.Context, characterID, userID string) error
+
+	// CopyToSpellbook copies a spell into a wizard's spellbook, paying its gold cost
+	CopyToSpellbook(ctx context.Context, input *CopySpellInput) (*CopySpellResult, error)
+}
+
+// CastSpellInput contains data for casting a spell
+type CastSpellInput struct {
+	CharacterID string
+	UserID      string // Owner of the caster; also used for encounter turn checks
+	SpellKey    string
+	SlotLevel   int      // Level to cast at; 0 casts at the spell's own level
+	EncounterID string   // Optional outside of combat
+	TargetIDs   []string // Combatant IDs
+
+	// Ritual casts a ritual spell without a slot; only allowed outside combat
+	Ritual bool
+
+	// Scroll casts the spell from a spell scroll at SlotLevel without a slot.
+	// The caster needn't know the spell but it must be on their class's list.
+	Scroll bool
+
+	// Item casts the spell from a charged magic item, such as a wand, at
+	// SlotLevel without a slot. Anyone holding the item can cast it.
+	Item bool
+}
+
+// CastSpellResult contains the outcome of a cast
+type CastSpellResult struct {
+	SpellKey   string
+	SpellName  string
+	SlotLevel  int // 0 for cantrips
+	SlotsLeft  int // Remaining slots at SlotLevel
+	DamageType string
+	Targets    []*TargetResult
+
+	// Concentration is set when the spell requires concentration
+	Concentration bool
+
+	// DroppedConcentration names the spell the caster stopped concentrating on
+	DroppedConcentration string
+
+	// Ritual is set when the spell was cast as a ritual without a slot
+	Ritual bool
+
+	Message string
+}
+
+// TotalDamage returns the damage dealt across all targets
+func (r *CastSpellResult) TotalDamage() int {
+	total := 0
+	for _, target := range r.Targets {
+		total += target.Damage
+	}
+	return total
+}
+
+// TargetResult is the outcome of a spell against one target
+type TargetResult struct {
+	CombatantID string
+	Name        string
+
+	// Spell attacks
+	AttackRoll  int // Natural d20
+	AttackTotal int
+	Hit         bool
+	Critical    bool
+
+	// Saving throws
+	SaveRoll  int // Natural d20
+	SaveTotal int
+	SaveDC    int
+	Saved     bool
+
+	Damage   int
+	Healing  int                  // Hit points the target regains
+	Effect   *shared.ActiveEffect // Optional effect applied to the target
+	Defeated bool
+}
+
+// PreparationOptions describes a prepared caster's choices
+type PreparationOptions struct {
+	CharacterID string
+	MaxPrepared int
+	Prepared    []string
+
+	// Spells the caster can prepare, by spell level
+	Spells map[int][]*rulebook.SpellReference
+}
+
+// Levels returns the spell levels with spells to prepare, lowest first
+func (o *PreparationOptions) Levels() []int {
+	levels := make([]int, 0, len(o.Spells))
+	for level := 1; level <= maxSpellLevel; level++ {
+		if len(o.Spells[level]) > 0 {
+			levels = append(levels, level)
+		}
+	}
+	return levels
+}
+
+// PreparedAtLevel returns the prepared spells of one spell level
+func (o *PreparationOptions) PreparedAtLevel(level int) []string {
+	var prepared []string
+	for _, ref := range o.Spells[level] {
+		if slices.Contains(o.Prepared, ref.Key) {
+			prepared = append(prepared, ref.Key)
+		}
+	}
+	return prepared
+}
+
+// PrepareSpellsInput contains a caster's prepared spells for one spell level
+type PrepareSpellsInput struct {
+	CharacterID string
+	UserID      string
+	SpellLevel  int
+	SpellKeys   []string // Replaces the prepared spells of SpellLevel
+}
+
+// CopySpellInput contains data for copying a spell into a spellbook
+type CopySpellInput struct {
+	CharacterID string
+	UserID      string
+	SpellKey    string
+}
+
+// CopySpellResult contains the cost of copying a spell
+type CopySpellResult struct {
+	SpellName string
+	GoldCost  int
+	Hours     int
+}
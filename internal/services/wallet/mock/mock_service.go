@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mock_service.go -package=mockwallet -source=service.go
+//
+
+// Package mockwallet is a generated GoMock package.
+package mockwallet
+
+import (
+	context "context"
+	reflect "reflect"
+
+	character "github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	wallet "github.com/KirkDiggler/dnd-bot-discord/internal/services/wallet"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Give mocks base method.
+func (m *MockService) Give(ctx context.Context, input *wallet.AdjustInput) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Give", ctx, input)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Give indicates an expected call of Give.
+func (mr *MockServiceMockRecorder) Give(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Give", reflect.TypeOf((*MockService)(nil).Give), ctx, input)
+}
+
+// SplitCoins mocks base method.
+func (m *MockService) SplitCoins(ctx context.Context, sessionID string, value int) ([]*wallet.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SplitCoins", ctx, sessionID, value)
+	ret0, _ := ret[0].([]*wallet.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SplitCoins indicates an expected call of SplitCoins.
+func (mr *MockServiceMockRecorder) SplitCoins(ctx, sessionID, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitCoins", reflect.TypeOf((*MockService)(nil).SplitCoins), ctx, sessionID, value)
+}
+
+// Take mocks base method.
+func (m *MockService) Take(ctx context.Context, input *wallet.AdjustInput) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, input)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockServiceMockRecorder) Take(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockService)(nil).Take), ctx, input)
+}
+
+// Transfer mocks base method.
+func (m *MockService) Transfer(ctx context.Context, input *wallet.TransferInput) (*wallet.TransferResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, input)
+	ret0, _ := ret[0].(*wallet.TransferResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockServiceMockRecorder) Transfer(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockService)(nil).Transfer), ctx, input)
+}
@@ -0,0 +1,271 @@
+// Package wallet moves coins between characters and the party's loot.
+package wallet
+
+//go:generate mockgen -destination=mock/mock_service.go -package=mockwallet -source=service.go
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	sessService "github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+)
+
+// Service manages character coins
+type Service interface {
+	// Transfer pays coins from the user's character to another character in
+	// the same session. Change is made automatically from the payer's coins.
+	Transfer(ctx context.Context, input *TransferInput) (*TransferResult, error)
+
+	// Give adds coins to a character in a session the user is the DM of
+	Give(ctx context.Context, input *AdjustInput) (*character.Character, error)
+
+	// Take removes coins from a character in a session the user is the DM of
+	Take(ctx context.Context, input *AdjustInput) (*character.Character, error)
+
+	// SplitCoins divides a value in copper pieces evenly across the
+	// session's party and credits each character
+	SplitCoins(ctx context.Context, sessionID string, value int) ([]*Share, error)
+}
+
+// TransferInput describes a payment between two characters
+type TransferInput struct {
+	SessionID       string
+	UserID          string // Owner of the paying character
+	ToCharacterName string
+	Amount          int
+	Coin            shared.Coin
+}
+
+// TransferResult contains both characters after a payment
+type TransferResult struct {
+	From *character.Character
+	To   *character.Character
+}
+
+// AdjustInput describes coins a DM gives to or takes from a character
+type AdjustInput struct {
+	SessionID     string
+	UserID        string // The DM
+	CharacterName string
+	Amount        int
+	Coin          shared.Coin
+}
+
+// Share is one character's cut of split coins
+type Share struct {
+	Character *character.Character
+	Value     int // In copper pieces
+}
+
+type service struct {
+	sessionService   sessService.Service
+	characterService charService.Service
+}
+
+// ServiceConfig holds configuration for the wallet service
+type ServiceConfig struct {
+	SessionService   sessService.Service // Required
+	CharacterService charService.Service // Required
+}
+
+// NewService creates a new wallet service
+func NewService(cfg *ServiceConfig) Service {
+	if cfg.SessionService == nil {
+		panic("session service is required")
+	}
+	if cfg.CharacterService == nil {
+		panic("character service is required")
+	}
+
+	return &service{
+		sessionService:   cfg.SessionService,
+		characterService: cfg.CharacterService,
+	}
+}
+
+// Transfer pays coins from the user's character to another party member
+func (s *service) Transfer(ctx context.Context, input *TransferInput) (*TransferResult, error) {
+	if input == nil {
+		return nil, dnderr.InvalidArgument("input cannot be nil")
+	}
+	if err := validateAmount(input.Amount, input.Coin); err != nil {
+		return nil, err
+	}
+
+	sess, err := s.sessionService.GetSession(ctx, input.SessionID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get session '%s'", input.SessionID)
+	}
+
+	member, exists := sess.Members[input.UserID]
+	if !exists || member.CharacterID == "" {
+		return nil, dnderr.PermissionDenied("you don't have a character in this session").
+			WithMeta("session_id", input.SessionID)
+	}
+
+	from, err := s.characterService.GetByID(member.CharacterID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", member.CharacterID)
+	}
+
+	to, err := s.findPartyMember(sess, input.ToCharacterName)
+	if err != nil {
+		return nil, err
+	}
+	if to.ID == from.ID {
+		return nil, dnderr.InvalidArgument("you can't pay yourself")
+	}
+
+	fromWallet, toWallet := from.Wallet, to.Wallet
+	value := input.Amount * input.Coin.Value()
+	if !from.Wallet.Spend(value) {
+		return nil, dnderr.InvalidArgumentf("%s only has %s", from.Name, from.Wallet).
+			WithMeta("cost", shared.FormatCopper(value))
+	}
+	to.Wallet.Add(input.Amount, input.Coin)
+
+	payCtx := charService.WithChange(ctx, input.UserID, fmt.Sprintf("%s paid %s %d %s", from.Name, to.Name, input.Amount, input.Coin))
+	if err := s.characterService.UpdateEquipment(payCtx, from); err != nil {
+		from.Wallet, to.Wallet = fromWallet, toWallet
+		return nil, dnderr.Wrapf(err, "failed to save %s", from.Name)
+	}
+	if err := s.characterService.UpdateEquipment(payCtx, to); err != nil {
+		// The payer is already saved; refund them so the coins don't vanish
+		from.Wallet, to.Wallet = fromWallet, toWallet
+		refundCtx := charService.WithChange(ctx, input.UserID, fmt.Sprintf("refunded a failed payment to %s", to.Name))
+		if refundErr := s.characterService.UpdateEquipment(refundCtx, from); refundErr != nil {
+			log.Printf("Failed to refund %s after a failed payment: %v", from.Name, refundErr)
+		}
+		return nil, dnderr.Wrapf(err, "failed to save %s", to.Name)
+	}
+
+	return &TransferResult{From: from, To: to}, nil
+}
+
+// Give adds coins to a party member's wallet
+func (s *service) Give(ctx context.Context, input *AdjustInput) (*character.Character, error) {
+	char, err := s.getAdjustTarget(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	char.Wallet.Add(input.Amount, input.Coin)
+
+	ctx = charService.WithChange(ctx, input.UserID, fmt.Sprintf("DM gave %d %s", input.Amount, input.Coin))
+	if err := s.characterService.UpdateEquipment(ctx, char); err != nil {
+		return nil, dnderr.Wrapf(err, "failed to save %s", char.Name)
+	}
+	return char, nil
+}
+
+// Take removes coins from a party member's wallet, making change if needed
+func (s *service) Take(ctx context.Context, input *AdjustInput) (*character.Character, error) {
+	char, err := s.getAdjustTarget(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	value := input.Amount * input.Coin.Value()
+	if !char.Wallet.Spend(value) {
+		return nil, dnderr.InvalidArgumentf("%s only has %s", char.Name, char.Wallet).
+			WithMeta("cost", shared.FormatCopper(value))
+	}
+
+	ctx = charService.WithChange(ctx, input.UserID, fmt.Sprintf("DM took %d %s", input.Amount, input.Coin))
+	if err := s.characterService.UpdateEquipment(ctx, char); err != nil {
+		return nil, dnderr.Wrapf(err, "failed to save %s", char.Name)
+	}
+	return char, nil
+}
+
+// SplitCoins divides coins evenly across the party. Copper left over from
+// an uneven split goes to the first characters alphabetically.
+func (s *service) SplitCoins(ctx context.Context, sessionID string, value int) ([]*Share, error) {
+	if value <= 0 {
+		return nil, dnderr.InvalidArgument("there are no coins to split")
+	}
+
+	sess, err := s.sessionService.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get session '%s'", sessionID)
+	}
+
+	party, err := s.characterService.GetParty(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = charService.WithChange(ctx, "", "share of split coins")
+	shares := make([]*Share, len(party))
+	for i, char := range party {
+		shares[i] = &Share{
+			Character: char,
+			Value:     value / len(party),
+		}
+		if i < value%len(party) {
+			shares[i].Value++
+		}
+
+		char.Wallet.AddCopper(shares[i].Value)
+		if err := s.characterService.UpdateEquipment(ctx, char); err != nil {
+			return nil, dnderr.Wrapf(err, "failed to save %s", char.Name)
+		}
+	}
+
+	return shares, nil
+}
+
+// getAdjustTarget checks the user is the session's DM and finds the character
+func (s *service) getAdjustTarget(ctx context.Context, input *AdjustInput) (*character.Character, error) {
+	if input == nil {
+		return nil, dnderr.InvalidArgument("input cannot be nil")
+	}
+	if err := validateAmount(input.Amount, input.Coin); err != nil {
+		return nil, err
+	}
+
+	sess, err := s.sessionService.GetSession(ctx, input.SessionID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get session '%s'", input.SessionID)
+	}
+
+	if member, exists := sess.Members[input.UserID]; !exists || member.Role != gameSession.SessionRoleDM {
+		return nil, dnderr.PermissionDenied("only the DM can give or take coins").
+			WithMeta("session_id", input.SessionID)
+	}
+
+	return s.findPartyMember(sess, input.CharacterName)
+}
+
+// findPartyMember finds a character in the session by name
+func (s *service) findPartyMember(sess *gameSession.Session, name string) (*character.Character, error) {
+	party, err := s.characterService.GetParty(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, char := range party {
+		if strings.EqualFold(char.Name, strings.TrimSpace(name)) {
+			return char, nil
+		}
+	}
+	return nil, dnderr.NotFoundf("no one named '%s' is in the party", name).
+		WithMeta("session_id", sess.ID)
+}
+
+func validateAmount(amount int, coin shared.Coin) error {
+	if amount <= 0 {
+		return dnderr.InvalidArgument("amount must be positive")
+	}
+	if _, ok := shared.ParseCoin(string(coin)); !ok {
+		return dnderr.InvalidArgumentf("unknown coin '%s'", coin)
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package wallet_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	mockcharacter "github.com/KirkDiggler/dnd-bot-discord/internal/services/character/mock"
+	mocksession "github.com/KirkDiggler/dnd-bot-discord/internal/services/session/mock"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/wallet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testSessionID = "session_123"
+	testDMID      = "dm_123"
+)
+
+type testDeps struct {
+	service    wallet.Service
+	sessionSvc *mocksession.MockService
+	charSvc    *mockcharacter.MockService
+}
+
+func setup(t *testing.T) *testDeps {
+	ctrl := gomock.NewController(t)
+
+	deps := &testDeps{
+		sessionSvc: mocksession.NewMockService(ctrl),
+		charSvc:    mockcharacter.NewMockService(ctrl),
+	}
+	deps.service = wallet.NewService(&wallet.ServiceConfig{
+		SessionService:   deps.sessionSvc,
+		CharacterService: deps.charSvc,
+	})
+	return deps
+}
+
+// setupParty creates a session with a DM and a player per character, and
+// lets the character service find each character and load the party
+func setupParty(deps *testDeps, chars ...*character.Character) *gameSession.Session {
+	sess := &gameSession.Session{
+		ID:     testSessionID,
+		Status: gameSession.SessionStatusActive,
+		Members: map[string]*gameSession.SessionMember{
+			testDMID: {UserID: testDMID, Role: gameSession.SessionRoleDM},
+		},
+	}
+	for _, char := range chars {
+		sess.Members[char.OwnerID] = &gameSession.SessionMember{
+			UserID:      char.OwnerID,
+			Role:        gameSession.SessionRolePlayer,
+			CharacterID: char.ID,
+		}
+		deps.charSvc.EXPECT().GetByID(char.ID).Return(char, nil).AnyTimes()
+	}
+	party := slices.Clone(chars)
+	slices.SortFunc(party, func(a, b *character.Character) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	deps.charSvc.EXPECT().GetParty(sess).Return(party, nil).AnyTimes()
+	deps.sessionSvc.EXPECT().GetSession(gomock.Any(), testSessionID).Return(sess, nil).AnyTimes()
+	return sess
+}
+
+func newCharacter(id, owner, name string, coins shared.Wallet) *character.Character {
+	return &character.Character{
+		ID:      id,
+		OwnerID: owner,
+		Name:    name,
+		Wallet:  coins,
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	t.Run("pays another party member with change", func(t *testing.T) {
+		deps := setup(t)
+		alice := newCharacter("char_1", "user_1", "Alice", shared.Wallet{Gold: 2})
+		bram := newCharacter("char_2", "user_2", "Bram", shared.Wallet{})
+		setupParty(deps, alice, bram)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), alice).Return(nil)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), bram).Return(nil)
+
+		result, err := deps.service.Transfer(context.Background(), &wallet.TransferInput{
+			SessionID:       testSessionID,
+			UserID:          "user_1",
+			ToCharacterName: "bram",
+			Amount:          15,
+			Coin:            shared.CoinSilver,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, shared.Wallet{Silver: 5}, result.From.Wallet)
+		assert.Equal(t, shared.Wallet{Silver: 15}, result.To.Wallet)
+	})
+
+	t.Run("refunds the payer when the recipient can't be saved", func(t *testing.T) {
+		deps := setup(t)
+		alice := newCharacter("char_1", "user_1", "Alice", shared.Wallet{Gold: 2})
+		bram := newCharacter("char_2", "user_2", "Bram", shared.Wallet{})
+		setupParty(deps, alice, bram)
+
+		var saved []shared.Wallet
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), alice).
+			Do(func(_ context.Context, char *character.Character) {
+				saved = append(saved, char.Wallet)
+			}).Return(nil).Times(2)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), bram).Return(dnderr.Internal("redis unavailable"))
+
+		_, err := deps.service.Transfer(context.Background(), &wallet.TransferInput{
+			SessionID:       testSessionID,
+			UserID:          "user_1",
+			ToCharacterName: "Bram",
+			Amount:          1,
+			Coin:            shared.CoinGold,
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, []shared.Wallet{{Gold: 1}, {Gold: 2}}, saved, "alice is saved paid, then refunded")
+		assert.Equal(t, shared.Wallet{Gold: 2}, alice.Wallet)
+		assert.Equal(t, shared.Wallet{}, bram.Wallet)
+	})
+
+	t.Run("can't pay more than the character has", func(t *testing.T) {
+		deps := setup(t)
+		alice := newCharacter("char_1", "user_1", "Alice", shared.Wallet{Silver: 3})
+		bram := newCharacter("char_2", "user_2", "Bram", shared.Wallet{})
+		setupParty(deps, alice, bram)
+
+		_, err := deps.service.Transfer(context.Background(), &wallet.TransferInput{
+			SessionID:       testSessionID,
+			UserID:          "user_1",
+			ToCharacterName: "Bram",
+			Amount:          1,
+			Coin:            shared.CoinGold,
+		})
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodeInvalidArgument))
+		assert.Equal(t, shared.Wallet{Silver: 3}, alice.Wallet)
+		assert.Equal(t, shared.Wallet{}, bram.Wallet)
+	})
+
+	t.Run("recipient must be in the party", func(t *testing.T) {
+		deps := setup(t)
+		alice := newCharacter("char_1", "user_1", "Alice", shared.Wallet{Gold: 5})
+		setupParty(deps, alice)
+
+		_, err := deps.service.Transfer(context.Background(), &wallet.TransferInput{
+			SessionID:       testSessionID,
+			UserID:          "user_1",
+			ToCharacterName: "Stranger",
+			Amount:          1,
+			Coin:            shared.CoinGold,
+		})
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodeNotFound))
+	})
+}
+
+func TestGiveAndTake(t *testing.T) {
+	t.Run("DM gives coins", func(t *testing.T) {
+		deps := setup(t)
+		alice := newCharacter("char_1", "user_1", "Alice", shared.Wallet{})
+		setupParty(deps, alice)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), alice).Return(nil)
+
+		char, err := deps.service.Give(context.Background(), &wallet.AdjustInput{
+			SessionID:     testSessionID,
+			UserID:        testDMID,
+			CharacterName: "Alice",
+			Amount:        3,
+			Coin:          shared.CoinPlatinum,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, char.Wallet.Platinum)
+	})
+
+	t.Run("DM takes coins, breaking larger ones", func(t *testing.T) {
+		deps := setup(t)
+		alice := newCharacter("char_1", "user_1", "Alice", shared.Wallet{Gold: 1})
+		setupParty(deps, alice)
+		deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), alice).Return(nil)
+
+		char, err := deps.service.Take(context.Background(), &wallet.AdjustInput{
+			SessionID:     testSessionID,
+			UserID:        testDMID,
+			CharacterName: "Alice",
+			Amount:        25,
+			Coin:          shared.CoinCopper,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, shared.Wallet{Copper: 5, Silver: 7}, char.Wallet)
+	})
+
+	t.Run("players can't give themselves coins", func(t *testing.T) {
+		deps := setup(t)
+		alice := newCharacter("char_1", "user_1", "Alice", shared.Wallet{})
+		setupParty(deps, alice)
+
+		_, err := deps.service.Give(context.Background(), &wallet.AdjustInput{
+			SessionID:     testSessionID,
+			UserID:        "user_1",
+			CharacterName: "Alice",
+			Amount:        100,
+			Coin:          shared.CoinGold,
+		})
+
+		require.Error(t, err)
+		assert.True(t, dnderr.Is(err, dnderr.CodePermissionDenied))
+	})
+}
+
+func TestSplitCoins(t *testing.T) {
+	deps := setup(t)
+	alice := newCharacter("char_1", "user_1", "Alice", shared.Wallet{})
+	bram := newCharacter("char_2", "user_2", "Bram", shared.Wallet{Gold: 1})
+	cora := newCharacter("char_3", "user_3", "Cora", shared.Wallet{})
+	setupParty(deps, cora, alice, bram)
+	deps.charSvc.EXPECT().UpdateEquipment(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	// 10 gold split three ways
+	shares, err := deps.service.SplitCoins(context.Background(), testSessionID, 1000)
+
+	require.NoError(t, err)
+	require.Len(t, shares, 3)
+	assert.Equal(t, "Alice", shares[0].Character.Name)
+	assert.Equal(t, 334, shares[0].Value, "the leftover copper goes to the first character")
+	assert.Equal(t, 333, shares[1].Value)
+	assert.Equal(t, 333, shares[2].Value)
+
+	assert.Equal(t, shared.Wallet{Copper: 4, Silver: 3, Gold: 3}, alice.Wallet)
+	assert.Equal(t, shared.Wallet{Copper: 3, Silver: 3, Gold: 4}, bram.Wallet)
+	assert.Equal(t, shared.Wallet{Copper: 3, Silver: 3, Gold: 3}, cora.Wallet)
+}
@@ -0,0 +1,87 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+)
+
+// MonsterSource looks up monster stat blocks by key. The dnd5e client
+// satisfies this interface, as does a local fixture file.
+type MonsterSource interface {
+	GetMonster(key string) (*combat.MonsterTemplate, error)
+}
+
+// FixtureMonsters is a MonsterSource backed by monster templates loaded from disk
+type FixtureMonsters map[string]*combat.MonsterTemplate
+
+// LoadMonsterFixture reads a JSON array of monster templates
+func LoadMonsterFixture(path string) (FixtureMonsters, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read monster fixture: %w", err)
+	}
+
+	var templates []*combat.MonsterTemplate
+	if err := json.Unmarshal(raw, &templates); err != nil {
+		return nil, fmt.Errorf("failed to parse monster fixture %s: %w", path, err)
+	}
+
+	fixture := make(FixtureMonsters, len(templates))
+	for _, template := range templates {
+		if template == nil || template.Key == "" {
+			continue
+		}
+		fixture[template.Key] = template
+	}
+	return fixture, nil
+}
+
+// GetMonster implements MonsterSource
+func (f FixtureMonsters) GetMonster(key string) (*combat.MonsterTemplate, error) {
+	template, ok := f[strings.ToLower(key)]
+	if !ok {
+		return nil, dnderr.NotFoundf("monster '%s' not found in fixture", key)
+	}
+	return template, nil
+}
+
+// MonsterFromTemplate converts a monster template into encounter input.
+// Actions that don't deal damage (like Multiattack) are dropped so the
+// monster's first action is always an attack.
+func MonsterFromTemplate(template *combat.MonsterTemplate) *encounter.AddMonsterInput {
+	input := &encounter.AddMonsterInput{
+		Name:       template.Name,
+		MaxHP:      template.HitPoints,
+		AC:         template.ArmorClass,
+		Speed:      30,
+		CR:         float64(template.ChallengeRating),
+		XP:         template.XP,
+		MonsterRef: template.Key,
+	}
+	if template.Dexterity > 0 {
+		input.InitiativeBonus = dexterityModifier(template.Dexterity)
+	}
+
+	for _, action := range template.Actions {
+		if action == nil || len(action.Damage) == 0 || action.Damage[0] == nil {
+			continue
+		}
+		input.Actions = append(input.Actions, action)
+	}
+
+	return input
+}
+
+// dexterityModifier converts a dexterity score to the monster's initiative bonus
+func dexterityModifier(score int) int {
+	if score >= 10 {
+		return (score - 10) / 2
+	}
+	return (score - 11) / 2
+}
@@ -0,0 +1,265 @@
+package simulation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/damage"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/equipment"
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+)
+
+// classPreset describes a typical build for a class: standard array scores,
+// the weapon it fights with, the AC of its starting armor and the damage
+// spells it casts
+type classPreset struct {
+	name      string
+	hitDie    int
+	primary   shared.Attribute
+	scores    map[shared.Attribute]int
+	weapon    *equipment.Weapon
+	armorAC   int  // Base AC from armor and shield
+	maxDexAC  int  // Cap on the DEX bonus to AC (-1 for no cap)
+	unarmored bool // Uses the class's unarmored defense (adds CON or WIS)
+	features  []*rulebook.CharacterFeature
+	cantrip   string // Damage cantrip cast instead of a weapon attack
+	spell     string // Leveled damage spell cast while slots last
+}
+
+func weapon(key, name, category, weaponRange string, dice int, damageType damage.Type, properties ...string) *equipment.Weapon {
+	w := &equipment.Weapon{
+		Base:           equipment.BasicEquipment{Key: key, Name: name},
+		Damage:         &damage.Damage{DiceCount: 1, DiceSize: dice, DamageType: damageType},
+		WeaponCategory: category,
+		WeaponRange:    weaponRange,
+	}
+	for _, property := range properties {
+		w.Properties = append(w.Properties, &shared.ReferenceItem{Key: property, Name: property})
+	}
+	return w
+}
+
+func scores(str, dex, con, intel, wis, cha int) map[shared.Attribute]int {
+	return map[shared.Attribute]int{
+		shared.AttributeStrength:     str,
+		shared.AttributeDexterity:    dex,
+		shared.AttributeConstitution: con,
+		shared.AttributeIntelligence: intel,
+		shared.AttributeWisdom:       wis,
+		shared.AttributeCharisma:     cha,
+	}
+}
+
+var classPresets = map[string]*classPreset{
+	"barbarian": {
+		name: "Barbarian", hitDie: 12, primary: shared.AttributeStrength,
+		scores:    scores(15, 13, 14, 8, 12, 10),
+		weapon:    weapon("greataxe", "Greataxe", "Martial", "Melee", 12, damage.TypeSlashing, "heavy", "two-handed"),
+		armorAC:   10,
+		maxDexAC:  -1,
+		unarmored: true,
+	},
+	"bard": {
+		name: "Bard", hitDie: 8, primary: shared.AttributeCharisma,
+		scores:   scores(8, 14, 13, 10, 12, 15),
+		weapon:   weapon("rapier", "Rapier", "Martial", "Melee", 8, damage.TypePiercing, "finesse"),
+		armorAC:  11,
+		maxDexAC: -1,
+		cantrip:  "vicious-mockery",
+		spell:    "dissonant-whispers",
+	},
+	"cleric": {
+		name: "Cleric", hitDie: 8, primary: shared.AttributeWisdom,
+		scores:   scores(14, 8, 13, 10, 15, 12),
+		weapon:   weapon("mace", "Mace", "Simple", "Melee", 6, damage.TypeBludgeoning),
+		armorAC:  18, // Chain mail and shield
+		maxDexAC: 0,
+		cantrip:  "sacred-flame",
+		spell:    "guiding-bolt",
+	},
+	"druid": {
+		name: "Druid", hitDie: 8, primary: shared.AttributeWisdom,
+		scores:   scores(8, 14, 13, 12, 15, 10),
+		weapon:   weapon("scimitar", "Scimitar", "Martial", "Melee", 6, damage.TypeSlashing, "finesse", "light"),
+		armorAC:  13, // Leather armor and shield
+		maxDexAC: -1,
+		cantrip:  "produce-flame",
+		spell:    "thunderwave",
+	},
+	"fighter": {
+		name: "Fighter", hitDie: 10, primary: shared.AttributeStrength,
+		scores:   scores(15, 13, 14, 8, 12, 10),
+		weapon:   weapon("longsword", "Longsword", "Martial", "Melee", 8, damage.TypeSlashing, "versatile"),
+		armorAC:  18, // Chain mail and shield
+		maxDexAC: 0,
+		features: []*rulebook.CharacterFeature{{
+			Key: "fighting_style", Name: "Fighting Style", Type: rulebook.FeatureTypeClass, Level: 1, Source: "Fighter",
+			Metadata: map[string]any{"style": "dueling"},
+		}},
+	},
+	"monk": {
+		name: "Monk", hitDie: 8, primary: shared.AttributeDexterity,
+		scores:    scores(10, 15, 13, 8, 14, 12),
+		weapon:    weapon("shortsword", "Shortsword", "Martial", "Melee", 6, damage.TypePiercing, "finesse", "light"),
+		armorAC:   10,
+		maxDexAC:  -1,
+		unarmored: true,
+		features: []*rulebook.CharacterFeature{{
+			Key: "martial-arts", Name: "Martial Arts", Type: rulebook.FeatureTypeClass, Level: 1, Source: "Monk",
+		}},
+	},
+	"paladin": {
+		name: "Paladin", hitDie: 10, primary: shared.AttributeStrength,
+		scores:   scores(15, 8, 13, 10, 12, 14),
+		weapon:   weapon("longsword", "Longsword", "Martial", "Melee", 8, damage.TypeSlashing, "versatile"),
+		armorAC:  18, // Chain mail and shield
+		maxDexAC: 0,
+	},
+	"ranger": {
+		name: "Ranger", hitDie: 10, primary: shared.AttributeDexterity,
+		scores:   scores(12, 15, 13, 8, 14, 10),
+		weapon:   weapon("longbow", "Longbow", "Martial", "Ranged", 8, damage.TypePiercing, "heavy", "two-handed"),
+		armorAC:  14, // Scale mail
+		maxDexAC: 2,
+	},
+	"rogue": {
+		name: "Rogue", hitDie: 8, primary: shared.AttributeDexterity,
+		scores:   scores(8, 15, 14, 12, 13, 10),
+		weapon:   weapon("rapier", "Rapier", "Martial", "Melee", 8, damage.TypePiercing, "finesse"),
+		armorAC:  11, // Leather armor
+		maxDexAC: -1,
+	},
+	"sorcerer": {
+		name: "Sorcerer", hitDie: 6, primary: shared.AttributeCharisma,
+		scores:   scores(8, 14, 13, 12, 10, 15),
+		weapon:   weapon("dagger", "Dagger", "Simple", "Melee", 4, damage.TypePiercing, "finesse", "light", "thrown"),
+		armorAC:  10,
+		maxDexAC: -1,
+		cantrip:  "fire-bolt",
+		spell:    "chromatic-orb",
+	},
+	"warlock": {
+		name: "Warlock", hitDie: 8, primary: shared.AttributeCharisma,
+		scores:   scores(8, 14, 13, 12, 10, 15),
+		weapon:   weapon("dagger", "Dagger", "Simple", "Melee", 4, damage.TypePiercing, "finesse", "light", "thrown"),
+		armorAC:  11, // Leather armor
+		maxDexAC: -1,
+		cantrip:  "eldritch-blast",
+		spell:    "arms-of-hadar",
+	},
+	"wizard": {
+		name: "Wizard", hitDie: 6, primary: shared.AttributeIntelligence,
+		scores:   scores(8, 14, 13, 15, 12, 10),
+		weapon:   weapon("quarterstaff", "Quarterstaff", "Simple", "Melee", 6, damage.TypeBludgeoning, "versatile"),
+		armorAC:  10,
+		maxDexAC: -1,
+		cantrip:  "fire-bolt",
+		spell:    "magic-missile",
+	},
+}
+
+// PresetClasses returns the class keys that have a preset build
+func PresetClasses() []string {
+	keys := make([]string, 0, len(classPresets))
+	for key := range classPresets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// NewPresetCharacter builds a ready-to-fight character of the given class and
+// level from a typical build. Ability score improvements go to the class's
+// primary ability and hit points use the fixed average per level.
+func NewPresetCharacter(classKey string, level int) (*character.Character, error) {
+	preset, ok := classPresets[strings.ToLower(classKey)]
+	if !ok {
+		return nil, dnderr.InvalidArgumentf("no preset for class '%s' (available: %s)", classKey, strings.Join(PresetClasses(), ", "))
+	}
+	if level < 1 || level > 20 {
+		return nil, dnderr.InvalidArgumentf("level must be between 1 and 20, got %d", level)
+	}
+
+	attributes := make(map[shared.Attribute]*character.AbilityScore)
+	for attr, score := range preset.scores {
+		attributes[attr] = &character.AbilityScore{Score: score, Bonus: (score - 10) / 2}
+	}
+
+	// Ability score improvements at 4, 8, 12, 16 and 19
+	for _, asiLevel := range []int{4, 8, 12, 16, 19} {
+		if level >= asiLevel {
+			primary := attributes[preset.primary]
+			primary.Score = min(primary.Score+2, 20)
+			primary.Bonus = (primary.Score - 10) / 2
+		}
+	}
+
+	dexBonus := attributes[shared.AttributeDexterity].Bonus
+	conBonus := attributes[shared.AttributeConstitution].Bonus
+
+	ac := preset.armorAC
+	if preset.maxDexAC < 0 {
+		ac += dexBonus
+	} else {
+		ac += min(dexBonus, preset.maxDexAC)
+	}
+	if preset.unarmored {
+		if preset.primary == shared.AttributeDexterity {
+			ac += attributes[shared.AttributeWisdom].Bonus // Monk
+		} else {
+			ac += conBonus // Barbarian
+		}
+	}
+
+	maxHP := preset.hitDie + conBonus + (level-1)*(preset.hitDie/2+1+conBonus)
+
+	class := &rulebook.Class{Key: strings.ToLower(classKey), Name: preset.name, HitDie: preset.hitDie}
+
+	weap := *preset.weapon
+	char := &character.Character{
+		ID:               fmt.Sprintf("preset-%s-%d", class.Key, level),
+		Name:             fmt.Sprintf("%s %d", preset.name, level),
+		Speed:            30,
+		Race:             &rulebook.Race{Key: "human", Name: "Human", Speed: 30},
+		Class:            class,
+		Attributes:       attributes,
+		HitDie:           preset.hitDie,
+		AC:               ac,
+		MaxHitPoints:     maxHP,
+		CurrentHitPoints: maxHP,
+		Level:            level,
+		Status:           shared.CharacterStatusActive,
+		Proficiencies: map[rulebook.ProficiencyType][]*rulebook.Proficiency{
+			rulebook.ProficiencyTypeWeapon: {
+				{Key: "simple-weapons", Name: "Simple Weapons", Type: rulebook.ProficiencyTypeWeapon},
+				{Key: weap.GetKey(), Name: weap.GetName(), Type: rulebook.ProficiencyTypeWeapon},
+			},
+		},
+		Inventory: map[equipment.EquipmentType][]equipment.Equipment{
+			equipment.EquipmentTypeWeapon: {&weap},
+		},
+		EquippedSlots: map[shared.Slot]equipment.Equipment{
+			weap.GetSlot(): &weap,
+		},
+		Features: preset.features,
+	}
+
+	if preset.cantrip != "" {
+		char.Spells = &character.SpellList{Cantrips: []string{preset.cantrip}}
+		if rulebook.PreparesSpells(class.Key) {
+			char.Spells.PreparedSpells = []string{preset.spell}
+		} else {
+			char.Spells.KnownSpells = []string{preset.spell}
+		}
+	}
+
+	char.Resources = &character.CharacterResources{}
+	char.Resources.Initialize(class, level)
+	char.Resources.HP = shared.HPResource{Current: maxHP, Max: maxHP}
+
+	return char, nil
+}
@@ -0,0 +1,174 @@
+package simulation
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+)
+
+// CombatantStats accumulates what one combatant did across every run
+type CombatantStats struct {
+	Name     string
+	IsPlayer bool
+
+	Attacks int
+	Hits    int
+	Crits   int
+	Damage  int
+
+	// Resource usage (party members only)
+	HPLost         int
+	TimesDowned    int
+	SpellSlotsUsed int
+	AbilityUses    int
+}
+
+// HitRate returns the fraction of attacks that hit
+func (c *CombatantStats) HitRate() float64 {
+	if c.Attacks == 0 {
+		return 0
+	}
+	return float64(c.Hits) / float64(c.Attacks)
+}
+
+// Report summarizes a batch of simulated encounters
+type Report struct {
+	Runs        int
+	Seed        int64
+	PartyWins   int
+	MonsterWins int
+	Stalemates  int // Runs that hit the round limit
+	Rounds      []int
+
+	Combatants map[string]*CombatantStats
+}
+
+func newReport(seed int64) *Report {
+	return &Report{
+		Seed:       seed,
+		Combatants: make(map[string]*CombatantStats),
+	}
+}
+
+func (r *Report) stats(name string, isPlayer bool) *CombatantStats {
+	stats, ok := r.Combatants[name]
+	if !ok {
+		stats = &CombatantStats{Name: name, IsPlayer: isPlayer}
+		r.Combatants[name] = stats
+	}
+	return stats
+}
+
+// WinRate returns the fraction of runs the party won
+func (r *Report) WinRate() float64 {
+	if r.Runs == 0 {
+		return 0
+	}
+	return float64(r.PartyWins) / float64(r.Runs)
+}
+
+// TotalRounds returns the number of rounds fought across all runs
+func (r *Report) TotalRounds() int {
+	total := 0
+	for _, rounds := range r.Rounds {
+		total += rounds
+	}
+	return total
+}
+
+// AverageRounds returns the mean number of rounds per run
+func (r *Report) AverageRounds() float64 {
+	if len(r.Rounds) == 0 {
+		return 0
+	}
+	return float64(r.TotalRounds()) / float64(len(r.Rounds))
+}
+
+// RoundRange returns the fewest and most rounds any run took
+func (r *Report) RoundRange() (fewest, most int) {
+	for i, rounds := range r.Rounds {
+		if i == 0 || rounds < fewest {
+			fewest = rounds
+		}
+		if rounds > most {
+			most = rounds
+		}
+	}
+	return fewest, most
+}
+
+// DamagePerRound returns the combatant's average damage per round of combat
+func (r *Report) DamagePerRound(name string) float64 {
+	stats, ok := r.Combatants[name]
+	total := r.TotalRounds()
+	if !ok || total == 0 {
+		return 0
+	}
+	return float64(stats.Damage) / float64(total)
+}
+
+// SortedCombatants returns party members first, then monsters, each by name
+func (r *Report) SortedCombatants() []*CombatantStats {
+	list := make([]*CombatantStats, 0, len(r.Combatants))
+	for _, stats := range r.Combatants {
+		list = append(list, stats)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].IsPlayer != list[j].IsPlayer {
+			return list[i].IsPlayer
+		}
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// Print writes a human readable summary of the report
+func (r *Report) Print(w io.Writer) error {
+	fewest, most := r.RoundRange()
+
+	if _, err := fmt.Fprintf(w, "Simulated %d encounters (seed %d)\n", r.Runs, r.Seed); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Party wins: %d (%.1f%%)  Monster wins: %d  Stalemates: %d\n",
+		r.PartyWins, r.WinRate()*100, r.MonsterWins, r.Stalemates); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Rounds to finish: avg %.1f, min %d, max %d\n\n", r.AverageRounds(), fewest, most); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "Combatant\tSide\tDmg/Round\tHit Rate\tCrits\tTotal Dmg")
+	for _, stats := range r.SortedCombatants() {
+		side := "monster"
+		if stats.IsPlayer {
+			side = "party"
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%.1f\t%.0f%%\t%d\t%d\n",
+			stats.Name, side, r.DamagePerRound(stats.Name), stats.HitRate()*100, stats.Crits, stats.Damage)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(w, "\nResource usage per encounter (party)"); err != nil {
+		return err
+	}
+
+	runs := float64(max(r.Runs, 1))
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "Character\tHP Lost\tDowned\tSpell Slots\tAbility Uses")
+	for _, stats := range r.SortedCombatants() {
+		if !stats.IsPlayer {
+			continue
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%.1f\t%.0f%%\t%.1f\t%.1f\n",
+			stats.Name,
+			float64(stats.HPLost)/runs,
+			float64(stats.TimesDowned)/runs*100,
+			float64(stats.SpellSlotsUsed)/runs,
+			float64(stats.AbilityUses)/runs)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,484 @@
+// Package simulation runs encounters headlessly through the real encounter
+// service so dungeon difficulty can be tuned without playing fights by hand.
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/dice"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/combat"
+	gameSession "github.com/KirkDiggler/dnd-bot-discord/internal/domain/game/session"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	characterdraft "github.com/KirkDiggler/dnd-bot-discord/internal/repositories/character_draft"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/characters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/encounters"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/repositories/gamesessions"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	sessService "github.com/KirkDiggler/dnd-bot-discord/internal/services/session"
+	spellService "github.com/KirkDiggler/dnd-bot-discord/internal/services/spell"
+	rpgevents "github.com/KirkDiggler/rpg-toolkit/events"
+)
+
+const (
+	simSessionID = "sim-session"
+	simDMID      = "sim-dm"
+
+	// DefaultMaxRounds stops runaway fights that neither side can finish
+	DefaultMaxRounds = 30
+)
+
+// Config describes a batch of encounters to simulate
+type Config struct {
+	Party      []*character.Character
+	Monsters   []*encounter.AddMonsterInput
+	Runs       int
+	Seed       int64
+	MaxRounds  int                     // Defaults to DefaultMaxRounds
+	HouseRules *gameSession.HouseRules // Optional session house rules
+	Spells     SpellSource             // Optional; without it casters fight with their weapons
+}
+
+// Simulator fights the configured party against the configured monsters
+type Simulator struct {
+	cfg    *Config
+	roller dice.Roller
+}
+
+// NewSimulator validates the config and creates a simulator with a roller
+// seeded from cfg.Seed, so the same config always produces the same report
+func NewSimulator(cfg *Config) (*Simulator, error) {
+	if cfg == nil {
+		return nil, dnderr.InvalidArgument("config cannot be nil")
+	}
+	if len(cfg.Party) == 0 {
+		return nil, dnderr.InvalidArgument("party must have at least one character")
+	}
+	if len(cfg.Monsters) == 0 {
+		return nil, dnderr.InvalidArgument("at least one monster is required")
+	}
+	if cfg.Runs < 1 {
+		return nil, dnderr.InvalidArgumentf("runs must be at least 1, got %d", cfg.Runs)
+	}
+	if cfg.MaxRounds <= 0 {
+		cfg.MaxRounds = DefaultMaxRounds
+	}
+	if cfg.HouseRules == nil {
+		cfg.HouseRules = &gameSession.HouseRules{}
+	}
+
+	return &Simulator{
+		cfg:    cfg,
+		roller: dice.NewSeededRoller(cfg.Seed),
+	}, nil
+}
+
+// Run simulates every encounter and aggregates the results
+func (s *Simulator) Run(ctx context.Context) (*Report, error) {
+	report := newReport(s.cfg.Seed)
+
+	for run := 0; run < s.cfg.Runs; run++ {
+		if err := s.runOnce(ctx, report); err != nil {
+			return nil, dnderr.Wrapf(err, "simulation run %d failed", run+1)
+		}
+		report.Runs++
+	}
+
+	return report, nil
+}
+
+// partyMember tracks a simulated character between setup and scoring
+type partyMember struct {
+	playerID    string
+	characterID string
+	name        string
+	startHP     int
+	startSlots  int
+	startUses   int
+}
+
+// runOnce fights a single encounter with fresh in-memory repositories
+func (s *Simulator) runOnce(ctx context.Context, report *Report) error {
+	charRepo := characters.NewInMemoryRepository()
+	characterService := charService.NewService(&charService.ServiceConfig{
+		Repository:      charRepo,
+		DraftRepository: characterdraft.NewInMemoryRepository(),
+	})
+
+	sessionRepo := gamesessions.NewInMemoryRepository()
+	sessionService := sessService.NewService(&sessService.ServiceConfig{
+		Repository:       sessionRepo,
+		CharacterService: characterService,
+	})
+
+	eventBus := rpgevents.NewBus()
+	encounterService := encounter.NewService(&encounter.ServiceConfig{
+		Repository:       encounters.NewInMemoryRepository(),
+		SessionService:   sessionService,
+		CharacterService: characterService,
+		UUIDGenerator:    &sequentialIDs{prefix: "sim"},
+		DiceRoller:       s.roller,
+		EventBus:         eventBus,
+	})
+
+	var spells spellService.Service
+	if s.cfg.Spells != nil {
+		spells = spellService.NewService(&spellService.ServiceConfig{
+			CharacterService: &spellLookup{Service: characterService, spells: s.cfg.Spells},
+			EncounterService: encounterService,
+			SessionService:   sessionService,
+			DiceRoller:       s.roller,
+			EventBus:         eventBus,
+		})
+	}
+
+	now := time.Now()
+	sess := &gameSession.Session{
+		ID:        simSessionID,
+		Name:      "Simulation",
+		CreatorID: simDMID,
+		DMID:      simDMID,
+		Status:    gameSession.SessionStatusActive,
+		Members: map[string]*gameSession.SessionMember{
+			simDMID: {UserID: simDMID, Role: gameSession.SessionRoleDM},
+		},
+		Settings:   &gameSession.SessionSettings{HouseRules: s.cfg.HouseRules},
+		CreatedAt:  now,
+		LastActive: now,
+	}
+
+	// Store fresh copies of the party so every run starts at full strength
+	party := make([]*partyMember, 0, len(s.cfg.Party))
+	names := make(map[string]int)
+	for i, template := range s.cfg.Party {
+		char := template.Clone().WithDiceRoller(s.roller)
+		char.ID = fmt.Sprintf("sim-char-%d", i+1)
+		char.OwnerID = fmt.Sprintf("sim-player-%d", i+1)
+		char.Status = shared.CharacterStatusActive
+		char.CurrentHitPoints = char.MaxHitPoints
+
+		// Keep names unique so stats don't merge
+		names[char.Name]++
+		if count := names[char.Name]; count > 1 {
+			char.Name = fmt.Sprintf("%s #%d", char.Name, count)
+		}
+
+		if err := charRepo.Create(ctx, char); err != nil {
+			return dnderr.Wrap(err, "failed to store party member")
+		}
+
+		sess.Members[char.OwnerID] = &gameSession.SessionMember{
+			UserID:      char.OwnerID,
+			CharacterID: char.ID,
+			Role:        gameSession.SessionRolePlayer,
+		}
+
+		slots, uses := resourceTotals(char)
+		party = append(party, &partyMember{
+			playerID:    char.OwnerID,
+			characterID: char.ID,
+			name:        char.Name,
+			startHP:     char.CurrentHitPoints,
+			startSlots:  slots,
+			startUses:   uses,
+		})
+	}
+
+	if err := sessionRepo.Create(ctx, sess); err != nil {
+		return dnderr.Wrap(err, "failed to create session")
+	}
+
+	enc, err := encounterService.CreateEncounter(ctx, &encounter.CreateEncounterInput{
+		SessionID: simSessionID,
+		ChannelID: "sim-channel",
+		Name:      "Simulated Encounter",
+		UserID:    simDMID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, member := range party {
+		if _, err := encounterService.AddPlayer(ctx, enc.ID, member.playerID, member.characterID); err != nil {
+			return err
+		}
+	}
+	for _, monster := range s.cfg.Monsters {
+		input := *monster
+		if _, err := encounterService.AddMonster(ctx, enc.ID, simDMID, &input); err != nil {
+			return err
+		}
+	}
+
+	if err := encounterService.RollInitiative(ctx, enc.ID, simDMID); err != nil {
+		return err
+	}
+	if err := encounterService.StartEncounter(ctx, enc.ID, simDMID); err != nil {
+		return err
+	}
+
+	c := &combatServices{
+		characters: characterService,
+		encounters: encounterService,
+		spells:     spells,
+	}
+	enc, err = s.fight(ctx, c, enc.ID, report)
+	if err != nil {
+		return err
+	}
+
+	// Score the run
+	report.Rounds = append(report.Rounds, enc.Round)
+	ended, playersWon := enc.CheckCombatEnd()
+	switch {
+	case !ended:
+		report.Stalemates++
+	case playersWon:
+		report.PartyWins++
+	default:
+		report.MonsterWins++
+	}
+
+	for _, combatant := range enc.Combatants {
+		if combatant.Type != combat.CombatantTypePlayer {
+			report.stats(combatant.Name, false)
+			continue
+		}
+
+		for _, member := range party {
+			if member.characterID != combatant.CharacterID {
+				continue
+			}
+
+			stats := report.stats(member.name, true)
+			stats.HPLost += member.startHP - max(combatant.CurrentHP, 0)
+			if combatant.CurrentHP <= 0 {
+				stats.TimesDowned++
+			}
+
+			if char, getErr := characterService.GetByID(member.characterID); getErr == nil {
+				slots, uses := resourceTotals(char)
+				stats.SpellSlotsUsed += member.startSlots - slots
+				stats.AbilityUses += member.startUses - uses
+			}
+		}
+	}
+
+	return nil
+}
+
+// combatServices are the services a simulated fight plays turns through
+type combatServices struct {
+	characters charService.Service
+	encounters encounter.Service
+	spells     spellService.Service // Nil when the simulation has no spell source
+}
+
+// fight plays turns until one side is defeated or the round limit is hit.
+// Party members cast their damage spell while they have slots, then their
+// cantrip, and otherwise attack, always at the most wounded monster;
+// monsters use their automated turns, exactly as they do in Discord.
+func (s *Simulator) fight(ctx context.Context, c *combatServices, encounterID string, report *Report) (*combat.Encounter, error) {
+	encounterService := c.encounters
+	for {
+		enc, err := encounterService.GetEncounter(ctx, encounterID)
+		if err != nil {
+			return nil, err
+		}
+		if enc.Status != combat.EncounterStatusActive || enc.Round > s.cfg.MaxRounds {
+			return enc, nil
+		}
+
+		current := enc.GetCurrentCombatant()
+		if current == nil {
+			return enc, nil
+		}
+
+		switch {
+		case current.IsActive && current.Type == combat.CombatantTypeMonster:
+			results, err := encounterService.ProcessAllMonsterTurns(ctx, encounterID)
+			if err != nil {
+				return nil, err
+			}
+			for _, result := range results {
+				recordAttack(report, result, false)
+			}
+			continue
+
+		case current.IsActive && current.Type == combat.CombatantTypePlayer:
+			if target := weakestMonster(enc); target != nil {
+				cast, err := s.castSpell(ctx, c, current, target, encounterID)
+				if err != nil {
+					return nil, err
+				}
+				if cast != nil {
+					recordSpell(report, current.Name, cast)
+					if enc, err = encounterService.GetEncounter(ctx, encounterID); err != nil {
+						return nil, err
+					}
+					if enc.Status != combat.EncounterStatusActive {
+						continue
+					}
+					break
+				}
+
+				result, err := encounterService.PerformAttack(ctx, &encounter.AttackInput{
+					EncounterID: encounterID,
+					AttackerID:  current.ID,
+					TargetID:    target.ID,
+					UserID:      current.PlayerID,
+				})
+				if err != nil {
+					return nil, err
+				}
+				recordAttack(report, result, true)
+				if result.CombatEnded {
+					continue
+				}
+			}
+		}
+
+		if err := encounterService.NextTurn(ctx, encounterID, simDMID); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sequentialIDs generates predictable IDs. Initiative is rolled in ID order,
+// so random UUIDs would make seeded runs unrepeatable.
+type sequentialIDs struct {
+	prefix string
+	next   int
+}
+
+// New implements uuid.Generator
+func (g *sequentialIDs) New() string {
+	g.next++
+	return fmt.Sprintf("%s-%04d", g.prefix, g.next)
+}
+
+// weakestMonster picks the standing monster with the fewest hit points,
+// breaking ties by turn order
+func weakestMonster(enc *combat.Encounter) *combat.Combatant {
+	var target *combat.Combatant
+	for _, id := range enc.TurnOrder {
+		combatant := enc.Combatants[id]
+		if combatant == nil || combatant.Type != combat.CombatantTypeMonster || !combatant.IsActive || !combatant.IsAlive() {
+			continue
+		}
+		if target == nil || combatant.CurrentHP < target.CurrentHP {
+			target = combatant
+		}
+	}
+	return target
+}
+
+func recordAttack(report *Report, result *encounter.AttackResult, isPlayer bool) {
+	if result == nil {
+		return
+	}
+
+	stats := report.stats(result.AttackerName, isPlayer)
+	stats.Attacks++
+	if result.Hit {
+		stats.Hits++
+		stats.Damage += result.Damage
+	}
+	if result.Critical {
+		stats.Crits++
+	}
+}
+
+// castSpell casts the player's leveled spell if they have a slot for it,
+// otherwise their cantrip. It returns nil when the player has nothing to
+// cast and should attack instead.
+func (s *Simulator) castSpell(ctx context.Context, c *combatServices, current, target *combat.Combatant, encounterID string) (*spellService.CastSpellResult, error) {
+	if c.spells == nil || current.CharacterID == "" {
+		return nil, nil
+	}
+
+	char, err := c.characters.GetByID(current.CharacterID)
+	if err != nil {
+		return nil, dnderr.Wrapf(err, "failed to get character '%s'", current.CharacterID)
+	}
+	if char.Spells == nil {
+		return nil, nil
+	}
+
+	input := &spellService.CastSpellInput{
+		CharacterID: char.ID,
+		UserID:      current.PlayerID,
+		EncounterID: encounterID,
+		TargetIDs:   []string{target.ID},
+	}
+
+	for _, key := range append(slices.Clone(char.Spells.PreparedSpells), char.Spells.KnownSpells...) {
+		spell, err := s.cfg.Spells.GetSpell(key)
+		if err != nil {
+			return nil, err
+		}
+		if slotLevel, ok := availableSlot(char, spell.Level); ok {
+			input.SpellKey, input.SlotLevel = key, slotLevel
+			return c.spells.CastSpell(ctx, input)
+		}
+	}
+
+	if len(char.Spells.Cantrips) == 0 {
+		return nil, nil
+	}
+	input.SpellKey = char.Spells.Cantrips[0]
+	return c.spells.CastSpell(ctx, input)
+}
+
+// availableSlot returns the lowest slot level a spell can be cast with.
+// Pact magic always casts at its own level, reported as 0.
+func availableSlot(char *character.Character, spellLevel int) (int, bool) {
+	resources := char.GetResources()
+	if resources.PactMagicOnly() {
+		slot := resources.SpellSlots[resources.PactSlotLevel()]
+		return 0, spellLevel <= resources.PactSlotLevel() && slot.Remaining > 0
+	}
+	for level := spellLevel; level <= 9; level++ {
+		if slot, ok := resources.SpellSlots[level]; ok && slot.Remaining > 0 {
+			return level, true
+		}
+	}
+	return 0, false
+}
+
+// recordSpell counts each target of a damage spell as an attack, hit when
+// it took damage
+func recordSpell(report *Report, casterName string, result *spellService.CastSpellResult) {
+	stats := report.stats(casterName, true)
+	for _, target := range result.Targets {
+		stats.Attacks++
+		if target.Damage > 0 {
+			stats.Hits++
+			stats.Damage += target.Damage
+		}
+		if target.Critical {
+			stats.Crits++
+		}
+	}
+}
+
+// resourceTotals sums the remaining spell slots and limited ability uses
+func resourceTotals(char *character.Character) (slots, uses int) {
+	if char.Resources == nil {
+		return 0, 0
+	}
+	for _, slot := range char.Resources.SpellSlots {
+		slots += slot.Remaining
+	}
+	for _, ability := range char.Resources.Abilities {
+		if ability != nil && ability.UsesMax > 0 {
+			uses += ability.UsesRemaining
+		}
+	}
+	return slots, uses
+}
@@ -0,0 +1,184 @@
+package simulation_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/character"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/domain/shared"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/services/encounter"
+	"github.com/KirkDiggler/dnd-bot-discord/internal/simulation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadMonsters(t *testing.T, keys ...string) []*encounter.AddMonsterInput {
+	t.Helper()
+
+	fixture, err := simulation.LoadMonsterFixture("testdata/monsters.json")
+	require.NoError(t, err)
+
+	var monsters []*encounter.AddMonsterInput
+	for _, key := range keys {
+		template, err := fixture.GetMonster(key)
+		require.NoError(t, err)
+		monsters = append(monsters, simulation.MonsterFromTemplate(template))
+	}
+	return monsters
+}
+
+func presetParty(t *testing.T, classes ...string) []*character.Character {
+	t.Helper()
+
+	var party []*character.Character
+	for _, class := range classes {
+		char, err := simulation.NewPresetCharacter(class, 3)
+		require.NoError(t, err)
+		party = append(party, char)
+	}
+	return party
+}
+
+func TestSimulator_Run(t *testing.T) {
+	sim, err := simulation.NewSimulator(&simulation.Config{
+		Party:    presetParty(t, "fighter", "fighter", "rogue"),
+		Monsters: loadMonsters(t, "goblin", "goblin", "orc"),
+		Runs:     10,
+		Seed:     7,
+	})
+	require.NoError(t, err)
+
+	report, err := sim.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 10, report.Runs)
+	assert.Len(t, report.Rounds, 10)
+	assert.Equal(t, 10, report.PartyWins+report.MonsterWins+report.Stalemates)
+
+	// Duplicate names are kept apart
+	assert.Contains(t, report.Combatants, "Fighter 3")
+	assert.Contains(t, report.Combatants, "Fighter 3 #2")
+	assert.Contains(t, report.Combatants, "Goblin A")
+	assert.Contains(t, report.Combatants, "Goblin B")
+	assert.Contains(t, report.Combatants, "Orc")
+
+	totalDamage := 0
+	for _, stats := range report.Combatants {
+		totalDamage += stats.Damage
+	}
+	assert.Positive(t, totalDamage, "someone should have landed a hit in ten fights")
+
+	var out bytes.Buffer
+	require.NoError(t, report.Print(&out))
+	assert.Contains(t, out.String(), "Simulated 10 encounters (seed 7)")
+	assert.Contains(t, out.String(), "Goblin A")
+}
+
+func TestSimulator_SameSeedSameReport(t *testing.T) {
+	run := func() *simulation.Report {
+		sim, err := simulation.NewSimulator(&simulation.Config{
+			Party:    presetParty(t, "barbarian", "wizard"),
+			Monsters: loadMonsters(t, "skeleton", "goblin"),
+			Runs:     5,
+			Seed:     1234,
+		})
+		require.NoError(t, err)
+
+		report, err := sim.Run(context.Background())
+		require.NoError(t, err)
+		return report
+	}
+
+	first, second := run(), run()
+	assert.Equal(t, first.Rounds, second.Rounds)
+	assert.Equal(t, first.PartyWins, second.PartyWins)
+	for name, stats := range first.Combatants {
+		assert.Equal(t, stats, second.Combatants[name], "stats for %s should match", name)
+	}
+}
+
+func TestSimulator_CastersCastSpells(t *testing.T) {
+	spells, err := simulation.LoadSpellFixture("testdata/spells.json")
+	require.NoError(t, err)
+
+	sim, err := simulation.NewSimulator(&simulation.Config{
+		Party:    presetParty(t, "wizard", "cleric", "warlock"),
+		Monsters: loadMonsters(t, "orc", "orc", "bugbear"),
+		Runs:     5,
+		Seed:     42,
+		Spells:   spells,
+	})
+	require.NoError(t, err)
+
+	report, err := sim.Run(context.Background())
+	require.NoError(t, err)
+
+	for _, name := range []string{"Wizard 3", "Cleric 3", "Warlock 3"} {
+		stats := report.Combatants[name]
+		require.NotNil(t, stats, name)
+		assert.Positive(t, stats.SpellSlotsUsed, "%s should spend spell slots", name)
+		assert.Positive(t, stats.Damage, "%s should deal spell damage", name)
+	}
+}
+
+func TestNewSimulator_Validation(t *testing.T) {
+	party := presetParty(t, "fighter")
+	monsters := loadMonsters(t, "goblin")
+
+	_, err := simulation.NewSimulator(&simulation.Config{Monsters: monsters, Runs: 1})
+	assert.Error(t, err, "party is required")
+
+	_, err = simulation.NewSimulator(&simulation.Config{Party: party, Runs: 1})
+	assert.Error(t, err, "monsters are required")
+
+	_, err = simulation.NewSimulator(&simulation.Config{Party: party, Monsters: monsters})
+	assert.Error(t, err, "runs must be positive")
+}
+
+func TestNewPresetCharacter(t *testing.T) {
+	fighter, err := simulation.NewPresetCharacter("Fighter", 5)
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, fighter.Level)
+	assert.Equal(t, 18, fighter.AC)
+	// 10 + 2 at first level, then 6 + 2 for four more levels
+	assert.Equal(t, 44, fighter.MaxHitPoints)
+	// Level 4 ASI bumps strength from 15 to 17
+	assert.Equal(t, 17, fighter.Attributes[shared.AttributeStrength].Score)
+
+	monk, err := simulation.NewPresetCharacter("monk", 1)
+	require.NoError(t, err)
+	assert.Equal(t, 14, monk.AC, "unarmored defense adds DEX and WIS")
+
+	wizard, err := simulation.NewPresetCharacter("wizard", 1)
+	require.NoError(t, err)
+	require.NotNil(t, wizard.Spells)
+	assert.Equal(t, []string{"fire-bolt"}, wizard.Spells.Cantrips)
+	assert.Equal(t, []string{"magic-missile"}, wizard.Spells.PreparedSpells, "wizards prepare their spells")
+
+	_, err = simulation.NewPresetCharacter("artificer", 1)
+	assert.Error(t, err)
+
+	_, err = simulation.NewPresetCharacter("fighter", 21)
+	assert.Error(t, err)
+}
+
+func TestMonsterFromTemplate_DropsActionsWithoutDamage(t *testing.T) {
+	fixture, err := simulation.LoadMonsterFixture("testdata/monsters.json")
+	require.NoError(t, err)
+
+	template, err := fixture.GetMonster("bugbear")
+	require.NoError(t, err)
+
+	input := simulation.MonsterFromTemplate(template)
+	assert.Equal(t, "Bugbear", input.Name)
+	assert.Equal(t, 27, input.MaxHP)
+	assert.Equal(t, 16, input.AC)
+	assert.Equal(t, 2, input.InitiativeBonus)
+	require.Len(t, input.Actions, 1)
+	assert.Equal(t, "Morningstar", input.Actions[0].Name)
+
+	_, err = fixture.GetMonster("tarrasque")
+	assert.Error(t, err)
+}
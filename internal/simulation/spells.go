@@ -0,0 +1,69 @@
+package simulation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	rulebook "github.com/KirkDiggler/dnd-bot-discord/internal/domain/rulebook/dnd5e"
+	dnderr "github.com/KirkDiggler/dnd-bot-discord/internal/errors"
+	charService "github.com/KirkDiggler/dnd-bot-discord/internal/services/character"
+)
+
+// SpellSource looks up spells by key. The dnd5e client satisfies this
+// interface, as does a local fixture file.
+type SpellSource interface {
+	GetSpell(key string) (*rulebook.Spell, error)
+}
+
+// FixtureSpells is a SpellSource backed by spells loaded from disk
+type FixtureSpells map[string]*rulebook.Spell
+
+// LoadSpellFixture reads a JSON array of spells
+func LoadSpellFixture(path string) (FixtureSpells, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spell fixture: %w", err)
+	}
+
+	var spells []*rulebook.Spell
+	if err := json.Unmarshal(raw, &spells); err != nil {
+		return nil, fmt.Errorf("failed to parse spell fixture %s: %w", path, err)
+	}
+
+	fixture := make(FixtureSpells, len(spells))
+	for _, spell := range spells {
+		if spell == nil || spell.Key == "" {
+			continue
+		}
+		fixture[spell.Key] = spell
+	}
+	return fixture, nil
+}
+
+// GetSpell implements SpellSource
+func (f FixtureSpells) GetSpell(key string) (*rulebook.Spell, error) {
+	spell, ok := f[strings.ToLower(key)]
+	if !ok {
+		return nil, dnderr.NotFoundf("spell '%s' not found in fixture", key)
+	}
+	return spell, nil
+}
+
+// spellLookup answers the spell service's spell lookups from the
+// simulation's spell source, since the simulated character service has no
+// D&D 5e client
+type spellLookup struct {
+	charService.Service
+	spells SpellSource
+}
+
+// GetSpell implements charService.Service
+func (l *spellLookup) GetSpell(_ context.Context, spellKey string) (*rulebook.Spell, error) {
+	if spellKey == "" {
+		return nil, dnderr.InvalidArgument("spell key is required")
+	}
+	return l.spells.GetSpell(spellKey)
+}